@@ -1 +1,21 @@
 package main
+
+import (
+	"os"
+
+	"github.com/MangoDowner/mediawiki/includes"
+)
+
+/**
+ * Configure the site from its settings file, LocalSettings.yaml or
+ * LocalSettings.json, or the file MW_CONFIG_FILE names, and from the
+ * MW_SETTING_* environment variables overriding it, e.g.
+ * MW_SETTING_LANGUAGE_CODE=de.
+ *
+ * See "go run ./maintenance/showConfig" for the resulting configuration.
+ */
+func loadLocalSettings() {
+	if err := includes.LoadLocalSettings(includes.GetLocalSettingsPath(), os.Environ()); err != nil {
+		panic(err)
+	}
+}
@@ -58,6 +58,9 @@ func (c *MainController) Main() {
 	// TODO:
 	//$this->doPreOutputCommit( $outputWork );
 
+	// Vary on Accept-Language, which picks the language variant of the page
+	c.GetOutput().SendCacheControl(c.Ctx.ResponseWriter.Header(), c.GetTitle(), c.GetRequest())
+
 	c.Data["Website"] = "beego.me"
 	c.Data["Email"] = "astaxie@gmail.com"
 	c.TplName = "index.tpl"
@@ -9,6 +9,7 @@ import (
 	"github.com/MangoDowner/mediawiki/includes"
 	"github.com/MangoDowner/mediawiki/includes/actions"
 	"github.com/MangoDowner/mediawiki/includes/config"
+	"github.com/MangoDowner/mediawiki/includes/languages"
 	"github.com/astaxie/beego"
 )
 
@@ -42,6 +43,11 @@ func (b *MediaWiki) parseTitle() (ret *includes.Title, err error) {
 
 	fmt.Println("[parseTitle]", curId, title, action)
 
+	// Check variant links so that interwiki links don't have to worry
+	// about the possible different language variants
+	if ret != nil {
+		ret = ret.FindVariantLink()
+	}
 	return ret, nil
 }
 
@@ -97,7 +103,10 @@ func (b *MediaWiki) performRequest() {
 		output.SetPrintable()
 	}
 	var unused bool // To pass it by reference
-	includes.NewHooks().Run("BeforeInitialize", []interface{}{&title, &unused}, "")
+	user, _ := b.GetUser().(languages.IUser)
+	// This controller, not an includes.MediaWiki, handles the request
+	includes.NewHookRunner(includes.GetHookContainer()).OnBeforeInitialize(
+		&title, &unused, &output, &user, b.GetRequest(), nil)
 
 	// Invalid titles. T23776: The interwikis must redirect even if the page name is empty.
 	fmt.Println("INVALID")
@@ -128,7 +137,7 @@ func (b *MediaWiki) performRequest() {
 	// Redirect loops, titleless URL, $wgUsePathInfo URLs, and URLs with a variant
 	if !b.tryNormaliseRedirect(title) {
 		// Prevent information leak via Special:MyPage et al (T109724)
-		spFactory = includes.NewMediaWikiServices().GetSpecialPageFactory()
+		spFactory = includes.GetMediaWikiServices().GetSpecialPageFactory()
 		fmt.Println("FAC:", spFactory)
 	}
 
@@ -171,7 +180,8 @@ func (b *MediaWiki) tryNormaliseRedirect(title *includes.Title) bool {
 	if b.GetString("action", "view") != "view" ||
 		b.WasPosted() ||
 		b.GetString("title") != "" && title.GetPrefixedDBkey() == b.GetString("title") ||
-		includes.NewHooks().Run("TestCanonicalRedirect", []interface{}{title}, "") {
+		!includes.NewHookRunner(includes.GetHookContainer()).OnTestCanonicalRedirect(
+			b.GetRequest(), title, b.GetOutput()) {
 		return false
 	}
 
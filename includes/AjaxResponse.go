@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"github.com/MangoDowner/mediawiki/includes/config"
 	"github.com/MangoDowner/mediawiki/includes/libs"
-	"github.com/astaxie/beego"
 	"github.com/astaxie/beego/context"
 	"time"
 )
@@ -64,7 +63,7 @@ type AjaxResponse struct {
 	/**
 	 * @var Config
 	 */
-	mConfig beego.Config
+	mConfig config.IConfig
 
 	context *context.Context
 }
@@ -78,6 +77,7 @@ func NewAjaxResponse(ctx *context.Context,text string) *AjaxResponse {
 	this.mResponseCode = 200
 	this.mContentType = "application/x-wiki"
 	this.context = ctx
+	this.mConfig = NewMainConfig()
 	if text != "" {
 		this.addText(text)
 	}
@@ -126,11 +126,11 @@ func (a *AjaxResponse) SendHeaders() {
 		// If CDN caches are configured, tell them to cache the response,
 		// and tell the client to always check with the CDN. Otherwise,
 		// tell the client to use a cached copy, without a way to purge it.
-		if config.Configs.GetBool("UseSquid") {
+		if a.mConfig.Get("UseSquid").(bool) {
 			// Expect explicit purge of the proxy cache, but require end user agents
 			// to revalidate against the proxy on each visit.
 			// Surrogate-Control controls our CDN, Cache-Control downstream caches
-			if  config.Configs.GetBool("UseESI") {
+			if a.mConfig.Get("UseESI").(bool) {
 				a.context.ResponseWriter.Header().Set("Surrogate-Control",
 					fmt.Sprintf(`max-age=%d, content=="ESI/1.0"`, a.mCacheDuration))
 				a.context.ResponseWriter.Header().Set("Cache-Control",
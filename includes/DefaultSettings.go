@@ -1,10 +1,245 @@
 /**
  * Default values for MediaWiki configuration settings.
  *
+ * The settings which are data are described by MainConfigSchema, with their
+ * types and defaults: the $wg globals, app.conf and LocalSettings override
+ * them, and NewMainConfig() gives the effective values. The hooks and the
+ * service wirings are functions, so they stay Go variables.
  */
 package includes
 
-var (
+import (
+	"github.com/MangoDowner/mediawiki/includes/config"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+)
+
+/**
+ * The settings of MediaWiki core
+ *
+ * @var ConfigSchema
+ */
+var MainConfigSchema = config.NewConfigSchema(map[string]*config.ConfigSetting{
+
+	/**
+	 * MediaWiki version number
+	 * @since 1.2
+	 */
+	"Version": {
+		Type:        config.TYPE_STRING,
+		Default:     consts.MW_VERSION,
+		Description: "MediaWiki version number",
+	},
+
+	/**
+	 * Filesystem extensions directory.
+	 * Defaults to "{$IP}/extensions".
+	 * @since 1.25
+	 */
+	"ExtensionDirectory": {
+		Type:        config.TYPE_STRING,
+		Default:     "extensions",
+		Description: "Filesystem extensions directory",
+	},
+
+	/**
+	 * Filesystem stylesheets directory.
+	 * Defaults to "{$IP}/skins".
+	 * @since 1.3
+	 */
+	"StyleDirectory": {
+		Type:        config.TYPE_STRING,
+		Default:     "skins",
+		Description: "Filesystem stylesheets directory",
+	},
+
+	/**
+	 * Database type
+	 *
+	 * Only "sqlite" is supported so far. The binary must link a database/sql
+	 * driver registered as "sqlite3", see the sqlite build tag.
+	 */
+	"DBtype": {
+		Type:        config.TYPE_STRING,
+		Default:     "sqlite",
+		Description: "Database type",
+	},
+
+	/**
+	 * Directory of the SQLite database file, which is named after $wgDBname
+	 *
+	 * The database is kept in memory for the lifetime of the process if
+	 * empty, which is only good for caches.
+	 */
+	"SQLiteDataDir": {
+		Type:        config.TYPE_STRING,
+		Default:     "",
+		Description: "SQLite data directory",
+	},
+
+	/**
+	 * Current wiki database name
+	 *
+	 * Should be alphanumeric, without spaces nor hyphens.
+	 * This is used to determine the current/local wiki ID (WikiMap::getCurrentWikiId).
+	 */
+	"DBname": {
+		Type:        config.TYPE_STRING,
+		Default:     "my_wiki",
+		Description: "Current wiki database name",
+	},
+
+	/**
+	 * Current wiki database table name prefix
+	 *
+	 * Should be alphanumeric, without spaces nor hyphens, preferably ending in an underscore.
+	 */
+	"DBprefix": {
+		Type:        config.TYPE_STRING,
+		Default:     "",
+		Description: "Current wiki database table name prefix",
+	},
+
+	/**
+	 * Main cache type. This should be a cache with fast access, but it may have
+	 * limited space. By default, it is disabled, since the stock database cache
+	 * is not fast enough to make it worthwhile.
+	 *
+	 * The options are:
+	 *
+	 *   - CACHE_ANYTHING:   Use anything, as long as it works
+	 *   - CACHE_NONE:       Do not cache
+	 *   - CACHE_DB:         Store cache objects in the DB
+	 *   - CACHE_ACCEL:      APC, APCU or WinCache
+	 *   - (other):          A string may be used which identifies a cache
+	 *                       configuration in $wgObjectCaches.
+	 */
+	"MainCacheType": {
+		Type:        config.TYPE_STRING,
+		Default:     consts.CACHE_NONE,
+		Description: "Main cache type",
+	},
+
+	/**
+	 * The cache type for storing the contents of the MediaWiki namespace. This
+	 * cache is used for a small amount of data which is expensive to regenerate.
+	 *
+	 * For available types see $wgMainCacheType.
+	 */
+	"MessageCacheType": {
+		Type:        config.TYPE_STRING,
+		Default:     consts.CACHE_ANYTHING,
+		Description: "The cache type for storing the contents of the MediaWiki namespace",
+	},
+
+	/**
+	 * The cache type for storing article HTML. This is used to store data which
+	 * is expensive to regenerate, and benefits from having plenty of storage space.
+	 *
+	 * For available types see $wgMainCacheType.
+	 */
+	"ParserCacheType": {
+		Type:        config.TYPE_STRING,
+		Default:     consts.CACHE_ANYTHING,
+		Description: "The cache type for storing article HTML",
+	},
+
+	/**
+	 * The cache type for storing session data.
+	 *
+	 * For available types see $wgMainCacheType.
+	 */
+	"SessionCacheType": {
+		Type:        config.TYPE_STRING,
+		Default:     consts.CACHE_ANYTHING,
+		Description: "The cache type for storing session data",
+	},
+
+	/**
+	 * Overwrite the caching key prefix with custom value.
+	 * Empty to use the wiki ID.
+	 * @since 1.19
+	 */
+	"CachePrefix": {
+		Type:        config.TYPE_STRING,
+		Default:     "",
+		Description: "Overwrite the caching key prefix with custom value",
+	},
+
+	/**
+	 * Site language code. See languages/data/Names.php for languages supported by
+	 * MediaWiki out of the box. Not all languages listed there have translations,
+	 * see languages/messages/ for the list of languages with some localisation.
+	 */
+	"LanguageCode": {
+		Type:        config.TYPE_STRING,
+		Default:     "en",
+		Description: "Site language code",
+	},
+
+	/**
+	 * List of language names or overrides for default names in Names.php
+	 */
+	"ExtraLanguageNames": {
+		Type:        config.TYPE_OBJECT,
+		Default:     map[string]string{},
+		Description: "List of language names or overrides for default names in Names.php",
+	},
+
+	/**
+	 * Whether to enable the pig latin variant of English (en-x-piglatin),
+	 * used to ease variant development work.
+	 */
+	"UsePigLatinVariant": {
+		Type:        config.TYPE_BOOLEAN,
+		Default:     false,
+		Description: "Whether to enable the pig latin variant of English (en-x-piglatin)",
+	},
+
+	/**
+	 * Some languages need different word forms, usually for different cases.
+	 * Used in Language::convertGrammar().
+	 *
+	 * @par Example:
+	 * @code
+	 * $wgGrammarForms['en']['genitive']['car'] = 'car\'s';
+	 * @endcode
+	 */
+	"GrammarForms": {
+		Type:        config.TYPE_OBJECT,
+		Default:     map[string]map[string]map[string]string{},
+		Description: "Word forms of the languages, used in Language::convertGrammar()",
+	},
+
+	/**
+	 * When translating messages with wfMessage(), it is not always clear what
+	 * should be considered UI messages and what should be content messages.
+	 *
+	 * This array lists the keys of the messages which are always UI messages.
+	 */
+	"ForceUIMsgAsContentMsg": {
+		Type:        config.TYPE_ARRAY,
+		Default:     []string{},
+		Description: "Keys of the messages which are always UI messages",
+	},
+
+	/**
+	 * Translation using MediaWiki: namespace.
+	 * Interface messages will be loaded from the database.
+	 */
+	"UseDatabaseMessages": {
+		Type:        config.TYPE_BOOLEAN,
+		Default:     true,
+		Description: "Load the interface messages from the MediaWiki namespace",
+	},
+
+	/**
+	 * Expiry time for the message cache key
+	 */
+	"MsgCacheExpiry": {
+		Type:        config.TYPE_INTEGER,
+		Default:     86400,
+		Description: "Expiry time for the message cache key",
+	},
 
 	/**
 	 * Temporary variable that applies MediaWiki UI wherever it can be supported.
@@ -12,7 +247,11 @@ var (
 	 * stable and change has been communicated.
 	 * @since 1.24
 	 */
-	WgUseMediaWikiUIEverywhere = false
+	"UseMediaWikiUIEverywhere": {
+		Type:        config.TYPE_BOOLEAN,
+		Default:     false,
+		Description: "Apply MediaWiki UI wherever it can be supported",
+	},
 
 	/**
 	 * Additional namespaces. If the namespaces defined in Language.php and
@@ -40,8 +279,281 @@ var (
 	 *
 	 * @todo Add a note about maintenance/namespaceDupes.php
 	 */
-	WgExtraNamespaces map[int]string
+	"ExtraNamespaces": {
+		Type:        config.TYPE_OBJECT,
+		Default:     map[string]interface{}{},
+		Description: "Additional namespaces, by index",
+	},
+
+	/**
+	 * Which namespaces should support subpages?
+	 * See Language.php for a list of namespaces.
+	 */
+	"NamespacesWithSubpages": {
+		Type:        config.TYPE_OBJECT,
+		Default:     map[string]interface{}{},
+		Description: "Which namespaces should support subpages",
+	},
+
+	/**
+	 * Array of namespaces which can be deemed to contain valid "content", as far
+	 * as the site statistics are concerned. Useful if additional namespaces also
+	 * contain "content" which should be considered when generating a count of the
+	 * number of articles in the wiki.
+	 */
+	"ContentNamespaces": {
+		Type:        config.TYPE_ARRAY,
+		Default:     []interface{}{consts.NS_MAIN},
+		Description: "Namespaces which contain content",
+	},
+
+	/**
+	 * Set the minimum permissions required to edit pages in each
+	 * namespace.  If you list more than one permission, a user must
+	 * have all of them to edit pages in that namespace.
+	 */
+	"NamespaceProtection": {
+		Type:        config.TYPE_OBJECT,
+		Default:     map[string]interface{}{},
+		Description: "The minimum permissions required to edit pages in each namespace",
+	},
 
+	/**
+	 * Associative array mapping namespace IDs to the name of the content model pages in that namespace
+	 * should have by default (use the CONTENT_MODEL_XXX constants). If no special content type is
+	 * defined for a given namespace, pages in that namespace will use the CONTENT_MODEL_WIKITEXT
+	 * (except for the special case of JS and CS pages).
+	 *
+	 * @since 1.21
+	 */
+	"NamespaceContentModels": {
+		Type:        config.TYPE_OBJECT,
+		Default:     map[string]interface{}{},
+		Description: "The default content model of the pages, by namespace",
+	},
+
+	/**
+	 * Array of allowed values for the "title=foo&action=<action>" parameter. Syntax is:
+	 *     "foo" : "ClassName"    Load the specified class which subclasses Action
+	 *     "foo" : true           Load the class FooAction which subclasses Action
+	 *                             If something is specified in the getActionOverrides()
+	 *                             of the relevant Page object it will be used
+	 *                             instead of the default class.
+	 *     "foo" : false          The action is disabled; show an error message
+	 * Unsetting core actions will probably cause things to complain loudly.
+	 */
+	"Actions": {
+		Type: config.TYPE_OBJECT,
+		Default: map[string]interface{}{
+			"credits":        true,
+			"delete":         true,
+			"edit":           true,
+			"editchangetags": nil,
+			"history":        true,
+			"info":           true,
+			"markpatrolled":  true,
+			"mcrundo":        nil,
+			"mcrrestore":     nil,
+			"protect":        true,
+			"purge":          true,
+			"raw":            true,
+			"render":         true,
+			"revert":         true,
+			"revisiondelete": nil,
+			"rollback":       true,
+			"submit":         true,
+			"unprotect":      true,
+			"unwatch":        true,
+			"view":           true,
+			"watch":          true,
+		},
+		Description: "Allowed values for the action parameter",
+	},
+
+	/**
+	 * List of special pages, followed by what subtitle they should go under
+	 * at Special:SpecialPages
+	 *
+	 * @deprecated 1.21 Override SpecialPage::getGroupName instead
+	 */
+	"SpecialPages": {
+		Type:        config.TYPE_OBJECT,
+		Default:     map[string]interface{}{},
+		Description: "Special pages of the extensions",
+	},
+
+	/**
+	 * Extension messages directories.
+	 *
+	 * Associative array mapping extension name to the directory where configurations can be found.
+	 *
+	 * @since 1.23
+	 */
+	"MessagesDirs": {
+		Type:        config.TYPE_OBJECT,
+		Default:     map[string]interface{}{},
+		Description: "Message directories of the extensions",
+	},
+
+	/**
+	 * Define extra client-side modules to be registered with ResourceLoader.
+	 *
+	 * @since 1.17
+	 */
+	"ResourceModules": {
+		Type:        config.TYPE_OBJECT,
+		Default:     map[string]interface{}{},
+		Description: "Extra client-side modules to be registered with ResourceLoader",
+	},
+
+	/**
+	 * Registry of factory functions to create config objects, by extension
+	 * name. The functions are names registration.RegisterFunction() knows,
+	 * e.g. "GlobalVarConfig::newInstance". Core registers 'main' itself.
+	 * @since 1.23
+	 */
+	"ConfigRegistry": {
+		Type:        config.TYPE_OBJECT,
+		Default:     map[string]interface{}{},
+		Description: "Registry of factory functions to create config objects",
+	},
+
+	/**
+	 * Disable the internal MySQL-based search, to allow it to be
+	 * implemented by an extension instead.
+	 */
+	"DisableInternalSearch": {
+		Type:        config.TYPE_BOOLEAN,
+		Default:     false,
+		Description: "Disable the internal search",
+	},
+
+	/**
+	 * Set to true to enable the e-mail basic features:
+	 * Password reminders, etc. If sending e-mail on your
+	 * server doesn't work, you might want to disable this.
+	 */
+	"EnableEmail": {
+		Type:        config.TYPE_BOOLEAN,
+		Default:     true,
+		Description: "Enable the e-mail basic features",
+	},
+
+	/**
+	 * Require email authentication before sending mail to an email address.
+	 * This is highly recommended. It prevents MediaWiki from being used as an open
+	 * spam relay.
+	 */
+	"EmailAuthentication": {
+		Type:        config.TYPE_BOOLEAN,
+		Default:     true,
+		Description: "Require email authentication before sending mail to an email address",
+	},
+
+	/**
+	 * Settings added to this array will override the default globals for the user
+	 * preferences used by anonymous visitors and newly created accounts.
+	 * For instance, to disable editing on double clicks:
+	 * $wgDefaultUserOptions ['editondblclick'] = 0;
+	 */
+	"DefaultUserOptions": {
+		Type:        config.TYPE_OBJECT,
+		Default:     map[string]interface{}{"gender": "unknown"},
+		Description: "Default values of the user preferences",
+	},
+
+	/**
+	 * Allow running of javascript test suites via [[Special:JavaScriptTest]] (such as QUnit).
+	 * @since 1.20
+	 */
+	"EnableJavaScriptTest": {
+		Type:        config.TYPE_BOOLEAN,
+		Default:     false,
+		Description: "Allow running of javascript test suites via Special:JavaScriptTest",
+	},
+
+	/**
+	 * Enable page language feature
+	 * Allows setting page language in database
+	 * @since 1.24
+	 */
+	"PageLanguageUseDB": {
+		Type:        config.TYPE_BOOLEAN,
+		Default:     false,
+		Description: "Allows setting page language in database",
+	},
+
+	/**
+	 * Set to false to disable use of the database fields introduced by the ContentHandler facility.
+	 * This way, the ContentHandler facility can be used without any additional information in the
+	 * database.
+	 * @since 1.21
+	 */
+	"ContentHandlerUseDB": {
+		Type:        config.TYPE_BOOLEAN,
+		Default:     true,
+		Description: "Use the database fields introduced by the ContentHandler facility",
+	},
+
+	/**
+	 * Enable/disable CDN.
+	 * See https://www.mediawiki.org/wiki/Manual:Squid_caching
+	 */
+	"UseSquid": {
+		Type:        config.TYPE_BOOLEAN,
+		Default:     false,
+		Description: "Enable/disable CDN",
+	},
+
+	/**
+	 * If you run Squid3 with ESI support, enable this (default:false):
+	 */
+	"UseESI": {
+		Type:        config.TYPE_BOOLEAN,
+		Default:     false,
+		Description: "Whether the CDN supports ESI",
+	},
+
+	/**
+	 * Functions which can be called through the AJAX interface
+	 */
+	"AjaxExportList": {
+		Type:        config.TYPE_ARRAY,
+		Default:     []interface{}{},
+		Description: "Functions which can be called through the AJAX interface",
+	},
+
+	/**
+	 * Destination of statsd metrics.
+	 *
+	 * A host or host:port of a statsd server. Port defaults to 8125.
+	 *
+	 * If not set, statsd metrics will not be collected.
+	 *
+	 * @see wfLogProfilingData
+	 * @since 1.25
+	 */
+	"StatsdServer": {
+		Type:        config.TYPE_STRING,
+		Default:     "",
+		Description: "Destination of statsd metrics",
+	},
+
+	/**
+	 * Prefix for metric names sent to $wgStatsdServer.
+	 *
+	 * @see MediaWikiServices::getStatsdDataFactory
+	 * @see BufferingStatsdDataFactory
+	 * @since 1.25
+	 */
+	"StatsdMetricPrefix": {
+		Type:        config.TYPE_STRING,
+		Default:     "MediaWiki",
+		Description: "Prefix for metric names sent to $wgStatsdServer",
+	},
+})
+
+var (
 	/**
 	 * Global list of hooks.
 	 *
@@ -78,40 +590,15 @@ var (
 	 */
 	WgHooks = map[string][]HookFunc{}
 
-
-
-
 	/**
-	 * Array of allowed values for the "title=foo&action=<action>" parameter. Syntax is:
-	 *     "foo" : "ClassName"    Load the specified class which subclasses Action
-	 *     "foo" : true           Load the class FooAction which subclasses Action
-	 *                             If something is specified in the getActionOverrides()
-	 *                             of the relevant Page object it will be used
-	 *                             instead of the default class.
-	 *     "foo" : false          The action is disabled; show an error message
-	 * Unsetting core actions will probably cause things to complain loudly.
+	 * List of service wirings to load. Extensions should add their own
+	 * wiring to this list, to define their services.
+	 *
+	 * @see MediaWikiServices
+	 * @see ServiceContainer::loadWiringFiles() for details on loading
+	 *   service instantiator functions.
+	 * @see docs/injection.txt for an overview of dependency
+	 *   injection in MediaWiki.
 	 */
-	WgActions = map[string]interface{}{
-		"credits" : true,
-		"delete" : true,
-		"edit" : true,
-		"editchangetags" : nil,
-		"history" : true,
-		"info" : true,
-		"markpatrolled" : true,
-		"mcrundo" : nil,
-		"mcrrestore" : nil,
-		"protect" : true,
-		"purge" : true,
-		"raw" : true,
-		"render" : true,
-		"revert" : true,
-		"revisiondelete" : nil,
-		"rollback" : true,
-		"submit" : true,
-		"unprotect" : true,
-		"unwatch" : true,
-		"view" : true,
-		"watch" : true,
-	}
-)
\ No newline at end of file
+	WgServiceWiringFiles = []ServiceWiring{CoreServiceWiring}
+)
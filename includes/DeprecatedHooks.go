@@ -0,0 +1,138 @@
+package includes
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/MangoDowner/mediawiki/includes/exception"
+)
+
+/**
+ * Deprecation details of a hook
+ */
+type DeprecatedHookInfo struct {
+	/** @var string Version in which the hook was deprecated */
+	DeprecatedVersion string
+	/** @var string Component which deprecated the hook, MediaWiki if empty */
+	Component string
+	/** @var string Hook to use instead, if any */
+	Replacement string
+}
+
+/**
+ * DeprecatedHooks class.
+ *
+ * Registry of the deprecated hooks, so that the handlers of a deprecated
+ * hook are warned about wherever it is run from, instead of each caller
+ * passing the version to Hooks::run().
+ *
+ * @since 1.35
+ */
+type DeprecatedHooks struct {
+	/**
+	 * @var array Map of (hook name => DeprecatedHookInfo)
+	 */
+	deprecatedHooks map[string]*DeprecatedHookInfo
+	mutex           sync.RWMutex
+}
+
+/**
+ * Hooks deprecated in MediaWiki core, with their replacements
+ * @var array Map of (hook name => DeprecatedHookInfo)
+ */
+var coreDeprecatedHooks = map[string]*DeprecatedHookInfo{
+	"AbortAutoAccount":               {DeprecatedVersion: "1.27"},
+	"AbortLogin":                     {DeprecatedVersion: "1.27"},
+	"AbortNewAccount":                {DeprecatedVersion: "1.27"},
+	"AddNewAccount":                  {DeprecatedVersion: "1.27", Replacement: "LocalUserCreated"},
+	"APIEditBeforeSave":              {DeprecatedVersion: "1.28", Replacement: "EditFilterMergedContent"},
+	"ArticleAfterFetchContentObject": {DeprecatedVersion: "1.32", Replacement: "ArticleRevisionViewCustom"},
+	"ArticleContentViewCustom":       {DeprecatedVersion: "1.32", Replacement: "ArticleRevisionViewCustom"},
+	"AuthPluginAutoCreate":           {DeprecatedVersion: "1.27", Replacement: "LocalUserCreated"},
+	"LinkBegin":                      {DeprecatedVersion: "1.28", Replacement: "HtmlPageLinkRendererBegin"},
+	"LinkEnd":                        {DeprecatedVersion: "1.28", Replacement: "HtmlPageLinkRendererEnd"},
+	"RevisionInsertComplete":         {DeprecatedVersion: "1.31", Replacement: "RevisionRecordInserted"},
+	"UploadVerification":             {DeprecatedVersion: "1.28", Replacement: "UploadVerifyFile"},
+	"UserRights":                     {DeprecatedVersion: "1.26", Replacement: "UserGroupsChanged"},
+}
+
+/**
+ * @param array $deprecatedHooks Hooks deprecated by extensions, in addition
+ *  to the core ones: map of (hook name => map of parameters), with:
+ *   - deprecatedVersion: Version in which the hook was deprecated. Required.
+ *   - component: Name of the component which deprecated the hook.
+ *   - replacement: Hook to use instead.
+ * @throws MWException If a hook lacks its deprecated version
+ */
+func NewDeprecatedHooks(deprecatedHooks map[string]map[string]string) *DeprecatedHooks {
+	this := new(DeprecatedHooks)
+	this.deprecatedHooks = make(map[string]*DeprecatedHookInfo, len(coreDeprecatedHooks)+len(deprecatedHooks))
+	for name, info := range coreDeprecatedHooks {
+		copied := *info
+		this.deprecatedHooks[name] = &copied
+	}
+	for name, params := range deprecatedHooks {
+		this.MarkDeprecated(name, params["deprecatedVersion"], params["component"], params["replacement"])
+	}
+	return this
+}
+
+/**
+ * For use by extensions, to add to list of deprecated hooks.
+ *
+ * @param string $hook
+ * @param string $version Version in which the hook was deprecated
+ * @param string|null $component Name of the component which deprecated the hook
+ * @param string|null $replacement Hook to use instead
+ * @throws MWException If the hook is already marked deprecated with another version
+ */
+func (d *DeprecatedHooks) MarkDeprecated(hook, version, component, replacement string) {
+	if version == "" {
+		panic(exception.NewMWException(fmt.Sprintf("Cannot mark hook '%s' deprecated without a version.", hook)))
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if info, ok := d.deprecatedHooks[hook]; ok && info.DeprecatedVersion != version {
+		panic(exception.NewMWException(fmt.Sprintf(
+			"Cannot mark hook '%s' deprecated with version %s. It is already deprecated with version %s.",
+			hook, version, info.DeprecatedVersion)))
+	}
+	d.deprecatedHooks[hook] = &DeprecatedHookInfo{
+		DeprecatedVersion: version,
+		Component:         component,
+		Replacement:       replacement,
+	}
+}
+
+/**
+ * Checks whether a hook has been marked as deprecated
+ *
+ * @param string $hook
+ * @return bool
+ */
+func (d *DeprecatedHooks) IsHookDeprecated(hook string) bool {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+	_, ok := d.deprecatedHooks[hook]
+	return ok
+}
+
+/**
+ * Gets deprecation info for a specific hook or all hooks if hook not specified
+ *
+ * @param string|null $hook
+ * @return array|null Map of (hook name => DeprecatedHookInfo); empty if
+ *  $hook is given and not deprecated
+ */
+func (d *DeprecatedHooks) GetDeprecationInfo(hook string) map[string]*DeprecatedHookInfo {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+	result := make(map[string]*DeprecatedHookInfo)
+	for name, info := range d.deprecatedHooks {
+		if hook == "" || hook == name {
+			copied := *info
+			result[name] = &copied
+		}
+	}
+	return result
+}
@@ -0,0 +1,42 @@
+package includes
+
+import (
+	"testing"
+
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * @covers DeprecatedHooks::markDeprecated
+ * @covers DeprecatedHooks::isHookDeprecated
+ * @covers DeprecatedHooks::getDeprecationInfo
+ */
+func TestDeprecatedHooks(t *testing.T) {
+	deprecatedHooks := NewDeprecatedHooks(map[string]map[string]string{
+		"FooHook": {"deprecatedVersion": "1.34", "component": "FooExtension", "replacement": "BarHook"},
+	})
+	test.AssetTrue(deprecatedHooks.IsHookDeprecated("LinkBegin"), `Core deprecated hooks`)
+	test.AssetTrue(deprecatedHooks.IsHookDeprecated("FooHook"), `Hooks deprecated by extensions`)
+	test.AssetEqual(false, deprecatedHooks.IsHookDeprecated("BeforeInitialize"), `Other hooks`)
+
+	info := deprecatedHooks.GetDeprecationInfo("FooHook")["FooHook"]
+	test.AssetEqual("1.34", info.DeprecatedVersion, `Deprecated version`)
+	test.AssetEqual("FooExtension", info.Component, `Component`)
+	test.AssetEqual("BarHook", info.Replacement, `Replacement hook`)
+	test.AssetEqual(0, len(deprecatedHooks.GetDeprecationInfo("BeforeInitialize")), `No info for other hooks`)
+	test.AssetTrue(len(deprecatedHooks.GetDeprecationInfo("")) > 1, `Info for all the hooks`)
+
+	deprecatedHooks.MarkDeprecated("FooHook", "1.34", "FooExtension", "BarHook")
+	func() {
+		defer func() {
+			test.AssetTrue(recover() != nil, `Already deprecated with another version`)
+		}()
+		deprecatedHooks.MarkDeprecated("FooHook", "1.35", "", "")
+	}()
+	func() {
+		defer func() {
+			test.AssetTrue(recover() != nil, `Version is required`)
+		}()
+		deprecatedHooks.MarkDeprecated("BazHook", "", "", "")
+	}()
+}
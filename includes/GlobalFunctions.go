@@ -2,8 +2,10 @@ package includes
 
 import (
 	"fmt"
+	"path/filepath"
 	"github.com/MangoDowner/mediawiki/includes/libs"
 	"github.com/MangoDowner/mediawiki/includes/php"
+	"github.com/MangoDowner/mediawiki/includes/registration"
 	"github.com/astaxie/beego/context"
 	"github.com/astaxie/beego/logs"
 	"go-common/library/log"
@@ -80,13 +82,96 @@ func WfMessage(key string, params ...string) *Message {
 	message := NewMessage(key, nil, nil)
 
 	// We call Message::params() to reduce code duplication
-	if len(params) != 0 {
-		message.Params(params)
+	for _, param := range params {
+		message.Params(param)
 	}
 
 	return message
 }
 
+/**
+ * Characters which are escaped by wfEscapeWikiText()
+ */
+var wikiTextEscapes = map[string]string{
+	"\"": "&#34;", "&": "&#38;", "'": "&#39;", "<": "&#60;",
+	"=": "&#61;", ">": "&#62;", "[": "&#91;", "]": "&#93;",
+	"{": "&#123;", "|": "&#124;", "}": "&#125;", ";": "&#59;",
+	"\n#": "\n&#35;", "\r#": "\r&#35;",
+	"\n*": "\n&#42;", "\r*": "\r&#42;",
+	"\n:": "\n&#58;", "\r:": "\r&#58;",
+	"\n ": "\n&#32;", "\r ": "\r&#32;",
+	"\n\n": "\n&#10;", "\r\n": "&#13;\n",
+	"\n\r": "\n&#13;", "\r\r": "\r&#13;",
+	"\n\t": "\n&#9;", "\r\t": "\r&#9;", // "\n\t\n" is treated like "\n\n"
+	"\n----": "\n&#45;---", "\r----": "\r&#45;---",
+	"__": "_&#95;", "://": "&#58;//",
+}
+
+/**
+ * Escapes the given text so that it may be output using addWikiText()
+ * without any linking, formatting, etc. making its way through. This
+ * is achieved by substituting certain characters with HTML entities.
+ * As required by the callers, "<nowiki>" is not used.
+ *
+ * @todo escape magic links and the protocols which don't use "://"
+ * @param string $text Text to be escaped
+ * @return string
+ */
+func WfEscapeWikiText(text string) string {
+	return php.Strtr("\n"+text, wikiTextEscapes)[1:]
+}
+
+/**
+ * Load an extension
+ *
+ * This queues an extension to be loaded through
+ * the ExtensionRegistry system.
+ *
+ * @param string $ext Name of the extension to load
+ * @param string|null $path Absolute path of where to find the extension.json file
+ * @since 1.25
+ */
+func WfLoadExtension(ext string, path string) {
+	if path == "" {
+		path = filepath.Join(NewMainConfig().Get("ExtensionDirectory").(string), ext, "extension.json")
+	}
+	registration.GetInstance().Queue(path)
+}
+
+/**
+ * Load multiple extensions at once
+ *
+ * Same as wfLoadExtension, but more efficient if you
+ * are loading multiple extensions.
+ *
+ * If you want to specify custom paths, you should interact with
+ * ExtensionRegistry directly.
+ *
+ * @see wfLoadExtension
+ * @param string[] $exts Array of extension names to load
+ * @since 1.25
+ */
+func WfLoadExtensions(exts []string) {
+	for _, ext := range exts {
+		WfLoadExtension(ext, "")
+	}
+}
+
+/**
+ * Load a skin
+ *
+ * @see wfLoadExtension
+ * @param string $skin Name of the extension to load
+ * @param string|null $path Absolute path of where to find the skin.json file
+ * @since 1.25
+ */
+func WfLoadSkin(skin string, path string) {
+	if path == "" {
+		path = filepath.Join(NewMainConfig().Get("StyleDirectory").(string), skin, "skin.json")
+	}
+	registration.GetInstance().Queue(path)
+}
+
 /**
  * Throws a warning that $function is deprecated
  *
@@ -0,0 +1,676 @@
+package includes
+
+//go:generate go run ../maintenance/generateHookInterfaces -hooks ../docs/hooks.txt -output HookInterfaces.go
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	"github.com/MangoDowner/mediawiki/includes/libs/stats"
+	"github.com/MangoDowner/mediawiki/includes/registration"
+	"github.com/astaxie/beego/logs"
+)
+
+/**
+ * HookContainer class.
+ *
+ * Runs the handlers of a hook: the handlers registered via Hooks::register()
+ * and $wgHooks, which are called reflectively, and the handlers implementing
+ * the typed interface of the hook (e.g. BeforeInitializeHook), which are
+ * called directly.
+ *
+ * The typed interfaces and the HookRunner methods running them are generated
+ * from docs/hooks.txt into HookInterfaces.go; see
+ * maintenance/generateHookInterfaces.
+ *
+ * Handlers run by descending priority, then in registration order; the
+ * old-style handlers have priority 0 and run before the typed handlers of
+ * the same priority. A panicking handler is logged and skipped, and the time
+ * taken by each handler is sent to the stats sink as
+ * "hooks.<hook>.<handler>".
+ *
+ * The handlers of the hooks in the DeprecatedHooks registry are warned
+ * about once each, through wfDeprecated().
+ *
+ * @since 1.35
+ */
+type HookContainer struct {
+	/** @var Hooks Handlers of the old-style hooks */
+	hooks *Hooks
+	/** @var array Map of (hook name => typed handlers, by descending priority) */
+	handlers map[string][]*hookHandler
+	/** @var IStatsdDataFactory */
+	stats stats.IStatsdDataFactory
+	/** @var DeprecatedHooks */
+	deprecatedHooks *DeprecatedHooks
+	/** @var bool[] Map of (hook name and handler name => true) for the deprecation warnings given */
+	warned map[string]bool
+	mutex  sync.RWMutex
+}
+
+/**
+ * A handler of a hook
+ */
+type hookHandler struct {
+	/** @var string Readable name of the handler */
+	name string
+	/** @var callable|object Old-style callback, or implementation of the hook interface */
+	callback interface{}
+	/** @var bool Whether $callback is an old-style callback */
+	legacy bool
+	/** @var int Handlers with higher priorities run first */
+	priority int
+}
+
+/**
+ * The outcome of running the handlers of a hook
+ */
+type HookRunResult struct {
+	/** @var string Name of the hook */
+	Hook string
+	/** @var bool Whether a handler aborted the hook */
+	Aborted bool
+	/** @var string Name of the handler that aborted the hook */
+	AbortedBy string
+	/** @var string Why the hook was aborted: the error or the message the handler returned */
+	Reason string
+	/** @var bool Whether the handler returned a message, which callers treat as a FatalError */
+	Fatal bool
+	/** @var error[] Panics of the handlers; a panic aborts the hook unless it is unabortable */
+	Errors []error
+}
+
+/** @var HookContainer|null */
+var hookContainer *HookContainer
+var hookContainerMutex sync.Mutex
+
+/**
+ * @param Hooks $hooks Runs the old-style handlers
+ */
+func NewHookContainer(hooks *Hooks) *HookContainer {
+	this := new(HookContainer)
+	this.hooks = hooks
+	this.handlers = make(map[string][]*hookHandler)
+	this.stats = stats.NewNullStatsdDataFactory()
+	this.deprecatedHooks = NewDeprecatedHooks(nil)
+	this.warned = make(map[string]bool)
+	return this
+}
+
+/**
+ * Get the HookContainer of the wiki
+ *
+ * @return HookContainer
+ */
+func GetHookContainer() *HookContainer {
+	hookContainerMutex.Lock()
+	defer hookContainerMutex.Unlock()
+	if hookContainer == nil {
+		hookContainer = NewHookContainer(NewHooks())
+	}
+	return hookContainer
+}
+
+/**
+ * Replaces the HookContainer of the wiki; null makes the next
+ * GetHookContainer() call create a new one.
+ *
+ * @note This is for use in tests only!
+ *
+ * @param HookContainer|null $container
+ * @return HookContainer|null The old HookContainer, so it can be restored later.
+ */
+func ForceGlobalHookContainer(container *HookContainer) *HookContainer {
+	hookContainerMutex.Lock()
+	defer hookContainerMutex.Unlock()
+	old := hookContainer
+	hookContainer = container
+	return old
+}
+
+/**
+ * Set the stats sink receiving the time taken by the handlers
+ *
+ * @param IStatsdDataFactory $stats
+ */
+func (c *HookContainer) SetStatsdDataFactory(stats stats.IStatsdDataFactory) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.stats = stats
+}
+
+/**
+ * Get the registry of the deprecated hooks, to which extensions add theirs
+ *
+ * @return DeprecatedHooks
+ */
+func (c *HookContainer) GetDeprecatedHooks() *DeprecatedHooks {
+	return c.deprecatedHooks
+}
+
+/**
+ * Attach a handler to a hook with a typed interface
+ *
+ * @param string $name Name of the hook
+ * @param object $handler Implementation of the interface of the hook
+ * @throws MWException If the hook has no typed interface, or $handler
+ *  does not implement it
+ */
+func (c *HookContainer) Register(name string, handler interface{}) {
+	c.RegisterWithPriority(name, handler, 0)
+}
+
+/**
+ * Attach a handler to a hook with a typed interface, to run before the
+ * handlers with lower priorities
+ *
+ * @param string $name Name of the hook
+ * @param object $handler Implementation of the interface of the hook
+ * @param int $priority Handlers with higher priorities run first; 0 by default
+ * @throws MWException If the hook has no typed interface, or $handler
+ *  does not implement it
+ */
+func (c *HookContainer) RegisterWithPriority(name string, handler interface{}, priority int) {
+	hookInterface, ok := hookInterfaces[name]
+	if !ok {
+		panic(exception.NewMWException(fmt.Sprintf("Hook %s has no typed interface.", name)))
+	}
+	if handler == nil || !reflect.TypeOf(handler).Implements(hookInterface) {
+		panic(exception.NewMWException(fmt.Sprintf(
+			"Handler %T of hook %s does not implement %s.", handler, name, hookInterface.Name())))
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.addHandler(name, handler, priority)
+}
+
+/**
+ * Attach a handler to all the hooks whose typed interface it implements,
+ * as extensions do with their hook handler objects
+ *
+ * @param object $handler
+ * @return string[] Names of the hooks the handler was attached to
+ */
+func (c *HookContainer) RegisterHandler(handler interface{}) []string {
+	var names []string
+	if handler == nil {
+		return names
+	}
+	handlerType := reflect.TypeOf(handler)
+	for name, hookInterface := range hookInterfaces {
+		if handlerType.Implements(hookInterface) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, name := range names {
+		c.addHandler(name, handler, 0)
+	}
+	return names
+}
+
+/**
+ * Returns true if a hook has a handler registered to it, in any way
+ *
+ * @param string $name Name of the hook
+ * @return bool
+ */
+func (c *HookContainer) IsRegistered(name string) bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return len(c.handlers[name]) > 0 || c.hooks.IsRegistered(name) || len(extensionHandlers(name)) > 0
+}
+
+/**
+ * Get the names of all hooks that have at least one handler registered.
+ *
+ * @return string[]
+ */
+func (c *HookContainer) GetHookNames() []string {
+	candidates := make(map[string]bool)
+	for name := range WgHooks {
+		candidates[name] = true
+	}
+	c.mutex.RLock()
+	for name := range c.hooks.handlers {
+		candidates[name] = true
+	}
+	for name := range c.handlers {
+		candidates[name] = true
+	}
+	c.mutex.RUnlock()
+	attrs, _ := registration.GetInstance().GetAttribute("Hooks").(map[string]interface{})
+	for name := range attrs {
+		candidates[name] = true
+	}
+
+	names := make([]string, 0, len(candidates))
+	for name := range candidates {
+		if len(c.getHandlers(c.hooks, name)) > 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+/**
+ * Return the names of the handlers of a hook, in the order they run
+ *
+ * @param string $hook Name of the hook
+ * @return string[]
+ */
+func (c *HookContainer) GetHandlerDescriptions(hook string) []string {
+	handlers := c.getHandlers(c.hooks, hook)
+	descriptions := make([]string, 0, len(handlers))
+	for _, handler := range handlers {
+		descriptions = append(descriptions, handler.name)
+	}
+	return descriptions
+}
+
+/**
+ * Call the handlers of a hook with reflectively passed parameters, as
+ * Hooks::run() does. Typed handlers are called as well, so the callers
+ * that cannot use HookRunner reach them too.
+ *
+ * @param string $event Event name
+ * @param array $args Array of parameters passed to hook functions
+ * @param string|null $deprecatedVersion [optional] Mark hook as deprecated with version number
+ * @return bool True if no handler aborted the hook
+ * @throws MWException If the parameters do not match the typed interface
+ * @throws FatalError If a handler returned an error message
+ */
+func (c *HookContainer) Run(event string, args []interface{}, deprecatedVersion string) bool {
+	return c.checkResult(c.RunWithResult(event, args, deprecatedVersion))
+}
+
+/**
+ * Call the handlers of a hook with reflectively passed parameters, and
+ * report which handler aborted it and why
+ *
+ * @param string $event Event name
+ * @param array $args Array of parameters passed to hook functions
+ * @param string|null $deprecatedVersion [optional] Mark hook as deprecated with version number
+ * @return HookRunResult
+ * @throws MWException If the parameters do not match the typed interface
+ */
+func (c *HookContainer) RunWithResult(event string, args []interface{}, deprecatedVersion string) *HookRunResult {
+	return c.runHandlers(c.hooks, event, args, deprecatedVersion, true, c.reflectiveCall(event, args))
+}
+
+/**
+ * Call the handlers of a hook whose handlers may not abort it, as
+ * Hooks::runWithoutAbort() does
+ *
+ * @param string $event Event name
+ * @param array $args Array of parameters passed to hook functions
+ * @param string|null $deprecatedVersion [optional] Mark hook as deprecated with version number
+ * @return bool Always true
+ * @throws MWException If a handler aborts the hook in development mode
+ */
+func (c *HookContainer) RunWithoutAbort(event string, args []interface{}, deprecatedVersion string) bool {
+	c.runHandlers(c.hooks, event, args, deprecatedVersion, false, c.reflectiveCall(event, args))
+	return true
+}
+
+/**
+ * Run a hook for HookRunner: the old-style handlers get $args, and $call
+ * calls each typed handler
+ *
+ * @param string $name Name of the hook
+ * @param array $args Parameters for the old-style handlers
+ * @param callable $call Calls a handler implementing the hook interface
+ * @return bool True if no handler aborted the hook
+ * @throws FatalError If an old-style handler returned an error message
+ */
+func (c *HookContainer) run(name string, args []interface{}, call func(handler interface{}) bool) bool {
+	return c.checkResult(c.runHandlers(c.hooks, name, args, "", true, func(handler interface{}) func() interface{} {
+		return func() interface{} {
+			return call(handler)
+		}
+	}))
+}
+
+/**
+ * Run the old-style and the typed handlers of a hook, by priority
+ *
+ * @param Hooks $hooks Has the old-style handlers, besides $wgHooks and the
+ *  extensions
+ * @param string $name Name of the hook
+ * @param array $args Parameters for the old-style handlers
+ * @param string|null $deprecatedVersion Mark hook as deprecated with version number
+ * @param bool $abortable Whether the handlers may abort the hook
+ * @param callable $prepare Returns the function calling a typed handler
+ * @return HookRunResult
+ */
+func (c *HookContainer) runHandlers(hooks *Hooks, name string, args []interface{}, deprecatedVersion string,
+	abortable bool, prepare func(handler interface{}) func() interface{}) *HookRunResult {
+	result := &HookRunResult{Hook: name}
+	deprecation := c.getDeprecation(name, deprecatedVersion)
+	for _, handler := range c.getHandlers(hooks, name) {
+		if deprecation != nil {
+			c.warnDeprecated(name, handler, deprecation)
+		}
+
+		var invoke func() interface{}
+		if handler.legacy {
+			if reflect.ValueOf(handler.callback).Kind() != reflect.Func {
+				// Let Hooks::callHook() reject the invalid callback
+				hooks.callHook(name, handler.callback, args, "", nil)
+			}
+			callback := handler.callback
+			invoke = func() interface{} {
+				return hooks.callHook(name, callback, args, "", nil)
+			}
+		} else {
+			invoke = prepare(handler.callback)
+		}
+
+		retVal, err := c.callGuarded(name, handler, invoke)
+		if err != nil {
+			result.Errors = append(result.Errors, err)
+			if !abortable {
+				continue
+			}
+			// A broken handler must not let the hook pass as if it succeeded
+			result.Aborted = true
+			result.AbortedBy = handler.name
+			result.Reason = err.Error()
+			break
+		}
+		reason, fatal, aborted := abortReason(retVal)
+		if !aborted {
+			continue
+		}
+		if !abortable {
+			reportInvalidAbort(name, handler.name)
+			continue
+		}
+		result.Aborted = true
+		result.AbortedBy = handler.name
+		result.Reason = reason
+		result.Fatal = fatal
+		break
+	}
+	return result
+}
+
+/**
+ * Get the deprecation details of a hook, from the registry or the caller
+ *
+ * @param string $name Name of the hook
+ * @param string|null $deprecatedVersion Version given by the caller
+ * @return DeprecatedHookInfo|null Null if the hook is not deprecated
+ */
+func (c *HookContainer) getDeprecation(name string, deprecatedVersion string) *DeprecatedHookInfo {
+	if info, ok := c.deprecatedHooks.GetDeprecationInfo(name)[name]; ok {
+		return info
+	}
+	if deprecatedVersion != "" {
+		return &DeprecatedHookInfo{DeprecatedVersion: deprecatedVersion}
+	}
+	return nil
+}
+
+/**
+ * Warn about the handler of a deprecated hook, once per handler
+ *
+ * @param string $name Name of the hook
+ * @param hookHandler $handler
+ * @param DeprecatedHookInfo $info
+ */
+func (c *HookContainer) warnDeprecated(name string, handler *hookHandler, info *DeprecatedHookInfo) {
+	key := name + "\x00" + handler.name
+	c.mutex.Lock()
+	warned := c.warned[key]
+	c.warned[key] = true
+	c.mutex.Unlock()
+	if warned {
+		return
+	}
+
+	usage := fmt.Sprintf("%s hook (used in %s)", name, handler.name)
+	if info.Replacement != "" {
+		usage = fmt.Sprintf("%s hook (used in %s; use %s instead)", name, handler.name, info.Replacement)
+	}
+	WfDeprecated(usage, info.DeprecatedVersion, info.Component, 0)
+}
+
+/**
+ * Call a handler, turning its panic into an error and sending the time it
+ * took to the stats sink. MWException and FatalError are raised on purpose
+ * and keep propagating.
+ *
+ * @param string $name Name of the hook
+ * @param hookHandler $handler
+ * @param callable $invoke Calls the handler
+ * @return mixed The return value of the handler
+ * @return error If the handler panicked
+ */
+func (c *HookContainer) callGuarded(name string, handler *hookHandler, invoke func() interface{}) (retVal interface{}, err error) {
+	start := time.Now()
+	defer func() {
+		c.mutex.RLock()
+		sink := c.stats
+		c.mutex.RUnlock()
+		sink.Timing("hooks."+name+"."+handler.name, float64(time.Since(start))/float64(time.Millisecond))
+
+		if recovered := recover(); recovered != nil {
+			switch recovered.(type) {
+			case *exception.MWException, *exception.FatalError:
+				panic(recovered)
+			}
+			err = fmt.Errorf("handler %s of hook %s panicked: %v", handler.name, name, recovered)
+			logs.Error(err.Error())
+		}
+	}()
+	return invoke(), nil
+}
+
+/**
+ * @param string $name Name of the hook
+ * @param array $args
+ * @return callable Returns the function calling a typed handler with $args
+ */
+func (c *HookContainer) reflectiveCall(name string, args []interface{}) func(handler interface{}) func() interface{} {
+	return func(handler interface{}) func() interface{} {
+		method, params := c.prepareCall(name, handler, args)
+		return func() interface{} {
+			return method.Call(params)[0].Bool()
+		}
+	}
+}
+
+/**
+ * Find the method of a typed handler for a hook, and its parameters
+ *
+ * @param string $name Name of the hook
+ * @param object $handler
+ * @param array $args
+ * @return ReflectionMethod The On<Hook> method of $handler
+ * @return array Parameters of the method
+ * @throws MWException If the parameters do not match the typed interface
+ */
+func (c *HookContainer) prepareCall(name string, handler interface{}, args []interface{}) (reflect.Value, []reflect.Value) {
+	method := reflect.ValueOf(handler).MethodByName("On" + name)
+	methodType := method.Type()
+	if methodType.NumIn() != len(args) {
+		panic(exception.NewMWException(fmt.Sprintf(
+			"Hook %s was run with %d parameters, but its interface takes %d.", name, len(args), methodType.NumIn())))
+	}
+	params := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		paramType := methodType.In(i)
+		if arg == nil {
+			params[i] = reflect.Zero(paramType)
+			continue
+		}
+		params[i] = reflect.ValueOf(arg)
+		if !params[i].Type().AssignableTo(paramType) {
+			panic(exception.NewMWException(fmt.Sprintf(
+				"Hook %s was run with %T as parameter %d, but its interface takes %s.", name, arg, i+1, paramType)))
+		}
+	}
+	return method, params
+}
+
+/**
+ * Turn the result of a run into the return value of Hooks::run()
+ *
+ * @param HookRunResult $result
+ * @return bool True if no handler aborted the hook
+ * @throws FatalError If a handler returned an error message
+ */
+func (c *HookContainer) checkResult(result *HookRunResult) bool {
+	if result.Fatal {
+		// String returned means error.
+		panic(exception.NewFatalError(result.Reason))
+	}
+	return !result.Aborted
+}
+
+/**
+ * @param Hooks $hooks Has the old-style handlers, besides $wgHooks and the
+ *  extensions
+ * @param string $name Name of the hook
+ * @return hookHandler[] The old-style handlers, then the typed ones, by descending priority
+ */
+func (c *HookContainer) getHandlers(hooks *Hooks, name string) []*hookHandler {
+	var handlers []*hookHandler
+	for _, callback := range hooks.GetHandlers(name) {
+		handlers = append(handlers, &hookHandler{
+			name:     handlerName(callback),
+			callback: callback,
+			legacy:   true,
+		})
+	}
+	handlers = append(handlers, extensionHandlers(name)...)
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	handlers = append(handlers, c.handlers[name]...)
+	sort.SliceStable(handlers, func(i, j int) bool {
+		return handlers[i].priority > handlers[j].priority
+	})
+	return handlers
+}
+
+/**
+ * The handlers of a hook in the manifests of the loaded extensions, which
+ * are called as the ones of $wgHooks
+ *
+ * @param string $name Name of the hook
+ * @return hookHandler[]
+ */
+func extensionHandlers(name string) []*hookHandler {
+	var handlers []*hookHandler
+	attrs, _ := registration.GetInstance().GetAttribute("Hooks").(map[string]interface{})
+	functions, _ := attrs[name].([]interface{})
+	for _, function := range functions {
+		function, _ := function.(string)
+		if callback, ok := registration.GetFunction(function); ok {
+			handlers = append(handlers, &hookHandler{
+				name:     function,
+				callback: callback,
+				legacy:   true,
+			})
+		}
+	}
+	return handlers
+}
+
+/**
+ * Add a typed handler to a hook, keeping them ordered by priority. The
+ * caller holds the mutex.
+ *
+ * @param string $name Name of the hook
+ * @param object $handler
+ * @param int $priority
+ */
+func (c *HookContainer) addHandler(name string, handler interface{}, priority int) {
+	handlers := append(c.handlers[name], &hookHandler{
+		name:     handlerName(handler),
+		callback: handler,
+		priority: priority,
+	})
+	sort.SliceStable(handlers, func(i, j int) bool {
+		return handlers[i].priority > handlers[j].priority
+	})
+	c.handlers[name] = handlers
+}
+
+/**
+ * @param callable|object $handler
+ * @return string Readable name of the handler, e.g. "includes.someFunction"
+ *  or "includes.SomeHandler"
+ */
+func handlerName(handler interface{}) string {
+	value := reflect.ValueOf(handler)
+	name := fmt.Sprintf("%T", handler)
+	if value.Kind() == reflect.Func && !value.IsNil() {
+		if function := runtime.FuncForPC(value.Pointer()); function != nil {
+			name = function.Name()
+		}
+	}
+	name = strings.TrimLeft(name, "*")
+	if slash := strings.LastIndex(name, "/"); slash >= 0 {
+		name = name[slash+1:]
+	}
+	return strings.TrimSuffix(name, "-fm")
+}
+
+/**
+ * Interpret the return value of a handler, as Hooks::run() does
+ *
+ * @param mixed $retVal
+ * @return string Why the handler aborted the hook
+ * @return bool Whether the handler returned an error message
+ * @return bool Whether the handler aborted the hook
+ */
+func abortReason(retVal interface{}) (string, bool, bool) {
+	switch v := retVal.(type) {
+	case error:
+		if v != nil {
+			return v.Error(), false, true
+		}
+	case bool:
+		if !v {
+			return "returned false", false, true
+		}
+	case string:
+		return v, true, true
+	}
+	return "", false, false
+}
+
+/**
+ * HookRunner class.
+ *
+ * Runs hooks with typed parameters, so that mismatched handlers and callers
+ * fail to compile. Its On<Hook> methods are generated into HookInterfaces.go.
+ *
+ * @since 1.35
+ */
+type HookRunner struct {
+	/** @var HookContainer */
+	container *HookContainer
+}
+
+/**
+ * @param HookContainer $container
+ */
+func NewHookRunner(container *HookContainer) *HookRunner {
+	this := new(HookRunner)
+	this.container = container
+	return this
+}
@@ -0,0 +1,318 @@
+package includes
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	"github.com/MangoDowner/mediawiki/includes/libs/stats"
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+type namespaceHandler struct {
+	Calls int
+	Abort bool
+}
+
+func (n *namespaceHandler) OnCanonicalNamespaces(namespaces *map[int]string) bool {
+	n.Calls++
+	(*namespaces)[100] = "Portal"
+	return !n.Abort
+}
+
+func (n *namespaceHandler) OnMessageCacheReplace(title string, text string) bool {
+	n.Calls++
+	return true
+}
+
+/**
+ * @covers HookContainer::register
+ * @covers HookRunner::onCanonicalNamespaces
+ */
+func TestTypedHooks(t *testing.T) {
+	container := NewHookContainer(NewHooks())
+	first, second := &namespaceHandler{Abort: true}, &namespaceHandler{}
+	container.Register("CanonicalNamespaces", first)
+	container.Register("CanonicalNamespaces", second)
+	test.AssetTrue(container.IsRegistered("CanonicalNamespaces"), `Typed handlers are registered`)
+
+	namespaces := map[int]string{0: ""}
+	ret := NewHookRunner(container).OnCanonicalNamespaces(&namespaces)
+	test.AssetEqual(false, ret, `Handlers may abort the hook`)
+	test.AssetEqual("Portal", namespaces[100], `Handlers get the parameters`)
+	test.AssetEqual(0, second.Calls, `Hooks abort after a false return`)
+
+	func() {
+		defer func() {
+			test.AssetTrue(recover() != nil, `Handlers must implement the hook interface`)
+		}()
+		container.Register("MediaWikiServices", first)
+	}()
+	func() {
+		defer func() {
+			test.AssetTrue(recover() != nil, `Hooks without interface are rejected`)
+		}()
+		container.Register("NoSuchHook", first)
+	}()
+}
+
+/**
+ * @covers HookContainer::registerHandler
+ */
+func TestRegisterHandler(t *testing.T) {
+	container := NewHookContainer(NewHooks())
+	handler := &namespaceHandler{}
+	test.AssetEqual(
+		fmt.Sprint([]string{"CanonicalNamespaces", "MessageCacheReplace"}),
+		fmt.Sprint(container.RegisterHandler(handler)),
+		`Registered for every hook interface implemented`,
+	)
+	NewHookRunner(container).OnMessageCacheReplace("Title", "text")
+	test.AssetEqual(1, handler.Calls, `Handler called`)
+}
+
+/**
+ * @covers HookContainer::run
+ * @covers HookContainer::callHandler
+ */
+func TestLegacyAndTypedHooks(t *testing.T) {
+	oldHandlers, hadHandlers := WgHooks["CanonicalNamespaces"]
+	defer func() {
+		if hadHandlers {
+			WgHooks["CanonicalNamespaces"] = oldHandlers
+		} else {
+			delete(WgHooks, "CanonicalNamespaces")
+		}
+	}()
+	var order []string
+	WgHooks["CanonicalNamespaces"] = []HookFunc{func(namespaces *map[int]string) bool {
+		order = append(order, "legacy")
+		return true
+	}}
+
+	container := NewHookContainer(NewHooks())
+	handler := &namespaceHandler{}
+	container.Register("CanonicalNamespaces", handler)
+
+	namespaces := map[int]string{}
+	NewHookRunner(container).OnCanonicalNamespaces(&namespaces)
+	test.AssetEqual(fmt.Sprint([]string{"legacy"}), fmt.Sprint(order), `$wgHooks handlers run by HookRunner`)
+	test.AssetEqual(1, handler.Calls, `Typed handlers run by HookRunner`)
+
+	container.Run("CanonicalNamespaces", []interface{}{&namespaces}, "")
+	test.AssetEqual(2, len(order), `$wgHooks handlers run by Hooks::run`)
+	test.AssetEqual(2, handler.Calls, `Typed handlers run by Hooks::run`)
+
+	func() {
+		defer func() {
+			test.AssetTrue(recover() != nil, `Mismatched parameters fail loudly`)
+		}()
+		container.Run("CanonicalNamespaces", []interface{}{namespaces}, "")
+	}()
+	func() {
+		defer func() {
+			test.AssetTrue(recover() != nil, `Missing parameters fail loudly`)
+		}()
+		container.Run("CanonicalNamespaces", []interface{}{}, "")
+	}()
+}
+
+type orderedHandler struct {
+	name  string
+	order *[]string
+}
+
+func (o *orderedHandler) OnCanonicalNamespaces(namespaces *map[int]string) bool {
+	*o.order = append(*o.order, o.name)
+	return true
+}
+
+/**
+ * @covers HookContainer::registerWithPriority
+ */
+func TestHookPriorities(t *testing.T) {
+	var order []string
+	container := NewHookContainer(NewHooks())
+	container.Register("CanonicalNamespaces", &orderedHandler{"default", &order})
+	container.RegisterWithPriority("CanonicalNamespaces", &orderedHandler{"late", &order}, -10)
+	container.RegisterWithPriority("CanonicalNamespaces", &orderedHandler{"early", &order}, 10)
+	container.Register("CanonicalNamespaces", &orderedHandler{"default2", &order})
+
+	namespaces := map[int]string{}
+	NewHookRunner(container).OnCanonicalNamespaces(&namespaces)
+	test.AssetEqual(
+		fmt.Sprint([]string{"early", "default", "default2", "late"}),
+		fmt.Sprint(order),
+		`Handlers run by priority, then in registration order`,
+	)
+}
+
+/**
+ * @covers HookContainer::runWithResult
+ */
+func TestHookRunResult(t *testing.T) {
+	hooks := NewHooks()
+	container := NewHookContainer(hooks)
+	namespaces := map[int]string{}
+
+	result := container.RunWithResult("CanonicalNamespaces", []interface{}{&namespaces}, "")
+	test.AssetEqual(false, result.Aborted, `Not aborted without handlers`)
+
+	hooks.register("CanonicalNamespaces", func(namespaces *map[int]string) error {
+		return fmt.Errorf("no namespaces today")
+	})
+	result = container.RunWithResult("CanonicalNamespaces", []interface{}{&namespaces}, "")
+	test.AssetEqual(true, result.Aborted, `Aborted by an error`)
+	test.AssetEqual("no namespaces today", result.Reason, `Abort reason`)
+	test.AssetTrue(strings.HasPrefix(result.AbortedBy, "includes.TestHookRunResult."), `Aborting handler`)
+
+	container.RegisterWithPriority("CanonicalNamespaces", &namespaceHandler{Abort: true}, 1)
+	result = container.RunWithResult("CanonicalNamespaces", []interface{}{&namespaces}, "")
+	test.AssetEqual("includes.namespaceHandler", result.AbortedBy, `Aborting typed handler`)
+	test.AssetEqual("returned false", result.Reason, `Abort reason of false returns`)
+
+	hooks = NewHooks()
+	container = NewHookContainer(hooks)
+	hooks.register("CanonicalNamespaces", func(namespaces *map[int]string) string {
+		return "hook-failed"
+	})
+	result = container.RunWithResult("CanonicalNamespaces", []interface{}{&namespaces}, "")
+	test.AssetEqual(true, result.Fatal, `Error messages are reported`)
+	func() {
+		defer func() {
+			_, ok := recover().(*exception.FatalError)
+			test.AssetTrue(ok, `Hooks::run() throws FatalError for error messages`)
+		}()
+		container.Run("CanonicalNamespaces", []interface{}{&namespaces}, "")
+	}()
+}
+
+type panickingHandler struct{}
+
+func (p *panickingHandler) OnCanonicalNamespaces(namespaces *map[int]string) bool {
+	panic("broken extension")
+}
+
+/**
+ * @covers HookContainer::callGuarded
+ */
+func TestHookPanicsAndTiming(t *testing.T) {
+	sink := stats.NewBufferingStatsdDataFactory("")
+	container := NewHookContainer(NewHooks())
+	container.SetStatsdDataFactory(sink)
+	handler := &namespaceHandler{}
+	container.Register("CanonicalNamespaces", &panickingHandler{})
+	container.Register("CanonicalNamespaces", handler)
+
+	namespaces := map[int]string{}
+	result := container.RunWithResult("CanonicalNamespaces", []interface{}{&namespaces}, "")
+	test.AssertEqual(t, 1, len(result.Errors), `Panic converted to an error`)
+	test.AssertEqual(t, true, result.Aborted, `Panics abort the hook`)
+	test.AssertEqual(t, "includes.panickingHandler", result.AbortedBy, `Panicking handler reported`)
+	test.AssertEqual(t, result.Errors[0].Error(), result.Reason, `Panic is the reason`)
+	test.AssertEqual(t, 0, handler.Calls, `Handlers after the panicking one are skipped`)
+	test.AssertEqual(t, false, NewHookRunner(container).OnCanonicalNamespaces(&namespaces), `Typed runs fail closed too`)
+
+	var keys []string
+	for _, data := range sink.GetData() {
+		test.AssertEqual(t, stats.STATSD_METRIC_TIMING, data.Metric, `Handler timing`)
+		keys = append(keys, data.Key)
+	}
+	test.AssertEqual(t,
+		fmt.Sprint([]string{
+			"hooks.CanonicalNamespaces.includes.panickingHandler",
+			"hooks.CanonicalNamespaces.includes.panickingHandler",
+		}),
+		fmt.Sprint(keys),
+		`Timing per handler`,
+	)
+
+	// Unabortable hooks record the panic and go on
+	test.AssertTrue(t, container.RunWithoutAbort("CanonicalNamespaces", []interface{}{&namespaces}, ""),
+		`Unabortable hooks are not aborted`)
+	test.AssertEqual(t, 1, handler.Calls, `Later handlers run`)
+}
+
+type throwingHandler struct{}
+
+func (h *throwingHandler) OnCanonicalNamespaces(namespaces *map[int]string) bool {
+	panic(exception.NewMWException("invalid namespaces"))
+}
+
+/**
+ * @covers HookContainer::callGuarded
+ */
+func TestHookExceptionsPropagate(t *testing.T) {
+	container := NewHookContainer(NewHooks())
+	container.Register("CanonicalNamespaces", &throwingHandler{})
+
+	namespaces := map[int]string{}
+	func() {
+		defer func() {
+			_, ok := recover().(*exception.MWException)
+			test.AssertTrue(t, ok, `MWException thrown by a handler propagates`)
+		}()
+		container.Run("CanonicalNamespaces", []interface{}{&namespaces}, "")
+	}()
+}
+
+/**
+ * @covers HookContainer::warnDeprecated
+ */
+func TestDeprecatedHookWarnings(t *testing.T) {
+	hooks := NewHooks()
+	container := NewHookContainer(hooks)
+	container.GetDeprecatedHooks().MarkDeprecated("CanonicalNamespaces", "1.35", "", "FooNamespaces")
+	container.Register("CanonicalNamespaces", &namespaceHandler{})
+	hooks.register("CanonicalNamespaces", func(namespaces *map[int]string) {})
+
+	namespaces := map[int]string{}
+	container.Run("CanonicalNamespaces", []interface{}{&namespaces}, "")
+	container.Run("CanonicalNamespaces", []interface{}{&namespaces}, "")
+	test.AssetEqual(2, len(container.warned), `Warned once per handler`)
+	test.AssetTrue(container.warned["CanonicalNamespaces\x00includes.namespaceHandler"], `Typed handlers warned about`)
+
+	container.Run("MessageCacheReplace", []interface{}{"Title", "text"}, "1.30")
+	test.AssetEqual(2, len(container.warned), `Hooks without handlers`)
+	hooks.register("MessageCacheReplace", func(title string, text string) {})
+	container.Run("MessageCacheReplace", []interface{}{"Title", "text"}, "1.30")
+	test.AssetEqual(3, len(container.warned), `Version passed by the caller`)
+}
+
+/**
+ * @covers HookContainer::runWithoutAbort
+ * @covers Hooks::runWithoutAbort
+ */
+func TestUnabortableHooks(t *testing.T) {
+	oldFailOnInvalidAbort := failOnInvalidAbort
+	defer func() { failOnInvalidAbort = oldFailOnInvalidAbort }()
+	container := NewHookContainer(NewHooks())
+	handler := &namespaceHandler{Abort: true}
+	container.Register("CanonicalNamespaces", handler)
+	container.Register("CanonicalNamespaces", &namespaceHandler{})
+	namespaces := map[int]string{}
+
+	failOnInvalidAbort = func() bool { return false }
+	test.AssetTrue(container.RunWithoutAbort("CanonicalNamespaces", []interface{}{&namespaces}, ""), `Abort logged`)
+
+	failOnInvalidAbort = func() bool { return true }
+	func() {
+		defer func() {
+			_, ok := recover().(*exception.MWException)
+			test.AssetTrue(ok, `Abort fails loudly in development mode`)
+		}()
+		container.RunWithoutAbort("CanonicalNamespaces", []interface{}{&namespaces}, "")
+	}()
+	func() {
+		defer func() {
+			_, ok := recover().(*exception.MWException)
+			test.AssetTrue(ok, `Hooks::runWithoutAbort() fails loudly in development mode`)
+		}()
+		hooks := NewHooks()
+		hooks.register("CanonicalNamespaces", func(namespaces *map[int]string) error {
+			return fmt.Errorf("abort")
+		})
+		hooks.RunWithoutAbort("CanonicalNamespaces", []interface{}{&namespaces}, "")
+	}()
+}
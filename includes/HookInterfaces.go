@@ -0,0 +1,15507 @@
+// Code generated by maintenance/generateHookInterfaces from docs/hooks.txt. DO NOT EDIT.
+
+package includes
+
+import (
+	"reflect"
+
+	"github.com/MangoDowner/mediawiki/includes/languages"
+	"github.com/MangoDowner/mediawiki/includes/parser"
+)
+
+/**
+ * After calling the execute() method of an API module. Use
+ * this to extend core API modules.
+ */
+type APIAfterExecuteHook interface {
+	/**
+	 * @param mixed &$module Module object
+	 * @return bool False to abort the hook
+	 */
+	OnAPIAfterExecute(module *interface{}) bool
+}
+
+/**
+ * Run the APIAfterExecute hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIAfterExecute(module *interface{}) bool {
+	args := []interface{}{module}
+	return r.container.run("APIAfterExecute", args, func(handler interface{}) bool {
+		return handler.(APIAfterExecuteHook).OnAPIAfterExecute(module)
+	})
+}
+
+/**
+ * DEPRECATED since 1.28! Use EditFilterMergedContent instead.
+ * Before saving a page with api.php?action=edit, after
+ * processing request parameters. Return false to let the request fail, returning
+ * an error message or an <edit result="Failure"> tag if $resultArr was filled.
+ * Unlike for example 'EditFilterMergedContent' this also being run on undo.
+ * Since MediaWiki 1.25, 'EditFilterMergedContent' can also return error details
+ * for the API and it's recommended to use it instead of this hook.
+ */
+type APIEditBeforeSaveHook interface {
+	/**
+	 * @param mixed $editPage the EditPage object
+	 * @param mixed $text the text passed to the API. Note that this includes only the single section for section edit, and is not necessarily the final text in case of automatically resolved edit conflicts.
+	 * @param mixed &$resultArr data in this array will be added to the API result
+	 * @return bool False to abort the hook
+	 */
+	OnAPIEditBeforeSave(editPage interface{}, text interface{}, resultArr *interface{}) bool
+}
+
+/**
+ * Run the APIEditBeforeSave hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIEditBeforeSave(editPage interface{}, text interface{}, resultArr *interface{}) bool {
+	args := []interface{}{editPage, text, resultArr}
+	return r.container.run("APIEditBeforeSave", args, func(handler interface{}) bool {
+		return handler.(APIEditBeforeSaveHook).OnAPIEditBeforeSave(editPage, text, resultArr)
+	})
+}
+
+/**
+ * Use this hook to modify a module's parameters.
+ */
+type APIGetAllowedParamsHook interface {
+	/**
+	 * @param mixed &$module ApiBase Module object
+	 * @param mixed &$params Array of parameters
+	 * @param mixed $flags int zero or OR-ed flags like ApiBase::GET_VALUES_FOR_HELP
+	 * @return bool False to abort the hook
+	 */
+	OnAPIGetAllowedParams(module *interface{}, params *interface{}, flags interface{}) bool
+}
+
+/**
+ * Run the APIGetAllowedParams hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIGetAllowedParams(module *interface{}, params *interface{}, flags interface{}) bool {
+	args := []interface{}{module, params, flags}
+	return r.container.run("APIGetAllowedParams", args, func(handler interface{}) bool {
+		return handler.(APIGetAllowedParamsHook).OnAPIGetAllowedParams(module, params, flags)
+	})
+}
+
+/**
+ * DEPRECATED since 1.25! Use APIGetDescriptionMessages
+ * instead. Use this hook to modify a module's description.
+ */
+type APIGetDescriptionHook interface {
+	/**
+	 * @param mixed &$module ApiBase Module object
+	 * @param mixed &$desc String description, or array of description strings
+	 * @return bool False to abort the hook
+	 */
+	OnAPIGetDescription(module *interface{}, desc *interface{}) bool
+}
+
+/**
+ * Run the APIGetDescription hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIGetDescription(module *interface{}, desc *interface{}) bool {
+	args := []interface{}{module, desc}
+	return r.container.run("APIGetDescription", args, func(handler interface{}) bool {
+		return handler.(APIGetDescriptionHook).OnAPIGetDescription(module, desc)
+	})
+}
+
+/**
+ * Use this hook to modify a module's help message.
+ */
+type APIGetDescriptionMessagesHook interface {
+	/**
+	 * @param mixed $module ApiBase Module object
+	 * @param mixed &$msg Array of Message objects
+	 * @return bool False to abort the hook
+	 */
+	OnAPIGetDescriptionMessages(module interface{}, msg *interface{}) bool
+}
+
+/**
+ * Run the APIGetDescriptionMessages hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIGetDescriptionMessages(module interface{}, msg *interface{}) bool {
+	args := []interface{}{module, msg}
+	return r.container.run("APIGetDescriptionMessages", args, func(handler interface{}) bool {
+		return handler.(APIGetDescriptionMessagesHook).OnAPIGetDescriptionMessages(module, msg)
+	})
+}
+
+/**
+ * DEPRECATED since 1.25! Use
+ * APIGetParamDescriptionMessages instead.
+ * Use this hook to modify a module's parameter descriptions.
+ */
+type APIGetParamDescriptionHook interface {
+	/**
+	 * @param mixed &$module ApiBase Module object
+	 * @param mixed &$desc Array of parameter descriptions
+	 * @return bool False to abort the hook
+	 */
+	OnAPIGetParamDescription(module *interface{}, desc *interface{}) bool
+}
+
+/**
+ * Run the APIGetParamDescription hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIGetParamDescription(module *interface{}, desc *interface{}) bool {
+	args := []interface{}{module, desc}
+	return r.container.run("APIGetParamDescription", args, func(handler interface{}) bool {
+		return handler.(APIGetParamDescriptionHook).OnAPIGetParamDescription(module, desc)
+	})
+}
+
+/**
+ * Use this hook to modify a module's parameter
+ * descriptions.
+ */
+type APIGetParamDescriptionMessagesHook interface {
+	/**
+	 * @param mixed $module ApiBase Module object
+	 * @param mixed &$msg Array of arrays of Message objects
+	 * @return bool False to abort the hook
+	 */
+	OnAPIGetParamDescriptionMessages(module interface{}, msg *interface{}) bool
+}
+
+/**
+ * Run the APIGetParamDescriptionMessages hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIGetParamDescriptionMessages(module interface{}, msg *interface{}) bool {
+	args := []interface{}{module, msg}
+	return r.container.run("APIGetParamDescriptionMessages", args, func(handler interface{}) bool {
+		return handler.(APIGetParamDescriptionMessagesHook).OnAPIGetParamDescriptionMessages(module, msg)
+	})
+}
+
+/**
+ * Use this hook to modify an API module's help output.
+ */
+type APIHelpModifyOutputHook interface {
+	/**
+	 * @param mixed $module ApiBase Module object
+	 * @param mixed &$help Array of HTML strings to be joined for the output.
+	 * @param mixed $options Array Options passed to ApiHelp::getHelp
+	 * @param mixed &$tocData Array If a TOC is being generated, this array has keys as anchors in the page and values as for Linker::generateTOC().
+	 * @return bool False to abort the hook
+	 */
+	OnAPIHelpModifyOutput(module interface{}, help *interface{}, options interface{}, tocData *interface{}) bool
+}
+
+/**
+ * Run the APIHelpModifyOutput hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIHelpModifyOutput(module interface{}, help *interface{}, options interface{}, tocData *interface{}) bool {
+	args := []interface{}{module, help, options, tocData}
+	return r.container.run("APIHelpModifyOutput", args, func(handler interface{}) bool {
+		return handler.(APIHelpModifyOutputHook).OnAPIHelpModifyOutput(module, help, options, tocData)
+	})
+}
+
+/**
+ * After calling the execute() method of an
+ * action=query submodule. Use this to extend core API modules.
+ */
+type APIQueryAfterExecuteHook interface {
+	/**
+	 * @param mixed &$module Module object
+	 * @return bool False to abort the hook
+	 */
+	OnAPIQueryAfterExecute(module *interface{}) bool
+}
+
+/**
+ * Run the APIQueryAfterExecute hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIQueryAfterExecute(module *interface{}) bool {
+	args := []interface{}{module}
+	return r.container.run("APIQueryAfterExecute", args, func(handler interface{}) bool {
+		return handler.(APIQueryAfterExecuteHook).OnAPIQueryAfterExecute(module)
+	})
+}
+
+/**
+ * After calling the executeGenerator() method of
+ * an action=query submodule. Use this to extend core API modules.
+ */
+type APIQueryGeneratorAfterExecuteHook interface {
+	/**
+	 * @param mixed &$module Module object
+	 * @param mixed &$resultPageSet ApiPageSet object
+	 * @return bool False to abort the hook
+	 */
+	OnAPIQueryGeneratorAfterExecute(module *interface{}, resultPageSet *interface{}) bool
+}
+
+/**
+ * Run the APIQueryGeneratorAfterExecute hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIQueryGeneratorAfterExecute(module *interface{}, resultPageSet *interface{}) bool {
+	args := []interface{}{module, resultPageSet}
+	return r.container.run("APIQueryGeneratorAfterExecute", args, func(handler interface{}) bool {
+		return handler.(APIQueryGeneratorAfterExecuteHook).OnAPIQueryGeneratorAfterExecute(module, resultPageSet)
+	})
+}
+
+/**
+ * DEPRECATED since 1.24! Use ApiQueryTokensRegisterTypes
+ * instead. Use this hook to add custom tokens to prop=info. Every token has an
+ * action, which will be used in the intoken parameter and in the output
+ * (actiontoken="..."), and a callback function which should return the token, or
+ * false if the user isn't allowed to obtain it. The prototype of the callback
+ * function is func($pageid, $title), where $pageid is the page ID of the page the
+ * token is requested for and $title is the associated Title object. In the hook,
+ * just add your callback to the $tokenFunctions array and return true (returning
+ * false makes no sense).
+ */
+type APIQueryInfoTokensHook interface {
+	/**
+	 * @param mixed &$tokenFunctions [ action => callback ]
+	 * @return bool False to abort the hook
+	 */
+	OnAPIQueryInfoTokens(tokenFunctions *interface{}) bool
+}
+
+/**
+ * Run the APIQueryInfoTokens hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIQueryInfoTokens(tokenFunctions *interface{}) bool {
+	args := []interface{}{tokenFunctions}
+	return r.container.run("APIQueryInfoTokens", args, func(handler interface{}) bool {
+		return handler.(APIQueryInfoTokensHook).OnAPIQueryInfoTokens(tokenFunctions)
+	})
+}
+
+/**
+ * DEPRECATED since 1.24! Use
+ * ApiQueryTokensRegisterTypes instead.
+ * Use this hook to add custom tokens to list=recentchanges. Every token has an
+ * action, which will be used in the rctoken parameter and in the output
+ * (actiontoken="..."), and a callback function which should return the token, or
+ * false if the user isn't allowed to obtain it. The prototype of the callback
+ * function is func($pageid, $title, $rc), where $pageid is the page ID of the
+ * page associated to the revision the token is requested for, $title the
+ * associated Title object and $rc the associated RecentChange object. In the
+ * hook, just add your callback to the $tokenFunctions array and return true
+ * (returning false makes no sense).
+ */
+type APIQueryRecentChangesTokensHook interface {
+	/**
+	 * @param mixed &$tokenFunctions [ action => callback ]
+	 * @return bool False to abort the hook
+	 */
+	OnAPIQueryRecentChangesTokens(tokenFunctions *interface{}) bool
+}
+
+/**
+ * Run the APIQueryRecentChangesTokens hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIQueryRecentChangesTokens(tokenFunctions *interface{}) bool {
+	args := []interface{}{tokenFunctions}
+	return r.container.run("APIQueryRecentChangesTokens", args, func(handler interface{}) bool {
+		return handler.(APIQueryRecentChangesTokensHook).OnAPIQueryRecentChangesTokens(tokenFunctions)
+	})
+}
+
+/**
+ * DEPRECATED since 1.24! Use
+ * ApiQueryTokensRegisterTypes instead.
+ * Use this hook to add custom tokens to prop=revisions. Every token has an
+ * action, which will be used in the rvtoken parameter and in the output
+ * (actiontoken="..."), and a callback function which should return the token, or
+ * false if the user isn't allowed to obtain it. The prototype of the callback
+ * function is func($pageid, $title, $rev), where $pageid is the page ID of the
+ * page associated to the revision the token is requested for, $title the
+ * associated Title object and $rev the associated Revision object. In the hook,
+ * just add your callback to the $tokenFunctions array and return true (returning
+ * false makes no sense).
+ */
+type APIQueryRevisionsTokensHook interface {
+	/**
+	 * @param mixed &$tokenFunctions [ action => callback ]
+	 * @return bool False to abort the hook
+	 */
+	OnAPIQueryRevisionsTokens(tokenFunctions *interface{}) bool
+}
+
+/**
+ * Run the APIQueryRevisionsTokens hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIQueryRevisionsTokens(tokenFunctions *interface{}) bool {
+	args := []interface{}{tokenFunctions}
+	return r.container.run("APIQueryRevisionsTokens", args, func(handler interface{}) bool {
+		return handler.(APIQueryRevisionsTokensHook).OnAPIQueryRevisionsTokens(tokenFunctions)
+	})
+}
+
+/**
+ * Use this hook to add extra information to the
+ * sites general information.
+ */
+type APIQuerySiteInfoGeneralInfoHook interface {
+	/**
+	 * @param mixed $module the current ApiQuerySiteInfo module
+	 * @param mixed &$results array of results, add things here
+	 * @return bool False to abort the hook
+	 */
+	OnAPIQuerySiteInfoGeneralInfo(module interface{}, results *interface{}) bool
+}
+
+/**
+ * Run the APIQuerySiteInfoGeneralInfo hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIQuerySiteInfoGeneralInfo(module interface{}, results *interface{}) bool {
+	args := []interface{}{module, results}
+	return r.container.run("APIQuerySiteInfoGeneralInfo", args, func(handler interface{}) bool {
+		return handler.(APIQuerySiteInfoGeneralInfoHook).OnAPIQuerySiteInfoGeneralInfo(module, results)
+	})
+}
+
+/**
+ * Use this hook to add extra information to the
+ * sites statistics information.
+ */
+type APIQuerySiteInfoStatisticsInfoHook interface {
+	/**
+	 * @param mixed &$results array of results, add things here
+	 * @return bool False to abort the hook
+	 */
+	OnAPIQuerySiteInfoStatisticsInfo(results *interface{}) bool
+}
+
+/**
+ * Run the APIQuerySiteInfoStatisticsInfo hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIQuerySiteInfoStatisticsInfo(results *interface{}) bool {
+	args := []interface{}{results}
+	return r.container.run("APIQuerySiteInfoStatisticsInfo", args, func(handler interface{}) bool {
+		return handler.(APIQuerySiteInfoStatisticsInfoHook).OnAPIQuerySiteInfoStatisticsInfo(results)
+	})
+}
+
+/**
+ * DEPRECATED since 1.24! Use ApiQueryTokensRegisterTypes
+ * instead.
+ * Use this hook to add custom token to list=users. Every token has an action,
+ * which will be used in the ustoken parameter and in the output
+ * (actiontoken="..."), and a callback function which should return the token, or
+ * false if the user isn't allowed to obtain it. The prototype of the callback
+ * function is func($user) where $user is the User object. In the hook, just add
+ * your callback to the $tokenFunctions array and return true (returning false
+ * makes no sense).
+ */
+type APIQueryUsersTokensHook interface {
+	/**
+	 * @param mixed &$tokenFunctions [ action => callback ]
+	 * @return bool False to abort the hook
+	 */
+	OnAPIQueryUsersTokens(tokenFunctions *interface{}) bool
+}
+
+/**
+ * Run the APIQueryUsersTokens hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAPIQueryUsersTokens(tokenFunctions *interface{}) bool {
+	args := []interface{}{tokenFunctions}
+	return r.container.run("APIQueryUsersTokens", args, func(handler interface{}) bool {
+		return handler.(APIQueryUsersTokensHook).OnAPIQueryUsersTokens(tokenFunctions)
+	})
+}
+
+/**
+ * DEPRECATED since 1.27! Create a PreAuthenticationProvider
+ * instead. Return false to cancel automated local account creation, where normally
+ * authentication against an external auth plugin would be creating a local
+ * account.
+ */
+type AbortAutoAccountHook interface {
+	/**
+	 * @param mixed $user the User object about to be created (read-only, incomplete)
+	 * @param mixed &$abortMsg out parameter: name of error message to be displayed to user
+	 * @return bool False to abort the hook
+	 */
+	OnAbortAutoAccount(user interface{}, abortMsg *interface{}) bool
+}
+
+/**
+ * Run the AbortAutoAccount hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAbortAutoAccount(user interface{}, abortMsg *interface{}) bool {
+	args := []interface{}{user, abortMsg}
+	return r.container.run("AbortAutoAccount", args, func(handler interface{}) bool {
+		return handler.(AbortAutoAccountHook).OnAbortAutoAccount(user, abortMsg)
+	})
+}
+
+/**
+ * Return false to cancel an autoblock.
+ */
+type AbortAutoblockHook interface {
+	/**
+	 * @param mixed $autoblockip The IP going to be autoblocked.
+	 * @param mixed &$block The block from which the autoblock is coming.
+	 * @return bool False to abort the hook
+	 */
+	OnAbortAutoblock(autoblockip interface{}, block *interface{}) bool
+}
+
+/**
+ * Run the AbortAutoblock hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAbortAutoblock(autoblockip interface{}, block *interface{}) bool {
+	args := []interface{}{autoblockip, block}
+	return r.container.run("AbortAutoblock", args, func(handler interface{}) bool {
+		return handler.(AbortAutoblockHook).OnAbortAutoblock(autoblockip, block)
+	})
+}
+
+/**
+ * Can be used to cancel the caching of a diff.
+ */
+type AbortDiffCacheHook interface {
+	/**
+	 * @param mixed &$diffEngine DifferenceEngine object
+	 * @return bool False to abort the hook
+	 */
+	OnAbortDiffCache(diffEngine *interface{}) bool
+}
+
+/**
+ * Run the AbortDiffCache hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAbortDiffCache(diffEngine *interface{}) bool {
+	args := []interface{}{diffEngine}
+	return r.container.run("AbortDiffCache", args, func(handler interface{}) bool {
+		return handler.(AbortDiffCacheHook).OnAbortDiffCache(diffEngine)
+	})
+}
+
+/**
+ * Can be used to cancel email notifications for an edit.
+ */
+type AbortEmailNotificationHook interface {
+	/**
+	 * @param mixed $editor The User who made the change.
+	 * @param mixed $title The Title of the page that was edited.
+	 * @param mixed $rc The current RecentChange object.
+	 * @return bool False to abort the hook
+	 */
+	OnAbortEmailNotification(editor interface{}, title interface{}, rc interface{}) bool
+}
+
+/**
+ * Run the AbortEmailNotification hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAbortEmailNotification(editor interface{}, title interface{}, rc interface{}) bool {
+	args := []interface{}{editor, title, rc}
+	return r.container.run("AbortEmailNotification", args, func(handler interface{}) bool {
+		return handler.(AbortEmailNotificationHook).OnAbortEmailNotification(editor, title, rc)
+	})
+}
+
+/**
+ * DEPRECATED since 1.27! Create a PreAuthenticationProvider instead.
+ * Return false to cancel account login.
+ */
+type AbortLoginHook interface {
+	/**
+	 * @param mixed $user the User object being authenticated against
+	 * @param mixed $password the password being submitted, not yet checked for validity
+	 * @param mixed &$retval a LoginForm class constant to return from authenticateUserData(); default is LoginForm::ABORTED. Note that the client may be using a machine API rather than the HTML user interface.
+	 * @param mixed &$msg the message identifier for abort reason (new in 1.18, not available before 1.18)
+	 * @return bool False to abort the hook
+	 */
+	OnAbortLogin(user interface{}, password interface{}, retval *interface{}, msg *interface{}) bool
+}
+
+/**
+ * Run the AbortLogin hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAbortLogin(user interface{}, password interface{}, retval *interface{}, msg *interface{}) bool {
+	args := []interface{}{user, password, retval, msg}
+	return r.container.run("AbortLogin", args, func(handler interface{}) bool {
+		return handler.(AbortLoginHook).OnAbortLogin(user, password, retval, msg)
+	})
+}
+
+/**
+ * DEPRECATED since 1.27! Create a PreAuthenticationProvider
+ * instead. Return false to cancel explicit account creation.
+ */
+type AbortNewAccountHook interface {
+	/**
+	 * @param mixed $user the User object about to be created (read-only, incomplete)
+	 * @param mixed &$msg out parameter: HTML to display on abort
+	 * @param mixed &$status out parameter: Status object to return, replaces the older $msg param (added in 1.23) Create the object with Status::newFatal() to ensure proper API error messages are returned when creating account through API clients.
+	 * @return bool False to abort the hook
+	 */
+	OnAbortNewAccount(user interface{}, msg *interface{}, status *interface{}) bool
+}
+
+/**
+ * Run the AbortNewAccount hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAbortNewAccount(user interface{}, msg *interface{}, status *interface{}) bool {
+	args := []interface{}{user, msg, status}
+	return r.container.run("AbortNewAccount", args, func(handler interface{}) bool {
+		return handler.(AbortNewAccountHook).OnAbortNewAccount(user, msg, status)
+	})
+}
+
+/**
+ * Return false to cancel talk page email
+ * notification
+ */
+type AbortTalkPageEmailNotificationHook interface {
+	/**
+	 * @param mixed $targetUser the user whom to send talk page email notification
+	 * @param mixed $title the page title
+	 * @return bool False to abort the hook
+	 */
+	OnAbortTalkPageEmailNotification(targetUser interface{}, title interface{}) bool
+}
+
+/**
+ * Run the AbortTalkPageEmailNotification hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAbortTalkPageEmailNotification(targetUser interface{}, title interface{}) bool {
+	args := []interface{}{targetUser, title}
+	return r.container.run("AbortTalkPageEmailNotification", args, func(handler interface{}) bool {
+		return handler.(AbortTalkPageEmailNotificationHook).OnAbortTalkPageEmailNotification(targetUser, title)
+	})
+}
+
+/**
+ * Before executing the HTMLForm object.
+ */
+type ActionBeforeFormDisplayHook interface {
+	/**
+	 * @param mixed $name name of the action
+	 * @param mixed &$form HTMLForm object
+	 * @param mixed $article Article object
+	 * @return bool False to abort the hook
+	 */
+	OnActionBeforeFormDisplay(name interface{}, form *interface{}, article interface{}) bool
+}
+
+/**
+ * Run the ActionBeforeFormDisplay hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnActionBeforeFormDisplay(name interface{}, form *interface{}, article interface{}) bool {
+	args := []interface{}{name, form, article}
+	return r.container.run("ActionBeforeFormDisplay", args, func(handler interface{}) bool {
+		return handler.(ActionBeforeFormDisplayHook).OnActionBeforeFormDisplay(name, form, article)
+	})
+}
+
+/**
+ * Before creating an HTMLForm object for a page action;
+ * Allows to change the fields on the form that will be generated.
+ */
+type ActionModifyFormFieldsHook interface {
+	/**
+	 * @param mixed $name name of the action
+	 * @param mixed &$fields HTMLForm descriptor array
+	 * @param mixed $article Article object
+	 * @return bool False to abort the hook
+	 */
+	OnActionModifyFormFields(name interface{}, fields *interface{}, article interface{}) bool
+}
+
+/**
+ * Run the ActionModifyFormFields hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnActionModifyFormFields(name interface{}, fields *interface{}, article interface{}) bool {
+	args := []interface{}{name, fields, article}
+	return r.container.run("ActionModifyFormFields", args, func(handler interface{}) bool {
+		return handler.(ActionModifyFormFieldsHook).OnActionModifyFormFields(name, fields, article)
+	})
+}
+
+/**
+ * DEPRECATED since 1.27! Use LocalUserCreated.
+ * After a user account is created.
+ */
+type AddNewAccountHook interface {
+	/**
+	 * @param mixed $user the User object that was created. (Parameter added in 1.7)
+	 * @param mixed $byEmail true when account was created "by email" (added in 1.12)
+	 * @return bool False to abort the hook
+	 */
+	OnAddNewAccount(user interface{}, byEmail interface{}) bool
+}
+
+/**
+ * Run the AddNewAccount hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAddNewAccount(user interface{}, byEmail interface{}) bool {
+	args := []interface{}{user, byEmail}
+	return r.container.run("AddNewAccount", args, func(handler interface{}) bool {
+		return handler.(AddNewAccountHook).OnAddNewAccount(user, byEmail)
+	})
+}
+
+/**
+ * Executed in OutputPage.php after all feed links (atom,
+ * rss,...) are created. Can be used to omit specific feeds from being outputted.
+ * You must not use this hook to add feeds, use OutputPage::addFeedLink() instead.
+ */
+type AfterBuildFeedLinksHook interface {
+	/**
+	 * @param mixed &$feedLinks Array of created feed links
+	 * @return bool False to abort the hook
+	 */
+	OnAfterBuildFeedLinks(feedLinks *interface{}) bool
+}
+
+/**
+ * Run the AfterBuildFeedLinks hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAfterBuildFeedLinks(feedLinks *interface{}) bool {
+	args := []interface{}{feedLinks}
+	return r.container.run("AfterBuildFeedLinks", args, func(handler interface{}) bool {
+		return handler.(AfterBuildFeedLinksHook).OnAfterBuildFeedLinks(feedLinks)
+	})
+}
+
+/**
+ * Nearly at the end of OutputPage::output() but
+ * before OutputPage::sendCacheControl() and final ob_end_flush() which
+ * will send the buffered output to the client. This allows for last-minute
+ * modification of the output within the buffer by using ob_get_clean().
+ */
+type AfterFinalPageOutputHook interface {
+	/**
+	 * @param mixed $output The OutputPage object where output() was called
+	 * @return bool False to abort the hook
+	 */
+	OnAfterFinalPageOutput(output interface{}) bool
+}
+
+/**
+ * Run the AfterFinalPageOutput hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAfterFinalPageOutput(output interface{}) bool {
+	args := []interface{}{output}
+	return r.container.run("AfterFinalPageOutput", args, func(handler interface{}) bool {
+		return handler.(AfterFinalPageOutputHook).OnAfterFinalPageOutput(output)
+	})
+}
+
+/**
+ * When a page import is completed.
+ */
+type AfterImportPageHook interface {
+	/**
+	 * @param mixed $title Title under which the revisions were imported
+	 * @param mixed $foreignTitle ForeignTitle object based on data provided by the XML file
+	 * @param mixed $revCount Number of revisions in the XML file
+	 * @param mixed $sRevCount Number of successfully imported revisions
+	 * @param mixed $pageInfo associative array of page information
+	 * @return bool False to abort the hook
+	 */
+	OnAfterImportPage(title interface{}, foreignTitle interface{}, revCount interface{}, sRevCount interface{}, pageInfo interface{}) bool
+}
+
+/**
+ * Run the AfterImportPage hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAfterImportPage(title interface{}, foreignTitle interface{}, revCount interface{}, sRevCount interface{}, pageInfo interface{}) bool {
+	args := []interface{}{title, foreignTitle, revCount, sRevCount, pageInfo}
+	return r.container.run("AfterImportPage", args, func(handler interface{}) bool {
+		return handler.(AfterImportPageHook).OnAfterImportPage(title, foreignTitle, revCount, sRevCount, pageInfo)
+	})
+}
+
+/**
+ * After an image gallery is formed by Parser,
+ * just before adding its HTML to parser output.
+ */
+type AfterParserFetchFileAndTitleHook interface {
+	/**
+	 * @param mixed $parser Parser object that called the hook
+	 * @param mixed $ig Gallery, an object of one of the gallery classes (inheriting from ImageGalleryBase)
+	 * @param mixed &$html HTML generated by the gallery
+	 * @return bool False to abort the hook
+	 */
+	OnAfterParserFetchFileAndTitle(parser interface{}, ig interface{}, html *interface{}) bool
+}
+
+/**
+ * Run the AfterParserFetchFileAndTitle hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAfterParserFetchFileAndTitle(parser interface{}, ig interface{}, html *interface{}) bool {
+	args := []interface{}{parser, ig, html}
+	return r.container.run("AfterParserFetchFileAndTitle", args, func(handler interface{}) bool {
+		return handler.(AfterParserFetchFileAndTitleHook).OnAfterParserFetchFileAndTitle(parser, ig, html)
+	})
+}
+
+/**
+ * Before checking if a user can edit a page and before showing
+ * the edit form ( EditPage::edit() ). This is triggered on &action=edit.
+ */
+type AlternateEditHook interface {
+	/**
+	 * @param mixed $editPage the EditPage object
+	 * @return bool False to abort the hook
+	 */
+	OnAlternateEdit(editPage interface{}) bool
+}
+
+/**
+ * Run the AlternateEdit hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAlternateEdit(editPage interface{}) bool {
+	args := []interface{}{editPage}
+	return r.container.run("AlternateEdit", args, func(handler interface{}) bool {
+		return handler.(AlternateEditHook).OnAlternateEdit(editPage)
+	})
+}
+
+/**
+ * Before generating the preview of the page when editing
+ * ( EditPage::getPreviewText() ).
+ * Return false and set $previewHTML and $parserOutput to output custom page
+ * preview HTML.
+ */
+type AlternateEditPreviewHook interface {
+	/**
+	 * @param mixed $editPage the EditPage object
+	 * @param mixed &$content the Content object for the text field from the edit page
+	 * @param mixed &$previewHTML Text to be placed into the page for the preview
+	 * @param mixed &$parserOutput the ParserOutput object for the preview
+	 * @return bool False to abort the hook
+	 */
+	OnAlternateEditPreview(editPage interface{}, content *interface{}, previewHTML *interface{}, parserOutput *interface{}) bool
+}
+
+/**
+ * Run the AlternateEditPreview hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAlternateEditPreview(editPage interface{}, content *interface{}, previewHTML *interface{}, parserOutput *interface{}) bool {
+	args := []interface{}{editPage, content, previewHTML, parserOutput}
+	return r.container.run("AlternateEditPreview", args, func(handler interface{}) bool {
+		return handler.(AlternateEditPreviewHook).OnAlternateEditPreview(editPage, content, previewHTML, parserOutput)
+	})
+}
+
+/**
+ * Called before mail is sent so that mail could be logged
+ * (or something else) instead of using PEAR or PHP's mail(). Return false to skip
+ * the regular method of sending mail.  Return a string to return a php-mail-error
+ * message containing the error. Returning true will continue with sending email
+ * in the regular way.
+ */
+type AlternateUserMailerHook interface {
+	/**
+	 * @param mixed $headers Associative array of headers for the email
+	 * @param mixed $to MailAddress object or array
+	 * @param mixed $from From address
+	 * @param mixed $subject Subject of the email
+	 * @param mixed $body Body of the message
+	 * @return bool False to abort the hook
+	 */
+	OnAlternateUserMailer(headers interface{}, to interface{}, from interface{}, subject interface{}, body interface{}) bool
+}
+
+/**
+ * Run the AlternateUserMailer hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAlternateUserMailer(headers interface{}, to interface{}, from interface{}, subject interface{}, body interface{}) bool {
+	args := []interface{}{headers, to, from, subject, body}
+	return r.container.run("AlternateUserMailer", args, func(handler interface{}) bool {
+		return handler.(AlternateUserMailerHook).OnAlternateUserMailer(headers, to, from, subject, body)
+	})
+}
+
+/**
+ * Allow extensions to modify the query used by
+ * Special:AncientPages.
+ */
+type AncientPagesQueryHook interface {
+	/**
+	 * @param mixed &$tables tables to join in the query
+	 * @param mixed &$conds conditions for the query
+	 * @param mixed &$joinConds join conditions for the query
+	 * @return bool False to abort the hook
+	 */
+	OnAncientPagesQuery(tables *interface{}, conds *interface{}, joinConds *interface{}) bool
+}
+
+/**
+ * Run the AncientPagesQuery hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAncientPagesQuery(tables *interface{}, conds *interface{}, joinConds *interface{}) bool {
+	args := []interface{}{tables, conds, joinConds}
+	return r.container.run("AncientPagesQuery", args, func(handler interface{}) bool {
+		return handler.(AncientPagesQueryHook).OnAncientPagesQuery(tables, conds, joinConds)
+	})
+}
+
+/**
+ * Before calling ApiMain's execute() method in api.php.
+ */
+type ApiBeforeMainHook interface {
+	/**
+	 * @param mixed &$main ApiMain object
+	 * @return bool False to abort the hook
+	 */
+	OnApiBeforeMain(main *interface{}) bool
+}
+
+/**
+ * Run the ApiBeforeMain hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiBeforeMain(main *interface{}) bool {
+	args := []interface{}{main}
+	return r.container.run("ApiBeforeMain", args, func(handler interface{}) bool {
+		return handler.(ApiBeforeMainHook).OnApiBeforeMain(main)
+	})
+}
+
+/**
+ * Called during ApiMain::checkCanExecute. Use to further
+ * authenticate and authorize API clients before executing the module. Return
+ * false and set a message to cancel the request.
+ */
+type ApiCheckCanExecuteHook interface {
+	/**
+	 * @param mixed $module Module object
+	 * @param mixed $user Current user
+	 * @param mixed &$message API message to die with. Specific values accepted depend on the MediaWiki version: * 1.29+: IApiMessage, Message, string message key, or key+parameters array to pass to ApiBase::dieWithError(). * 1.27+: IApiMessage, or a key or key+parameters in ApiBase::$messageMap. * Earlier: A key or key+parameters in ApiBase::$messageMap.
+	 * @return bool False to abort the hook
+	 */
+	OnApiCheckCanExecute(module interface{}, user interface{}, message *interface{}) bool
+}
+
+/**
+ * Run the ApiCheckCanExecute hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiCheckCanExecute(module interface{}, user interface{}, message *interface{}) bool {
+	args := []interface{}{module, user, message}
+	return r.container.run("ApiCheckCanExecute", args, func(handler interface{}) bool {
+		return handler.(ApiCheckCanExecuteHook).OnApiCheckCanExecute(module, user, message)
+	})
+}
+
+/**
+ * Add messages to the 'deprecation-help' warning generated
+ * from ApiBase::addDeprecation().
+ */
+type ApiDeprecationHelpHook interface {
+	/**
+	 * @param mixed &$msgs Message[] Messages to include in the help. Multiple messages will be joined with spaces.
+	 * @return bool False to abort the hook
+	 */
+	OnApiDeprecationHelp(msgs *interface{}) bool
+}
+
+/**
+ * Run the ApiDeprecationHelp hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiDeprecationHelp(msgs *interface{}) bool {
+	args := []interface{}{msgs}
+	return r.container.run("ApiDeprecationHelp", args, func(handler interface{}) bool {
+		return handler.(ApiDeprecationHelpHook).OnApiDeprecationHelp(msgs)
+	})
+}
+
+/**
+ * Called to convert the result of ContribsPager
+ * into a FeedItem instance that ApiFeedContributions can consume. Implementors of
+ * this hook may cancel the hook to signal that the item is not viewable in the
+ * provided context.
+ */
+type ApiFeedContributions__feedItemHook interface {
+	/**
+	 * @param mixed $row A row of data from ContribsPager.  The set of data returned by ContribsPager can be adjusted by handling the ContribsPager::reallyDoQuery hook.
+	 * @param mixed $context An IContextSource implementation.
+	 * @param mixed &$feedItem Set this to a FeedItem instance if the callback can handle the provided row. This is provided to the hook as a null, if it is non null then another callback has already handled the hook.
+	 * @return bool False to abort the hook
+	 */
+	OnApiFeedContributions__feedItem(row interface{}, context interface{}, feedItem *interface{}) bool
+}
+
+/**
+ * Run the ApiFeedContributions::feedItem hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiFeedContributions__feedItem(row interface{}, context interface{}, feedItem *interface{}) bool {
+	args := []interface{}{row, context, feedItem}
+	return r.container.run("ApiFeedContributions::feedItem", args, func(handler interface{}) bool {
+		return handler.(ApiFeedContributions__feedItemHook).OnApiFeedContributions__feedItem(row, context, feedItem)
+	})
+}
+
+/**
+ * Use to syntax-highlight API pretty-printed output. When
+ * highlighting, add output to $context->getOutput() and return false.
+ */
+type ApiFormatHighlightHook interface {
+	/**
+	 * @param mixed $context An IContextSource.
+	 * @param mixed $text Text to be highlighted.
+	 * @param mixed $mime MIME type of $text.
+	 * @param mixed $format API format code for $text.
+	 * @return bool False to abort the hook
+	 */
+	OnApiFormatHighlight(context interface{}, text interface{}, mime interface{}, format interface{}) bool
+}
+
+/**
+ * Run the ApiFormatHighlight hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiFormatHighlight(context interface{}, text interface{}, mime interface{}, format interface{}) bool {
+	args := []interface{}{context, text, mime, format}
+	return r.container.run("ApiFormatHighlight", args, func(handler interface{}) bool {
+		return handler.(ApiFormatHighlightHook).OnApiFormatHighlight(context, text, mime, format)
+	})
+}
+
+/**
+ * Called when ApiMain has finished initializing its
+ * module manager. Can be used to conditionally register API modules.
+ */
+type ApiMain__moduleManagerHook interface {
+	/**
+	 * @param mixed $moduleManager ApiModuleManager Module manager instance
+	 * @return bool False to abort the hook
+	 */
+	OnApiMain__moduleManager(moduleManager interface{}) bool
+}
+
+/**
+ * Run the ApiMain::moduleManager hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiMain__moduleManager(moduleManager interface{}) bool {
+	args := []interface{}{moduleManager}
+	return r.container.run("ApiMain::moduleManager", args, func(handler interface{}) bool {
+		return handler.(ApiMain__moduleManagerHook).OnApiMain__moduleManager(moduleManager)
+	})
+}
+
+/**
+ * Called by ApiMain::executeActionWithErrorHandling() when
+ * an exception is thrown during API action execution.
+ */
+type ApiMain__onExceptionHook interface {
+	/**
+	 * @param mixed $apiMain Calling ApiMain instance.
+	 * @param mixed $e Exception object.
+	 * @return bool False to abort the hook
+	 */
+	OnApiMain__onException(apiMain interface{}, e interface{}) bool
+}
+
+/**
+ * Run the ApiMain::onException hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiMain__onException(apiMain interface{}, e interface{}) bool {
+	args := []interface{}{apiMain, e}
+	return r.container.run("ApiMain::onException", args, func(handler interface{}) bool {
+		return handler.(ApiMain__onExceptionHook).OnApiMain__onException(apiMain, e)
+	})
+}
+
+/**
+ * Called from ApiParse and ApiExpandTemplates to allow
+ * extensions to adjust the ParserOptions before parsing.
+ */
+type ApiMakeParserOptionsHook interface {
+	/**
+	 * @param mixed $options ParserOptions object
+	 * @param mixed $title Title to be parsed
+	 * @param mixed $params Parameter array for the API module
+	 * @param mixed $module API module (which is also a ContextSource)
+	 * @param mixed &$reset Set to a ScopedCallback used to reset any hooks after the parse is done.
+	 * @param mixed &$suppressCache Set true if cache should be suppressed.
+	 * @return bool False to abort the hook
+	 */
+	OnApiMakeParserOptions(options interface{}, title interface{}, params interface{}, module interface{}, reset *interface{}, suppressCache *interface{}) bool
+}
+
+/**
+ * Run the ApiMakeParserOptions hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiMakeParserOptions(options interface{}, title interface{}, params interface{}, module interface{}, reset *interface{}, suppressCache *interface{}) bool {
+	args := []interface{}{options, title, params, module, reset, suppressCache}
+	return r.container.run("ApiMakeParserOptions", args, func(handler interface{}) bool {
+		return handler.(ApiMakeParserOptionsHook).OnApiMakeParserOptions(options, title, params, module, reset, suppressCache)
+	})
+}
+
+/**
+ * When lag information is being requested via API. Use this to
+ * override lag information. Generally a hook function should only replace
+ * $lagInfo if the new $lagInfo['lag'] is greater than the current $lagInfo['lag'].
+ */
+type ApiMaxLagInfoHook interface {
+	/**
+	 * @param mixed &$lagInfo Maximum lag information array. Fields in the array are: 'lag' is the number of seconds of lag. 'host' is the host name on which the lag exists. 'type' is an indication of the type of lag, e.g. "db" for database replication lag or "jobqueue" for job queue size converted to pseudo-seconds. It is possible to add more fields and they will be returned to the user in the API response.
+	 * @return bool False to abort the hook
+	 */
+	OnApiMaxLagInfo(lagInfo *interface{}) bool
+}
+
+/**
+ * Run the ApiMaxLagInfo hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiMaxLagInfo(lagInfo *interface{}) bool {
+	args := []interface{}{lagInfo}
+	return r.container.run("ApiMaxLagInfo", args, func(handler interface{}) bool {
+		return handler.(ApiMaxLagInfoHook).OnApiMaxLagInfo(lagInfo)
+	})
+}
+
+/**
+ * Called when constructing the OpenSearch results. Hooks
+ * can alter or append to the array.
+ */
+type ApiOpenSearchSuggestHook interface {
+	/**
+	 * @param mixed &$results array with integer keys to associative arrays. Keys in associative array: - title: Title object. - redirect from: Title or null. - extract: Description for this result. - extract trimmed: If truthy, the extract will not be trimmed to $wgOpenSearchDescriptionLength. - image: Thumbnail for this result. Value is an array with subkeys 'source' (url), 'width', 'height', 'alt', 'align'. - url: Url for the given title.
+	 * @return bool False to abort the hook
+	 */
+	OnApiOpenSearchSuggest(results *interface{}) bool
+}
+
+/**
+ * Run the ApiOpenSearchSuggest hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiOpenSearchSuggest(results *interface{}) bool {
+	args := []interface{}{results}
+	return r.container.run("ApiOpenSearchSuggest", args, func(handler interface{}) bool {
+		return handler.(ApiOpenSearchSuggestHook).OnApiOpenSearchSuggest(results)
+	})
+}
+
+/**
+ * Called when preparing the OutputPage object for
+ * ApiParse. This is mainly intended for calling OutputPage::addContentOverride()
+ * or OutputPage::addContentOverrideCallback().
+ */
+type ApiParseMakeOutputPageHook interface {
+	/**
+	 * @param mixed $module ApiBase (which is also a ContextSource)
+	 * @param mixed $output OutputPage
+	 * @return bool False to abort the hook
+	 */
+	OnApiParseMakeOutputPage(module interface{}, output interface{}) bool
+}
+
+/**
+ * Run the ApiParseMakeOutputPage hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiParseMakeOutputPage(module interface{}, output interface{}) bool {
+	args := []interface{}{module, output}
+	return r.container.run("ApiParseMakeOutputPage", args, func(handler interface{}) bool {
+		return handler.(ApiParseMakeOutputPageHook).OnApiParseMakeOutputPage(module, output)
+	})
+}
+
+/**
+ * Called when ApiQuery has finished initializing its
+ * module manager. Can be used to conditionally register API query modules.
+ */
+type ApiQuery__moduleManagerHook interface {
+	/**
+	 * @param mixed $moduleManager ApiModuleManager Module manager instance
+	 * @return bool False to abort the hook
+	 */
+	OnApiQuery__moduleManager(moduleManager interface{}) bool
+}
+
+/**
+ * Run the ApiQuery::moduleManager hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiQuery__moduleManager(moduleManager interface{}) bool {
+	args := []interface{}{moduleManager}
+	return r.container.run("ApiQuery::moduleManager", args, func(handler interface{}) bool {
+		return handler.(ApiQuery__moduleManagerHook).OnApiQuery__moduleManager(moduleManager)
+	})
+}
+
+/**
+ * Called for (some) API query modules after the
+ * database query has returned. An API query module wanting to use this hook
+ * should see the ApiQueryBase::select() and ApiQueryBase::processRow()
+ * documentation.
+ */
+type ApiQueryBaseAfterQueryHook interface {
+	/**
+	 * @param mixed $module ApiQueryBase module in question
+	 * @param mixed $result ResultWrapper|bool returned from the IDatabase::select()
+	 * @param mixed &$hookData array that was passed to the 'ApiQueryBaseBeforeQuery' hook and will be passed to the 'ApiQueryBaseProcessRow' hook, intended for inter-hook communication.
+	 * @return bool False to abort the hook
+	 */
+	OnApiQueryBaseAfterQuery(module interface{}, result interface{}, hookData *interface{}) bool
+}
+
+/**
+ * Run the ApiQueryBaseAfterQuery hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiQueryBaseAfterQuery(module interface{}, result interface{}, hookData *interface{}) bool {
+	args := []interface{}{module, result, hookData}
+	return r.container.run("ApiQueryBaseAfterQuery", args, func(handler interface{}) bool {
+		return handler.(ApiQueryBaseAfterQueryHook).OnApiQueryBaseAfterQuery(module, result, hookData)
+	})
+}
+
+/**
+ * Called for (some) API query modules before a
+ * database query is made. WARNING: It would be very easy to misuse this hook and
+ * break the module! Any joins added *must* join on a unique key of the target
+ * table unless you really know what you're doing. An API query module wanting to
+ * use this hook should see the ApiQueryBase::select() and
+ * ApiQueryBase::processRow() documentation.
+ */
+type ApiQueryBaseBeforeQueryHook interface {
+	/**
+	 * @param mixed $module ApiQueryBase module in question
+	 * @param mixed &$tables array of tables to be queried
+	 * @param mixed &$fields array of columns to select
+	 * @param mixed &$conds array of WHERE conditionals for query
+	 * @param mixed &$query_options array of options for the database request
+	 * @param mixed &$join_conds join conditions for the tables
+	 * @param mixed &$hookData array that will be passed to the 'ApiQueryBaseAfterQuery' and 'ApiQueryBaseProcessRow' hooks, intended for inter-hook communication.
+	 * @return bool False to abort the hook
+	 */
+	OnApiQueryBaseBeforeQuery(module interface{}, tables *interface{}, fields *interface{}, conds *interface{}, query_options *interface{}, join_conds *interface{}, hookData *interface{}) bool
+}
+
+/**
+ * Run the ApiQueryBaseBeforeQuery hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiQueryBaseBeforeQuery(module interface{}, tables *interface{}, fields *interface{}, conds *interface{}, query_options *interface{}, join_conds *interface{}, hookData *interface{}) bool {
+	args := []interface{}{module, tables, fields, conds, query_options, join_conds, hookData}
+	return r.container.run("ApiQueryBaseBeforeQuery", args, func(handler interface{}) bool {
+		return handler.(ApiQueryBaseBeforeQueryHook).OnApiQueryBaseBeforeQuery(module, tables, fields, conds, query_options, join_conds, hookData)
+	})
+}
+
+/**
+ * Called for (some) API query modules as each row of
+ * the database result is processed. Return false to stop processing the result
+ * set. An API query module wanting to use this hook should see the
+ * ApiQueryBase::select() and ApiQueryBase::processRow() documentation.
+ */
+type ApiQueryBaseProcessRowHook interface {
+	/**
+	 * @param mixed $module ApiQueryBase module in question
+	 * @param mixed $row stdClass Database result row
+	 * @param mixed &$data array to be included in the ApiResult.
+	 * @param mixed &$hookData array that was be passed to the 'ApiQueryBaseBeforeQuery' and 'ApiQueryBaseAfterQuery' hooks, intended for inter-hook communication.
+	 * @return bool False to abort the hook
+	 */
+	OnApiQueryBaseProcessRow(module interface{}, row interface{}, data *interface{}, hookData *interface{}) bool
+}
+
+/**
+ * Run the ApiQueryBaseProcessRow hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiQueryBaseProcessRow(module interface{}, row interface{}, data *interface{}, hookData *interface{}) bool {
+	args := []interface{}{module, row, data, hookData}
+	return r.container.run("ApiQueryBaseProcessRow", args, func(handler interface{}) bool {
+		return handler.(ApiQueryBaseProcessRowHook).OnApiQueryBaseProcessRow(module, row, data, hookData)
+	})
+}
+
+/**
+ * Use this hook to add additional token types to
+ * action=query&meta=tokens. Note that most modules will probably be able to use
+ * the 'csrf' token instead of creating their own token types.
+ */
+type ApiQueryTokensRegisterTypesHook interface {
+	/**
+	 * @param mixed &$salts [ type => salt to pass to User::getEditToken(), or array of salt and key to pass to Session::getToken() ]
+	 * @return bool False to abort the hook
+	 */
+	OnApiQueryTokensRegisterTypes(salts *interface{}) bool
+}
+
+/**
+ * Run the ApiQueryTokensRegisterTypes hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiQueryTokensRegisterTypes(salts *interface{}) bool {
+	args := []interface{}{salts}
+	return r.container.run("ApiQueryTokensRegisterTypes", args, func(handler interface{}) bool {
+		return handler.(ApiQueryTokensRegisterTypesHook).OnApiQueryTokensRegisterTypes(salts)
+	})
+}
+
+/**
+ * Extract row data for ApiQueryWatchlist.
+ */
+type ApiQueryWatchlistExtractOutputDataHook interface {
+	/**
+	 * @param mixed $module ApiQueryWatchlist instance
+	 * @param mixed $watchedItem WatchedItem instance
+	 * @param mixed $recentChangeInfo Array of recent change info data
+	 * @param mixed &$vals Associative array of data to be output for the row
+	 * @return bool False to abort the hook
+	 */
+	OnApiQueryWatchlistExtractOutputData(module interface{}, watchedItem interface{}, recentChangeInfo interface{}, vals *interface{}) bool
+}
+
+/**
+ * Run the ApiQueryWatchlistExtractOutputData hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiQueryWatchlistExtractOutputData(module interface{}, watchedItem interface{}, recentChangeInfo interface{}, vals *interface{}) bool {
+	args := []interface{}{module, watchedItem, recentChangeInfo, vals}
+	return r.container.run("ApiQueryWatchlistExtractOutputData", args, func(handler interface{}) bool {
+		return handler.(ApiQueryWatchlistExtractOutputDataHook).OnApiQueryWatchlistExtractOutputData(module, watchedItem, recentChangeInfo, vals)
+	})
+}
+
+/**
+ * Populate the options
+ * to be passed from ApiQueryWatchlist to WatchedItemQueryService.
+ */
+type ApiQueryWatchlistPrepareWatchedItemQueryServiceOptionsHook interface {
+	/**
+	 * @param mixed $module ApiQueryWatchlist instance
+	 * @param mixed $params Array of parameters, as would be returned by $module->extractRequestParams()
+	 * @param mixed &$options Array of options for WatchedItemQueryService::getWatchedItemsWithRecentChangeInfo()
+	 * @return bool False to abort the hook
+	 */
+	OnApiQueryWatchlistPrepareWatchedItemQueryServiceOptions(module interface{}, params interface{}, options *interface{}) bool
+}
+
+/**
+ * Run the ApiQueryWatchlistPrepareWatchedItemQueryServiceOptions hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiQueryWatchlistPrepareWatchedItemQueryServiceOptions(module interface{}, params interface{}, options *interface{}) bool {
+	args := []interface{}{module, params, options}
+	return r.container.run("ApiQueryWatchlistPrepareWatchedItemQueryServiceOptions", args, func(handler interface{}) bool {
+		return handler.(ApiQueryWatchlistPrepareWatchedItemQueryServiceOptionsHook).OnApiQueryWatchlistPrepareWatchedItemQueryServiceOptions(module, params, options)
+	})
+}
+
+/**
+ * Add or remove APIs from the RSD services list. Each service
+ * should have its own entry in the $apis array and have a unique name, passed as
+ * key for the array that represents the service data. In this data array, the
+ * key-value-pair identified by the apiLink key is required.
+ */
+type ApiRsdServiceApisHook interface {
+	/**
+	 * @param mixed &$apis array of services
+	 * @return bool False to abort the hook
+	 */
+	OnApiRsdServiceApis(apis *interface{}) bool
+}
+
+/**
+ * Run the ApiRsdServiceApis hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiRsdServiceApis(apis *interface{}) bool {
+	args := []interface{}{apis}
+	return r.container.run("ApiRsdServiceApis", args, func(handler interface{}) bool {
+		return handler.(ApiRsdServiceApisHook).OnApiRsdServiceApis(apis)
+	})
+}
+
+/**
+ * DEPRECATED since 1.24! Use ApiQueryTokensRegisterTypes instead.
+ * Use this hook to extend action=tokens with new token types.
+ */
+type ApiTokensGetTokenTypesHook interface {
+	/**
+	 * @param mixed &$tokenTypes supported token types in format 'type' => callback function used to retrieve this type of tokens.
+	 * @return bool False to abort the hook
+	 */
+	OnApiTokensGetTokenTypes(tokenTypes *interface{}) bool
+}
+
+/**
+ * Run the ApiTokensGetTokenTypes hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiTokensGetTokenTypes(tokenTypes *interface{}) bool {
+	args := []interface{}{tokenTypes}
+	return r.container.run("ApiTokensGetTokenTypes", args, func(handler interface{}) bool {
+		return handler.(ApiTokensGetTokenTypesHook).OnApiTokensGetTokenTypes(tokenTypes)
+	})
+}
+
+/**
+ * Called from ApiValidatePassword.
+ */
+type ApiValidatePasswordHook interface {
+	/**
+	 * @param mixed $module ApiValidatePassword instance.
+	 * @param mixed &$r Result array.
+	 * @return bool False to abort the hook
+	 */
+	OnApiValidatePassword(module interface{}, rParam *interface{}) bool
+}
+
+/**
+ * Run the ApiValidatePassword hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnApiValidatePassword(module interface{}, rParam *interface{}) bool {
+	args := []interface{}{module, rParam}
+	return r.container.run("ApiValidatePassword", args, func(handler interface{}) bool {
+		return handler.(ApiValidatePasswordHook).OnApiValidatePassword(module, rParam)
+	})
+}
+
+/**
+ * Before fetching deletion & move log entries
+ * to display a message of a non-existing page being deleted/moved, give extensions
+ * a chance to hide their (unrelated) log entries.
+ */
+type Article__MissingArticleConditionsHook interface {
+	/**
+	 * @param mixed &$conds Array of query conditions (all of which have to be met; conditions will AND in the final query)
+	 * @param mixed $logTypes Array of log types being queried
+	 * @return bool False to abort the hook
+	 */
+	OnArticle__MissingArticleConditions(conds *interface{}, logTypes interface{}) bool
+}
+
+/**
+ * Run the Article::MissingArticleConditions hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticle__MissingArticleConditions(conds *interface{}, logTypes interface{}) bool {
+	args := []interface{}{conds, logTypes}
+	return r.container.run("Article::MissingArticleConditions", args, func(handler interface{}) bool {
+		return handler.(Article__MissingArticleConditionsHook).OnArticle__MissingArticleConditions(conds, logTypes)
+	})
+}
+
+/**
+ * DEPRECATED since 1.32, use ArticleRevisionViewCustom
+ * to control output. After fetching content of an article from the database.
+ */
+type ArticleAfterFetchContentObjectHook interface {
+	/**
+	 * @param mixed &$article the article (object) being loaded from the database
+	 * @param mixed &$content the content of the article, as a Content object
+	 * @return bool False to abort the hook
+	 */
+	OnArticleAfterFetchContentObject(article *interface{}, content *interface{}) bool
+}
+
+/**
+ * Run the ArticleAfterFetchContentObject hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleAfterFetchContentObject(article *interface{}, content *interface{}) bool {
+	args := []interface{}{article, content}
+	return r.container.run("ArticleAfterFetchContentObject", args, func(handler interface{}) bool {
+		return handler.(ArticleAfterFetchContentObjectHook).OnArticleAfterFetchContentObject(article, content)
+	})
+}
+
+/**
+ * Before writing the confirmation form for article
+ * deletion.
+ */
+type ArticleConfirmDeleteHook interface {
+	/**
+	 * @param mixed $article the article (object) being deleted
+	 * @param mixed $output the OutputPage object
+	 * @param mixed &$reason the reason (string) the article is being deleted
+	 * @return bool False to abort the hook
+	 */
+	OnArticleConfirmDelete(article interface{}, output interface{}, reason *interface{}) bool
+}
+
+/**
+ * Run the ArticleConfirmDelete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleConfirmDelete(article interface{}, output interface{}, reason *interface{}) bool {
+	args := []interface{}{article, output, reason}
+	return r.container.run("ArticleConfirmDelete", args, func(handler interface{}) bool {
+		return handler.(ArticleConfirmDeleteHook).OnArticleConfirmDelete(article, output, reason)
+	})
+}
+
+/**
+ * Before showing the article content below a diff. Use
+ * this to change the content in this area or how it is loaded.
+ */
+type ArticleContentOnDiffHook interface {
+	/**
+	 * @param mixed $diffEngine the DifferenceEngine
+	 * @param mixed $output the OutputPage object
+	 * @return bool False to abort the hook
+	 */
+	OnArticleContentOnDiff(diffEngine interface{}, output interface{}) bool
+}
+
+/**
+ * Run the ArticleContentOnDiff hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleContentOnDiff(diffEngine interface{}, output interface{}) bool {
+	args := []interface{}{diffEngine, output}
+	return r.container.run("ArticleContentOnDiff", args, func(handler interface{}) bool {
+		return handler.(ArticleContentOnDiffHook).OnArticleContentOnDiff(diffEngine, output)
+	})
+}
+
+/**
+ * DEPRECATED since 1.32, use ArticleRevisionViewCustom instead,
+ * or provide an appropriate ContentHandler. Allows to output the text of the article in a
+ * different format than wikitext.
+ */
+type ArticleContentViewCustomHook interface {
+	/**
+	 * @param mixed $content content of the page, as a Content object
+	 * @param mixed $title title of the page
+	 * @param mixed $output a ParserOutput object
+	 * @return bool False to abort the hook
+	 */
+	OnArticleContentViewCustom(content interface{}, title interface{}, output interface{}) bool
+}
+
+/**
+ * Run the ArticleContentViewCustom hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleContentViewCustom(content interface{}, title interface{}, output interface{}) bool {
+	args := []interface{}{content, title, output}
+	return r.container.run("ArticleContentViewCustom", args, func(handler interface{}) bool {
+		return handler.(ArticleContentViewCustomHook).OnArticleContentViewCustom(content, title, output)
+	})
+}
+
+/**
+ * Before an article is deleted.
+ */
+type ArticleDeleteHook interface {
+	/**
+	 * @param mixed &$wikiPage the WikiPage (object) being deleted
+	 * @param mixed &$user the user (object) deleting the article
+	 * @param mixed &$reason the reason (string) the article is being deleted
+	 * @param mixed &$error if the deletion was prohibited, the (raw HTML) error message to display (added in 1.13)
+	 * @param mixed &$status Status object, modify this to throw an error. Overridden by $error (added in 1.20)
+	 * @param mixed $suppress Whether this is a suppression deletion or not (added in 1.27)
+	 * @return bool False to abort the hook
+	 */
+	OnArticleDelete(wikiPage *interface{}, user *interface{}, reason *interface{}, error *interface{}, status *interface{}, suppress interface{}) bool
+}
+
+/**
+ * Run the ArticleDelete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleDelete(wikiPage *interface{}, user *interface{}, reason *interface{}, error *interface{}, status *interface{}, suppress interface{}) bool {
+	args := []interface{}{wikiPage, user, reason, error, status, suppress}
+	return r.container.run("ArticleDelete", args, func(handler interface{}) bool {
+		return handler.(ArticleDeleteHook).OnArticleDelete(wikiPage, user, reason, error, status, suppress)
+	})
+}
+
+/**
+ * Output after an article has been deleted.
+ */
+type ArticleDeleteAfterSuccessHook interface {
+	/**
+	 * @param mixed $title Title of the article that has been deleted.
+	 * @param mixed $outputPage OutputPage that can be used to append the output.
+	 * @return bool False to abort the hook
+	 */
+	OnArticleDeleteAfterSuccess(title interface{}, outputPage interface{}) bool
+}
+
+/**
+ * Run the ArticleDeleteAfterSuccess hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleDeleteAfterSuccess(title interface{}, outputPage interface{}) bool {
+	args := []interface{}{title, outputPage}
+	return r.container.run("ArticleDeleteAfterSuccess", args, func(handler interface{}) bool {
+		return handler.(ArticleDeleteAfterSuccessHook).OnArticleDeleteAfterSuccess(title, outputPage)
+	})
+}
+
+/**
+ * After an article is deleted.
+ */
+type ArticleDeleteCompleteHook interface {
+	/**
+	 * @param mixed &$wikiPage the WikiPage that was deleted
+	 * @param mixed &$user the user that deleted the article
+	 * @param mixed $reason the reason the article was deleted
+	 * @param mixed $id id of the article that was deleted
+	 * @param mixed $content the Content of the deleted page (or null, when deleting a broken page)
+	 * @param mixed $logEntry the ManualLogEntry used to record the deletion
+	 * @param mixed $archivedRevisionCount the number of revisions archived during the deletion
+	 * @return bool False to abort the hook
+	 */
+	OnArticleDeleteComplete(wikiPage *interface{}, user *interface{}, reason interface{}, id interface{}, content interface{}, logEntry interface{}, archivedRevisionCount interface{}) bool
+}
+
+/**
+ * Run the ArticleDeleteComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleDeleteComplete(wikiPage *interface{}, user *interface{}, reason interface{}, id interface{}, content interface{}, logEntry interface{}, archivedRevisionCount interface{}) bool {
+	args := []interface{}{wikiPage, user, reason, id, content, logEntry, archivedRevisionCount}
+	return r.container.run("ArticleDeleteComplete", args, func(handler interface{}) bool {
+		return handler.(ArticleDeleteCompleteHook).OnArticleDeleteComplete(wikiPage, user, reason, id, content, logEntry, archivedRevisionCount)
+	})
+}
+
+/**
+ * Before updating user_newtalk when a user talk page
+ * was changed.
+ */
+type ArticleEditUpdateNewTalkHook interface {
+	/**
+	 * @param mixed &$wikiPage WikiPage (object) of the user talk page
+	 * @param mixed $recipient User (object) who's talk page was edited
+	 * @return bool False to abort the hook
+	 */
+	OnArticleEditUpdateNewTalk(wikiPage *interface{}, recipient interface{}) bool
+}
+
+/**
+ * Run the ArticleEditUpdateNewTalk hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleEditUpdateNewTalk(wikiPage *interface{}, recipient interface{}) bool {
+	args := []interface{}{wikiPage, recipient}
+	return r.container.run("ArticleEditUpdateNewTalk", args, func(handler interface{}) bool {
+		return handler.(ArticleEditUpdateNewTalkHook).OnArticleEditUpdateNewTalk(wikiPage, recipient)
+	})
+}
+
+/**
+ * When edit updates (mainly link tracking) are made when an
+ * article has been changed.
+ */
+type ArticleEditUpdatesHook interface {
+	/**
+	 * @param mixed &$wikiPage the WikiPage (object)
+	 * @param mixed &$editInfo data holder that includes the parser output ($editInfo->output) for that page after the change
+	 * @param mixed $changed bool for if the page was changed
+	 * @return bool False to abort the hook
+	 */
+	OnArticleEditUpdates(wikiPage *interface{}, editInfo *interface{}, changed interface{}) bool
+}
+
+/**
+ * Run the ArticleEditUpdates hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleEditUpdates(wikiPage *interface{}, editInfo *interface{}, changed interface{}) bool {
+	args := []interface{}{wikiPage, editInfo, changed}
+	return r.container.run("ArticleEditUpdates", args, func(handler interface{}) bool {
+		return handler.(ArticleEditUpdatesHook).OnArticleEditUpdates(wikiPage, editInfo, changed)
+	})
+}
+
+/**
+ * Before deleting old entries from
+ * recentchanges table, return false to not delete old entries.
+ */
+type ArticleEditUpdatesDeleteFromRecentchangesHook interface {
+	/**
+	 * @param mixed &$wikiPage WikiPage (object) being modified
+	 * @return bool False to abort the hook
+	 */
+	OnArticleEditUpdatesDeleteFromRecentchanges(wikiPage *interface{}) bool
+}
+
+/**
+ * Run the ArticleEditUpdatesDeleteFromRecentchanges hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleEditUpdatesDeleteFromRecentchanges(wikiPage *interface{}) bool {
+	args := []interface{}{wikiPage}
+	return r.container.run("ArticleEditUpdatesDeleteFromRecentchanges", args, func(handler interface{}) bool {
+		return handler.(ArticleEditUpdatesDeleteFromRecentchangesHook).OnArticleEditUpdatesDeleteFromRecentchanges(wikiPage)
+	})
+}
+
+/**
+ * when creating an article object from a title object using
+ * Wiki::articleFromTitle().
+ */
+type ArticleFromTitleHook interface {
+	/**
+	 * @param mixed &$title Title (object) used to create the article object
+	 * @param mixed &$article Article (object) that will be returned
+	 * @param mixed $context IContextSource (object)
+	 * @return bool False to abort the hook
+	 */
+	OnArticleFromTitle(title *interface{}, article *interface{}, context interface{}) bool
+}
+
+/**
+ * Run the ArticleFromTitle hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleFromTitle(title *interface{}, article *interface{}, context interface{}) bool {
+	args := []interface{}{title, article, context}
+	return r.container.run("ArticleFromTitle", args, func(handler interface{}) bool {
+		return handler.(ArticleFromTitleHook).OnArticleFromTitle(title, article, context)
+	})
+}
+
+/**
+ * After merging to article using Special:Mergehistory.
+ */
+type ArticleMergeCompleteHook interface {
+	/**
+	 * @param mixed $targetTitle target title (object)
+	 * @param mixed $destTitle destination title (object)
+	 * @return bool False to abort the hook
+	 */
+	OnArticleMergeComplete(targetTitle interface{}, destTitle interface{}) bool
+}
+
+/**
+ * Run the ArticleMergeComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleMergeComplete(targetTitle interface{}, destTitle interface{}) bool {
+	args := []interface{}{targetTitle, destTitle}
+	return r.container.run("ArticleMergeComplete", args, func(handler interface{}) bool {
+		return handler.(ArticleMergeCompleteHook).OnArticleMergeComplete(targetTitle, destTitle)
+	})
+}
+
+/**
+ * After loading data of an article from the database.
+ */
+type ArticlePageDataAfterHook interface {
+	/**
+	 * @param mixed &$wikiPage WikiPage (object) whose data were loaded
+	 * @param mixed &$row row (object) returned from the database server
+	 * @return bool False to abort the hook
+	 */
+	OnArticlePageDataAfter(wikiPage *interface{}, row *interface{}) bool
+}
+
+/**
+ * Run the ArticlePageDataAfter hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticlePageDataAfter(wikiPage *interface{}, row *interface{}) bool {
+	args := []interface{}{wikiPage, row}
+	return r.container.run("ArticlePageDataAfter", args, func(handler interface{}) bool {
+		return handler.(ArticlePageDataAfterHook).OnArticlePageDataAfter(wikiPage, row)
+	})
+}
+
+/**
+ * Before loading data of an article from the database.
+ */
+type ArticlePageDataBeforeHook interface {
+	/**
+	 * @param mixed &$wikiPage WikiPage (object) that data will be loaded
+	 * @param mixed &$fields fields (array) to load from the database
+	 * @param mixed &$tables tables (array) to load from the database
+	 * @param mixed &$joinConds join conditions (array) to load from the database
+	 * @return bool False to abort the hook
+	 */
+	OnArticlePageDataBefore(wikiPage *interface{}, fields *interface{}, tables *interface{}, joinConds *interface{}) bool
+}
+
+/**
+ * Run the ArticlePageDataBefore hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticlePageDataBefore(wikiPage *interface{}, fields *interface{}, tables *interface{}, joinConds *interface{}) bool {
+	args := []interface{}{wikiPage, fields, tables, joinConds}
+	return r.container.run("ArticlePageDataBefore", args, func(handler interface{}) bool {
+		return handler.(ArticlePageDataBeforeHook).OnArticlePageDataBefore(wikiPage, fields, tables, joinConds)
+	})
+}
+
+/**
+ * Called when preparing text to be saved.
+ */
+type ArticlePrepareTextForEditHook interface {
+	/**
+	 * @param mixed $wikiPage the WikiPage being saved
+	 * @param mixed $popts parser options to be used for pre-save transformation
+	 * @return bool False to abort the hook
+	 */
+	OnArticlePrepareTextForEdit(wikiPage interface{}, popts interface{}) bool
+}
+
+/**
+ * Run the ArticlePrepareTextForEdit hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticlePrepareTextForEdit(wikiPage interface{}, popts interface{}) bool {
+	args := []interface{}{wikiPage, popts}
+	return r.container.run("ArticlePrepareTextForEdit", args, func(handler interface{}) bool {
+		return handler.(ArticlePrepareTextForEditHook).OnArticlePrepareTextForEdit(wikiPage, popts)
+	})
+}
+
+/**
+ * Before an article is protected.
+ */
+type ArticleProtectHook interface {
+	/**
+	 * @param mixed &$wikiPage the WikiPage being protected
+	 * @param mixed &$user the user doing the protection
+	 * @param mixed $protect Set of restriction keys
+	 * @param mixed $reason Reason for protect
+	 * @return bool False to abort the hook
+	 */
+	OnArticleProtect(wikiPage *interface{}, user *interface{}, protect interface{}, reason interface{}) bool
+}
+
+/**
+ * Run the ArticleProtect hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleProtect(wikiPage *interface{}, user *interface{}, protect interface{}, reason interface{}) bool {
+	args := []interface{}{wikiPage, user, protect, reason}
+	return r.container.run("ArticleProtect", args, func(handler interface{}) bool {
+		return handler.(ArticleProtectHook).OnArticleProtect(wikiPage, user, protect, reason)
+	})
+}
+
+/**
+ * After an article is protected.
+ */
+type ArticleProtectCompleteHook interface {
+	/**
+	 * @param mixed &$wikiPage the WikiPage that was protected
+	 * @param mixed &$user the user who did the protection
+	 * @param mixed $protect Set of restriction keys
+	 * @param mixed $reason Reason for protect
+	 * @return bool False to abort the hook
+	 */
+	OnArticleProtectComplete(wikiPage *interface{}, user *interface{}, protect interface{}, reason interface{}) bool
+}
+
+/**
+ * Run the ArticleProtectComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleProtectComplete(wikiPage *interface{}, user *interface{}, protect interface{}, reason interface{}) bool {
+	args := []interface{}{wikiPage, user, protect, reason}
+	return r.container.run("ArticleProtectComplete", args, func(handler interface{}) bool {
+		return handler.(ArticleProtectCompleteHook).OnArticleProtectComplete(wikiPage, user, protect, reason)
+	})
+}
+
+/**
+ * Before executing "&action=purge".
+ */
+type ArticlePurgeHook interface {
+	/**
+	 * @param mixed &$wikiPage WikiPage (object) to purge
+	 * @return bool False to abort the hook
+	 */
+	OnArticlePurge(wikiPage *interface{}) bool
+}
+
+/**
+ * Run the ArticlePurge hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticlePurge(wikiPage *interface{}) bool {
+	args := []interface{}{wikiPage}
+	return r.container.run("ArticlePurge", args, func(handler interface{}) bool {
+		return handler.(ArticlePurgeHook).OnArticlePurge(wikiPage)
+	})
+}
+
+/**
+ * After an article revision is restored.
+ */
+type ArticleRevisionUndeletedHook interface {
+	/**
+	 * @param mixed &$title the article title
+	 * @param mixed $revision the revision
+	 * @param mixed $oldPageID the page ID of the revision when archived (may be null)
+	 * @return bool False to abort the hook
+	 */
+	OnArticleRevisionUndeleted(title *interface{}, revision interface{}, oldPageID interface{}) bool
+}
+
+/**
+ * Run the ArticleRevisionUndeleted hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleRevisionUndeleted(title *interface{}, revision interface{}, oldPageID interface{}) bool {
+	args := []interface{}{title, revision, oldPageID}
+	return r.container.run("ArticleRevisionUndeleted", args, func(handler interface{}) bool {
+		return handler.(ArticleRevisionUndeletedHook).OnArticleRevisionUndeleted(title, revision, oldPageID)
+	})
+}
+
+/**
+ * Allows custom rendering of an article's content.
+ * Note that it is preferable to implement proper handing for a custom data type using
+ * the ContentHandler facility.
+ */
+type ArticleRevisionViewCustomHook interface {
+	/**
+	 * @param mixed $revision content of the page, as a RevisionRecord object, or null if the revision could not be loaded. May also be a fake that wraps content supplied by an extension.
+	 * @param mixed $title title of the page
+	 * @param mixed $oldid the requested revision id, or 0 for the currrent revision.
+	 * @param mixed $output a ParserOutput object
+	 * @return bool False to abort the hook
+	 */
+	OnArticleRevisionViewCustom(revision interface{}, title interface{}, oldid interface{}, output interface{}) bool
+}
+
+/**
+ * Run the ArticleRevisionViewCustom hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleRevisionViewCustom(revision interface{}, title interface{}, oldid interface{}, output interface{}) bool {
+	args := []interface{}{revision, title, oldid, output}
+	return r.container.run("ArticleRevisionViewCustom", args, func(handler interface{}) bool {
+		return handler.(ArticleRevisionViewCustomHook).OnArticleRevisionViewCustom(revision, title, oldid, output)
+	})
+}
+
+/**
+ * Called when changing visibility of one or more
+ * revisions of an article.
+ */
+type ArticleRevisionVisibilitySetHook interface {
+	/**
+	 * @param mixed $title Title object of the article
+	 * @param mixed $ids Ids to set the visibility for
+	 * @param mixed $visibilityChangeMap Map of revision id to oldBits and newBits.  This array can be examined to determine exactly what visibility bits have changed for each revision.  This array is of the form [id => ['oldBits' => $oldBits, 'newBits' => $newBits], ... ]
+	 * @return bool False to abort the hook
+	 */
+	OnArticleRevisionVisibilitySet(title interface{}, ids interface{}, visibilityChangeMap interface{}) bool
+}
+
+/**
+ * Run the ArticleRevisionVisibilitySet hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleRevisionVisibilitySet(title interface{}, ids interface{}, visibilityChangeMap interface{}) bool {
+	args := []interface{}{title, ids, visibilityChangeMap}
+	return r.container.run("ArticleRevisionVisibilitySet", args, func(handler interface{}) bool {
+		return handler.(ArticleRevisionVisibilitySetHook).OnArticleRevisionVisibilitySet(title, ids, visibilityChangeMap)
+	})
+}
+
+/**
+ * After an article rollback is completed.
+ */
+type ArticleRollbackCompleteHook interface {
+	/**
+	 * @param mixed $wikiPage the WikiPage that was edited
+	 * @param mixed $user the user who did the rollback
+	 * @param mixed $revision the revision the page was reverted back to
+	 * @param mixed $current the reverted revision
+	 * @return bool False to abort the hook
+	 */
+	OnArticleRollbackComplete(wikiPage interface{}, user interface{}, revision interface{}, current interface{}) bool
+}
+
+/**
+ * Run the ArticleRollbackComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleRollbackComplete(wikiPage interface{}, user interface{}, revision interface{}, current interface{}) bool {
+	args := []interface{}{wikiPage, user, revision, current}
+	return r.container.run("ArticleRollbackComplete", args, func(handler interface{}) bool {
+		return handler.(ArticleRollbackCompleteHook).OnArticleRollbackComplete(wikiPage, user, revision, current)
+	})
+}
+
+/**
+ * Called at the beginning of Article#showPatrolFooter.
+ * Extensions can use this to not show the [mark as patrolled] link in certain
+ * circumstances.
+ */
+type ArticleShowPatrolFooterHook interface {
+	/**
+	 * @param mixed $article the Article object
+	 * @return bool False to abort the hook
+	 */
+	OnArticleShowPatrolFooter(article interface{}) bool
+}
+
+/**
+ * Run the ArticleShowPatrolFooter hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleShowPatrolFooter(article interface{}) bool {
+	args := []interface{}{article}
+	return r.container.run("ArticleShowPatrolFooter", args, func(handler interface{}) bool {
+		return handler.(ArticleShowPatrolFooterHook).OnArticleShowPatrolFooter(article)
+	})
+}
+
+/**
+ * When one or more revisions of an article are restored.
+ */
+type ArticleUndeleteHook interface {
+	/**
+	 * @param mixed &$title Title corresponding to the article restored
+	 * @param mixed $create Whether or not the restoration caused the page to be created (i.e. it didn't exist before).
+	 * @param mixed $comment The comment associated with the undeletion.
+	 * @param mixed $oldPageId ID of page previously deleted (from archive table). This ID will be used for the restored page.
+	 * @param mixed $restoredPages Set of page IDs that have revisions restored for this undelete, with keys being page IDs and values are 'true'.
+	 * @return bool False to abort the hook
+	 */
+	OnArticleUndelete(title *interface{}, create interface{}, comment interface{}, oldPageId interface{}, restoredPages interface{}) bool
+}
+
+/**
+ * Run the ArticleUndelete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleUndelete(title *interface{}, create interface{}, comment interface{}, oldPageId interface{}, restoredPages interface{}) bool {
+	args := []interface{}{title, create, comment, oldPageId, restoredPages}
+	return r.container.run("ArticleUndelete", args, func(handler interface{}) bool {
+		return handler.(ArticleUndeleteHook).OnArticleUndelete(title, create, comment, oldPageId, restoredPages)
+	})
+}
+
+/**
+ * When a log entry is generated but not yet saved.
+ */
+type ArticleUndeleteLogEntryHook interface {
+	/**
+	 * @param mixed $pageArchive the PageArchive object
+	 * @param mixed &$logEntry ManualLogEntry object
+	 * @param mixed $user User who is performing the log action
+	 * @return bool False to abort the hook
+	 */
+	OnArticleUndeleteLogEntry(pageArchive interface{}, logEntry *interface{}, user interface{}) bool
+}
+
+/**
+ * Run the ArticleUndeleteLogEntry hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleUndeleteLogEntry(pageArchive interface{}, logEntry *interface{}, user interface{}) bool {
+	args := []interface{}{pageArchive, logEntry, user}
+	return r.container.run("ArticleUndeleteLogEntry", args, func(handler interface{}) bool {
+		return handler.(ArticleUndeleteLogEntryHook).OnArticleUndeleteLogEntry(pageArchive, logEntry, user)
+	})
+}
+
+/**
+ * After a page is updated (usually on save), before
+ * the user is redirected back to the page.
+ */
+type ArticleUpdateBeforeRedirectHook interface {
+	/**
+	 * @param mixed $article the article
+	 * @param mixed &$sectionanchor The section anchor link (e.g. "#overview" )
+	 * @param mixed &$extraq Extra query parameters which can be added via hooked functions
+	 * @return bool False to abort the hook
+	 */
+	OnArticleUpdateBeforeRedirect(article interface{}, sectionanchor *interface{}, extraq *interface{}) bool
+}
+
+/**
+ * Run the ArticleUpdateBeforeRedirect hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleUpdateBeforeRedirect(article interface{}, sectionanchor *interface{}, extraq *interface{}) bool {
+	args := []interface{}{article, sectionanchor, extraq}
+	return r.container.run("ArticleUpdateBeforeRedirect", args, func(handler interface{}) bool {
+		return handler.(ArticleUpdateBeforeRedirectHook).OnArticleUpdateBeforeRedirect(article, sectionanchor, extraq)
+	})
+}
+
+/**
+ * After showing the footer section of an ordinary page view
+ */
+type ArticleViewFooterHook interface {
+	/**
+	 * @param mixed $article Article object
+	 * @param mixed $patrolFooterShown boolean whether patrol footer is shown
+	 * @return bool False to abort the hook
+	 */
+	OnArticleViewFooter(article interface{}, patrolFooterShown interface{}) bool
+}
+
+/**
+ * Run the ArticleViewFooter hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleViewFooter(article interface{}, patrolFooterShown interface{}) bool {
+	args := []interface{}{article, patrolFooterShown}
+	return r.container.run("ArticleViewFooter", args, func(handler interface{}) bool {
+		return handler.(ArticleViewFooterHook).OnArticleViewFooter(article, patrolFooterShown)
+	})
+}
+
+/**
+ * Control article output. Called before the parser cache is about
+ * to be tried for article viewing.
+ */
+type ArticleViewHeaderHook interface {
+	/**
+	 * @param mixed &$article the article
+	 * @param mixed &$pcache whether to try the parser cache or not
+	 * @param mixed &$outputDone whether the output for this page finished or not. Set to a ParserOutput object to both indicate that the output is done and what parser output was used.
+	 * @return bool False to abort the hook
+	 */
+	OnArticleViewHeader(article *interface{}, pcache *interface{}, outputDone *interface{}) bool
+}
+
+/**
+ * Run the ArticleViewHeader hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleViewHeader(article *interface{}, pcache *interface{}, outputDone *interface{}) bool {
+	args := []interface{}{article, pcache, outputDone}
+	return r.container.run("ArticleViewHeader", args, func(handler interface{}) bool {
+		return handler.(ArticleViewHeaderHook).OnArticleViewHeader(article, pcache, outputDone)
+	})
+}
+
+/**
+ * Before setting "Redirected from ..." subtitle when a
+ * redirect was followed.
+ */
+type ArticleViewRedirectHook interface {
+	/**
+	 * @param mixed &$article target article (object)
+	 * @return bool False to abort the hook
+	 */
+	OnArticleViewRedirect(article *interface{}) bool
+}
+
+/**
+ * Run the ArticleViewRedirect hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnArticleViewRedirect(article *interface{}) bool {
+	args := []interface{}{article}
+	return r.container.run("ArticleViewRedirect", args, func(handler interface{}) bool {
+		return handler.(ArticleViewRedirectHook).OnArticleViewRedirect(article)
+	})
+}
+
+/**
+ * After converting a field information array obtained
+ * from a set of AuthenticationRequest classes into a form descriptor; hooks
+ * can tweak the array to change how login etc. forms should look.
+ */
+type AuthChangeFormFieldsHook interface {
+	/**
+	 * @param mixed $requests array of AuthenticationRequests the fields are created from
+	 * @param mixed $fieldInfo field information array (union of all AuthenticationRequest::getFieldInfo() responses).
+	 * @param mixed &$formDescriptor HTMLForm descriptor. The special key 'weight' can be set to change the order of the fields.
+	 * @param mixed $action one of the AuthManager::ACTION_* constants.
+	 * @return bool False to abort the hook
+	 */
+	OnAuthChangeFormFields(requests interface{}, fieldInfo interface{}, formDescriptor *interface{}, action interface{}) bool
+}
+
+/**
+ * Run the AuthChangeFormFields hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAuthChangeFormFields(requests interface{}, fieldInfo interface{}, formDescriptor *interface{}, action interface{}) bool {
+	args := []interface{}{requests, fieldInfo, formDescriptor, action}
+	return r.container.run("AuthChangeFormFields", args, func(handler interface{}) bool {
+		return handler.(AuthChangeFormFieldsHook).OnAuthChangeFormFields(requests, fieldInfo, formDescriptor, action)
+	})
+}
+
+/**
+ * A login attempt either succeeded or failed
+ * for a reason other than misconfiguration or session loss. No return data is
+ * accepted; this hook is for auditing only.
+ */
+type AuthManagerLoginAuthenticateAuditHook interface {
+	/**
+	 * @param mixed $response The MediaWiki\Auth\AuthenticationResponse in either a PASS or FAIL state.
+	 * @param mixed $user The User object being authenticated against, or null if authentication failed before getting that far.
+	 * @param mixed $username A guess at the user name being authenticated, or null if we can't even determine that.
+	 * @return bool False to abort the hook
+	 */
+	OnAuthManagerLoginAuthenticateAudit(response interface{}, user interface{}, username interface{}) bool
+}
+
+/**
+ * Run the AuthManagerLoginAuthenticateAudit hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAuthManagerLoginAuthenticateAudit(response interface{}, user interface{}, username interface{}) bool {
+	args := []interface{}{response, user, username}
+	return r.container.run("AuthManagerLoginAuthenticateAudit", args, func(handler interface{}) bool {
+		return handler.(AuthManagerLoginAuthenticateAuditHook).OnAuthManagerLoginAuthenticateAudit(response, user, username)
+	})
+}
+
+/**
+ * DEPRECATED since 1.27! Use the 'LocalUserCreated' hook
+ * instead. Called when creating a local account for an user logged in from an
+ * external authentication method.
+ */
+type AuthPluginAutoCreateHook interface {
+	/**
+	 * @param mixed $user User object created locally
+	 * @return bool False to abort the hook
+	 */
+	OnAuthPluginAutoCreate(user interface{}) bool
+}
+
+/**
+ * Run the AuthPluginAutoCreate hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAuthPluginAutoCreate(user interface{}) bool {
+	args := []interface{}{user}
+	return r.container.run("AuthPluginAutoCreate", args, func(handler interface{}) bool {
+		return handler.(AuthPluginAutoCreateHook).OnAuthPluginAutoCreate(user)
+	})
+}
+
+/**
+ * DEPRECATED since 1.27! Extensions should be updated to use
+ * AuthManager. Update or replace authentication plugin object ($wgAuth). Gives a
+ * chance for an extension to set it programmatically to a variable class.
+ */
+type AuthPluginSetupHook interface {
+	/**
+	 * @param mixed &$auth the $wgAuth object, probably a stub
+	 * @return bool False to abort the hook
+	 */
+	OnAuthPluginSetup(auth *interface{}) bool
+}
+
+/**
+ * Run the AuthPluginSetup hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAuthPluginSetup(auth *interface{}) bool {
+	args := []interface{}{auth}
+	return r.container.run("AuthPluginSetup", args, func(handler interface{}) bool {
+		return handler.(AuthPluginSetupHook).OnAuthPluginSetup(auth)
+	})
+}
+
+/**
+ * Check autopromote condition for user.
+ */
+type AutopromoteConditionHook interface {
+	/**
+	 * @param mixed $type condition type
+	 * @param mixed $args arguments
+	 * @param mixed $user user
+	 * @param mixed &$result result of checking autopromote condition
+	 * @return bool False to abort the hook
+	 */
+	OnAutopromoteCondition(typeParam interface{}, argsParam interface{}, user interface{}, result *interface{}) bool
+}
+
+/**
+ * Run the AutopromoteCondition hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnAutopromoteCondition(typeParam interface{}, argsParam interface{}, user interface{}, result *interface{}) bool {
+	args := []interface{}{typeParam, argsParam, user, result}
+	return r.container.run("AutopromoteCondition", args, func(handler interface{}) bool {
+		return handler.(AutopromoteConditionHook).OnAutopromoteCondition(typeParam, argsParam, user, result)
+	})
+}
+
+/**
+ * Allows to set conditions for query when links to
+ * certain title are fetched.
+ */
+type BacklinkCacheGetConditionsHook interface {
+	/**
+	 * @param mixed $table table name
+	 * @param mixed $title title of the page to which backlinks are sought
+	 * @param mixed &$conds query conditions
+	 * @return bool False to abort the hook
+	 */
+	OnBacklinkCacheGetConditions(table interface{}, title interface{}, conds *interface{}) bool
+}
+
+/**
+ * Run the BacklinkCacheGetConditions hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBacklinkCacheGetConditions(table interface{}, title interface{}, conds *interface{}) bool {
+	args := []interface{}{table, title, conds}
+	return r.container.run("BacklinkCacheGetConditions", args, func(handler interface{}) bool {
+		return handler.(BacklinkCacheGetConditionsHook).OnBacklinkCacheGetConditions(table, title, conds)
+	})
+}
+
+/**
+ * Allows to set prefix for a specific link table.
+ */
+type BacklinkCacheGetPrefixHook interface {
+	/**
+	 * @param mixed $table table name
+	 * @param mixed &$prefix prefix
+	 * @return bool False to abort the hook
+	 */
+	OnBacklinkCacheGetPrefix(table interface{}, prefix *interface{}) bool
+}
+
+/**
+ * Run the BacklinkCacheGetPrefix hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBacklinkCacheGetPrefix(table interface{}, prefix *interface{}) bool {
+	args := []interface{}{table, prefix}
+	return r.container.run("BacklinkCacheGetPrefix", args, func(handler interface{}) bool {
+		return handler.(BacklinkCacheGetPrefixHook).OnBacklinkCacheGetPrefix(table, prefix)
+	})
+}
+
+/**
+ * When checking against the bad image list. Change $bad and return
+ * false to override. If an image is "bad", it is not rendered inline in wiki
+ * pages or galleries in category pages.
+ */
+type BadImageHook interface {
+	/**
+	 * @param mixed $name Image name being checked
+	 * @param mixed &$bad Whether or not the image is "bad"
+	 * @return bool False to abort the hook
+	 */
+	OnBadImage(name interface{}, bad *interface{}) bool
+}
+
+/**
+ * Run the BadImage hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBadImage(name interface{}, bad *interface{}) bool {
+	args := []interface{}{name, bad}
+	return r.container.run("BadImage", args, func(handler interface{}) bool {
+		return handler.(BadImageHook).OnBadImage(name, bad)
+	})
+}
+
+/**
+ * After output of portlets, allow injecting
+ * custom HTML after the section. Any uses of the hook need to handle escaping.
+ */
+type BaseTemplateAfterPortletHook interface {
+	/**
+	 * @param mixed $template BaseTemplate
+	 * @param mixed $portlet string portlet name
+	 * @param mixed &$html string
+	 * @return bool False to abort the hook
+	 */
+	OnBaseTemplateAfterPortlet(template interface{}, portlet interface{}, html *interface{}) bool
+}
+
+/**
+ * Run the BaseTemplateAfterPortlet hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBaseTemplateAfterPortlet(template interface{}, portlet interface{}, html *interface{}) bool {
+	args := []interface{}{template, portlet, html}
+	return r.container.run("BaseTemplateAfterPortlet", args, func(handler interface{}) bool {
+		return handler.(BaseTemplateAfterPortletHook).OnBaseTemplateAfterPortlet(template, portlet, html)
+	})
+}
+
+/**
+ * Called by BaseTemplate when building the $toolbox array
+ * and returning it for the skin to output. You can add items to the toolbox while
+ * still letting the skin make final decisions on skin-specific markup conventions
+ * using this hook.
+ */
+type BaseTemplateToolboxHook interface {
+	/**
+	 * @param mixed &$sk The BaseTemplate base skin template
+	 * @param mixed &$toolbox An array of toolbox items, see BaseTemplate::getToolbox and BaseTemplate::makeListItem for details on the format of individual items inside of this array.
+	 * @return bool False to abort the hook
+	 */
+	OnBaseTemplateToolbox(sk *interface{}, toolbox *interface{}) bool
+}
+
+/**
+ * Run the BaseTemplateToolbox hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBaseTemplateToolbox(sk *interface{}, toolbox *interface{}) bool {
+	args := []interface{}{sk, toolbox}
+	return r.container.run("BaseTemplateToolbox", args, func(handler interface{}) bool {
+		return handler.(BaseTemplateToolboxHook).OnBaseTemplateToolbox(sk, toolbox)
+	})
+}
+
+/**
+ * Before displaying message key "noarticletext" or
+ * "noarticletext-nopermission" at Article::showMissingArticle().
+ */
+type BeforeDisplayNoArticleTextHook interface {
+	/**
+	 * @param mixed $article article object
+	 * @return bool False to abort the hook
+	 */
+	OnBeforeDisplayNoArticleText(article interface{}) bool
+}
+
+/**
+ * Run the BeforeDisplayNoArticleText hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBeforeDisplayNoArticleText(article interface{}) bool {
+	args := []interface{}{article}
+	return r.container.run("BeforeDisplayNoArticleText", args, func(handler interface{}) bool {
+		return handler.(BeforeDisplayNoArticleTextHook).OnBeforeDisplayNoArticleText(article)
+	})
+}
+
+/**
+ * Prior to forcing HTTP->HTTPS redirect. Gives a chance to
+ * override how the redirect is output by modifying, or by returning false, and
+ * letting standard HTTP rendering take place.
+ * ATTENTION: This hook is likely to be removed soon due to overall design of the
+ * system.
+ */
+type BeforeHttpsRedirectHook interface {
+	/**
+	 * @param mixed $context IContextSource object
+	 * @param mixed &$redirect string URL, modifiable
+	 * @return bool False to abort the hook
+	 */
+	OnBeforeHttpsRedirect(context interface{}, redirect *interface{}) bool
+}
+
+/**
+ * Run the BeforeHttpsRedirect hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBeforeHttpsRedirect(context interface{}, redirect *interface{}) bool {
+	args := []interface{}{context, redirect}
+	return r.container.run("BeforeHttpsRedirect", args, func(handler interface{}) bool {
+		return handler.(BeforeHttpsRedirectHook).OnBeforeHttpsRedirect(context, redirect)
+	})
+}
+
+/**
+ * Before anything is initialized in
+ * MediaWiki::performRequest().
+ */
+type BeforeInitializeHook interface {
+	/**
+	 * @param Title &$title Title being used for request
+	 * @param bool &$unused null
+	 * @param OutputPage &$output OutputPage object
+	 * @param languages.IUser &$user User
+	 * @param WebRequest $request WebRequest object
+	 * @param MediaWiki $mediaWiki Mediawiki object
+	 * @return bool False to abort the hook
+	 */
+	OnBeforeInitialize(title **Title, unused *bool, output **OutputPage, user *languages.IUser, request *WebRequest, mediaWiki *MediaWiki) bool
+}
+
+/**
+ * Run the BeforeInitialize hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBeforeInitialize(title **Title, unused *bool, output **OutputPage, user *languages.IUser, request *WebRequest, mediaWiki *MediaWiki) bool {
+	args := []interface{}{title, unused, output, user, request, mediaWiki}
+	return r.container.run("BeforeInitialize", args, func(handler interface{}) bool {
+		return handler.(BeforeInitializeHook).OnBeforeInitialize(title, unused, output, user, request, mediaWiki)
+	})
+}
+
+/**
+ * Prior to outputting a page.
+ */
+type BeforePageDisplayHook interface {
+	/**
+	 * @param mixed &$out OutputPage object
+	 * @param mixed &$skin Skin object
+	 * @return bool False to abort the hook
+	 */
+	OnBeforePageDisplay(out *interface{}, skin *interface{}) bool
+}
+
+/**
+ * Run the BeforePageDisplay hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBeforePageDisplay(out *interface{}, skin *interface{}) bool {
+	args := []interface{}{out, skin}
+	return r.container.run("BeforePageDisplay", args, func(handler interface{}) bool {
+		return handler.(BeforePageDisplayHook).OnBeforePageDisplay(out, skin)
+	})
+}
+
+/**
+ * Prior to sending an HTTP redirect. Gives a chance to
+ * override how the redirect is output by modifying, or by returning false and
+ * taking over the output.
+ */
+type BeforePageRedirectHook interface {
+	/**
+	 * @param mixed $out OutputPage object
+	 * @param mixed &$redirect URL, modifiable
+	 * @param mixed &$code HTTP code (eg '301' or '302'), modifiable
+	 * @return bool False to abort the hook
+	 */
+	OnBeforePageRedirect(out interface{}, redirect *interface{}, code *interface{}) bool
+}
+
+/**
+ * Run the BeforePageRedirect hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBeforePageRedirect(out interface{}, redirect *interface{}, code *interface{}) bool {
+	args := []interface{}{out, redirect, code}
+	return r.container.run("BeforePageRedirect", args, func(handler interface{}) bool {
+		return handler.(BeforePageRedirectHook).OnBeforePageRedirect(out, redirect, code)
+	})
+}
+
+/**
+ * Before an image is rendered by Parser.
+ */
+type BeforeParserFetchFileAndTitleHook interface {
+	/**
+	 * @param mixed $parser Parser object
+	 * @param mixed $nt the image title
+	 * @param mixed &$options array of options to RepoGroup::findFile. If it contains 'broken' as a key then the file will appear as a broken thumbnail.
+	 * @param mixed &$descQuery query string to add to thumbnail URL
+	 * @return bool False to abort the hook
+	 */
+	OnBeforeParserFetchFileAndTitle(parser interface{}, nt interface{}, options *interface{}, descQuery *interface{}) bool
+}
+
+/**
+ * Run the BeforeParserFetchFileAndTitle hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBeforeParserFetchFileAndTitle(parser interface{}, nt interface{}, options *interface{}, descQuery *interface{}) bool {
+	args := []interface{}{parser, nt, options, descQuery}
+	return r.container.run("BeforeParserFetchFileAndTitle", args, func(handler interface{}) bool {
+		return handler.(BeforeParserFetchFileAndTitleHook).OnBeforeParserFetchFileAndTitle(parser, nt, options, descQuery)
+	})
+}
+
+/**
+ * Before a template is fetched by Parser.
+ */
+type BeforeParserFetchTemplateAndtitleHook interface {
+	/**
+	 * @param mixed $parser Parser object
+	 * @param mixed $title title of the template
+	 * @param mixed &$skip skip this template and link it?
+	 * @param mixed &$id the id of the revision being parsed
+	 * @return bool False to abort the hook
+	 */
+	OnBeforeParserFetchTemplateAndtitle(parser interface{}, title interface{}, skip *interface{}, id *interface{}) bool
+}
+
+/**
+ * Run the BeforeParserFetchTemplateAndtitle hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBeforeParserFetchTemplateAndtitle(parser interface{}, title interface{}, skip *interface{}, id *interface{}) bool {
+	args := []interface{}{parser, title, skip, id}
+	return r.container.run("BeforeParserFetchTemplateAndtitle", args, func(handler interface{}) bool {
+		return handler.(BeforeParserFetchTemplateAndtitleHook).OnBeforeParserFetchTemplateAndtitle(parser, title, skip, id)
+	})
+}
+
+/**
+ * Before an image gallery is rendered by Parser.
+ */
+type BeforeParserrenderImageGalleryHook interface {
+	/**
+	 * @param mixed &$parser Parser object
+	 * @param mixed &$ig ImageGallery object
+	 * @return bool False to abort the hook
+	 */
+	OnBeforeParserrenderImageGallery(parser *interface{}, ig *interface{}) bool
+}
+
+/**
+ * Run the BeforeParserrenderImageGallery hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBeforeParserrenderImageGallery(parser *interface{}, ig *interface{}) bool {
+	args := []interface{}{parser, ig}
+	return r.container.run("BeforeParserrenderImageGallery", args, func(handler interface{}) bool {
+		return handler.(BeforeParserrenderImageGalleryHook).OnBeforeParserrenderImageGallery(parser, ig)
+	})
+}
+
+/**
+ * Before the welcomecreation message is displayed to a
+ * newly created user.
+ */
+type BeforeWelcomeCreationHook interface {
+	/**
+	 * @param mixed &$welcome_creation_msg MediaWiki message name to display on the welcome screen to a newly created user account.
+	 * @param mixed &$injected_html Any HTML to inject after the "logged in" message of a newly created user account
+	 * @return bool False to abort the hook
+	 */
+	OnBeforeWelcomeCreation(welcome_creation_msg *interface{}, injected_html *interface{}) bool
+}
+
+/**
+ * Run the BeforeWelcomeCreation hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBeforeWelcomeCreation(welcome_creation_msg *interface{}, injected_html *interface{}) bool {
+	args := []interface{}{welcome_creation_msg, injected_html}
+	return r.container.run("BeforeWelcomeCreation", args, func(handler interface{}) bool {
+		return handler.(BeforeWelcomeCreationHook).OnBeforeWelcomeCreation(welcome_creation_msg, injected_html)
+	})
+}
+
+/**
+ * By BitmapHandler::normaliseParams, after all
+ * normalizations have been performed, except for the $wgMaxImageArea check.
+ */
+type BitmapHandlerCheckImageAreaHook interface {
+	/**
+	 * @param mixed $image File
+	 * @param mixed &$params Array of parameters
+	 * @param mixed &$checkImageAreaHookResult null, set to true or false to override the $wgMaxImageArea check result.
+	 * @return bool False to abort the hook
+	 */
+	OnBitmapHandlerCheckImageArea(image interface{}, params *interface{}, checkImageAreaHookResult *interface{}) bool
+}
+
+/**
+ * Run the BitmapHandlerCheckImageArea hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBitmapHandlerCheckImageArea(image interface{}, params *interface{}, checkImageAreaHookResult *interface{}) bool {
+	args := []interface{}{image, params, checkImageAreaHookResult}
+	return r.container.run("BitmapHandlerCheckImageArea", args, func(handler interface{}) bool {
+		return handler.(BitmapHandlerCheckImageAreaHook).OnBitmapHandlerCheckImageArea(image, params, checkImageAreaHookResult)
+	})
+}
+
+/**
+ * before a file is transformed, gives extension the
+ * possibility to transform it themselves
+ */
+type BitmapHandlerTransformHook interface {
+	/**
+	 * @param mixed $handler BitmapHandler
+	 * @param mixed $image File
+	 * @param mixed &$scalerParams Array with scaler parameters
+	 * @param mixed &$mto null, set to a MediaTransformOutput
+	 * @return bool False to abort the hook
+	 */
+	OnBitmapHandlerTransform(handlerParam interface{}, image interface{}, scalerParams *interface{}, mto *interface{}) bool
+}
+
+/**
+ * Run the BitmapHandlerTransform hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBitmapHandlerTransform(handlerParam interface{}, image interface{}, scalerParams *interface{}, mto *interface{}) bool {
+	args := []interface{}{handlerParam, image, scalerParams, mto}
+	return r.container.run("BitmapHandlerTransform", args, func(handler interface{}) bool {
+		return handler.(BitmapHandlerTransformHook).OnBitmapHandlerTransform(handlerParam, image, scalerParams, mto)
+	})
+}
+
+/**
+ * Before an IP address or user is blocked.
+ */
+type BlockIpHook interface {
+	/**
+	 * @param mixed &$block the Block object about to be saved
+	 * @param mixed &$user the user _doing_ the block (not the one being blocked)
+	 * @param mixed &$reason if the hook is aborted, the error message to be returned in an array
+	 * @return bool False to abort the hook
+	 */
+	OnBlockIp(block *interface{}, user *interface{}, reason *interface{}) bool
+}
+
+/**
+ * Run the BlockIp hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBlockIp(block *interface{}, user *interface{}, reason *interface{}) bool {
+	args := []interface{}{block, user, reason}
+	return r.container.run("BlockIp", args, func(handler interface{}) bool {
+		return handler.(BlockIpHook).OnBlockIp(block, user, reason)
+	})
+}
+
+/**
+ * After an IP address or user is blocked.
+ */
+type BlockIpCompleteHook interface {
+	/**
+	 * @param mixed $block the Block object that was saved
+	 * @param mixed $user the user who did the block (not the one being blocked)
+	 * @param mixed $priorBlock the Block object for the prior block or null if there was none
+	 * @return bool False to abort the hook
+	 */
+	OnBlockIpComplete(block interface{}, user interface{}, priorBlock interface{}) bool
+}
+
+/**
+ * Run the BlockIpComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBlockIpComplete(block interface{}, user interface{}, priorBlock interface{}) bool {
+	args := []interface{}{block, user, priorBlock}
+	return r.container.run("BlockIpComplete", args, func(handler interface{}) bool {
+		return handler.(BlockIpCompleteHook).OnBlockIpComplete(block, user, priorBlock)
+	})
+}
+
+/**
+ * Before information output on Special:Booksources.
+ */
+type BookInformationHook interface {
+	/**
+	 * @param mixed $isbn ISBN to show information for
+	 * @param mixed $output OutputPage object in use
+	 * @return bool False to abort the hook
+	 */
+	OnBookInformation(isbn interface{}, output interface{}) bool
+}
+
+/**
+ * Run the BookInformation hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnBookInformation(isbn interface{}, output interface{}) bool {
+	args := []interface{}{isbn, output}
+	return r.container.run("BookInformation", args, func(handler interface{}) bool {
+		return handler.(BookInformationHook).OnBookInformation(isbn, output)
+	})
+}
+
+/**
+ * Determine whether the client at a given source IP is likely
+ * to be able to access the wiki via HTTPS.
+ */
+type CanIPUseHTTPSHook interface {
+	/**
+	 * @param mixed $ip The IP address in human-readable form
+	 * @param mixed &$canDo This reference should be set to false if the client may not be able to use HTTPS
+	 * @return bool False to abort the hook
+	 */
+	OnCanIPUseHTTPS(ip interface{}, canDo *interface{}) bool
+}
+
+/**
+ * Run the CanIPUseHTTPS hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnCanIPUseHTTPS(ip interface{}, canDo *interface{}) bool {
+	args := []interface{}{ip, canDo}
+	return r.container.run("CanIPUseHTTPS", args, func(handler interface{}) bool {
+		return handler.(CanIPUseHTTPSHook).OnCanIPUseHTTPS(ip, canDo)
+	})
+}
+
+/**
+ * For extensions adding their own namespaces or altering
+ * the defaults.
+ * Note that if you need to specify namespace protection or content model for
+ * a namespace that is added in a CanonicalNamespaces hook handler, you
+ * should do so by altering $wgNamespaceProtection and
+ * $wgNamespaceContentModels outside the handler, in top-level scope. The
+ * point at which the CanonicalNamespaces hook fires is too late for altering
+ * these variables. This applies even if the namespace addition is
+ * conditional; it is permissible to declare a content model and protection
+ * for a namespace and then decline to actually register it.
+ */
+type CanonicalNamespacesHook interface {
+	/**
+	 * @param map[int]string &$namespaces Array of namespace numbers with corresponding canonical names
+	 * @return bool False to abort the hook
+	 */
+	OnCanonicalNamespaces(namespaces *map[int]string) bool
+}
+
+/**
+ * Run the CanonicalNamespaces hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnCanonicalNamespaces(namespaces *map[int]string) bool {
+	args := []interface{}{namespaces}
+	return r.container.run("CanonicalNamespaces", args, func(handler interface{}) bool {
+		return handler.(CanonicalNamespacesHook).OnCanonicalNamespaces(namespaces)
+	})
+}
+
+/**
+ * After a page is added to a category.
+ */
+type CategoryAfterPageAddedHook interface {
+	/**
+	 * @param mixed $category Category that page was added to
+	 * @param mixed $wikiPage WikiPage that was added
+	 * @return bool False to abort the hook
+	 */
+	OnCategoryAfterPageAdded(category interface{}, wikiPage interface{}) bool
+}
+
+/**
+ * Run the CategoryAfterPageAdded hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnCategoryAfterPageAdded(category interface{}, wikiPage interface{}) bool {
+	args := []interface{}{category, wikiPage}
+	return r.container.run("CategoryAfterPageAdded", args, func(handler interface{}) bool {
+		return handler.(CategoryAfterPageAddedHook).OnCategoryAfterPageAdded(category, wikiPage)
+	})
+}
+
+/**
+ * After a page is removed from a category.
+ */
+type CategoryAfterPageRemovedHook interface {
+	/**
+	 * @param mixed $category Category that page was removed from
+	 * @param mixed $wikiPage WikiPage that was removed
+	 * @param mixed $id the page ID (original ID in case of page deletions)
+	 * @return bool False to abort the hook
+	 */
+	OnCategoryAfterPageRemoved(category interface{}, wikiPage interface{}, id interface{}) bool
+}
+
+/**
+ * Run the CategoryAfterPageRemoved hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnCategoryAfterPageRemoved(category interface{}, wikiPage interface{}, id interface{}) bool {
+	args := []interface{}{category, wikiPage, id}
+	return r.container.run("CategoryAfterPageRemoved", args, func(handler interface{}) bool {
+		return handler.(CategoryAfterPageRemovedHook).OnCategoryAfterPageRemoved(category, wikiPage, id)
+	})
+}
+
+/**
+ * Before viewing a categorypage in CategoryPage::view.
+ */
+type CategoryPageViewHook interface {
+	/**
+	 * @param mixed &$catpage CategoryPage instance
+	 * @return bool False to abort the hook
+	 */
+	OnCategoryPageView(catpage *interface{}) bool
+}
+
+/**
+ * Run the CategoryPageView hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnCategoryPageView(catpage *interface{}) bool {
+	args := []interface{}{catpage}
+	return r.container.run("CategoryPageView", args, func(handler interface{}) bool {
+		return handler.(CategoryPageViewHook).OnCategoryPageView(catpage)
+	})
+}
+
+/**
+ * After querying for pages to be displayed
+ * in a Category page. Gives extensions the opportunity to batch load any
+ * related data about the pages.
+ */
+type CategoryViewer__doCategoryQueryHook interface {
+	/**
+	 * @param mixed $type The category type. Either 'page', 'file' or 'subcat'
+	 * @param mixed $res Query result from Wikimedia\Rdbms\IDatabase::select()
+	 * @return bool False to abort the hook
+	 */
+	OnCategoryViewer__doCategoryQuery(typeParam interface{}, res interface{}) bool
+}
+
+/**
+ * Run the CategoryViewer::doCategoryQuery hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnCategoryViewer__doCategoryQuery(typeParam interface{}, res interface{}) bool {
+	args := []interface{}{typeParam, res}
+	return r.container.run("CategoryViewer::doCategoryQuery", args, func(handler interface{}) bool {
+		return handler.(CategoryViewer__doCategoryQueryHook).OnCategoryViewer__doCategoryQuery(typeParam, res)
+	})
+}
+
+/**
+ * Before generating an output link allow
+ * extensions opportunity to generate a more specific or relevant link.
+ */
+type CategoryViewer__generateLinkHook interface {
+	/**
+	 * @param mixed $type The category type. Either 'page', 'img' or 'subcat'
+	 * @param mixed $title Title object for the categorized page
+	 * @param mixed $html Requested html content of anchor
+	 * @param mixed &$link Returned value. When set to a non-null value by a hook subscriber this value will be used as the anchor instead of Linker::link
+	 * @return bool False to abort the hook
+	 */
+	OnCategoryViewer__generateLink(typeParam interface{}, title interface{}, html interface{}, link *interface{}) bool
+}
+
+/**
+ * Run the CategoryViewer::generateLink hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnCategoryViewer__generateLink(typeParam interface{}, title interface{}, html interface{}, link *interface{}) bool {
+	args := []interface{}{typeParam, title, html, link}
+	return r.container.run("CategoryViewer::generateLink", args, func(handler interface{}) bool {
+		return handler.(CategoryViewer__generateLinkHook).OnCategoryViewer__generateLink(typeParam, title, html, link)
+	})
+}
+
+/**
+ * Called when user changes his password.
+ * No return data is accepted; this hook is for auditing only.
+ */
+type ChangeAuthenticationDataAuditHook interface {
+	/**
+	 * @param mixed $req AuthenticationRequest object describing the change (and target user)
+	 * @param mixed $status StatusValue with the result of the action
+	 * @return bool False to abort the hook
+	 */
+	OnChangeAuthenticationDataAudit(req interface{}, status interface{}) bool
+}
+
+/**
+ * Run the ChangeAuthenticationDataAudit hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnChangeAuthenticationDataAudit(req interface{}, status interface{}) bool {
+	args := []interface{}{req, status}
+	return r.container.run("ChangeAuthenticationDataAudit", args, func(handler interface{}) bool {
+		return handler.(ChangeAuthenticationDataAuditHook).OnChangeAuthenticationDataAudit(req, status)
+	})
+}
+
+/**
+ * DEPRECATED since 1.27! Use AuthChangeFormFields or
+ * security levels. For extensions that need to add a field to the ChangePassword
+ * form via the Preferences form.
+ */
+type ChangePasswordFormHook interface {
+	/**
+	 * @param mixed &$extraFields An array of arrays that hold fields like would be passed to the pretty function.
+	 * @return bool False to abort the hook
+	 */
+	OnChangePasswordForm(extraFields *interface{}) bool
+}
+
+/**
+ * Run the ChangePasswordForm hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnChangePasswordForm(extraFields *interface{}) bool {
+	args := []interface{}{extraFields}
+	return r.container.run("ChangePasswordForm", args, func(handler interface{}) bool {
+		return handler.(ChangePasswordFormHook).OnChangePasswordForm(extraFields)
+	})
+}
+
+/**
+ * Called after a change tag has been deleted (that is,
+ * removed from all revisions and log entries to which it was applied). This gives
+ * extensions a chance to take it off their books.
+ */
+type ChangeTagAfterDeleteHook interface {
+	/**
+	 * @param mixed $tag name of the tag
+	 * @param mixed &$status Status object. Add warnings to this as required. There is no point setting errors, as the deletion has already been partly carried out by this point.
+	 * @return bool False to abort the hook
+	 */
+	OnChangeTagAfterDelete(tag interface{}, status *interface{}) bool
+}
+
+/**
+ * Run the ChangeTagAfterDelete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnChangeTagAfterDelete(tag interface{}, status *interface{}) bool {
+	args := []interface{}{tag, status}
+	return r.container.run("ChangeTagAfterDelete", args, func(handler interface{}) bool {
+		return handler.(ChangeTagAfterDeleteHook).OnChangeTagAfterDelete(tag, status)
+	})
+}
+
+/**
+ * Tell whether a change tag should be able to be created
+ * from the UI (Special:Tags) or via the API. You could use this hook if you want
+ * to reserve a specific "namespace" of tags, or something similar.
+ */
+type ChangeTagCanCreateHook interface {
+	/**
+	 * @param mixed $tag name of the tag
+	 * @param mixed $user user initiating the action
+	 * @param mixed &$status Status object. Add your errors using `$status->fatal()` or warnings using `$status->warning()`. Errors and warnings will be relayed to the user. If you set an error, the user will be unable to create the tag.
+	 * @return bool False to abort the hook
+	 */
+	OnChangeTagCanCreate(tag interface{}, user interface{}, status *interface{}) bool
+}
+
+/**
+ * Run the ChangeTagCanCreate hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnChangeTagCanCreate(tag interface{}, user interface{}, status *interface{}) bool {
+	args := []interface{}{tag, user, status}
+	return r.container.run("ChangeTagCanCreate", args, func(handler interface{}) bool {
+		return handler.(ChangeTagCanCreateHook).OnChangeTagCanCreate(tag, user, status)
+	})
+}
+
+/**
+ * Tell whether a change tag should be able to be
+ * deleted from the UI (Special:Tags) or via the API. The default is that tags
+ * defined using the ListDefinedTags hook are not allowed to be deleted unless
+ * specifically allowed. If you wish to allow deletion of the tag, set
+ * `$status = Status::newGood()` to allow deletion, and then `return false` from
+ * the hook function. Ensure you consume the 'ChangeTagAfterDelete' hook to carry
+ * out custom deletion actions.
+ */
+type ChangeTagCanDeleteHook interface {
+	/**
+	 * @param mixed $tag name of the tag
+	 * @param mixed $user user initiating the action
+	 * @param mixed &$status Status object. See above.
+	 * @return bool False to abort the hook
+	 */
+	OnChangeTagCanDelete(tag interface{}, user interface{}, status *interface{}) bool
+}
+
+/**
+ * Run the ChangeTagCanDelete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnChangeTagCanDelete(tag interface{}, user interface{}, status *interface{}) bool {
+	args := []interface{}{tag, user, status}
+	return r.container.run("ChangeTagCanDelete", args, func(handler interface{}) bool {
+		return handler.(ChangeTagCanDeleteHook).OnChangeTagCanDelete(tag, user, status)
+	})
+}
+
+/**
+ * Called after tags have been updated with the
+ * ChangeTags::updateTags function. Params:
+ */
+type ChangeTagsAfterUpdateTagsHook interface {
+	/**
+	 * @param mixed $addedTags tags effectively added in the update
+	 * @param mixed $removedTags tags effectively removed in the update
+	 * @param mixed $prevTags tags that were present prior to the update
+	 * @param mixed $rc_id recentchanges table id
+	 * @param mixed $rev_id revision table id
+	 * @param mixed $log_id logging table id
+	 * @param mixed $params tag params
+	 * @param mixed $rc RecentChange being tagged when the tagging accompanies the action, or null
+	 * @param mixed $user User who performed the tagging when the tagging is subsequent to the action, or null
+	 * @return bool False to abort the hook
+	 */
+	OnChangeTagsAfterUpdateTags(addedTags interface{}, removedTags interface{}, prevTags interface{}, rc_id interface{}, rev_id interface{}, log_id interface{}, params interface{}, rc interface{}, user interface{}) bool
+}
+
+/**
+ * Run the ChangeTagsAfterUpdateTags hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnChangeTagsAfterUpdateTags(addedTags interface{}, removedTags interface{}, prevTags interface{}, rc_id interface{}, rev_id interface{}, log_id interface{}, params interface{}, rc interface{}, user interface{}) bool {
+	args := []interface{}{addedTags, removedTags, prevTags, rc_id, rev_id, log_id, params, rc, user}
+	return r.container.run("ChangeTagsAfterUpdateTags", args, func(handler interface{}) bool {
+		return handler.(ChangeTagsAfterUpdateTagsHook).OnChangeTagsAfterUpdateTags(addedTags, removedTags, prevTags, rc_id, rev_id, log_id, params, rc, user)
+	})
+}
+
+/**
+ * Called when checking if a user can add tags to a change.
+ */
+type ChangeTagsAllowedAddHook interface {
+	/**
+	 * @param mixed &$allowedTags List of all the tags the user is allowed to add. Any tags the user wants to add ($addTags) that are not in this array will cause it to fail. You may add or remove tags to this array as required.
+	 * @param mixed $addTags List of tags user intends to add.
+	 * @param mixed $user User who is adding the tags.
+	 * @return bool False to abort the hook
+	 */
+	OnChangeTagsAllowedAdd(allowedTags *interface{}, addTags interface{}, user interface{}) bool
+}
+
+/**
+ * Run the ChangeTagsAllowedAdd hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnChangeTagsAllowedAdd(allowedTags *interface{}, addTags interface{}, user interface{}) bool {
+	args := []interface{}{allowedTags, addTags, user}
+	return r.container.run("ChangeTagsAllowedAdd", args, func(handler interface{}) bool {
+		return handler.(ChangeTagsAllowedAddHook).OnChangeTagsAllowedAdd(allowedTags, addTags, user)
+	})
+}
+
+/**
+ * Allows you to nominate which of the tags your extension
+ * uses are in active use.
+ */
+type ChangeTagsListActiveHook interface {
+	/**
+	 * @param mixed &$tags list of all active tags. Append to this array.
+	 * @return bool False to abort the hook
+	 */
+	OnChangeTagsListActive(tags *interface{}) bool
+}
+
+/**
+ * Run the ChangeTagsListActive hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnChangeTagsListActive(tags *interface{}) bool {
+	args := []interface{}{tags}
+	return r.container.run("ChangeTagsListActive", args, func(handler interface{}) bool {
+		return handler.(ChangeTagsListActiveHook).OnChangeTagsListActive(tags)
+	})
+}
+
+/**
+ * Called before user groups are changed.
+ */
+type ChangeUserGroupsHook interface {
+	/**
+	 * @param mixed $performer The User who will perform the change
+	 * @param mixed $user The User whose groups will be changed
+	 * @param mixed &$add The groups that will be added
+	 * @param mixed &$remove The groups that will be removed
+	 * @return bool False to abort the hook
+	 */
+	OnChangeUserGroups(performer interface{}, user interface{}, add *interface{}, remove *interface{}) bool
+}
+
+/**
+ * Run the ChangeUserGroups hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnChangeUserGroups(performer interface{}, user interface{}, add *interface{}, remove *interface{}) bool {
+	args := []interface{}{performer, user, add, remove}
+	return r.container.run("ChangeUserGroups", args, func(handler interface{}) bool {
+		return handler.(ChangeUserGroupsHook).OnChangeUserGroups(performer, user, add, remove)
+	})
+}
+
+/**
+ * Batch process change list rows prior to rendering.
+ */
+type ChangesListInitRowsHook interface {
+	/**
+	 * @param mixed $changesList ChangesList instance
+	 * @param mixed $rows The data that will be rendered. May be a ResultWrapper instance or an array.
+	 * @return bool False to abort the hook
+	 */
+	OnChangesListInitRows(changesList interface{}, rows interface{}) bool
+}
+
+/**
+ * Run the ChangesListInitRows hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnChangesListInitRows(changesList interface{}, rows interface{}) bool {
+	args := []interface{}{changesList, rows}
+	return r.container.run("ChangesListInitRows", args, func(handler interface{}) bool {
+		return handler.(ChangesListInitRowsHook).OnChangesListInitRows(changesList, rows)
+	})
+}
+
+/**
+ * Override or augment link to article in RC list.
+ */
+type ChangesListInsertArticleLinkHook interface {
+	/**
+	 * @param mixed &$changesList ChangesList instance.
+	 * @param mixed &$articlelink HTML of link to article (already filled-in).
+	 * @param mixed &$s HTML of row that is being constructed.
+	 * @param mixed &$rc RecentChange instance.
+	 * @param mixed $unpatrolled Whether or not we are showing unpatrolled changes.
+	 * @param mixed $watched Whether or not the change is watched by the user.
+	 * @return bool False to abort the hook
+	 */
+	OnChangesListInsertArticleLink(changesList *interface{}, articlelink *interface{}, s *interface{}, rc *interface{}, unpatrolled interface{}, watched interface{}) bool
+}
+
+/**
+ * Run the ChangesListInsertArticleLink hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnChangesListInsertArticleLink(changesList *interface{}, articlelink *interface{}, s *interface{}, rc *interface{}, unpatrolled interface{}, watched interface{}) bool {
+	args := []interface{}{changesList, articlelink, s, rc, unpatrolled, watched}
+	return r.container.run("ChangesListInsertArticleLink", args, func(handler interface{}) bool {
+		return handler.(ChangesListInsertArticleLinkHook).OnChangesListInsertArticleLink(changesList, articlelink, s, rc, unpatrolled, watched)
+	})
+}
+
+/**
+ * Called when building SQL query on pages
+ * inheriting from ChangesListSpecialPage (in core: RecentChanges,
+ * RecentChangesLinked and Watchlist).
+ * Do not use this to implement individual filters if they are compatible with the
+ * ChangesListFilter and ChangesListFilterGroup structure.
+ * Instead, use sub-classes of those classes, in conjunction with the
+ * ChangesListSpecialPageStructuredFilters hook.
+ * This hook can be used to implement filters that do not implement that structure,
+ * or custom behavior that is not an individual filter.
+ */
+type ChangesListSpecialPageQueryHook interface {
+	/**
+	 * @param mixed $name name of the special page, e.g. 'Watchlist'
+	 * @param mixed &$tables array of tables to be queried
+	 * @param mixed &$fields array of columns to select
+	 * @param mixed &$conds array of WHERE conditionals for query
+	 * @param mixed &$query_options array of options for the database request
+	 * @param mixed &$join_conds join conditions for the tables
+	 * @param mixed $opts FormOptions for this request
+	 * @return bool False to abort the hook
+	 */
+	OnChangesListSpecialPageQuery(name interface{}, tables *interface{}, fields *interface{}, conds *interface{}, query_options *interface{}, join_conds *interface{}, opts interface{}) bool
+}
+
+/**
+ * Run the ChangesListSpecialPageQuery hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnChangesListSpecialPageQuery(name interface{}, tables *interface{}, fields *interface{}, conds *interface{}, query_options *interface{}, join_conds *interface{}, opts interface{}) bool {
+	args := []interface{}{name, tables, fields, conds, query_options, join_conds, opts}
+	return r.container.run("ChangesListSpecialPageQuery", args, func(handler interface{}) bool {
+		return handler.(ChangesListSpecialPageQueryHook).OnChangesListSpecialPageQuery(name, tables, fields, conds, query_options, join_conds, opts)
+	})
+}
+
+/**
+ * Called to allow extensions to register
+ * filters for pages inheriting from ChangesListSpecialPage (in core: RecentChanges,
+ * RecentChangesLinked, and Watchlist).  Generally, you will want to construct
+ * new ChangesListBooleanFilter or ChangesListStringOptionsFilter objects.
+ * When constructing them, you specify which group they belong to.  You can reuse
+ * existing groups (accessed through $special->getFilterGroup), or create your own
+ * (ChangesListBooleanFilterGroup or ChangesListStringOptionsFilterGroup).
+ * If you create new groups, you must register them with $special->registerFilterGroup.
+ * Note that this is called regardless of whether the user is currently using
+ * the new (structured) or old (unstructured) filter UI.  If you want your boolean
+ * filter to show on both the new and old UI, specify all the supported fields.
+ * These include showHide, label, and description.
+ * See the constructor of each ChangesList* class for documentation of supported
+ * fields.
+ */
+type ChangesListSpecialPageStructuredFiltersHook interface {
+	/**
+	 * @param mixed $special ChangesListSpecialPage instance
+	 * @return bool False to abort the hook
+	 */
+	OnChangesListSpecialPageStructuredFilters(special interface{}) bool
+}
+
+/**
+ * Run the ChangesListSpecialPageStructuredFilters hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnChangesListSpecialPageStructuredFilters(special interface{}) bool {
+	args := []interface{}{special}
+	return r.container.run("ChangesListSpecialPageStructuredFilters", args, func(handler interface{}) bool {
+		return handler.(ChangesListSpecialPageStructuredFiltersHook).OnChangesListSpecialPageStructuredFilters(special)
+	})
+}
+
+/**
+ * Called if $wgCategoryCollation is an unknown collation.
+ */
+type Collation__factoryHook interface {
+	/**
+	 * @param mixed $collationName Name of the collation in question
+	 * @param mixed &$collationObject Null. Replace with a subclass of the Collation class that implements the collation given in $collationName.
+	 * @return bool False to abort the hook
+	 */
+	OnCollation__factory(collationName interface{}, collationObject *interface{}) bool
+}
+
+/**
+ * Run the Collation::factory hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnCollation__factory(collationName interface{}, collationObject *interface{}) bool {
+	args := []interface{}{collationName, collationObject}
+	return r.container.run("Collation::factory", args, func(handler interface{}) bool {
+		return handler.(Collation__factoryHook).OnCollation__factory(collationName, collationObject)
+	})
+}
+
+/**
+ * Called after a user's email has been confirmed
+ * successfully.
+ */
+type ConfirmEmailCompleteHook interface {
+	/**
+	 * @param mixed $user user (object) whose email is being confirmed
+	 * @return bool False to abort the hook
+	 */
+	OnConfirmEmailComplete(user interface{}) bool
+}
+
+/**
+ * Run the ConfirmEmailComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnConfirmEmailComplete(user interface{}) bool {
+	args := []interface{}{user}
+	return r.container.run("ConfirmEmailComplete", args, func(handler interface{}) bool {
+		return handler.(ConfirmEmailCompleteHook).OnConfirmEmailComplete(user)
+	})
+}
+
+/**
+ * Modify parser output for a given content object.
+ * Called by Content::getParserOutput after parsing has finished. Can be used
+ * for changes that depend on the result of the parsing but have to be done
+ * before LinksUpdate is called (such as adding tracking categories based on
+ * the rendered HTML).
+ */
+type ContentAlterParserOutputHook interface {
+	/**
+	 * @param mixed $content The Content to render
+	 * @param mixed $title Title of the page, as context
+	 * @param mixed $parserOutput ParserOutput to manipulate
+	 * @return bool False to abort the hook
+	 */
+	OnContentAlterParserOutput(content interface{}, title interface{}, parserOutput interface{}) bool
+}
+
+/**
+ * Run the ContentAlterParserOutput hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnContentAlterParserOutput(content interface{}, title interface{}, parserOutput interface{}) bool {
+	args := []interface{}{content, title, parserOutput}
+	return r.container.run("ContentAlterParserOutput", args, func(handler interface{}) bool {
+		return handler.(ContentAlterParserOutputHook).OnContentAlterParserOutput(content, title, parserOutput)
+	})
+}
+
+/**
+ * Customize parser output for a given content object,
+ * called by AbstractContent::getParserOutput. May be used to override the normal
+ * model-specific rendering of page content.
+ */
+type ContentGetParserOutputHook interface {
+	/**
+	 * @param mixed $content The Content to render
+	 * @param mixed $title Title of the page, as context
+	 * @param mixed $revId The revision ID, as context
+	 * @param mixed $options ParserOptions for rendering. To avoid confusing the parser cache, the output can only depend on parameters provided to this hook function, not on global state.
+	 * @param mixed $generateHtml boolean, indicating whether full HTML should be generated. If false, generation of HTML may be skipped, but other information should still be present in the ParserOutput object.
+	 * @param mixed &$output ParserOutput, to manipulate or replace
+	 * @return bool False to abort the hook
+	 */
+	OnContentGetParserOutput(content interface{}, title interface{}, revId interface{}, options interface{}, generateHtml interface{}, output *interface{}) bool
+}
+
+/**
+ * Run the ContentGetParserOutput hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnContentGetParserOutput(content interface{}, title interface{}, revId interface{}, options interface{}, generateHtml interface{}, output *interface{}) bool {
+	args := []interface{}{content, title, revId, options, generateHtml, output}
+	return r.container.run("ContentGetParserOutput", args, func(handler interface{}) bool {
+		return handler.(ContentGetParserOutputHook).OnContentGetParserOutput(content, title, revId, options, generateHtml, output)
+	})
+}
+
+/**
+ * Called when the default content model is
+ * determined for a given title. May be used to assign a different model for that
+ * title.
+ */
+type ContentHandlerDefaultModelForHook interface {
+	/**
+	 * @param mixed $title the Title in question
+	 * @param mixed &$model the model name. Use with CONTENT_MODEL_XXX constants.
+	 * @return bool False to abort the hook
+	 */
+	OnContentHandlerDefaultModelFor(title interface{}, model *interface{}) bool
+}
+
+/**
+ * Run the ContentHandlerDefaultModelFor hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnContentHandlerDefaultModelFor(title interface{}, model *interface{}) bool {
+	args := []interface{}{title, model}
+	return r.container.run("ContentHandlerDefaultModelFor", args, func(handler interface{}) bool {
+		return handler.(ContentHandlerDefaultModelForHook).OnContentHandlerDefaultModelFor(title, model)
+	})
+}
+
+/**
+ * Called when a ContentHandler is requested for
+ * a given content model name, but no entry for that model exists in
+ * $wgContentHandlers.
+ * Note: if your extension implements additional models via this hook, please
+ * use GetContentModels hook to make them known to core.
+ */
+type ContentHandlerForModelIDHook interface {
+	/**
+	 * @param mixed $modeName the requested content model name
+	 * @param mixed &$handler set this to a ContentHandler object, if desired.
+	 * @return bool False to abort the hook
+	 */
+	OnContentHandlerForModelID(modeName interface{}, handlerParam *interface{}) bool
+}
+
+/**
+ * Run the ContentHandlerForModelID hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnContentHandlerForModelID(modeName interface{}, handlerParam *interface{}) bool {
+	args := []interface{}{modeName, handlerParam}
+	return r.container.run("ContentHandlerForModelID", args, func(handler interface{}) bool {
+		return handler.(ContentHandlerForModelIDHook).OnContentHandlerForModelID(modeName, handlerParam)
+	})
+}
+
+/**
+ * Called to determine whether that content model can
+ * be used on a given page. This is especially useful to prevent some content
+ * models to be used in some special location.
+ */
+type ContentModelCanBeUsedOnHook interface {
+	/**
+	 * @param mixed $contentModel ID of the content model in question
+	 * @param mixed $title the Title in question.
+	 * @param mixed &$ok Output parameter, whether it is OK to use $contentModel on $title. Handler functions that modify $ok should generally return false to prevent further hooks from further modifying $ok.
+	 * @return bool False to abort the hook
+	 */
+	OnContentModelCanBeUsedOn(contentModel interface{}, title interface{}, ok *interface{}) bool
+}
+
+/**
+ * Run the ContentModelCanBeUsedOn hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnContentModelCanBeUsedOn(contentModel interface{}, title interface{}, ok *interface{}) bool {
+	args := []interface{}{contentModel, title, ok}
+	return r.container.run("ContentModelCanBeUsedOn", args, func(handler interface{}) bool {
+		return handler.(ContentModelCanBeUsedOnHook).OnContentModelCanBeUsedOn(contentModel, title, ok)
+	})
+}
+
+/**
+ * Modify the allowed CSP load sources. This
+ * affects all directives except for the script directive. If you want to add a
+ * script source, see ContentSecurityPolicyScriptSource hook.
+ */
+type ContentSecurityPolicyDefaultSourceHook interface {
+	/**
+	 * @param mixed &$defaultSrc Array of Content-Security-Policy allowed sources
+	 * @param mixed $policyConfig Current configuration for the Content-Security-Policy header
+	 * @param mixed $mode ContentSecurityPolicy::REPORT_ONLY_MODE or ContentSecurityPolicy::FULL_MODE depending on type of header
+	 * @return bool False to abort the hook
+	 */
+	OnContentSecurityPolicyDefaultSource(defaultSrc *interface{}, policyConfig interface{}, mode interface{}) bool
+}
+
+/**
+ * Run the ContentSecurityPolicyDefaultSource hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnContentSecurityPolicyDefaultSource(defaultSrc *interface{}, policyConfig interface{}, mode interface{}) bool {
+	args := []interface{}{defaultSrc, policyConfig, mode}
+	return r.container.run("ContentSecurityPolicyDefaultSource", args, func(handler interface{}) bool {
+		return handler.(ContentSecurityPolicyDefaultSourceHook).OnContentSecurityPolicyDefaultSource(defaultSrc, policyConfig, mode)
+	})
+}
+
+/**
+ * Modify the content security policy
+ * directives. Use this only if ContentSecurityPolicyDefaultSource and
+ * ContentSecurityPolicyScriptSource do not meet your needs.
+ */
+type ContentSecurityPolicyDirectivesHook interface {
+	/**
+	 * @param mixed &$directives Array of CSP directives
+	 * @param mixed $policyConfig Current configuration for the CSP header
+	 * @param mixed $mode ContentSecurityPolicy::REPORT_ONLY_MODE or ContentSecurityPolicy::FULL_MODE depending on type of header
+	 * @return bool False to abort the hook
+	 */
+	OnContentSecurityPolicyDirectives(directives *interface{}, policyConfig interface{}, mode interface{}) bool
+}
+
+/**
+ * Run the ContentSecurityPolicyDirectives hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnContentSecurityPolicyDirectives(directives *interface{}, policyConfig interface{}, mode interface{}) bool {
+	args := []interface{}{directives, policyConfig, mode}
+	return r.container.run("ContentSecurityPolicyDirectives", args, func(handler interface{}) bool {
+		return handler.(ContentSecurityPolicyDirectivesHook).OnContentSecurityPolicyDirectives(directives, policyConfig, mode)
+	})
+}
+
+/**
+ * Modify the allowed CSP script sources.
+ * Note that you also have to use ContentSecurityPolicyDefaultSource if you
+ * want non-script sources to be loaded from
+ * whatever you add.
+ */
+type ContentSecurityPolicyScriptSourceHook interface {
+	/**
+	 * @param mixed &$scriptSrc Array of CSP directives
+	 * @param mixed $policyConfig Current configuration for the CSP header
+	 * @param mixed $mode ContentSecurityPolicy::REPORT_ONLY_MODE or ContentSecurityPolicy::FULL_MODE depending on type of header
+	 * @return bool False to abort the hook
+	 */
+	OnContentSecurityPolicyScriptSource(scriptSrc *interface{}, policyConfig interface{}, mode interface{}) bool
+}
+
+/**
+ * Run the ContentSecurityPolicyScriptSource hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnContentSecurityPolicyScriptSource(scriptSrc *interface{}, policyConfig interface{}, mode interface{}) bool {
+	args := []interface{}{scriptSrc, policyConfig, mode}
+	return r.container.run("ContentSecurityPolicyScriptSource", args, func(handler interface{}) bool {
+		return handler.(ContentSecurityPolicyScriptSourceHook).OnContentSecurityPolicyScriptSource(scriptSrc, policyConfig, mode)
+	})
+}
+
+/**
+ * Before the contributions query is about to run
+ */
+type ContribsPager__getQueryInfoHook interface {
+	/**
+	 * @param mixed &$pager Pager object for contributions
+	 * @param mixed &$queryInfo The query for the contribs Pager
+	 * @return bool False to abort the hook
+	 */
+	OnContribsPager__getQueryInfo(pager *interface{}, queryInfo *interface{}) bool
+}
+
+/**
+ * Run the ContribsPager::getQueryInfo hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnContribsPager__getQueryInfo(pager *interface{}, queryInfo *interface{}) bool {
+	args := []interface{}{pager, queryInfo}
+	return r.container.run("ContribsPager::getQueryInfo", args, func(handler interface{}) bool {
+		return handler.(ContribsPager__getQueryInfoHook).OnContribsPager__getQueryInfo(pager, queryInfo)
+	})
+}
+
+/**
+ * Called before really executing the query for My
+ * Contributions
+ */
+type ContribsPager__reallyDoQueryHook interface {
+	/**
+	 * @param mixed &$data an array of results of all contribs queries
+	 * @param mixed $pager The ContribsPager object hooked into
+	 * @param mixed $offset Index offset, inclusive
+	 * @param mixed $limit Exact query limit
+	 * @param mixed $descending Query direction, false for ascending, true for descending
+	 * @return bool False to abort the hook
+	 */
+	OnContribsPager__reallyDoQuery(data *interface{}, pager interface{}, offset interface{}, limit interface{}, descending interface{}) bool
+}
+
+/**
+ * Run the ContribsPager::reallyDoQuery hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnContribsPager__reallyDoQuery(data *interface{}, pager interface{}, offset interface{}, limit interface{}, descending interface{}) bool {
+	args := []interface{}{data, pager, offset, limit, descending}
+	return r.container.run("ContribsPager::reallyDoQuery", args, func(handler interface{}) bool {
+		return handler.(ContribsPager__reallyDoQueryHook).OnContribsPager__reallyDoQuery(data, pager, offset, limit, descending)
+	})
+}
+
+/**
+ * Called before a contributions HTML line is finished
+ */
+type ContributionsLineEndingHook interface {
+	/**
+	 * @param mixed $page SpecialPage object for contributions
+	 * @param mixed &$ret the HTML line
+	 * @param mixed $row the DB row for this line
+	 * @param mixed &$classes the classes to add to the surrounding <li>
+	 * @param mixed &$attribs associative array of other HTML attributes for the <li> element. Currently only data attributes reserved to MediaWiki are allowed (see Sanitizer::isReservedDataAttribute).
+	 * @return bool False to abort the hook
+	 */
+	OnContributionsLineEnding(page interface{}, ret *interface{}, row interface{}, classes *interface{}, attribs *interface{}) bool
+}
+
+/**
+ * Run the ContributionsLineEnding hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnContributionsLineEnding(page interface{}, ret *interface{}, row interface{}, classes *interface{}, attribs *interface{}) bool {
+	args := []interface{}{page, ret, row, classes, attribs}
+	return r.container.run("ContributionsLineEnding", args, func(handler interface{}) bool {
+		return handler.(ContributionsLineEndingHook).OnContributionsLineEnding(page, ret, row, classes, attribs)
+	})
+}
+
+/**
+ * Change tool links above Special:Contributions
+ */
+type ContributionsToolLinksHook interface {
+	/**
+	 * @param mixed $id User identifier
+	 * @param mixed $title User page title
+	 * @param mixed &$tools Array of tool links
+	 * @param mixed $specialPage SpecialPage instance for context and services. Can be either SpecialContributions or DeletedContributionsPage. Extensions should type hint against a generic SpecialPage though.
+	 * @return bool False to abort the hook
+	 */
+	OnContributionsToolLinks(id interface{}, title interface{}, tools *interface{}, specialPage interface{}) bool
+}
+
+/**
+ * Run the ContributionsToolLinks hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnContributionsToolLinks(id interface{}, title interface{}, tools *interface{}, specialPage interface{}) bool {
+	args := []interface{}{id, title, tools, specialPage}
+	return r.container.run("ContributionsToolLinks", args, func(handler interface{}) bool {
+		return handler.(ContributionsToolLinksHook).OnContributionsToolLinks(id, title, tools, specialPage)
+	})
+}
+
+/**
+ * Called by AbstractContent::convert when a conversion to
+ * another content model is requested.
+ * Handler functions that modify $result should generally return false to disable
+ * further attempts at conversion.
+ */
+type ConvertContentHook interface {
+	/**
+	 * @param mixed $content The Content object to be converted.
+	 * @param mixed $toModel The ID of the content model to convert to.
+	 * @param mixed $lossy boolean indicating whether lossy conversion is allowed.
+	 * @param mixed &$result Output parameter, in case the handler function wants to provide a converted Content object. Note that $result->getContentModel() must return $toModel.
+	 * @return bool False to abort the hook
+	 */
+	OnConvertContent(content interface{}, toModel interface{}, lossy interface{}, result *interface{}) bool
+}
+
+/**
+ * Run the ConvertContent hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnConvertContent(content interface{}, toModel interface{}, lossy interface{}, result *interface{}) bool {
+	args := []interface{}{content, toModel, lossy, result}
+	return r.container.run("ConvertContent", args, func(handler interface{}) bool {
+		return handler.(ConvertContentHook).OnConvertContent(content, toModel, lossy, result)
+	})
+}
+
+/**
+ * When invoking the page editor
+ * Return true to allow the normal editor to be used, or false if implementing
+ * a custom editor, e.g. for a special namespace, etc.
+ */
+type CustomEditorHook interface {
+	/**
+	 * @param mixed $article Article being edited
+	 * @param mixed $user User performing the edit
+	 * @return bool False to abort the hook
+	 */
+	OnCustomEditor(article interface{}, user interface{}) bool
+}
+
+/**
+ * Run the CustomEditor hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnCustomEditor(article interface{}, user interface{}) bool {
+	args := []interface{}{article, user}
+	return r.container.run("CustomEditor", args, func(handler interface{}) bool {
+		return handler.(CustomEditorHook).OnCustomEditor(article, user)
+	})
+}
+
+/**
+ * Called after initialising an Oracle database
+ */
+type DatabaseOraclePostInitHook interface {
+	/**
+	 * @param mixed $db the DatabaseOracle object
+	 * @return bool False to abort the hook
+	 */
+	OnDatabaseOraclePostInit(db interface{}) bool
+}
+
+/**
+ * Run the DatabaseOraclePostInit hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDatabaseOraclePostInit(db interface{}) bool {
+	args := []interface{}{db}
+	return r.container.run("DatabaseOraclePostInit", args, func(handler interface{}) bool {
+		return handler.(DatabaseOraclePostInitHook).OnDatabaseOraclePostInit(db)
+	})
+}
+
+/**
+ * Called by the cleanupPreferences.php maintenance
+ * script to build a WHERE clause with which to delete preferences that are not
+ * known about. This hook is used by extensions that have dynamically-named
+ * preferences that should not be deleted in the usual cleanup process. For
+ * example, the Gadgets extension creates preferences prefixed with 'gadget-', and
+ * so anything with that prefix is excluded from the deletion.
+ * &where: An array that will be passed as the $cond parameter to
+ *   IDatabase::select() to determine what will be deleted from the user_properties
+ *   table.
+ */
+type DeleteUnknownPreferencesHook interface {
+	/**
+	 * @param mixed $db The IDatabase object, useful for accessing $db->buildLike() etc.
+	 * @return bool False to abort the hook
+	 */
+	OnDeleteUnknownPreferences(db interface{}) bool
+}
+
+/**
+ * Run the DeleteUnknownPreferences hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDeleteUnknownPreferences(db interface{}) bool {
+	args := []interface{}{db}
+	return r.container.run("DeleteUnknownPreferences", args, func(handler interface{}) bool {
+		return handler.(DeleteUnknownPreferencesHook).OnDeleteUnknownPreferences(db)
+	})
+}
+
+/**
+ * Called before really executing the query
+ * for Special:DeletedContributions
+ * Similar to ContribsPager::reallyDoQuery
+ */
+type DeletedContribsPager__reallyDoQueryHook interface {
+	/**
+	 * @param mixed &$data an array of results of all contribs queries
+	 * @param mixed $pager The DeletedContribsPager object hooked into
+	 * @param mixed $offset Index offset, inclusive
+	 * @param mixed $limit Exact query limit
+	 * @param mixed $descending Query direction, false for ascending, true for descending
+	 * @return bool False to abort the hook
+	 */
+	OnDeletedContribsPager__reallyDoQuery(data *interface{}, pager interface{}, offset interface{}, limit interface{}, descending interface{}) bool
+}
+
+/**
+ * Run the DeletedContribsPager::reallyDoQuery hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDeletedContribsPager__reallyDoQuery(data *interface{}, pager interface{}, offset interface{}, limit interface{}, descending interface{}) bool {
+	args := []interface{}{data, pager, offset, limit, descending}
+	return r.container.run("DeletedContribsPager::reallyDoQuery", args, func(handler interface{}) bool {
+		return handler.(DeletedContribsPager__reallyDoQueryHook).OnDeletedContribsPager__reallyDoQuery(data, pager, offset, limit, descending)
+	})
+}
+
+/**
+ * Called before a DeletedContributions HTML line
+ * is finished.
+ * Similar to ContributionsLineEnding
+ */
+type DeletedContributionsLineEndingHook interface {
+	/**
+	 * @param mixed $page SpecialPage object for DeletedContributions
+	 * @param mixed &$ret the HTML line
+	 * @param mixed $row the DB row for this line
+	 * @param mixed &$classes the classes to add to the surrounding <li>
+	 * @param mixed &$attribs associative array of other HTML attributes for the <li> element. Currently only data attributes reserved to MediaWiki are allowed (see Sanitizer::isReservedDataAttribute).
+	 * @return bool False to abort the hook
+	 */
+	OnDeletedContributionsLineEnding(page interface{}, ret *interface{}, row interface{}, classes *interface{}, attribs *interface{}) bool
+}
+
+/**
+ * Run the DeletedContributionsLineEnding hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDeletedContributionsLineEnding(page interface{}, ret *interface{}, row interface{}, classes *interface{}, attribs *interface{}) bool {
+	args := []interface{}{page, ret, row, classes, attribs}
+	return r.container.run("DeletedContributionsLineEnding", args, func(handler interface{}) bool {
+		return handler.(DeletedContributionsLineEndingHook).OnDeletedContributionsLineEnding(page, ret, row, classes, attribs)
+	})
+}
+
+/**
+ * Override or extend the revision tools available from the
+ * diff view, i.e. undo, etc.
+ */
+type DiffRevisionToolsHook interface {
+	/**
+	 * @param mixed $newRev Revision object of the "new" revision
+	 * @param mixed &$links Array of HTML links
+	 * @param mixed $oldRev Revision object of the "old" revision (may be null)
+	 * @param mixed $user Current user object
+	 * @return bool False to abort the hook
+	 */
+	OnDiffRevisionTools(newRev interface{}, links *interface{}, oldRev interface{}, user interface{}) bool
+}
+
+/**
+ * Run the DiffRevisionTools hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDiffRevisionTools(newRev interface{}, links *interface{}, oldRev interface{}, user interface{}) bool {
+	args := []interface{}{newRev, links, oldRev, user}
+	return r.container.run("DiffRevisionTools", args, func(handler interface{}) bool {
+		return handler.(DiffRevisionToolsHook).OnDiffRevisionTools(newRev, links, oldRev, user)
+	})
+}
+
+/**
+ * Called before diff display
+ */
+type DiffViewHeaderHook interface {
+	/**
+	 * @param mixed $diff DifferenceEngine object that's calling
+	 * @param mixed $oldRev Revision object of the "old" revision (may be null/invalid)
+	 * @param mixed $newRev Revision object of the "new" revision
+	 * @return bool False to abort the hook
+	 */
+	OnDiffViewHeader(diff interface{}, oldRev interface{}, newRev interface{}) bool
+}
+
+/**
+ * Run the DiffViewHeader hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDiffViewHeader(diff interface{}, oldRev interface{}, newRev interface{}) bool {
+	args := []interface{}{diff, oldRev, newRev}
+	return r.container.run("DiffViewHeader", args, func(handler interface{}) bool {
+		return handler.(DiffViewHeaderHook).OnDiffViewHeader(diff, oldRev, newRev)
+	})
+}
+
+/**
+ * called in DifferenceEngine::loadNewText()
+ * after the new revision's content has been loaded into the class member variable
+ * $differenceEngine->mNewContent but before returning true from this function.
+ */
+type DifferenceEngineAfterLoadNewTextHook interface {
+	/**
+	 * @param mixed $differenceEngine DifferenceEngine object
+	 * @return bool False to abort the hook
+	 */
+	OnDifferenceEngineAfterLoadNewText(differenceEngine interface{}) bool
+}
+
+/**
+ * Run the DifferenceEngineAfterLoadNewText hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDifferenceEngineAfterLoadNewText(differenceEngine interface{}) bool {
+	args := []interface{}{differenceEngine}
+	return r.container.run("DifferenceEngineAfterLoadNewText", args, func(handler interface{}) bool {
+		return handler.(DifferenceEngineAfterLoadNewTextHook).OnDifferenceEngineAfterLoadNewText(differenceEngine)
+	})
+}
+
+/**
+ * called in
+ * DifferenceEngine::loadText() after the new revision's content has been loaded
+ * into the class member variable $differenceEngine->mNewContent but before
+ * checking if the variable's value is null.
+ * This hook can be used to inject content into said class member variable.
+ */
+type DifferenceEngineLoadTextAfterNewContentIsLoadedHook interface {
+	/**
+	 * @param mixed $differenceEngine DifferenceEngine object
+	 * @return bool False to abort the hook
+	 */
+	OnDifferenceEngineLoadTextAfterNewContentIsLoaded(differenceEngine interface{}) bool
+}
+
+/**
+ * Run the DifferenceEngineLoadTextAfterNewContentIsLoaded hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDifferenceEngineLoadTextAfterNewContentIsLoaded(differenceEngine interface{}) bool {
+	args := []interface{}{differenceEngine}
+	return r.container.run("DifferenceEngineLoadTextAfterNewContentIsLoaded", args, func(handler interface{}) bool {
+		return handler.(DifferenceEngineLoadTextAfterNewContentIsLoadedHook).OnDifferenceEngineLoadTextAfterNewContentIsLoaded(differenceEngine)
+	})
+}
+
+/**
+ * Allows extensions to change the "mark as
+ * patrolled" link which is shown both on the diff header as well as on the bottom
+ * of a page, usually wrapped in a span element which has class="patrollink".
+ */
+type DifferenceEngineMarkPatrolledLinkHook interface {
+	/**
+	 * @param mixed $differenceEngine DifferenceEngine object
+	 * @param mixed &$markAsPatrolledLink The "mark as patrolled" link HTML (string)
+	 * @param mixed $rcid Recent change ID (rc_id) for this change (int)
+	 * @return bool False to abort the hook
+	 */
+	OnDifferenceEngineMarkPatrolledLink(differenceEngine interface{}, markAsPatrolledLink *interface{}, rcid interface{}) bool
+}
+
+/**
+ * Run the DifferenceEngineMarkPatrolledLink hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDifferenceEngineMarkPatrolledLink(differenceEngine interface{}, markAsPatrolledLink *interface{}, rcid interface{}) bool {
+	args := []interface{}{differenceEngine, markAsPatrolledLink, rcid}
+	return r.container.run("DifferenceEngineMarkPatrolledLink", args, func(handler interface{}) bool {
+		return handler.(DifferenceEngineMarkPatrolledLinkHook).OnDifferenceEngineMarkPatrolledLink(differenceEngine, markAsPatrolledLink, rcid)
+	})
+}
+
+/**
+ * Allows extensions to possibly change the
+ * rcid parameter. For example the rcid might be set to zero due to the user being
+ * the same as the performer of the change but an extension might still want to
+ * show it under certain conditions.
+ */
+type DifferenceEngineMarkPatrolledRCIDHook interface {
+	/**
+	 * @param mixed &$rcid rc_id (int) of the change or 0
+	 * @param mixed $differenceEngine DifferenceEngine object
+	 * @param mixed $change RecentChange object
+	 * @param mixed $user User object representing the current user
+	 * @return bool False to abort the hook
+	 */
+	OnDifferenceEngineMarkPatrolledRCID(rcid *interface{}, differenceEngine interface{}, change interface{}, user interface{}) bool
+}
+
+/**
+ * Run the DifferenceEngineMarkPatrolledRCID hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDifferenceEngineMarkPatrolledRCID(rcid *interface{}, differenceEngine interface{}, change interface{}, user interface{}) bool {
+	args := []interface{}{rcid, differenceEngine, change, user}
+	return r.container.run("DifferenceEngineMarkPatrolledRCID", args, func(handler interface{}) bool {
+		return handler.(DifferenceEngineMarkPatrolledRCIDHook).OnDifferenceEngineMarkPatrolledRCID(rcid, differenceEngine, change, user)
+	})
+}
+
+/**
+ * Allows extensions to change the $newHeader
+ * variable, which contains information about the new revision, such as the
+ * revision's author, whether the revision was marked as a minor edit or not, etc.
+ */
+type DifferenceEngineNewHeaderHook interface {
+	/**
+	 * @param mixed $differenceEngine DifferenceEngine object
+	 * @param mixed &$newHeader The string containing the various #mw-diff-otitle[1-5] divs, which include things like revision author info, revision comment, RevisionDelete link and more
+	 * @param mixed $formattedRevisionTools Array containing revision tools, some of which may have been injected with the DiffRevisionTools hook
+	 * @param mixed $nextlink String containing the link to the next revision (if any); also included in $newHeader
+	 * @param mixed $rollback Rollback link (string) to roll this revision back to the previous one, if any
+	 * @param mixed $newminor String indicating if the new revision was marked as a minor edit
+	 * @param mixed $diffOnly Boolean parameter passed to DifferenceEngine#showDiffPage, indicating whether we should show just the diff; passed in as a query string parameter to the various URLs constructed here (i.e. $nextlink)
+	 * @param mixed $rdel RevisionDelete link for the new revision, if the current user is allowed to use the RevisionDelete feature
+	 * @param mixed $unhide Boolean parameter indicating whether to show RevisionDeleted revisions
+	 * @return bool False to abort the hook
+	 */
+	OnDifferenceEngineNewHeader(differenceEngine interface{}, newHeader *interface{}, formattedRevisionTools interface{}, nextlink interface{}, rollback interface{}, newminor interface{}, diffOnly interface{}, rdel interface{}, unhide interface{}) bool
+}
+
+/**
+ * Run the DifferenceEngineNewHeader hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDifferenceEngineNewHeader(differenceEngine interface{}, newHeader *interface{}, formattedRevisionTools interface{}, nextlink interface{}, rollback interface{}, newminor interface{}, diffOnly interface{}, rdel interface{}, unhide interface{}) bool {
+	args := []interface{}{differenceEngine, newHeader, formattedRevisionTools, nextlink, rollback, newminor, diffOnly, rdel, unhide}
+	return r.container.run("DifferenceEngineNewHeader", args, func(handler interface{}) bool {
+		return handler.(DifferenceEngineNewHeaderHook).OnDifferenceEngineNewHeader(differenceEngine, newHeader, formattedRevisionTools, nextlink, rollback, newminor, diffOnly, rdel, unhide)
+	})
+}
+
+/**
+ * Allows extensions to change the $oldHeader
+ * variable, which contains information about the old revision, such as the
+ * revision's author, whether the revision was marked as a minor edit or not, etc.
+ */
+type DifferenceEngineOldHeaderHook interface {
+	/**
+	 * @param mixed $differenceEngine DifferenceEngine object
+	 * @param mixed &$oldHeader The string containing the various #mw-diff-otitle[1-5] divs, which include things like revision author info, revision comment, RevisionDelete link and more
+	 * @param mixed $prevlink String containing the link to the previous revision (if any); also included in $oldHeader
+	 * @param mixed $oldminor String indicating if the old revision was marked as a minor edit
+	 * @param mixed $diffOnly Boolean parameter passed to DifferenceEngine#showDiffPage, indicating whether we should show just the diff; passed in as a query string parameter to the various URLs constructed here (i.e. $prevlink)
+	 * @param mixed $ldel RevisionDelete link for the old revision, if the current user is allowed to use the RevisionDelete feature
+	 * @param mixed $unhide Boolean parameter indicating whether to show RevisionDeleted revisions
+	 * @return bool False to abort the hook
+	 */
+	OnDifferenceEngineOldHeader(differenceEngine interface{}, oldHeader *interface{}, prevlink interface{}, oldminor interface{}, diffOnly interface{}, ldel interface{}, unhide interface{}) bool
+}
+
+/**
+ * Run the DifferenceEngineOldHeader hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDifferenceEngineOldHeader(differenceEngine interface{}, oldHeader *interface{}, prevlink interface{}, oldminor interface{}, diffOnly interface{}, ldel interface{}, unhide interface{}) bool {
+	args := []interface{}{differenceEngine, oldHeader, prevlink, oldminor, diffOnly, ldel, unhide}
+	return r.container.run("DifferenceEngineOldHeader", args, func(handler interface{}) bool {
+		return handler.(DifferenceEngineOldHeaderHook).OnDifferenceEngineOldHeader(differenceEngine, oldHeader, prevlink, oldminor, diffOnly, ldel, unhide)
+	})
+}
+
+/**
+ * Change the $oldHeader variable in cases
+ * when there is no old revision
+ */
+type DifferenceEngineOldHeaderNoOldRevHook interface {
+	/**
+	 * @param mixed &$oldHeader empty string by default
+	 * @return bool False to abort the hook
+	 */
+	OnDifferenceEngineOldHeaderNoOldRev(oldHeader *interface{}) bool
+}
+
+/**
+ * Run the DifferenceEngineOldHeaderNoOldRev hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDifferenceEngineOldHeaderNoOldRev(oldHeader *interface{}) bool {
+	args := []interface{}{oldHeader}
+	return r.container.run("DifferenceEngineOldHeaderNoOldRev", args, func(handler interface{}) bool {
+		return handler.(DifferenceEngineOldHeaderNoOldRevHook).OnDifferenceEngineOldHeaderNoOldRev(oldHeader)
+	})
+}
+
+/**
+ * Allows extensions to change the
+ * parser output. Return false to not add parser output via OutputPage's
+ * addParserOutput method.
+ */
+type DifferenceEngineRenderRevisionAddParserOutputHook interface {
+	/**
+	 * @param mixed $differenceEngine DifferenceEngine object
+	 * @param mixed $out OutputPage object
+	 * @param mixed $parserOutput ParserOutput object
+	 * @param mixed $wikiPage WikiPage object
+	 * @return bool False to abort the hook
+	 */
+	OnDifferenceEngineRenderRevisionAddParserOutput(differenceEngine interface{}, out interface{}, parserOutput interface{}, wikiPage interface{}) bool
+}
+
+/**
+ * Run the DifferenceEngineRenderRevisionAddParserOutput hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDifferenceEngineRenderRevisionAddParserOutput(differenceEngine interface{}, out interface{}, parserOutput interface{}, wikiPage interface{}) bool {
+	args := []interface{}{differenceEngine, out, parserOutput, wikiPage}
+	return r.container.run("DifferenceEngineRenderRevisionAddParserOutput", args, func(handler interface{}) bool {
+		return handler.(DifferenceEngineRenderRevisionAddParserOutputHook).OnDifferenceEngineRenderRevisionAddParserOutput(differenceEngine, out, parserOutput, wikiPage)
+	})
+}
+
+/**
+ * An extension can hook into
+ * this hook point and return false to not show the final "mark as patrolled" link
+ * on the bottom of a page.
+ * This hook has no arguments.
+ */
+type DifferenceEngineRenderRevisionShowFinalPatrolLinkHook interface {
+	/**
+	 * @return bool False to abort the hook
+	 */
+	OnDifferenceEngineRenderRevisionShowFinalPatrolLink() bool
+}
+
+/**
+ * Run the DifferenceEngineRenderRevisionShowFinalPatrolLink hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDifferenceEngineRenderRevisionShowFinalPatrolLink() bool {
+	args := []interface{}{}
+	return r.container.run("DifferenceEngineRenderRevisionShowFinalPatrolLink", args, func(handler interface{}) bool {
+		return handler.(DifferenceEngineRenderRevisionShowFinalPatrolLinkHook).OnDifferenceEngineRenderRevisionShowFinalPatrolLink()
+	})
+}
+
+/**
+ * Allows extensions to affect the diff text which
+ * eventually gets sent to the OutputPage object.
+ */
+type DifferenceEngineShowDiffHook interface {
+	/**
+	 * @param mixed $differenceEngine DifferenceEngine object
+	 * @return bool False to abort the hook
+	 */
+	OnDifferenceEngineShowDiff(differenceEngine interface{}) bool
+}
+
+/**
+ * Run the DifferenceEngineShowDiff hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDifferenceEngineShowDiff(differenceEngine interface{}) bool {
+	args := []interface{}{differenceEngine}
+	return r.container.run("DifferenceEngineShowDiff", args, func(handler interface{}) bool {
+		return handler.(DifferenceEngineShowDiffHook).OnDifferenceEngineShowDiff(differenceEngine)
+	})
+}
+
+/**
+ * Add additional output via the available
+ * OutputPage object into the diff view
+ */
+type DifferenceEngineShowDiffPageHook interface {
+	/**
+	 * @param mixed $out OutputPage object
+	 * @return bool False to abort the hook
+	 */
+	OnDifferenceEngineShowDiffPage(out interface{}) bool
+}
+
+/**
+ * Run the DifferenceEngineShowDiffPage hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDifferenceEngineShowDiffPage(out interface{}) bool {
+	args := []interface{}{out}
+	return r.container.run("DifferenceEngineShowDiffPage", args, func(handler interface{}) bool {
+		return handler.(DifferenceEngineShowDiffPageHook).OnDifferenceEngineShowDiffPage(out)
+	})
+}
+
+/**
+ * called in
+ * DifferenceEngine::showDiffPage() when revision data cannot be loaded.
+ * Return false in order to prevent displaying the missing revision message
+ * (i.e. to prevent DifferenceEngine::showMissingRevision() from being called).
+ */
+type DifferenceEngineShowDiffPageMaybeShowMissingRevisionHook interface {
+	/**
+	 * @param mixed $differenceEngine DifferenceEngine object
+	 * @return bool False to abort the hook
+	 */
+	OnDifferenceEngineShowDiffPageMaybeShowMissingRevision(differenceEngine interface{}) bool
+}
+
+/**
+ * Run the DifferenceEngineShowDiffPageMaybeShowMissingRevision hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDifferenceEngineShowDiffPageMaybeShowMissingRevision(differenceEngine interface{}) bool {
+	args := []interface{}{differenceEngine}
+	return r.container.run("DifferenceEngineShowDiffPageMaybeShowMissingRevision", args, func(handler interface{}) bool {
+		return handler.(DifferenceEngineShowDiffPageMaybeShowMissingRevisionHook).OnDifferenceEngineShowDiffPageMaybeShowMissingRevision(differenceEngine)
+	})
+}
+
+/**
+ * Allows extensions to change the diff
+ * table body (without header) in cases when there is no old revision or the old
+ * and new revisions are identical.
+ */
+type DifferenceEngineShowEmptyOldContentHook interface {
+	/**
+	 * @param mixed $differenceEngine DifferenceEngine object
+	 * @return bool False to abort the hook
+	 */
+	OnDifferenceEngineShowEmptyOldContent(differenceEngine interface{}) bool
+}
+
+/**
+ * Run the DifferenceEngineShowEmptyOldContent hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDifferenceEngineShowEmptyOldContent(differenceEngine interface{}) bool {
+	args := []interface{}{differenceEngine}
+	return r.container.run("DifferenceEngineShowEmptyOldContent", args, func(handler interface{}) bool {
+		return handler.(DifferenceEngineShowEmptyOldContentHook).OnDifferenceEngineShowEmptyOldContent(differenceEngine)
+	})
+}
+
+/**
+ * before creating subtitle when browsing old versions of
+ * an article
+ */
+type DisplayOldSubtitleHook interface {
+	/**
+	 * @param mixed &$article article (object) being viewed
+	 * @param mixed &$oldid oldid (int) being viewed
+	 * @return bool False to abort the hook
+	 */
+	OnDisplayOldSubtitle(article *interface{}, oldid *interface{}) bool
+}
+
+/**
+ * Run the DisplayOldSubtitle hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnDisplayOldSubtitle(article *interface{}, oldid *interface{}) bool {
+	args := []interface{}{article, oldid}
+	return r.container.run("DisplayOldSubtitle", args, func(handler interface{}) bool {
+		return handler.(DisplayOldSubtitleHook).OnDisplayOldSubtitle(article, oldid)
+	})
+}
+
+/**
+ * Perform checks on an edit
+ */
+type EditFilterHook interface {
+	/**
+	 * @param mixed $editor EditPage instance (object). The edit form (see includes/EditPage.php)
+	 * @param mixed $text Contents of the edit box
+	 * @param mixed $section Section being edited
+	 * @param mixed &$error Error message to return
+	 * @param mixed $summary Edit summary for page
+	 * @return bool False to abort the hook
+	 */
+	OnEditFilter(editor interface{}, text interface{}, section interface{}, error *interface{}, summary interface{}) bool
+}
+
+/**
+ * Run the EditFilter hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditFilter(editor interface{}, text interface{}, section interface{}, error *interface{}, summary interface{}) bool {
+	args := []interface{}{editor, text, section, error, summary}
+	return r.container.run("EditFilter", args, func(handler interface{}) bool {
+		return handler.(EditFilterHook).OnEditFilter(editor, text, section, error, summary)
+	})
+}
+
+/**
+ * Post-section-merge edit filter.
+ * This may be triggered by the EditPage or any other facility that modifies page
+ * content. Use the $status object to indicate whether the edit should be allowed,
+ * and to provide a reason for disallowing it. Return false to abort the edit, and
+ * true to continue. Returning true if $status->isOK() returns false means "don't
+ * save but continue user interaction", e.g. show the edit form.
+ * $status->apiHookResult can be set to an array to be returned by api.php
+ *   action=edit. This is used to deliver captchas.
+ */
+type EditFilterMergedContentHook interface {
+	/**
+	 * @param mixed $context object implementing the IContextSource interface.
+	 * @param mixed $content content of the edit box, as a Content object.
+	 * @param mixed $status Status object to represent errors, etc.
+	 * @param mixed $summary Edit summary for page
+	 * @param mixed $user the User object representing the user whois performing the edit.
+	 * @param mixed $minoredit whether the edit was marked as minor by the user.
+	 * @return bool False to abort the hook
+	 */
+	OnEditFilterMergedContent(context interface{}, content interface{}, status interface{}, summary interface{}, user interface{}, minoredit interface{}) bool
+}
+
+/**
+ * Run the EditFilterMergedContent hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditFilterMergedContent(context interface{}, content interface{}, status interface{}, summary interface{}, user interface{}, minoredit interface{}) bool {
+	args := []interface{}{context, content, status, summary, user, minoredit}
+	return r.container.run("EditFilterMergedContent", args, func(handler interface{}) bool {
+		return handler.(EditFilterMergedContentHook).OnEditFilterMergedContent(context, content, status, summary, user, minoredit)
+	})
+}
+
+/**
+ * Allows modifying the edit form when editing existing
+ * pages
+ */
+type EditFormInitialTextHook interface {
+	/**
+	 * @param mixed $editPage EditPage object
+	 * @return bool False to abort the hook
+	 */
+	OnEditFormInitialText(editPage interface{}) bool
+}
+
+/**
+ * Run the EditFormInitialText hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditFormInitialText(editPage interface{}) bool {
+	args := []interface{}{editPage}
+	return r.container.run("EditFormInitialText", args, func(handler interface{}) bool {
+		return handler.(EditFormInitialTextHook).OnEditFormInitialText(editPage)
+	})
+}
+
+/**
+ * Allows population of the edit form when creating
+ * new pages
+ */
+type EditFormPreloadTextHook interface {
+	/**
+	 * @param mixed &$text Text to preload with
+	 * @param mixed &$title Title object representing the page being created
+	 * @return bool False to abort the hook
+	 */
+	OnEditFormPreloadText(text *interface{}, title *interface{}) bool
+}
+
+/**
+ * Run the EditFormPreloadText hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditFormPreloadText(text *interface{}, title *interface{}) bool {
+	args := []interface{}{text, title}
+	return r.container.run("EditFormPreloadText", args, func(handler interface{}) bool {
+		return handler.(EditFormPreloadTextHook).OnEditFormPreloadText(text, title)
+	})
+}
+
+/**
+ * Called before an article is
+ * saved, that is before WikiPage::doEditContent() is called
+ */
+type EditPage__attemptSaveHook interface {
+	/**
+	 * @param mixed $editpage_Obj the current EditPage object
+	 * @return bool False to abort the hook
+	 */
+	OnEditPage__attemptSave(editpage_Obj interface{}) bool
+}
+
+/**
+ * Run the EditPage::attemptSave hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPage__attemptSave(editpage_Obj interface{}) bool {
+	args := []interface{}{editpage_Obj}
+	return r.container.run("EditPage::attemptSave", args, func(handler interface{}) bool {
+		return handler.(EditPage__attemptSaveHook).OnEditPage__attemptSave(editpage_Obj)
+	})
+}
+
+/**
+ * Called after an article save attempt
+ */
+type EditPage__attemptSave_afterHook interface {
+	/**
+	 * @param mixed $editpage_Obj the current EditPage object
+	 * @param mixed $status the resulting Status object
+	 * @param mixed $resultDetails Result details array
+	 * @return bool False to abort the hook
+	 */
+	OnEditPage__attemptSave_after(editpage_Obj interface{}, status interface{}, resultDetails interface{}) bool
+}
+
+/**
+ * Run the EditPage::attemptSave:after hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPage__attemptSave_after(editpage_Obj interface{}, status interface{}, resultDetails interface{}) bool {
+	args := []interface{}{editpage_Obj, status, resultDetails}
+	return r.container.run("EditPage::attemptSave:after", args, func(handler interface{}) bool {
+		return handler.(EditPage__attemptSave_afterHook).OnEditPage__attemptSave_after(editpage_Obj, status, resultDetails)
+	})
+}
+
+/**
+ * allow extensions to read additional data
+ * posted in the form
+ * Return value is ignored (should always return true)
+ */
+type EditPage__importFormDataHook interface {
+	/**
+	 * @param mixed $editpage EditPage instance
+	 * @param mixed $request Webrequest
+	 * @return bool False to abort the hook
+	 */
+	OnEditPage__importFormData(editpage interface{}, request interface{}) bool
+}
+
+/**
+ * Run the EditPage::importFormData hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPage__importFormData(editpage interface{}, request interface{}) bool {
+	args := []interface{}{editpage, request}
+	return r.container.run("EditPage::importFormData", args, func(handler interface{}) bool {
+		return handler.(EditPage__importFormDataHook).OnEditPage__importFormData(editpage, request)
+	})
+}
+
+/**
+ * allows injection of form field into edit form
+ * Return value is ignored (should always return true)
+ */
+type EditPage__showEditForm_fieldsHook interface {
+	/**
+	 * @param mixed &$editor the EditPage instance for reference
+	 * @param mixed &$out an OutputPage instance to write to
+	 * @return bool False to abort the hook
+	 */
+	OnEditPage__showEditForm_fields(editor *interface{}, out *interface{}) bool
+}
+
+/**
+ * Run the EditPage::showEditForm:fields hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPage__showEditForm_fields(editor *interface{}, out *interface{}) bool {
+	args := []interface{}{editor, out}
+	return r.container.run("EditPage::showEditForm:fields", args, func(handler interface{}) bool {
+		return handler.(EditPage__showEditForm_fieldsHook).OnEditPage__showEditForm_fields(editor, out)
+	})
+}
+
+/**
+ * before showing the edit form
+ * Return false to halt editing; you'll need to handle error messages, etc.
+ * yourself. Alternatively, modifying $error and returning true will cause the
+ * contents of $error to be echoed at the top of the edit form as wikitext.
+ * Return true without altering $error to allow the edit to proceed.
+ */
+type EditPage__showEditForm_initialHook interface {
+	/**
+	 * @param mixed &$editor EditPage instance (object)
+	 * @param mixed &$out an OutputPage instance to write to
+	 * @return bool False to abort the hook
+	 */
+	OnEditPage__showEditForm_initial(editor *interface{}, out *interface{}) bool
+}
+
+/**
+ * Run the EditPage::showEditForm:initial hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPage__showEditForm_initial(editor *interface{}, out *interface{}) bool {
+	args := []interface{}{editor, out}
+	return r.container.run("EditPage::showEditForm:initial", args, func(handler interface{}) bool {
+		return handler.(EditPage__showEditForm_initialHook).OnEditPage__showEditForm_initial(editor, out)
+	})
+}
+
+/**
+ * similar to EditPage::showEditForm:initial
+ * but for the read-only 'view source' variant of the edit form.
+ * Return value is ignored (should always return true)
+ */
+type EditPage__showReadOnlyForm_initialHook interface {
+	/**
+	 * @param mixed $editor EditPage instance (object)
+	 * @param mixed &$out an OutputPage instance to write to
+	 * @return bool False to abort the hook
+	 */
+	OnEditPage__showReadOnlyForm_initial(editor interface{}, out *interface{}) bool
+}
+
+/**
+ * Run the EditPage::showReadOnlyForm:initial hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPage__showReadOnlyForm_initial(editor interface{}, out *interface{}) bool {
+	args := []interface{}{editor, out}
+	return r.container.run("EditPage::showReadOnlyForm:initial", args, func(handler interface{}) bool {
+		return handler.(EditPage__showReadOnlyForm_initialHook).OnEditPage__showReadOnlyForm_initial(editor, out)
+	})
+}
+
+/**
+ * allows injection of form fields into
+ * the editOptions area
+ * Return value is ignored (should always be true)
+ */
+type EditPage__showStandardInputs_optionsHook interface {
+	/**
+	 * @param mixed $editor EditPage instance (object)
+	 * @param mixed $out an OutputPage instance to write to
+	 * @param mixed &$tabindex HTML tabindex of the last edit check/button
+	 * @return bool False to abort the hook
+	 */
+	OnEditPage__showStandardInputs_options(editor interface{}, out interface{}, tabindex *interface{}) bool
+}
+
+/**
+ * Run the EditPage::showStandardInputs:options hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPage__showStandardInputs_options(editor interface{}, out interface{}, tabindex *interface{}) bool {
+	args := []interface{}{editor, out, tabindex}
+	return r.container.run("EditPage::showStandardInputs:options", args, func(handler interface{}) bool {
+		return handler.(EditPage__showStandardInputs_optionsHook).OnEditPage__showStandardInputs_options(editor, out, tabindex)
+	})
+}
+
+/**
+ * allows modifying the EditPage object and output
+ * when there's an edit conflict.  Return false to halt normal diff output; in
+ * this case you're responsible for computing and outputting the entire "conflict"
+ * part, i.e., the "difference between revisions" and "your text" headers and
+ * sections.
+ */
+type EditPageBeforeConflictDiffHook interface {
+	/**
+	 * @param mixed &$editor EditPage instance
+	 * @param mixed &$out OutputPage instance
+	 * @return bool False to abort the hook
+	 */
+	OnEditPageBeforeConflictDiff(editor *interface{}, out *interface{}) bool
+}
+
+/**
+ * Run the EditPageBeforeConflictDiff hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPageBeforeConflictDiff(editor *interface{}, out *interface{}) bool {
+	args := []interface{}{editor, out}
+	return r.container.run("EditPageBeforeConflictDiff", args, func(handler interface{}) bool {
+		return handler.(EditPageBeforeConflictDiffHook).OnEditPageBeforeConflictDiff(editor, out)
+	})
+}
+
+/**
+ * Allows modifying the edit buttons below the
+ * textarea in the edit form.
+ */
+type EditPageBeforeEditButtonsHook interface {
+	/**
+	 * @param mixed &$editpage The current EditPage object
+	 * @param mixed &$buttons Array of edit buttons "Save", "Preview", "Live", and "Diff"
+	 * @param mixed &$tabindex HTML tabindex of the last edit check/button
+	 * @return bool False to abort the hook
+	 */
+	OnEditPageBeforeEditButtons(editpage *interface{}, buttons *interface{}, tabindex *interface{}) bool
+}
+
+/**
+ * Run the EditPageBeforeEditButtons hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPageBeforeEditButtons(editpage *interface{}, buttons *interface{}, tabindex *interface{}) bool {
+	args := []interface{}{editpage, buttons, tabindex}
+	return r.container.run("EditPageBeforeEditButtons", args, func(handler interface{}) bool {
+		return handler.(EditPageBeforeEditButtonsHook).OnEditPageBeforeEditButtons(editpage, buttons, tabindex)
+	})
+}
+
+/**
+ * Allow adding an edit toolbar above the textarea in
+ * the edit form.
+ */
+type EditPageBeforeEditToolbarHook interface {
+	/**
+	 * @param mixed &$toolbar The toolbar HTML, initially an empty `<div id="toolbar"></div>` Hook subscribers can return false to have no toolbar HTML be loaded.
+	 * @return bool False to abort the hook
+	 */
+	OnEditPageBeforeEditToolbar(toolbar *interface{}) bool
+}
+
+/**
+ * Run the EditPageBeforeEditToolbar hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPageBeforeEditToolbar(toolbar *interface{}) bool {
+	args := []interface{}{toolbar}
+	return r.container.run("EditPageBeforeEditToolbar", args, func(handler interface{}) bool {
+		return handler.(EditPageBeforeEditToolbarHook).OnEditPageBeforeEditToolbar(toolbar)
+	})
+}
+
+/**
+ * Allow for site and per-namespace customization of
+ * contribution/copyright notice.
+ */
+type EditPageCopyrightWarningHook interface {
+	/**
+	 * @param mixed $title title of page being edited
+	 * @param mixed &$msg localization message name, overridable. Default is either 'copyrightwarning' or 'copyrightwarning2'.
+	 * @return bool False to abort the hook
+	 */
+	OnEditPageCopyrightWarning(title interface{}, msg *interface{}) bool
+}
+
+/**
+ * Run the EditPageCopyrightWarning hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPageCopyrightWarning(title interface{}, msg *interface{}) bool {
+	args := []interface{}{title, msg}
+	return r.container.run("EditPageCopyrightWarning", args, func(handler interface{}) bool {
+		return handler.(EditPageCopyrightWarningHook).OnEditPageCopyrightWarning(title, msg)
+	})
+}
+
+/**
+ * Allows modifying the edit checkboxes
+ * below the textarea in the edit form.
+ */
+type EditPageGetCheckboxesDefinitionHook interface {
+	/**
+	 * @param mixed $editpage The current EditPage object
+	 * @param mixed &$checkboxes Array of checkbox definitions. See EditPage::getCheckboxesDefinition() for the format.
+	 * @return bool False to abort the hook
+	 */
+	OnEditPageGetCheckboxesDefinition(editpage interface{}, checkboxes *interface{}) bool
+}
+
+/**
+ * Run the EditPageGetCheckboxesDefinition hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPageGetCheckboxesDefinition(editpage interface{}, checkboxes *interface{}) bool {
+	args := []interface{}{editpage, checkboxes}
+	return r.container.run("EditPageGetCheckboxesDefinition", args, func(handler interface{}) bool {
+		return handler.(EditPageGetCheckboxesDefinitionHook).OnEditPageGetCheckboxesDefinition(editpage, checkboxes)
+	})
+}
+
+/**
+ * Allow modifying the wikitext that will be used in
+ * "Show changes". Note that it is preferable to implement diff handling for
+ * different data types using the ContentHandler facility.
+ */
+type EditPageGetDiffContentHook interface {
+	/**
+	 * @param mixed $editPage EditPage object
+	 * @param mixed &$newtext wikitext that will be used as "your version"
+	 * @return bool False to abort the hook
+	 */
+	OnEditPageGetDiffContent(editPage interface{}, newtext *interface{}) bool
+}
+
+/**
+ * Run the EditPageGetDiffContent hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPageGetDiffContent(editPage interface{}, newtext *interface{}) bool {
+	args := []interface{}{editPage, newtext}
+	return r.container.run("EditPageGetDiffContent", args, func(handler interface{}) bool {
+		return handler.(EditPageGetDiffContentHook).OnEditPageGetDiffContent(editPage, newtext)
+	})
+}
+
+/**
+ * Allow modifying the wikitext that will be
+ * previewed. Note that it is preferable to implement previews for different data
+ * types using the ContentHandler facility.
+ */
+type EditPageGetPreviewContentHook interface {
+	/**
+	 * @param mixed $editPage EditPage object
+	 * @param mixed &$content Content object to be previewed (may be replaced by hook function)
+	 * @return bool False to abort the hook
+	 */
+	OnEditPageGetPreviewContent(editPage interface{}, content *interface{}) bool
+}
+
+/**
+ * Run the EditPageGetPreviewContent hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPageGetPreviewContent(editPage interface{}, content *interface{}) bool {
+	args := []interface{}{editPage, content}
+	return r.container.run("EditPageGetPreviewContent", args, func(handler interface{}) bool {
+		return handler.(EditPageGetPreviewContentHook).OnEditPageGetPreviewContent(editPage, content)
+	})
+}
+
+/**
+ * When a section edit request is given for an
+ * non-existent section
+ */
+type EditPageNoSuchSectionHook interface {
+	/**
+	 * @param mixed &$editpage The current EditPage object
+	 * @param mixed &$res the HTML of the error text
+	 * @return bool False to abort the hook
+	 */
+	OnEditPageNoSuchSection(editpage *interface{}, res *interface{}) bool
+}
+
+/**
+ * Run the EditPageNoSuchSection hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPageNoSuchSection(editpage *interface{}, res *interface{}) bool {
+	args := []interface{}{editpage, res}
+	return r.container.run("EditPageNoSuchSection", args, func(handler interface{}) bool {
+		return handler.(EditPageNoSuchSectionHook).OnEditPageNoSuchSection(editpage, res)
+	})
+}
+
+/**
+ * Give a chance for site and per-namespace customizations
+ * of terms of service summary link that might exist separately from the copyright
+ * notice.
+ */
+type EditPageTosSummaryHook interface {
+	/**
+	 * @param mixed $title title of page being edited
+	 * @param mixed &$msg localization message name, overridable. Default is 'editpage-tos-summary'
+	 * @return bool False to abort the hook
+	 */
+	OnEditPageTosSummary(title interface{}, msg *interface{}) bool
+}
+
+/**
+ * Run the EditPageTosSummary hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEditPageTosSummary(title interface{}, msg *interface{}) bool {
+	args := []interface{}{title, msg}
+	return r.container.run("EditPageTosSummary", args, func(handler interface{}) bool {
+		return handler.(EditPageTosSummaryHook).OnEditPageTosSummary(title, msg)
+	})
+}
+
+/**
+ * When checking that the user's email address is "confirmed".
+ * This runs before the other checks, such as anonymity and the real check; return
+ * true to allow those checks to occur, and false if checking is done.
+ */
+type EmailConfirmedHook interface {
+	/**
+	 * @param mixed &$user User being checked
+	 * @param mixed &$confirmed Whether or not the email address is confirmed
+	 * @return bool False to abort the hook
+	 */
+	OnEmailConfirmed(user *interface{}, confirmed *interface{}) bool
+}
+
+/**
+ * Run the EmailConfirmed hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEmailConfirmed(user *interface{}, confirmed *interface{}) bool {
+	args := []interface{}{user, confirmed}
+	return r.container.run("EmailConfirmed", args, func(handler interface{}) bool {
+		return handler.(EmailConfirmedHook).OnEmailConfirmed(user, confirmed)
+	})
+}
+
+/**
+ * Before sending email from one user to another.
+ */
+type EmailUserHook interface {
+	/**
+	 * @param mixed &$to MailAddress object of receiving user
+	 * @param mixed &$from MailAddress object of sending user
+	 * @param mixed &$subject subject of the mail
+	 * @param mixed &$text text of the mail
+	 * @param mixed &$error Out-param for an error. Should be set to a Status object or boolean false.
+	 * @return bool False to abort the hook
+	 */
+	OnEmailUser(to *interface{}, from *interface{}, subject *interface{}, text *interface{}, error *interface{}) bool
+}
+
+/**
+ * Run the EmailUser hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEmailUser(to *interface{}, from *interface{}, subject *interface{}, text *interface{}, error *interface{}) bool {
+	args := []interface{}{to, from, subject, text, error}
+	return r.container.run("EmailUser", args, func(handler interface{}) bool {
+		return handler.(EmailUserHook).OnEmailUser(to, from, subject, text, error)
+	})
+}
+
+/**
+ * Before sending the copy of the email to the author.
+ */
+type EmailUserCCHook interface {
+	/**
+	 * @param mixed &$to MailAddress object of receiving user
+	 * @param mixed &$from MailAddress object of sending user
+	 * @param mixed &$subject subject of the mail
+	 * @param mixed &$text text of the mail
+	 * @return bool False to abort the hook
+	 */
+	OnEmailUserCC(to *interface{}, from *interface{}, subject *interface{}, text *interface{}) bool
+}
+
+/**
+ * Run the EmailUserCC hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEmailUserCC(to *interface{}, from *interface{}, subject *interface{}, text *interface{}) bool {
+	args := []interface{}{to, from, subject, text}
+	return r.container.run("EmailUserCC", args, func(handler interface{}) bool {
+		return handler.(EmailUserCCHook).OnEmailUserCC(to, from, subject, text)
+	})
+}
+
+/**
+ * After sending email from one user to another.
+ */
+type EmailUserCompleteHook interface {
+	/**
+	 * @param mixed $to MailAddress object of receiving user
+	 * @param mixed $from MailAddress object of sending user
+	 * @param mixed $subject subject of the mail
+	 * @param mixed $text text of the mail
+	 * @return bool False to abort the hook
+	 */
+	OnEmailUserComplete(to interface{}, from interface{}, subject interface{}, text interface{}) bool
+}
+
+/**
+ * Run the EmailUserComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEmailUserComplete(to interface{}, from interface{}, subject interface{}, text interface{}) bool {
+	args := []interface{}{to, from, subject, text}
+	return r.container.run("EmailUserComplete", args, func(handler interface{}) bool {
+		return handler.(EmailUserCompleteHook).OnEmailUserComplete(to, from, subject, text)
+	})
+}
+
+/**
+ * After building the email user form object.
+ */
+type EmailUserFormHook interface {
+	/**
+	 * @param mixed &$form HTMLForm object
+	 * @return bool False to abort the hook
+	 */
+	OnEmailUserForm(form *interface{}) bool
+}
+
+/**
+ * Run the EmailUserForm hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEmailUserForm(form *interface{}) bool {
+	args := []interface{}{form}
+	return r.container.run("EmailUserForm", args, func(handler interface{}) bool {
+		return handler.(EmailUserFormHook).OnEmailUserForm(form)
+	})
+}
+
+/**
+ * to retrieve permissions errors for emailing a
+ * user.
+ */
+type EmailUserPermissionsErrorsHook interface {
+	/**
+	 * @param mixed $user The user who is trying to email another user.
+	 * @param mixed $editToken The user's edit token.
+	 * @param mixed &$hookErr Out-param for the error. Passed as the parameters to OutputPage::showErrorPage.
+	 * @return bool False to abort the hook
+	 */
+	OnEmailUserPermissionsErrors(user interface{}, editToken interface{}, hookErr *interface{}) bool
+}
+
+/**
+ * Run the EmailUserPermissionsErrors hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEmailUserPermissionsErrors(user interface{}, editToken interface{}, hookErr *interface{}) bool {
+	args := []interface{}{user, editToken, hookErr}
+	return r.container.run("EmailUserPermissionsErrors", args, func(handler interface{}) bool {
+		return handler.(EmailUserPermissionsErrorsHook).OnEmailUserPermissionsErrors(user, editToken, hookErr)
+	})
+}
+
+/**
+ * to alter, remove or add to the links of a
+ * group of changes in EnhancedChangesList.
+ * Hook subscribers can return false to omit this line from recentchanges.
+ */
+type EnhancedChangesList__getLogTextHook interface {
+	/**
+	 * @param mixed $changesList EnhancedChangesList object
+	 * @param mixed &$links The links that were generated by EnhancedChangesList
+	 * @param mixed $block The RecentChanges objects in that block
+	 * @return bool False to abort the hook
+	 */
+	OnEnhancedChangesList__getLogText(changesList interface{}, links *interface{}, block interface{}) bool
+}
+
+/**
+ * Run the EnhancedChangesList::getLogText hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEnhancedChangesList__getLogText(changesList interface{}, links *interface{}, block interface{}) bool {
+	args := []interface{}{changesList, links, block}
+	return r.container.run("EnhancedChangesList::getLogText", args, func(handler interface{}) bool {
+		return handler.(EnhancedChangesList__getLogTextHook).OnEnhancedChangesList__getLogText(changesList, links, block)
+	})
+}
+
+/**
+ * to alter data used to build
+ * a non-grouped recent change line in EnhancedChangesList.
+ */
+type EnhancedChangesListModifyBlockLineDataHook interface {
+	/**
+	 * @param mixed $changesList EnhancedChangesList object
+	 * @param mixed &$data An array with all the components that will be joined in order to create the line
+	 * @param mixed $rc The RecentChange object for this line
+	 * @return bool False to abort the hook
+	 */
+	OnEnhancedChangesListModifyBlockLineData(changesList interface{}, data *interface{}, rc interface{}) bool
+}
+
+/**
+ * Run the EnhancedChangesListModifyBlockLineData hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEnhancedChangesListModifyBlockLineData(changesList interface{}, data *interface{}, rc interface{}) bool {
+	args := []interface{}{changesList, data, rc}
+	return r.container.run("EnhancedChangesListModifyBlockLineData", args, func(handler interface{}) bool {
+		return handler.(EnhancedChangesListModifyBlockLineDataHook).OnEnhancedChangesListModifyBlockLineData(changesList, data, rc)
+	})
+}
+
+/**
+ * to alter data used to build
+ * a grouped recent change inner line in EnhancedChangesList.
+ * Hook subscribers can return false to omit this line from recentchanges.
+ */
+type EnhancedChangesListModifyLineDataHook interface {
+	/**
+	 * @param mixed $changesList EnhancedChangesList object
+	 * @param mixed &$data An array with all the components that will be joined in order to create the line
+	 * @param mixed $block An array of RecentChange objects in that block
+	 * @param mixed $rc The RecentChange object for this line
+	 * @param mixed &$classes An array of classes to change
+	 * @param mixed &$attribs associative array of other HTML attributes for the <tr> element. Currently only data attributes reserved to MediaWiki are allowed (see Sanitizer::isReservedDataAttribute).
+	 * @return bool False to abort the hook
+	 */
+	OnEnhancedChangesListModifyLineData(changesList interface{}, data *interface{}, block interface{}, rc interface{}, classes *interface{}, attribs *interface{}) bool
+}
+
+/**
+ * Run the EnhancedChangesListModifyLineData hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnEnhancedChangesListModifyLineData(changesList interface{}, data *interface{}, block interface{}, rc interface{}, classes *interface{}, attribs *interface{}) bool {
+	args := []interface{}{changesList, data, block, rc, classes, attribs}
+	return r.container.run("EnhancedChangesListModifyLineData", args, func(handler interface{}) bool {
+		return handler.(EnhancedChangesListModifyLineDataHook).OnEnhancedChangesListModifyLineData(changesList, data, block, rc, classes, attribs)
+	})
+}
+
+/**
+ * Exemption from the account creation
+ * throttle.
+ */
+type ExemptFromAccountCreationThrottleHook interface {
+	/**
+	 * @param mixed $ip The ip address of the user
+	 * @return bool False to abort the hook
+	 */
+	OnExemptFromAccountCreationThrottle(ip interface{}) bool
+}
+
+/**
+ * Run the ExemptFromAccountCreationThrottle hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnExemptFromAccountCreationThrottle(ip interface{}) bool {
+	args := []interface{}{ip}
+	return r.container.run("ExemptFromAccountCreationThrottle", args, func(handler interface{}) bool {
+		return handler.(ExemptFromAccountCreationThrottleHook).OnExemptFromAccountCreationThrottle(ip)
+	})
+}
+
+/**
+ * Called when generating the extensions credits, use this to
+ * change the tables headers.
+ */
+type ExtensionTypesHook interface {
+	/**
+	 * @param mixed &$extTypes associative array of extensions types
+	 * @return bool False to abort the hook
+	 */
+	OnExtensionTypes(extTypes *interface{}) bool
+}
+
+/**
+ * Run the ExtensionTypes hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnExtensionTypes(extTypes *interface{}) bool {
+	args := []interface{}{extTypes}
+	return r.container.run("ExtensionTypes", args, func(handler interface{}) bool {
+		return handler.(ExtensionTypesHook).OnExtensionTypes(extTypes)
+	})
+}
+
+/**
+ * When fetching the ChangesList derivative for a particular
+ * user.
+ */
+type FetchChangesListHook interface {
+	/**
+	 * @param mixed $user User the list is being fetched for
+	 * @param mixed &$skin Skin object to be used with the list
+	 * @param mixed &$list List object (defaults to NULL, change it to an object instance and return false override the list derivative used)
+	 * @return bool False to abort the hook
+	 */
+	OnFetchChangesList(user interface{}, skin *interface{}, list *interface{}) bool
+}
+
+/**
+ * Run the FetchChangesList hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnFetchChangesList(user interface{}, skin *interface{}, list *interface{}) bool {
+	args := []interface{}{user, skin, list}
+	return r.container.run("FetchChangesList", args, func(handler interface{}) bool {
+		return handler.(FetchChangesListHook).OnFetchChangesList(user, skin, list)
+	})
+}
+
+/**
+ * When a file is deleted.
+ */
+type FileDeleteCompleteHook interface {
+	/**
+	 * @param mixed &$file reference to the deleted file
+	 * @param mixed &$oldimage in case of the deletion of an old image, the name of the old file
+	 * @param mixed &$article in case all revisions of the file are deleted a reference to the WikiFilePage associated with the file.
+	 * @param mixed &$user user who performed the deletion
+	 * @param mixed &$reason reason
+	 * @return bool False to abort the hook
+	 */
+	OnFileDeleteComplete(file *interface{}, oldimage *interface{}, article *interface{}, user *interface{}, reason *interface{}) bool
+}
+
+/**
+ * Run the FileDeleteComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnFileDeleteComplete(file *interface{}, oldimage *interface{}, article *interface{}, user *interface{}, reason *interface{}) bool {
+	args := []interface{}{file, oldimage, article, user, reason}
+	return r.container.run("FileDeleteComplete", args, func(handler interface{}) bool {
+		return handler.(FileDeleteCompleteHook).OnFileDeleteComplete(file, oldimage, article, user, reason)
+	})
+}
+
+/**
+ * When a file is transformed and moved into storage.
+ */
+type FileTransformedHook interface {
+	/**
+	 * @param mixed $file reference to the File object
+	 * @param mixed $thumb the MediaTransformOutput object
+	 * @param mixed $tmpThumbPath The temporary file system path of the transformed file
+	 * @param mixed $thumbPath The permanent storage path of the transformed file
+	 * @return bool False to abort the hook
+	 */
+	OnFileTransformed(file interface{}, thumb interface{}, tmpThumbPath interface{}, thumbPath interface{}) bool
+}
+
+/**
+ * Run the FileTransformed hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnFileTransformed(file interface{}, thumb interface{}, tmpThumbPath interface{}, thumbPath interface{}) bool {
+	args := []interface{}{file, thumb, tmpThumbPath, thumbPath}
+	return r.container.run("FileTransformed", args, func(handler interface{}) bool {
+		return handler.(FileTransformedHook).OnFileTransformed(file, thumb, tmpThumbPath, thumbPath)
+	})
+}
+
+/**
+ * When a file is undeleted
+ */
+type FileUndeleteCompleteHook interface {
+	/**
+	 * @param mixed $title title object to the file
+	 * @param mixed $fileVersions array of undeleted versions. Empty if all versions were restored
+	 * @param mixed $user user who performed the undeletion
+	 * @param mixed $reason reason
+	 * @return bool False to abort the hook
+	 */
+	OnFileUndeleteComplete(title interface{}, fileVersions interface{}, user interface{}, reason interface{}) bool
+}
+
+/**
+ * Run the FileUndeleteComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnFileUndeleteComplete(title interface{}, fileVersions interface{}, user interface{}, reason interface{}) bool {
+	args := []interface{}{title, fileVersions, user, reason}
+	return r.container.run("FileUndeleteComplete", args, func(handler interface{}) bool {
+		return handler.(FileUndeleteCompleteHook).OnFileUndeleteComplete(title, fileVersions, user, reason)
+	})
+}
+
+/**
+ * When a file upload occurs.
+ */
+type FileUploadHook interface {
+	/**
+	 * @param mixed $file Image object representing the file that was uploaded
+	 * @param mixed $reupload Boolean indicating if there was a previously another image there or not (since 1.17)
+	 * @param mixed $hasDescription Boolean indicating that there was already a description page and a new one from the comment wasn't created (since 1.17)
+	 * @return bool False to abort the hook
+	 */
+	OnFileUpload(file interface{}, reupload interface{}, hasDescription interface{}) bool
+}
+
+/**
+ * Run the FileUpload hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnFileUpload(file interface{}, reupload interface{}, hasDescription interface{}) bool {
+	args := []interface{}{file, reupload, hasDescription}
+	return r.container.run("FileUpload", args, func(handler interface{}) bool {
+		return handler.(FileUploadHook).OnFileUpload(file, reupload, hasDescription)
+	})
+}
+
+/**
+ * When an autocomment is formatted by the Linker.
+ */
+type FormatAutocommentsHook interface {
+	/**
+	 * @param mixed &$comment Reference to the accumulated comment. Initially null, when set the default code will be skipped.
+	 * @param mixed $pre Boolean, true if there is text before this autocomment
+	 * @param mixed $auto The extracted part of the parsed comment before the call to the hook.
+	 * @param mixed $post Boolean, true if there is text after this autocomment
+	 * @param mixed $title An optional title object used to links to sections. Can be null.
+	 * @param mixed $local Boolean indicating whether section links should refer to local page.
+	 * @param mixed $wikiId String containing the ID (as used by WikiMap) of the wiki from which the autocomment originated; null for the local wiki. Added in 1.26, should default to null in handler functions, for backwards compatibility.
+	 * @return bool False to abort the hook
+	 */
+	OnFormatAutocomments(comment *interface{}, pre interface{}, auto interface{}, post interface{}, title interface{}, local interface{}, wikiId interface{}) bool
+}
+
+/**
+ * Run the FormatAutocomments hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnFormatAutocomments(comment *interface{}, pre interface{}, auto interface{}, post interface{}, title interface{}, local interface{}, wikiId interface{}) bool {
+	args := []interface{}{comment, pre, auto, post, title, local, wikiId}
+	return r.container.run("FormatAutocomments", args, func(handler interface{}) bool {
+		return handler.(FormatAutocommentsHook).OnFormatAutocomments(comment, pre, auto, post, title, local, wikiId)
+	})
+}
+
+/**
+ * Get list of classes that can render different modes of a
+ * gallery.
+ */
+type GalleryGetModesHook interface {
+	/**
+	 * @param mixed &$modeArray An associative array mapping mode names to classes that implement that mode. It is expected all registered classes are a subclass of ImageGalleryBase.
+	 * @return bool False to abort the hook
+	 */
+	OnGalleryGetModes(modeArray *interface{}) bool
+}
+
+/**
+ * Run the GalleryGetModes hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGalleryGetModes(modeArray *interface{}) bool {
+	args := []interface{}{modeArray}
+	return r.container.run("GalleryGetModes", args, func(handler interface{}) bool {
+		return handler.(GalleryGetModesHook).OnGalleryGetModes(modeArray)
+	})
+}
+
+/**
+ * When determining which autopromote groups a user is
+ * entitled to be in.
+ */
+type GetAutoPromoteGroupsHook interface {
+	/**
+	 * @param mixed $user user to promote.
+	 * @param mixed &$promote groups that will be added.
+	 * @return bool False to abort the hook
+	 */
+	OnGetAutoPromoteGroups(user interface{}, promote *interface{}) bool
+}
+
+/**
+ * Run the GetAutoPromoteGroups hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetAutoPromoteGroups(user interface{}, promote *interface{}) bool {
+	args := []interface{}{user, promote}
+	return r.container.run("GetAutoPromoteGroups", args, func(handler interface{}) bool {
+		return handler.(GetAutoPromoteGroupsHook).OnGetAutoPromoteGroups(user, promote)
+	})
+}
+
+/**
+ * after loading blocking status of an user from the database
+ */
+type GetBlockedStatusHook interface {
+	/**
+	 * @param mixed &$user user (object) being checked
+	 * @return bool False to abort the hook
+	 */
+	OnGetBlockedStatus(user *interface{}) bool
+}
+
+/**
+ * Run the GetBlockedStatus hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetBlockedStatus(user *interface{}) bool {
+	args := []interface{}{user}
+	return r.container.run("GetBlockedStatus", args, func(handler interface{}) bool {
+		return handler.(GetBlockedStatusHook).OnGetBlockedStatus(user)
+	})
+}
+
+/**
+ * Get cookies that should vary cache options.
+ */
+type GetCacheVaryCookiesHook interface {
+	/**
+	 * @param mixed $out OutputPage object
+	 * @param mixed &$cookies array of cookies name, add a value to it if you want to add a cookie that have to vary cache options
+	 * @return bool False to abort the hook
+	 */
+	OnGetCacheVaryCookies(out interface{}, cookies *interface{}) bool
+}
+
+/**
+ * Run the GetCacheVaryCookies hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetCacheVaryCookies(out interface{}, cookies *interface{}) bool {
+	args := []interface{}{out, cookies}
+	return r.container.run("GetCacheVaryCookies", args, func(handler interface{}) bool {
+		return handler.(GetCacheVaryCookiesHook).OnGetCacheVaryCookies(out, cookies)
+	})
+}
+
+/**
+ * Modify fully-qualified URLs used for IRC and e-mail
+ * notifications.
+ */
+type GetCanonicalURLHook interface {
+	/**
+	 * @param mixed &$title Title object of page
+	 * @param mixed &$url string value as output (out parameter, can modify)
+	 * @param mixed $query query options passed to Title::getCanonicalURL()
+	 * @return bool False to abort the hook
+	 */
+	OnGetCanonicalURL(title *interface{}, url *interface{}, query interface{}) bool
+}
+
+/**
+ * Run the GetCanonicalURL hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetCanonicalURL(title *interface{}, url *interface{}, query interface{}) bool {
+	args := []interface{}{title, url, query}
+	return r.container.run("GetCanonicalURL", args, func(handler interface{}) bool {
+		return handler.(GetCanonicalURLHook).OnGetCanonicalURL(title, url, query)
+	})
+}
+
+/**
+ * Add content models to the list of available models.
+ */
+type GetContentModelsHook interface {
+	/**
+	 * @param mixed &$models array containing current model list, as strings. Extensions should add to this list.
+	 * @return bool False to abort the hook
+	 */
+	OnGetContentModels(models *interface{}) bool
+}
+
+/**
+ * Run the GetContentModels hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetContentModels(models *interface{}) bool {
+	args := []interface{}{models}
+	return r.container.run("GetContentModels", args, func(handler interface{}) bool {
+		return handler.(GetContentModelsHook).OnGetContentModels(models)
+	})
+}
+
+/**
+ * Override the default sortkey for a page.
+ */
+type GetDefaultSortkeyHook interface {
+	/**
+	 * @param mixed $title Title object that we need to get a sortkey for
+	 * @param mixed &$sortkey Sortkey to use.
+	 * @return bool False to abort the hook
+	 */
+	OnGetDefaultSortkey(title interface{}, sortkey *interface{}) bool
+}
+
+/**
+ * Run the GetDefaultSortkey hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetDefaultSortkey(title interface{}, sortkey *interface{}) bool {
+	args := []interface{}{title, sortkey}
+	return r.container.run("GetDefaultSortkey", args, func(handler interface{}) bool {
+		return handler.(GetDefaultSortkeyHook).OnGetDefaultSortkey(title, sortkey)
+	})
+}
+
+/**
+ * Called when getting a new difference engine interface
+ * object.  Can be used to decorate or replace the default difference engine.
+ */
+type GetDifferenceEngineHook interface {
+	/**
+	 * @param mixed $context IContextSource context to be used for diff
+	 * @param mixed $old Revision ID to show and diff with
+	 * @param mixed $new Either a revision ID or one of the strings 'cur', 'prev' or 'next'
+	 * @param mixed $refreshCache If set, refreshes the diff cache
+	 * @param mixed $unhide If set, allow viewing deleted revs
+	 * @param mixed &$differenceEngine The difference engine object to be used for the diff
+	 * @return bool False to abort the hook
+	 */
+	OnGetDifferenceEngine(context interface{}, old interface{}, new interface{}, refreshCache interface{}, unhide interface{}, differenceEngine *interface{}) bool
+}
+
+/**
+ * Run the GetDifferenceEngine hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetDifferenceEngine(context interface{}, old interface{}, new interface{}, refreshCache interface{}, unhide interface{}, differenceEngine *interface{}) bool {
+	args := []interface{}{context, old, new, refreshCache, unhide, differenceEngine}
+	return r.container.run("GetDifferenceEngine", args, func(handler interface{}) bool {
+		return handler.(GetDifferenceEngineHook).OnGetDifferenceEngine(context, old, new, refreshCache, unhide, differenceEngine)
+	})
+}
+
+/**
+ * Modify the list of behavior switch (double
+ * underscore) magic words. Called by MagicWord.
+ */
+type GetDoubleUnderscoreIDsHook interface {
+	/**
+	 * @param mixed &$doubleUnderscoreIDs array of strings
+	 * @return bool False to abort the hook
+	 */
+	OnGetDoubleUnderscoreIDs(doubleUnderscoreIDs *interface{}) bool
+}
+
+/**
+ * Run the GetDoubleUnderscoreIDs hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetDoubleUnderscoreIDs(doubleUnderscoreIDs *interface{}) bool {
+	args := []interface{}{doubleUnderscoreIDs}
+	return r.container.run("GetDoubleUnderscoreIDs", args, func(handler interface{}) bool {
+		return handler.(GetDoubleUnderscoreIDsHook).OnGetDoubleUnderscoreIDs(doubleUnderscoreIDs)
+	})
+}
+
+/**
+ * Get extended file metadata for the API
+ */
+type GetExtendedMetadataHook interface {
+	/**
+	 * @param mixed &$combinedMeta Array of the form: 'MetadataPropName' => [ value' => prop value, 'source' => 'name of hook' ].
+	 * @param mixed $file File object of file in question
+	 * @param mixed $context RequestContext (including language to use)
+	 * @param mixed $single Only extract the current language; if false, the prop value should be in the metadata multi-language array format: mediawiki.org/wiki/Manual:File_metadata_handling#Multi-language_array_format
+	 * @param mixed &$maxCacheTime how long the results can be cached
+	 * @return bool False to abort the hook
+	 */
+	OnGetExtendedMetadata(combinedMeta *interface{}, file interface{}, context interface{}, single interface{}, maxCacheTime *interface{}) bool
+}
+
+/**
+ * Run the GetExtendedMetadata hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetExtendedMetadata(combinedMeta *interface{}, file interface{}, context interface{}, single interface{}, maxCacheTime *interface{}) bool {
+	args := []interface{}{combinedMeta, file, context, single, maxCacheTime}
+	return r.container.run("GetExtendedMetadata", args, func(handler interface{}) bool {
+		return handler.(GetExtendedMetadataHook).OnGetExtendedMetadata(combinedMeta, file, context, single, maxCacheTime)
+	})
+}
+
+/**
+ * Modify fully-qualified URLs used in redirects/export/offsite data.
+ */
+type GetFullURLHook interface {
+	/**
+	 * @param mixed &$title Title object of page
+	 * @param mixed &$url string value as output (out parameter, can modify)
+	 * @param mixed $query query options passed to Title::getFullURL()
+	 * @return bool False to abort the hook
+	 */
+	OnGetFullURL(title *interface{}, url *interface{}, query interface{}) bool
+}
+
+/**
+ * Run the GetFullURL hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetFullURL(title *interface{}, url *interface{}, query interface{}) bool {
+	args := []interface{}{title, url, query}
+	return r.container.run("GetFullURL", args, func(handler interface{}) bool {
+		return handler.(GetFullURLHook).OnGetFullURL(title, url, query)
+	})
+}
+
+/**
+ * Pre-emptively override the human-readable timestamp
+ * generated by MWTimestamp::getHumanTimestamp(). Return false in this hook to use
+ * the custom output.
+ */
+type GetHumanTimestampHook interface {
+	/**
+	 * @param mixed &$output string for the output timestamp
+	 * @param mixed $timestamp MWTimestamp object of the current (user-adjusted) timestamp
+	 * @param mixed $relativeTo MWTimestamp object of the relative (user-adjusted) timestamp
+	 * @param mixed $user User whose preferences are being used to make timestamp
+	 * @param mixed $lang Language that will be used to render the timestamp
+	 * @return bool False to abort the hook
+	 */
+	OnGetHumanTimestamp(output *interface{}, timestamp interface{}, relativeTo interface{}, user interface{}, lang interface{}) bool
+}
+
+/**
+ * Run the GetHumanTimestamp hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetHumanTimestamp(output *interface{}, timestamp interface{}, relativeTo interface{}, user interface{}, lang interface{}) bool {
+	args := []interface{}{output, timestamp, relativeTo, user, lang}
+	return r.container.run("GetHumanTimestamp", args, func(handler interface{}) bool {
+		return handler.(GetHumanTimestampHook).OnGetHumanTimestamp(output, timestamp, relativeTo, user, lang)
+	})
+}
+
+/**
+ * modify the ip of the current user (called only once).
+ */
+type GetIPHook interface {
+	/**
+	 * @param mixed &$ip string holding the ip as determined so far
+	 * @return bool False to abort the hook
+	 */
+	OnGetIP(ip *interface{}) bool
+}
+
+/**
+ * Run the GetIP hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetIP(ip *interface{}) bool {
+	args := []interface{}{ip}
+	return r.container.run("GetIP", args, func(handler interface{}) bool {
+		return handler.(GetIPHook).OnGetIP(ip)
+	})
+}
+
+/**
+ * Modify fully-qualified URLs used for squid cache purging.
+ */
+type GetInternalURLHook interface {
+	/**
+	 * @param mixed &$title Title object of page
+	 * @param mixed &$url string value as output (out parameter, can modify)
+	 * @param mixed $query query options passed to Title::getInternalURL()
+	 * @return bool False to abort the hook
+	 */
+	OnGetInternalURL(title *interface{}, url *interface{}, query interface{}) bool
+}
+
+/**
+ * Run the GetInternalURL hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetInternalURL(title *interface{}, url *interface{}, query interface{}) bool {
+	args := []interface{}{title, url, query}
+	return r.container.run("GetInternalURL", args, func(handler interface{}) bool {
+		return handler.(GetInternalURLHook).OnGetInternalURL(title, url, query)
+	})
+}
+
+/**
+ * Called in LanguageConverter#getPreferredVariant() to
+ *   allow fetching the language variant code from cookies or other such
+ *   alternative storage.
+ */
+type GetLangPreferredVariantHook interface {
+	/**
+	 * @param mixed &$req language variant from the URL (string) or boolean false if no variant was specified in the URL; the value of this variable comes from LanguageConverter#getURLVariant()
+	 * @return bool False to abort the hook
+	 */
+	OnGetLangPreferredVariant(req *interface{}) bool
+}
+
+/**
+ * Run the GetLangPreferredVariant hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetLangPreferredVariant(req *interface{}) bool {
+	args := []interface{}{req}
+	return r.container.run("GetLangPreferredVariant", args, func(handler interface{}) bool {
+		return handler.(GetLangPreferredVariantHook).OnGetLangPreferredVariant(req)
+	})
+}
+
+/**
+ * modify the CSS class of an array of page links.
+ */
+type GetLinkColoursHook interface {
+	/**
+	 * @param mixed $linkcolour_ids array of prefixed DB keys of the pages linked to, indexed by page_id.
+	 * @param mixed &$colours (output) array of CSS classes, indexed by prefixed DB keys
+	 * @param mixed $title Title object of the page being parsed, on which the links will be shown
+	 * @return bool False to abort the hook
+	 */
+	OnGetLinkColours(linkcolour_ids interface{}, colours *interface{}, title interface{}) bool
+}
+
+/**
+ * Run the GetLinkColours hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetLinkColours(linkcolour_ids interface{}, colours *interface{}, title interface{}) bool {
+	args := []interface{}{linkcolour_ids, colours, title}
+	return r.container.run("GetLinkColours", args, func(handler interface{}) bool {
+		return handler.(GetLinkColoursHook).OnGetLinkColours(linkcolour_ids, colours, title)
+	})
+}
+
+/**
+ * Modify local URLs as output into page links. Note that if you are
+ * working with internal urls (non-interwiki) then it may be preferable to work
+ * with the GetLocalURL::Internal or GetLocalURL::Article hooks as GetLocalURL can
+ * be buggy for internal urls on render if you do not re-implement the horrible
+ * hack that Title::getLocalURL uses in your own extension.
+ */
+type GetLocalURLHook interface {
+	/**
+	 * @param mixed &$title Title object of page
+	 * @param mixed &$url string value as output (out parameter, can modify)
+	 * @param mixed $query query options passed to Title::getLocalURL()
+	 * @return bool False to abort the hook
+	 */
+	OnGetLocalURL(title *interface{}, url *interface{}, query interface{}) bool
+}
+
+/**
+ * Run the GetLocalURL hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetLocalURL(title *interface{}, url *interface{}, query interface{}) bool {
+	args := []interface{}{title, url, query}
+	return r.container.run("GetLocalURL", args, func(handler interface{}) bool {
+		return handler.(GetLocalURLHook).OnGetLocalURL(title, url, query)
+	})
+}
+
+/**
+ * Modify local URLs specifically pointing to article paths
+ * without any fancy queries or variants.
+ */
+type GetLocalURL__ArticleHook interface {
+	/**
+	 * @param mixed &$title Title object of page
+	 * @param mixed &$url string value as output (out parameter, can modify)
+	 * @return bool False to abort the hook
+	 */
+	OnGetLocalURL__Article(title *interface{}, url *interface{}) bool
+}
+
+/**
+ * Run the GetLocalURL::Article hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetLocalURL__Article(title *interface{}, url *interface{}) bool {
+	args := []interface{}{title, url}
+	return r.container.run("GetLocalURL::Article", args, func(handler interface{}) bool {
+		return handler.(GetLocalURL__ArticleHook).OnGetLocalURL__Article(title, url)
+	})
+}
+
+/**
+ * Modify local URLs to internal pages.
+ */
+type GetLocalURL__InternalHook interface {
+	/**
+	 * @param mixed &$title Title object of page
+	 * @param mixed &$url string value as output (out parameter, can modify)
+	 * @param mixed $query query options passed to Title::getLocalURL()
+	 * @return bool False to abort the hook
+	 */
+	OnGetLocalURL__Internal(title *interface{}, url *interface{}, query interface{}) bool
+}
+
+/**
+ * Run the GetLocalURL::Internal hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetLocalURL__Internal(title *interface{}, url *interface{}, query interface{}) bool {
+	args := []interface{}{title, url, query}
+	return r.container.run("GetLocalURL::Internal", args, func(handler interface{}) bool {
+		return handler.(GetLocalURL__InternalHook).OnGetLocalURL__Internal(title, url, query)
+	})
+}
+
+/**
+ * Add log types where the target is a userpage
+ */
+type GetLogTypesOnUserHook interface {
+	/**
+	 * @param mixed &$types Array of log types
+	 * @return bool False to abort the hook
+	 */
+	OnGetLogTypesOnUser(types *interface{}) bool
+}
+
+/**
+ * Run the GetLogTypesOnUser hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetLogTypesOnUser(types *interface{}) bool {
+	args := []interface{}{types}
+	return r.container.run("GetLogTypesOnUser", args, func(handler interface{}) bool {
+		return handler.(GetLogTypesOnUserHook).OnGetLogTypesOnUser(types)
+	})
+}
+
+/**
+ * Modify the image metadata version currently in use. This
+ * is used when requesting image metadata from a ForeignApiRepo. Media handlers
+ * that need to have versioned metadata should add an element to the end of the
+ * version array of the form 'handler_name=version'. Most media handlers won't need
+ * to do this unless they broke backwards compatibility with a previous version of
+ * the media handler metadata output.
+ */
+type GetMetadataVersionHook interface {
+	/**
+	 * @param mixed &$version Array of version strings
+	 * @return bool False to abort the hook
+	 */
+	OnGetMetadataVersion(version *interface{}) bool
+}
+
+/**
+ * Run the GetMetadataVersion hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetMetadataVersion(version *interface{}) bool {
+	args := []interface{}{version}
+	return r.container.run("GetMetadataVersion", args, func(handler interface{}) bool {
+		return handler.(GetMetadataVersionHook).OnGetMetadataVersion(version)
+	})
+}
+
+/**
+ * Disable or modify the new messages alert
+ */
+type GetNewMessagesAlertHook interface {
+	/**
+	 * @param mixed &$newMessagesAlert An empty string by default. If the user has new talk page messages, this should be populated with an alert message to that effect
+	 * @param mixed $newtalks An empty array if the user has no new messages or an array containing links and revisions if there are new messages (See User::getNewMessageLinks)
+	 * @param mixed $user The user object of the user who is loading the page
+	 * @param mixed $out OutputPage object (to check what type of page the user is on)
+	 * @return bool False to abort the hook
+	 */
+	OnGetNewMessagesAlert(newMessagesAlert *interface{}, newtalks interface{}, user interface{}, out interface{}) bool
+}
+
+/**
+ * Run the GetNewMessagesAlert hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetNewMessagesAlert(newMessagesAlert *interface{}, newtalks interface{}, user interface{}, out interface{}) bool {
+	args := []interface{}{newMessagesAlert, newtalks, user, out}
+	return r.container.run("GetNewMessagesAlert", args, func(handler interface{}) bool {
+		return handler.(GetNewMessagesAlertHook).OnGetNewMessagesAlert(newMessagesAlert, newtalks, user, out)
+	})
+}
+
+/**
+ * Modify user preferences.
+ */
+type GetPreferencesHook interface {
+	/**
+	 * @param mixed $user User whose preferences are being modified.
+	 * @param mixed &$preferences Preferences description array, to be fed to an HTMLForm object
+	 * @return bool False to abort the hook
+	 */
+	OnGetPreferences(user interface{}, preferences *interface{}) bool
+}
+
+/**
+ * Run the GetPreferences hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetPreferences(user interface{}, preferences *interface{}) bool {
+	args := []interface{}{user, preferences}
+	return r.container.run("GetPreferences", args, func(handler interface{}) bool {
+		return handler.(GetPreferencesHook).OnGetPreferences(user, preferences)
+	})
+}
+
+/**
+ * Pre-emptively override the relative timestamp generated
+ * by MWTimestamp::getRelativeTimestamp(). Return false in this hook to use the
+ * custom output.
+ */
+type GetRelativeTimestampHook interface {
+	/**
+	 * @param mixed &$output string for the output timestamp
+	 * @param mixed &$diff DateInterval representing the difference between the timestamps
+	 * @param mixed $timestamp MWTimestamp object of the current (user-adjusted) timestamp
+	 * @param mixed $relativeTo MWTimestamp object of the relative (user-adjusted) timestamp
+	 * @param mixed $user User whose preferences are being used to make timestamp
+	 * @param mixed $lang Language that will be used to render the timestamp
+	 * @return bool False to abort the hook
+	 */
+	OnGetRelativeTimestamp(output *interface{}, diff *interface{}, timestamp interface{}, relativeTo interface{}, user interface{}, lang interface{}) bool
+}
+
+/**
+ * Run the GetRelativeTimestamp hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetRelativeTimestamp(output *interface{}, diff *interface{}, timestamp interface{}, relativeTo interface{}, user interface{}, lang interface{}) bool {
+	args := []interface{}{output, diff, timestamp, relativeTo, user, lang}
+	return r.container.run("GetRelativeTimestamp", args, func(handler interface{}) bool {
+		return handler.(GetRelativeTimestampHook).OnGetRelativeTimestamp(output, diff, timestamp, relativeTo, user, lang)
+	})
+}
+
+/**
+ * Replace or wrap the standard SlotDiffRenderer for some
+ * content type.
+ */
+type GetSlotDiffRendererHook interface {
+	/**
+	 * @param mixed $contentHandler ContentHandler for which the slot diff renderer is fetched.
+	 * @param mixed &$slotDiffRenderer SlotDiffRenderer to change or replace.
+	 * @param mixed $context IContextSource
+	 * @return bool False to abort the hook
+	 */
+	OnGetSlotDiffRenderer(contentHandler interface{}, slotDiffRenderer *interface{}, context interface{}) bool
+}
+
+/**
+ * Run the GetSlotDiffRenderer hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetSlotDiffRenderer(contentHandler interface{}, slotDiffRenderer *interface{}, context interface{}) bool {
+	args := []interface{}{contentHandler, slotDiffRenderer, context}
+	return r.container.run("GetSlotDiffRenderer", args, func(handler interface{}) bool {
+		return handler.(GetSlotDiffRendererHook).OnGetSlotDiffRenderer(contentHandler, slotDiffRenderer, context)
+	})
+}
+
+/**
+ * Called when generating the list of git viewers for
+ * Special:Version, use this to change the list.
+ */
+type GitViewersHook interface {
+	/**
+	 * @param mixed &$extTypes associative array of repo URLS to viewer URLs.
+	 * @return bool False to abort the hook
+	 */
+	OnGitViewers(extTypes *interface{}) bool
+}
+
+/**
+ * Run the GitViewers hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGitViewers(extTypes *interface{}) bool {
+	args := []interface{}{extTypes}
+	return r.container.run("GitViewers", args, func(handler interface{}) bool {
+		return handler.(GitViewersHook).OnGitViewers(extTypes)
+	})
+}
+
+/**
+ * Override whether a page should be cached in file
+ * cache.
+ */
+type HTMLFileCache__useFileCacheHook interface {
+	/**
+	 * @param mixed $context An IContextSource object with information about the request being served.
+	 * @return bool False to abort the hook
+	 */
+	OnHTMLFileCache__useFileCache(context interface{}) bool
+}
+
+/**
+ * Run the HTMLFileCache::useFileCache hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnHTMLFileCache__useFileCache(context interface{}) bool {
+	args := []interface{}{context}
+	return r.container.run("HTMLFileCache::useFileCache", args, func(handler interface{}) bool {
+		return handler.(HTMLFileCache__useFileCacheHook).OnHTMLFileCache__useFileCache(context)
+	})
+}
+
+/**
+ * Add one or more links to revision history page subtitle.
+ */
+type HistoryPageToolLinksHook interface {
+	/**
+	 * @param mixed $context IContextSource (object)
+	 * @param mixed $linkRenderer LinkRenderer instance
+	 * @param mixed &$links Array of HTML strings
+	 * @return bool False to abort the hook
+	 */
+	OnHistoryPageToolLinks(context interface{}, linkRenderer interface{}, links *interface{}) bool
+}
+
+/**
+ * Run the HistoryPageToolLinks hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnHistoryPageToolLinks(context interface{}, linkRenderer interface{}, links *interface{}) bool {
+	args := []interface{}{context, linkRenderer, links}
+	return r.container.run("HistoryPageToolLinks", args, func(handler interface{}) bool {
+		return handler.(HistoryPageToolLinksHook).OnHistoryPageToolLinks(context, linkRenderer, links)
+	})
+}
+
+/**
+ * Override or extend the revision tools available from the
+ * page history view, i.e. undo, rollback, etc.
+ */
+type HistoryRevisionToolsHook interface {
+	/**
+	 * @param mixed $rev Revision object
+	 * @param mixed &$links Array of HTML links
+	 * @param mixed $prevRev Revision object, next in line in page history, or null
+	 * @param mixed $user Current user object
+	 * @return bool False to abort the hook
+	 */
+	OnHistoryRevisionTools(rev interface{}, links *interface{}, prevRev interface{}, user interface{}) bool
+}
+
+/**
+ * Run the HistoryRevisionTools hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnHistoryRevisionTools(rev interface{}, links *interface{}, prevRev interface{}, user interface{}) bool {
+	args := []interface{}{rev, links, prevRev, user}
+	return r.container.run("HistoryRevisionTools", args, func(handler interface{}) bool {
+		return handler.(HistoryRevisionToolsHook).OnHistoryRevisionTools(rev, links, prevRev, user)
+	})
+}
+
+/**
+ * Used when generating internal and interwiki links in
+ * LinkRenderer, before processing starts.  Return false to skip default
+ * processing and return $ret.
+ */
+type HtmlPageLinkRendererBeginHook interface {
+	/**
+	 * @param mixed $linkRenderer the LinkRenderer object
+	 * @param mixed $target the LinkTarget that the link is pointing to
+	 * @param mixed &$text the contents that the <a> tag should have; either a plain, unescaped string or a HtmlArmor object; null means "default".
+	 * @param mixed &$customAttribs the HTML attributes that the <a> tag should have, in associative array form, with keys and values unescaped.  Should be merged with default values, with a value of false meaning to suppress the attribute.
+	 * @param mixed &$query the query string to add to the generated URL (the bit after the "?"), in associative array form, with keys and values unescaped.
+	 * @param mixed &$ret the value to return if your hook returns false.
+	 * @return bool False to abort the hook
+	 */
+	OnHtmlPageLinkRendererBegin(linkRenderer interface{}, target interface{}, text *interface{}, customAttribs *interface{}, query *interface{}, ret *interface{}) bool
+}
+
+/**
+ * Run the HtmlPageLinkRendererBegin hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnHtmlPageLinkRendererBegin(linkRenderer interface{}, target interface{}, text *interface{}, customAttribs *interface{}, query *interface{}, ret *interface{}) bool {
+	args := []interface{}{linkRenderer, target, text, customAttribs, query, ret}
+	return r.container.run("HtmlPageLinkRendererBegin", args, func(handler interface{}) bool {
+		return handler.(HtmlPageLinkRendererBeginHook).OnHtmlPageLinkRendererBegin(linkRenderer, target, text, customAttribs, query, ret)
+	})
+}
+
+/**
+ * Used when generating internal and interwiki links in LinkRenderer,
+ * just before the function returns a value.  If you return true, an <a> element
+ * with HTML attributes $attribs and contents $html will be returned.  If you
+ * return false, $ret will be returned.
+ */
+type HtmlPageLinkRendererEndHook interface {
+	/**
+	 * @param mixed $linkRenderer the LinkRenderer object
+	 * @param mixed $target the LinkTarget object that the link is pointing to
+	 * @param mixed $isKnown boolean indicating whether the page is known or not
+	 * @param mixed &$text the contents that the <a> tag should have; either a plain, unescaped string or a HtmlArmor object.
+	 * @param mixed &$attribs the final HTML attributes of the <a> tag, after processing, in associative array form.
+	 * @param mixed &$ret the value to return if your hook returns false.
+	 * @return bool False to abort the hook
+	 */
+	OnHtmlPageLinkRendererEnd(linkRenderer interface{}, target interface{}, isKnown interface{}, text *interface{}, attribs *interface{}, ret *interface{}) bool
+}
+
+/**
+ * Run the HtmlPageLinkRendererEnd hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnHtmlPageLinkRendererEnd(linkRenderer interface{}, target interface{}, isKnown interface{}, text *interface{}, attribs *interface{}, ret *interface{}) bool {
+	args := []interface{}{linkRenderer, target, isKnown, text, attribs, ret}
+	return r.container.run("HtmlPageLinkRendererEnd", args, func(handler interface{}) bool {
+		return handler.(HtmlPageLinkRendererEndHook).OnHtmlPageLinkRendererEnd(linkRenderer, target, isKnown, text, attribs, ret)
+	})
+}
+
+/**
+ * When constructing the URL to use in an IRC notification.
+ * Callee may modify $url and $query, URL will be constructed as $url . $query
+ */
+type IRCLineURLHook interface {
+	/**
+	 * @param mixed &$url URL to index.php
+	 * @param mixed &$query Query string
+	 * @param mixed $rc RecentChange object that triggered url generation
+	 * @return bool False to abort the hook
+	 */
+	OnIRCLineURL(url *interface{}, query *interface{}, rc interface{}) bool
+}
+
+/**
+ * Run the IRCLineURL hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnIRCLineURL(url *interface{}, query *interface{}, rc interface{}) bool {
+	args := []interface{}{url, query, rc}
+	return r.container.run("IRCLineURL", args, func(handler interface{}) bool {
+		return handler.(IRCLineURLHook).OnIRCLineURL(url, query, rc)
+	})
+}
+
+/**
+ * Called before producing the HTML created by a wiki
+ * image insertion. You can skip the default logic entirely by returning false, or
+ * just modify a few things using call-by-reference.
+ */
+type ImageBeforeProduceHTMLHook interface {
+	/**
+	 * @param mixed &$skin Skin object
+	 * @param mixed &$title Title object of the image
+	 * @param mixed &$file File object, or false if it doesn't exist
+	 * @param mixed &$frameParams Various parameters with special meanings; see documentation in includes/Linker.php for Linker::makeImageLink
+	 * @param mixed &$handlerParams Various parameters with special meanings; see documentation in includes/Linker.php for Linker::makeImageLink
+	 * @param mixed &$time Timestamp of file in 'YYYYMMDDHHIISS' string form, or false for current
+	 * @param mixed &$res Final HTML output, used if you return false
+	 * @param mixed $parser Parser instance
+	 * @param mixed &$query Query params for desc URL
+	 * @param mixed &$widthOption Used by the parser to remember the user preference thumbnailsize
+	 * @return bool False to abort the hook
+	 */
+	OnImageBeforeProduceHTML(skin *interface{}, title *interface{}, file *interface{}, frameParams *interface{}, handlerParams *interface{}, time *interface{}, res *interface{}, parser interface{}, query *interface{}, widthOption *interface{}) bool
+}
+
+/**
+ * Run the ImageBeforeProduceHTML hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnImageBeforeProduceHTML(skin *interface{}, title *interface{}, file *interface{}, frameParams *interface{}, handlerParams *interface{}, time *interface{}, res *interface{}, parser interface{}, query *interface{}, widthOption *interface{}) bool {
+	args := []interface{}{skin, title, file, frameParams, handlerParams, time, res, parser, query, widthOption}
+	return r.container.run("ImageBeforeProduceHTML", args, func(handler interface{}) bool {
+		return handler.(ImageBeforeProduceHTMLHook).OnImageBeforeProduceHTML(skin, title, file, frameParams, handlerParams, time, res, parser, query, widthOption)
+	})
+}
+
+/**
+ * Call potential extension just before showing
+ * the image on an image page.
+ */
+type ImageOpenShowImageInlineBeforeHook interface {
+	/**
+	 * @param mixed &$imagePage ImagePage object ($this)
+	 * @param mixed &$output $wgOut
+	 * @return bool False to abort the hook
+	 */
+	OnImageOpenShowImageInlineBefore(imagePage *interface{}, output *interface{}) bool
+}
+
+/**
+ * Run the ImageOpenShowImageInlineBefore hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnImageOpenShowImageInlineBefore(imagePage *interface{}, output *interface{}) bool {
+	args := []interface{}{imagePage, output}
+	return r.container.run("ImageOpenShowImageInlineBefore", args, func(handler interface{}) bool {
+		return handler.(ImageOpenShowImageInlineBeforeHook).OnImageOpenShowImageInlineBefore(imagePage, output)
+	})
+}
+
+/**
+ * Called after the image links section on an image
+ * page is built.
+ */
+type ImagePageAfterImageLinksHook interface {
+	/**
+	 * @param mixed $imagePage ImagePage object ($this)
+	 * @param mixed &$html HTML for the hook to add
+	 * @return bool False to abort the hook
+	 */
+	OnImagePageAfterImageLinks(imagePage interface{}, html *interface{}) bool
+}
+
+/**
+ * Run the ImagePageAfterImageLinks hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnImagePageAfterImageLinks(imagePage interface{}, html *interface{}) bool {
+	args := []interface{}{imagePage, html}
+	return r.container.run("ImagePageAfterImageLinks", args, func(handler interface{}) bool {
+		return handler.(ImagePageAfterImageLinksHook).OnImagePageAfterImageLinks(imagePage, html)
+	})
+}
+
+/**
+ * Called when a file history line is constructed.
+ */
+type ImagePageFileHistoryLineHook interface {
+	/**
+	 * @param mixed $imagePage ImagePage object ($this)
+	 * @param mixed $file the file
+	 * @param mixed &$line the HTML of the history line
+	 * @param mixed &$css the line CSS class
+	 * @return bool False to abort the hook
+	 */
+	OnImagePageFileHistoryLine(imagePage interface{}, file interface{}, line *interface{}, css *interface{}) bool
+}
+
+/**
+ * Run the ImagePageFileHistoryLine hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnImagePageFileHistoryLine(imagePage interface{}, file interface{}, line *interface{}, css *interface{}) bool {
+	args := []interface{}{imagePage, file, line, css}
+	return r.container.run("ImagePageFileHistoryLine", args, func(handler interface{}) bool {
+		return handler.(ImagePageFileHistoryLineHook).OnImagePageFileHistoryLine(imagePage, file, line, css)
+	})
+}
+
+/**
+ * Called when fetching the file associated with an image
+ * page.
+ */
+type ImagePageFindFileHook interface {
+	/**
+	 * @param mixed $page ImagePage object
+	 * @param mixed &$file File object
+	 * @param mixed &$displayFile displayed File object
+	 * @return bool False to abort the hook
+	 */
+	OnImagePageFindFile(page interface{}, file *interface{}, displayFile *interface{}) bool
+}
+
+/**
+ * Run the ImagePageFindFile hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnImagePageFindFile(page interface{}, file *interface{}, displayFile *interface{}) bool {
+	args := []interface{}{page, file, displayFile}
+	return r.container.run("ImagePageFindFile", args, func(handler interface{}) bool {
+		return handler.(ImagePageFindFileHook).OnImagePageFindFile(page, file, displayFile)
+	})
+}
+
+/**
+ * Called when the file toc on an image page is generated.
+ */
+type ImagePageShowTOCHook interface {
+	/**
+	 * @param mixed $page ImagePage object
+	 * @param mixed &$toc Array of <li> strings
+	 * @return bool False to abort the hook
+	 */
+	OnImagePageShowTOC(page interface{}, toc *interface{}) bool
+}
+
+/**
+ * Run the ImagePageShowTOC hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnImagePageShowTOC(page interface{}, toc *interface{}) bool {
+	args := []interface{}{page, toc}
+	return r.container.run("ImagePageShowTOC", args, func(handler interface{}) bool {
+		return handler.(ImagePageShowTOCHook).OnImagePageShowTOC(page, toc)
+	})
+}
+
+/**
+ * executed before file is streamed to user, but only when
+ * using img_auth.php.
+ */
+type ImgAuthBeforeStreamHook interface {
+	/**
+	 * @param mixed &$title the Title object of the file as it would appear for the upload page
+	 * @param mixed &$path the original file and path name when img_auth was invoked by the web server
+	 * @param mixed &$name the name only component of the file
+	 * @param mixed &$result The location to pass back results of the hook routine (only used if failed) $result[0]=The index of the header message $result[1]=The index of the body text message $result[2 through n]=Parameters passed to body text message. Please note the header message cannot receive/use parameters.
+	 * @return bool False to abort the hook
+	 */
+	OnImgAuthBeforeStream(title *interface{}, path *interface{}, name *interface{}, result *interface{}) bool
+}
+
+/**
+ * Run the ImgAuthBeforeStream hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnImgAuthBeforeStream(title *interface{}, path *interface{}, name *interface{}, result *interface{}) bool {
+	args := []interface{}{title, path, name, result}
+	return r.container.run("ImgAuthBeforeStream", args, func(handler interface{}) bool {
+		return handler.(ImgAuthBeforeStreamHook).OnImgAuthBeforeStream(title, path, name, result)
+	})
+}
+
+/**
+ * When parsing a XML tag in a log item.
+ * Return false to stop further processing of the tag
+ */
+type ImportHandleLogItemXMLTagHook interface {
+	/**
+	 * @param mixed $reader XMLReader object
+	 * @param mixed $logInfo Array of information
+	 * @return bool False to abort the hook
+	 */
+	OnImportHandleLogItemXMLTag(reader interface{}, logInfo interface{}) bool
+}
+
+/**
+ * Run the ImportHandleLogItemXMLTag hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnImportHandleLogItemXMLTag(reader interface{}, logInfo interface{}) bool {
+	args := []interface{}{reader, logInfo}
+	return r.container.run("ImportHandleLogItemXMLTag", args, func(handler interface{}) bool {
+		return handler.(ImportHandleLogItemXMLTagHook).OnImportHandleLogItemXMLTag(reader, logInfo)
+	})
+}
+
+/**
+ * When parsing a XML tag in a page.
+ * Return false to stop further processing of the tag
+ */
+type ImportHandlePageXMLTagHook interface {
+	/**
+	 * @param mixed $reader XMLReader object
+	 * @param mixed &$pageInfo Array of information
+	 * @return bool False to abort the hook
+	 */
+	OnImportHandlePageXMLTag(reader interface{}, pageInfo *interface{}) bool
+}
+
+/**
+ * Run the ImportHandlePageXMLTag hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnImportHandlePageXMLTag(reader interface{}, pageInfo *interface{}) bool {
+	args := []interface{}{reader, pageInfo}
+	return r.container.run("ImportHandlePageXMLTag", args, func(handler interface{}) bool {
+		return handler.(ImportHandlePageXMLTagHook).OnImportHandlePageXMLTag(reader, pageInfo)
+	})
+}
+
+/**
+ * When parsing a XML tag in a page revision.
+ * Return false to stop further processing of the tag
+ */
+type ImportHandleRevisionXMLTagHook interface {
+	/**
+	 * @param mixed $reader XMLReader object
+	 * @param mixed $pageInfo Array of page information
+	 * @param mixed $revisionInfo Array of revision information
+	 * @return bool False to abort the hook
+	 */
+	OnImportHandleRevisionXMLTag(reader interface{}, pageInfo interface{}, revisionInfo interface{}) bool
+}
+
+/**
+ * Run the ImportHandleRevisionXMLTag hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnImportHandleRevisionXMLTag(reader interface{}, pageInfo interface{}, revisionInfo interface{}) bool {
+	args := []interface{}{reader, pageInfo, revisionInfo}
+	return r.container.run("ImportHandleRevisionXMLTag", args, func(handler interface{}) bool {
+		return handler.(ImportHandleRevisionXMLTagHook).OnImportHandleRevisionXMLTag(reader, pageInfo, revisionInfo)
+	})
+}
+
+/**
+ * When parsing a top level XML tag.
+ * Return false to stop further processing of the tag
+ */
+type ImportHandleToplevelXMLTagHook interface {
+	/**
+	 * @param mixed $reader XMLReader object
+	 * @return bool False to abort the hook
+	 */
+	OnImportHandleToplevelXMLTag(reader interface{}) bool
+}
+
+/**
+ * Run the ImportHandleToplevelXMLTag hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnImportHandleToplevelXMLTag(reader interface{}) bool {
+	args := []interface{}{reader}
+	return r.container.run("ImportHandleToplevelXMLTag", args, func(handler interface{}) bool {
+		return handler.(ImportHandleToplevelXMLTagHook).OnImportHandleToplevelXMLTag(reader)
+	})
+}
+
+/**
+ * When a user doesn't exist locally, this hook is
+ * called to give extensions an opportunity to auto-create it. If the auto-creation
+ * is successful, return false.
+ */
+type ImportHandleUnknownUserHook interface {
+	/**
+	 * @param mixed $name User name
+	 * @return bool False to abort the hook
+	 */
+	OnImportHandleUnknownUser(name interface{}) bool
+}
+
+/**
+ * Run the ImportHandleUnknownUser hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnImportHandleUnknownUser(name interface{}) bool {
+	args := []interface{}{name}
+	return r.container.run("ImportHandleUnknownUser", args, func(handler interface{}) bool {
+		return handler.(ImportHandleUnknownUserHook).OnImportHandleUnknownUser(name)
+	})
+}
+
+/**
+ * When parsing a XML tag in a file upload.
+ * Return false to stop further processing of the tag
+ */
+type ImportHandleUploadXMLTagHook interface {
+	/**
+	 * @param mixed $reader XMLReader object
+	 * @param mixed $revisionInfo Array of information
+	 * @return bool False to abort the hook
+	 */
+	OnImportHandleUploadXMLTag(reader interface{}, revisionInfo interface{}) bool
+}
+
+/**
+ * Run the ImportHandleUploadXMLTag hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnImportHandleUploadXMLTag(reader interface{}, revisionInfo interface{}) bool {
+	args := []interface{}{reader, revisionInfo}
+	return r.container.run("ImportHandleUploadXMLTag", args, func(handler interface{}) bool {
+		return handler.(ImportHandleUploadXMLTagHook).OnImportHandleUploadXMLTag(reader, revisionInfo)
+	})
+}
+
+/**
+ * Hook to change the interwiki link used in log entries
+ * and edit summaries for transwiki imports.
+ */
+type ImportLogInterwikiLinkHook interface {
+	/**
+	 * @param mixed &$fullInterwikiPrefix Interwiki prefix, may contain colons.
+	 * @param mixed &$pageTitle String that contains page title.
+	 * @return bool False to abort the hook
+	 */
+	OnImportLogInterwikiLink(fullInterwikiPrefix *interface{}, pageTitle *interface{}) bool
+}
+
+/**
+ * Run the ImportLogInterwikiLink hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnImportLogInterwikiLink(fullInterwikiPrefix *interface{}, pageTitle *interface{}) bool {
+	args := []interface{}{fullInterwikiPrefix, pageTitle}
+	return r.container.run("ImportLogInterwikiLink", args, func(handler interface{}) bool {
+		return handler.(ImportLogInterwikiLinkHook).OnImportLogInterwikiLink(fullInterwikiPrefix, pageTitle)
+	})
+}
+
+/**
+ * Called when reading from the $wgImportSources configuration
+ * variable. Can be used to lazy-load the import sources list.
+ */
+type ImportSourcesHook interface {
+	/**
+	 * @param mixed &$importSources The value of $wgImportSources. Modify as necessary. See the comment in DefaultSettings.php for the detail of how to structure this array.
+	 * @return bool False to abort the hook
+	 */
+	OnImportSources(importSources *interface{}) bool
+}
+
+/**
+ * Run the ImportSources hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnImportSources(importSources *interface{}) bool {
+	args := []interface{}{importSources}
+	return r.container.run("ImportSources", args, func(handler interface{}) bool {
+		return handler.(ImportSourcesHook).OnImportSources(importSources)
+	})
+}
+
+/**
+ * When building information to display on the action=info page.
+ */
+type InfoActionHook interface {
+	/**
+	 * @param mixed $context IContextSource object
+	 * @param mixed &$pageInfo Array of information
+	 * @return bool False to abort the hook
+	 */
+	OnInfoAction(context interface{}, pageInfo *interface{}) bool
+}
+
+/**
+ * Run the InfoAction hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnInfoAction(context interface{}, pageInfo *interface{}) bool {
+	args := []interface{}{context, pageInfo}
+	return r.container.run("InfoAction", args, func(handler interface{}) bool {
+		return handler.(InfoActionHook).OnInfoAction(context, pageInfo)
+	})
+}
+
+/**
+ * MediaWiki check to see if title is a redirect.
+ */
+type InitializeArticleMaybeRedirectHook interface {
+	/**
+	 * @param mixed &$title Title object for the current page
+	 * @param mixed &$request WebRequest
+	 * @param mixed &$ignoreRedirect boolean to skip redirect check
+	 * @param mixed &$target Title/string of redirect target
+	 * @param mixed &$article Article object
+	 * @return bool False to abort the hook
+	 */
+	OnInitializeArticleMaybeRedirect(title *interface{}, request *interface{}, ignoreRedirect *interface{}, target *interface{}, article *interface{}) bool
+}
+
+/**
+ * Run the InitializeArticleMaybeRedirect hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnInitializeArticleMaybeRedirect(title *interface{}, request *interface{}, ignoreRedirect *interface{}, target *interface{}, article *interface{}) bool {
+	args := []interface{}{title, request, ignoreRedirect, target, article}
+	return r.container.run("InitializeArticleMaybeRedirect", args, func(handler interface{}) bool {
+		return handler.(InitializeArticleMaybeRedirectHook).OnInitializeArticleMaybeRedirect(title, request, ignoreRedirect, target, article)
+	})
+}
+
+/**
+ * during Parser's internalParse method before links
+ * but after nowiki/noinclude/includeonly/onlyinclude and other processings.
+ */
+type InternalParseBeforeLinksHook interface {
+	/**
+	 * @param mixed &$parser Parser object
+	 * @param mixed &$text string containing partially parsed text
+	 * @param mixed &$stripState Parser's internal StripState object
+	 * @return bool False to abort the hook
+	 */
+	OnInternalParseBeforeLinks(parser *interface{}, text *interface{}, stripState *interface{}) bool
+}
+
+/**
+ * Run the InternalParseBeforeLinks hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnInternalParseBeforeLinks(parser *interface{}, text *interface{}, stripState *interface{}) bool {
+	args := []interface{}{parser, text, stripState}
+	return r.container.run("InternalParseBeforeLinks", args, func(handler interface{}) bool {
+		return handler.(InternalParseBeforeLinksHook).OnInternalParseBeforeLinks(parser, text, stripState)
+	})
+}
+
+/**
+ * during Parser's internalParse method just before
+ * the parser removes unwanted/dangerous HTML tags and after nowiki/noinclude/
+ * includeonly/onlyinclude and other processings. Ideal for syntax-extensions after
+ * template/parser function execution which respect nowiki and HTML-comments.
+ */
+type InternalParseBeforeSanitizeHook interface {
+	/**
+	 * @param mixed &$parser Parser object
+	 * @param mixed &$text string containing partially parsed text
+	 * @param mixed &$stripState Parser's internal StripState object
+	 * @return bool False to abort the hook
+	 */
+	OnInternalParseBeforeSanitize(parser *interface{}, text *interface{}, stripState *interface{}) bool
+}
+
+/**
+ * Run the InternalParseBeforeSanitize hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnInternalParseBeforeSanitize(parser *interface{}, text *interface{}, stripState *interface{}) bool {
+	args := []interface{}{parser, text, stripState}
+	return r.container.run("InternalParseBeforeSanitize", args, func(handler interface{}) bool {
+		return handler.(InternalParseBeforeSanitizeHook).OnInternalParseBeforeSanitize(parser, text, stripState)
+	})
+}
+
+/**
+ * When resolving if a given prefix is an interwiki or not.
+ * Return true without providing an interwiki to continue interwiki search.
+ */
+type InterwikiLoadPrefixHook interface {
+	/**
+	 * @param mixed $prefix interwiki prefix we are looking for.
+	 * @param mixed &$iwData output array describing the interwiki with keys iw_url, iw_local, iw_trans and optionally iw_api and iw_wikiid.
+	 * @return bool False to abort the hook
+	 */
+	OnInterwikiLoadPrefix(prefix interface{}, iwData *interface{}) bool
+}
+
+/**
+ * Run the InterwikiLoadPrefix hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnInterwikiLoadPrefix(prefix interface{}, iwData *interface{}) bool {
+	args := []interface{}{prefix, iwData}
+	return r.container.run("InterwikiLoadPrefix", args, func(handler interface{}) bool {
+		return handler.(InterwikiLoadPrefixHook).OnInterwikiLoadPrefix(prefix, iwData)
+	})
+}
+
+/**
+ * Called after a user's email has been invalidated
+ * successfully.
+ */
+type InvalidateEmailCompleteHook interface {
+	/**
+	 * @param mixed $user user (object) whose email is being invalidated
+	 * @return bool False to abort the hook
+	 */
+	OnInvalidateEmailComplete(user interface{}) bool
+}
+
+/**
+ * Run the InvalidateEmailComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnInvalidateEmailComplete(user interface{}) bool {
+	args := []interface{}{user}
+	return r.container.run("InvalidateEmailComplete", args, func(handler interface{}) bool {
+		return handler.(InvalidateEmailCompleteHook).OnInvalidateEmailComplete(user)
+	})
+}
+
+/**
+ * Override the result of Article::isFileCacheable() (if true)
+ */
+type IsFileCacheableHook interface {
+	/**
+	 * @param mixed &$article article (object) being checked
+	 * @return bool False to abort the hook
+	 */
+	OnIsFileCacheable(article *interface{}) bool
+}
+
+/**
+ * Run the IsFileCacheable hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnIsFileCacheable(article *interface{}) bool {
+	args := []interface{}{article}
+	return r.container.run("IsFileCacheable", args, func(handler interface{}) bool {
+		return handler.(IsFileCacheableHook).OnIsFileCacheable(article)
+	})
+}
+
+/**
+ * Override the result of IP::isTrustedProxy()
+ */
+type IsTrustedProxyHook interface {
+	/**
+	 * @param mixed &$ip IP being check
+	 * @param mixed &$result Change this value to override the result of IP::isTrustedProxy()
+	 * @return bool False to abort the hook
+	 */
+	OnIsTrustedProxy(ip *interface{}, result *interface{}) bool
+}
+
+/**
+ * Run the IsTrustedProxy hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnIsTrustedProxy(ip *interface{}, result *interface{}) bool {
+	args := []interface{}{ip, result}
+	return r.container.run("IsTrustedProxy", args, func(handler interface{}) bool {
+		return handler.(IsTrustedProxyHook).OnIsTrustedProxy(ip, result)
+	})
+}
+
+/**
+ * Override the result of UploadFromUrl::isAllowedUrl()
+ */
+type IsUploadAllowedFromUrlHook interface {
+	/**
+	 * @param mixed $url URL used to upload from
+	 * @param mixed &$allowed Boolean indicating if uploading is allowed for given URL
+	 * @return bool False to abort the hook
+	 */
+	OnIsUploadAllowedFromUrl(url interface{}, allowed *interface{}) bool
+}
+
+/**
+ * Run the IsUploadAllowedFromUrl hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnIsUploadAllowedFromUrl(url interface{}, allowed *interface{}) bool {
+	args := []interface{}{url, allowed}
+	return r.container.run("IsUploadAllowedFromUrl", args, func(handler interface{}) bool {
+		return handler.(IsUploadAllowedFromUrlHook).OnIsUploadAllowedFromUrl(url, allowed)
+	})
+}
+
+/**
+ */
+type Language__getMessagesFileNameHook interface {
+	/**
+	 * @param mixed $code The language code or the language we're looking for a messages file for
+	 * @param mixed &$file The messages file path, you can override this to change the location.
+	 * @return bool False to abort the hook
+	 */
+	OnLanguage__getMessagesFileName(code interface{}, file *interface{}) bool
+}
+
+/**
+ * Run the Language::getMessagesFileName hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLanguage__getMessagesFileName(code interface{}, file *interface{}) bool {
+	args := []interface{}{code, file}
+	return r.container.run("Language::getMessagesFileName", args, func(handler interface{}) bool {
+		return handler.(Language__getMessagesFileNameHook).OnLanguage__getMessagesFileName(code, file)
+	})
+}
+
+/**
+ * DEPRECATED since 1.16! Use $magicWords in a file listed in
+ * $wgExtensionMessagesFiles instead.
+ * Use this to define synonyms of magic words depending of the language
+ */
+type LanguageGetMagicHook interface {
+	/**
+	 * @param mixed &$magicExtensions associative array of magic words synonyms
+	 * @param mixed $lang language code (string)
+	 * @return bool False to abort the hook
+	 */
+	OnLanguageGetMagic(magicExtensions *interface{}, lang interface{}) bool
+}
+
+/**
+ * Run the LanguageGetMagic hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLanguageGetMagic(magicExtensions *interface{}, lang interface{}) bool {
+	args := []interface{}{magicExtensions, lang}
+	return r.container.run("LanguageGetMagic", args, func(handler interface{}) bool {
+		return handler.(LanguageGetMagicHook).OnLanguageGetMagic(magicExtensions, lang)
+	})
+}
+
+/**
+ * Provide custom ordering for namespaces or
+ * remove namespaces. Do not use this hook to add namespaces. Use
+ * CanonicalNamespaces for that.
+ */
+type LanguageGetNamespacesHook interface {
+	/**
+	 * @param mixed &$namespaces Array of namespaces indexed by their numbers
+	 * @return bool False to abort the hook
+	 */
+	OnLanguageGetNamespaces(namespaces *interface{}) bool
+}
+
+/**
+ * Run the LanguageGetNamespaces hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLanguageGetNamespaces(namespaces *interface{}) bool {
+	args := []interface{}{namespaces}
+	return r.container.run("LanguageGetNamespaces", args, func(handler interface{}) bool {
+		return handler.(LanguageGetNamespacesHook).OnLanguageGetNamespaces(namespaces)
+	})
+}
+
+/**
+ * DEPRECATED! Use $specialPageAliases in a file
+ * listed in $wgExtensionMessagesFiles instead.
+ * Use to define aliases of special pages names depending of the language
+ */
+type LanguageGetSpecialPageAliasesHook interface {
+	/**
+	 * @param mixed &$specialPageAliases associative array of magic words synonyms
+	 * @param mixed $lang language code (string)
+	 * @return bool False to abort the hook
+	 */
+	OnLanguageGetSpecialPageAliases(specialPageAliases *interface{}, lang interface{}) bool
+}
+
+/**
+ * Run the LanguageGetSpecialPageAliases hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLanguageGetSpecialPageAliases(specialPageAliases *interface{}, lang interface{}) bool {
+	args := []interface{}{specialPageAliases, lang}
+	return r.container.run("LanguageGetSpecialPageAliases", args, func(handler interface{}) bool {
+		return handler.(LanguageGetSpecialPageAliasesHook).OnLanguageGetSpecialPageAliases(specialPageAliases, lang)
+	})
+}
+
+/**
+ * Provide translated language names.
+ */
+type LanguageGetTranslatedLanguageNamesHook interface {
+	/**
+	 * @param map[string]string &$names array of language code => language name
+	 * @param string $code language of the preferred translations
+	 * @return bool False to abort the hook
+	 */
+	OnLanguageGetTranslatedLanguageNames(names *map[string]string, code string) bool
+}
+
+/**
+ * Run the LanguageGetTranslatedLanguageNames hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLanguageGetTranslatedLanguageNames(names *map[string]string, code string) bool {
+	args := []interface{}{names, code}
+	return r.container.run("LanguageGetTranslatedLanguageNames", args, func(handler interface{}) bool {
+		return handler.(LanguageGetTranslatedLanguageNamesHook).OnLanguageGetTranslatedLanguageNames(names, code)
+	})
+}
+
+/**
+ * Manipulate a page's language links. This is called
+ * in various places to allow extensions to define the effective language
+ * links for a page.
+ */
+type LanguageLinksHook interface {
+	/**
+	 * @param mixed $title The page's Title.
+	 * @param mixed &$links Array with elements of the form "language:title" in the order that they will be output.
+	 * @param mixed &$linkFlags Associative array mapping prefixed links to arrays of flags. Currently unused, but planned to provide support for marking individual language links in the UI, e.g. for featured articles.
+	 * @return bool False to abort the hook
+	 */
+	OnLanguageLinks(title interface{}, links *interface{}, linkFlags *interface{}) bool
+}
+
+/**
+ * Run the LanguageLinks hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLanguageLinks(title interface{}, links *interface{}, linkFlags *interface{}) bool {
+	args := []interface{}{title, links, linkFlags}
+	return r.container.run("LanguageLinks", args, func(handler interface{}) bool {
+		return handler.(LanguageLinksHook).OnLanguageLinks(title, links, linkFlags)
+	})
+}
+
+/**
+ * Hook to change the language selector available on a page.
+ */
+type LanguageSelectorHook interface {
+	/**
+	 * @param mixed $out The output page.
+	 * @param mixed $cssClassName CSS class name of the language selector.
+	 * @return bool False to abort the hook
+	 */
+	OnLanguageSelector(out interface{}, cssClassName interface{}) bool
+}
+
+/**
+ * Run the LanguageSelector hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLanguageSelector(out interface{}, cssClassName interface{}) bool {
+	args := []interface{}{out, cssClassName}
+	return r.container.run("LanguageSelector", args, func(handler interface{}) bool {
+		return handler.(LanguageSelectorHook).OnLanguageSelector(out, cssClassName)
+	})
+}
+
+/**
+ * DEPRECATED since 1.28! Use HtmlPageLinkRendererBegin instead.
+ * Used when generating internal and interwiki links in Linker::link(), before
+ * processing starts.  Return false to skip default processing and return $ret. See
+ * documentation for Linker::link() for details on the expected meanings of
+ * parameters.
+ */
+type LinkBeginHook interface {
+	/**
+	 * @param mixed $skin the Skin object
+	 * @param mixed $target the Title that the link is pointing to
+	 * @param mixed &$html the contents that the <a> tag should have (raw HTML); null means "default".
+	 * @param mixed &$customAttribs the HTML attributes that the <a> tag should have, in associative array form, with keys and values unescaped.  Should be merged with default values, with a value of false meaning to suppress the attribute.
+	 * @param mixed &$query the query string to add to the generated URL (the bit after the "?"), in associative array form, with keys and values unescaped.
+	 * @param mixed &$options array of options.  Can include 'known', 'broken', 'noclasses'.
+	 * @param mixed &$ret the value to return if your hook returns false.
+	 * @return bool False to abort the hook
+	 */
+	OnLinkBegin(skin interface{}, target interface{}, html *interface{}, customAttribs *interface{}, query *interface{}, options *interface{}, ret *interface{}) bool
+}
+
+/**
+ * Run the LinkBegin hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLinkBegin(skin interface{}, target interface{}, html *interface{}, customAttribs *interface{}, query *interface{}, options *interface{}, ret *interface{}) bool {
+	args := []interface{}{skin, target, html, customAttribs, query, options, ret}
+	return r.container.run("LinkBegin", args, func(handler interface{}) bool {
+		return handler.(LinkBeginHook).OnLinkBegin(skin, target, html, customAttribs, query, options, ret)
+	})
+}
+
+/**
+ * DEPRECATED since 1.28! Use HtmlPageLinkRendererEnd hook instead
+ * Used when generating internal and interwiki links in Linker::link(),
+ * just before the function returns a value.  If you return true, an <a> element
+ * with HTML attributes $attribs and contents $html will be returned.  If you
+ * return false, $ret will be returned.
+ */
+type LinkEndHook interface {
+	/**
+	 * @param mixed $skin the Skin object
+	 * @param mixed $target the Title object that the link is pointing to
+	 * @param mixed $options the options.  Will always include either 'known' or 'broken', and may include 'noclasses'.
+	 * @param mixed &$html the final (raw HTML) contents of the <a> tag, after processing.
+	 * @param mixed &$attribs the final HTML attributes of the <a> tag, after processing, in associative array form.
+	 * @param mixed &$ret the value to return if your hook returns false.
+	 * @return bool False to abort the hook
+	 */
+	OnLinkEnd(skin interface{}, target interface{}, options interface{}, html *interface{}, attribs *interface{}, ret *interface{}) bool
+}
+
+/**
+ * Run the LinkEnd hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLinkEnd(skin interface{}, target interface{}, options interface{}, html *interface{}, attribs *interface{}, ret *interface{}) bool {
+	args := []interface{}{skin, target, options, html, attribs, ret}
+	return r.container.run("LinkEnd", args, func(handler interface{}) bool {
+		return handler.(LinkEndHook).OnLinkEnd(skin, target, options, html, attribs, ret)
+	})
+}
+
+/**
+ * At the end of Linker::makeExternalImage() just
+ * before the return.
+ */
+type LinkerMakeExternalImageHook interface {
+	/**
+	 * @param mixed &$url the image url
+	 * @param mixed &$alt the image's alt text
+	 * @param mixed &$img the new image HTML (if returning false)
+	 * @return bool False to abort the hook
+	 */
+	OnLinkerMakeExternalImage(url *interface{}, alt *interface{}, img *interface{}) bool
+}
+
+/**
+ * Run the LinkerMakeExternalImage hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLinkerMakeExternalImage(url *interface{}, alt *interface{}, img *interface{}) bool {
+	args := []interface{}{url, alt, img}
+	return r.container.run("LinkerMakeExternalImage", args, func(handler interface{}) bool {
+		return handler.(LinkerMakeExternalImageHook).OnLinkerMakeExternalImage(url, alt, img)
+	})
+}
+
+/**
+ * At the end of Linker::makeExternalLink() just
+ * before the return.
+ */
+type LinkerMakeExternalLinkHook interface {
+	/**
+	 * @param mixed &$url the link url
+	 * @param mixed &$text the link text
+	 * @param mixed &$link the new link HTML (if returning false)
+	 * @param mixed &$attribs the attributes to be applied.
+	 * @param mixed $linkType The external link type
+	 * @return bool False to abort the hook
+	 */
+	OnLinkerMakeExternalLink(url *interface{}, text *interface{}, link *interface{}, attribs *interface{}, linkType interface{}) bool
+}
+
+/**
+ * Run the LinkerMakeExternalLink hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLinkerMakeExternalLink(url *interface{}, text *interface{}, link *interface{}, attribs *interface{}, linkType interface{}) bool {
+	args := []interface{}{url, text, link, attribs, linkType}
+	return r.container.run("LinkerMakeExternalLink", args, func(handler interface{}) bool {
+		return handler.(LinkerMakeExternalLinkHook).OnLinkerMakeExternalLink(url, text, link, attribs, linkType)
+	})
+}
+
+/**
+ * At the end of Linker::makeMediaLinkFile() just
+ * before the return.
+ */
+type LinkerMakeMediaLinkFileHook interface {
+	/**
+	 * @param mixed $title the Title object that the link is pointing to
+	 * @param mixed $file the File object or false if broken link
+	 * @param mixed &$html the link text
+	 * @param mixed &$attribs the attributes to be applied
+	 * @param mixed &$ret the value to return if your hook returns false
+	 * @return bool False to abort the hook
+	 */
+	OnLinkerMakeMediaLinkFile(title interface{}, file interface{}, html *interface{}, attribs *interface{}, ret *interface{}) bool
+}
+
+/**
+ * Run the LinkerMakeMediaLinkFile hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLinkerMakeMediaLinkFile(title interface{}, file interface{}, html *interface{}, attribs *interface{}, ret *interface{}) bool {
+	args := []interface{}{title, file, html, attribs, ret}
+	return r.container.run("LinkerMakeMediaLinkFile", args, func(handler interface{}) bool {
+		return handler.(LinkerMakeMediaLinkFileHook).OnLinkerMakeMediaLinkFile(title, file, html, attribs, ret)
+	})
+}
+
+/**
+ * At the beginning of LinksUpdate::doUpdate() just before the
+ * actual update.
+ */
+type LinksUpdateHook interface {
+	/**
+	 * @param mixed &$linksUpdate the LinksUpdate object
+	 * @return bool False to abort the hook
+	 */
+	OnLinksUpdate(linksUpdate *interface{}) bool
+}
+
+/**
+ * Run the LinksUpdate hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLinksUpdate(linksUpdate *interface{}) bool {
+	args := []interface{}{linksUpdate}
+	return r.container.run("LinksUpdate", args, func(handler interface{}) bool {
+		return handler.(LinksUpdateHook).OnLinksUpdate(linksUpdate)
+	})
+}
+
+/**
+ * At the end of LinksUpdate::incrTableUpdate() after
+ * each link table insert.  For example, pagelinks, imagelinks, externallinks.
+ */
+type LinksUpdateAfterInsertHook interface {
+	/**
+	 * @param mixed $linksUpdate LinksUpdate object
+	 * @param mixed $table the table to insert links to
+	 * @param mixed $insertions an array of links to insert
+	 * @return bool False to abort the hook
+	 */
+	OnLinksUpdateAfterInsert(linksUpdate interface{}, table interface{}, insertions interface{}) bool
+}
+
+/**
+ * Run the LinksUpdateAfterInsert hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLinksUpdateAfterInsert(linksUpdate interface{}, table interface{}, insertions interface{}) bool {
+	args := []interface{}{linksUpdate, table, insertions}
+	return r.container.run("LinksUpdateAfterInsert", args, func(handler interface{}) bool {
+		return handler.(LinksUpdateAfterInsertHook).OnLinksUpdateAfterInsert(linksUpdate, table, insertions)
+	})
+}
+
+/**
+ * At the end of LinksUpdate::doUpdate() when updating,
+ * including delete and insert, has completed for all link tables
+ */
+type LinksUpdateCompleteHook interface {
+	/**
+	 * @param mixed &$linksUpdate the LinksUpdate object
+	 * @param mixed $ticket prior result of LBFactory::getEmptyTransactionTicket()
+	 * @return bool False to abort the hook
+	 */
+	OnLinksUpdateComplete(linksUpdate *interface{}, ticket interface{}) bool
+}
+
+/**
+ * Run the LinksUpdateComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLinksUpdateComplete(linksUpdate *interface{}, ticket interface{}) bool {
+	args := []interface{}{linksUpdate, ticket}
+	return r.container.run("LinksUpdateComplete", args, func(handler interface{}) bool {
+		return handler.(LinksUpdateCompleteHook).OnLinksUpdateComplete(linksUpdate, ticket)
+	})
+}
+
+/**
+ * At the end of LinksUpdate() is construction.
+ */
+type LinksUpdateConstructedHook interface {
+	/**
+	 * @param mixed &$linksUpdate the LinksUpdate object
+	 * @return bool False to abort the hook
+	 */
+	OnLinksUpdateConstructed(linksUpdate *interface{}) bool
+}
+
+/**
+ * Run the LinksUpdateConstructed hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLinksUpdateConstructed(linksUpdate *interface{}) bool {
+	args := []interface{}{linksUpdate}
+	return r.container.run("LinksUpdateConstructed", args, func(handler interface{}) bool {
+		return handler.(LinksUpdateConstructedHook).OnLinksUpdateConstructed(linksUpdate)
+	})
+}
+
+/**
+ * When trying to find all defined tags.
+ */
+type ListDefinedTagsHook interface {
+	/**
+	 * @param mixed &$tags The list of tags.
+	 * @return bool False to abort the hook
+	 */
+	OnListDefinedTags(tags *interface{}) bool
+}
+
+/**
+ * Run the ListDefinedTags hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnListDefinedTags(tags *interface{}) bool {
+	args := []interface{}{tags}
+	return r.container.run("ListDefinedTags", args, func(handler interface{}) bool {
+		return handler.(ListDefinedTagsHook).OnListDefinedTags(tags)
+	})
+}
+
+/**
+ * Called during database installation and updates.
+ */
+type LoadExtensionSchemaUpdatesHook interface {
+	/**
+	 * @param mixed $updater A DatabaseUpdater subclass
+	 * @return bool False to abort the hook
+	 */
+	OnLoadExtensionSchemaUpdates(updater interface{}) bool
+}
+
+/**
+ * Run the LoadExtensionSchemaUpdates hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLoadExtensionSchemaUpdates(updater interface{}) bool {
+	args := []interface{}{updater}
+	return r.container.run("LoadExtensionSchemaUpdates", args, func(handler interface{}) bool {
+		return handler.(LoadExtensionSchemaUpdatesHook).OnLoadExtensionSchemaUpdates(updater)
+	})
+}
+
+/**
+ * Called before file history query performed.
+ */
+type LocalFile__getHistoryHook interface {
+	/**
+	 * @param mixed &$file the File object
+	 * @param mixed &$tables tables
+	 * @param mixed &$fields select fields
+	 * @param mixed &$conds conditions
+	 * @param mixed &$opts query options
+	 * @param mixed &$join_conds JOIN conditions
+	 * @return bool False to abort the hook
+	 */
+	OnLocalFile__getHistory(file *interface{}, tables *interface{}, fields *interface{}, conds *interface{}, opts *interface{}, join_conds *interface{}) bool
+}
+
+/**
+ * Run the LocalFile::getHistory hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLocalFile__getHistory(file *interface{}, tables *interface{}, fields *interface{}, conds *interface{}, opts *interface{}, join_conds *interface{}) bool {
+	args := []interface{}{file, tables, fields, conds, opts, join_conds}
+	return r.container.run("LocalFile::getHistory", args, func(handler interface{}) bool {
+		return handler.(LocalFile__getHistoryHook).OnLocalFile__getHistory(file, tables, fields, conds, opts, join_conds)
+	})
+}
+
+/**
+ * Called before thumbnails for a local file a purged.
+ */
+type LocalFilePurgeThumbnailsHook interface {
+	/**
+	 * @param mixed $file the File object
+	 * @param mixed $archiveName name of an old file version or false if it's the current one
+	 * @return bool False to abort the hook
+	 */
+	OnLocalFilePurgeThumbnails(file interface{}, archiveName interface{}) bool
+}
+
+/**
+ * Run the LocalFilePurgeThumbnails hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLocalFilePurgeThumbnails(file interface{}, archiveName interface{}) bool {
+	args := []interface{}{file, archiveName}
+	return r.container.run("LocalFilePurgeThumbnails", args, func(handler interface{}) bool {
+		return handler.(LocalFilePurgeThumbnailsHook).OnLocalFilePurgeThumbnails(file, archiveName)
+	})
+}
+
+/**
+ * Called when a local user has been created
+ */
+type LocalUserCreatedHook interface {
+	/**
+	 * @param mixed $user User object for the created user
+	 * @param mixed $autocreated Boolean, whether this was an auto-creation
+	 * @return bool False to abort the hook
+	 */
+	OnLocalUserCreated(user interface{}, autocreated interface{}) bool
+}
+
+/**
+ * Run the LocalUserCreated hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLocalUserCreated(user interface{}, autocreated interface{}) bool {
+	args := []interface{}{user, autocreated}
+	return r.container.run("LocalUserCreated", args, func(handler interface{}) bool {
+		return handler.(LocalUserCreatedHook).OnLocalUserCreated(user, autocreated)
+	})
+}
+
+/**
+ * Called when loading the localisation data into
+ * cache.
+ */
+type LocalisationCacheRecacheHook interface {
+	/**
+	 * @param mixed $cache The LocalisationCache object
+	 * @param mixed $code language code
+	 * @param mixed &$alldata The localisation data from core and extensions
+	 * @param mixed &$purgeBlobs whether to purge/update the message blobs via MessageBlobStore::clear()
+	 * @return bool False to abort the hook
+	 */
+	OnLocalisationCacheRecache(cache interface{}, code interface{}, alldata *interface{}, purgeBlobs *interface{}) bool
+}
+
+/**
+ * Run the LocalisationCacheRecache hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLocalisationCacheRecache(cache interface{}, code interface{}, alldata *interface{}, purgeBlobs *interface{}) bool {
+	args := []interface{}{cache, code, alldata, purgeBlobs}
+	return r.container.run("LocalisationCacheRecache", args, func(handler interface{}) bool {
+		return handler.(LocalisationCacheRecacheHook).OnLocalisationCacheRecache(cache, code, alldata, purgeBlobs)
+	})
+}
+
+/**
+ * Called for each language when merging
+ * fallback data into the cache.
+ */
+type LocalisationCacheRecacheFallbackHook interface {
+	/**
+	 * @param mixed $cache The LocalisationCache object
+	 * @param mixed $code language code
+	 * @param mixed &$alldata The localisation data from core and extensions. Note some keys may be omitted if they won't be merged into the final result.
+	 * @return bool False to abort the hook
+	 */
+	OnLocalisationCacheRecacheFallback(cache interface{}, code interface{}, alldata *interface{}) bool
+}
+
+/**
+ * Run the LocalisationCacheRecacheFallback hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLocalisationCacheRecacheFallback(cache interface{}, code interface{}, alldata *interface{}) bool {
+	args := []interface{}{cache, code, alldata}
+	return r.container.run("LocalisationCacheRecacheFallback", args, func(handler interface{}) bool {
+		return handler.(LocalisationCacheRecacheFallbackHook).OnLocalisationCacheRecacheFallback(cache, code, alldata)
+	})
+}
+
+/**
+ * When fetching the blacklist of
+ * localisation checks.
+ */
+type LocalisationChecksBlacklistHook interface {
+	/**
+	 * @param mixed &$blacklist array of checks to blacklist. See the bottom of maintenance/language/checkLanguage.inc for the format of this variable.
+	 * @return bool False to abort the hook
+	 */
+	OnLocalisationChecksBlacklist(blacklist *interface{}) bool
+}
+
+/**
+ * Run the LocalisationChecksBlacklist hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLocalisationChecksBlacklist(blacklist *interface{}) bool {
+	args := []interface{}{blacklist}
+	return r.container.run("LocalisationChecksBlacklist", args, func(handler interface{}) bool {
+		return handler.(LocalisationChecksBlacklistHook).OnLocalisationChecksBlacklist(blacklist)
+	})
+}
+
+/**
+ * When fetching the list of ignored and
+ * optional localisation messages
+ */
+type LocalisationIgnoredOptionalMessagesHook interface {
+	/**
+	 * @param mixed &$ignored Array of ignored message keys
+	 * @param mixed &$optional Array of optional message keys
+	 * @return bool False to abort the hook
+	 */
+	OnLocalisationIgnoredOptionalMessages(ignored *interface{}, optional *interface{}) bool
+}
+
+/**
+ * Run the LocalisationIgnoredOptionalMessages hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLocalisationIgnoredOptionalMessages(ignored *interface{}, optional *interface{}) bool {
+	args := []interface{}{ignored, optional}
+	return r.container.run("LocalisationIgnoredOptionalMessages", args, func(handler interface{}) bool {
+		return handler.(LocalisationIgnoredOptionalMessagesHook).OnLocalisationIgnoredOptionalMessages(ignored, optional)
+	})
+}
+
+/**
+ * When getting extra inputs to display on
+ * Special:Log for a specific log type
+ */
+type LogEventsListGetExtraInputsHook interface {
+	/**
+	 * @param mixed $type String of log type being displayed
+	 * @param mixed $logEventsList LogEventsList object for context and access to the WebRequest
+	 * @param mixed &$input string HTML of an input element (deprecated, use $formDescriptor instead)
+	 * @param mixed &$formDescriptor array HTMLForm's form descriptor
+	 * @return bool False to abort the hook
+	 */
+	OnLogEventsListGetExtraInputs(typeParam interface{}, logEventsList interface{}, input *interface{}, formDescriptor *interface{}) bool
+}
+
+/**
+ * Run the LogEventsListGetExtraInputs hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLogEventsListGetExtraInputs(typeParam interface{}, logEventsList interface{}, input *interface{}, formDescriptor *interface{}) bool {
+	args := []interface{}{typeParam, logEventsList, input, formDescriptor}
+	return r.container.run("LogEventsListGetExtraInputs", args, func(handler interface{}) bool {
+		return handler.(LogEventsListGetExtraInputsHook).OnLogEventsListGetExtraInputs(typeParam, logEventsList, input, formDescriptor)
+	})
+}
+
+/**
+ * Called before a Special:Log line is finished
+ */
+type LogEventsListLineEndingHook interface {
+	/**
+	 * @param mixed $page the LogEventsList object
+	 * @param mixed &$ret the HTML line
+	 * @param mixed $entry the DatabaseLogEntry object for this row
+	 * @param mixed &$classes the classes to add to the surrounding <li>
+	 * @param mixed &$attribs associative array of other HTML attributes for the <li> element. Currently only data attributes reserved to MediaWiki are allowed (see Sanitizer::isReservedDataAttribute).
+	 * @return bool False to abort the hook
+	 */
+	OnLogEventsListLineEnding(page interface{}, ret *interface{}, entry interface{}, classes *interface{}, attribs *interface{}) bool
+}
+
+/**
+ * Run the LogEventsListLineEnding hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLogEventsListLineEnding(page interface{}, ret *interface{}, entry interface{}, classes *interface{}, attribs *interface{}) bool {
+	args := []interface{}{page, ret, entry, classes, attribs}
+	return r.container.run("LogEventsListLineEnding", args, func(handler interface{}) bool {
+		return handler.(LogEventsListLineEndingHook).OnLogEventsListLineEnding(page, ret, entry, classes, attribs)
+	})
+}
+
+/**
+ * Called before the string is added to OutputPage.
+ * Returning false will prevent the string from being added to the OutputPage.
+ */
+type LogEventsListShowLogExtractHook interface {
+	/**
+	 * @param mixed &$s html string to show for the log extract
+	 * @param mixed $types String or Array Log types to show
+	 * @param mixed $page String or Title The page title to show log entries for
+	 * @param mixed $user String The user who made the log entries
+	 * @param mixed $param Associative Array with the following additional options: - lim Integer Limit of items to show, default is 50 - conds Array Extra conditions for the query (e.g. "log_action != 'revision'") - showIfEmpty boolean Set to false if you don't want any output in case the loglist is empty if set to true (default), "No matching items in log" is displayed if loglist is empty - msgKey Array If you want a nice box with a message, set this to the key of the message. First element is the message key, additional optional elements are parameters for the key that are processed with wfMessage()->params()->parseAsBlock() - offset Set to overwrite offset parameter in $wgRequest set to '' to unset offset - wrap String Wrap the message in html (usually something like "&lt;div ...>$1&lt;/div>"). - flags Integer display flags (NO_ACTION_LINK,NO_EXTRA_USER_LINKS)
+	 * @return bool False to abort the hook
+	 */
+	OnLogEventsListShowLogExtract(s *interface{}, types interface{}, page interface{}, user interface{}, param interface{}) bool
+}
+
+/**
+ * Run the LogEventsListShowLogExtract hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLogEventsListShowLogExtract(s *interface{}, types interface{}, page interface{}, user interface{}, param interface{}) bool {
+	args := []interface{}{s, types, page, user, param}
+	return r.container.run("LogEventsListShowLogExtract", args, func(handler interface{}) bool {
+		return handler.(LogEventsListShowLogExtractHook).OnLogEventsListShowLogExtract(s, types, page, user, param)
+	})
+}
+
+/**
+ * Called before an exception (or PHP error) is logged. This is
+ * meant for integration with external error aggregation services; returning false
+ * will NOT prevent logging.
+ */
+type LogExceptionHook interface {
+	/**
+	 * @param mixed $e The exception (in case of a plain old PHP error, a wrapping ErrorException)
+	 * @param mixed $suppressed true if the error was suppressed via error_reporting()/wfSuppressWarnings()
+	 * @return bool False to abort the hook
+	 */
+	OnLogException(e interface{}, suppressed interface{}) bool
+}
+
+/**
+ * Run the LogException hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLogException(e interface{}, suppressed interface{}) bool {
+	args := []interface{}{e, suppressed}
+	return r.container.run("LogException", args, func(handler interface{}) bool {
+		return handler.(LogExceptionHook).OnLogException(e, suppressed)
+	})
+}
+
+/**
+ * Processes a single log entry on Special:Log.
+ */
+type LogLineHook interface {
+	/**
+	 * @param mixed $log_type string for the type of log entry (e.g. 'move'). Corresponds to logging.log_type database field.
+	 * @param mixed $log_action string for the type of log action (e.g. 'delete', 'block', 'create2'). Corresponds to logging.log_action database field.
+	 * @param mixed $title Title object that corresponds to logging.log_namespace and logging.log_title database fields.
+	 * @param mixed $paramArray Array of parameters that corresponds to logging.log_params field. Note that only $paramArray[0] appears to contain anything.
+	 * @param mixed &$comment string that corresponds to logging.log_comment database field, and which is displayed in the UI.
+	 * @param mixed &$revert string that is displayed in the UI, similar to $comment.
+	 * @param mixed $time timestamp of the log entry (added in 1.12)
+	 * @return bool False to abort the hook
+	 */
+	OnLogLine(log_type interface{}, log_action interface{}, title interface{}, paramArray interface{}, comment *interface{}, revert *interface{}, time interface{}) bool
+}
+
+/**
+ * Run the LogLine hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLogLine(log_type interface{}, log_action interface{}, title interface{}, paramArray interface{}, comment *interface{}, revert *interface{}, time interface{}) bool {
+	args := []interface{}{log_type, log_action, title, paramArray, comment, revert, time}
+	return r.container.run("LogLine", args, func(handler interface{}) bool {
+		return handler.(LogLineHook).OnLogLine(log_type, log_action, title, paramArray, comment, revert, time)
+	})
+}
+
+/**
+ * Called in LoginForm when a function gets valid
+ * error messages. Allows to add additional error messages (except messages already
+ * in LoginForm::$validErrorMessages).
+ */
+type LoginFormValidErrorMessagesHook interface {
+	/**
+	 * @param mixed &$messages Already added messages (inclusive messages from LoginForm::$validErrorMessages)
+	 * @return bool False to abort the hook
+	 */
+	OnLoginFormValidErrorMessages(messages *interface{}) bool
+}
+
+/**
+ * Run the LoginFormValidErrorMessages hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLoginFormValidErrorMessages(messages *interface{}) bool {
+	args := []interface{}{messages}
+	return r.container.run("LoginFormValidErrorMessages", args, func(handler interface{}) bool {
+		return handler.(LoginFormValidErrorMessagesHook).OnLoginFormValidErrorMessages(messages)
+	})
+}
+
+/**
+ * DEPRECATED since 1.27! Create a PreAuthenticationProvider
+ * instead. Called during login to allow extensions the opportunity to inform a
+ * user that their username doesn't exist for a specific reason, instead of letting
+ * the login form give the generic error message that the account does not exist.
+ * For example, when the account has been renamed or deleted.
+ */
+type LoginUserMigratedHook interface {
+	/**
+	 * @param mixed $user the User object being authenticated against.
+	 * @param mixed &$msg the message identifier for abort reason, or an array to pass a message key and parameters.
+	 * @return bool False to abort the hook
+	 */
+	OnLoginUserMigrated(user interface{}, msg *interface{}) bool
+}
+
+/**
+ * Run the LoginUserMigrated hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLoginUserMigrated(user interface{}, msg *interface{}) bool {
+	args := []interface{}{user, msg}
+	return r.container.run("LoginUserMigrated", args, func(handler interface{}) bool {
+		return handler.(LoginUserMigratedHook).OnLoginUserMigrated(user, msg)
+	})
+}
+
+/**
+ * Allow extensions to modify the query used by
+ * Special:LonelyPages.
+ */
+type LonelyPagesQueryHook interface {
+	/**
+	 * @param mixed &$tables tables to join in the query
+	 * @param mixed &$conds conditions for the query
+	 * @param mixed &$joinConds join conditions for the query
+	 * @return bool False to abort the hook
+	 */
+	OnLonelyPagesQuery(tables *interface{}, conds *interface{}, joinConds *interface{}) bool
+}
+
+/**
+ * Run the LonelyPagesQuery hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnLonelyPagesQuery(tables *interface{}, conds *interface{}, joinConds *interface{}) bool {
+	args := []interface{}{tables, conds, joinConds}
+	return r.container.run("LonelyPagesQuery", args, func(handler interface{}) bool {
+		return handler.(LonelyPagesQueryHook).OnLonelyPagesQuery(tables, conds, joinConds)
+	})
+}
+
+/**
+ * When defining new magic words IDs.
+ */
+type MagicWordwgVariableIDsHook interface {
+	/**
+	 * @param mixed &$variableIDs array of strings
+	 * @return bool False to abort the hook
+	 */
+	OnMagicWordwgVariableIDs(variableIDs *interface{}) bool
+}
+
+/**
+ * Run the MagicWordwgVariableIDs hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnMagicWordwgVariableIDs(variableIDs *interface{}) bool {
+	args := []interface{}{variableIDs}
+	return r.container.run("MagicWordwgVariableIDs", args, func(handler interface{}) bool {
+		return handler.(MagicWordwgVariableIDsHook).OnMagicWordwgVariableIDs(variableIDs)
+	})
+}
+
+/**
+ * before executing the refreshLinks.php maintenance
+ * script.
+ */
+type MaintenanceRefreshLinksInitHook interface {
+	/**
+	 * @param mixed $refreshLinks RefreshLinks object
+	 * @return bool False to abort the hook
+	 */
+	OnMaintenanceRefreshLinksInit(refreshLinks interface{}) bool
+}
+
+/**
+ * Run the MaintenanceRefreshLinksInit hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnMaintenanceRefreshLinksInit(refreshLinks interface{}) bool {
+	args := []interface{}{refreshLinks}
+	return r.container.run("MaintenanceRefreshLinksInit", args, func(handler interface{}) bool {
+		return handler.(MaintenanceRefreshLinksInitHook).OnMaintenanceRefreshLinksInit(refreshLinks)
+	})
+}
+
+/**
+ * Called at end of OutputPage::getJSVars.
+ * Ideally, this hook should only be used to add variables that depend on
+ * the current page/request; static configuration should be added through
+ * ResourceLoaderGetConfigVars instead.
+ */
+type MakeGlobalVariablesScriptHook interface {
+	/**
+	 * @param mixed &$vars variable (or multiple variables) to be added into the output of Skin::makeVariablesScript
+	 * @param mixed $out The OutputPage which called the hook, can be used to get the real title.
+	 * @return bool False to abort the hook
+	 */
+	OnMakeGlobalVariablesScript(vars *interface{}, out interface{}) bool
+}
+
+/**
+ * Run the MakeGlobalVariablesScript hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnMakeGlobalVariablesScript(vars *interface{}, out interface{}) bool {
+	args := []interface{}{vars, out}
+	return r.container.run("MakeGlobalVariablesScript", args, func(handler interface{}) bool {
+		return handler.(MakeGlobalVariablesScriptHook).OnMakeGlobalVariablesScript(vars, out)
+	})
+}
+
+/**
+ * Before an edit is marked patrolled.
+ */
+type MarkPatrolledHook interface {
+	/**
+	 * @param mixed $rcid ID of the revision to be marked patrolled
+	 * @param mixed &$user the user (object) marking the revision as patrolled
+	 * @param mixed $wcOnlySysopsCanPatrol config setting indicating whether the user needs to be a sysop in order to mark an edit patrolled.
+	 * @param mixed $auto true if the edit is being marked as patrolled automatically
+	 * @return bool False to abort the hook
+	 */
+	OnMarkPatrolled(rcid interface{}, user *interface{}, wcOnlySysopsCanPatrol interface{}, auto interface{}) bool
+}
+
+/**
+ * Run the MarkPatrolled hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnMarkPatrolled(rcid interface{}, user *interface{}, wcOnlySysopsCanPatrol interface{}, auto interface{}) bool {
+	args := []interface{}{rcid, user, wcOnlySysopsCanPatrol, auto}
+	return r.container.run("MarkPatrolled", args, func(handler interface{}) bool {
+		return handler.(MarkPatrolledHook).OnMarkPatrolled(rcid, user, wcOnlySysopsCanPatrol, auto)
+	})
+}
+
+/**
+ * After an edit is marked patrolled.
+ */
+type MarkPatrolledCompleteHook interface {
+	/**
+	 * @param mixed $rcid ID of the revision marked as patrolled
+	 * @param mixed &$user user (object) who marked the edit patrolled
+	 * @param mixed $wcOnlySysopsCanPatrol config setting indicating whether the user must be a sysop to patrol the edit.
+	 * @param mixed $auto true if the edit is being marked as patrolled automatically
+	 * @return bool False to abort the hook
+	 */
+	OnMarkPatrolledComplete(rcid interface{}, user *interface{}, wcOnlySysopsCanPatrol interface{}, auto interface{}) bool
+}
+
+/**
+ * Run the MarkPatrolledComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnMarkPatrolledComplete(rcid interface{}, user *interface{}, wcOnlySysopsCanPatrol interface{}, auto interface{}) bool {
+	args := []interface{}{rcid, user, wcOnlySysopsCanPatrol, auto}
+	return r.container.run("MarkPatrolledComplete", args, func(handler interface{}) bool {
+		return handler.(MarkPatrolledCompleteHook).OnMarkPatrolledComplete(rcid, user, wcOnlySysopsCanPatrol, auto)
+	})
+}
+
+/**
+ * Override MediaWiki::performAction(). Use this to do
+ * something completely different, after the basic globals have been set up, but
+ * before ordinary actions take place.
+ */
+type MediaWikiPerformActionHook interface {
+	/**
+	 * @param mixed $output $wgOut
+	 * @param mixed $article Article on which the action will be performed
+	 * @param mixed $title Title on which the action will be performed
+	 * @param mixed $user $wgUser
+	 * @param mixed $request $wgRequest
+	 * @param mixed $mediaWiki The $mediawiki object
+	 * @return bool False to abort the hook
+	 */
+	OnMediaWikiPerformAction(output interface{}, article interface{}, title interface{}, user interface{}, request interface{}, mediaWiki interface{}) bool
+}
+
+/**
+ * Run the MediaWikiPerformAction hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnMediaWikiPerformAction(output interface{}, article interface{}, title interface{}, user interface{}, request interface{}, mediaWiki interface{}) bool {
+	args := []interface{}{output, article, title, user, request, mediaWiki}
+	return r.container.run("MediaWikiPerformAction", args, func(handler interface{}) bool {
+		return handler.(MediaWikiPerformActionHook).OnMediaWikiPerformAction(output, article, title, user, request, mediaWiki)
+	})
+}
+
+/**
+ * Called when a global MediaWikiServices instance is
+ * initialized. Extensions may use this to define, replace, or wrap services.
+ * However, the preferred way to define a new service is
+ * the $wgServiceWiringFiles array.
+ */
+type MediaWikiServicesHook interface {
+	/**
+	 * @param MediaWikiServices $services MediaWikiServices
+	 * @return bool False to abort the hook
+	 */
+	OnMediaWikiServices(services *MediaWikiServices) bool
+}
+
+/**
+ * Run the MediaWikiServices hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnMediaWikiServices(services *MediaWikiServices) bool {
+	args := []interface{}{services}
+	return r.container.run("MediaWikiServices", args, func(handler interface{}) bool {
+		return handler.(MediaWikiServicesHook).OnMediaWikiServices(services)
+	})
+}
+
+/**
+ * When fetching a message. Can be used to override the key
+ * for customisations. Given and returned message key must be in special format:
+ * 1) first letter must be in lower case according to the content language.
+ * 2) spaces must be replaced with underscores
+ */
+type MessageCache__getHook interface {
+	/**
+	 * @param mixed &$key message key (string)
+	 * @return bool False to abort the hook
+	 */
+	OnMessageCache__get(key *interface{}) bool
+}
+
+/**
+ * Run the MessageCache::get hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnMessageCache__get(key *interface{}) bool {
+	args := []interface{}{key}
+	return r.container.run("MessageCache::get", args, func(handler interface{}) bool {
+		return handler.(MessageCache__getHook).OnMessageCache__get(key)
+	})
+}
+
+/**
+ * When a message page is changed. Useful for updating
+ * caches.
+ */
+type MessageCacheReplaceHook interface {
+	/**
+	 * @param string $title name of the page changed.
+	 * @param string $text new contents of the page.
+	 * @return bool False to abort the hook
+	 */
+	OnMessageCacheReplace(title string, text string) bool
+}
+
+/**
+ * Run the MessageCacheReplace hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnMessageCacheReplace(title string, text string) bool {
+	args := []interface{}{title, text}
+	return r.container.run("MessageCacheReplace", args, func(handler interface{}) bool {
+		return handler.(MessageCacheReplaceHook).OnMessageCacheReplace(title, text)
+	})
+}
+
+/**
+ * When loading a message from the database.
+ */
+type MessagesPreLoadHook interface {
+	/**
+	 * @param string $title title of the message (string)
+	 * @param mixed &$message value (string), change it to the message you want to define
+	 * @param string $code code (string) denoting the language to try.
+	 * @return bool False to abort the hook
+	 */
+	OnMessagesPreLoad(title string, message *interface{}, code string) bool
+}
+
+/**
+ * Run the MessagesPreLoad hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnMessagesPreLoad(title string, message *interface{}, code string) bool {
+	args := []interface{}{title, message, code}
+	return r.container.run("MessagesPreLoad", args, func(handler interface{}) bool {
+		return handler.(MessagesPreLoadHook).OnMessagesPreLoad(title, message, code)
+	})
+}
+
+/**
+ * Allows MW extensions guess the MIME by content.
+ */
+type MimeMagicGuessFromContentHook interface {
+	/**
+	 * @param mixed $mimeMagic Instance of MimeAnalyzer.
+	 * @param mixed &$head First 1024 bytes of the file in a string (in - Do not alter!).
+	 * @param mixed &$tail More or equal than last 65558 bytes of the file in a string (in - Do not alter!).
+	 * @param mixed $file File path.
+	 * @param mixed &$mime MIME type (out).
+	 * @return bool False to abort the hook
+	 */
+	OnMimeMagicGuessFromContent(mimeMagic interface{}, head *interface{}, tail *interface{}, file interface{}, mime *interface{}) bool
+}
+
+/**
+ * Run the MimeMagicGuessFromContent hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnMimeMagicGuessFromContent(mimeMagic interface{}, head *interface{}, tail *interface{}, file interface{}, mime *interface{}) bool {
+	args := []interface{}{mimeMagic, head, tail, file, mime}
+	return r.container.run("MimeMagicGuessFromContent", args, func(handler interface{}) bool {
+		return handler.(MimeMagicGuessFromContentHook).OnMimeMagicGuessFromContent(mimeMagic, head, tail, file, mime)
+	})
+}
+
+/**
+ * Allows MW extensions to further improve the
+ * MIME type detected by considering the file extension.
+ */
+type MimeMagicImproveFromExtensionHook interface {
+	/**
+	 * @param mixed $mimeMagic Instance of MimeAnalyzer.
+	 * @param mixed $ext File extension.
+	 * @param mixed &$mime MIME type (in/out).
+	 * @return bool False to abort the hook
+	 */
+	OnMimeMagicImproveFromExtension(mimeMagic interface{}, ext interface{}, mime *interface{}) bool
+}
+
+/**
+ * Run the MimeMagicImproveFromExtension hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnMimeMagicImproveFromExtension(mimeMagic interface{}, ext interface{}, mime *interface{}) bool {
+	args := []interface{}{mimeMagic, ext, mime}
+	return r.container.run("MimeMagicImproveFromExtension", args, func(handler interface{}) bool {
+		return handler.(MimeMagicImproveFromExtensionHook).OnMimeMagicImproveFromExtension(mimeMagic, ext, mime)
+	})
+}
+
+/**
+ * Before processing the list mapping MIME types to media types
+ * and the list mapping MIME types to file extensions.
+ * As an extension author, you are encouraged to submit patches to MediaWiki's
+ * core to add new MIME types to mime.types.
+ */
+type MimeMagicInitHook interface {
+	/**
+	 * @param mixed $mimeMagic Instance of MimeAnalyzer. Use $mimeMagic->addExtraInfo( $stringOfInfo ); for adding new MIME info to the list. Use $mimeMagic->addExtraTypes( $stringOfTypes ); for adding new MIME types to the list.
+	 * @return bool False to abort the hook
+	 */
+	OnMimeMagicInit(mimeMagic interface{}) bool
+}
+
+/**
+ * Run the MimeMagicInit hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnMimeMagicInit(mimeMagic interface{}) bool {
+	args := []interface{}{mimeMagic}
+	return r.container.run("MimeMagicInit", args, func(handler interface{}) bool {
+		return handler.(MimeMagicInitHook).OnMimeMagicInit(mimeMagic)
+	})
+}
+
+/**
+ * Modify the query used by the exporter.
+ */
+type ModifyExportQueryHook interface {
+	/**
+	 * @param mixed $db The database object to be queried.
+	 * @param mixed &$tables Tables in the query.
+	 * @param mixed &$conds Conditions in the query.
+	 * @param mixed &$opts Options for the query.
+	 * @param mixed &$join_conds Join conditions for the query.
+	 * @return bool False to abort the hook
+	 */
+	OnModifyExportQuery(db interface{}, tables *interface{}, conds *interface{}, opts *interface{}, join_conds *interface{}) bool
+}
+
+/**
+ * Run the ModifyExportQuery hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnModifyExportQuery(db interface{}, tables *interface{}, conds *interface{}, opts *interface{}, join_conds *interface{}) bool {
+	args := []interface{}{db, tables, conds, opts, join_conds}
+	return r.container.run("ModifyExportQuery", args, func(handler interface{}) bool {
+		return handler.(ModifyExportQueryHook).OnModifyExportQuery(db, tables, conds, opts, join_conds)
+	})
+}
+
+/**
+ * Specify whether the user is allowed to move the
+ * page.
+ */
+type MovePageCheckPermissionsHook interface {
+	/**
+	 * @param mixed $oldTitle Title object of the current (old) location
+	 * @param mixed $newTitle Title object of the new location
+	 * @param mixed $user User making the move
+	 * @param mixed $reason string of the reason provided by the user
+	 * @param mixed $status Status object to pass error messages to
+	 * @return bool False to abort the hook
+	 */
+	OnMovePageCheckPermissions(oldTitle interface{}, newTitle interface{}, user interface{}, reason interface{}, status interface{}) bool
+}
+
+/**
+ * Run the MovePageCheckPermissions hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnMovePageCheckPermissions(oldTitle interface{}, newTitle interface{}, user interface{}, reason interface{}, status interface{}) bool {
+	args := []interface{}{oldTitle, newTitle, user, reason, status}
+	return r.container.run("MovePageCheckPermissions", args, func(handler interface{}) bool {
+		return handler.(MovePageCheckPermissionsHook).OnMovePageCheckPermissions(oldTitle, newTitle, user, reason, status)
+	})
+}
+
+/**
+ * Specify whether a page can be moved for technical
+ * reasons.
+ */
+type MovePageIsValidMoveHook interface {
+	/**
+	 * @param mixed $oldTitle Title object of the current (old) location
+	 * @param mixed $newTitle Title object of the new location
+	 * @param mixed $status Status object to pass error messages to
+	 * @return bool False to abort the hook
+	 */
+	OnMovePageIsValidMove(oldTitle interface{}, newTitle interface{}, status interface{}) bool
+}
+
+/**
+ * Run the MovePageIsValidMove hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnMovePageIsValidMove(oldTitle interface{}, newTitle interface{}, status interface{}) bool {
+	args := []interface{}{oldTitle, newTitle, status}
+	return r.container.run("MovePageIsValidMove", args, func(handler interface{}) bool {
+		return handler.(MovePageIsValidMoveHook).OnMovePageIsValidMove(oldTitle, newTitle, status)
+	})
+}
+
+/**
+ * Called when determining if it is possible to pages in a
+ * namespace.
+ */
+type NamespaceIsMovableHook interface {
+	/**
+	 * @param mixed $index Integer; the index of the namespace being checked.
+	 * @param mixed &$result Boolean; whether MediaWiki currently thinks that pages in this namespace are movable. Hooks may change this value to override the return value of MWNamespace::isMovable().
+	 * @return bool False to abort the hook
+	 */
+	OnNamespaceIsMovable(index interface{}, result *interface{}) bool
+}
+
+/**
+ * Run the NamespaceIsMovable hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnNamespaceIsMovable(index interface{}, result *interface{}) bool {
+	args := []interface{}{index, result}
+	return r.container.run("NamespaceIsMovable", args, func(handler interface{}) bool {
+		return handler.(NamespaceIsMovableHook).OnNamespaceIsMovable(index, result)
+	})
+}
+
+/**
+ * Called when a new DifferenceEngine object is made
+ */
+type NewDifferenceEngineHook interface {
+	/**
+	 * @param mixed $title the diff page title (nullable)
+	 * @param mixed &$oldId the actual old Id to use in the diff
+	 * @param mixed &$newId the actual new Id to use in the diff (0 means current)
+	 * @param mixed $old the ?old= param value from the url
+	 * @param mixed $new the ?new= param value from the url
+	 * @return bool False to abort the hook
+	 */
+	OnNewDifferenceEngine(title interface{}, oldId *interface{}, newId *interface{}, old interface{}, new interface{}) bool
+}
+
+/**
+ * Run the NewDifferenceEngine hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnNewDifferenceEngine(title interface{}, oldId *interface{}, newId *interface{}, old interface{}, new interface{}) bool {
+	args := []interface{}{title, oldId, newId, old, new}
+	return r.container.run("NewDifferenceEngine", args, func(handler interface{}) bool {
+		return handler.(NewDifferenceEngineHook).OnNewDifferenceEngine(title, oldId, newId, old, new)
+	})
+}
+
+/**
+ * Called before a NewPages line is finished.
+ */
+type NewPagesLineEndingHook interface {
+	/**
+	 * @param mixed $page the SpecialNewPages object
+	 * @param mixed &$ret the HTML line
+	 * @param mixed $row the database row for this page (the recentchanges record and a few extras - see NewPagesPager::getQueryInfo)
+	 * @param mixed &$classes the classes to add to the surrounding <li>
+	 * @param mixed &$attribs associative array of other HTML attributes for the <li> element. Currently only data attributes reserved to MediaWiki are allowed (see Sanitizer::isReservedDataAttribute).
+	 * @return bool False to abort the hook
+	 */
+	OnNewPagesLineEnding(page interface{}, ret *interface{}, row interface{}, classes *interface{}, attribs *interface{}) bool
+}
+
+/**
+ * Run the NewPagesLineEnding hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnNewPagesLineEnding(page interface{}, ret *interface{}, row interface{}, classes *interface{}, attribs *interface{}) bool {
+	args := []interface{}{page, ret, row, classes, attribs}
+	return r.container.run("NewPagesLineEnding", args, func(handler interface{}) bool {
+		return handler.(NewPagesLineEndingHook).OnNewPagesLineEnding(page, ret, row, classes, attribs)
+	})
+}
+
+/**
+ * Called when a revision was inserted due to an
+ * edit.
+ */
+type NewRevisionFromEditCompleteHook interface {
+	/**
+	 * @param mixed $wikiPage the WikiPage edited
+	 * @param mixed $rev the new revision
+	 * @param mixed $originalRevId if the edit restores or repeats an earlier revision (such as a rollback or a null revision), the ID of that earlier revision. False otherwise. (Used to be called $baseID.)
+	 * @param mixed $user the editing user
+	 * @param mixed &$tags tags to apply to the edit and recent change
+	 * @return bool False to abort the hook
+	 */
+	OnNewRevisionFromEditComplete(wikiPage interface{}, rev interface{}, originalRevId interface{}, user interface{}, tags *interface{}) bool
+}
+
+/**
+ * Run the NewRevisionFromEditComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnNewRevisionFromEditComplete(wikiPage interface{}, rev interface{}, originalRevId interface{}, user interface{}, tags *interface{}) bool {
+	args := []interface{}{wikiPage, rev, originalRevId, user, tags}
+	return r.container.run("NewRevisionFromEditComplete", args, func(handler interface{}) bool {
+		return handler.(NewRevisionFromEditCompleteHook).OnNewRevisionFromEditComplete(wikiPage, rev, originalRevId, user, tags)
+	})
+}
+
+/**
+ * Customize entire recent changes line, or
+ * return false to omit the line from RecentChanges and Watchlist special pages.
+ */
+type OldChangesListRecentChangesLineHook interface {
+	/**
+	 * @param mixed &$changeslist The OldChangesList instance.
+	 * @param mixed &$s HTML of the form "<li>...</li>" containing one RC entry.
+	 * @param mixed $rc The RecentChange object.
+	 * @param mixed &$classes array of css classes for the <li> element.
+	 * @param mixed &$attribs associative array of other HTML attributes for the <li> element. Currently only data attributes reserved to MediaWiki are allowed (see Sanitizer::isReservedDataAttribute).
+	 * @return bool False to abort the hook
+	 */
+	OnOldChangesListRecentChangesLine(changeslist *interface{}, s *interface{}, rc interface{}, classes *interface{}, attribs *interface{}) bool
+}
+
+/**
+ * Run the OldChangesListRecentChangesLine hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnOldChangesListRecentChangesLine(changeslist *interface{}, s *interface{}, rc interface{}, classes *interface{}, attribs *interface{}) bool {
+	args := []interface{}{changeslist, s, rc, classes, attribs}
+	return r.container.run("OldChangesListRecentChangesLine", args, func(handler interface{}) bool {
+		return handler.(OldChangesListRecentChangesLineHook).OnOldChangesListRecentChangesLine(changeslist, s, rc, classes, attribs)
+	})
+}
+
+/**
+ * Called when constructing the OpenSearch description XML. Hooks
+ * can alter or append to the array of URLs for search & suggestion formats.
+ */
+type OpenSearchUrlsHook interface {
+	/**
+	 * @param mixed &$urls array of associative arrays with Url element attributes
+	 * @return bool False to abort the hook
+	 */
+	OnOpenSearchUrls(urls *interface{}) bool
+}
+
+/**
+ * Run the OpenSearchUrls hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnOpenSearchUrls(urls *interface{}) bool {
+	args := []interface{}{urls}
+	return r.container.run("OpenSearchUrls", args, func(handler interface{}) bool {
+		return handler.(OpenSearchUrlsHook).OnOpenSearchUrls(urls)
+	})
+}
+
+/**
+ * Called by WikiPage::triggerOpportunisticLinksUpdate
+ * when a page view triggers a re-rendering of the page. This may happen
+ * particularly if the parser cache is split by user language, and no cached
+ * rendering of the page exists in the user's language. The hook is called
+ * before checking whether page_links_updated indicates that the links are up
+ * to date. Returning false will cause triggerOpportunisticLinksUpdate() to abort
+ * without triggering any updates.
+ */
+type OpportunisticLinksUpdateHook interface {
+	/**
+	 * @param mixed $page the Page that was rendered.
+	 * @param mixed $title the Title of the rendered page.
+	 * @param mixed $parserOutput ParserOutput resulting from rendering the page.
+	 * @return bool False to abort the hook
+	 */
+	OnOpportunisticLinksUpdate(page interface{}, title interface{}, parserOutput interface{}) bool
+}
+
+/**
+ * Run the OpportunisticLinksUpdate hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnOpportunisticLinksUpdate(page interface{}, title interface{}, parserOutput interface{}) bool {
+	args := []interface{}{page, title, parserOutput}
+	return r.container.run("OpportunisticLinksUpdate", args, func(handler interface{}) bool {
+		return handler.(OpportunisticLinksUpdateHook).OnOpportunisticLinksUpdate(page, title, parserOutput)
+	})
+}
+
+/**
+ * Get links to the autoblock log from extensions which
+ * autoblocks users and/or IP addresses too.
+ */
+type OtherAutoblockLogLinkHook interface {
+	/**
+	 * @param mixed &$otherBlockLink An array with links to other autoblock logs
+	 * @return bool False to abort the hook
+	 */
+	OnOtherAutoblockLogLink(otherBlockLink *interface{}) bool
+}
+
+/**
+ * Run the OtherAutoblockLogLink hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnOtherAutoblockLogLink(otherBlockLink *interface{}) bool {
+	args := []interface{}{otherBlockLink}
+	return r.container.run("OtherAutoblockLogLink", args, func(handler interface{}) bool {
+		return handler.(OtherAutoblockLogLinkHook).OnOtherAutoblockLogLink(otherBlockLink)
+	})
+}
+
+/**
+ * Get links to the block log from extensions which blocks
+ * users and/or IP addresses too.
+ */
+type OtherBlockLogLinkHook interface {
+	/**
+	 * @param mixed &$otherBlockLink An array with links to other block logs
+	 * @param mixed $ip The requested IP address or username
+	 * @return bool False to abort the hook
+	 */
+	OnOtherBlockLogLink(otherBlockLink *interface{}, ip interface{}) bool
+}
+
+/**
+ * Run the OtherBlockLogLink hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnOtherBlockLogLink(otherBlockLink *interface{}, ip interface{}) bool {
+	args := []interface{}{otherBlockLink, ip}
+	return r.container.run("OtherBlockLogLink", args, func(handler interface{}) bool {
+		return handler.(OtherBlockLogLinkHook).OnOtherBlockLogLink(otherBlockLink, ip)
+	})
+}
+
+/**
+ * Called in OutputPage#getHeadLinksArray right
+ * before returning the result.
+ */
+type OutputPageAfterGetHeadLinksArrayHook interface {
+	/**
+	 * @param mixed &$tags array containing all <head> links generated so far. The array format is "link name or number => 'link HTML'".
+	 * @param mixed $out the OutputPage object
+	 * @return bool False to abort the hook
+	 */
+	OnOutputPageAfterGetHeadLinksArray(tags *interface{}, out interface{}) bool
+}
+
+/**
+ * Run the OutputPageAfterGetHeadLinksArray hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnOutputPageAfterGetHeadLinksArray(tags *interface{}, out interface{}) bool {
+	args := []interface{}{tags, out}
+	return r.container.run("OutputPageAfterGetHeadLinksArray", args, func(handler interface{}) bool {
+		return handler.(OutputPageAfterGetHeadLinksArrayHook).OnOutputPageAfterGetHeadLinksArray(tags, out)
+	})
+}
+
+/**
+ * A page has been processed by the parser and the
+ * resulting HTML is about to be displayed.
+ */
+type OutputPageBeforeHTMLHook interface {
+	/**
+	 * @param mixed &$parserOutput the parserOutput (object) that corresponds to the page
+	 * @param mixed &$text the text that will be displayed, in HTML (string)
+	 * @return bool False to abort the hook
+	 */
+	OnOutputPageBeforeHTML(parserOutput *interface{}, text *interface{}) bool
+}
+
+/**
+ * Run the OutputPageBeforeHTML hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnOutputPageBeforeHTML(parserOutput *interface{}, text *interface{}) bool {
+	args := []interface{}{parserOutput, text}
+	return r.container.run("OutputPageBeforeHTML", args, func(handler interface{}) bool {
+		return handler.(OutputPageBeforeHTMLHook).OnOutputPageBeforeHTML(parserOutput, text)
+	})
+}
+
+/**
+ * Called when OutputPage::headElement is creating the
+ * body tag to allow for extensions to add attributes to the body of the page they
+ * might need. Or to allow building extensions to add body classes that aren't of
+ * high enough demand to be included in core.
+ */
+type OutputPageBodyAttributesHook interface {
+	/**
+	 * @param mixed $out The OutputPage which called the hook, can be used to get the real title
+	 * @param mixed $sk The Skin that called OutputPage::headElement
+	 * @param mixed &$bodyAttrs An array of attributes for the body tag passed to Html::openElement
+	 * @return bool False to abort the hook
+	 */
+	OnOutputPageBodyAttributes(out interface{}, sk interface{}, bodyAttrs *interface{}) bool
+}
+
+/**
+ * Run the OutputPageBodyAttributes hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnOutputPageBodyAttributes(out interface{}, sk interface{}, bodyAttrs *interface{}) bool {
+	args := []interface{}{out, sk, bodyAttrs}
+	return r.container.run("OutputPageBodyAttributes", args, func(handler interface{}) bool {
+		return handler.(OutputPageBodyAttributesHook).OnOutputPageBodyAttributes(out, sk, bodyAttrs)
+	})
+}
+
+/**
+ * when checking if the page has been modified
+ * since the last visit.
+ */
+type OutputPageCheckLastModifiedHook interface {
+	/**
+	 * @param mixed &$modifiedTimes array of timestamps. The following keys are set: page, user, epoch
+	 * @param mixed $out OutputPage object (since 1.28)
+	 * @return bool False to abort the hook
+	 */
+	OnOutputPageCheckLastModified(modifiedTimes *interface{}, out interface{}) bool
+}
+
+/**
+ * Run the OutputPageCheckLastModified hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnOutputPageCheckLastModified(modifiedTimes *interface{}, out interface{}) bool {
+	args := []interface{}{modifiedTimes, out}
+	return r.container.run("OutputPageCheckLastModified", args, func(handler interface{}) bool {
+		return handler.(OutputPageCheckLastModifiedHook).OnOutputPageCheckLastModified(modifiedTimes, out)
+	})
+}
+
+/**
+ * Links are about to be generated for the page's
+ * categories. Implementations should return false if they generate the category
+ * links, so the default link generation is skipped.
+ */
+type OutputPageMakeCategoryLinksHook interface {
+	/**
+	 * @param mixed &$out OutputPage instance (object)
+	 * @param mixed $categories associative array, keys are category names, values are category types ("normal" or "hidden")
+	 * @param mixed &$links array, intended to hold the result. Must be an associative array with category types as keys and arrays of HTML links as values.
+	 * @return bool False to abort the hook
+	 */
+	OnOutputPageMakeCategoryLinks(out *interface{}, categories interface{}, links *interface{}) bool
+}
+
+/**
+ * Run the OutputPageMakeCategoryLinks hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnOutputPageMakeCategoryLinks(out *interface{}, categories interface{}, links *interface{}) bool {
+	args := []interface{}{out, categories, links}
+	return r.container.run("OutputPageMakeCategoryLinks", args, func(handler interface{}) bool {
+		return handler.(OutputPageMakeCategoryLinksHook).OnOutputPageMakeCategoryLinks(out, categories, links)
+	})
+}
+
+/**
+ * after adding a parserOutput to $wgOut
+ */
+type OutputPageParserOutputHook interface {
+	/**
+	 * @param mixed &$out OutputPage instance (object)
+	 * @param mixed $parserOutput parserOutput instance being added in $out
+	 * @return bool False to abort the hook
+	 */
+	OnOutputPageParserOutput(out *interface{}, parserOutput interface{}) bool
+}
+
+/**
+ * Run the OutputPageParserOutput hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnOutputPageParserOutput(out *interface{}, parserOutput interface{}) bool {
+	args := []interface{}{out, parserOutput}
+	return r.container.run("OutputPageParserOutput", args, func(handler interface{}) bool {
+		return handler.(OutputPageParserOutputHook).OnOutputPageParserOutput(out, parserOutput)
+	})
+}
+
+/**
+ * After a new article is created.
+ */
+type PageContentInsertCompleteHook interface {
+	/**
+	 * @param mixed $wikiPage WikiPage created
+	 * @param mixed $user User creating the article
+	 * @param mixed $content New content as a Content object
+	 * @param mixed $summary Edit summary/comment
+	 * @param mixed $isMinor Whether or not the edit was marked as minor
+	 * @param mixed $isWatch (No longer used)
+	 * @param mixed $section (No longer used)
+	 * @param mixed $flags Flags passed to WikiPage::doEditContent()
+	 * @param mixed $revision New Revision of the article
+	 * @return bool False to abort the hook
+	 */
+	OnPageContentInsertComplete(wikiPage interface{}, user interface{}, content interface{}, summary interface{}, isMinor interface{}, isWatch interface{}, section interface{}, flags interface{}, revision interface{}) bool
+}
+
+/**
+ * Run the PageContentInsertComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPageContentInsertComplete(wikiPage interface{}, user interface{}, content interface{}, summary interface{}, isMinor interface{}, isWatch interface{}, section interface{}, flags interface{}, revision interface{}) bool {
+	args := []interface{}{wikiPage, user, content, summary, isMinor, isWatch, section, flags, revision}
+	return r.container.run("PageContentInsertComplete", args, func(handler interface{}) bool {
+		return handler.(PageContentInsertCompleteHook).OnPageContentInsertComplete(wikiPage, user, content, summary, isMinor, isWatch, section, flags, revision)
+	})
+}
+
+/**
+ * Allows changing the language in which the content of a
+ * page is written. Defaults to the wiki content language ($wgContLang).
+ */
+type PageContentLanguageHook interface {
+	/**
+	 * @param mixed $title Title object
+	 * @param mixed &$pageLang the page content language (either an object or a language code)
+	 * @param mixed $wgLang the user language
+	 * @return bool False to abort the hook
+	 */
+	OnPageContentLanguage(title interface{}, pageLang *interface{}, wgLang interface{}) bool
+}
+
+/**
+ * Run the PageContentLanguage hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPageContentLanguage(title interface{}, pageLang *interface{}, wgLang interface{}) bool {
+	args := []interface{}{title, pageLang, wgLang}
+	return r.container.run("PageContentLanguage", args, func(handler interface{}) bool {
+		return handler.(PageContentLanguageHook).OnPageContentLanguage(title, pageLang, wgLang)
+	})
+}
+
+/**
+ * Before an article is saved.
+ */
+type PageContentSaveHook interface {
+	/**
+	 * @param mixed $wikiPage the WikiPage (object) being saved
+	 * @param languages.IUser $user the user (object) saving the article
+	 * @param mixed $content the new article content, as a Content object
+	 * @param string $summary the article summary (comment)
+	 * @param bool $isminor minor flag
+	 * @param bool $iswatch watch flag
+	 * @param mixed $section section #
+	 * @return bool False to abort the hook
+	 */
+	OnPageContentSave(wikiPage interface{}, user languages.IUser, content interface{}, summary string, isminor bool, iswatch bool, section interface{}) bool
+}
+
+/**
+ * Run the PageContentSave hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPageContentSave(wikiPage interface{}, user languages.IUser, content interface{}, summary string, isminor bool, iswatch bool, section interface{}) bool {
+	args := []interface{}{wikiPage, user, content, summary, isminor, iswatch, section}
+	return r.container.run("PageContentSave", args, func(handler interface{}) bool {
+		return handler.(PageContentSaveHook).OnPageContentSave(wikiPage, user, content, summary, isminor, iswatch, section)
+	})
+}
+
+/**
+ * After an article has been updated.
+ */
+type PageContentSaveCompleteHook interface {
+	/**
+	 * @param mixed $wikiPage WikiPage modified
+	 * @param mixed $user User performing the modification
+	 * @param mixed $content New content, as a Content object
+	 * @param mixed $summary Edit summary/comment
+	 * @param mixed $isMinor Whether or not the edit was marked as minor
+	 * @param mixed $isWatch (No longer used)
+	 * @param mixed $section (No longer used)
+	 * @param mixed $flags Flags passed to WikiPage::doEditContent()
+	 * @param mixed $revision New Revision of the article (can be null for edits that change nothing)
+	 * @param mixed $status Status object about to be returned by doEditContent()
+	 * @param mixed $originalRevId if the edit restores or repeats an earlier revision (such as a rollback or a null revision), the ID of that earlier revision. False otherwise. (Used to be called $baseRevId.)
+	 * @param mixed $undidRevId the rev ID (or 0) this edit undid
+	 * @return bool False to abort the hook
+	 */
+	OnPageContentSaveComplete(wikiPage interface{}, user interface{}, content interface{}, summary interface{}, isMinor interface{}, isWatch interface{}, section interface{}, flags interface{}, revision interface{}, status interface{}, originalRevId interface{}, undidRevId interface{}) bool
+}
+
+/**
+ * Run the PageContentSaveComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPageContentSaveComplete(wikiPage interface{}, user interface{}, content interface{}, summary interface{}, isMinor interface{}, isWatch interface{}, section interface{}, flags interface{}, revision interface{}, status interface{}, originalRevId interface{}, undidRevId interface{}) bool {
+	args := []interface{}{wikiPage, user, content, summary, isMinor, isWatch, section, flags, revision, status, originalRevId, undidRevId}
+	return r.container.run("PageContentSaveComplete", args, func(handler interface{}) bool {
+		return handler.(PageContentSaveCompleteHook).OnPageContentSaveComplete(wikiPage, user, content, summary, isMinor, isWatch, section, flags, revision, status, originalRevId, undidRevId)
+	})
+}
+
+/**
+ * Called when constructing a list of DeferrableUpdate to be
+ * executed when a page is deleted.
+ */
+type PageDeletionDataUpdatesHook interface {
+	/**
+	 * @param mixed $title The Title of the page being deleted.
+	 * @param mixed $revision A RevisionRecord representing the page's current revision at the time of deletion.
+	 * @param mixed &$updates A list of DeferrableUpdate that can be manipulated by the hook handler.
+	 * @return bool False to abort the hook
+	 */
+	OnPageDeletionDataUpdates(title interface{}, revision interface{}, updates *interface{}) bool
+}
+
+/**
+ * Run the PageDeletionDataUpdates hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPageDeletionDataUpdates(title interface{}, revision interface{}, updates *interface{}) bool {
+	args := []interface{}{title, revision, updates}
+	return r.container.run("PageDeletionDataUpdates", args, func(handler interface{}) bool {
+		return handler.(PageDeletionDataUpdatesHook).OnPageDeletionDataUpdates(title, revision, updates)
+	})
+}
+
+/**
+ * When a history page list is about to be constructed.
+ */
+type PageHistoryBeforeListHook interface {
+	/**
+	 * @param mixed &$article the article that the history is loading for
+	 * @param mixed $context RequestContext object
+	 * @return bool False to abort the hook
+	 */
+	OnPageHistoryBeforeList(article *interface{}, context interface{}) bool
+}
+
+/**
+ * Run the PageHistoryBeforeList hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPageHistoryBeforeList(article *interface{}, context interface{}) bool {
+	args := []interface{}{article, context}
+	return r.container.run("PageHistoryBeforeList", args, func(handler interface{}) bool {
+		return handler.(PageHistoryBeforeListHook).OnPageHistoryBeforeList(article, context)
+	})
+}
+
+/**
+ * Right before the end <li> is added to a history line.
+ */
+type PageHistoryLineEndingHook interface {
+	/**
+	 * @param mixed $historyAction the action object
+	 * @param mixed &$row the revision row for this line
+	 * @param mixed &$s the string representing this parsed line
+	 * @param mixed &$classes array containing the <li> element classes
+	 * @param mixed &$attribs associative array of other HTML attributes for the <li> element. Currently only data attributes reserved to MediaWiki are allowed (see Sanitizer::isReservedDataAttribute).
+	 * @return bool False to abort the hook
+	 */
+	OnPageHistoryLineEnding(historyAction interface{}, row *interface{}, s *interface{}, classes *interface{}, attribs *interface{}) bool
+}
+
+/**
+ * Run the PageHistoryLineEnding hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPageHistoryLineEnding(historyAction interface{}, row *interface{}, s *interface{}, classes *interface{}, attribs *interface{}) bool {
+	args := []interface{}{historyAction, row, s, classes, attribs}
+	return r.container.run("PageHistoryLineEnding", args, func(handler interface{}) bool {
+		return handler.(PageHistoryLineEndingHook).OnPageHistoryLineEnding(historyAction, row, s, classes, attribs)
+	})
+}
+
+/**
+ * Called after the pager query was run, before
+ * any output is generated, to allow batch lookups for prefetching information
+ * needed for display. If the hook handler returns false, the regular behavior of
+ * doBatchLookups() is skipped.
+ */
+type PageHistoryPager__doBatchLookupsHook interface {
+	/**
+	 * @param mixed $pager the PageHistoryPager
+	 * @param mixed $result a ResultWrapper representing the query result
+	 * @return bool False to abort the hook
+	 */
+	OnPageHistoryPager__doBatchLookups(pager interface{}, result interface{}) bool
+}
+
+/**
+ * Run the PageHistoryPager::doBatchLookups hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPageHistoryPager__doBatchLookups(pager interface{}, result interface{}) bool {
+	args := []interface{}{pager, result}
+	return r.container.run("PageHistoryPager::doBatchLookups", args, func(handler interface{}) bool {
+		return handler.(PageHistoryPager__doBatchLookupsHook).OnPageHistoryPager__doBatchLookups(pager, result)
+	})
+}
+
+/**
+ * when a history pager query parameter set is
+ * constructed.
+ */
+type PageHistoryPager__getQueryInfoHook interface {
+	/**
+	 * @param mixed &$pager the pager
+	 * @param mixed &$queryInfo the query parameters
+	 * @return bool False to abort the hook
+	 */
+	OnPageHistoryPager__getQueryInfo(pager *interface{}, queryInfo *interface{}) bool
+}
+
+/**
+ * Run the PageHistoryPager::getQueryInfo hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPageHistoryPager__getQueryInfo(pager *interface{}, queryInfo *interface{}) bool {
+	args := []interface{}{pager, queryInfo}
+	return r.container.run("PageHistoryPager::getQueryInfo", args, func(handler interface{}) bool {
+		return handler.(PageHistoryPager__getQueryInfoHook).OnPageHistoryPager__getQueryInfo(pager, queryInfo)
+	})
+}
+
+/**
+ * NOTE: Consider using ParserOptionsRegister instead.
+ * Alter the parser cache option hash key. A parser extension
+ * which depends on user options should install this hook and append its values to
+ * the key.
+ */
+type PageRenderingHashHook interface {
+	/**
+	 * @param mixed &$confstr reference to a hash key string which can be modified
+	 * @param mixed $user User (object) requesting the page
+	 * @param mixed &$forOptions array of options the hash is for
+	 * @return bool False to abort the hook
+	 */
+	OnPageRenderingHash(confstr *interface{}, user interface{}, forOptions *interface{}) bool
+}
+
+/**
+ * Run the PageRenderingHash hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPageRenderingHash(confstr *interface{}, user interface{}, forOptions *interface{}) bool {
+	args := []interface{}{confstr, user, forOptions}
+	return r.container.run("PageRenderingHash", args, func(handler interface{}) bool {
+		return handler.(PageRenderingHashHook).OnPageRenderingHash(confstr, user, forOptions)
+	})
+}
+
+/**
+ * Allow database (or other) changes to be made after a
+ * page view is seen by MediaWiki.  Note this does not capture views made
+ * via external caches such as Squid.
+ */
+type PageViewUpdatesHook interface {
+	/**
+	 * @param mixed $wikipage WikiPage (object) for the page being viewed.
+	 * @param mixed $user User (object) for the user who is viewing.
+	 * @return bool False to abort the hook
+	 */
+	OnPageViewUpdates(wikipage interface{}, user interface{}) bool
+}
+
+/**
+ * Run the PageViewUpdates hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPageViewUpdates(wikipage interface{}, user interface{}) bool {
+	args := []interface{}{wikipage, user}
+	return r.container.run("PageViewUpdates", args, func(handler interface{}) bool {
+		return handler.(PageViewUpdatesHook).OnPageViewUpdates(wikipage, user)
+	})
+}
+
+/**
+ * Called from Parser::parse() just after the call to
+ * Parser::internalParse() returns.
+ */
+type ParserAfterParseHook interface {
+	/**
+	 * @param mixed &$parser parser object
+	 * @param mixed &$text text being parsed
+	 * @param mixed &$stripState stripState used (object)
+	 * @return bool False to abort the hook
+	 */
+	OnParserAfterParse(parser *interface{}, text *interface{}, stripState *interface{}) bool
+}
+
+/**
+ * Run the ParserAfterParse hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserAfterParse(parser *interface{}, text *interface{}, stripState *interface{}) bool {
+	args := []interface{}{parser, text, stripState}
+	return r.container.run("ParserAfterParse", args, func(handler interface{}) bool {
+		return handler.(ParserAfterParseHook).OnParserAfterParse(parser, text, stripState)
+	})
+}
+
+/**
+ * Called at end of parsing time.
+ * TODO: No more strip, deprecated ?
+ */
+type ParserAfterStripHook interface {
+	/**
+	 * @param mixed &$parser parser object
+	 * @param mixed &$text text being parsed
+	 * @param mixed &$stripState stripState used (object)
+	 * @return bool False to abort the hook
+	 */
+	OnParserAfterStrip(parser *interface{}, text *interface{}, stripState *interface{}) bool
+}
+
+/**
+ * Run the ParserAfterStrip hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserAfterStrip(parser *interface{}, text *interface{}, stripState *interface{}) bool {
+	args := []interface{}{parser, text, stripState}
+	return r.container.run("ParserAfterStrip", args, func(handler interface{}) bool {
+		return handler.(ParserAfterStripHook).OnParserAfterStrip(parser, text, stripState)
+	})
+}
+
+/**
+ * Called after Parser::tidy() in Parser::parse()
+ */
+type ParserAfterTidyHook interface {
+	/**
+	 * @param mixed &$parser Parser object being used
+	 * @param mixed &$text text that will be returned
+	 * @return bool False to abort the hook
+	 */
+	OnParserAfterTidy(parser *interface{}, text *interface{}) bool
+}
+
+/**
+ * Run the ParserAfterTidy hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserAfterTidy(parser *interface{}, text *interface{}) bool {
+	args := []interface{}{parser, text}
+	return r.container.run("ParserAfterTidy", args, func(handler interface{}) bool {
+		return handler.(ParserAfterTidyHook).OnParserAfterTidy(parser, text)
+	})
+}
+
+/**
+ * Called after the first unstripGeneral() in
+ * Parser::internalParseHalfParsed()
+ */
+type ParserAfterUnstripHook interface {
+	/**
+	 * @param mixed &$parser Parser object being used
+	 * @param mixed &$text text that will be returned
+	 * @return bool False to abort the hook
+	 */
+	OnParserAfterUnstrip(parser *interface{}, text *interface{}) bool
+}
+
+/**
+ * Run the ParserAfterUnstrip hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserAfterUnstrip(parser *interface{}, text *interface{}) bool {
+	args := []interface{}{parser, text}
+	return r.container.run("ParserAfterUnstrip", args, func(handler interface{}) bool {
+		return handler.(ParserAfterUnstripHook).OnParserAfterUnstrip(parser, text)
+	})
+}
+
+/**
+ * Called at the beginning of Parser::internalParse().
+ */
+type ParserBeforeInternalParseHook interface {
+	/**
+	 * @param mixed &$parser Parser object
+	 * @param mixed &$text text to parse
+	 * @param mixed &$stripState StripState instance being used
+	 * @return bool False to abort the hook
+	 */
+	OnParserBeforeInternalParse(parser *interface{}, text *interface{}, stripState *interface{}) bool
+}
+
+/**
+ * Run the ParserBeforeInternalParse hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserBeforeInternalParse(parser *interface{}, text *interface{}, stripState *interface{}) bool {
+	args := []interface{}{parser, text, stripState}
+	return r.container.run("ParserBeforeInternalParse", args, func(handler interface{}) bool {
+		return handler.(ParserBeforeInternalParseHook).OnParserBeforeInternalParse(parser, text, stripState)
+	})
+}
+
+/**
+ * Called at start of parsing time.
+ * TODO: No more strip, deprecated ?
+ */
+type ParserBeforeStripHook interface {
+	/**
+	 * @param mixed &$parser parser object
+	 * @param mixed &$text text being parsed
+	 * @param mixed &$stripState stripState used (object)
+	 * @return bool False to abort the hook
+	 */
+	OnParserBeforeStrip(parser *interface{}, text *interface{}, stripState *interface{}) bool
+}
+
+/**
+ * Run the ParserBeforeStrip hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserBeforeStrip(parser *interface{}, text *interface{}, stripState *interface{}) bool {
+	args := []interface{}{parser, text, stripState}
+	return r.container.run("ParserBeforeStrip", args, func(handler interface{}) bool {
+		return handler.(ParserBeforeStripHook).OnParserBeforeStrip(parser, text, stripState)
+	})
+}
+
+/**
+ * Called before tidy and custom tags replacements.
+ */
+type ParserBeforeTidyHook interface {
+	/**
+	 * @param mixed &$parser Parser object being used
+	 * @param mixed &$text actual text
+	 * @return bool False to abort the hook
+	 */
+	OnParserBeforeTidy(parser *interface{}, text *interface{}) bool
+}
+
+/**
+ * Run the ParserBeforeTidy hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserBeforeTidy(parser *interface{}, text *interface{}) bool {
+	args := []interface{}{parser, text}
+	return r.container.run("ParserBeforeTidy", args, func(handler interface{}) bool {
+		return handler.(ParserBeforeTidyHook).OnParserBeforeTidy(parser, text)
+	})
+}
+
+/**
+ * Called after a ParserOutput has been committed to
+ * the parser cache.
+ */
+type ParserCacheSaveCompleteHook interface {
+	/**
+	 * @param mixed $parserCache ParserCache object $parserOutput was stored in
+	 * @param mixed $parserOutput ParserOutput object that was stored
+	 * @param mixed $title Title of the page that was parsed to generate $parserOutput
+	 * @param mixed $popts ParserOptions used for generating $parserOutput
+	 * @param mixed $revId ID of the revision that was parsed to create $parserOutput
+	 * @return bool False to abort the hook
+	 */
+	OnParserCacheSaveComplete(parserCache interface{}, parserOutput interface{}, title interface{}, popts interface{}, revId interface{}) bool
+}
+
+/**
+ * Run the ParserCacheSaveComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserCacheSaveComplete(parserCache interface{}, parserOutput interface{}, title interface{}, popts interface{}, revId interface{}) bool {
+	args := []interface{}{parserCache, parserOutput, title, popts, revId}
+	return r.container.run("ParserCacheSaveComplete", args, func(handler interface{}) bool {
+		return handler.(ParserCacheSaveCompleteHook).OnParserCacheSaveComplete(parserCache, parserOutput, title, popts, revId)
+	})
+}
+
+/**
+ * Called at the end of Parser::clearState().
+ */
+type ParserClearStateHook interface {
+	/**
+	 * @param mixed &$parser Parser object being cleared
+	 * @return bool False to abort the hook
+	 */
+	OnParserClearState(parser *interface{}) bool
+}
+
+/**
+ * Run the ParserClearState hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserClearState(parser *interface{}) bool {
+	args := []interface{}{parser}
+	return r.container.run("ParserClearState", args, func(handler interface{}) bool {
+		return handler.(ParserClearStateHook).OnParserClearState(parser)
+	})
+}
+
+/**
+ * Called when the parser is cloned.
+ */
+type ParserClonedHook interface {
+	/**
+	 * @param mixed $parser Newly-cloned Parser object
+	 * @return bool False to abort the hook
+	 */
+	OnParserCloned(parser interface{}) bool
+}
+
+/**
+ * Run the ParserCloned hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserCloned(parser interface{}) bool {
+	args := []interface{}{parser}
+	return r.container.run("ParserCloned", args, func(handler interface{}) bool {
+		return handler.(ParserClonedHook).OnParserCloned(parser)
+	})
+}
+
+/**
+ * Called when the parser fetches a template
+ */
+type ParserFetchTemplateHook interface {
+	/**
+	 * @param mixed $parser Parser Parser object or false
+	 * @param mixed $title Title object of the template to be fetched
+	 * @param mixed $rev Revision object of the template
+	 * @param mixed &$text Transclusion text of the template or false or null
+	 * @param mixed &$deps Array of template dependencies with 'title', 'page_id', 'rev_id' keys
+	 * @return bool False to abort the hook
+	 */
+	OnParserFetchTemplate(parser interface{}, title interface{}, rev interface{}, text *interface{}, deps *interface{}) bool
+}
+
+/**
+ * Run the ParserFetchTemplate hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserFetchTemplate(parser interface{}, title interface{}, rev interface{}, text *interface{}, deps *interface{}) bool {
+	args := []interface{}{parser, title, rev, text, deps}
+	return r.container.run("ParserFetchTemplate", args, func(handler interface{}) bool {
+		return handler.(ParserFetchTemplateHook).OnParserFetchTemplate(parser, title, rev, text, deps)
+	})
+}
+
+/**
+ * Called when the parser initialises for the first time.
+ */
+type ParserFirstCallInitHook interface {
+	/**
+	 * @param parser.Parser &$parser Parser object being cleared
+	 * @return bool False to abort the hook
+	 */
+	OnParserFirstCallInit(parser **parser.Parser) bool
+}
+
+/**
+ * Run the ParserFirstCallInit hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserFirstCallInit(parser **parser.Parser) bool {
+	args := []interface{}{parser}
+	return r.container.run("ParserFirstCallInit", args, func(handler interface{}) bool {
+		return handler.(ParserFirstCallInitHook).OnParserFirstCallInit(parser)
+	})
+}
+
+/**
+ * Called when the parser need the value of a
+ * custom magic word
+ */
+type ParserGetVariableValueSwitchHook interface {
+	/**
+	 * @param mixed &$parser Parser object
+	 * @param mixed &$varCache array to store the value in case of multiples calls of the same magic word
+	 * @param mixed &$index index (string) of the magic
+	 * @param mixed &$ret value of the magic word (the hook should set it)
+	 * @param mixed &$frame PPFrame object to use for expanding any template variables
+	 * @return bool False to abort the hook
+	 */
+	OnParserGetVariableValueSwitch(parser *interface{}, varCache *interface{}, index *interface{}, ret *interface{}, frame *interface{}) bool
+}
+
+/**
+ * Run the ParserGetVariableValueSwitch hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserGetVariableValueSwitch(parser *interface{}, varCache *interface{}, index *interface{}, ret *interface{}, frame *interface{}) bool {
+	args := []interface{}{parser, varCache, index, ret, frame}
+	return r.container.run("ParserGetVariableValueSwitch", args, func(handler interface{}) bool {
+		return handler.(ParserGetVariableValueSwitchHook).OnParserGetVariableValueSwitch(parser, varCache, index, ret, frame)
+	})
+}
+
+/**
+ * Use this to change the value of the time for the
+ * {{LOCAL...}} magic word.
+ */
+type ParserGetVariableValueTsHook interface {
+	/**
+	 * @param mixed &$parser Parser object
+	 * @param mixed &$time actual time (timestamp)
+	 * @return bool False to abort the hook
+	 */
+	OnParserGetVariableValueTs(parser *interface{}, time *interface{}) bool
+}
+
+/**
+ * Run the ParserGetVariableValueTs hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserGetVariableValueTs(parser *interface{}, time *interface{}) bool {
+	args := []interface{}{parser, time}
+	return r.container.run("ParserGetVariableValueTs", args, func(handler interface{}) bool {
+		return handler.(ParserGetVariableValueTsHook).OnParserGetVariableValueTs(parser, time)
+	})
+}
+
+/**
+ * use this to change the value of the variable
+ * cache or return false to not use it.
+ */
+type ParserGetVariableValueVarCacheHook interface {
+	/**
+	 * @param mixed &$parser Parser object
+	 * @param mixed &$varCache variable cache (array)
+	 * @return bool False to abort the hook
+	 */
+	OnParserGetVariableValueVarCache(parser *interface{}, varCache *interface{}) bool
+}
+
+/**
+ * Run the ParserGetVariableValueVarCache hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserGetVariableValueVarCache(parser *interface{}, varCache *interface{}) bool {
+	args := []interface{}{parser, varCache}
+	return r.container.run("ParserGetVariableValueVarCache", args, func(handler interface{}) bool {
+		return handler.(ParserGetVariableValueVarCacheHook).OnParserGetVariableValueVarCache(parser, varCache)
+	})
+}
+
+/**
+ * Called for each row in the parser limit report that
+ * needs formatting. If nothing handles this hook, the default is to use "$key" to
+ * get the label, and "$key-value" or "$key-value-text"/"$key-value-html" to
+ * format the value.
+ */
+type ParserLimitReportFormatHook interface {
+	/**
+	 * @param mixed $key Key for the limit report item (string)
+	 * @param mixed &$value Value of the limit report item
+	 * @param mixed &$report String onto which to append the data
+	 * @param mixed $isHTML If true, $report is an HTML table with two columns; if false, it's text intended for display in a monospaced font.
+	 * @param mixed $localize If false, $report should be output in English.
+	 * @return bool False to abort the hook
+	 */
+	OnParserLimitReportFormat(key interface{}, value *interface{}, report *interface{}, isHTML interface{}, localize interface{}) bool
+}
+
+/**
+ * Run the ParserLimitReportFormat hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserLimitReportFormat(key interface{}, value *interface{}, report *interface{}, isHTML interface{}, localize interface{}) bool {
+	args := []interface{}{key, value, report, isHTML, localize}
+	return r.container.run("ParserLimitReportFormat", args, func(handler interface{}) bool {
+		return handler.(ParserLimitReportFormatHook).OnParserLimitReportFormat(key, value, report, isHTML, localize)
+	})
+}
+
+/**
+ * Called at the end of Parser:parse() when the parser
+ * will include comments about size of the text parsed. Hooks should use
+ * $output->setLimitReportData() to populate data. Functions for this hook should
+ * not use $wgLang; do that in ParserLimitReportFormat instead.
+ */
+type ParserLimitReportPrepareHook interface {
+	/**
+	 * @param mixed $parser Parser object
+	 * @param mixed $output ParserOutput object
+	 * @return bool False to abort the hook
+	 */
+	OnParserLimitReportPrepare(parser interface{}, output interface{}) bool
+}
+
+/**
+ * Run the ParserLimitReportPrepare hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserLimitReportPrepare(parser interface{}, output interface{}) bool {
+	args := []interface{}{parser, output}
+	return r.container.run("ParserLimitReportPrepare", args, func(handler interface{}) bool {
+		return handler.(ParserLimitReportPrepareHook).OnParserLimitReportPrepare(parser, output)
+	})
+}
+
+/**
+ * Called before the parser make an image link, use this
+ * to modify the parameters of the image.
+ */
+type ParserMakeImageParamsHook interface {
+	/**
+	 * @param mixed $title title object representing the file
+	 * @param mixed $file file object that will be used to create the image
+	 * @param mixed &$params 2-D array of parameters
+	 * @param mixed $parser Parser object that called the hook
+	 * @return bool False to abort the hook
+	 */
+	OnParserMakeImageParams(title interface{}, file interface{}, params *interface{}, parser interface{}) bool
+}
+
+/**
+ * Run the ParserMakeImageParams hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserMakeImageParams(title interface{}, file interface{}, params *interface{}, parser interface{}) bool {
+	args := []interface{}{title, file, params, parser}
+	return r.container.run("ParserMakeImageParams", args, func(handler interface{}) bool {
+		return handler.(ParserMakeImageParamsHook).OnParserMakeImageParams(title, file, params, parser)
+	})
+}
+
+/**
+ * Register additional parser options. Note that if you
+ * change the default value for an option, all existing parser cache entries will
+ * be invalid. To avoid bugs, you'll need to handle that somehow (e.g. with the
+ * RejectParserCacheValue hook) because MediaWiki won't do it for you.
+ */
+type ParserOptionsRegisterHook interface {
+	/**
+	 * @param mixed &$defaults Set the default value for your option here.
+	 * @param mixed &$inCacheKey To fragment the parser cache on your option, set a truthy value here.
+	 * @param mixed &$lazyLoad To lazy-initialize your option, set it null in $defaults and set a callable here. The callable is passed the ParserOptions object and the option name.
+	 * @return bool False to abort the hook
+	 */
+	OnParserOptionsRegister(defaults *interface{}, inCacheKey *interface{}, lazyLoad *interface{}) bool
+}
+
+/**
+ * Run the ParserOptionsRegister hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserOptionsRegister(defaults *interface{}, inCacheKey *interface{}, lazyLoad *interface{}) bool {
+	args := []interface{}{defaults, inCacheKey, lazyLoad}
+	return r.container.run("ParserOptionsRegister", args, func(handler interface{}) bool {
+		return handler.(ParserOptionsRegisterHook).OnParserOptionsRegister(defaults, inCacheKey, lazyLoad)
+	})
+}
+
+/**
+ * Called from ParserOutput::getText() to do
+ * post-cache transforms.
+ */
+type ParserOutputPostCacheTransformHook interface {
+	/**
+	 * @param mixed $parserOutput The ParserOutput object.
+	 * @param mixed &$text The text being transformed, before core transformations are done.
+	 * @param mixed &$options The options array being used for the transformation.
+	 * @return bool False to abort the hook
+	 */
+	OnParserOutputPostCacheTransform(parserOutput interface{}, text *interface{}, options *interface{}) bool
+}
+
+/**
+ * Run the ParserOutputPostCacheTransform hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserOutputPostCacheTransform(parserOutput interface{}, text *interface{}, options *interface{}) bool {
+	args := []interface{}{parserOutput, text, options}
+	return r.container.run("ParserOutputPostCacheTransform", args, func(handler interface{}) bool {
+		return handler.(ParserOutputPostCacheTransformHook).OnParserOutputPostCacheTransform(parserOutput, text, options)
+	})
+}
+
+/**
+ * Called when an edit stash parse finishes, before the
+ * output is cached.
+ */
+type ParserOutputStashForEditHook interface {
+	/**
+	 * @param mixed $page the WikiPage of the candidate edit
+	 * @param mixed $content the Content object of the candidate edit
+	 * @param mixed $output the ParserOutput result of the candidate edit
+	 * @param mixed $summary the change summary of the candidate edit
+	 * @param mixed $user the User considering the edit
+	 * @return bool False to abort the hook
+	 */
+	OnParserOutputStashForEdit(page interface{}, content interface{}, output interface{}, summary interface{}, user interface{}) bool
+}
+
+/**
+ * Run the ParserOutputStashForEdit hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserOutputStashForEdit(page interface{}, content interface{}, output interface{}, summary interface{}, user interface{}) bool {
+	args := []interface{}{page, content, output, summary, user}
+	return r.container.run("ParserOutputStashForEdit", args, func(handler interface{}) bool {
+		return handler.(ParserOutputStashForEditHook).OnParserOutputStashForEdit(page, content, output, summary, user)
+	})
+}
+
+/**
+ * Called each time the parser creates a document section
+ * from wikitext. Use this to apply per-section modifications to HTML (like
+ * wrapping the section in a DIV).  Caveat: DIVs are valid wikitext, and a DIV
+ * can begin in one section and end in another. Make sure your code can handle
+ * that case gracefully. See the EditSectionClearerLink extension for an example.
+ */
+type ParserSectionCreateHook interface {
+	/**
+	 * @param mixed $parser the calling Parser instance
+	 * @param mixed $section the section number, zero-based, but section 0 is usually empty
+	 * @param mixed &$sectionContent ref to the content of the section. modify this.
+	 * @param mixed $showEditLinks boolean describing whether this section has an edit link
+	 * @return bool False to abort the hook
+	 */
+	OnParserSectionCreate(parser interface{}, section interface{}, sectionContent *interface{}, showEditLinks interface{}) bool
+}
+
+/**
+ * Run the ParserSectionCreate hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserSectionCreate(parser interface{}, section interface{}, sectionContent *interface{}, showEditLinks interface{}) bool {
+	args := []interface{}{parser, section, sectionContent, showEditLinks}
+	return r.container.run("ParserSectionCreate", args, func(handler interface{}) bool {
+		return handler.(ParserSectionCreateHook).OnParserSectionCreate(parser, section, sectionContent, showEditLinks)
+	})
+}
+
+/**
+ * Allows to define globals for parser tests.
+ */
+type ParserTestGlobalsHook interface {
+	/**
+	 * @param mixed &$globals Array with all the globals which should be set for parser tests. The arrays keys serve as the globals names, its values are the globals values.
+	 * @return bool False to abort the hook
+	 */
+	OnParserTestGlobals(globals *interface{}) bool
+}
+
+/**
+ * Run the ParserTestGlobals hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserTestGlobals(globals *interface{}) bool {
+	args := []interface{}{globals}
+	return r.container.run("ParserTestGlobals", args, func(handler interface{}) bool {
+		return handler.(ParserTestGlobalsHook).OnParserTestGlobals(globals)
+	})
+}
+
+/**
+ * Alter the list of tables to duplicate when parser tests are
+ * run. Use when page save hooks require the presence of custom tables to ensure
+ * that tests continue to run properly.
+ */
+type ParserTestTablesHook interface {
+	/**
+	 * @param mixed &$tables array of table names
+	 * @return bool False to abort the hook
+	 */
+	OnParserTestTables(tables *interface{}) bool
+}
+
+/**
+ * Run the ParserTestTables hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnParserTestTables(tables *interface{}) bool {
+	args := []interface{}{tables}
+	return r.container.run("ParserTestTables", args, func(handler interface{}) bool {
+		return handler.(ParserTestTablesHook).OnParserTestTables(tables)
+	})
+}
+
+/**
+ * Alter the effective password policy for a user.
+ */
+type PasswordPoliciesForUserHook interface {
+	/**
+	 * @param mixed $user User object whose policy you are modifying
+	 * @param mixed &$effectivePolicy Array of policy statements that apply to this user
+	 * @return bool False to abort the hook
+	 */
+	OnPasswordPoliciesForUser(user interface{}, effectivePolicy *interface{}) bool
+}
+
+/**
+ * Run the PasswordPoliciesForUser hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPasswordPoliciesForUser(user interface{}, effectivePolicy *interface{}) bool {
+	args := []interface{}{user, effectivePolicy}
+	return r.container.run("PasswordPoliciesForUser", args, func(handler interface{}) bool {
+		return handler.(PasswordPoliciesForUserHook).OnPasswordPoliciesForUser(user, effectivePolicy)
+	})
+}
+
+/**
+ * Called before a retroactive autoblock is applied
+ * to a user.
+ */
+type PerformRetroactiveAutoblockHook interface {
+	/**
+	 * @param mixed $block Block object (which is set to be autoblocking)
+	 * @param mixed &$blockIds Array of block IDs of the autoblock
+	 * @return bool False to abort the hook
+	 */
+	OnPerformRetroactiveAutoblock(block interface{}, blockIds *interface{}) bool
+}
+
+/**
+ * Run the PerformRetroactiveAutoblock hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPerformRetroactiveAutoblock(block interface{}, blockIds *interface{}) bool {
+	args := []interface{}{block, blockIds}
+	return r.container.run("PerformRetroactiveAutoblock", args, func(handler interface{}) bool {
+		return handler.(PerformRetroactiveAutoblockHook).OnPerformRetroactiveAutoblock(block, blockIds)
+	})
+}
+
+/**
+ * Alter the user-specific navigation links (e.g. "my page,
+ * my talk page, my contributions" etc).
+ */
+type PersonalUrlsHook interface {
+	/**
+	 * @param mixed &$personal_urls Array of link specifiers (see SkinTemplate.php)
+	 * @param mixed &$title Title object representing the current page
+	 * @param mixed $skin SkinTemplate object providing context (e.g. to check if the user is logged in, etc.)
+	 * @return bool False to abort the hook
+	 */
+	OnPersonalUrls(personal_urls *interface{}, title *interface{}, skin interface{}) bool
+}
+
+/**
+ * Run the PersonalUrls hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPersonalUrls(personal_urls *interface{}, title *interface{}, skin interface{}) bool {
+	args := []interface{}{personal_urls, title, skin}
+	return r.container.run("PersonalUrls", args, func(handler interface{}) bool {
+		return handler.(PersonalUrlsHook).OnPersonalUrls(personal_urls, title, skin)
+	})
+}
+
+/**
+ * Allows extensions to override the results of User::pingLimiter().
+ */
+type PingLimiterHook interface {
+	/**
+	 * @param mixed &$user User performing the action
+	 * @param mixed $action Action being performed
+	 * @param mixed &$result Whether or not the action should be prevented Change $result and return false to give a definitive answer, otherwise the built-in rate limiting checks are used, if enabled.
+	 * @param mixed $incrBy Amount to increment counter by
+	 * @return bool False to abort the hook
+	 */
+	OnPingLimiter(user *interface{}, action interface{}, result *interface{}, incrBy interface{}) bool
+}
+
+/**
+ * Run the PingLimiter hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPingLimiter(user *interface{}, action interface{}, result *interface{}, incrBy interface{}) bool {
+	args := []interface{}{user, action, result, incrBy}
+	return r.container.run("PingLimiter", args, func(handler interface{}) bool {
+		return handler.(PingLimiterHook).OnPingLimiter(user, action, result, incrBy)
+	})
+}
+
+/**
+ * Override placement of new sections. Return false and put the
+ * merged text into $text to override the default behavior.
+ */
+type PlaceNewSectionHook interface {
+	/**
+	 * @param mixed $wikipage WikiPage object
+	 * @param mixed $oldtext the text of the article before editing
+	 * @param mixed $subject subject of the new section
+	 * @param mixed &$text text of the new section
+	 * @return bool False to abort the hook
+	 */
+	OnPlaceNewSection(wikipage interface{}, oldtext interface{}, subject interface{}, text *interface{}) bool
+}
+
+/**
+ * Run the PlaceNewSection hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPlaceNewSection(wikipage interface{}, oldtext interface{}, subject interface{}, text *interface{}) bool {
+	args := []interface{}{wikipage, oldtext, subject, text}
+	return r.container.run("PlaceNewSection", args, func(handler interface{}) bool {
+		return handler.(PlaceNewSectionHook).OnPlaceNewSection(wikipage, oldtext, subject, text)
+	})
+}
+
+/**
+ * Modify the post login redirect behavior.
+ * Occurs after signing up or logging in, allows for interception of redirect.
+ */
+type PostLoginRedirectHook interface {
+	/**
+	 * @param mixed &$returnTo The page name to return to, as a string
+	 * @param mixed &$returnToQuery array of url parameters, mapping parameter names to values
+	 * @param mixed &$type type of login redirect as string; error: display a return to link ignoring $wgRedirectOnLogin signup: display a return to link using $wgRedirectOnLogin if needed success: display a return to link using $wgRedirectOnLogin if needed successredirect: send an HTTP redirect using $wgRedirectOnLogin if needed
+	 * @return bool False to abort the hook
+	 */
+	OnPostLoginRedirect(returnTo *interface{}, returnToQuery *interface{}, typeParam *interface{}) bool
+}
+
+/**
+ * Run the PostLoginRedirect hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPostLoginRedirect(returnTo *interface{}, returnToQuery *interface{}, typeParam *interface{}) bool {
+	args := []interface{}{returnTo, returnToQuery, typeParam}
+	return r.container.run("PostLoginRedirect", args, func(handler interface{}) bool {
+		return handler.(PostLoginRedirectHook).OnPostLoginRedirect(returnTo, returnToQuery, typeParam)
+	})
+}
+
+/**
+ * Override preferences being saved
+ */
+type PreferencesFormPreSaveHook interface {
+	/**
+	 * @param mixed $formData array of user submitted data
+	 * @param mixed $form HTMLForm object, also a ContextSource
+	 * @param mixed $user User object with preferences to be saved set
+	 * @param mixed &$result boolean indicating success
+	 * @param mixed $oldUserOptions array with user old options (before save)
+	 * @return bool False to abort the hook
+	 */
+	OnPreferencesFormPreSave(formData interface{}, form interface{}, user interface{}, result *interface{}, oldUserOptions interface{}) bool
+}
+
+/**
+ * Run the PreferencesFormPreSave hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPreferencesFormPreSave(formData interface{}, form interface{}, user interface{}, result *interface{}, oldUserOptions interface{}) bool {
+	args := []interface{}{formData, form, user, result, oldUserOptions}
+	return r.container.run("PreferencesFormPreSave", args, func(handler interface{}) bool {
+		return handler.(PreferencesFormPreSaveHook).OnPreferencesFormPreSave(formData, form, user, result, oldUserOptions)
+	})
+}
+
+/**
+ * Override the text used for the <legend> of a
+ * preferences section.
+ */
+type PreferencesGetLegendHook interface {
+	/**
+	 * @param mixed $form the HTMLForm object. This is a ContextSource as well
+	 * @param mixed $key the section name
+	 * @param mixed &$legend the legend text. Defaults to wfMessage( "prefs-$key" )->text() but may be overridden
+	 * @return bool False to abort the hook
+	 */
+	OnPreferencesGetLegend(form interface{}, key interface{}, legend *interface{}) bool
+}
+
+/**
+ * Run the PreferencesGetLegend hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPreferencesGetLegend(form interface{}, key interface{}, legend *interface{}) bool {
+	args := []interface{}{form, key, legend}
+	return r.container.run("PreferencesGetLegend", args, func(handler interface{}) bool {
+		return handler.(PreferencesGetLegendHook).OnPreferencesGetLegend(form, key, legend)
+	})
+}
+
+/**
+ * DEPRECATED since 1.27! Override
+ * SearchEngine::completionSearchBackend instead.
+ * Override the title prefix search used for OpenSearch and
+ * AJAX search suggestions. Put results into &$results outparam and return false.
+ */
+type PrefixSearchBackendHook interface {
+	/**
+	 * @param mixed $ns array of int namespace keys to search in
+	 * @param mixed $search search term (not guaranteed to be conveniently normalized)
+	 * @param mixed $limit maximum number of results to return
+	 * @param mixed &$results out param: array of page names (strings)
+	 * @param mixed $offset number of results to offset from the beginning
+	 * @return bool False to abort the hook
+	 */
+	OnPrefixSearchBackend(ns interface{}, search interface{}, limit interface{}, results *interface{}, offset interface{}) bool
+}
+
+/**
+ * Run the PrefixSearchBackend hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPrefixSearchBackend(ns interface{}, search interface{}, limit interface{}, results *interface{}, offset interface{}) bool {
+	args := []interface{}{ns, search, limit, results, offset}
+	return r.container.run("PrefixSearchBackend", args, func(handler interface{}) bool {
+		return handler.(PrefixSearchBackendHook).OnPrefixSearchBackend(ns, search, limit, results, offset)
+	})
+}
+
+/**
+ * Called if core was not able to extract a
+ * namespace from the search string so that extensions can attempt it.
+ */
+type PrefixSearchExtractNamespaceHook interface {
+	/**
+	 * @param mixed &$namespaces array of int namespace keys to search in (change this if you can extract namespaces)
+	 * @param mixed &$search search term (replace this with term without the namespace if you can extract one)
+	 * @return bool False to abort the hook
+	 */
+	OnPrefixSearchExtractNamespace(namespaces *interface{}, search *interface{}) bool
+}
+
+/**
+ * Run the PrefixSearchExtractNamespace hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPrefixSearchExtractNamespace(namespaces *interface{}, search *interface{}) bool {
+	args := []interface{}{namespaces, search}
+	return r.container.run("PrefixSearchExtractNamespace", args, func(handler interface{}) bool {
+		return handler.(PrefixSearchExtractNamespaceHook).OnPrefixSearchExtractNamespace(namespaces, search)
+	})
+}
+
+/**
+ * Called when user changes their email address.
+ */
+type PrefsEmailAuditHook interface {
+	/**
+	 * @param mixed $user User (object) changing his email address
+	 * @param mixed $oldaddr old email address (string)
+	 * @param mixed $newaddr new email address (string)
+	 * @return bool False to abort the hook
+	 */
+	OnPrefsEmailAudit(user interface{}, oldaddr interface{}, newaddr interface{}) bool
+}
+
+/**
+ * Run the PrefsEmailAudit hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnPrefsEmailAudit(user interface{}, oldaddr interface{}, newaddr interface{}) bool {
+	args := []interface{}{user, oldaddr, newaddr}
+	return r.container.run("PrefsEmailAudit", args, func(handler interface{}) bool {
+		return handler.(PrefsEmailAuditHook).OnPrefsEmailAudit(user, oldaddr, newaddr)
+	})
+}
+
+/**
+ * Called after all protection type fieldsets are made
+ * in the form.
+ */
+type ProtectionForm__buildFormHook interface {
+	/**
+	 * @param mixed $article the title being (un)protected
+	 * @param mixed &$output a string of the form HTML so far
+	 * @return bool False to abort the hook
+	 */
+	OnProtectionForm__buildForm(article interface{}, output *interface{}) bool
+}
+
+/**
+ * Run the ProtectionForm::buildForm hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnProtectionForm__buildForm(article interface{}, output *interface{}) bool {
+	args := []interface{}{article, output}
+	return r.container.run("ProtectionForm::buildForm", args, func(handler interface{}) bool {
+		return handler.(ProtectionForm__buildFormHook).OnProtectionForm__buildForm(article, output)
+	})
+}
+
+/**
+ * Called when a protection form is submitted.
+ */
+type ProtectionForm__saveHook interface {
+	/**
+	 * @param mixed $article the Page being (un)protected
+	 * @param mixed &$errorMsg an html message string of an error or an array of message name and its parameters
+	 * @param mixed $reasonstr a string describing the reason page protection level is altered
+	 * @return bool False to abort the hook
+	 */
+	OnProtectionForm__save(article interface{}, errorMsg *interface{}, reasonstr interface{}) bool
+}
+
+/**
+ * Run the ProtectionForm::save hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnProtectionForm__save(article interface{}, errorMsg *interface{}, reasonstr interface{}) bool {
+	args := []interface{}{article, errorMsg, reasonstr}
+	return r.container.run("ProtectionForm::save", args, func(handler interface{}) bool {
+		return handler.(ProtectionForm__saveHook).OnProtectionForm__save(article, errorMsg, reasonstr)
+	})
+}
+
+/**
+ * Called after the protection log extract is
+ * shown.
+ */
+type ProtectionForm__showLogExtractHook interface {
+	/**
+	 * @param mixed $article the page the form is shown for
+	 * @param mixed $out OutputPage object
+	 * @return bool False to abort the hook
+	 */
+	OnProtectionForm__showLogExtract(article interface{}, out interface{}) bool
+}
+
+/**
+ * Run the ProtectionForm::showLogExtract hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnProtectionForm__showLogExtract(article interface{}, out interface{}) bool {
+	args := []interface{}{article, out}
+	return r.container.run("ProtectionForm::showLogExtract", args, func(handler interface{}) bool {
+		return handler.(ProtectionForm__showLogExtractHook).OnProtectionForm__showLogExtract(article, out)
+	})
+}
+
+/**
+ * Lets you modify the query used by Special:Random to select
+ * random pages.
+ */
+type RandomPageQueryHook interface {
+	/**
+	 * @param mixed &$tables Database tables to be used in the query
+	 * @param mixed &$conds Conditions to be applied in the query
+	 * @param mixed &$joinConds Join conditions to be applied in the query
+	 * @return bool False to abort the hook
+	 */
+	OnRandomPageQuery(tables *interface{}, conds *interface{}, joinConds *interface{}) bool
+}
+
+/**
+ * Run the RandomPageQuery hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnRandomPageQuery(tables *interface{}, conds *interface{}, joinConds *interface{}) bool {
+	args := []interface{}{tables, conds, joinConds}
+	return r.container.run("RandomPageQuery", args, func(handler interface{}) bool {
+		return handler.(RandomPageQueryHook).OnRandomPageQuery(tables, conds, joinConds)
+	})
+}
+
+/**
+ * Right before the text is blown out in action=raw.
+ */
+type RawPageViewBeforeOutputHook interface {
+	/**
+	 * @param mixed &$obj RawAction object
+	 * @param mixed &$text The text that's going to be the output
+	 * @return bool False to abort the hook
+	 */
+	OnRawPageViewBeforeOutput(obj *interface{}, text *interface{}) bool
+}
+
+/**
+ * Run the RawPageViewBeforeOutput hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnRawPageViewBeforeOutput(obj *interface{}, text *interface{}) bool {
+	args := []interface{}{obj, text}
+	return r.container.run("RawPageViewBeforeOutput", args, func(handler interface{}) bool {
+		return handler.(RawPageViewBeforeOutputHook).OnRawPageViewBeforeOutput(obj, text)
+	})
+}
+
+/**
+ * Called at the end of RecentChange::save().
+ */
+type RecentChange_saveHook interface {
+	/**
+	 * @param mixed &$recentChange RecentChange object
+	 * @return bool False to abort the hook
+	 */
+	OnRecentChange_save(recentChange *interface{}) bool
+}
+
+/**
+ * Run the RecentChange_save hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnRecentChange_save(recentChange *interface{}) bool {
+	args := []interface{}{recentChange}
+	return r.container.run("RecentChange_save", args, func(handler interface{}) bool {
+		return handler.(RecentChange_saveHook).OnRecentChange_save(recentChange)
+	})
+}
+
+/**
+ * Called when old recentchanges rows are purged, after
+ * deleting those rows but within the same transaction.
+ */
+type RecentChangesPurgeRowsHook interface {
+	/**
+	 * @param mixed $rows The deleted rows as an array of recentchanges row objects (with up to $wgUpdateRowsPerQuery items).
+	 * @return bool False to abort the hook
+	 */
+	OnRecentChangesPurgeRows(rows interface{}) bool
+}
+
+/**
+ * Run the RecentChangesPurgeRows hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnRecentChangesPurgeRows(rows interface{}) bool {
+	args := []interface{}{rows}
+	return r.container.run("RecentChangesPurgeRows", args, func(handler interface{}) bool {
+		return handler.(RecentChangesPurgeRowsHook).OnRecentChangesPurgeRows(rows)
+	})
+}
+
+/**
+ * Lets you alter the set of parameter
+ * names such as "oldid" that are preserved when using redirecting special pages
+ * such as Special:MyPage and Special:MyTalk.
+ */
+type RedirectSpecialArticleRedirectParamsHook interface {
+	/**
+	 * @param mixed &$redirectParams An array of parameters preserved by redirecting special pages.
+	 * @return bool False to abort the hook
+	 */
+	OnRedirectSpecialArticleRedirectParams(redirectParams *interface{}) bool
+}
+
+/**
+ * Run the RedirectSpecialArticleRedirectParams hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnRedirectSpecialArticleRedirectParams(redirectParams *interface{}) bool {
+	args := []interface{}{redirectParams}
+	return r.container.run("RedirectSpecialArticleRedirectParams", args, func(handler interface{}) bool {
+		return handler.(RedirectSpecialArticleRedirectParamsHook).OnRedirectSpecialArticleRedirectParams(redirectParams)
+	})
+}
+
+/**
+ * Return false to reject an otherwise usable
+ * cached value from the Parser cache. NOTE: CARELESS USE OF THIS HOOK CAN
+ * HAVE CATASTROPHIC CONSEQUENCES FOR HIGH-TRAFFIC INSTALLATIONS. USE WITH
+ * EXTREME CARE.
+ */
+type RejectParserCacheValueHook interface {
+	/**
+	 * @param mixed $parserOutput ParserOutput value.
+	 * @param mixed $wikiPage WikiPage object.
+	 * @param mixed $parserOptions ParserOptions object.
+	 * @return bool False to abort the hook
+	 */
+	OnRejectParserCacheValue(parserOutput interface{}, wikiPage interface{}, parserOptions interface{}) bool
+}
+
+/**
+ * Run the RejectParserCacheValue hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnRejectParserCacheValue(parserOutput interface{}, wikiPage interface{}, parserOptions interface{}) bool {
+	args := []interface{}{parserOutput, wikiPage, parserOptions}
+	return r.container.run("RejectParserCacheValue", args, func(handler interface{}) bool {
+		return handler.(RejectParserCacheValueHook).OnRejectParserCacheValue(parserOutput, wikiPage, parserOptions)
+	})
+}
+
+/**
+ * Called when RequestContext::getSkin creates a skin
+ * instance. Can be used by an extension override what skin is used in certain
+ * contexts.
+ */
+type RequestContextCreateSkinHook interface {
+	/**
+	 * @param mixed $context (IContextSource) The RequestContext the skin is being created for.
+	 * @param mixed &$skin A variable reference you may set a Skin instance or string key on to override the skin that will be used for the context.
+	 * @return bool False to abort the hook
+	 */
+	OnRequestContextCreateSkin(context interface{}, skin *interface{}) bool
+}
+
+/**
+ * Run the RequestContextCreateSkin hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnRequestContextCreateSkin(context interface{}, skin *interface{}) bool {
+	args := []interface{}{context, skin}
+	return r.container.run("RequestContextCreateSkin", args, func(handler interface{}) bool {
+		return handler.(RequestContextCreateSkinHook).OnRequestContextCreateSkin(context, skin)
+	})
+}
+
+/**
+ * Called to determine if the request is somehow
+ * flagged to lack same-origin security. Return false to indicate the lack. Note
+ * if the "somehow" involves HTTP headers, you'll probably need to make sure
+ * the header is varied on.
+ */
+type RequestHasSameOriginSecurityHook interface {
+	/**
+	 * @param mixed $request The WebRequest object.
+	 * @return bool False to abort the hook
+	 */
+	OnRequestHasSameOriginSecurity(request interface{}) bool
+}
+
+/**
+ * Run the RequestHasSameOriginSecurity hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnRequestHasSameOriginSecurity(request interface{}) bool {
+	args := []interface{}{request}
+	return r.container.run("RequestHasSameOriginSecurity", args, func(handler interface{}) bool {
+		return handler.(RequestHasSameOriginSecurityHook).OnRequestHasSameOriginSecurity(request)
+	})
+}
+
+/**
+ * Allow extensions to set a default password expiration
+ */
+type ResetPasswordExpirationHook interface {
+	/**
+	 * @param mixed $user The user having their password expiration reset
+	 * @param mixed &$newExpire The new expiration date
+	 * @return bool False to abort the hook
+	 */
+	OnResetPasswordExpiration(user interface{}, newExpire *interface{}) bool
+}
+
+/**
+ * Run the ResetPasswordExpiration hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnResetPasswordExpiration(user interface{}, newExpire *interface{}) bool {
+	args := []interface{}{user, newExpire}
+	return r.container.run("ResetPasswordExpiration", args, func(handler interface{}) bool {
+		return handler.(ResetPasswordExpirationHook).OnResetPasswordExpiration(user, newExpire)
+	})
+}
+
+/**
+ * Called from ResourceLoaderForeignApiModule.
+ * Use this to add dependencies to 'mediawiki.ForeignApi' module when you wish
+ * to override its behavior. See the module docs for more information.
+ */
+type ResourceLoaderForeignApiModulesHook interface {
+	/**
+	 * @param mixed &$dependencies string[] List of modules that 'mediawiki.ForeignApi' should depend on
+	 * @param mixed $context ResourceLoaderContext|null
+	 * @return bool False to abort the hook
+	 */
+	OnResourceLoaderForeignApiModules(dependencies *interface{}, context interface{}) bool
+}
+
+/**
+ * Run the ResourceLoaderForeignApiModules hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnResourceLoaderForeignApiModules(dependencies *interface{}, context interface{}) bool {
+	args := []interface{}{dependencies, context}
+	return r.container.run("ResourceLoaderForeignApiModules", args, func(handler interface{}) bool {
+		return handler.(ResourceLoaderForeignApiModulesHook).OnResourceLoaderForeignApiModules(dependencies, context)
+	})
+}
+
+/**
+ * Called at the end of
+ * ResourceLoaderStartUpModule::getConfigSettings(). Use this to export static
+ * configuration variables to JavaScript. Things that depend on the current page
+ * or request state must be added through MakeGlobalVariablesScript instead.
+ */
+type ResourceLoaderGetConfigVarsHook interface {
+	/**
+	 * @param mixed &$vars [ variable name => value ]
+	 * @return bool False to abort the hook
+	 */
+	OnResourceLoaderGetConfigVars(vars *interface{}) bool
+}
+
+/**
+ * Run the ResourceLoaderGetConfigVars hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnResourceLoaderGetConfigVars(vars *interface{}) bool {
+	args := []interface{}{vars}
+	return r.container.run("ResourceLoaderGetConfigVars", args, func(handler interface{}) bool {
+		return handler.(ResourceLoaderGetConfigVarsHook).OnResourceLoaderGetConfigVars(vars)
+	})
+}
+
+/**
+ * Called in
+ * ResourceLoaderJqueryMsgModule to allow adding magic words for jQueryMsg.
+ * The value should be a string, and they can depend only on the
+ * ResourceLoaderContext.
+ */
+type ResourceLoaderJqueryMsgModuleMagicWordsHook interface {
+	/**
+	 * @param mixed $context ResourceLoaderContext
+	 * @param mixed &$magicWords Associative array mapping all-caps magic word to a string value
+	 * @return bool False to abort the hook
+	 */
+	OnResourceLoaderJqueryMsgModuleMagicWords(context interface{}, magicWords *interface{}) bool
+}
+
+/**
+ * Run the ResourceLoaderJqueryMsgModuleMagicWords hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnResourceLoaderJqueryMsgModuleMagicWords(context interface{}, magicWords *interface{}) bool {
+	args := []interface{}{context, magicWords}
+	return r.container.run("ResourceLoaderJqueryMsgModuleMagicWords", args, func(handler interface{}) bool {
+		return handler.(ResourceLoaderJqueryMsgModuleMagicWordsHook).OnResourceLoaderJqueryMsgModuleMagicWords(context, magicWords)
+	})
+}
+
+/**
+ * Right before modules information is required,
+ * such as when responding to a resource
+ * loader request or generating HTML output.
+ */
+type ResourceLoaderRegisterModulesHook interface {
+	/**
+	 * @param mixed &$resourceLoader ResourceLoader object
+	 * @return bool False to abort the hook
+	 */
+	OnResourceLoaderRegisterModules(resourceLoader *interface{}) bool
+}
+
+/**
+ * Run the ResourceLoaderRegisterModules hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnResourceLoaderRegisterModules(resourceLoader *interface{}) bool {
+	args := []interface{}{resourceLoader}
+	return r.container.run("ResourceLoaderRegisterModules", args, func(handler interface{}) bool {
+		return handler.(ResourceLoaderRegisterModulesHook).OnResourceLoaderRegisterModules(resourceLoader)
+	})
+}
+
+/**
+ * Let you add new JavaScript testing modules. This is
+ * called after the addition of 'qunit' and MediaWiki testing resources.
+ */
+type ResourceLoaderTestModulesHook interface {
+	/**
+	 * @param mixed &$testModules array of JavaScript testing modules. The 'qunit' framework, included in core, is fed using tests/qunit/QUnitTestResources.php. To add a new qunit module named 'myext.tests': $testModules['qunit']['myext.tests'] = [ 'script' => 'extension/myext/tests.js', 'dependencies' => <any module dependency you might have> ]; For QUnit framework, the mediawiki.tests.qunit.testrunner dependency will be added to any module.
+	 * @param mixed &$ResourceLoader object
+	 * @return bool False to abort the hook
+	 */
+	OnResourceLoaderTestModules(testModules *interface{}, ResourceLoader *interface{}) bool
+}
+
+/**
+ * Run the ResourceLoaderTestModules hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnResourceLoaderTestModules(testModules *interface{}, ResourceLoader *interface{}) bool {
+	args := []interface{}{testModules, ResourceLoader}
+	return r.container.run("ResourceLoaderTestModules", args, func(handler interface{}) bool {
+		return handler.(ResourceLoaderTestModulesHook).OnResourceLoaderTestModules(testModules, ResourceLoader)
+	})
+}
+
+/**
+ * Called when constructing a list of DeferrableUpdate to be
+ * executed to record secondary data about a revision.
+ */
+type RevisionDataUpdatesHook interface {
+	/**
+	 * @param mixed $title The Title of the page the revision  belongs to
+	 * @param mixed $renderedRevision a RenderedRevision object representing the new revision and providing access to the RevisionRecord as well as ParserOutput of that revision.
+	 * @param mixed &$updates A list of DeferrableUpdate that can be manipulated by the hook handler.
+	 * @return bool False to abort the hook
+	 */
+	OnRevisionDataUpdates(title interface{}, renderedRevision interface{}, updates *interface{}) bool
+}
+
+/**
+ * Run the RevisionDataUpdates hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnRevisionDataUpdates(title interface{}, renderedRevision interface{}, updates *interface{}) bool {
+	args := []interface{}{title, renderedRevision, updates}
+	return r.container.run("RevisionDataUpdates", args, func(handler interface{}) bool {
+		return handler.(RevisionDataUpdatesHook).OnRevisionDataUpdates(title, renderedRevision, updates)
+	})
+}
+
+/**
+ * DEPRECATED since 1.31! Use RevisionRecordInserted hook
+ * instead. Called after a revision is inserted into the database.
+ */
+type RevisionInsertCompleteHook interface {
+	/**
+	 * @param mixed $revision the Revision
+	 * @param mixed $data DEPRECATED! Always null!
+	 * @param mixed $flags DEPRECATED! Always null!
+	 * @return bool False to abort the hook
+	 */
+	OnRevisionInsertComplete(revision interface{}, data interface{}, flags interface{}) bool
+}
+
+/**
+ * Run the RevisionInsertComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnRevisionInsertComplete(revision interface{}, data interface{}, flags interface{}) bool {
+	args := []interface{}{revision, data, flags}
+	return r.container.run("RevisionInsertComplete", args, func(handler interface{}) bool {
+		return handler.(RevisionInsertCompleteHook).OnRevisionInsertComplete(revision, data, flags)
+	})
+}
+
+/**
+ * Called after a revision is inserted into the database.
+ */
+type RevisionRecordInsertedHook interface {
+	/**
+	 * @param mixed $revisionRecord the RevisionRecord that has just been inserted.
+	 * @return bool False to abort the hook
+	 */
+	OnRevisionRecordInserted(revisionRecord interface{}) bool
+}
+
+/**
+ * Run the RevisionRecordInserted hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnRevisionRecordInserted(revisionRecord interface{}) bool {
+	args := []interface{}{revisionRecord}
+	return r.container.run("RevisionRecordInserted", args, func(handler interface{}) bool {
+		return handler.(RevisionRecordInsertedHook).OnRevisionRecordInserted(revisionRecord)
+	})
+}
+
+/**
+ * If there was no match for the exact result. This
+ * runs before lettercase variants are attempted, whereas 'SearchGetNearMatch'
+ * runs after.
+ */
+type SearchAfterNoDirectMatchHook interface {
+	/**
+	 * @param mixed $term Search term string
+	 * @param mixed &$title Outparam; set to $title object and return false for a match
+	 * @return bool False to abort the hook
+	 */
+	OnSearchAfterNoDirectMatch(term interface{}, title *interface{}) bool
+}
+
+/**
+ * Run the SearchAfterNoDirectMatch hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSearchAfterNoDirectMatch(term interface{}, title *interface{}) bool {
+	args := []interface{}{term, title}
+	return r.container.run("SearchAfterNoDirectMatch", args, func(handler interface{}) bool {
+		return handler.(SearchAfterNoDirectMatchHook).OnSearchAfterNoDirectMatch(term, title)
+	})
+}
+
+/**
+ * Add data to search document. Allows to add any data to
+ * the field map used to index the document.
+ */
+type SearchDataForIndexHook interface {
+	/**
+	 * @param mixed &$fields Array of name => value pairs for fields
+	 * @param mixed $handler ContentHandler for the content being indexed
+	 * @param mixed $page WikiPage that is being indexed
+	 * @param mixed $output ParserOutput that is produced from the page
+	 * @param mixed $engine SearchEngine for which the indexing is intended
+	 * @return bool False to abort the hook
+	 */
+	OnSearchDataForIndex(fields *interface{}, handlerParam interface{}, page interface{}, output interface{}, engine interface{}) bool
+}
+
+/**
+ * Run the SearchDataForIndex hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSearchDataForIndex(fields *interface{}, handlerParam interface{}, page interface{}, output interface{}, engine interface{}) bool {
+	args := []interface{}{fields, handlerParam, page, output, engine}
+	return r.container.run("SearchDataForIndex", args, func(handler interface{}) bool {
+		return handler.(SearchDataForIndexHook).OnSearchDataForIndex(fields, handlerParam, page, output, engine)
+	})
+}
+
+/**
+ * An extra chance for exact-title-matches in "go" searches
+ * if nothing was found.
+ */
+type SearchGetNearMatchHook interface {
+	/**
+	 * @param mixed $term Search term string
+	 * @param mixed &$title Outparam; set to $title object and return false for a match
+	 * @return bool False to abort the hook
+	 */
+	OnSearchGetNearMatch(term interface{}, title *interface{}) bool
+}
+
+/**
+ * Run the SearchGetNearMatch hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSearchGetNearMatch(term interface{}, title *interface{}) bool {
+	args := []interface{}{term, title}
+	return r.container.run("SearchGetNearMatch", args, func(handler interface{}) bool {
+		return handler.(SearchGetNearMatchHook).OnSearchGetNearMatch(term, title)
+	})
+}
+
+/**
+ * Perform exact-title-matches in "go" searches before
+ * the normal operations.
+ */
+type SearchGetNearMatchBeforeHook interface {
+	/**
+	 * @param mixed $allSearchTerms Array of the search terms in all content languages
+	 * @param mixed &$titleResult Outparam; the value to return. A Title object or null.
+	 * @return bool False to abort the hook
+	 */
+	OnSearchGetNearMatchBefore(allSearchTerms interface{}, titleResult *interface{}) bool
+}
+
+/**
+ * Run the SearchGetNearMatchBefore hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSearchGetNearMatchBefore(allSearchTerms interface{}, titleResult *interface{}) bool {
+	args := []interface{}{allSearchTerms, titleResult}
+	return r.container.run("SearchGetNearMatchBefore", args, func(handler interface{}) bool {
+		return handler.(SearchGetNearMatchBeforeHook).OnSearchGetNearMatchBefore(allSearchTerms, titleResult)
+	})
+}
+
+/**
+ * A chance to modify exact-title-matches in "go"
+ * searches.
+ */
+type SearchGetNearMatchCompleteHook interface {
+	/**
+	 * @param mixed $term Search term string
+	 * @param mixed &$title Current Title object that is being returned (null if none found).
+	 * @return bool False to abort the hook
+	 */
+	OnSearchGetNearMatchComplete(term interface{}, title *interface{}) bool
+}
+
+/**
+ * Run the SearchGetNearMatchComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSearchGetNearMatchComplete(term interface{}, title *interface{}) bool {
+	args := []interface{}{term, title}
+	return r.container.run("SearchGetNearMatchComplete", args, func(handler interface{}) bool {
+		return handler.(SearchGetNearMatchCompleteHook).OnSearchGetNearMatchComplete(term, title)
+	})
+}
+
+/**
+ * Add fields to search index mapping.
+ */
+type SearchIndexFieldsHook interface {
+	/**
+	 * @param mixed &$fields Array of fields, all implement SearchIndexField
+	 * @param mixed $engine SearchEngine instance for which mapping is being built.
+	 * @return bool False to abort the hook
+	 */
+	OnSearchIndexFields(fields *interface{}, engine interface{}) bool
+}
+
+/**
+ * Run the SearchIndexFields hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSearchIndexFields(fields *interface{}, engine interface{}) bool {
+	args := []interface{}{fields, engine}
+	return r.container.run("SearchIndexFields", args, func(handler interface{}) bool {
+		return handler.(SearchIndexFieldsHook).OnSearchIndexFields(fields, engine)
+	})
+}
+
+/**
+ * Set the revision used when displaying a page in
+ * search results.
+ */
+type SearchResultInitFromTitleHook interface {
+	/**
+	 * @param mixed $title Current Title object being displayed in search results.
+	 * @param mixed &$id Revision ID (default is false, for latest)
+	 * @return bool False to abort the hook
+	 */
+	OnSearchResultInitFromTitle(title interface{}, id *interface{}) bool
+}
+
+/**
+ * Run the SearchResultInitFromTitle hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSearchResultInitFromTitle(title interface{}, id *interface{}) bool {
+	args := []interface{}{title, id}
+	return r.container.run("SearchResultInitFromTitle", args, func(handler interface{}) bool {
+		return handler.(SearchResultInitFromTitleHook).OnSearchResultInitFromTitle(title, id)
+	})
+}
+
+/**
+ * Allows extension to add its code to the list of search
+ * result augmentors.
+ */
+type SearchResultsAugmentHook interface {
+	/**
+	 * @param mixed &$setAugmentors List of whole-set augmentor objects, must implement ResultSetAugmentor.
+	 * @param mixed &$rowAugmentors List of per-row augmentor objects, must implement ResultAugmentor. Note that lists should be in the format name => object and the names in both lists should be distinct.
+	 * @return bool False to abort the hook
+	 */
+	OnSearchResultsAugment(setAugmentors *interface{}, rowAugmentors *interface{}) bool
+}
+
+/**
+ * Run the SearchResultsAugment hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSearchResultsAugment(setAugmentors *interface{}, rowAugmentors *interface{}) bool {
+	args := []interface{}{setAugmentors, rowAugmentors}
+	return r.container.run("SearchResultsAugment", args, func(handler interface{}) bool {
+		return handler.(SearchResultsAugmentHook).OnSearchResultsAugment(setAugmentors, rowAugmentors)
+	})
+}
+
+/**
+ * An option to modify which namespaces are searchable.
+ */
+type SearchableNamespacesHook interface {
+	/**
+	 * @param mixed &$arr Array of namespaces ($nsId => $name) which will be used.
+	 * @return bool False to abort the hook
+	 */
+	OnSearchableNamespaces(arr *interface{}) bool
+}
+
+/**
+ * Run the SearchableNamespaces hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSearchableNamespaces(arr *interface{}) bool {
+	args := []interface{}{arr}
+	return r.container.run("SearchableNamespaces", args, func(handler interface{}) bool {
+		return handler.(SearchableNamespacesHook).OnSearchableNamespaces(arr)
+	})
+}
+
+/**
+ * DEPRECATED! Use RevisionDataUpdates or override
+ * ContentHandler::getSecondaryDataUpdates instead.
+ * Allows modification of the list of DataUpdates to perform when page content is modified.
+ */
+type SecondaryDataUpdatesHook interface {
+	/**
+	 * @param mixed $title Title of the page that is being edited.
+	 * @param mixed $oldContent Content object representing the page's content before the edit.
+	 * @param mixed $recursive bool indicating whether DataUpdates should trigger recursive updates (relevant mostly for LinksUpdate).
+	 * @param mixed $parserOutput ParserOutput representing the rendered version of the page after the edit.
+	 * @param mixed &$updates a list of DataUpdate objects, to be modified or replaced by the hook handler.
+	 * @return bool False to abort the hook
+	 */
+	OnSecondaryDataUpdates(title interface{}, oldContent interface{}, recursive interface{}, parserOutput interface{}, updates *interface{}) bool
+}
+
+/**
+ * Run the SecondaryDataUpdates hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSecondaryDataUpdates(title interface{}, oldContent interface{}, recursive interface{}, parserOutput interface{}, updates *interface{}) bool {
+	args := []interface{}{title, oldContent, recursive, parserOutput, updates}
+	return r.container.run("SecondaryDataUpdates", args, func(handler interface{}) bool {
+		return handler.(SecondaryDataUpdatesHook).OnSecondaryDataUpdates(title, oldContent, recursive, parserOutput, updates)
+	})
+}
+
+/**
+ * Affect the return value from
+ * MediaWiki\Auth\AuthManager::securitySensitiveOperationStatus().
+ */
+type SecuritySensitiveOperationStatusHook interface {
+	/**
+	 * @param mixed &$status (string) The status to be returned. One of the AuthManager::SEC_* constants. SEC_REAUTH will be automatically changed to SEC_FAIL if authentication isn't possible for the current session type.
+	 * @param mixed $operation (string) The operation being checked.
+	 * @param mixed $session (MediaWiki\Session\Session) The current session. The currently-authenticated user may be retrieved as $session->getUser().
+	 * @param mixed $timeSinceAuth (int) The time since last authentication. PHP_INT_MAX if the time of last auth is unknown, or -1 if authentication is not possible.
+	 * @return bool False to abort the hook
+	 */
+	OnSecuritySensitiveOperationStatus(status *interface{}, operation interface{}, session interface{}, timeSinceAuth interface{}) bool
+}
+
+/**
+ * Run the SecuritySensitiveOperationStatus hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSecuritySensitiveOperationStatus(status *interface{}, operation interface{}, session interface{}, timeSinceAuth interface{}) bool {
+	args := []interface{}{status, operation, session, timeSinceAuth}
+	return r.container.run("SecuritySensitiveOperationStatus", args, func(handler interface{}) bool {
+		return handler.(SecuritySensitiveOperationStatusHook).OnSecuritySensitiveOperationStatus(status, operation, session, timeSinceAuth)
+	})
+}
+
+/**
+ * Called before a link to the current article is displayed to
+ * allow the display of the link to be customized.
+ */
+type SelfLinkBeginHook interface {
+	/**
+	 * @param mixed $nt the Title object
+	 * @param mixed &$html html to display for the link
+	 * @param mixed &$trail optional text to display before $html
+	 * @param mixed &$prefix optional text to display after $html
+	 * @param mixed &$ret the value to return if your hook returns false
+	 * @return bool False to abort the hook
+	 */
+	OnSelfLinkBegin(nt interface{}, html *interface{}, trail *interface{}, prefix *interface{}, ret *interface{}) bool
+}
+
+/**
+ * Run the SelfLinkBegin hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSelfLinkBegin(nt interface{}, html *interface{}, trail *interface{}, prefix *interface{}, ret *interface{}) bool {
+	args := []interface{}{nt, html, trail, prefix, ret}
+	return r.container.run("SelfLinkBegin", args, func(handler interface{}) bool {
+		return handler.(SelfLinkBeginHook).OnSelfLinkBegin(nt, html, trail, prefix, ret)
+	})
+}
+
+/**
+ * Return true to send watchlist email
+ * notification
+ */
+type SendWatchlistEmailNotificationHook interface {
+	/**
+	 * @param mixed $targetUser the user whom to send watchlist email notification
+	 * @param mixed $title the page title
+	 * @param mixed $enotif EmailNotification object
+	 * @return bool False to abort the hook
+	 */
+	OnSendWatchlistEmailNotification(targetUser interface{}, title interface{}, enotif interface{}) bool
+}
+
+/**
+ * Run the SendWatchlistEmailNotification hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSendWatchlistEmailNotification(targetUser interface{}, title interface{}, enotif interface{}) bool {
+	args := []interface{}{targetUser, title, enotif}
+	return r.container.run("SendWatchlistEmailNotification", args, func(handler interface{}) bool {
+		return handler.(SendWatchlistEmailNotificationHook).OnSendWatchlistEmailNotification(targetUser, title, enotif)
+	})
+}
+
+/**
+ * Validate a MediaWiki\Session\SessionInfo as it's being
+ * loaded from storage. Return false to prevent it from being used.
+ */
+type SessionCheckInfoHook interface {
+	/**
+	 * @param mixed &$reason String rejection reason to be logged
+	 * @param mixed $info MediaWiki\Session\SessionInfo being validated
+	 * @param mixed $request WebRequest being loaded from
+	 * @param mixed $metadata Array|false Metadata array for the MediaWiki\Session\Session
+	 * @param mixed $data Array|false Data array for the MediaWiki\Session\Session
+	 * @return bool False to abort the hook
+	 */
+	OnSessionCheckInfo(reason *interface{}, info interface{}, request interface{}, metadata interface{}, data interface{}) bool
+}
+
+/**
+ * Run the SessionCheckInfo hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSessionCheckInfo(reason *interface{}, info interface{}, request interface{}, metadata interface{}, data interface{}) bool {
+	args := []interface{}{reason, info, request, metadata, data}
+	return r.container.run("SessionCheckInfo", args, func(handler interface{}) bool {
+		return handler.(SessionCheckInfoHook).OnSessionCheckInfo(reason, info, request, metadata, data)
+	})
+}
+
+/**
+ * Add metadata to a session being saved.
+ */
+type SessionMetadataHook interface {
+	/**
+	 * @param mixed $backend MediaWiki\Session\SessionBackend being saved.
+	 * @param mixed &$metadata Array Metadata to be stored. Add new keys here.
+	 * @param mixed $requests Array of WebRequests potentially being saved to. Generally 0-1 real request and 0+ FauxRequests.
+	 * @return bool False to abort the hook
+	 */
+	OnSessionMetadata(backend interface{}, metadata *interface{}, requests interface{}) bool
+}
+
+/**
+ * Run the SessionMetadata hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSessionMetadata(backend interface{}, metadata *interface{}, requests interface{}) bool {
+	args := []interface{}{backend, metadata, requests}
+	return r.container.run("SessionMetadata", args, func(handler interface{}) bool {
+		return handler.(SessionMetadataHook).OnSessionMetadata(backend, metadata, requests)
+	})
+}
+
+/**
+ * Called in Setup.php, after cache objects are set
+ */
+type SetupAfterCacheHook interface {
+	/**
+	 * @return bool False to abort the hook
+	 */
+	OnSetupAfterCache() bool
+}
+
+/**
+ * Run the SetupAfterCache hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSetupAfterCache() bool {
+	args := []interface{}{}
+	return r.container.run("SetupAfterCache", args, func(handler interface{}) bool {
+		return handler.(SetupAfterCacheHook).OnSetupAfterCache()
+	})
+}
+
+/**
+ * Allow extensions to modify the query used by
+ * Special:ShortPages.
+ */
+type ShortPagesQueryHook interface {
+	/**
+	 * @param mixed &$tables tables to join in the query
+	 * @param mixed &$conds conditions for the query
+	 * @param mixed &$joinConds join conditions for the query
+	 * @param mixed &$options options for the query
+	 * @return bool False to abort the hook
+	 */
+	OnShortPagesQuery(tables *interface{}, conds *interface{}, joinConds *interface{}, options *interface{}) bool
+}
+
+/**
+ * Run the ShortPagesQuery hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnShortPagesQuery(tables *interface{}, conds *interface{}, joinConds *interface{}, options *interface{}) bool {
+	args := []interface{}{tables, conds, joinConds, options}
+	return r.container.run("ShortPagesQuery", args, func(handler interface{}) bool {
+		return handler.(ShortPagesQueryHook).OnShortPagesQuery(tables, conds, joinConds, options)
+	})
+}
+
+/**
+ * Called when generating the output for a non-existent page.
+ */
+type ShowMissingArticleHook interface {
+	/**
+	 * @param mixed $article The article object corresponding to the page
+	 * @return bool False to abort the hook
+	 */
+	OnShowMissingArticle(article interface{}) bool
+}
+
+/**
+ * Run the ShowMissingArticle hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnShowMissingArticle(article interface{}) bool {
+	args := []interface{}{article}
+	return r.container.run("ShowMissingArticle", args, func(handler interface{}) bool {
+		return handler.(ShowMissingArticleHook).OnShowMissingArticle(article)
+	})
+}
+
+/**
+ * Customize display of search hit.
+ */
+type ShowSearchHitHook interface {
+	/**
+	 * @param mixed $searchPage The SpecialSearch instance.
+	 * @param mixed $result The SearchResult to show
+	 * @param mixed $terms Search terms, for highlighting
+	 * @param mixed &$link HTML of link to the matching page. May be modified.
+	 * @param mixed &$redirect HTML of redirect info. May be modified.
+	 * @param mixed &$section HTML of matching section. May be modified.
+	 * @param mixed &$extract HTML of content extract. May be modified.
+	 * @param mixed &$score HTML of score. May be modified.
+	 * @param mixed &$size HTML of page size. May be modified.
+	 * @param mixed &$date HTML of of page modification date. May be modified.
+	 * @param mixed &$related HTML of additional info for the matching page. May be modified.
+	 * @param mixed &$html May be set to the full HTML that should be used to represent the search hit. Must include the <li> ... </li> tags. Will only be used if the hook function returned false.
+	 * @return bool False to abort the hook
+	 */
+	OnShowSearchHit(searchPage interface{}, result interface{}, terms interface{}, link *interface{}, redirect *interface{}, section *interface{}, extract *interface{}, score *interface{}, size *interface{}, date *interface{}, related *interface{}, html *interface{}) bool
+}
+
+/**
+ * Run the ShowSearchHit hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnShowSearchHit(searchPage interface{}, result interface{}, terms interface{}, link *interface{}, redirect *interface{}, section *interface{}, extract *interface{}, score *interface{}, size *interface{}, date *interface{}, related *interface{}, html *interface{}) bool {
+	args := []interface{}{searchPage, result, terms, link, redirect, section, extract, score, size, date, related, html}
+	return r.container.run("ShowSearchHit", args, func(handler interface{}) bool {
+		return handler.(ShowSearchHitHook).OnShowSearchHit(searchPage, result, terms, link, redirect, section, extract, score, size, date, related, html)
+	})
+}
+
+/**
+ * Customise display of search hit title/link.
+ */
+type ShowSearchHitTitleHook interface {
+	/**
+	 * @param mixed &$title Title to link to
+	 * @param mixed &$titleSnippet Label for the link representing the search result. Typically the article title.
+	 * @param mixed $result The SearchResult object
+	 * @param mixed $terms String of the search terms entered
+	 * @param mixed $specialSearch The SpecialSearch object
+	 * @param mixed &$query Array of query string parameters for the link representing the search result.
+	 * @param mixed &$attributes Array of title link attributes, can be modified by extension.
+	 * @return bool False to abort the hook
+	 */
+	OnShowSearchHitTitle(title *interface{}, titleSnippet *interface{}, result interface{}, terms interface{}, specialSearch interface{}, query *interface{}, attributes *interface{}) bool
+}
+
+/**
+ * Run the ShowSearchHitTitle hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnShowSearchHitTitle(title *interface{}, titleSnippet *interface{}, result interface{}, terms interface{}, specialSearch interface{}, query *interface{}, attributes *interface{}) bool {
+	args := []interface{}{title, titleSnippet, result, terms, specialSearch, query, attributes}
+	return r.container.run("ShowSearchHitTitle", args, func(handler interface{}) bool {
+		return handler.(ShowSearchHitTitleHook).OnShowSearchHitTitle(title, titleSnippet, result, terms, specialSearch, query, attributes)
+	})
+}
+
+/**
+ * Allows to edit sidebar just before it is output by skins.
+ * Warning: This hook is run on each display. You should consider to use
+ * 'SkinBuildSidebar' that is aggressively cached.
+ */
+type SidebarBeforeOutputHook interface {
+	/**
+	 * @param mixed $skin Skin object
+	 * @param mixed &$bar Sidebar content Modify $bar to add or modify sidebar portlets.
+	 * @return bool False to abort the hook
+	 */
+	OnSidebarBeforeOutput(skin interface{}, bar *interface{}) bool
+}
+
+/**
+ * Run the SidebarBeforeOutput hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSidebarBeforeOutput(skin interface{}, bar *interface{}) bool {
+	args := []interface{}{skin, bar}
+	return r.container.run("SidebarBeforeOutput", args, func(handler interface{}) bool {
+		return handler.(SidebarBeforeOutputHook).OnSidebarBeforeOutput(skin, bar)
+	})
+}
+
+/**
+ * After the sitenotice/anonnotice is composed.
+ */
+type SiteNoticeAfterHook interface {
+	/**
+	 * @param mixed &$siteNotice HTML sitenotice. Alter the contents of $siteNotice to add to/alter the sitenotice/anonnotice.
+	 * @param mixed $skin Skin object
+	 * @return bool False to abort the hook
+	 */
+	OnSiteNoticeAfter(siteNotice *interface{}, skin interface{}) bool
+}
+
+/**
+ * Run the SiteNoticeAfter hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSiteNoticeAfter(siteNotice *interface{}, skin interface{}) bool {
+	args := []interface{}{siteNotice, skin}
+	return r.container.run("SiteNoticeAfter", args, func(handler interface{}) bool {
+		return handler.(SiteNoticeAfterHook).OnSiteNoticeAfter(siteNotice, skin)
+	})
+}
+
+/**
+ * Before the sitenotice/anonnotice is composed. Return true to
+ * allow the normal method of notice selection/rendering to work, or change the
+ * value of $siteNotice and return false to alter it.
+ */
+type SiteNoticeBeforeHook interface {
+	/**
+	 * @param mixed &$siteNotice HTML returned as the sitenotice
+	 * @param mixed $skin Skin object
+	 * @return bool False to abort the hook
+	 */
+	OnSiteNoticeBefore(siteNotice *interface{}, skin interface{}) bool
+}
+
+/**
+ * Run the SiteNoticeBefore hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSiteNoticeBefore(siteNotice *interface{}, skin interface{}) bool {
+	args := []interface{}{siteNotice, skin}
+	return r.container.run("SiteNoticeBefore", args, func(handler interface{}) bool {
+		return handler.(SiteNoticeBeforeHook).OnSiteNoticeBefore(siteNotice, skin)
+	})
+}
+
+/**
+ * At the end of Skin::bottomScripts().
+ */
+type SkinAfterBottomScriptsHook interface {
+	/**
+	 * @param mixed $skin Skin object
+	 * @param mixed &$text bottomScripts Text. Append to $text to add additional text/scripts after the stock bottom scripts.
+	 * @return bool False to abort the hook
+	 */
+	OnSkinAfterBottomScripts(skin interface{}, text *interface{}) bool
+}
+
+/**
+ * Run the SkinAfterBottomScripts hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinAfterBottomScripts(skin interface{}, text *interface{}) bool {
+	args := []interface{}{skin, text}
+	return r.container.run("SkinAfterBottomScripts", args, func(handler interface{}) bool {
+		return handler.(SkinAfterBottomScriptsHook).OnSkinAfterBottomScripts(skin, text)
+	})
+}
+
+/**
+ * Allows extensions to add text after the page content and
+ * article metadata. This hook should work in all skins. Set the &$data variable to
+ * the text you're going to add.
+ */
+type SkinAfterContentHook interface {
+	/**
+	 * @param mixed &$data (string) Text to be printed out directly (without parsing)
+	 * @param mixed $skin Skin object
+	 * @return bool False to abort the hook
+	 */
+	OnSkinAfterContent(data *interface{}, skin interface{}) bool
+}
+
+/**
+ * Run the SkinAfterContent hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinAfterContent(data *interface{}, skin interface{}) bool {
+	args := []interface{}{data, skin}
+	return r.container.run("SkinAfterContent", args, func(handler interface{}) bool {
+		return handler.(SkinAfterContentHook).OnSkinAfterContent(data, skin)
+	})
+}
+
+/**
+ * At the end of Skin::buildSidebar().
+ */
+type SkinBuildSidebarHook interface {
+	/**
+	 * @param mixed $skin Skin object
+	 * @param mixed &$bar Sidebar contents Modify $bar to add or modify sidebar portlets.
+	 * @return bool False to abort the hook
+	 */
+	OnSkinBuildSidebar(skin interface{}, bar *interface{}) bool
+}
+
+/**
+ * Run the SkinBuildSidebar hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinBuildSidebar(skin interface{}, bar *interface{}) bool {
+	args := []interface{}{skin, bar}
+	return r.container.run("SkinBuildSidebar", args, func(handler interface{}) bool {
+		return handler.(SkinBuildSidebarHook).OnSkinBuildSidebar(skin, bar)
+	})
+}
+
+/**
+ * Allow for site and per-namespace customization of
+ * copyright notice.
+ */
+type SkinCopyrightFooterHook interface {
+	/**
+	 * @param mixed $title displayed page title
+	 * @param mixed $type 'normal' or 'history' for old/diff views
+	 * @param mixed &$msg overridable message; usually 'copyright' or 'history_copyright'. This message must be in HTML format, not wikitext!
+	 * @param mixed &$link overridable HTML link to be passed into the message as $1
+	 * @param mixed &$forContent DEPRECATED! overridable flag if copyright footer is shown in content language.
+	 * @return bool False to abort the hook
+	 */
+	OnSkinCopyrightFooter(title interface{}, typeParam interface{}, msg *interface{}, link *interface{}, forContent *interface{}) bool
+}
+
+/**
+ * Run the SkinCopyrightFooter hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinCopyrightFooter(title interface{}, typeParam interface{}, msg *interface{}, link *interface{}, forContent *interface{}) bool {
+	args := []interface{}{title, typeParam, msg, link, forContent}
+	return r.container.run("SkinCopyrightFooter", args, func(handler interface{}) bool {
+		return handler.(SkinCopyrightFooterHook).OnSkinCopyrightFooter(title, typeParam, msg, link, forContent)
+	})
+}
+
+/**
+ * Modify the section edit links
+ */
+type SkinEditSectionLinksHook interface {
+	/**
+	 * @param mixed $skin Skin object rendering the UI
+	 * @param mixed $title Title object for the title being linked to (may not be the same as the page title, if the section is included from a template)
+	 * @param mixed $section The designation of the section being pointed to, to be included in the link, like "&section=$section"
+	 * @param mixed $tooltip The default tooltip.  Escape before using. By default, this is wrapped in the 'editsectionhint' message.
+	 * @param mixed &$result Array containing all link detail arrays. Each link detail array should contain the following keys: - targetTitle - Target Title object - text - String for the text - attribs - Array of attributes - query - Array of query parameters to add to the URL - options - Array of options for Linker::link
+	 * @param mixed $lang The language code to use for the link in the wfMessage function
+	 * @return bool False to abort the hook
+	 */
+	OnSkinEditSectionLinks(skin interface{}, title interface{}, section interface{}, tooltip interface{}, result *interface{}, lang interface{}) bool
+}
+
+/**
+ * Run the SkinEditSectionLinks hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinEditSectionLinks(skin interface{}, title interface{}, section interface{}, tooltip interface{}, result *interface{}, lang interface{}) bool {
+	args := []interface{}{skin, title, section, tooltip, result, lang}
+	return r.container.run("SkinEditSectionLinks", args, func(handler interface{}) bool {
+		return handler.(SkinEditSectionLinksHook).OnSkinEditSectionLinks(skin, title, section, tooltip, result, lang)
+	})
+}
+
+/**
+ * TODO
+ */
+type SkinGetPoweredByHook interface {
+	/**
+	 * @param mixed &$text additional 'powered by' icons in HTML. Note: Modern skin does not use the MediaWiki icon but plain text instead.
+	 * @param mixed $skin Skin object
+	 * @return bool False to abort the hook
+	 */
+	OnSkinGetPoweredBy(text *interface{}, skin interface{}) bool
+}
+
+/**
+ * Run the SkinGetPoweredBy hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinGetPoweredBy(text *interface{}, skin interface{}) bool {
+	args := []interface{}{text, skin}
+	return r.container.run("SkinGetPoweredBy", args, func(handler interface{}) bool {
+		return handler.(SkinGetPoweredByHook).OnSkinGetPoweredBy(text, skin)
+	})
+}
+
+/**
+ * Supply titles that should be added to link existence
+ * cache before the page is rendered.
+ */
+type SkinPreloadExistenceHook interface {
+	/**
+	 * @param mixed &$titles Array of Title objects
+	 * @param mixed $skin Skin object
+	 * @return bool False to abort the hook
+	 */
+	OnSkinPreloadExistence(titles *interface{}, skin interface{}) bool
+}
+
+/**
+ * Run the SkinPreloadExistence hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinPreloadExistence(titles *interface{}, skin interface{}) bool {
+	args := []interface{}{titles, skin}
+	return r.container.run("SkinPreloadExistence", args, func(handler interface{}) bool {
+		return handler.(SkinPreloadExistenceHook).OnSkinPreloadExistence(titles, skin)
+	})
+}
+
+/**
+ * At the beginning of Skin::subPageSubtitle().
+ * If false is returned $subpages will be used instead of the HTML
+ * subPageSubtitle() generates.
+ * If true is returned, $subpages will be ignored and the rest of
+ * subPageSubtitle() will run.
+ */
+type SkinSubPageSubtitleHook interface {
+	/**
+	 * @param mixed &$subpages Subpage links HTML
+	 * @param mixed $skin Skin object
+	 * @param mixed $out OutputPage object
+	 * @return bool False to abort the hook
+	 */
+	OnSkinSubPageSubtitle(subpages *interface{}, skin interface{}, out interface{}) bool
+}
+
+/**
+ * Run the SkinSubPageSubtitle hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinSubPageSubtitle(subpages *interface{}, skin interface{}, out interface{}) bool {
+	args := []interface{}{subpages, skin, out}
+	return r.container.run("SkinSubPageSubtitle", args, func(handler interface{}) bool {
+		return handler.(SkinSubPageSubtitleHook).OnSkinSubPageSubtitle(subpages, skin, out)
+	})
+}
+
+/**
+ * After creating the "permanent
+ * link" tab.
+ */
+type SkinTemplateBuildNavUrlsNav_urlsAfterPermalinkHook interface {
+	/**
+	 * @param mixed &$sktemplate SkinTemplate object
+	 * @param mixed &$nav_urls array of tabs
+	 * @param mixed &$revid The revision id of the permanent link
+	 * @param mixed &$revid2 The revision id of the permanent link, second time
+	 * @return bool False to abort the hook
+	 */
+	OnSkinTemplateBuildNavUrlsNav_urlsAfterPermalink(sktemplate *interface{}, nav_urls *interface{}, revid *interface{}, revid2 *interface{}) bool
+}
+
+/**
+ * Run the SkinTemplateBuildNavUrlsNav_urlsAfterPermalink hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinTemplateBuildNavUrlsNav_urlsAfterPermalink(sktemplate *interface{}, nav_urls *interface{}, revid *interface{}, revid2 *interface{}) bool {
+	args := []interface{}{sktemplate, nav_urls, revid, revid2}
+	return r.container.run("SkinTemplateBuildNavUrlsNav_urlsAfterPermalink", args, func(handler interface{}) bool {
+		return handler.(SkinTemplateBuildNavUrlsNav_urlsAfterPermalinkHook).OnSkinTemplateBuildNavUrlsNav_urlsAfterPermalink(sktemplate, nav_urls, revid, revid2)
+	})
+}
+
+/**
+ * After building the data for a language link from
+ * which the actual html is constructed.
+ */
+type SkinTemplateGetLanguageLinkHook interface {
+	/**
+	 * @param mixed &$languageLink array containing data about the link. The following keys can be modified: href, text, title, class, lang, hreflang. Each of them is a string.
+	 * @param mixed $languageLinkTitle Title object belonging to the external language link.
+	 * @param mixed $title Title object of the page the link belongs to.
+	 * @param mixed $outputPage The OutputPage object the links are built from.
+	 * @return bool False to abort the hook
+	 */
+	OnSkinTemplateGetLanguageLink(languageLink *interface{}, languageLinkTitle interface{}, title interface{}, outputPage interface{}) bool
+}
+
+/**
+ * Run the SkinTemplateGetLanguageLink hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinTemplateGetLanguageLink(languageLink *interface{}, languageLinkTitle interface{}, title interface{}, outputPage interface{}) bool {
+	args := []interface{}{languageLink, languageLinkTitle, title, outputPage}
+	return r.container.run("SkinTemplateGetLanguageLink", args, func(handler interface{}) bool {
+		return handler.(SkinTemplateGetLanguageLinkHook).OnSkinTemplateGetLanguageLink(languageLink, languageLinkTitle, title, outputPage)
+	})
+}
+
+/**
+ * Called on content pages after the tabs have been
+ * added, but before variants have been added.
+ */
+type SkinTemplateNavigationHook interface {
+	/**
+	 * @param mixed &$sktemplate SkinTemplate object
+	 * @param mixed &$links Structured navigation links. This is used to alter the navigation for skins which use buildNavigationUrls such as Vector.
+	 * @return bool False to abort the hook
+	 */
+	OnSkinTemplateNavigation(sktemplate *interface{}, links *interface{}) bool
+}
+
+/**
+ * Run the SkinTemplateNavigation hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinTemplateNavigation(sktemplate *interface{}, links *interface{}) bool {
+	args := []interface{}{sktemplate, links}
+	return r.container.run("SkinTemplateNavigation", args, func(handler interface{}) bool {
+		return handler.(SkinTemplateNavigationHook).OnSkinTemplateNavigation(sktemplate, links)
+	})
+}
+
+/**
+ * Called on special pages after the special
+ * tab is added but before variants have been added.
+ */
+type SkinTemplateNavigation__SpecialPageHook interface {
+	/**
+	 * @param mixed &$sktemplate SkinTemplate object
+	 * @param mixed &$links Structured navigation links. This is used to alter the navigation for skins which use buildNavigationUrls such as Vector.
+	 * @return bool False to abort the hook
+	 */
+	OnSkinTemplateNavigation__SpecialPage(sktemplate *interface{}, links *interface{}) bool
+}
+
+/**
+ * Run the SkinTemplateNavigation::SpecialPage hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinTemplateNavigation__SpecialPage(sktemplate *interface{}, links *interface{}) bool {
+	args := []interface{}{sktemplate, links}
+	return r.container.run("SkinTemplateNavigation::SpecialPage", args, func(handler interface{}) bool {
+		return handler.(SkinTemplateNavigation__SpecialPageHook).OnSkinTemplateNavigation__SpecialPage(sktemplate, links)
+	})
+}
+
+/**
+ * Called on both content and special pages
+ * after variants have been added.
+ */
+type SkinTemplateNavigation__UniversalHook interface {
+	/**
+	 * @param mixed &$sktemplate SkinTemplate object
+	 * @param mixed &$links Structured navigation links. This is used to alter the navigation for skins which use buildNavigationUrls such as Vector.
+	 * @return bool False to abort the hook
+	 */
+	OnSkinTemplateNavigation__Universal(sktemplate *interface{}, links *interface{}) bool
+}
+
+/**
+ * Run the SkinTemplateNavigation::Universal hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinTemplateNavigation__Universal(sktemplate *interface{}, links *interface{}) bool {
+	args := []interface{}{sktemplate, links}
+	return r.container.run("SkinTemplateNavigation::Universal", args, func(handler interface{}) bool {
+		return handler.(SkinTemplateNavigation__UniversalHook).OnSkinTemplateNavigation__Universal(sktemplate, links)
+	})
+}
+
+/**
+ * Before SkinTemplate::outputPage() starts
+ * page output.
+ */
+type SkinTemplateOutputPageBeforeExecHook interface {
+	/**
+	 * @param mixed &$sktemplate SkinTemplate object
+	 * @param mixed &$tpl QuickTemplate engine object
+	 * @return bool False to abort the hook
+	 */
+	OnSkinTemplateOutputPageBeforeExec(sktemplate *interface{}, tpl *interface{}) bool
+}
+
+/**
+ * Run the SkinTemplateOutputPageBeforeExec hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinTemplateOutputPageBeforeExec(sktemplate *interface{}, tpl *interface{}) bool {
+	args := []interface{}{sktemplate, tpl}
+	return r.container.run("SkinTemplateOutputPageBeforeExec", args, func(handler interface{}) bool {
+		return handler.(SkinTemplateOutputPageBeforeExecHook).OnSkinTemplateOutputPageBeforeExec(sktemplate, tpl)
+	})
+}
+
+/**
+ * Use this to prevent showing active tabs.
+ */
+type SkinTemplatePreventOtherActiveTabsHook interface {
+	/**
+	 * @param mixed &$sktemplate SkinTemplate object
+	 * @param mixed &$res set to true to prevent active tabs
+	 * @return bool False to abort the hook
+	 */
+	OnSkinTemplatePreventOtherActiveTabs(sktemplate *interface{}, res *interface{}) bool
+}
+
+/**
+ * Run the SkinTemplatePreventOtherActiveTabs hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinTemplatePreventOtherActiveTabs(sktemplate *interface{}, res *interface{}) bool {
+	args := []interface{}{sktemplate, res}
+	return r.container.run("SkinTemplatePreventOtherActiveTabs", args, func(handler interface{}) bool {
+		return handler.(SkinTemplatePreventOtherActiveTabsHook).OnSkinTemplatePreventOtherActiveTabs(sktemplate, res)
+	})
+}
+
+/**
+ * Override SkinTemplate::tabAction().
+ * You can either create your own array, or alter the parameters for
+ * the normal one.
+ */
+type SkinTemplateTabActionHook interface {
+	/**
+	 * @param mixed &$sktemplate The SkinTemplate instance.
+	 * @param mixed $title Title instance for the page.
+	 * @param mixed $message Visible label of tab.
+	 * @param mixed $selected Whether this is a selected tab.
+	 * @param mixed $checkEdit Whether or not the action=edit query should be added if appropriate.
+	 * @param mixed &$classes Array of CSS classes to apply.
+	 * @param mixed &$query Query string to add to link.
+	 * @param mixed &$text Link text.
+	 * @param mixed &$result Complete assoc. array if you want to return true.
+	 * @return bool False to abort the hook
+	 */
+	OnSkinTemplateTabAction(sktemplate *interface{}, title interface{}, message interface{}, selected interface{}, checkEdit interface{}, classes *interface{}, query *interface{}, text *interface{}, result *interface{}) bool
+}
+
+/**
+ * Run the SkinTemplateTabAction hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinTemplateTabAction(sktemplate *interface{}, title interface{}, message interface{}, selected interface{}, checkEdit interface{}, classes *interface{}, query *interface{}, text *interface{}, result *interface{}) bool {
+	args := []interface{}{sktemplate, title, message, selected, checkEdit, classes, query, text, result}
+	return r.container.run("SkinTemplateTabAction", args, func(handler interface{}) bool {
+		return handler.(SkinTemplateTabActionHook).OnSkinTemplateTabAction(sktemplate, title, message, selected, checkEdit, classes, query, text, result)
+	})
+}
+
+/**
+ * Called by SkinTemplate skins after toolbox links have
+ * been rendered (useful for adding more).
+ */
+type SkinTemplateToolboxEndHook interface {
+	/**
+	 * @param mixed &$sk The QuickTemplate based skin template running the hook.
+	 * @param mixed $dummy Called when SkinTemplateToolboxEnd is used from a BaseTemplate skin, extensions that add support for BaseTemplateToolbox should watch for this dummy parameter with "$dummy=false" in their code and return without echoing any HTML to avoid creating duplicate toolbox items.
+	 * @return bool False to abort the hook
+	 */
+	OnSkinTemplateToolboxEnd(sk *interface{}, dummy interface{}) bool
+}
+
+/**
+ * Run the SkinTemplateToolboxEnd hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSkinTemplateToolboxEnd(sk *interface{}, dummy interface{}) bool {
+	args := []interface{}{sk, dummy}
+	return r.container.run("SkinTemplateToolboxEnd", args, func(handler interface{}) bool {
+		return handler.(SkinTemplateToolboxEndHook).OnSkinTemplateToolboxEnd(sk, dummy)
+	})
+}
+
+/**
+ * Called by Special:Version for returning information about the
+ * software.
+ */
+type SoftwareInfoHook interface {
+	/**
+	 * @param map[string]string &$software The array of software in format 'name' => 'version'. See SpecialVersion::softwareInformation().
+	 * @return bool False to abort the hook
+	 */
+	OnSoftwareInfo(software *map[string]string) bool
+}
+
+/**
+ * Run the SoftwareInfo hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSoftwareInfo(software *map[string]string) bool {
+	args := []interface{}{software}
+	return r.container.run("SoftwareInfo", args, func(handler interface{}) bool {
+		return handler.(SoftwareInfoHook).OnSoftwareInfo(software)
+	})
+}
+
+/**
+ * Add more fields to Special:Block
+ */
+type SpecialBlockModifyFormFieldsHook interface {
+	/**
+	 * @param mixed $sp SpecialPage object, for context
+	 * @param mixed &$fields Current HTMLForm fields
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialBlockModifyFormFields(sp interface{}, fields *interface{}) bool
+}
+
+/**
+ * Run the SpecialBlockModifyFormFields hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialBlockModifyFormFields(sp interface{}, fields *interface{}) bool {
+	args := []interface{}{sp, fields}
+	return r.container.run("SpecialBlockModifyFormFields", args, func(handler interface{}) bool {
+		return handler.(SpecialBlockModifyFormFieldsHook).OnSpecialBlockModifyFormFields(sp, fields)
+	})
+}
+
+/**
+ * Called before rendering a
+ * Special:Contributions row.
+ */
+type SpecialContributions__formatRow__flagsHook interface {
+	/**
+	 * @param mixed $context IContextSource object
+	 * @param mixed $row Revision information from the database
+	 * @param mixed &$flags List of flags on this row
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialContributions__formatRow__flags(context interface{}, row interface{}, flags *interface{}) bool
+}
+
+/**
+ * Run the SpecialContributions::formatRow::flags hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialContributions__formatRow__flags(context interface{}, row interface{}, flags *interface{}) bool {
+	args := []interface{}{context, row, flags}
+	return r.container.run("SpecialContributions::formatRow::flags", args, func(handler interface{}) bool {
+		return handler.(SpecialContributions__formatRow__flagsHook).OnSpecialContributions__formatRow__flags(context, row, flags)
+	})
+}
+
+/**
+ * Called with a list of filters to render
+ * on Special:Contributions.
+ */
+type SpecialContributions__getForm__filtersHook interface {
+	/**
+	 * @param mixed $sp SpecialContributions object, for context
+	 * @param mixed &$filters List of filters rendered as HTML
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialContributions__getForm__filters(sp interface{}, filters *interface{}) bool
+}
+
+/**
+ * Run the SpecialContributions::getForm::filters hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialContributions__getForm__filters(sp interface{}, filters *interface{}) bool {
+	args := []interface{}{sp, filters}
+	return r.container.run("SpecialContributions::getForm::filters", args, func(handler interface{}) bool {
+		return handler.(SpecialContributions__getForm__filtersHook).OnSpecialContributions__getForm__filters(sp, filters)
+	})
+}
+
+/**
+ * Before the form on Special:Contributions
+ */
+type SpecialContributionsBeforeMainOutputHook interface {
+	/**
+	 * @param mixed $id User id number, only provided for backwards-compatibility
+	 * @param mixed $user User object representing user contributions are being fetched for
+	 * @param mixed $sp SpecialPage instance, providing context
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialContributionsBeforeMainOutput(id interface{}, user interface{}, sp interface{}) bool
+}
+
+/**
+ * Run the SpecialContributionsBeforeMainOutput hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialContributionsBeforeMainOutput(id interface{}, user interface{}, sp interface{}) bool {
+	args := []interface{}{id, user, sp}
+	return r.container.run("SpecialContributionsBeforeMainOutput", args, func(handler interface{}) bool {
+		return handler.(SpecialContributionsBeforeMainOutputHook).OnSpecialContributionsBeforeMainOutput(id, user, sp)
+	})
+}
+
+/**
+ * Called right before the end of
+ * UsersPager::getDefaultQuery().
+ */
+type SpecialListusersDefaultQueryHook interface {
+	/**
+	 * @param mixed $pager The UsersPager instance
+	 * @param mixed &$query The query array to be returned
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialListusersDefaultQuery(pager interface{}, query *interface{}) bool
+}
+
+/**
+ * Run the SpecialListusersDefaultQuery hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialListusersDefaultQuery(pager interface{}, query *interface{}) bool {
+	args := []interface{}{pager, query}
+	return r.container.run("SpecialListusersDefaultQuery", args, func(handler interface{}) bool {
+		return handler.(SpecialListusersDefaultQueryHook).OnSpecialListusersDefaultQuery(pager, query)
+	})
+}
+
+/**
+ * Called right before the end of
+ * UsersPager::formatRow().
+ */
+type SpecialListusersFormatRowHook interface {
+	/**
+	 * @param mixed &$item HTML to be returned. Will be wrapped in an <li> after the hook finishes
+	 * @param mixed $row Database row object
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialListusersFormatRow(item *interface{}, row interface{}) bool
+}
+
+/**
+ * Run the SpecialListusersFormatRow hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialListusersFormatRow(item *interface{}, row interface{}) bool {
+	args := []interface{}{item, row}
+	return r.container.run("SpecialListusersFormatRow", args, func(handler interface{}) bool {
+		return handler.(SpecialListusersFormatRowHook).OnSpecialListusersFormatRow(item, row)
+	})
+}
+
+/**
+ * Called after adding the submit button in
+ * UsersPager::getPageHeader().
+ */
+type SpecialListusersHeaderHook interface {
+	/**
+	 * @param mixed $pager The UsersPager instance
+	 * @param mixed &$out The header HTML
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialListusersHeader(pager interface{}, out *interface{}) bool
+}
+
+/**
+ * Run the SpecialListusersHeader hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialListusersHeader(pager interface{}, out *interface{}) bool {
+	args := []interface{}{pager, out}
+	return r.container.run("SpecialListusersHeader", args, func(handler interface{}) bool {
+		return handler.(SpecialListusersHeaderHook).OnSpecialListusersHeader(pager, out)
+	})
+}
+
+/**
+ * Called before adding the submit button in
+ * UsersPager::getPageHeader().
+ */
+type SpecialListusersHeaderFormHook interface {
+	/**
+	 * @param mixed $pager The UsersPager instance
+	 * @param mixed &$out The header HTML
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialListusersHeaderForm(pager interface{}, out *interface{}) bool
+}
+
+/**
+ * Run the SpecialListusersHeaderForm hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialListusersHeaderForm(pager interface{}, out *interface{}) bool {
+	args := []interface{}{pager, out}
+	return r.container.run("SpecialListusersHeaderForm", args, func(handler interface{}) bool {
+		return handler.(SpecialListusersHeaderFormHook).OnSpecialListusersHeaderForm(pager, out)
+	})
+}
+
+/**
+ * Called right before the end of.
+ * UsersPager::getQueryInfo()
+ */
+type SpecialListusersQueryInfoHook interface {
+	/**
+	 * @param mixed $pager The UsersPager instance
+	 * @param mixed &$query The query array to be returned
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialListusersQueryInfo(pager interface{}, query *interface{}) bool
+}
+
+/**
+ * Run the SpecialListusersQueryInfo hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialListusersQueryInfo(pager interface{}, query *interface{}) bool {
+	args := []interface{}{pager, query}
+	return r.container.run("SpecialListusersQueryInfo", args, func(handler interface{}) bool {
+		return handler.(SpecialListusersQueryInfoHook).OnSpecialListusersQueryInfo(pager, query)
+	})
+}
+
+/**
+ * Add log relations to the current log
+ */
+type SpecialLogAddLogSearchRelationsHook interface {
+	/**
+	 * @param mixed $type String of the log type
+	 * @param mixed $request WebRequest object for getting the value provided by the current user
+	 * @param mixed &$qc Array for query conditions to add
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialLogAddLogSearchRelations(typeParam interface{}, request interface{}, qc *interface{}) bool
+}
+
+/**
+ * Run the SpecialLogAddLogSearchRelations hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialLogAddLogSearchRelations(typeParam interface{}, request interface{}, qc *interface{}) bool {
+	args := []interface{}{typeParam, request, qc}
+	return r.container.run("SpecialLogAddLogSearchRelations", args, func(handler interface{}) bool {
+		return handler.(SpecialLogAddLogSearchRelationsHook).OnSpecialLogAddLogSearchRelations(typeParam, request, qc)
+	})
+}
+
+/**
+ * Called after moving a page.
+ */
+type SpecialMovepageAfterMoveHook interface {
+	/**
+	 * @param mixed &$movePage MovePageForm object
+	 * @param mixed &$oldTitle old title (object)
+	 * @param mixed &$newTitle new title (object)
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialMovepageAfterMove(movePage *interface{}, oldTitle *interface{}, newTitle *interface{}) bool
+}
+
+/**
+ * Run the SpecialMovepageAfterMove hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialMovepageAfterMove(movePage *interface{}, oldTitle *interface{}, newTitle *interface{}) bool {
+	args := []interface{}{movePage, oldTitle, newTitle}
+	return r.container.run("SpecialMovepageAfterMove", args, func(handler interface{}) bool {
+		return handler.(SpecialMovepageAfterMoveHook).OnSpecialMovepageAfterMove(movePage, oldTitle, newTitle)
+	})
+}
+
+/**
+ * Called after building form options at NewPages.
+ */
+type SpecialNewPagesFiltersHook interface {
+	/**
+	 * @param mixed $special the special page object
+	 * @param mixed &$filters associative array of filter definitions. The keys are the HTML name/URL parameters. Each key maps to an associative array with a 'msg' (message key) and a 'default' value.
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialNewPagesFilters(special interface{}, filters *interface{}) bool
+}
+
+/**
+ * Run the SpecialNewPagesFilters hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialNewPagesFilters(special interface{}, filters *interface{}) bool {
+	args := []interface{}{special, filters}
+	return r.container.run("SpecialNewPagesFilters", args, func(handler interface{}) bool {
+		return handler.(SpecialNewPagesFiltersHook).OnSpecialNewPagesFilters(special, filters)
+	})
+}
+
+/**
+ * Called when building sql query for
+ * Special:NewPages.
+ */
+type SpecialNewpagesConditionsHook interface {
+	/**
+	 * @param mixed &$special NewPagesPager object (subclass of ReverseChronologicalPager)
+	 * @param mixed $opts FormOptions object containing special page options
+	 * @param mixed &$conds array of WHERE conditionals for query
+	 * @param mixed &$tables array of tables to be queried
+	 * @param mixed &$fields array of columns to select
+	 * @param mixed &$join_conds join conditions for the tables
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialNewpagesConditions(special *interface{}, opts interface{}, conds *interface{}, tables *interface{}, fields *interface{}, join_conds *interface{}) bool
+}
+
+/**
+ * Run the SpecialNewpagesConditions hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialNewpagesConditions(special *interface{}, opts interface{}, conds *interface{}, tables *interface{}, fields *interface{}, join_conds *interface{}) bool {
+	args := []interface{}{special, opts, conds, tables, fields, join_conds}
+	return r.container.run("SpecialNewpagesConditions", args, func(handler interface{}) bool {
+		return handler.(SpecialNewpagesConditionsHook).OnSpecialNewpagesConditions(special, opts, conds, tables, fields, join_conds)
+	})
+}
+
+/**
+ * Called after SpecialPage::execute.
+ */
+type SpecialPageAfterExecuteHook interface {
+	/**
+	 * @param mixed $special the SpecialPage object
+	 * @param mixed $subPage the subpage string or null if no subpage was specified
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialPageAfterExecute(special interface{}, subPage interface{}) bool
+}
+
+/**
+ * Run the SpecialPageAfterExecute hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialPageAfterExecute(special interface{}, subPage interface{}) bool {
+	args := []interface{}{special, subPage}
+	return r.container.run("SpecialPageAfterExecute", args, func(handler interface{}) bool {
+		return handler.(SpecialPageAfterExecuteHook).OnSpecialPageAfterExecute(special, subPage)
+	})
+}
+
+/**
+ * Called before SpecialPage::execute.
+ * Return false to prevent execution.
+ */
+type SpecialPageBeforeExecuteHook interface {
+	/**
+	 * @param mixed $special the SpecialPage object
+	 * @param mixed $subPage the subpage string or null if no subpage was specified
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialPageBeforeExecute(special interface{}, subPage interface{}) bool
+}
+
+/**
+ * Run the SpecialPageBeforeExecute hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialPageBeforeExecute(special interface{}, subPage interface{}) bool {
+	args := []interface{}{special, subPage}
+	return r.container.run("SpecialPageBeforeExecute", args, func(handler interface{}) bool {
+		return handler.(SpecialPageBeforeExecuteHook).OnSpecialPageBeforeExecute(special, subPage)
+	})
+}
+
+/**
+ * Before executing the HTMLForm object.
+ */
+type SpecialPageBeforeFormDisplayHook interface {
+	/**
+	 * @param mixed $name name of the special page
+	 * @param mixed &$form HTMLForm object
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialPageBeforeFormDisplay(name interface{}, form *interface{}) bool
+}
+
+/**
+ * Run the SpecialPageBeforeFormDisplay hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialPageBeforeFormDisplay(name interface{}, form *interface{}) bool {
+	args := []interface{}{name, form}
+	return r.container.run("SpecialPageBeforeFormDisplay", args, func(handler interface{}) bool {
+		return handler.(SpecialPageBeforeFormDisplayHook).OnSpecialPageBeforeFormDisplay(name, form)
+	})
+}
+
+/**
+ * Called when setting up SpecialPageFactory::$list, use
+ * this hook to remove a core special page or conditionally register special pages.
+ */
+type SpecialPage_initListHook interface {
+	/**
+	 * @param mixed &$list list (array) of core special pages
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialPage_initList(list *interface{}) bool
+}
+
+/**
+ * Run the SpecialPage_initList hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialPage_initList(list *interface{}) bool {
+	args := []interface{}{list}
+	return r.container.run("SpecialPage_initList", args, func(handler interface{}) bool {
+		return handler.(SpecialPage_initListHook).OnSpecialPage_initList(list)
+	})
+}
+
+/**
+ * When executing a form submission on
+ * Special:PasswordReset.
+ */
+type SpecialPasswordResetOnSubmitHook interface {
+	/**
+	 * @param mixed &$users array of User objects.
+	 * @param mixed $data array of data submitted by the user
+	 * @param mixed &$error string, error code (message key) used to describe to error (out parameter). The hook needs to return false when setting this, otherwise it will have no effect.
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialPasswordResetOnSubmit(users *interface{}, data interface{}, error *interface{}) bool
+}
+
+/**
+ * Run the SpecialPasswordResetOnSubmit hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialPasswordResetOnSubmit(users *interface{}, data interface{}, error *interface{}) bool {
+	args := []interface{}{users, data, error}
+	return r.container.run("SpecialPasswordResetOnSubmit", args, func(handler interface{}) bool {
+		return handler.(SpecialPasswordResetOnSubmitHook).OnSpecialPasswordResetOnSubmit(users, data, error)
+	})
+}
+
+/**
+ * Called during the execution of Special:Random,
+ * use this to change some selection criteria or substitute a different title.
+ */
+type SpecialRandomGetRandomTitleHook interface {
+	/**
+	 * @param mixed &$randstr The random number from wfRandom()
+	 * @param mixed &$isRedir Boolean, whether to select a redirect or non-redirect
+	 * @param mixed &$namespaces An array of namespace indexes to get the title from
+	 * @param mixed &$extra An array of extra SQL statements
+	 * @param mixed &$title If the hook returns false, a Title object to use instead of the result from the normal query
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialRandomGetRandomTitle(randstr *interface{}, isRedir *interface{}, namespaces *interface{}, extra *interface{}, title *interface{}) bool
+}
+
+/**
+ * Run the SpecialRandomGetRandomTitle hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialRandomGetRandomTitle(randstr *interface{}, isRedir *interface{}, namespaces *interface{}, extra *interface{}, title *interface{}) bool {
+	args := []interface{}{randstr, isRedir, namespaces, extra, title}
+	return r.container.run("SpecialRandomGetRandomTitle", args, func(handler interface{}) bool {
+		return handler.(SpecialRandomGetRandomTitleHook).OnSpecialRandomGetRandomTitle(randstr, isRedir, namespaces, extra, title)
+	})
+}
+
+/**
+ * Called when building form options in
+ * SpecialRecentChanges.
+ */
+type SpecialRecentChangesPanelHook interface {
+	/**
+	 * @param mixed &$extraOpts array of added items, to which can be added
+	 * @param mixed $opts FormOptions for this request
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialRecentChangesPanel(extraOpts *interface{}, opts interface{}) bool
+}
+
+/**
+ * Run the SpecialRecentChangesPanel hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialRecentChangesPanel(extraOpts *interface{}, opts interface{}) bool {
+	args := []interface{}{extraOpts, opts}
+	return r.container.run("SpecialRecentChangesPanel", args, func(handler interface{}) bool {
+		return handler.(SpecialRecentChangesPanelHook).OnSpecialRecentChangesPanel(extraOpts, opts)
+	})
+}
+
+/**
+ * Called when building token list for
+ * SpecialResetTokens.
+ */
+type SpecialResetTokensTokensHook interface {
+	/**
+	 * @param mixed &$tokens array of token information arrays in the format of [ 'preference' => '<preference-name>', 'label-message' => '<message-key>', ]
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialResetTokensTokens(tokens *interface{}) bool
+}
+
+/**
+ * Run the SpecialResetTokensTokens hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialResetTokensTokens(tokens *interface{}) bool {
+	args := []interface{}{tokens}
+	return r.container.run("SpecialResetTokensTokens", args, func(handler interface{}) bool {
+		return handler.(SpecialResetTokensTokensHook).OnSpecialResetTokensTokens(tokens)
+	})
+}
+
+/**
+ * Called when making the message to create a page or
+ * go to the existing page.
+ */
+type SpecialSearchCreateLinkHook interface {
+	/**
+	 * @param mixed $t title object searched for
+	 * @param mixed &$params an array of the default message name and page title (as parameter)
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialSearchCreateLink(t interface{}, params *interface{}) bool
+}
+
+/**
+ * Run the SpecialSearchCreateLink hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialSearchCreateLink(t interface{}, params *interface{}) bool {
+	args := []interface{}{t, params}
+	return r.container.run("SpecialSearchCreateLink", args, func(handler interface{}) bool {
+		return handler.(SpecialSearchCreateLinkHook).OnSpecialSearchCreateLink(t, params)
+	})
+}
+
+/**
+ * If a hook returns false the 'go' feature will be
+ * canceled and a normal search will be performed. Returning true without setting
+ * $url does a standard redirect to $title. Setting $url redirects to the
+ * specified URL.
+ */
+type SpecialSearchGoResultHook interface {
+	/**
+	 * @param mixed $term The string the user searched for
+	 * @param mixed $title The title the 'go' feature has decided to forward the user to
+	 * @param mixed &$url Initially null, hook subscribers can set this to specify the final url to redirect to
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialSearchGoResult(term interface{}, title interface{}, url *interface{}) bool
+}
+
+/**
+ * Run the SpecialSearchGoResult hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialSearchGoResult(term interface{}, title interface{}, url *interface{}) bool {
+	args := []interface{}{term, title, url}
+	return r.container.run("SpecialSearchGoResult", args, func(handler interface{}) bool {
+		return handler.(SpecialSearchGoResultHook).OnSpecialSearchGoResult(term, title, url)
+	})
+}
+
+/**
+ * Called when the 'Go' feature is triggered (generally
+ * from autocomplete search other than the main bar on Special:Search) and the
+ * target doesn't exist. Full text search results are generated after this hook is
+ * called.
+ */
+type SpecialSearchNogomatchHook interface {
+	/**
+	 * @param mixed &$title title object generated from the text entered by the user
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialSearchNogomatch(title *interface{}) bool
+}
+
+/**
+ * Run the SpecialSearchNogomatch hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialSearchNogomatch(title *interface{}) bool {
+	args := []interface{}{title}
+	return r.container.run("SpecialSearchNogomatch", args, func(handler interface{}) bool {
+		return handler.(SpecialSearchNogomatchHook).OnSpecialSearchNogomatch(title)
+	})
+}
+
+/**
+ * The equivalent of SpecialSearchProfileForm for
+ * the advanced form, a.k.a. power search box.
+ */
+type SpecialSearchPowerBoxHook interface {
+	/**
+	 * @param mixed &$showSections an array to add values with more options to
+	 * @param mixed $term the search term (not a title object)
+	 * @param mixed &$opts an array of hidden options (containing 'redirs' and 'profile')
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialSearchPowerBox(showSections *interface{}, term interface{}, opts *interface{}) bool
+}
+
+/**
+ * Run the SpecialSearchPowerBox hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialSearchPowerBox(showSections *interface{}, term interface{}, opts *interface{}) bool {
+	args := []interface{}{showSections, term, opts}
+	return r.container.run("SpecialSearchPowerBox", args, func(handler interface{}) bool {
+		return handler.(SpecialSearchPowerBoxHook).OnSpecialSearchPowerBox(showSections, term, opts)
+	})
+}
+
+/**
+ * Allows modification of search profile forms.
+ */
+type SpecialSearchProfileFormHook interface {
+	/**
+	 * @param mixed $search special page object
+	 * @param mixed &$form String: form html
+	 * @param mixed $profile String: current search profile
+	 * @param mixed $term String: search term
+	 * @param mixed $opts Array: key => value of hidden options for inclusion in custom forms
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialSearchProfileForm(search interface{}, form *interface{}, profile interface{}, term interface{}, opts interface{}) bool
+}
+
+/**
+ * Run the SpecialSearchProfileForm hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialSearchProfileForm(search interface{}, form *interface{}, profile interface{}, term interface{}, opts interface{}) bool {
+	args := []interface{}{search, form, profile, term, opts}
+	return r.container.run("SpecialSearchProfileForm", args, func(handler interface{}) bool {
+		return handler.(SpecialSearchProfileFormHook).OnSpecialSearchProfileForm(search, form, profile, term, opts)
+	})
+}
+
+/**
+ * Allows modification of search profiles.
+ */
+type SpecialSearchProfilesHook interface {
+	/**
+	 * @param mixed &$profiles profiles, which can be modified.
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialSearchProfiles(profiles *interface{}) bool
+}
+
+/**
+ * Run the SpecialSearchProfiles hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialSearchProfiles(profiles *interface{}) bool {
+	args := []interface{}{profiles}
+	return r.container.run("SpecialSearchProfiles", args, func(handler interface{}) bool {
+		return handler.(SpecialSearchProfilesHook).OnSpecialSearchProfiles(profiles)
+	})
+}
+
+/**
+ * Called before search result display
+ */
+type SpecialSearchResultsHook interface {
+	/**
+	 * @param mixed $term string of search term
+	 * @param mixed &$titleMatches empty or SearchResultSet object
+	 * @param mixed &$textMatches empty or SearchResultSet object
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialSearchResults(term interface{}, titleMatches *interface{}, textMatches *interface{}) bool
+}
+
+/**
+ * Run the SpecialSearchResults hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialSearchResults(term interface{}, titleMatches *interface{}, textMatches *interface{}) bool {
+	args := []interface{}{term, titleMatches, textMatches}
+	return r.container.run("SpecialSearchResults", args, func(handler interface{}) bool {
+		return handler.(SpecialSearchResultsHook).OnSpecialSearchResults(term, titleMatches, textMatches)
+	})
+}
+
+/**
+ * Called immediately before returning HTML
+ * on the search results page.  Useful for including a feedback link.
+ */
+type SpecialSearchResultsAppendHook interface {
+	/**
+	 * @param mixed $specialSearch SpecialSearch object ($this)
+	 * @param mixed $output $wgOut
+	 * @param mixed $term Search term specified by the user
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialSearchResultsAppend(specialSearch interface{}, output interface{}, term interface{}) bool
+}
+
+/**
+ * Run the SpecialSearchResultsAppend hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialSearchResultsAppend(specialSearch interface{}, output interface{}, term interface{}) bool {
+	args := []interface{}{specialSearch, output, term}
+	return r.container.run("SpecialSearchResultsAppend", args, func(handler interface{}) bool {
+		return handler.(SpecialSearchResultsAppendHook).OnSpecialSearchResultsAppend(specialSearch, output, term)
+	})
+}
+
+/**
+ * Called immediately before returning HTML
+ * on the search results page.  Useful for including an external search
+ * provider.  To disable the output of MediaWiki search output, return
+ * false.
+ */
+type SpecialSearchResultsPrependHook interface {
+	/**
+	 * @param mixed $specialSearch SpecialSearch object ($this)
+	 * @param mixed $output $wgOut
+	 * @param mixed $term Search term specified by the user
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialSearchResultsPrepend(specialSearch interface{}, output interface{}, term interface{}) bool
+}
+
+/**
+ * Run the SpecialSearchResultsPrepend hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialSearchResultsPrepend(specialSearch interface{}, output interface{}, term interface{}) bool {
+	args := []interface{}{specialSearch, output, term}
+	return r.container.run("SpecialSearchResultsPrepend", args, func(handler interface{}) bool {
+		return handler.(SpecialSearchResultsPrependHook).OnSpecialSearchResultsPrepend(specialSearch, output, term)
+	})
+}
+
+/**
+ * Allows passing custom data to search engine.
+ */
+type SpecialSearchSetupEngineHook interface {
+	/**
+	 * @param mixed $search SpecialSearch special page object
+	 * @param mixed $profile String: current search profile
+	 * @param mixed $engine the search engine
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialSearchSetupEngine(search interface{}, profile interface{}, engine interface{}) bool
+}
+
+/**
+ * Run the SpecialSearchSetupEngine hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialSearchSetupEngine(search interface{}, profile interface{}, engine interface{}) bool {
+	args := []interface{}{search, profile, engine}
+	return r.container.run("SpecialSearchSetupEngine", args, func(handler interface{}) bool {
+		return handler.(SpecialSearchSetupEngineHook).OnSpecialSearchSetupEngine(search, profile, engine)
+	})
+}
+
+/**
+ * Add extra statistic at the end of Special:Statistics.
+ */
+type SpecialStatsAddExtraHook interface {
+	/**
+	 * @param mixed &$extraStats Array to save the new stats $extraStats['<name of statistic>'] => <value>; <value> can be an array with the keys "name" and "number": "name" is the HTML to be displayed in the name column "number" is the number to be displayed. or, <value> can be the number to be displayed and <name> is the message key to use in the name column,
+	 * @param mixed $context IContextSource object
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialStatsAddExtra(extraStats *interface{}, context interface{}) bool
+}
+
+/**
+ * Run the SpecialStatsAddExtra hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialStatsAddExtra(extraStats *interface{}, context interface{}) bool {
+	args := []interface{}{extraStats, context}
+	return r.container.run("SpecialStatsAddExtra", args, func(handler interface{}) bool {
+		return handler.(SpecialStatsAddExtraHook).OnSpecialStatsAddExtra(extraStats, context)
+	})
+}
+
+/**
+ * Called for each cat link on
+ * Special:TrackingCategories
+ */
+type SpecialTrackingCategories__generateCatLinkHook interface {
+	/**
+	 * @param mixed $specialPage The SpecialTrackingCategories object
+	 * @param mixed $catTitle The Title object of the linked category
+	 * @param mixed &$html The Result html
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialTrackingCategories__generateCatLink(specialPage interface{}, catTitle interface{}, html *interface{}) bool
+}
+
+/**
+ * Run the SpecialTrackingCategories::generateCatLink hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialTrackingCategories__generateCatLink(specialPage interface{}, catTitle interface{}, html *interface{}) bool {
+	args := []interface{}{specialPage, catTitle, html}
+	return r.container.run("SpecialTrackingCategories::generateCatLink", args, func(handler interface{}) bool {
+		return handler.(SpecialTrackingCategories__generateCatLinkHook).OnSpecialTrackingCategories__generateCatLink(specialPage, catTitle, html)
+	})
+}
+
+/**
+ * Called after LinkBatch on
+ * Special:TrackingCategories
+ */
+type SpecialTrackingCategories__preprocessHook interface {
+	/**
+	 * @param mixed $specialPage The SpecialTrackingCategories object
+	 * @param mixed $trackingCategories Array of data from Special:TrackingCategories with msg and cats
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialTrackingCategories__preprocess(specialPage interface{}, trackingCategories interface{}) bool
+}
+
+/**
+ * Run the SpecialTrackingCategories::preprocess hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialTrackingCategories__preprocess(specialPage interface{}, trackingCategories interface{}) bool {
+	args := []interface{}{specialPage, trackingCategories}
+	return r.container.run("SpecialTrackingCategories::preprocess", args, func(handler interface{}) bool {
+		return handler.(SpecialTrackingCategories__preprocessHook).OnSpecialTrackingCategories__preprocess(specialPage, trackingCategories)
+	})
+}
+
+/**
+ * Called after successfully uploading a file from
+ * Special:Upload.
+ */
+type SpecialUploadCompleteHook interface {
+	/**
+	 * @param mixed &$form The SpecialUpload object
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialUploadComplete(form *interface{}) bool
+}
+
+/**
+ * Run the SpecialUploadComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialUploadComplete(form *interface{}) bool {
+	args := []interface{}{form}
+	return r.container.run("SpecialUploadComplete", args, func(handler interface{}) bool {
+		return handler.(SpecialUploadCompleteHook).OnSpecialUploadComplete(form)
+	})
+}
+
+/**
+ * Called when building the URL for Special:Version.
+ */
+type SpecialVersionVersionUrlHook interface {
+	/**
+	 * @param mixed $wgVersion Current $wgVersion for you to use
+	 * @param mixed &$versionUrl Raw url to link to (eg: release notes)
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialVersionVersionUrl(wgVersion interface{}, versionUrl *interface{}) bool
+}
+
+/**
+ * Run the SpecialVersionVersionUrl hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialVersionVersionUrl(wgVersion interface{}, versionUrl *interface{}) bool {
+	args := []interface{}{wgVersion, versionUrl}
+	return r.container.run("SpecialVersionVersionUrl", args, func(handler interface{}) bool {
+		return handler.(SpecialVersionVersionUrlHook).OnSpecialVersionVersionUrl(wgVersion, versionUrl)
+	})
+}
+
+/**
+ * Called when building sql query for
+ * SpecialWatchlist. Allows extensions to register custom values they have
+ * inserted to rc_type so they can be returned as part of the watchlist.
+ */
+type SpecialWatchlistGetNonRevisionTypesHook interface {
+	/**
+	 * @param mixed &$nonRevisionTypes array of values in the rc_type field of recentchanges table
+	 * @return bool False to abort the hook
+	 */
+	OnSpecialWatchlistGetNonRevisionTypes(nonRevisionTypes *interface{}) bool
+}
+
+/**
+ * Run the SpecialWatchlistGetNonRevisionTypes hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnSpecialWatchlistGetNonRevisionTypes(nonRevisionTypes *interface{}) bool {
+	args := []interface{}{nonRevisionTypes}
+	return r.container.run("SpecialWatchlistGetNonRevisionTypes", args, func(handler interface{}) bool {
+		return handler.(SpecialWatchlistGetNonRevisionTypesHook).OnSpecialWatchlistGetNonRevisionTypes(nonRevisionTypes)
+	})
+}
+
+/**
+ * Called when about to force a redirect to a canonical
+ * URL for a title when we have no other parameters on the URL. Gives a chance for
+ * extensions that alter page view behavior radically to abort that redirect or
+ * handle it manually.
+ */
+type TestCanonicalRedirectHook interface {
+	/**
+	 * @param WebRequest $request WebRequest
+	 * @param Title $title Title of the currently found title obj
+	 * @param OutputPage $output OutputPage object
+	 * @return bool False to abort the hook
+	 */
+	OnTestCanonicalRedirect(request *WebRequest, title *Title, output *OutputPage) bool
+}
+
+/**
+ * Run the TestCanonicalRedirect hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnTestCanonicalRedirect(request *WebRequest, title *Title, output *OutputPage) bool {
+	args := []interface{}{request, title, output}
+	return r.container.run("TestCanonicalRedirect", args, func(handler interface{}) bool {
+		return handler.(TestCanonicalRedirectHook).OnTestCanonicalRedirect(request, title, output)
+	})
+}
+
+/**
+ * Called before an image HTML is about to be
+ * rendered (by ThumbnailImage:toHtml method).
+ */
+type ThumbnailBeforeProduceHTMLHook interface {
+	/**
+	 * @param mixed $thumbnail the ThumbnailImage object
+	 * @param mixed &$attribs image attribute array
+	 * @param mixed &$linkAttribs image link attribute array
+	 * @return bool False to abort the hook
+	 */
+	OnThumbnailBeforeProduceHTML(thumbnail interface{}, attribs *interface{}, linkAttribs *interface{}) bool
+}
+
+/**
+ * Run the ThumbnailBeforeProduceHTML hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnThumbnailBeforeProduceHTML(thumbnail interface{}, attribs *interface{}, linkAttribs *interface{}) bool {
+	args := []interface{}{thumbnail, attribs, linkAttribs}
+	return r.container.run("ThumbnailBeforeProduceHTML", args, func(handler interface{}) bool {
+		return handler.(ThumbnailBeforeProduceHTMLHook).OnThumbnailBeforeProduceHTML(thumbnail, attribs, linkAttribs)
+	})
+}
+
+/**
+ * Called when creating an TitleArray object from a
+ * database result.
+ */
+type TitleArrayFromResultHook interface {
+	/**
+	 * @param mixed &$titleArray set this to an object to override the default object returned
+	 * @param mixed $res database result used to create the object
+	 * @return bool False to abort the hook
+	 */
+	OnTitleArrayFromResult(titleArray *interface{}, res interface{}) bool
+}
+
+/**
+ * Run the TitleArrayFromResult hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnTitleArrayFromResult(titleArray *interface{}, res interface{}) bool {
+	args := []interface{}{titleArray, res}
+	return r.container.run("TitleArrayFromResult", args, func(handler interface{}) bool {
+		return handler.(TitleArrayFromResultHook).OnTitleArrayFromResult(titleArray, res)
+	})
+}
+
+/**
+ * Called when determining whether a page exists at a given title.
+ */
+type TitleExistsHook interface {
+	/**
+	 * @param Title $title The title being tested.
+	 * @param bool &$exists Whether the title exists.
+	 * @return bool False to abort the hook
+	 */
+	OnTitleExists(title *Title, exists *bool) bool
+}
+
+/**
+ * Run the TitleExists hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnTitleExists(title *Title, exists *bool) bool {
+	args := []interface{}{title, exists}
+	return r.container.run("TitleExists", args, func(handler interface{}) bool {
+		return handler.(TitleExistsHook).OnTitleExists(title, exists)
+	})
+}
+
+/**
+ * Allows extensions to add edit notices
+ */
+type TitleGetEditNoticesHook interface {
+	/**
+	 * @param mixed $title The Title object for the page the edit notices are for
+	 * @param mixed $oldid Revision ID that the edit notices are for (or 0 for latest)
+	 * @param mixed &$notices Array of notices. Keys are i18n message keys, values are parseAsBlock()ed messages.
+	 * @return bool False to abort the hook
+	 */
+	OnTitleGetEditNotices(title interface{}, oldid interface{}, notices *interface{}) bool
+}
+
+/**
+ * Run the TitleGetEditNotices hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnTitleGetEditNotices(title interface{}, oldid interface{}, notices *interface{}) bool {
+	args := []interface{}{title, oldid, notices}
+	return r.container.run("TitleGetEditNotices", args, func(handler interface{}) bool {
+		return handler.(TitleGetEditNoticesHook).OnTitleGetEditNotices(title, oldid, notices)
+	})
+}
+
+/**
+ * Allows extensions to modify the types of protection
+ * that can be applied.
+ */
+type TitleGetRestrictionTypesHook interface {
+	/**
+	 * @param mixed $title The title in question.
+	 * @param mixed &$types The types of protection available.
+	 * @return bool False to abort the hook
+	 */
+	OnTitleGetRestrictionTypes(title interface{}, types *interface{}) bool
+}
+
+/**
+ * Run the TitleGetRestrictionTypes hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnTitleGetRestrictionTypes(title interface{}, types *interface{}) bool {
+	args := []interface{}{title, types}
+	return r.container.run("TitleGetRestrictionTypes", args, func(handler interface{}) bool {
+		return handler.(TitleGetRestrictionTypesHook).OnTitleGetRestrictionTypes(title, types)
+	})
+}
+
+/**
+ * Called when determining if a page exists. Allows
+ * overriding default behavior for determining if a page exists. If $isKnown is
+ * kept as null, regular checks happen. If it's a boolean, this value is returned
+ * by the isKnown method.
+ */
+type TitleIsAlwaysKnownHook interface {
+	/**
+	 * @param mixed $title Title object that is being checked
+	 * @param mixed &$isKnown Boolean|null; whether MediaWiki currently thinks this page is known
+	 * @return bool False to abort the hook
+	 */
+	OnTitleIsAlwaysKnown(title interface{}, isKnown *interface{}) bool
+}
+
+/**
+ * Run the TitleIsAlwaysKnown hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnTitleIsAlwaysKnown(title interface{}, isKnown *interface{}) bool {
+	args := []interface{}{title, isKnown}
+	return r.container.run("TitleIsAlwaysKnown", args, func(handler interface{}) bool {
+		return handler.(TitleIsAlwaysKnownHook).OnTitleIsAlwaysKnown(title, isKnown)
+	})
+}
+
+/**
+ * Called when determining if it is possible to move a page. Note
+ * that this hook is not called for interwiki pages or pages in immovable
+ * namespaces: for these, isMovable() always returns false.
+ */
+type TitleIsMovableHook interface {
+	/**
+	 * @param mixed $title Title object that is being checked
+	 * @param mixed &$result Boolean; whether MediaWiki currently thinks this page is movable. Hooks may change this value to override the return value of Title::isMovable().
+	 * @return bool False to abort the hook
+	 */
+	OnTitleIsMovable(title interface{}, result *interface{}) bool
+}
+
+/**
+ * Run the TitleIsMovable hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnTitleIsMovable(title interface{}, result *interface{}) bool {
+	args := []interface{}{title, result}
+	return r.container.run("TitleIsMovable", args, func(handler interface{}) bool {
+		return handler.(TitleIsMovableHook).OnTitleIsMovable(title, result)
+	})
+}
+
+/**
+ * Before moving an article (title).
+ */
+type TitleMoveHook interface {
+	/**
+	 * @param mixed $old old title
+	 * @param mixed $nt new title
+	 * @param mixed $user user who does the move
+	 * @return bool False to abort the hook
+	 */
+	OnTitleMove(old interface{}, nt interface{}, user interface{}) bool
+}
+
+/**
+ * Run the TitleMove hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnTitleMove(old interface{}, nt interface{}, user interface{}) bool {
+	args := []interface{}{old, nt, user}
+	return r.container.run("TitleMove", args, func(handler interface{}) bool {
+		return handler.(TitleMoveHook).OnTitleMove(old, nt, user)
+	})
+}
+
+/**
+ * After moving an article (title), post-commit.
+ */
+type TitleMoveCompleteHook interface {
+	/**
+	 * @param mixed &$old old title
+	 * @param mixed &$nt new title
+	 * @param mixed &$user user who did the move
+	 * @param mixed $pageid database ID of the page that's been moved
+	 * @param mixed $redirid database ID of the created redirect
+	 * @param mixed $reason reason for the move
+	 * @param mixed $revision the Revision created by the move
+	 * @return bool False to abort the hook
+	 */
+	OnTitleMoveComplete(old *interface{}, nt *interface{}, user *interface{}, pageid interface{}, redirid interface{}, reason interface{}, revision interface{}) bool
+}
+
+/**
+ * Run the TitleMoveComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnTitleMoveComplete(old *interface{}, nt *interface{}, user *interface{}, pageid interface{}, redirid interface{}, reason interface{}, revision interface{}) bool {
+	args := []interface{}{old, nt, user, pageid, redirid, reason, revision}
+	return r.container.run("TitleMoveComplete", args, func(handler interface{}) bool {
+		return handler.(TitleMoveCompleteHook).OnTitleMoveComplete(old, nt, user, pageid, redirid, reason, revision)
+	})
+}
+
+/**
+ * After moving an article (title), pre-commit.
+ */
+type TitleMoveCompletingHook interface {
+	/**
+	 * @param mixed $old old title
+	 * @param mixed $nt new title
+	 * @param mixed $user user who did the move
+	 * @param mixed $pageid database ID of the page that's been moved
+	 * @param mixed $redirid database ID of the created redirect
+	 * @param mixed $reason reason for the move
+	 * @param mixed $revision the Revision created by the move
+	 * @return bool False to abort the hook
+	 */
+	OnTitleMoveCompleting(old interface{}, nt interface{}, user interface{}, pageid interface{}, redirid interface{}, reason interface{}, revision interface{}) bool
+}
+
+/**
+ * Run the TitleMoveCompleting hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnTitleMoveCompleting(old interface{}, nt interface{}, user interface{}, pageid interface{}, redirid interface{}, reason interface{}, revision interface{}) bool {
+	args := []interface{}{old, nt, user, pageid, redirid, reason, revision}
+	return r.container.run("TitleMoveCompleting", args, func(handler interface{}) bool {
+		return handler.(TitleMoveCompletingHook).OnTitleMoveCompleting(old, nt, user, pageid, redirid, reason, revision)
+	})
+}
+
+/**
+ * Before moving an article (title), but just after the atomic
+ * DB section starts.
+ */
+type TitleMoveStartingHook interface {
+	/**
+	 * @param mixed $old old title
+	 * @param mixed $nt new title
+	 * @param mixed $user user who does the move
+	 * @return bool False to abort the hook
+	 */
+	OnTitleMoveStarting(old interface{}, nt interface{}, user interface{}) bool
+}
+
+/**
+ * Run the TitleMoveStarting hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnTitleMoveStarting(old interface{}, nt interface{}, user interface{}) bool {
+	args := []interface{}{old, nt, user}
+	return r.container.run("TitleMoveStarting", args, func(handler interface{}) bool {
+		return handler.(TitleMoveStartingHook).OnTitleMoveStarting(old, nt, user)
+	})
+}
+
+/**
+ * Called from Title::checkQuickPermissions to add to
+ * or override the quick permissions check.
+ */
+type TitleQuickPermissionsHook interface {
+	/**
+	 * @param mixed $title The Title object being accessed
+	 * @param mixed $user The User performing the action
+	 * @param mixed $action Action being performed
+	 * @param mixed &$errors Array of errors
+	 * @param mixed $doExpensiveQueries Whether to do expensive DB queries
+	 * @param mixed $short Whether to return immediately on first error
+	 * @return bool False to abort the hook
+	 */
+	OnTitleQuickPermissions(title interface{}, user interface{}, action interface{}, errors *interface{}, doExpensiveQueries interface{}, short interface{}) bool
+}
+
+/**
+ * Run the TitleQuickPermissions hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnTitleQuickPermissions(title interface{}, user interface{}, action interface{}, errors *interface{}, doExpensiveQueries interface{}, short interface{}) bool {
+	args := []interface{}{title, user, action, errors, doExpensiveQueries, short}
+	return r.container.run("TitleQuickPermissions", args, func(handler interface{}) bool {
+		return handler.(TitleQuickPermissionsHook).OnTitleQuickPermissions(title, user, action, errors, doExpensiveQueries, short)
+	})
+}
+
+/**
+ * Called at the end of read permissions checks, just before
+ * adding the default error message if nothing allows the user to read the page. If
+ * a handler wants a title to *not* be whitelisted, it should also return false.
+ */
+type TitleReadWhitelistHook interface {
+	/**
+	 * @param mixed $title Title object being checked against
+	 * @param mixed $user Current user object
+	 * @param mixed &$whitelisted Boolean value of whether this title is whitelisted
+	 * @return bool False to abort the hook
+	 */
+	OnTitleReadWhitelist(title interface{}, user interface{}, whitelisted *interface{}) bool
+}
+
+/**
+ * Run the TitleReadWhitelist hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnTitleReadWhitelist(title interface{}, user interface{}, whitelisted *interface{}) bool {
+	args := []interface{}{title, user, whitelisted}
+	return r.container.run("TitleReadWhitelist", args, func(handler interface{}) bool {
+		return handler.(TitleReadWhitelistHook).OnTitleReadWhitelist(title, user, whitelisted)
+	})
+}
+
+/**
+ * Called to determine which URLs to purge from HTTP caches.
+ */
+type TitleSquidURLsHook interface {
+	/**
+	 * @param mixed $title Title object to purge
+	 * @param mixed &$urls An array of URLs to purge from the caches, to be manipulated.
+	 * @return bool False to abort the hook
+	 */
+	OnTitleSquidURLs(title interface{}, urls *interface{}) bool
+}
+
+/**
+ * Run the TitleSquidURLs hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnTitleSquidURLs(title interface{}, urls *interface{}) bool {
+	args := []interface{}{title, urls}
+	return r.container.run("TitleSquidURLs", args, func(handler interface{}) bool {
+		return handler.(TitleSquidURLsHook).OnTitleSquidURLs(title, urls)
+	})
+}
+
+/**
+ * Before an IP address or user is unblocked.
+ */
+type UnblockUserHook interface {
+	/**
+	 * @param mixed &$block The Block object about to be saved
+	 * @param mixed &$user The user performing the unblock (not the one being unblocked)
+	 * @param mixed &$reason If the hook is aborted, the error message to be returned in an array
+	 * @return bool False to abort the hook
+	 */
+	OnUnblockUser(block *interface{}, user *interface{}, reason *interface{}) bool
+}
+
+/**
+ * Run the UnblockUser hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUnblockUser(block *interface{}, user *interface{}, reason *interface{}) bool {
+	args := []interface{}{block, user, reason}
+	return r.container.run("UnblockUser", args, func(handler interface{}) bool {
+		return handler.(UnblockUserHook).OnUnblockUser(block, user, reason)
+	})
+}
+
+/**
+ * After an IP address or user has been unblocked.
+ */
+type UnblockUserCompleteHook interface {
+	/**
+	 * @param mixed $block The Block object that was saved
+	 * @param mixed $user The user who performed the unblock (not the one being unblocked)
+	 * @return bool False to abort the hook
+	 */
+	OnUnblockUserComplete(block interface{}, user interface{}) bool
+}
+
+/**
+ * Run the UnblockUserComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUnblockUserComplete(block interface{}, user interface{}) bool {
+	args := []interface{}{block, user}
+	return r.container.run("UnblockUserComplete", args, func(handler interface{}) bool {
+		return handler.(UnblockUserCompleteHook).OnUnblockUserComplete(block, user)
+	})
+}
+
+/**
+ * Called in UndeleteForm::showHistory, after a
+ * PageArchive object has been created but before any further processing is done.
+ */
+type UndeleteForm__showHistoryHook interface {
+	/**
+	 * @param mixed &$archive PageArchive object
+	 * @param mixed $title Title object of the page that we're viewing
+	 * @return bool False to abort the hook
+	 */
+	OnUndeleteForm__showHistory(archive *interface{}, title interface{}) bool
+}
+
+/**
+ * Run the UndeleteForm::showHistory hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUndeleteForm__showHistory(archive *interface{}, title interface{}) bool {
+	args := []interface{}{archive, title}
+	return r.container.run("UndeleteForm::showHistory", args, func(handler interface{}) bool {
+		return handler.(UndeleteForm__showHistoryHook).OnUndeleteForm__showHistory(archive, title)
+	})
+}
+
+/**
+ * Called in UndeleteForm::showRevision, after a
+ * PageArchive object has been created but before any further processing is done.
+ */
+type UndeleteForm__showRevisionHook interface {
+	/**
+	 * @param mixed &$archive PageArchive object
+	 * @param mixed $title Title object of the page that we're viewing
+	 * @return bool False to abort the hook
+	 */
+	OnUndeleteForm__showRevision(archive *interface{}, title interface{}) bool
+}
+
+/**
+ * Run the UndeleteForm::showRevision hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUndeleteForm__showRevision(archive *interface{}, title interface{}) bool {
+	args := []interface{}{archive, title}
+	return r.container.run("UndeleteForm::showRevision", args, func(handler interface{}) bool {
+		return handler.(UndeleteForm__showRevisionHook).OnUndeleteForm__showRevision(archive, title)
+	})
+}
+
+/**
+ * Called in UndeleteForm::undelete, after checking that
+ * the site is not in read-only mode, that the Title object is not null and after
+ * a PageArchive object has been constructed but before performing any further
+ * processing.
+ */
+type UndeleteForm__undeleteHook interface {
+	/**
+	 * @param mixed &$archive PageArchive object
+	 * @param mixed $title Title object of the page that we're about to undelete
+	 * @return bool False to abort the hook
+	 */
+	OnUndeleteForm__undelete(archive *interface{}, title interface{}) bool
+}
+
+/**
+ * Run the UndeleteForm::undelete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUndeleteForm__undelete(archive *interface{}, title interface{}) bool {
+	args := []interface{}{archive, title}
+	return r.container.run("UndeleteForm::undelete", args, func(handler interface{}) bool {
+		return handler.(UndeleteForm__undeleteHook).OnUndeleteForm__undelete(archive, title)
+	})
+}
+
+/**
+ * Called when showing a revision in Special:Undelete.
+ */
+type UndeleteShowRevisionHook interface {
+	/**
+	 * @param mixed $title title object related to the revision
+	 * @param mixed $rev revision (object) that will be viewed
+	 * @return bool False to abort the hook
+	 */
+	OnUndeleteShowRevision(title interface{}, rev interface{}) bool
+}
+
+/**
+ * Run the UndeleteShowRevision hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUndeleteShowRevision(title interface{}, rev interface{}) bool {
+	args := []interface{}{title, rev}
+	return r.container.run("UndeleteShowRevision", args, func(handler interface{}) bool {
+		return handler.(UndeleteShowRevisionHook).OnUndeleteShowRevision(title, rev)
+	})
+}
+
+/**
+ * Called right after MediaWiki's test
+ * infrastructure has finished creating/duplicating core tables for unit tests.
+ */
+type UnitTestsAfterDatabaseSetupHook interface {
+	/**
+	 * @param mixed $database Database in question
+	 * @param mixed $prefix Table prefix to be used in unit tests
+	 * @return bool False to abort the hook
+	 */
+	OnUnitTestsAfterDatabaseSetup(database interface{}, prefix interface{}) bool
+}
+
+/**
+ * Run the UnitTestsAfterDatabaseSetup hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUnitTestsAfterDatabaseSetup(database interface{}, prefix interface{}) bool {
+	args := []interface{}{database, prefix}
+	return r.container.run("UnitTestsAfterDatabaseSetup", args, func(handler interface{}) bool {
+		return handler.(UnitTestsAfterDatabaseSetupHook).OnUnitTestsAfterDatabaseSetup(database, prefix)
+	})
+}
+
+/**
+ * Called right before MediaWiki tears down its
+ * database infrastructure used for unit tests.
+ */
+type UnitTestsBeforeDatabaseTeardownHook interface {
+	/**
+	 * @return bool False to abort the hook
+	 */
+	OnUnitTestsBeforeDatabaseTeardown() bool
+}
+
+/**
+ * Run the UnitTestsBeforeDatabaseTeardown hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUnitTestsBeforeDatabaseTeardown() bool {
+	args := []interface{}{}
+	return r.container.run("UnitTestsBeforeDatabaseTeardown", args, func(handler interface{}) bool {
+		return handler.(UnitTestsBeforeDatabaseTeardownHook).OnUnitTestsBeforeDatabaseTeardown()
+	})
+}
+
+/**
+ * Called when building a list of paths containing PHPUnit tests.
+ * Since 1.24: Paths pointing to a directory will be recursively scanned for
+ * test case files matching the suffix "Test.php".
+ */
+type UnitTestsListHook interface {
+	/**
+	 * @param mixed &$paths list of test cases and directories to search.
+	 * @return bool False to abort the hook
+	 */
+	OnUnitTestsList(paths *interface{}) bool
+}
+
+/**
+ * Run the UnitTestsList hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUnitTestsList(paths *interface{}) bool {
+	args := []interface{}{paths}
+	return r.container.run("UnitTestsList", args, func(handler interface{}) bool {
+		return handler.(UnitTestsListHook).OnUnitTestsList(paths)
+	})
+}
+
+/**
+ * Before a watch is removed from an article.
+ */
+type UnwatchArticleHook interface {
+	/**
+	 * @param mixed &$user user watching
+	 * @param mixed &$page WikiPage object to be removed
+	 * @param mixed &$status Status object to be returned if the hook returns false
+	 * @return bool False to abort the hook
+	 */
+	OnUnwatchArticle(user *interface{}, page *interface{}, status *interface{}) bool
+}
+
+/**
+ * Run the UnwatchArticle hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUnwatchArticle(user *interface{}, page *interface{}, status *interface{}) bool {
+	args := []interface{}{user, page, status}
+	return r.container.run("UnwatchArticle", args, func(handler interface{}) bool {
+		return handler.(UnwatchArticleHook).OnUnwatchArticle(user, page, status)
+	})
+}
+
+/**
+ * After a watch is removed from an article.
+ */
+type UnwatchArticleCompleteHook interface {
+	/**
+	 * @param mixed $user user that watched
+	 * @param mixed &$page WikiPage object that was watched
+	 * @return bool False to abort the hook
+	 */
+	OnUnwatchArticleComplete(user interface{}, page *interface{}) bool
+}
+
+/**
+ * Run the UnwatchArticleComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUnwatchArticleComplete(user interface{}, page *interface{}) bool {
+	args := []interface{}{user, page}
+	return r.container.run("UnwatchArticleComplete", args, func(handler interface{}) bool {
+		return handler.(UnwatchArticleCompleteHook).OnUnwatchArticleComplete(user, page)
+	})
+}
+
+/**
+ * Before notification email gets sent.
+ */
+type UpdateUserMailerFormattedPageStatusHook interface {
+	/**
+	 * @param mixed &$formattedPageStatus list of valid page states
+	 * @return bool False to abort the hook
+	 */
+	OnUpdateUserMailerFormattedPageStatus(formattedPageStatus *interface{}) bool
+}
+
+/**
+ * Run the UpdateUserMailerFormattedPageStatus hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUpdateUserMailerFormattedPageStatus(formattedPageStatus *interface{}) bool {
+	args := []interface{}{formattedPageStatus}
+	return r.container.run("UpdateUserMailerFormattedPageStatus", args, func(handler interface{}) bool {
+		return handler.(UpdateUserMailerFormattedPageStatusHook).OnUpdateUserMailerFormattedPageStatus(formattedPageStatus)
+	})
+}
+
+/**
+ * Upon completion of a file upload.
+ */
+type UploadCompleteHook interface {
+	/**
+	 * @param mixed &$uploadBase UploadBase (or subclass) object. File can be accessed by $uploadBase->getLocalFile().
+	 * @return bool False to abort the hook
+	 */
+	OnUploadComplete(uploadBase *interface{}) bool
+}
+
+/**
+ * Run the UploadComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUploadComplete(uploadBase *interface{}) bool {
+	args := []interface{}{uploadBase}
+	return r.container.run("UploadComplete", args, func(handler interface{}) bool {
+		return handler.(UploadCompleteHook).OnUploadComplete(uploadBase)
+	})
+}
+
+/**
+ * When UploadBase::createFromRequest has been called.
+ */
+type UploadCreateFromRequestHook interface {
+	/**
+	 * @param mixed $type (string) the requested upload type
+	 * @param mixed &$className the class name of the Upload instance to be created
+	 * @return bool False to abort the hook
+	 */
+	OnUploadCreateFromRequest(typeParam interface{}, className *interface{}) bool
+}
+
+/**
+ * Run the UploadCreateFromRequest hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUploadCreateFromRequest(typeParam interface{}, className *interface{}) bool {
+	args := []interface{}{typeParam, className}
+	return r.container.run("UploadCreateFromRequest", args, func(handler interface{}) bool {
+		return handler.(UploadCreateFromRequestHook).OnUploadCreateFromRequest(typeParam, className)
+	})
+}
+
+/**
+ * At the beginning of processUpload(). Lets you
+ * poke at member variables like $mUploadDescription before the file is saved.
+ * Do not use this hook to break upload processing.
+ * This will return the user to a blank form with no error message;
+ * use UploadVerifyUpload or UploadVerifyFile instead.
+ */
+type UploadForm_BeforeProcessingHook interface {
+	/**
+	 * @param mixed &$upload SpecialUpload object
+	 * @return bool False to abort the hook
+	 */
+	OnUploadForm_BeforeProcessing(upload *interface{}) bool
+}
+
+/**
+ * Run the UploadForm:BeforeProcessing hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUploadForm_BeforeProcessing(upload *interface{}) bool {
+	args := []interface{}{upload}
+	return r.container.run("UploadForm:BeforeProcessing", args, func(handler interface{}) bool {
+		return handler.(UploadForm_BeforeProcessingHook).OnUploadForm_BeforeProcessing(upload)
+	})
+}
+
+/**
+ * After the initial page text for file uploads
+ * is generated, to allow it to be altered.
+ */
+type UploadForm_getInitialPageTextHook interface {
+	/**
+	 * @param mixed &$pageText the page text
+	 * @param mixed $msg array of header messages
+	 * @param mixed $config Config object
+	 * @return bool False to abort the hook
+	 */
+	OnUploadForm_getInitialPageText(pageText *interface{}, msg interface{}, config interface{}) bool
+}
+
+/**
+ * Run the UploadForm:getInitialPageText hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUploadForm_getInitialPageText(pageText *interface{}, msg interface{}, config interface{}) bool {
+	args := []interface{}{pageText, msg, config}
+	return r.container.run("UploadForm:getInitialPageText", args, func(handler interface{}) bool {
+		return handler.(UploadForm_getInitialPageTextHook).OnUploadForm_getInitialPageText(pageText, msg, config)
+	})
+}
+
+/**
+ * Before the upload form is generated. You might set the
+ * member-variables $uploadFormTextTop and $uploadFormTextAfterSummary to inject
+ * text (HTML) either before or after the editform.
+ */
+type UploadForm_initialHook interface {
+	/**
+	 * @param mixed &$upload SpecialUpload object
+	 * @return bool False to abort the hook
+	 */
+	OnUploadForm_initial(upload *interface{}) bool
+}
+
+/**
+ * Run the UploadForm:initial hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUploadForm_initial(upload *interface{}) bool {
+	args := []interface{}{upload}
+	return r.container.run("UploadForm:initial", args, func(handler interface{}) bool {
+		return handler.(UploadForm_initialHook).OnUploadForm_initial(upload)
+	})
+}
+
+/**
+ * After the descriptor for the upload form as been
+ * assembled.
+ */
+type UploadFormInitDescriptorHook interface {
+	/**
+	 * @param mixed &$descriptor (array) the HTMLForm descriptor
+	 * @return bool False to abort the hook
+	 */
+	OnUploadFormInitDescriptor(descriptor *interface{}) bool
+}
+
+/**
+ * Run the UploadFormInitDescriptor hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUploadFormInitDescriptor(descriptor *interface{}) bool {
+	args := []interface{}{descriptor}
+	return r.container.run("UploadFormInitDescriptor", args, func(handler interface{}) bool {
+		return handler.(UploadFormInitDescriptorHook).OnUploadFormInitDescriptor(descriptor)
+	})
+}
+
+/**
+ * after the standard source inputs have been
+ * added to the descriptor
+ */
+type UploadFormSourceDescriptorsHook interface {
+	/**
+	 * @param mixed &$descriptor (array) the HTMLForm descriptor
+	 * @param mixed &$radio Boolean, if source type should be shown as radio button
+	 * @param mixed $selectedSourceType The selected source type
+	 * @return bool False to abort the hook
+	 */
+	OnUploadFormSourceDescriptors(descriptor *interface{}, radio *interface{}, selectedSourceType interface{}) bool
+}
+
+/**
+ * Run the UploadFormSourceDescriptors hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUploadFormSourceDescriptors(descriptor *interface{}, radio *interface{}, selectedSourceType interface{}) bool {
+	args := []interface{}{descriptor, radio, selectedSourceType}
+	return r.container.run("UploadFormSourceDescriptors", args, func(handler interface{}) bool {
+		return handler.(UploadFormSourceDescriptorsHook).OnUploadFormSourceDescriptors(descriptor, radio, selectedSourceType)
+	})
+}
+
+/**
+ * Before a file is stashed (uploaded to stash).
+ * Note that code which has not been updated for MediaWiki 1.28 may not call this
+ * hook. If your extension absolutely, positively must prevent some files from
+ * being uploaded, use UploadVerifyFile or UploadVerifyUpload.
+ */
+type UploadStashFileHook interface {
+	/**
+	 * @param mixed $upload (object) An instance of UploadBase, with all info about the upload
+	 * @param mixed $user (object) An instance of User, the user uploading this file
+	 * @param mixed $props (array) File properties, as returned by FSFile::getPropsFromPath()
+	 * @param mixed &$error output: If the file stashing should be prevented, set this to the reason in the form of [ messagename, param1, param2, ... ] or a MessageSpecifier instance (you might want to use ApiMessage to provide machine -readable details for the API).
+	 * @return bool False to abort the hook
+	 */
+	OnUploadStashFile(upload interface{}, user interface{}, props interface{}, error *interface{}) bool
+}
+
+/**
+ * Run the UploadStashFile hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUploadStashFile(upload interface{}, user interface{}, props interface{}, error *interface{}) bool {
+	args := []interface{}{upload, user, props, error}
+	return r.container.run("UploadStashFile", args, func(handler interface{}) bool {
+		return handler.(UploadStashFileHook).OnUploadStashFile(upload, user, props, error)
+	})
+}
+
+/**
+ * DEPRECATED since 1.28! Use UploadVerifyFile instead.
+ * Additional chances to reject an uploaded file.
+ */
+type UploadVerificationHook interface {
+	/**
+	 * @param mixed $saveName (string) destination file name
+	 * @param mixed $tempName (string) filesystem path to the temporary file for checks
+	 * @param mixed &$error (string) output: message key for message to show if upload canceled by returning false. May also be an array, where the first element is the message key and the remaining elements are used as parameters to the message.
+	 * @return bool False to abort the hook
+	 */
+	OnUploadVerification(saveName interface{}, tempName interface{}, error *interface{}) bool
+}
+
+/**
+ * Run the UploadVerification hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUploadVerification(saveName interface{}, tempName interface{}, error *interface{}) bool {
+	args := []interface{}{saveName, tempName, error}
+	return r.container.run("UploadVerification", args, func(handler interface{}) bool {
+		return handler.(UploadVerificationHook).OnUploadVerification(saveName, tempName, error)
+	})
+}
+
+/**
+ * extra file verification, based on MIME type, etc. Preferred
+ * in most cases over UploadVerification.
+ */
+type UploadVerifyFileHook interface {
+	/**
+	 * @param mixed $upload (object) an instance of UploadBase, with all info about the upload
+	 * @param mixed $mime (string) The uploaded file's MIME type, as detected by MediaWiki. Handlers will typically only apply for specific MIME types.
+	 * @param mixed &$error (object) output: true if the file is valid. Otherwise, set this to the reason in the form of [ messagename, param1, param2, ... ] or a MessageSpecifier instance (you might want to use ApiMessage to provide machine -readable details for the API).
+	 * @return bool False to abort the hook
+	 */
+	OnUploadVerifyFile(upload interface{}, mime interface{}, error *interface{}) bool
+}
+
+/**
+ * Run the UploadVerifyFile hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUploadVerifyFile(upload interface{}, mime interface{}, error *interface{}) bool {
+	args := []interface{}{upload, mime, error}
+	return r.container.run("UploadVerifyFile", args, func(handler interface{}) bool {
+		return handler.(UploadVerifyFileHook).OnUploadVerifyFile(upload, mime, error)
+	})
+}
+
+/**
+ * Upload verification, based on both file properties like
+ * MIME type (same as UploadVerifyFile) and the information entered by the user
+ * (upload comment, file page contents etc.).
+ */
+type UploadVerifyUploadHook interface {
+	/**
+	 * @param mixed $upload (object) An instance of UploadBase, with all info about the upload
+	 * @param mixed $user (object) An instance of User, the user uploading this file
+	 * @param mixed $props (array) File properties, as returned by FSFile::getPropsFromPath()
+	 * @param mixed $comment (string) Upload log comment (also used as edit summary)
+	 * @param mixed $pageText (string) File description page text (only used for new uploads)
+	 * @param mixed &$error output: If the file upload should be prevented, set this to the reason in the form of [ messagename, param1, param2, ... ] or a MessageSpecifier instance (you might want to use ApiMessage to provide machine-readable details for the API).
+	 * @return bool False to abort the hook
+	 */
+	OnUploadVerifyUpload(upload interface{}, user interface{}, props interface{}, comment interface{}, pageText interface{}, error *interface{}) bool
+}
+
+/**
+ * Run the UploadVerifyUpload hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUploadVerifyUpload(upload interface{}, user interface{}, props interface{}, comment interface{}, pageText interface{}, error *interface{}) bool {
+	args := []interface{}{upload, user, props, comment, pageText, error}
+	return r.container.run("UploadVerifyUpload", args, func(handler interface{}) bool {
+		return handler.(UploadVerifyUploadHook).OnUploadVerifyUpload(upload, user, props, comment, pageText, error)
+	})
+}
+
+/**
+ * before creation and mailing of a user's new
+ * temporary password
+ */
+type User__mailPasswordInternalHook interface {
+	/**
+	 * @param mixed &$user the user who sent the message out
+	 * @param mixed &$ip IP of the user who sent the message out
+	 * @param mixed &$u the account whose new password will be set
+	 * @return bool False to abort the hook
+	 */
+	OnUser__mailPasswordInternal(user *interface{}, ip *interface{}, u *interface{}) bool
+}
+
+/**
+ * Run the User::mailPasswordInternal hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUser__mailPasswordInternal(user *interface{}, ip *interface{}, u *interface{}) bool {
+	args := []interface{}{user, ip, u}
+	return r.container.run("User::mailPasswordInternal", args, func(handler interface{}) bool {
+		return handler.(User__mailPasswordInternalHook).OnUser__mailPasswordInternal(user, ip, u)
+	})
+}
+
+/**
+ * Called when adding a group or changing a group's expiry; return
+ * false to override stock group addition.
+ */
+type UserAddGroupHook interface {
+	/**
+	 * @param mixed $user the user object that is to have a group added
+	 * @param mixed &$group the group to add; can be modified
+	 * @param mixed &$expiry the expiry time in TS_MW format, or null if the group is not to expire; can be modified
+	 * @return bool False to abort the hook
+	 */
+	OnUserAddGroup(user interface{}, group *interface{}, expiry *interface{}) bool
+}
+
+/**
+ * Run the UserAddGroup hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserAddGroup(user interface{}, group *interface{}, expiry *interface{}) bool {
+	args := []interface{}{user, group, expiry}
+	return r.container.run("UserAddGroup", args, func(handler interface{}) bool {
+		return handler.(UserAddGroupHook).OnUserAddGroup(user, group, expiry)
+	})
+}
+
+/**
+ * Called when creating an UserArray object from a database
+ * result.
+ */
+type UserArrayFromResultHook interface {
+	/**
+	 * @param mixed &$userArray set this to an object to override the default object returned
+	 * @param mixed $res database result used to create the object
+	 * @return bool False to abort the hook
+	 */
+	OnUserArrayFromResult(userArray *interface{}, res interface{}) bool
+}
+
+/**
+ * Run the UserArrayFromResult hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserArrayFromResult(userArray *interface{}, res interface{}) bool {
+	args := []interface{}{userArray, res}
+	return r.container.run("UserArrayFromResult", args, func(handler interface{}) bool {
+		return handler.(UserArrayFromResultHook).OnUserArrayFromResult(userArray, res)
+	})
+}
+
+/**
+ * To override User::canSendEmail() permission check.
+ */
+type UserCanSendEmailHook interface {
+	/**
+	 * @param mixed &$user User (object) whose permission is being checked
+	 * @param mixed &$canSend bool set on input, can override on output
+	 * @return bool False to abort the hook
+	 */
+	OnUserCanSendEmail(user *interface{}, canSend *interface{}) bool
+}
+
+/**
+ * Run the UserCanSendEmail hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserCanSendEmail(user *interface{}, canSend *interface{}) bool {
+	args := []interface{}{user, canSend}
+	return r.container.run("UserCanSendEmail", args, func(handler interface{}) bool {
+		return handler.(UserCanSendEmailHook).OnUserCanSendEmail(user, canSend)
+	})
+}
+
+/**
+ * Called when clearing the "You have new
+ * messages!" message, return false to not delete it.
+ */
+type UserClearNewTalkNotificationHook interface {
+	/**
+	 * @param mixed &$user User (object) that will clear the message
+	 * @param mixed $oldid ID of the talk page revision being viewed (0 means the most recent one)
+	 * @return bool False to abort the hook
+	 */
+	OnUserClearNewTalkNotification(user *interface{}, oldid interface{}) bool
+}
+
+/**
+ * Run the UserClearNewTalkNotification hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserClearNewTalkNotification(user *interface{}, oldid interface{}) bool {
+	args := []interface{}{user, oldid}
+	return r.container.run("UserClearNewTalkNotification", args, func(handler interface{}) bool {
+		return handler.(UserClearNewTalkNotificationHook).OnUserClearNewTalkNotification(user, oldid)
+	})
+}
+
+/**
+ * DEPRECATED since 1.27! Create an AuthenticationProvider
+ * instead. Manipulate the login form.
+ */
+type UserCreateFormHook interface {
+	/**
+	 * @param mixed &$template SimpleTemplate instance for the form
+	 * @return bool False to abort the hook
+	 */
+	OnUserCreateForm(template *interface{}) bool
+}
+
+/**
+ * Run the UserCreateForm hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserCreateForm(template *interface{}) bool {
+	args := []interface{}{template}
+	return r.container.run("UserCreateForm", args, func(handler interface{}) bool {
+		return handler.(UserCreateFormHook).OnUserCreateForm(template)
+	})
+}
+
+/**
+ * Called in User::getEffectiveGroups().
+ */
+type UserEffectiveGroupsHook interface {
+	/**
+	 * @param mixed &$user User to get groups for
+	 * @param mixed &$groups Current effective groups
+	 * @return bool False to abort the hook
+	 */
+	OnUserEffectiveGroups(user *interface{}, groups *interface{}) bool
+}
+
+/**
+ * Run the UserEffectiveGroups hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserEffectiveGroups(user *interface{}, groups *interface{}) bool {
+	args := []interface{}{user, groups}
+	return r.container.run("UserEffectiveGroups", args, func(handler interface{}) bool {
+		return handler.(UserEffectiveGroupsHook).OnUserEffectiveGroups(user, groups)
+	})
+}
+
+/**
+ * After calculating a list of all available rights.
+ */
+type UserGetAllRightsHook interface {
+	/**
+	 * @param mixed &$rights Array of rights, which may be added to.
+	 * @return bool False to abort the hook
+	 */
+	OnUserGetAllRights(rights *interface{}) bool
+}
+
+/**
+ * Run the UserGetAllRights hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserGetAllRights(rights *interface{}) bool {
+	args := []interface{}{rights}
+	return r.container.run("UserGetAllRights", args, func(handler interface{}) bool {
+		return handler.(UserGetAllRightsHook).OnUserGetAllRights(rights)
+	})
+}
+
+/**
+ * After fetching the core default, this hook is run right
+ * before returning the options to the caller. Warning: This hook is called for
+ * every call to User::getDefaultOptions(), which means it's potentially called
+ * dozens or hundreds of times. You may want to cache the results of non-trivial
+ * operations in your hook function for this reason.
+ */
+type UserGetDefaultOptionsHook interface {
+	/**
+	 * @param mixed &$defaultOptions Array of preference keys and their default values.
+	 * @return bool False to abort the hook
+	 */
+	OnUserGetDefaultOptions(defaultOptions *interface{}) bool
+}
+
+/**
+ * Run the UserGetDefaultOptions hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserGetDefaultOptions(defaultOptions *interface{}) bool {
+	args := []interface{}{defaultOptions}
+	return r.container.run("UserGetDefaultOptions", args, func(handler interface{}) bool {
+		return handler.(UserGetDefaultOptionsHook).OnUserGetDefaultOptions(defaultOptions)
+	})
+}
+
+/**
+ * Called when getting an user email address.
+ */
+type UserGetEmailHook interface {
+	/**
+	 * @param mixed $user User object
+	 * @param mixed &$email email, change this to override local email
+	 * @return bool False to abort the hook
+	 */
+	OnUserGetEmail(user interface{}, email *interface{}) bool
+}
+
+/**
+ * Run the UserGetEmail hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserGetEmail(user interface{}, email *interface{}) bool {
+	args := []interface{}{user, email}
+	return r.container.run("UserGetEmail", args, func(handler interface{}) bool {
+		return handler.(UserGetEmailHook).OnUserGetEmail(user, email)
+	})
+}
+
+/**
+ * Called when getting the timestamp of
+ * email authentication.
+ */
+type UserGetEmailAuthenticationTimestampHook interface {
+	/**
+	 * @param mixed $user User object
+	 * @param mixed &$timestamp timestamp, change this to override local email authentication timestamp
+	 * @return bool False to abort the hook
+	 */
+	OnUserGetEmailAuthenticationTimestamp(user interface{}, timestamp *interface{}) bool
+}
+
+/**
+ * Run the UserGetEmailAuthenticationTimestamp hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserGetEmailAuthenticationTimestamp(user interface{}, timestamp *interface{}) bool {
+	args := []interface{}{user, timestamp}
+	return r.container.run("UserGetEmailAuthenticationTimestamp", args, func(handler interface{}) bool {
+		return handler.(UserGetEmailAuthenticationTimestampHook).OnUserGetEmailAuthenticationTimestamp(user, timestamp)
+	})
+}
+
+/**
+ * Called when getting user's interface language object.
+ */
+type UserGetLanguageObjectHook interface {
+	/**
+	 * @param mixed $user User object
+	 * @param mixed &$code Language code that will be used to create the object
+	 * @param mixed $context IContextSource object
+	 * @return bool False to abort the hook
+	 */
+	OnUserGetLanguageObject(user interface{}, code *interface{}, context interface{}) bool
+}
+
+/**
+ * Run the UserGetLanguageObject hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserGetLanguageObject(user interface{}, code *interface{}, context interface{}) bool {
+	args := []interface{}{user, code, context}
+	return r.container.run("UserGetLanguageObject", args, func(handler interface{}) bool {
+		return handler.(UserGetLanguageObjectHook).OnUserGetLanguageObject(user, code, context)
+	})
+}
+
+/**
+ * Allows to modify $wgReservedUsernames at run time.
+ */
+type UserGetReservedNamesHook interface {
+	/**
+	 * @param mixed &$reservedUsernames $wgReservedUsernames
+	 * @return bool False to abort the hook
+	 */
+	OnUserGetReservedNames(reservedUsernames *interface{}) bool
+}
+
+/**
+ * Run the UserGetReservedNames hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserGetReservedNames(reservedUsernames *interface{}) bool {
+	args := []interface{}{reservedUsernames}
+	return r.container.run("UserGetReservedNames", args, func(handler interface{}) bool {
+		return handler.(UserGetReservedNamesHook).OnUserGetReservedNames(reservedUsernames)
+	})
+}
+
+/**
+ * Called in User::getRights().
+ */
+type UserGetRightsHook interface {
+	/**
+	 * @param mixed $user User to get rights for
+	 * @param mixed &$rights Current rights
+	 * @return bool False to abort the hook
+	 */
+	OnUserGetRights(user interface{}, rights *interface{}) bool
+}
+
+/**
+ * Run the UserGetRights hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserGetRights(user interface{}, rights *interface{}) bool {
+	args := []interface{}{user, rights}
+	return r.container.run("UserGetRights", args, func(handler interface{}) bool {
+		return handler.(UserGetRightsHook).OnUserGetRights(user, rights)
+	})
+}
+
+/**
+ * Called in User::getRights(). This hook override
+ * the UserGetRights hook. It can be used to remove rights from user
+ * and ensure that will not be reinserted by the other hook callbacks
+ * therefore this hook should not be used to add any rights, use UserGetRights instead.
+ */
+type UserGetRightsRemoveHook interface {
+	/**
+	 * @param mixed $user User to get rights for
+	 * @param mixed &$rights Current rights
+	 * @return bool False to abort the hook
+	 */
+	OnUserGetRightsRemove(user interface{}, rights *interface{}) bool
+}
+
+/**
+ * Run the UserGetRightsRemove hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserGetRightsRemove(user interface{}, rights *interface{}) bool {
+	args := []interface{}{user, rights}
+	return r.container.run("UserGetRightsRemove", args, func(handler interface{}) bool {
+		return handler.(UserGetRightsRemoveHook).OnUserGetRightsRemove(user, rights)
+	})
+}
+
+/**
+ * Called after user groups are changed.
+ */
+type UserGroupsChangedHook interface {
+	/**
+	 * @param mixed $user User whose groups changed
+	 * @param mixed $added Groups added
+	 * @param mixed $removed Groups removed
+	 * @param mixed $performer User who performed the change, false if via autopromotion
+	 * @param mixed $reason The reason, if any, given by the user performing the change, false if via autopromotion.
+	 * @param mixed $oldUGMs An associative array (group name => UserGroupMembership object) of the user's group memberships before the change.
+	 * @param mixed $newUGMs An associative array (group name => UserGroupMembership object) of the user's current group memberships.
+	 * @return bool False to abort the hook
+	 */
+	OnUserGroupsChanged(user interface{}, added interface{}, removed interface{}, performer interface{}, reason interface{}, oldUGMs interface{}, newUGMs interface{}) bool
+}
+
+/**
+ * Run the UserGroupsChanged hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserGroupsChanged(user interface{}, added interface{}, removed interface{}, performer interface{}, reason interface{}, oldUGMs interface{}, newUGMs interface{}) bool {
+	args := []interface{}{user, added, removed, performer, reason, oldUGMs, newUGMs}
+	return r.container.run("UserGroupsChanged", args, func(handler interface{}) bool {
+		return handler.(UserGroupsChangedHook).OnUserGroupsChanged(user, added, removed, performer, reason, oldUGMs, newUGMs)
+	})
+}
+
+/**
+ * Check if a user is blocked from a specific page (for
+ * specific block exemptions).
+ */
+type UserIsBlockedFromHook interface {
+	/**
+	 * @param mixed $user User in question
+	 * @param mixed $title Title of the page in question
+	 * @param mixed &$blocked Out-param, whether or not the user is blocked from that page.
+	 * @param mixed &$allowUsertalk If the user is blocked, whether or not the block allows users to edit their own user talk pages.
+	 * @return bool False to abort the hook
+	 */
+	OnUserIsBlockedFrom(user interface{}, title interface{}, blocked *interface{}, allowUsertalk *interface{}) bool
+}
+
+/**
+ * Run the UserIsBlockedFrom hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserIsBlockedFrom(user interface{}, title interface{}, blocked *interface{}, allowUsertalk *interface{}) bool {
+	args := []interface{}{user, title, blocked, allowUsertalk}
+	return r.container.run("UserIsBlockedFrom", args, func(handler interface{}) bool {
+		return handler.(UserIsBlockedFromHook).OnUserIsBlockedFrom(user, title, blocked, allowUsertalk)
+	})
+}
+
+/**
+ * Check if user is blocked on all wikis.
+ */
+type UserIsBlockedGloballyHook interface {
+	/**
+	 * @param mixed &$user User object
+	 * @param mixed $ip User's IP address
+	 * @param mixed &$blocked Whether the user is blocked, to be modified by the hook
+	 * @param mixed &$block The Block object, to be modified by the hook
+	 * @return bool False to abort the hook
+	 */
+	OnUserIsBlockedGlobally(user *interface{}, ip interface{}, blocked *interface{}, block *interface{}) bool
+}
+
+/**
+ * Run the UserIsBlockedGlobally hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserIsBlockedGlobally(user *interface{}, ip interface{}, blocked *interface{}, block *interface{}) bool {
+	args := []interface{}{user, ip, blocked, block}
+	return r.container.run("UserIsBlockedGlobally", args, func(handler interface{}) bool {
+		return handler.(UserIsBlockedGloballyHook).OnUserIsBlockedGlobally(user, ip, blocked, block)
+	})
+}
+
+/**
+ * when determining whether a user is a bot account
+ */
+type UserIsBotHook interface {
+	/**
+	 * @param mixed $user the user
+	 * @param mixed &$isBot whether this is user a bot or not (boolean)
+	 * @return bool False to abort the hook
+	 */
+	OnUserIsBot(user interface{}, isBot *interface{}) bool
+}
+
+/**
+ * Run the UserIsBot hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserIsBot(user interface{}, isBot *interface{}) bool {
+	args := []interface{}{user, isBot}
+	return r.container.run("UserIsBot", args, func(handler interface{}) bool {
+		return handler.(UserIsBotHook).OnUserIsBot(user, isBot)
+	})
+}
+
+/**
+ * Check if all users are allowed some user right; return
+ * false if a UserGetRights hook might remove the named right.
+ */
+type UserIsEveryoneAllowedHook interface {
+	/**
+	 * @param mixed $right The user right being checked
+	 * @return bool False to abort the hook
+	 */
+	OnUserIsEveryoneAllowed(right interface{}) bool
+}
+
+/**
+ * Run the UserIsEveryoneAllowed hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserIsEveryoneAllowed(right interface{}) bool {
+	args := []interface{}{right}
+	return r.container.run("UserIsEveryoneAllowed", args, func(handler interface{}) bool {
+		return handler.(UserIsEveryoneAllowedHook).OnUserIsEveryoneAllowed(right)
+	})
+}
+
+/**
+ * Check if the user's name should be hidden. See User::isHidden().
+ */
+type UserIsHiddenHook interface {
+	/**
+	 * @param mixed $user User in question.
+	 * @param mixed &$hidden Set true if the user's name should be hidden.
+	 * @return bool False to abort the hook
+	 */
+	OnUserIsHidden(user interface{}, hidden *interface{}) bool
+}
+
+/**
+ * Run the UserIsHidden hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserIsHidden(user interface{}, hidden *interface{}) bool {
+	args := []interface{}{user, hidden}
+	return r.container.run("UserIsHidden", args, func(handler interface{}) bool {
+		return handler.(UserIsHiddenHook).OnUserIsHidden(user, hidden)
+	})
+}
+
+/**
+ * Check if the user is locked. See User::isLocked().
+ */
+type UserIsLockedHook interface {
+	/**
+	 * @param mixed $user User in question.
+	 * @param mixed &$locked Set true if the user should be locked.
+	 * @return bool False to abort the hook
+	 */
+	OnUserIsLocked(user interface{}, locked *interface{}) bool
+}
+
+/**
+ * Run the UserIsLocked hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserIsLocked(user interface{}, locked *interface{}) bool {
+	args := []interface{}{user, locked}
+	return r.container.run("UserIsLocked", args, func(handler interface{}) bool {
+		return handler.(UserIsLockedHook).OnUserIsLocked(user, locked)
+	})
+}
+
+/**
+ * Called to authenticate users on external or
+ * environmental means; occurs after session is loaded.
+ */
+type UserLoadAfterLoadFromSessionHook interface {
+	/**
+	 * @param mixed $user user object being loaded
+	 * @return bool False to abort the hook
+	 */
+	OnUserLoadAfterLoadFromSession(user interface{}) bool
+}
+
+/**
+ * Run the UserLoadAfterLoadFromSession hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserLoadAfterLoadFromSession(user interface{}) bool {
+	args := []interface{}{user}
+	return r.container.run("UserLoadAfterLoadFromSession", args, func(handler interface{}) bool {
+		return handler.(UserLoadAfterLoadFromSessionHook).OnUserLoadAfterLoadFromSession(user)
+	})
+}
+
+/**
+ * Called when loading a default user.
+ */
+type UserLoadDefaultsHook interface {
+	/**
+	 * @param mixed $user user object
+	 * @param mixed $name user name
+	 * @return bool False to abort the hook
+	 */
+	OnUserLoadDefaults(user interface{}, name interface{}) bool
+}
+
+/**
+ * Run the UserLoadDefaults hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserLoadDefaults(user interface{}, name interface{}) bool {
+	args := []interface{}{user, name}
+	return r.container.run("UserLoadDefaults", args, func(handler interface{}) bool {
+		return handler.(UserLoadDefaultsHook).OnUserLoadDefaults(user, name)
+	})
+}
+
+/**
+ * Called when loading a user from the database.
+ */
+type UserLoadFromDatabaseHook interface {
+	/**
+	 * @param mixed $user user object
+	 * @param mixed &$s database query object
+	 * @return bool False to abort the hook
+	 */
+	OnUserLoadFromDatabase(user interface{}, s *interface{}) bool
+}
+
+/**
+ * Run the UserLoadFromDatabase hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserLoadFromDatabase(user interface{}, s *interface{}) bool {
+	args := []interface{}{user, s}
+	return r.container.run("UserLoadFromDatabase", args, func(handler interface{}) bool {
+		return handler.(UserLoadFromDatabaseHook).OnUserLoadFromDatabase(user, s)
+	})
+}
+
+/**
+ * DEPRECATED since 1.27! Create a
+ * MediaWiki\Session\SessionProvider instead.
+ * Called to authenticate users on external/environmental means; occurs before
+ * session is loaded.
+ */
+type UserLoadFromSessionHook interface {
+	/**
+	 * @param mixed $user user object being loaded
+	 * @param mixed &$result set this to a boolean value to abort the normal authentication process
+	 * @return bool False to abort the hook
+	 */
+	OnUserLoadFromSession(user interface{}, result *interface{}) bool
+}
+
+/**
+ * Run the UserLoadFromSession hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserLoadFromSession(user interface{}, result *interface{}) bool {
+	args := []interface{}{user, result}
+	return r.container.run("UserLoadFromSession", args, func(handler interface{}) bool {
+		return handler.(UserLoadFromSessionHook).OnUserLoadFromSession(user, result)
+	})
+}
+
+/**
+ * When user options/preferences are being loaded from the
+ * database.
+ */
+type UserLoadOptionsHook interface {
+	/**
+	 * @param mixed $user User object
+	 * @param mixed &$options Options, can be modified.
+	 * @return bool False to abort the hook
+	 */
+	OnUserLoadOptions(user interface{}, options *interface{}) bool
+}
+
+/**
+ * Run the UserLoadOptions hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserLoadOptions(user interface{}, options *interface{}) bool {
+	args := []interface{}{user, options}
+	return r.container.run("UserLoadOptions", args, func(handler interface{}) bool {
+		return handler.(UserLoadOptionsHook).OnUserLoadOptions(user, options)
+	})
+}
+
+/**
+ * Called after a user is logged in
+ */
+type UserLoggedInHook interface {
+	/**
+	 * @param mixed $user User object for the logged-in user
+	 * @return bool False to abort the hook
+	 */
+	OnUserLoggedIn(user interface{}) bool
+}
+
+/**
+ * Run the UserLoggedIn hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserLoggedIn(user interface{}) bool {
+	args := []interface{}{user}
+	return r.container.run("UserLoggedIn", args, func(handler interface{}) bool {
+		return handler.(UserLoggedInHook).OnUserLoggedIn(user)
+	})
+}
+
+/**
+ * Show custom content after a user has logged in via the Web
+ * interface. For functionality that needs to run after any login (API or web) use
+ * UserLoggedIn.
+ */
+type UserLoginCompleteHook interface {
+	/**
+	 * @param mixed &$user the user object that was created on login
+	 * @param mixed &$inject_html Any HTML to inject after the "logged in" message.
+	 * @param mixed $direct (bool) The hook is called directly after a successful login. This will only happen once per login. A UserLoginComplete call with direct=false can happen when the user visits the login page while already logged in.
+	 * @return bool False to abort the hook
+	 */
+	OnUserLoginComplete(user *interface{}, inject_html *interface{}, direct interface{}) bool
+}
+
+/**
+ * Run the UserLoginComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserLoginComplete(user *interface{}, inject_html *interface{}, direct interface{}) bool {
+	args := []interface{}{user, inject_html, direct}
+	return r.container.run("UserLoginComplete", args, func(handler interface{}) bool {
+		return handler.(UserLoginCompleteHook).OnUserLoginComplete(user, inject_html, direct)
+	})
+}
+
+/**
+ * DEPRECATED since 1.27! Create an AuthenticationProvider
+ * instead. Manipulate the login form.
+ */
+type UserLoginFormHook interface {
+	/**
+	 * @param mixed &$template QuickTemplate instance for the form
+	 * @return bool False to abort the hook
+	 */
+	OnUserLoginForm(template *interface{}) bool
+}
+
+/**
+ * Run the UserLoginForm hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserLoginForm(template *interface{}) bool {
+	args := []interface{}{template}
+	return r.container.run("UserLoginForm", args, func(handler interface{}) bool {
+		return handler.(UserLoginFormHook).OnUserLoginForm(template)
+	})
+}
+
+/**
+ * Before a user logs out.
+ */
+type UserLogoutHook interface {
+	/**
+	 * @param mixed &$user the user object that is about to be logged out
+	 * @return bool False to abort the hook
+	 */
+	OnUserLogout(user *interface{}) bool
+}
+
+/**
+ * Run the UserLogout hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserLogout(user *interface{}) bool {
+	args := []interface{}{user}
+	return r.container.run("UserLogout", args, func(handler interface{}) bool {
+		return handler.(UserLogoutHook).OnUserLogout(user)
+	})
+}
+
+/**
+ * After a user has logged out.
+ */
+type UserLogoutCompleteHook interface {
+	/**
+	 * @param mixed &$user the user object _after_ logout (won't have name, ID, etc.)
+	 * @param mixed &$inject_html Any HTML to inject after the "logged out" message.
+	 * @param mixed $oldName name of the user before logout (string)
+	 * @return bool False to abort the hook
+	 */
+	OnUserLogoutComplete(user *interface{}, inject_html *interface{}, oldName interface{}) bool
+}
+
+/**
+ * Run the UserLogoutComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserLogoutComplete(user *interface{}, inject_html *interface{}, oldName interface{}) bool {
+	args := []interface{}{user, inject_html, oldName}
+	return r.container.run("UserLogoutComplete", args, func(handler interface{}) bool {
+		return handler.(UserLogoutCompleteHook).OnUserLogoutComplete(user, inject_html, oldName)
+	})
+}
+
+/**
+ * Called to generate a VERP return address
+ * when UserMailer sends an email, with a bounce handling extension.
+ */
+type UserMailerChangeReturnPathHook interface {
+	/**
+	 * @param mixed $to Array of MailAddress objects for the recipients
+	 * @param mixed &$returnPath The return address string
+	 * @return bool False to abort the hook
+	 */
+	OnUserMailerChangeReturnPath(to interface{}, returnPath *interface{}) bool
+}
+
+/**
+ * Run the UserMailerChangeReturnPath hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserMailerChangeReturnPath(to interface{}, returnPath *interface{}) bool {
+	args := []interface{}{to, returnPath}
+	return r.container.run("UserMailerChangeReturnPath", args, func(handler interface{}) bool {
+		return handler.(UserMailerChangeReturnPathHook).OnUserMailerChangeReturnPath(to, returnPath)
+	})
+}
+
+/**
+ * Called in UserMailer::send() to give extensions a chance
+ * to split up an email with multiple the To: field into separate emails.
+ */
+type UserMailerSplitToHook interface {
+	/**
+	 * @param mixed &$to array of MailAddress objects; unset the ones which should be mailed separately
+	 * @return bool False to abort the hook
+	 */
+	OnUserMailerSplitTo(to *interface{}) bool
+}
+
+/**
+ * Run the UserMailerSplitTo hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserMailerSplitTo(to *interface{}) bool {
+	args := []interface{}{to}
+	return r.container.run("UserMailerSplitTo", args, func(handler interface{}) bool {
+		return handler.(UserMailerSplitToHook).OnUserMailerSplitTo(to)
+	})
+}
+
+/**
+ * Called in UserMailer::send() to change email
+ * contents. Extensions can block sending the email by returning false and setting
+ * $error.
+ */
+type UserMailerTransformContentHook interface {
+	/**
+	 * @param mixed $to array of MailAdresses of the targets
+	 * @param mixed $from MailAddress of the sender
+	 * @param mixed &$body email body, either a string (for plaintext emails) or an array with 'text' and 'html' keys
+	 * @param mixed &$error should be set to an error message string
+	 * @return bool False to abort the hook
+	 */
+	OnUserMailerTransformContent(to interface{}, from interface{}, body *interface{}, error *interface{}) bool
+}
+
+/**
+ * Run the UserMailerTransformContent hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserMailerTransformContent(to interface{}, from interface{}, body *interface{}, error *interface{}) bool {
+	args := []interface{}{to, from, body, error}
+	return r.container.run("UserMailerTransformContent", args, func(handler interface{}) bool {
+		return handler.(UserMailerTransformContentHook).OnUserMailerTransformContent(to, from, body, error)
+	})
+}
+
+/**
+ * Called in UserMailer::send() to change email after
+ * it has gone through the MIME transform. Extensions can block sending the email
+ * by returning false and setting $error.
+ */
+type UserMailerTransformMessageHook interface {
+	/**
+	 * @param mixed $to array of MailAdresses of the targets
+	 * @param mixed $from MailAddress of the sender
+	 * @param mixed &$subject email subject (not MIME encoded)
+	 * @param mixed &$headers email headers (except To: and Subject:) as an array of header name => value pairs
+	 * @param mixed &$body email body (in MIME format) as a string
+	 * @param mixed &$error should be set to an error message string
+	 * @return bool False to abort the hook
+	 */
+	OnUserMailerTransformMessage(to interface{}, from interface{}, subject *interface{}, headers *interface{}, body *interface{}, error *interface{}) bool
+}
+
+/**
+ * Run the UserMailerTransformMessage hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserMailerTransformMessage(to interface{}, from interface{}, subject *interface{}, headers *interface{}, body *interface{}, error *interface{}) bool {
+	args := []interface{}{to, from, subject, headers, body, error}
+	return r.container.run("UserMailerTransformMessage", args, func(handler interface{}) bool {
+		return handler.(UserMailerTransformMessageHook).OnUserMailerTransformMessage(to, from, subject, headers, body, error)
+	})
+}
+
+/**
+ * Called when removing a group; return false to override stock
+ * group removal.
+ */
+type UserRemoveGroupHook interface {
+	/**
+	 * @param mixed $user the user object that is to have a group removed
+	 * @param mixed &$group the group to be removed, can be modified
+	 * @return bool False to abort the hook
+	 */
+	OnUserRemoveGroup(user interface{}, group *interface{}) bool
+}
+
+/**
+ * Run the UserRemoveGroup hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserRemoveGroup(user interface{}, group *interface{}) bool {
+	args := []interface{}{user, group}
+	return r.container.run("UserRemoveGroup", args, func(handler interface{}) bool {
+		return handler.(UserRemoveGroupHook).OnUserRemoveGroup(user, group)
+	})
+}
+
+/**
+ * Called to determine whether a user needs
+ * to be switched to HTTPS.
+ */
+type UserRequiresHTTPSHook interface {
+	/**
+	 * @param mixed $user User in question.
+	 * @param mixed &$https Boolean whether $user should be switched to HTTPS.
+	 * @return bool False to abort the hook
+	 */
+	OnUserRequiresHTTPS(user interface{}, https *interface{}) bool
+}
+
+/**
+ * Run the UserRequiresHTTPS hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserRequiresHTTPS(user interface{}, https *interface{}) bool {
+	args := []interface{}{user, https}
+	return r.container.run("UserRequiresHTTPS", args, func(handler interface{}) bool {
+		return handler.(UserRequiresHTTPSHook).OnUserRequiresHTTPS(user, https)
+	})
+}
+
+/**
+ * Called in User::resetOptions() when user preferences
+ * have been requested to be reset. This hook can be used to exclude certain
+ * options from being reset even when the user has requested all prefs to be reset,
+ * because certain options might be stored in the user_properties database table
+ * despite not being visible and editable via Special:Preferences.
+ */
+type UserResetAllOptionsHook interface {
+	/**
+	 * @param mixed $user the User (object) whose preferences are being reset
+	 * @param mixed &$newOptions array of new (site default) preferences
+	 * @param mixed $options array of the user's old preferences
+	 * @param mixed $resetKinds array containing the kinds of preferences to reset
+	 * @return bool False to abort the hook
+	 */
+	OnUserResetAllOptions(user interface{}, newOptions *interface{}, options interface{}, resetKinds interface{}) bool
+}
+
+/**
+ * Run the UserResetAllOptions hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserResetAllOptions(user interface{}, newOptions *interface{}, options interface{}, resetKinds interface{}) bool {
+	args := []interface{}{user, newOptions, options, resetKinds}
+	return r.container.run("UserResetAllOptions", args, func(handler interface{}) bool {
+		return handler.(UserResetAllOptionsHook).OnUserResetAllOptions(user, newOptions, options, resetKinds)
+	})
+}
+
+/**
+ * Called when retrieving "You have new messages!"
+ * message(s).
+ */
+type UserRetrieveNewTalksHook interface {
+	/**
+	 * @param mixed &$user user retrieving new talks messages
+	 * @param mixed &$talks array of new talks page(s)
+	 * @return bool False to abort the hook
+	 */
+	OnUserRetrieveNewTalks(user *interface{}, talks *interface{}) bool
+}
+
+/**
+ * Run the UserRetrieveNewTalks hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserRetrieveNewTalks(user *interface{}, talks *interface{}) bool {
+	args := []interface{}{user, talks}
+	return r.container.run("UserRetrieveNewTalks", args, func(handler interface{}) bool {
+		return handler.(UserRetrieveNewTalksHook).OnUserRetrieveNewTalks(user, talks)
+	})
+}
+
+/**
+ * DEPRECATED since 1.26! Use UserGroupsChanged instead.
+ * After a user's group memberships are changed.
+ */
+type UserRightsHook interface {
+	/**
+	 * @param mixed &$user User object that was changed
+	 * @param mixed $add Array of strings corresponding to groups added
+	 * @param mixed $remove Array of strings corresponding to groups removed
+	 * @return bool False to abort the hook
+	 */
+	OnUserRights(user *interface{}, add interface{}, remove interface{}) bool
+}
+
+/**
+ * Run the UserRights hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserRights(user *interface{}, add interface{}, remove interface{}) bool {
+	args := []interface{}{user, add, remove}
+	return r.container.run("UserRights", args, func(handler interface{}) bool {
+		return handler.(UserRightsHook).OnUserRights(user, add, remove)
+	})
+}
+
+/**
+ * Called just before saving user preferences. Hook handlers can
+ * either add or manipulate options, or reset one back to it's default to block
+ * changing it. Hook handlers are also allowed to abort the process by returning
+ * false, e.g. to save to a global profile instead. Compare to the UserSaveSettings
+ * hook, which is called after the preferences have been saved.
+ */
+type UserSaveOptionsHook interface {
+	/**
+	 * @param mixed $user The User for which the options are going to be saved
+	 * @param mixed &$options The users options as an associative array, modifiable
+	 * @return bool False to abort the hook
+	 */
+	OnUserSaveOptions(user interface{}, options *interface{}) bool
+}
+
+/**
+ * Run the UserSaveOptions hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserSaveOptions(user interface{}, options *interface{}) bool {
+	args := []interface{}{user, options}
+	return r.container.run("UserSaveOptions", args, func(handler interface{}) bool {
+		return handler.(UserSaveOptionsHook).OnUserSaveOptions(user, options)
+	})
+}
+
+/**
+ * Called directly after user preferences (user_properties in
+ * the database) have been saved. Compare to the UserSaveOptions hook, which is
+ * called before.
+ */
+type UserSaveSettingsHook interface {
+	/**
+	 * @param mixed $user The User for which the options have been saved
+	 * @return bool False to abort the hook
+	 */
+	OnUserSaveSettings(user interface{}) bool
+}
+
+/**
+ * Run the UserSaveSettings hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserSaveSettings(user interface{}) bool {
+	args := []interface{}{user}
+	return r.container.run("UserSaveSettings", args, func(handler interface{}) bool {
+		return handler.(UserSaveSettingsHook).OnUserSaveSettings(user)
+	})
+}
+
+/**
+ * DEPRECATED since 1.27! If you're trying to replace core
+ * session cookie handling, you want to create a subclass of
+ * MediaWiki\Session\CookieSessionProvider instead. Otherwise, you can no longer
+ * count on user data being saved to cookies versus some other mechanism.
+ * Called when setting user cookies.
+ */
+type UserSetCookiesHook interface {
+	/**
+	 * @param mixed $user User object
+	 * @param mixed &$session session array, will be added to the session
+	 * @param mixed &$cookies cookies array mapping cookie name to its value
+	 * @return bool False to abort the hook
+	 */
+	OnUserSetCookies(user interface{}, session *interface{}, cookies *interface{}) bool
+}
+
+/**
+ * Run the UserSetCookies hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserSetCookies(user interface{}, session *interface{}, cookies *interface{}) bool {
+	args := []interface{}{user, session, cookies}
+	return r.container.run("UserSetCookies", args, func(handler interface{}) bool {
+		return handler.(UserSetCookiesHook).OnUserSetCookies(user, session, cookies)
+	})
+}
+
+/**
+ * Called when changing user email address.
+ */
+type UserSetEmailHook interface {
+	/**
+	 * @param mixed $user User object
+	 * @param mixed &$email new email, change this to override new email address
+	 * @return bool False to abort the hook
+	 */
+	OnUserSetEmail(user interface{}, email *interface{}) bool
+}
+
+/**
+ * Run the UserSetEmail hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserSetEmail(user interface{}, email *interface{}) bool {
+	args := []interface{}{user, email}
+	return r.container.run("UserSetEmail", args, func(handler interface{}) bool {
+		return handler.(UserSetEmailHook).OnUserSetEmail(user, email)
+	})
+}
+
+/**
+ * Called when setting the timestamp of
+ * email authentication.
+ */
+type UserSetEmailAuthenticationTimestampHook interface {
+	/**
+	 * @param mixed $user User object
+	 * @param mixed &$timestamp new timestamp, change this to override local email authentication timestamp
+	 * @return bool False to abort the hook
+	 */
+	OnUserSetEmailAuthenticationTimestamp(user interface{}, timestamp *interface{}) bool
+}
+
+/**
+ * Run the UserSetEmailAuthenticationTimestamp hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserSetEmailAuthenticationTimestamp(user interface{}, timestamp *interface{}) bool {
+	args := []interface{}{user, timestamp}
+	return r.container.run("UserSetEmailAuthenticationTimestamp", args, func(handler interface{}) bool {
+		return handler.(UserSetEmailAuthenticationTimestampHook).OnUserSetEmailAuthenticationTimestamp(user, timestamp)
+	})
+}
+
+/**
+ * Called when generating a list of user tool links, e.g.
+ * "Foobar (Talk | Contribs | Block)".
+ */
+type UserToolLinksEditHook interface {
+	/**
+	 * @param mixed $userId User id of the current user
+	 * @param mixed $userText User name of the current user
+	 * @param mixed &$items Array of user tool links as HTML fragments
+	 * @return bool False to abort the hook
+	 */
+	OnUserToolLinksEdit(userId interface{}, userText interface{}, items *interface{}) bool
+}
+
+/**
+ * Run the UserToolLinksEdit hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserToolLinksEdit(userId interface{}, userText interface{}, items *interface{}) bool {
+	args := []interface{}{userId, userText, items}
+	return r.container.run("UserToolLinksEdit", args, func(handler interface{}) bool {
+		return handler.(UserToolLinksEditHook).OnUserToolLinksEdit(userId, userText, items)
+	})
+}
+
+/**
+ * Called in UsersPager::doBatchLookups() to give
+ * extensions providing user group data from an alternate source a chance to add
+ * their data into the cache array so that things like global user groups are
+ * displayed correctly in Special:ListUsers.
+ */
+type UsersPagerDoBatchLookupsHook interface {
+	/**
+	 * @param mixed $dbr Read-only database handle
+	 * @param mixed $userIds Array of user IDs whose groups we should look up
+	 * @param mixed &$cache Array of user ID -> (array of internal group name (e.g. 'sysop') -> UserGroupMembership object)
+	 * @param mixed &$groups Array of group name -> bool true mappings for members of a given user group
+	 * @return bool False to abort the hook
+	 */
+	OnUsersPagerDoBatchLookups(dbr interface{}, userIds interface{}, cache *interface{}, groups *interface{}) bool
+}
+
+/**
+ * Run the UsersPagerDoBatchLookups hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUsersPagerDoBatchLookups(dbr interface{}, userIds interface{}, cache *interface{}, groups *interface{}) bool {
+	args := []interface{}{dbr, userIds, cache, groups}
+	return r.container.run("UsersPagerDoBatchLookups", args, func(handler interface{}) bool {
+		return handler.(UsersPagerDoBatchLookupsHook).OnUsersPagerDoBatchLookups(dbr, userIds, cache, groups)
+	})
+}
+
+/**
+ * Called to validate the cached metadata in
+ * FormatMetadata::getExtendedMeta (return false means cache will be
+ * invalidated and GetExtendedMetadata hook called again).
+ */
+type ValidateExtendedMetadataCacheHook interface {
+	/**
+	 * @param mixed $timestamp The timestamp metadata was generated
+	 * @param mixed $file The file the metadata is for
+	 * @return bool False to abort the hook
+	 */
+	OnValidateExtendedMetadataCache(timestamp interface{}, file interface{}) bool
+}
+
+/**
+ * Run the ValidateExtendedMetadataCache hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnValidateExtendedMetadataCache(timestamp interface{}, file interface{}) bool {
+	args := []interface{}{timestamp, file}
+	return r.container.run("ValidateExtendedMetadataCache", args, func(handler interface{}) bool {
+		return handler.(ValidateExtendedMetadataCacheHook).OnValidateExtendedMetadataCache(timestamp, file)
+	})
+}
+
+/**
+ * Called in WantedPagesPage::getQueryInfo(), can be
+ * used to alter the SQL query which gets the list of wanted pages.
+ */
+type WantedPages__getQueryInfoHook interface {
+	/**
+	 * @param mixed &$wantedPages WantedPagesPage object
+	 * @param mixed &$query query array, see QueryPage::getQueryInfo() for format documentation
+	 * @return bool False to abort the hook
+	 */
+	OnWantedPages__getQueryInfo(wantedPages *interface{}, query *interface{}) bool
+}
+
+/**
+ * Run the WantedPages::getQueryInfo hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnWantedPages__getQueryInfo(wantedPages *interface{}, query *interface{}) bool {
+	args := []interface{}{wantedPages, query}
+	return r.container.run("WantedPages::getQueryInfo", args, func(handler interface{}) bool {
+		return handler.(WantedPages__getQueryInfoHook).OnWantedPages__getQueryInfo(wantedPages, query)
+	})
+}
+
+/**
+ * Before a watch is added to an article.
+ */
+type WatchArticleHook interface {
+	/**
+	 * @param mixed &$user user that will watch
+	 * @param mixed &$page WikiPage object to be watched
+	 * @param mixed &$status Status object to be returned if the hook returns false
+	 * @return bool False to abort the hook
+	 */
+	OnWatchArticle(user *interface{}, page *interface{}, status *interface{}) bool
+}
+
+/**
+ * Run the WatchArticle hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnWatchArticle(user *interface{}, page *interface{}, status *interface{}) bool {
+	args := []interface{}{user, page, status}
+	return r.container.run("WatchArticle", args, func(handler interface{}) bool {
+		return handler.(WatchArticleHook).OnWatchArticle(user, page, status)
+	})
+}
+
+/**
+ * After a watch is added to an article.
+ */
+type WatchArticleCompleteHook interface {
+	/**
+	 * @param mixed &$user user that watched
+	 * @param mixed &$page WikiPage object watched
+	 * @return bool False to abort the hook
+	 */
+	OnWatchArticleComplete(user *interface{}, page *interface{}) bool
+}
+
+/**
+ * Run the WatchArticleComplete hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnWatchArticleComplete(user *interface{}, page *interface{}) bool {
+	args := []interface{}{user, page}
+	return r.container.run("WatchArticleComplete", args, func(handler interface{}) bool {
+		return handler.(WatchArticleCompleteHook).OnWatchArticleComplete(user, page)
+	})
+}
+
+/**
+ * Create a WatchedItemQueryServiceExtension.
+ */
+type WatchedItemQueryServiceExtensionsHook interface {
+	/**
+	 * @param mixed &$extensions Add WatchedItemQueryServiceExtension objects to this array
+	 * @param mixed $watchedItemQueryService Service object
+	 * @return bool False to abort the hook
+	 */
+	OnWatchedItemQueryServiceExtensions(extensions *interface{}, watchedItemQueryService interface{}) bool
+}
+
+/**
+ * Run the WatchedItemQueryServiceExtensions hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnWatchedItemQueryServiceExtensions(extensions *interface{}, watchedItemQueryService interface{}) bool {
+	args := []interface{}{extensions, watchedItemQueryService}
+	return r.container.run("WatchedItemQueryServiceExtensions", args, func(handler interface{}) bool {
+		return handler.(WatchedItemQueryServiceExtensionsHook).OnWatchedItemQueryServiceExtensions(extensions, watchedItemQueryService)
+	})
+}
+
+/**
+ * Before building the Special:EditWatchlist
+ * form, used to manipulate the list of pages or preload data based on that list.
+ */
+type WatchlistEditorBeforeFormRenderHook interface {
+	/**
+	 * @param mixed &$watchlistInfo array of watchlisted pages in [namespaceId => ['title1' => 1, 'title2' => 1]] format
+	 * @return bool False to abort the hook
+	 */
+	OnWatchlistEditorBeforeFormRender(watchlistInfo *interface{}) bool
+}
+
+/**
+ * Run the WatchlistEditorBeforeFormRender hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnWatchlistEditorBeforeFormRender(watchlistInfo *interface{}) bool {
+	args := []interface{}{watchlistInfo}
+	return r.container.run("WatchlistEditorBeforeFormRender", args, func(handler interface{}) bool {
+		return handler.(WatchlistEditorBeforeFormRenderHook).OnWatchlistEditorBeforeFormRender(watchlistInfo)
+	})
+}
+
+/**
+ * when building remove lines in
+ * Special:Watchlist/edit.
+ */
+type WatchlistEditorBuildRemoveLineHook interface {
+	/**
+	 * @param mixed &$tools array of extra links
+	 * @param mixed $title Title object
+	 * @param mixed $redirect whether the page is a redirect
+	 * @param mixed $skin Skin object
+	 * @param mixed &$link HTML link to title
+	 * @return bool False to abort the hook
+	 */
+	OnWatchlistEditorBuildRemoveLine(tools *interface{}, title interface{}, redirect interface{}, skin interface{}, link *interface{}) bool
+}
+
+/**
+ * Run the WatchlistEditorBuildRemoveLine hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnWatchlistEditorBuildRemoveLine(tools *interface{}, title interface{}, redirect interface{}, skin interface{}, link *interface{}) bool {
+	args := []interface{}{tools, title, redirect, skin, link}
+	return r.container.run("WatchlistEditorBuildRemoveLine", args, func(handler interface{}) bool {
+		return handler.(WatchlistEditorBuildRemoveLineHook).OnWatchlistEditorBuildRemoveLine(tools, title, redirect, skin, link)
+	})
+}
+
+/**
+ * While building the PathRouter to parse the
+ * REQUEST_URI.
+ */
+type WebRequestPathInfoRouterHook interface {
+	/**
+	 * @param mixed $router The PathRouter instance
+	 * @return bool False to abort the hook
+	 */
+	OnWebRequestPathInfoRouter(router interface{}) bool
+}
+
+/**
+ * Run the WebRequestPathInfoRouter hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnWebRequestPathInfoRouter(router interface{}) bool {
+	args := []interface{}{router}
+	return r.container.run("WebRequestPathInfoRouter", args, func(handler interface{}) bool {
+		return handler.(WebRequestPathInfoRouterHook).OnWebRequestPathInfoRouter(router)
+	})
+}
+
+/**
+ * when setting a cookie in WebResponse::setcookie().
+ * Return false to prevent setting of the cookie.
+ */
+type WebResponseSetCookieHook interface {
+	/**
+	 * @param mixed &$name Cookie name passed to WebResponse::setcookie()
+	 * @param mixed &$value Cookie value passed to WebResponse::setcookie()
+	 * @param mixed &$expire Cookie expiration, as for PHP's setcookie()
+	 * @param mixed &$options Options passed to WebResponse::setcookie()
+	 * @return bool False to abort the hook
+	 */
+	OnWebResponseSetCookie(name *interface{}, value *interface{}, expire *interface{}, options *interface{}) bool
+}
+
+/**
+ * Run the WebResponseSetCookie hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnWebResponseSetCookie(name *interface{}, value *interface{}, expire *interface{}, options *interface{}) bool {
+	args := []interface{}{name, value, expire, options}
+	return r.container.run("WebResponseSetCookie", args, func(handler interface{}) bool {
+		return handler.(WebResponseSetCookieHook).OnWebResponseSetCookie(name, value, expire, options)
+	})
+}
+
+/**
+ * Allows annotations to be added to WhatLinksHere
+ */
+type WhatLinksHerePropsHook interface {
+	/**
+	 * @param mixed $row The DB row of the entry.
+	 * @param mixed $title The Title of the page where the link comes FROM
+	 * @param mixed $target The Title of the page where the link goes TO
+	 * @param mixed &$props Array of HTML strings to display after the title.
+	 * @return bool False to abort the hook
+	 */
+	OnWhatLinksHereProps(row interface{}, title interface{}, target interface{}, props *interface{}) bool
+}
+
+/**
+ * Run the WhatLinksHereProps hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnWhatLinksHereProps(row interface{}, title interface{}, target interface{}, props *interface{}) bool {
+	args := []interface{}{row, title, target, props}
+	return r.container.run("WhatLinksHereProps", args, func(handler interface{}) bool {
+		return handler.(WhatLinksHerePropsHook).OnWhatLinksHereProps(row, title, target, props)
+	})
+}
+
+/**
+ * Get the SELECT query for "stable" revisions
+ * dumps. One, and only one hook should set this, and return false.
+ */
+type WikiExporter__dumpStableQueryHook interface {
+	/**
+	 * @param mixed &$tables Database tables to use in the SELECT query
+	 * @param mixed &$opts Options to use for the query
+	 * @param mixed &$join Join conditions
+	 * @return bool False to abort the hook
+	 */
+	OnWikiExporter__dumpStableQuery(tables *interface{}, opts *interface{}, join *interface{}) bool
+}
+
+/**
+ * Run the WikiExporter::dumpStableQuery hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnWikiExporter__dumpStableQuery(tables *interface{}, opts *interface{}, join *interface{}) bool {
+	args := []interface{}{tables, opts, join}
+	return r.container.run("WikiExporter::dumpStableQuery", args, func(handler interface{}) bool {
+		return handler.(WikiExporter__dumpStableQueryHook).OnWikiExporter__dumpStableQuery(tables, opts, join)
+	})
+}
+
+/**
+ * DEPRECATED! Use PageDeletionDataUpdates or
+ * override ContentHandler::getDeletionDataUpdates instead.
+ * Manipulates the list of DeferrableUpdates to be applied when a page is deleted.
+ */
+type WikiPageDeletionUpdatesHook interface {
+	/**
+	 * @param mixed $page the WikiPage
+	 * @param mixed $content the Content to generate updates for, or null in case the page revision could not be loaded. The delete will succeed despite this.
+	 * @param mixed &$updates the array of objects that implement DeferrableUpdate. Hook function may want to add to it.
+	 * @return bool False to abort the hook
+	 */
+	OnWikiPageDeletionUpdates(page interface{}, content interface{}, updates *interface{}) bool
+}
+
+/**
+ * Run the WikiPageDeletionUpdates hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnWikiPageDeletionUpdates(page interface{}, content interface{}, updates *interface{}) bool {
+	args := []interface{}{page, content, updates}
+	return r.container.run("WikiPageDeletionUpdates", args, func(handler interface{}) bool {
+		return handler.(WikiPageDeletionUpdatesHook).OnWikiPageDeletionUpdates(page, content, updates)
+	})
+}
+
+/**
+ * Override WikiPage class used for a title
+ */
+type WikiPageFactoryHook interface {
+	/**
+	 * @param mixed $title Title of the page
+	 * @param mixed &$page Variable to set the created WikiPage to.
+	 * @return bool False to abort the hook
+	 */
+	OnWikiPageFactory(title interface{}, page *interface{}) bool
+}
+
+/**
+ * Run the WikiPageFactory hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnWikiPageFactory(title interface{}, page *interface{}) bool {
+	args := []interface{}{title, page}
+	return r.container.run("WikiPageFactory", args, func(handler interface{}) bool {
+		return handler.(WikiPageFactoryHook).OnWikiPageFactory(title, page)
+	})
+}
+
+/**
+ * Called at the end of XmlDumpWriter::openPage, to allow
+ * extra metadata to be added.
+ */
+type XmlDumpWriterOpenPageHook interface {
+	/**
+	 * @param mixed $obj The XmlDumpWriter object.
+	 * @param mixed &$out The output string.
+	 * @param mixed $row The database row for the page.
+	 * @param mixed $title The title of the page.
+	 * @return bool False to abort the hook
+	 */
+	OnXmlDumpWriterOpenPage(obj interface{}, out *interface{}, row interface{}, title interface{}) bool
+}
+
+/**
+ * Run the XmlDumpWriterOpenPage hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnXmlDumpWriterOpenPage(obj interface{}, out *interface{}, row interface{}, title interface{}) bool {
+	args := []interface{}{obj, out, row, title}
+	return r.container.run("XmlDumpWriterOpenPage", args, func(handler interface{}) bool {
+		return handler.(XmlDumpWriterOpenPageHook).OnXmlDumpWriterOpenPage(obj, out, row, title)
+	})
+}
+
+/**
+ * Called at the end of a revision in an XML dump, to
+ * add extra metadata.
+ */
+type XmlDumpWriterWriteRevisionHook interface {
+	/**
+	 * @param mixed &$obj The XmlDumpWriter object.
+	 * @param mixed &$out The text being output.
+	 * @param mixed $row The database row for the revision.
+	 * @param mixed $text The revision text.
+	 * @return bool False to abort the hook
+	 */
+	OnXmlDumpWriterWriteRevision(obj *interface{}, out *interface{}, row interface{}, text interface{}) bool
+}
+
+/**
+ * Run the XmlDumpWriterWriteRevision hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnXmlDumpWriterWriteRevision(obj *interface{}, out *interface{}, row interface{}, text interface{}) bool {
+	args := []interface{}{obj, out, row, text}
+	return r.container.run("XmlDumpWriterWriteRevision", args, func(handler interface{}) bool {
+		return handler.(XmlDumpWriterWriteRevisionHook).OnXmlDumpWriterWriteRevision(obj, out, row, text)
+	})
+}
+
+/**
+ * Add a permissions error when permissions errors are
+ * checked for. Use instead of userCan for most cases. Return false if the user
+ * can't do it, and populate $result with the reason in the form of
+ * [ messagename, param1, param2, ... ] or a MessageSpecifier instance (you
+ * might want to use ApiMessage to provide machine-readable details for the API).
+ * For consistency, error messages
+ * should be plain text with no special coloring, bolding, etc. to show that
+ * they're errors; presenting them properly to the user as errors is done by the
+ * caller.
+ */
+type GetUserPermissionsErrorsHook interface {
+	/**
+	 * @param mixed &$title Title object being checked against
+	 * @param mixed &$user Current user object
+	 * @param mixed $action Action being checked
+	 * @param mixed &$result User permissions error to add. If none, return true.
+	 * @return bool False to abort the hook
+	 */
+	OnGetUserPermissionsErrors(title *interface{}, user *interface{}, action interface{}, result *interface{}) bool
+}
+
+/**
+ * Run the getUserPermissionsErrors hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetUserPermissionsErrors(title *interface{}, user *interface{}, action interface{}, result *interface{}) bool {
+	args := []interface{}{title, user, action, result}
+	return r.container.run("getUserPermissionsErrors", args, func(handler interface{}) bool {
+		return handler.(GetUserPermissionsErrorsHook).OnGetUserPermissionsErrors(title, user, action, result)
+	})
+}
+
+/**
+ * Equal to getUserPermissionsErrors, but is
+ * called only if expensive checks are enabled. Add a permissions error when
+ * permissions errors are checked for. Return false if the user can't do it, and
+ * populate $result with the reason in the form of [ messagename, param1, param2,
+ * ... ] or a MessageSpecifier instance (you might want to use ApiMessage to
+ * provide machine-readable details for the API). For consistency, error messages
+ * should be plain text with no special coloring, bolding, etc. to show that
+ * they're errors; presenting them properly to the user as errors is done by the
+ * caller.
+ */
+type GetUserPermissionsErrorsExpensiveHook interface {
+	/**
+	 * @param mixed &$title Title object being checked against
+	 * @param mixed &$user Current user object
+	 * @param mixed $action Action being checked
+	 * @param mixed &$result User permissions error to add. If none, return true.
+	 * @return bool False to abort the hook
+	 */
+	OnGetUserPermissionsErrorsExpensive(title *interface{}, user *interface{}, action interface{}, result *interface{}) bool
+}
+
+/**
+ * Run the getUserPermissionsErrorsExpensive hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnGetUserPermissionsErrorsExpensive(title *interface{}, user *interface{}, action interface{}, result *interface{}) bool {
+	args := []interface{}{title, user, action, result}
+	return r.container.run("getUserPermissionsErrorsExpensive", args, func(handler interface{}) bool {
+		return handler.(GetUserPermissionsErrorsExpensiveHook).OnGetUserPermissionsErrorsExpensive(title, user, action, result)
+	})
+}
+
+/**
+ * Override the result of Sanitizer::validateEmail(), for
+ * instance to return false if the domain name doesn't match your organization.
+ */
+type IsValidEmailAddrHook interface {
+	/**
+	 * @param mixed $addr The e-mail address entered by the user
+	 * @param mixed &$result Set this and return false to override the internal checks
+	 * @return bool False to abort the hook
+	 */
+	OnIsValidEmailAddr(addr interface{}, result *interface{}) bool
+}
+
+/**
+ * Run the isValidEmailAddr hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnIsValidEmailAddr(addr interface{}, result *interface{}) bool {
+	args := []interface{}{addr, result}
+	return r.container.run("isValidEmailAddr", args, func(handler interface{}) bool {
+		return handler.(IsValidEmailAddrHook).OnIsValidEmailAddr(addr, result)
+	})
+}
+
+/**
+ * Override the result of User::isValidPassword()
+ */
+type IsValidPasswordHook interface {
+	/**
+	 * @param mixed $password The password entered by the user
+	 * @param mixed &$result Set this and return false to override the internal checks
+	 * @param mixed $user User the password is being validated for
+	 * @return bool False to abort the hook
+	 */
+	OnIsValidPassword(password interface{}, result *interface{}, user interface{}) bool
+}
+
+/**
+ * Run the isValidPassword hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnIsValidPassword(password interface{}, result *interface{}, user interface{}) bool {
+	args := []interface{}{password, result, user}
+	return r.container.run("isValidPassword", args, func(handler interface{}) bool {
+		return handler.(IsValidPasswordHook).OnIsValidPassword(password, result, user)
+	})
+}
+
+/**
+ * To interrupt/advise the "user can do X to Y article" check. If you
+ * want to display an error message, try getUserPermissionsErrors.
+ */
+type UserCanHook interface {
+	/**
+	 * @param mixed &$title Title object being checked against
+	 * @param mixed &$user Current user object
+	 * @param mixed $action Action being checked
+	 * @param mixed &$result Pointer to result returned if hook returns false. If null is returned, userCan checks are continued by internal code.
+	 * @return bool False to abort the hook
+	 */
+	OnUserCan(title *interface{}, user *interface{}, action interface{}, result *interface{}) bool
+}
+
+/**
+ * Run the userCan hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnUserCan(title *interface{}, user *interface{}, action interface{}, result *interface{}) bool {
+	args := []interface{}{title, user, action, result}
+	return r.container.run("userCan", args, func(handler interface{}) bool {
+		return handler.(UserCanHook).OnUserCan(title, user, action, result)
+	})
+}
+
+/**
+ * Called when generating a shell-escaped command line string to
+ * run a MediaWiki cli script.
+ */
+type WfShellWikiCmdHook interface {
+	/**
+	 * @param mixed &$script MediaWiki cli script path
+	 * @param mixed &$parameters Array of arguments and options to the script
+	 * @param mixed &$options Associative array of options, may contain the 'php' and 'wrapper' keys
+	 * @return bool False to abort the hook
+	 */
+	OnWfShellWikiCmd(script *interface{}, parameters *interface{}, options *interface{}) bool
+}
+
+/**
+ * Run the wfShellWikiCmd hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnWfShellWikiCmd(script *interface{}, parameters *interface{}, options *interface{}) bool {
+	args := []interface{}{script, parameters, options}
+	return r.container.run("wfShellWikiCmd", args, func(handler interface{}) bool {
+		return handler.(WfShellWikiCmdHook).OnWfShellWikiCmd(script, parameters, options)
+	})
+}
+
+/**
+ * Called when initialising list of QueryPage subclasses, use this
+ * to add new query pages to be updated with maintenance/updateSpecialPages.php.
+ */
+type WgQueryPagesHook interface {
+	/**
+	 * @param mixed &$qp The list of QueryPages
+	 * @return bool False to abort the hook
+	 */
+	OnWgQueryPages(qp *interface{}) bool
+}
+
+/**
+ * Run the wgQueryPages hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) OnWgQueryPages(qp *interface{}) bool {
+	args := []interface{}{qp}
+	return r.container.run("wgQueryPages", args, func(handler interface{}) bool {
+		return handler.(WgQueryPagesHook).OnWgQueryPages(qp)
+	})
+}
+
+/**
+ * Interfaces of the hooks, by hook name
+ * @var array Map of (hook name => interface type)
+ */
+var hookInterfaces = map[string]reflect.Type{
+	"APIAfterExecute":                                        reflect.TypeOf((*APIAfterExecuteHook)(nil)).Elem(),
+	"APIEditBeforeSave":                                      reflect.TypeOf((*APIEditBeforeSaveHook)(nil)).Elem(),
+	"APIGetAllowedParams":                                    reflect.TypeOf((*APIGetAllowedParamsHook)(nil)).Elem(),
+	"APIGetDescription":                                      reflect.TypeOf((*APIGetDescriptionHook)(nil)).Elem(),
+	"APIGetDescriptionMessages":                              reflect.TypeOf((*APIGetDescriptionMessagesHook)(nil)).Elem(),
+	"APIGetParamDescription":                                 reflect.TypeOf((*APIGetParamDescriptionHook)(nil)).Elem(),
+	"APIGetParamDescriptionMessages":                         reflect.TypeOf((*APIGetParamDescriptionMessagesHook)(nil)).Elem(),
+	"APIHelpModifyOutput":                                    reflect.TypeOf((*APIHelpModifyOutputHook)(nil)).Elem(),
+	"APIQueryAfterExecute":                                   reflect.TypeOf((*APIQueryAfterExecuteHook)(nil)).Elem(),
+	"APIQueryGeneratorAfterExecute":                          reflect.TypeOf((*APIQueryGeneratorAfterExecuteHook)(nil)).Elem(),
+	"APIQueryInfoTokens":                                     reflect.TypeOf((*APIQueryInfoTokensHook)(nil)).Elem(),
+	"APIQueryRecentChangesTokens":                            reflect.TypeOf((*APIQueryRecentChangesTokensHook)(nil)).Elem(),
+	"APIQueryRevisionsTokens":                                reflect.TypeOf((*APIQueryRevisionsTokensHook)(nil)).Elem(),
+	"APIQuerySiteInfoGeneralInfo":                            reflect.TypeOf((*APIQuerySiteInfoGeneralInfoHook)(nil)).Elem(),
+	"APIQuerySiteInfoStatisticsInfo":                         reflect.TypeOf((*APIQuerySiteInfoStatisticsInfoHook)(nil)).Elem(),
+	"APIQueryUsersTokens":                                    reflect.TypeOf((*APIQueryUsersTokensHook)(nil)).Elem(),
+	"AbortAutoAccount":                                       reflect.TypeOf((*AbortAutoAccountHook)(nil)).Elem(),
+	"AbortAutoblock":                                         reflect.TypeOf((*AbortAutoblockHook)(nil)).Elem(),
+	"AbortDiffCache":                                         reflect.TypeOf((*AbortDiffCacheHook)(nil)).Elem(),
+	"AbortEmailNotification":                                 reflect.TypeOf((*AbortEmailNotificationHook)(nil)).Elem(),
+	"AbortLogin":                                             reflect.TypeOf((*AbortLoginHook)(nil)).Elem(),
+	"AbortNewAccount":                                        reflect.TypeOf((*AbortNewAccountHook)(nil)).Elem(),
+	"AbortTalkPageEmailNotification":                         reflect.TypeOf((*AbortTalkPageEmailNotificationHook)(nil)).Elem(),
+	"ActionBeforeFormDisplay":                                reflect.TypeOf((*ActionBeforeFormDisplayHook)(nil)).Elem(),
+	"ActionModifyFormFields":                                 reflect.TypeOf((*ActionModifyFormFieldsHook)(nil)).Elem(),
+	"AddNewAccount":                                          reflect.TypeOf((*AddNewAccountHook)(nil)).Elem(),
+	"AfterBuildFeedLinks":                                    reflect.TypeOf((*AfterBuildFeedLinksHook)(nil)).Elem(),
+	"AfterFinalPageOutput":                                   reflect.TypeOf((*AfterFinalPageOutputHook)(nil)).Elem(),
+	"AfterImportPage":                                        reflect.TypeOf((*AfterImportPageHook)(nil)).Elem(),
+	"AfterParserFetchFileAndTitle":                           reflect.TypeOf((*AfterParserFetchFileAndTitleHook)(nil)).Elem(),
+	"AlternateEdit":                                          reflect.TypeOf((*AlternateEditHook)(nil)).Elem(),
+	"AlternateEditPreview":                                   reflect.TypeOf((*AlternateEditPreviewHook)(nil)).Elem(),
+	"AlternateUserMailer":                                    reflect.TypeOf((*AlternateUserMailerHook)(nil)).Elem(),
+	"AncientPagesQuery":                                      reflect.TypeOf((*AncientPagesQueryHook)(nil)).Elem(),
+	"ApiBeforeMain":                                          reflect.TypeOf((*ApiBeforeMainHook)(nil)).Elem(),
+	"ApiCheckCanExecute":                                     reflect.TypeOf((*ApiCheckCanExecuteHook)(nil)).Elem(),
+	"ApiDeprecationHelp":                                     reflect.TypeOf((*ApiDeprecationHelpHook)(nil)).Elem(),
+	"ApiFeedContributions::feedItem":                         reflect.TypeOf((*ApiFeedContributions__feedItemHook)(nil)).Elem(),
+	"ApiFormatHighlight":                                     reflect.TypeOf((*ApiFormatHighlightHook)(nil)).Elem(),
+	"ApiMain::moduleManager":                                 reflect.TypeOf((*ApiMain__moduleManagerHook)(nil)).Elem(),
+	"ApiMain::onException":                                   reflect.TypeOf((*ApiMain__onExceptionHook)(nil)).Elem(),
+	"ApiMakeParserOptions":                                   reflect.TypeOf((*ApiMakeParserOptionsHook)(nil)).Elem(),
+	"ApiMaxLagInfo":                                          reflect.TypeOf((*ApiMaxLagInfoHook)(nil)).Elem(),
+	"ApiOpenSearchSuggest":                                   reflect.TypeOf((*ApiOpenSearchSuggestHook)(nil)).Elem(),
+	"ApiParseMakeOutputPage":                                 reflect.TypeOf((*ApiParseMakeOutputPageHook)(nil)).Elem(),
+	"ApiQuery::moduleManager":                                reflect.TypeOf((*ApiQuery__moduleManagerHook)(nil)).Elem(),
+	"ApiQueryBaseAfterQuery":                                 reflect.TypeOf((*ApiQueryBaseAfterQueryHook)(nil)).Elem(),
+	"ApiQueryBaseBeforeQuery":                                reflect.TypeOf((*ApiQueryBaseBeforeQueryHook)(nil)).Elem(),
+	"ApiQueryBaseProcessRow":                                 reflect.TypeOf((*ApiQueryBaseProcessRowHook)(nil)).Elem(),
+	"ApiQueryTokensRegisterTypes":                            reflect.TypeOf((*ApiQueryTokensRegisterTypesHook)(nil)).Elem(),
+	"ApiQueryWatchlistExtractOutputData":                     reflect.TypeOf((*ApiQueryWatchlistExtractOutputDataHook)(nil)).Elem(),
+	"ApiQueryWatchlistPrepareWatchedItemQueryServiceOptions": reflect.TypeOf((*ApiQueryWatchlistPrepareWatchedItemQueryServiceOptionsHook)(nil)).Elem(),
+	"ApiRsdServiceApis":                                      reflect.TypeOf((*ApiRsdServiceApisHook)(nil)).Elem(),
+	"ApiTokensGetTokenTypes":                                 reflect.TypeOf((*ApiTokensGetTokenTypesHook)(nil)).Elem(),
+	"ApiValidatePassword":                                    reflect.TypeOf((*ApiValidatePasswordHook)(nil)).Elem(),
+	"Article::MissingArticleConditions":                      reflect.TypeOf((*Article__MissingArticleConditionsHook)(nil)).Elem(),
+	"ArticleAfterFetchContentObject":                         reflect.TypeOf((*ArticleAfterFetchContentObjectHook)(nil)).Elem(),
+	"ArticleConfirmDelete":                                   reflect.TypeOf((*ArticleConfirmDeleteHook)(nil)).Elem(),
+	"ArticleContentOnDiff":                                   reflect.TypeOf((*ArticleContentOnDiffHook)(nil)).Elem(),
+	"ArticleContentViewCustom":                               reflect.TypeOf((*ArticleContentViewCustomHook)(nil)).Elem(),
+	"ArticleDelete":                                          reflect.TypeOf((*ArticleDeleteHook)(nil)).Elem(),
+	"ArticleDeleteAfterSuccess":                              reflect.TypeOf((*ArticleDeleteAfterSuccessHook)(nil)).Elem(),
+	"ArticleDeleteComplete":                                  reflect.TypeOf((*ArticleDeleteCompleteHook)(nil)).Elem(),
+	"ArticleEditUpdateNewTalk":                               reflect.TypeOf((*ArticleEditUpdateNewTalkHook)(nil)).Elem(),
+	"ArticleEditUpdates":                                     reflect.TypeOf((*ArticleEditUpdatesHook)(nil)).Elem(),
+	"ArticleEditUpdatesDeleteFromRecentchanges":              reflect.TypeOf((*ArticleEditUpdatesDeleteFromRecentchangesHook)(nil)).Elem(),
+	"ArticleFromTitle":                                       reflect.TypeOf((*ArticleFromTitleHook)(nil)).Elem(),
+	"ArticleMergeComplete":                                   reflect.TypeOf((*ArticleMergeCompleteHook)(nil)).Elem(),
+	"ArticlePageDataAfter":                                   reflect.TypeOf((*ArticlePageDataAfterHook)(nil)).Elem(),
+	"ArticlePageDataBefore":                                  reflect.TypeOf((*ArticlePageDataBeforeHook)(nil)).Elem(),
+	"ArticlePrepareTextForEdit":                              reflect.TypeOf((*ArticlePrepareTextForEditHook)(nil)).Elem(),
+	"ArticleProtect":                                         reflect.TypeOf((*ArticleProtectHook)(nil)).Elem(),
+	"ArticleProtectComplete":                                 reflect.TypeOf((*ArticleProtectCompleteHook)(nil)).Elem(),
+	"ArticlePurge":                                           reflect.TypeOf((*ArticlePurgeHook)(nil)).Elem(),
+	"ArticleRevisionUndeleted":                               reflect.TypeOf((*ArticleRevisionUndeletedHook)(nil)).Elem(),
+	"ArticleRevisionViewCustom":                              reflect.TypeOf((*ArticleRevisionViewCustomHook)(nil)).Elem(),
+	"ArticleRevisionVisibilitySet":                           reflect.TypeOf((*ArticleRevisionVisibilitySetHook)(nil)).Elem(),
+	"ArticleRollbackComplete":                                reflect.TypeOf((*ArticleRollbackCompleteHook)(nil)).Elem(),
+	"ArticleShowPatrolFooter":                                reflect.TypeOf((*ArticleShowPatrolFooterHook)(nil)).Elem(),
+	"ArticleUndelete":                                        reflect.TypeOf((*ArticleUndeleteHook)(nil)).Elem(),
+	"ArticleUndeleteLogEntry":                                reflect.TypeOf((*ArticleUndeleteLogEntryHook)(nil)).Elem(),
+	"ArticleUpdateBeforeRedirect":                            reflect.TypeOf((*ArticleUpdateBeforeRedirectHook)(nil)).Elem(),
+	"ArticleViewFooter":                                      reflect.TypeOf((*ArticleViewFooterHook)(nil)).Elem(),
+	"ArticleViewHeader":                                      reflect.TypeOf((*ArticleViewHeaderHook)(nil)).Elem(),
+	"ArticleViewRedirect":                                    reflect.TypeOf((*ArticleViewRedirectHook)(nil)).Elem(),
+	"AuthChangeFormFields":                                   reflect.TypeOf((*AuthChangeFormFieldsHook)(nil)).Elem(),
+	"AuthManagerLoginAuthenticateAudit":                      reflect.TypeOf((*AuthManagerLoginAuthenticateAuditHook)(nil)).Elem(),
+	"AuthPluginAutoCreate":                                   reflect.TypeOf((*AuthPluginAutoCreateHook)(nil)).Elem(),
+	"AuthPluginSetup":                                        reflect.TypeOf((*AuthPluginSetupHook)(nil)).Elem(),
+	"AutopromoteCondition":                                   reflect.TypeOf((*AutopromoteConditionHook)(nil)).Elem(),
+	"BacklinkCacheGetConditions":                             reflect.TypeOf((*BacklinkCacheGetConditionsHook)(nil)).Elem(),
+	"BacklinkCacheGetPrefix":                                 reflect.TypeOf((*BacklinkCacheGetPrefixHook)(nil)).Elem(),
+	"BadImage":                                               reflect.TypeOf((*BadImageHook)(nil)).Elem(),
+	"BaseTemplateAfterPortlet":                               reflect.TypeOf((*BaseTemplateAfterPortletHook)(nil)).Elem(),
+	"BaseTemplateToolbox":                                    reflect.TypeOf((*BaseTemplateToolboxHook)(nil)).Elem(),
+	"BeforeDisplayNoArticleText":                             reflect.TypeOf((*BeforeDisplayNoArticleTextHook)(nil)).Elem(),
+	"BeforeHttpsRedirect":                                    reflect.TypeOf((*BeforeHttpsRedirectHook)(nil)).Elem(),
+	"BeforeInitialize":                                       reflect.TypeOf((*BeforeInitializeHook)(nil)).Elem(),
+	"BeforePageDisplay":                                      reflect.TypeOf((*BeforePageDisplayHook)(nil)).Elem(),
+	"BeforePageRedirect":                                     reflect.TypeOf((*BeforePageRedirectHook)(nil)).Elem(),
+	"BeforeParserFetchFileAndTitle":                          reflect.TypeOf((*BeforeParserFetchFileAndTitleHook)(nil)).Elem(),
+	"BeforeParserFetchTemplateAndtitle":                      reflect.TypeOf((*BeforeParserFetchTemplateAndtitleHook)(nil)).Elem(),
+	"BeforeParserrenderImageGallery":                         reflect.TypeOf((*BeforeParserrenderImageGalleryHook)(nil)).Elem(),
+	"BeforeWelcomeCreation":                                  reflect.TypeOf((*BeforeWelcomeCreationHook)(nil)).Elem(),
+	"BitmapHandlerCheckImageArea":                            reflect.TypeOf((*BitmapHandlerCheckImageAreaHook)(nil)).Elem(),
+	"BitmapHandlerTransform":                                 reflect.TypeOf((*BitmapHandlerTransformHook)(nil)).Elem(),
+	"BlockIp":                                                reflect.TypeOf((*BlockIpHook)(nil)).Elem(),
+	"BlockIpComplete":                                        reflect.TypeOf((*BlockIpCompleteHook)(nil)).Elem(),
+	"BookInformation":                                        reflect.TypeOf((*BookInformationHook)(nil)).Elem(),
+	"CanIPUseHTTPS":                                          reflect.TypeOf((*CanIPUseHTTPSHook)(nil)).Elem(),
+	"CanonicalNamespaces":                                    reflect.TypeOf((*CanonicalNamespacesHook)(nil)).Elem(),
+	"CategoryAfterPageAdded":                                 reflect.TypeOf((*CategoryAfterPageAddedHook)(nil)).Elem(),
+	"CategoryAfterPageRemoved":                               reflect.TypeOf((*CategoryAfterPageRemovedHook)(nil)).Elem(),
+	"CategoryPageView":                                       reflect.TypeOf((*CategoryPageViewHook)(nil)).Elem(),
+	"CategoryViewer::doCategoryQuery":                        reflect.TypeOf((*CategoryViewer__doCategoryQueryHook)(nil)).Elem(),
+	"CategoryViewer::generateLink":                           reflect.TypeOf((*CategoryViewer__generateLinkHook)(nil)).Elem(),
+	"ChangeAuthenticationDataAudit":                          reflect.TypeOf((*ChangeAuthenticationDataAuditHook)(nil)).Elem(),
+	"ChangePasswordForm":                                     reflect.TypeOf((*ChangePasswordFormHook)(nil)).Elem(),
+	"ChangeTagAfterDelete":                                   reflect.TypeOf((*ChangeTagAfterDeleteHook)(nil)).Elem(),
+	"ChangeTagCanCreate":                                     reflect.TypeOf((*ChangeTagCanCreateHook)(nil)).Elem(),
+	"ChangeTagCanDelete":                                     reflect.TypeOf((*ChangeTagCanDeleteHook)(nil)).Elem(),
+	"ChangeTagsAfterUpdateTags":                              reflect.TypeOf((*ChangeTagsAfterUpdateTagsHook)(nil)).Elem(),
+	"ChangeTagsAllowedAdd":                                   reflect.TypeOf((*ChangeTagsAllowedAddHook)(nil)).Elem(),
+	"ChangeTagsListActive":                                   reflect.TypeOf((*ChangeTagsListActiveHook)(nil)).Elem(),
+	"ChangeUserGroups":                                       reflect.TypeOf((*ChangeUserGroupsHook)(nil)).Elem(),
+	"ChangesListInitRows":                                    reflect.TypeOf((*ChangesListInitRowsHook)(nil)).Elem(),
+	"ChangesListInsertArticleLink":                           reflect.TypeOf((*ChangesListInsertArticleLinkHook)(nil)).Elem(),
+	"ChangesListSpecialPageQuery":                            reflect.TypeOf((*ChangesListSpecialPageQueryHook)(nil)).Elem(),
+	"ChangesListSpecialPageStructuredFilters":                reflect.TypeOf((*ChangesListSpecialPageStructuredFiltersHook)(nil)).Elem(),
+	"Collation::factory":                                     reflect.TypeOf((*Collation__factoryHook)(nil)).Elem(),
+	"ConfirmEmailComplete":                                   reflect.TypeOf((*ConfirmEmailCompleteHook)(nil)).Elem(),
+	"ContentAlterParserOutput":                               reflect.TypeOf((*ContentAlterParserOutputHook)(nil)).Elem(),
+	"ContentGetParserOutput":                                 reflect.TypeOf((*ContentGetParserOutputHook)(nil)).Elem(),
+	"ContentHandlerDefaultModelFor":                          reflect.TypeOf((*ContentHandlerDefaultModelForHook)(nil)).Elem(),
+	"ContentHandlerForModelID":                               reflect.TypeOf((*ContentHandlerForModelIDHook)(nil)).Elem(),
+	"ContentModelCanBeUsedOn":                                reflect.TypeOf((*ContentModelCanBeUsedOnHook)(nil)).Elem(),
+	"ContentSecurityPolicyDefaultSource":                     reflect.TypeOf((*ContentSecurityPolicyDefaultSourceHook)(nil)).Elem(),
+	"ContentSecurityPolicyDirectives":                        reflect.TypeOf((*ContentSecurityPolicyDirectivesHook)(nil)).Elem(),
+	"ContentSecurityPolicyScriptSource":                      reflect.TypeOf((*ContentSecurityPolicyScriptSourceHook)(nil)).Elem(),
+	"ContribsPager::getQueryInfo":                            reflect.TypeOf((*ContribsPager__getQueryInfoHook)(nil)).Elem(),
+	"ContribsPager::reallyDoQuery":                           reflect.TypeOf((*ContribsPager__reallyDoQueryHook)(nil)).Elem(),
+	"ContributionsLineEnding":                                reflect.TypeOf((*ContributionsLineEndingHook)(nil)).Elem(),
+	"ContributionsToolLinks":                                 reflect.TypeOf((*ContributionsToolLinksHook)(nil)).Elem(),
+	"ConvertContent":                                         reflect.TypeOf((*ConvertContentHook)(nil)).Elem(),
+	"CustomEditor":                                           reflect.TypeOf((*CustomEditorHook)(nil)).Elem(),
+	"DatabaseOraclePostInit":                                 reflect.TypeOf((*DatabaseOraclePostInitHook)(nil)).Elem(),
+	"DeleteUnknownPreferences":                               reflect.TypeOf((*DeleteUnknownPreferencesHook)(nil)).Elem(),
+	"DeletedContribsPager::reallyDoQuery":                    reflect.TypeOf((*DeletedContribsPager__reallyDoQueryHook)(nil)).Elem(),
+	"DeletedContributionsLineEnding":                         reflect.TypeOf((*DeletedContributionsLineEndingHook)(nil)).Elem(),
+	"DiffRevisionTools":                                      reflect.TypeOf((*DiffRevisionToolsHook)(nil)).Elem(),
+	"DiffViewHeader":                                         reflect.TypeOf((*DiffViewHeaderHook)(nil)).Elem(),
+	"DifferenceEngineAfterLoadNewText":                       reflect.TypeOf((*DifferenceEngineAfterLoadNewTextHook)(nil)).Elem(),
+	"DifferenceEngineLoadTextAfterNewContentIsLoaded":        reflect.TypeOf((*DifferenceEngineLoadTextAfterNewContentIsLoadedHook)(nil)).Elem(),
+	"DifferenceEngineMarkPatrolledLink":                      reflect.TypeOf((*DifferenceEngineMarkPatrolledLinkHook)(nil)).Elem(),
+	"DifferenceEngineMarkPatrolledRCID":                      reflect.TypeOf((*DifferenceEngineMarkPatrolledRCIDHook)(nil)).Elem(),
+	"DifferenceEngineNewHeader":                              reflect.TypeOf((*DifferenceEngineNewHeaderHook)(nil)).Elem(),
+	"DifferenceEngineOldHeader":                              reflect.TypeOf((*DifferenceEngineOldHeaderHook)(nil)).Elem(),
+	"DifferenceEngineOldHeaderNoOldRev":                      reflect.TypeOf((*DifferenceEngineOldHeaderNoOldRevHook)(nil)).Elem(),
+	"DifferenceEngineRenderRevisionAddParserOutput":          reflect.TypeOf((*DifferenceEngineRenderRevisionAddParserOutputHook)(nil)).Elem(),
+	"DifferenceEngineRenderRevisionShowFinalPatrolLink":      reflect.TypeOf((*DifferenceEngineRenderRevisionShowFinalPatrolLinkHook)(nil)).Elem(),
+	"DifferenceEngineShowDiff":                               reflect.TypeOf((*DifferenceEngineShowDiffHook)(nil)).Elem(),
+	"DifferenceEngineShowDiffPage":                           reflect.TypeOf((*DifferenceEngineShowDiffPageHook)(nil)).Elem(),
+	"DifferenceEngineShowDiffPageMaybeShowMissingRevision":   reflect.TypeOf((*DifferenceEngineShowDiffPageMaybeShowMissingRevisionHook)(nil)).Elem(),
+	"DifferenceEngineShowEmptyOldContent":                    reflect.TypeOf((*DifferenceEngineShowEmptyOldContentHook)(nil)).Elem(),
+	"DisplayOldSubtitle":                                     reflect.TypeOf((*DisplayOldSubtitleHook)(nil)).Elem(),
+	"EditFilter":                                             reflect.TypeOf((*EditFilterHook)(nil)).Elem(),
+	"EditFilterMergedContent":                                reflect.TypeOf((*EditFilterMergedContentHook)(nil)).Elem(),
+	"EditFormInitialText":                                    reflect.TypeOf((*EditFormInitialTextHook)(nil)).Elem(),
+	"EditFormPreloadText":                                    reflect.TypeOf((*EditFormPreloadTextHook)(nil)).Elem(),
+	"EditPage::attemptSave":                                  reflect.TypeOf((*EditPage__attemptSaveHook)(nil)).Elem(),
+	"EditPage::attemptSave:after":                            reflect.TypeOf((*EditPage__attemptSave_afterHook)(nil)).Elem(),
+	"EditPage::importFormData":                               reflect.TypeOf((*EditPage__importFormDataHook)(nil)).Elem(),
+	"EditPage::showEditForm:fields":                          reflect.TypeOf((*EditPage__showEditForm_fieldsHook)(nil)).Elem(),
+	"EditPage::showEditForm:initial":                         reflect.TypeOf((*EditPage__showEditForm_initialHook)(nil)).Elem(),
+	"EditPage::showReadOnlyForm:initial":                     reflect.TypeOf((*EditPage__showReadOnlyForm_initialHook)(nil)).Elem(),
+	"EditPage::showStandardInputs:options":                   reflect.TypeOf((*EditPage__showStandardInputs_optionsHook)(nil)).Elem(),
+	"EditPageBeforeConflictDiff":                             reflect.TypeOf((*EditPageBeforeConflictDiffHook)(nil)).Elem(),
+	"EditPageBeforeEditButtons":                              reflect.TypeOf((*EditPageBeforeEditButtonsHook)(nil)).Elem(),
+	"EditPageBeforeEditToolbar":                              reflect.TypeOf((*EditPageBeforeEditToolbarHook)(nil)).Elem(),
+	"EditPageCopyrightWarning":                               reflect.TypeOf((*EditPageCopyrightWarningHook)(nil)).Elem(),
+	"EditPageGetCheckboxesDefinition":                        reflect.TypeOf((*EditPageGetCheckboxesDefinitionHook)(nil)).Elem(),
+	"EditPageGetDiffContent":                                 reflect.TypeOf((*EditPageGetDiffContentHook)(nil)).Elem(),
+	"EditPageGetPreviewContent":                              reflect.TypeOf((*EditPageGetPreviewContentHook)(nil)).Elem(),
+	"EditPageNoSuchSection":                                  reflect.TypeOf((*EditPageNoSuchSectionHook)(nil)).Elem(),
+	"EditPageTosSummary":                                     reflect.TypeOf((*EditPageTosSummaryHook)(nil)).Elem(),
+	"EmailConfirmed":                                         reflect.TypeOf((*EmailConfirmedHook)(nil)).Elem(),
+	"EmailUser":                                              reflect.TypeOf((*EmailUserHook)(nil)).Elem(),
+	"EmailUserCC":                                            reflect.TypeOf((*EmailUserCCHook)(nil)).Elem(),
+	"EmailUserComplete":                                      reflect.TypeOf((*EmailUserCompleteHook)(nil)).Elem(),
+	"EmailUserForm":                                          reflect.TypeOf((*EmailUserFormHook)(nil)).Elem(),
+	"EmailUserPermissionsErrors":                             reflect.TypeOf((*EmailUserPermissionsErrorsHook)(nil)).Elem(),
+	"EnhancedChangesList::getLogText":                        reflect.TypeOf((*EnhancedChangesList__getLogTextHook)(nil)).Elem(),
+	"EnhancedChangesListModifyBlockLineData":                 reflect.TypeOf((*EnhancedChangesListModifyBlockLineDataHook)(nil)).Elem(),
+	"EnhancedChangesListModifyLineData":                      reflect.TypeOf((*EnhancedChangesListModifyLineDataHook)(nil)).Elem(),
+	"ExemptFromAccountCreationThrottle":                      reflect.TypeOf((*ExemptFromAccountCreationThrottleHook)(nil)).Elem(),
+	"ExtensionTypes":                                         reflect.TypeOf((*ExtensionTypesHook)(nil)).Elem(),
+	"FetchChangesList":                                       reflect.TypeOf((*FetchChangesListHook)(nil)).Elem(),
+	"FileDeleteComplete":                                     reflect.TypeOf((*FileDeleteCompleteHook)(nil)).Elem(),
+	"FileTransformed":                                        reflect.TypeOf((*FileTransformedHook)(nil)).Elem(),
+	"FileUndeleteComplete":                                   reflect.TypeOf((*FileUndeleteCompleteHook)(nil)).Elem(),
+	"FileUpload":                                             reflect.TypeOf((*FileUploadHook)(nil)).Elem(),
+	"FormatAutocomments":                                     reflect.TypeOf((*FormatAutocommentsHook)(nil)).Elem(),
+	"GalleryGetModes":                                        reflect.TypeOf((*GalleryGetModesHook)(nil)).Elem(),
+	"GetAutoPromoteGroups":                                   reflect.TypeOf((*GetAutoPromoteGroupsHook)(nil)).Elem(),
+	"GetBlockedStatus":                                       reflect.TypeOf((*GetBlockedStatusHook)(nil)).Elem(),
+	"GetCacheVaryCookies":                                    reflect.TypeOf((*GetCacheVaryCookiesHook)(nil)).Elem(),
+	"GetCanonicalURL":                                        reflect.TypeOf((*GetCanonicalURLHook)(nil)).Elem(),
+	"GetContentModels":                                       reflect.TypeOf((*GetContentModelsHook)(nil)).Elem(),
+	"GetDefaultSortkey":                                      reflect.TypeOf((*GetDefaultSortkeyHook)(nil)).Elem(),
+	"GetDifferenceEngine":                                    reflect.TypeOf((*GetDifferenceEngineHook)(nil)).Elem(),
+	"GetDoubleUnderscoreIDs":                                 reflect.TypeOf((*GetDoubleUnderscoreIDsHook)(nil)).Elem(),
+	"GetExtendedMetadata":                                    reflect.TypeOf((*GetExtendedMetadataHook)(nil)).Elem(),
+	"GetFullURL":                                             reflect.TypeOf((*GetFullURLHook)(nil)).Elem(),
+	"GetHumanTimestamp":                                      reflect.TypeOf((*GetHumanTimestampHook)(nil)).Elem(),
+	"GetIP":                                                  reflect.TypeOf((*GetIPHook)(nil)).Elem(),
+	"GetInternalURL":                                         reflect.TypeOf((*GetInternalURLHook)(nil)).Elem(),
+	"GetLangPreferredVariant":                                reflect.TypeOf((*GetLangPreferredVariantHook)(nil)).Elem(),
+	"GetLinkColours":                                         reflect.TypeOf((*GetLinkColoursHook)(nil)).Elem(),
+	"GetLocalURL":                                            reflect.TypeOf((*GetLocalURLHook)(nil)).Elem(),
+	"GetLocalURL::Article":                                   reflect.TypeOf((*GetLocalURL__ArticleHook)(nil)).Elem(),
+	"GetLocalURL::Internal":                                  reflect.TypeOf((*GetLocalURL__InternalHook)(nil)).Elem(),
+	"GetLogTypesOnUser":                                      reflect.TypeOf((*GetLogTypesOnUserHook)(nil)).Elem(),
+	"GetMetadataVersion":                                     reflect.TypeOf((*GetMetadataVersionHook)(nil)).Elem(),
+	"GetNewMessagesAlert":                                    reflect.TypeOf((*GetNewMessagesAlertHook)(nil)).Elem(),
+	"GetPreferences":                                         reflect.TypeOf((*GetPreferencesHook)(nil)).Elem(),
+	"GetRelativeTimestamp":                                   reflect.TypeOf((*GetRelativeTimestampHook)(nil)).Elem(),
+	"GetSlotDiffRenderer":                                    reflect.TypeOf((*GetSlotDiffRendererHook)(nil)).Elem(),
+	"GitViewers":                                             reflect.TypeOf((*GitViewersHook)(nil)).Elem(),
+	"HTMLFileCache::useFileCache":                            reflect.TypeOf((*HTMLFileCache__useFileCacheHook)(nil)).Elem(),
+	"HistoryPageToolLinks":                                   reflect.TypeOf((*HistoryPageToolLinksHook)(nil)).Elem(),
+	"HistoryRevisionTools":                                   reflect.TypeOf((*HistoryRevisionToolsHook)(nil)).Elem(),
+	"HtmlPageLinkRendererBegin":                              reflect.TypeOf((*HtmlPageLinkRendererBeginHook)(nil)).Elem(),
+	"HtmlPageLinkRendererEnd":                                reflect.TypeOf((*HtmlPageLinkRendererEndHook)(nil)).Elem(),
+	"IRCLineURL":                                             reflect.TypeOf((*IRCLineURLHook)(nil)).Elem(),
+	"ImageBeforeProduceHTML":                                 reflect.TypeOf((*ImageBeforeProduceHTMLHook)(nil)).Elem(),
+	"ImageOpenShowImageInlineBefore":                         reflect.TypeOf((*ImageOpenShowImageInlineBeforeHook)(nil)).Elem(),
+	"ImagePageAfterImageLinks":                               reflect.TypeOf((*ImagePageAfterImageLinksHook)(nil)).Elem(),
+	"ImagePageFileHistoryLine":                               reflect.TypeOf((*ImagePageFileHistoryLineHook)(nil)).Elem(),
+	"ImagePageFindFile":                                      reflect.TypeOf((*ImagePageFindFileHook)(nil)).Elem(),
+	"ImagePageShowTOC":                                       reflect.TypeOf((*ImagePageShowTOCHook)(nil)).Elem(),
+	"ImgAuthBeforeStream":                                    reflect.TypeOf((*ImgAuthBeforeStreamHook)(nil)).Elem(),
+	"ImportHandleLogItemXMLTag":                              reflect.TypeOf((*ImportHandleLogItemXMLTagHook)(nil)).Elem(),
+	"ImportHandlePageXMLTag":                                 reflect.TypeOf((*ImportHandlePageXMLTagHook)(nil)).Elem(),
+	"ImportHandleRevisionXMLTag":                             reflect.TypeOf((*ImportHandleRevisionXMLTagHook)(nil)).Elem(),
+	"ImportHandleToplevelXMLTag":                             reflect.TypeOf((*ImportHandleToplevelXMLTagHook)(nil)).Elem(),
+	"ImportHandleUnknownUser":                                reflect.TypeOf((*ImportHandleUnknownUserHook)(nil)).Elem(),
+	"ImportHandleUploadXMLTag":                               reflect.TypeOf((*ImportHandleUploadXMLTagHook)(nil)).Elem(),
+	"ImportLogInterwikiLink":                                 reflect.TypeOf((*ImportLogInterwikiLinkHook)(nil)).Elem(),
+	"ImportSources":                                          reflect.TypeOf((*ImportSourcesHook)(nil)).Elem(),
+	"InfoAction":                                             reflect.TypeOf((*InfoActionHook)(nil)).Elem(),
+	"InitializeArticleMaybeRedirect":                         reflect.TypeOf((*InitializeArticleMaybeRedirectHook)(nil)).Elem(),
+	"InternalParseBeforeLinks":                               reflect.TypeOf((*InternalParseBeforeLinksHook)(nil)).Elem(),
+	"InternalParseBeforeSanitize":                            reflect.TypeOf((*InternalParseBeforeSanitizeHook)(nil)).Elem(),
+	"InterwikiLoadPrefix":                                    reflect.TypeOf((*InterwikiLoadPrefixHook)(nil)).Elem(),
+	"InvalidateEmailComplete":                                reflect.TypeOf((*InvalidateEmailCompleteHook)(nil)).Elem(),
+	"IsFileCacheable":                                        reflect.TypeOf((*IsFileCacheableHook)(nil)).Elem(),
+	"IsTrustedProxy":                                         reflect.TypeOf((*IsTrustedProxyHook)(nil)).Elem(),
+	"IsUploadAllowedFromUrl":                                 reflect.TypeOf((*IsUploadAllowedFromUrlHook)(nil)).Elem(),
+	"Language::getMessagesFileName":                          reflect.TypeOf((*Language__getMessagesFileNameHook)(nil)).Elem(),
+	"LanguageGetMagic":                                       reflect.TypeOf((*LanguageGetMagicHook)(nil)).Elem(),
+	"LanguageGetNamespaces":                                  reflect.TypeOf((*LanguageGetNamespacesHook)(nil)).Elem(),
+	"LanguageGetSpecialPageAliases":                          reflect.TypeOf((*LanguageGetSpecialPageAliasesHook)(nil)).Elem(),
+	"LanguageGetTranslatedLanguageNames":                     reflect.TypeOf((*LanguageGetTranslatedLanguageNamesHook)(nil)).Elem(),
+	"LanguageLinks":                                          reflect.TypeOf((*LanguageLinksHook)(nil)).Elem(),
+	"LanguageSelector":                                       reflect.TypeOf((*LanguageSelectorHook)(nil)).Elem(),
+	"LinkBegin":                                              reflect.TypeOf((*LinkBeginHook)(nil)).Elem(),
+	"LinkEnd":                                                reflect.TypeOf((*LinkEndHook)(nil)).Elem(),
+	"LinkerMakeExternalImage":                                reflect.TypeOf((*LinkerMakeExternalImageHook)(nil)).Elem(),
+	"LinkerMakeExternalLink":                                 reflect.TypeOf((*LinkerMakeExternalLinkHook)(nil)).Elem(),
+	"LinkerMakeMediaLinkFile":                                reflect.TypeOf((*LinkerMakeMediaLinkFileHook)(nil)).Elem(),
+	"LinksUpdate":                                            reflect.TypeOf((*LinksUpdateHook)(nil)).Elem(),
+	"LinksUpdateAfterInsert":                                 reflect.TypeOf((*LinksUpdateAfterInsertHook)(nil)).Elem(),
+	"LinksUpdateComplete":                                    reflect.TypeOf((*LinksUpdateCompleteHook)(nil)).Elem(),
+	"LinksUpdateConstructed":                                 reflect.TypeOf((*LinksUpdateConstructedHook)(nil)).Elem(),
+	"ListDefinedTags":                                        reflect.TypeOf((*ListDefinedTagsHook)(nil)).Elem(),
+	"LoadExtensionSchemaUpdates":                             reflect.TypeOf((*LoadExtensionSchemaUpdatesHook)(nil)).Elem(),
+	"LocalFile::getHistory":                                  reflect.TypeOf((*LocalFile__getHistoryHook)(nil)).Elem(),
+	"LocalFilePurgeThumbnails":                               reflect.TypeOf((*LocalFilePurgeThumbnailsHook)(nil)).Elem(),
+	"LocalUserCreated":                                       reflect.TypeOf((*LocalUserCreatedHook)(nil)).Elem(),
+	"LocalisationCacheRecache":                               reflect.TypeOf((*LocalisationCacheRecacheHook)(nil)).Elem(),
+	"LocalisationCacheRecacheFallback":                       reflect.TypeOf((*LocalisationCacheRecacheFallbackHook)(nil)).Elem(),
+	"LocalisationChecksBlacklist":                            reflect.TypeOf((*LocalisationChecksBlacklistHook)(nil)).Elem(),
+	"LocalisationIgnoredOptionalMessages":                    reflect.TypeOf((*LocalisationIgnoredOptionalMessagesHook)(nil)).Elem(),
+	"LogEventsListGetExtraInputs":                            reflect.TypeOf((*LogEventsListGetExtraInputsHook)(nil)).Elem(),
+	"LogEventsListLineEnding":                                reflect.TypeOf((*LogEventsListLineEndingHook)(nil)).Elem(),
+	"LogEventsListShowLogExtract":                            reflect.TypeOf((*LogEventsListShowLogExtractHook)(nil)).Elem(),
+	"LogException":                                           reflect.TypeOf((*LogExceptionHook)(nil)).Elem(),
+	"LogLine":                                                reflect.TypeOf((*LogLineHook)(nil)).Elem(),
+	"LoginFormValidErrorMessages":                            reflect.TypeOf((*LoginFormValidErrorMessagesHook)(nil)).Elem(),
+	"LoginUserMigrated":                                      reflect.TypeOf((*LoginUserMigratedHook)(nil)).Elem(),
+	"LonelyPagesQuery":                                       reflect.TypeOf((*LonelyPagesQueryHook)(nil)).Elem(),
+	"MagicWordwgVariableIDs":                                 reflect.TypeOf((*MagicWordwgVariableIDsHook)(nil)).Elem(),
+	"MaintenanceRefreshLinksInit":                            reflect.TypeOf((*MaintenanceRefreshLinksInitHook)(nil)).Elem(),
+	"MakeGlobalVariablesScript":                              reflect.TypeOf((*MakeGlobalVariablesScriptHook)(nil)).Elem(),
+	"MarkPatrolled":                                          reflect.TypeOf((*MarkPatrolledHook)(nil)).Elem(),
+	"MarkPatrolledComplete":                                  reflect.TypeOf((*MarkPatrolledCompleteHook)(nil)).Elem(),
+	"MediaWikiPerformAction":                                 reflect.TypeOf((*MediaWikiPerformActionHook)(nil)).Elem(),
+	"MediaWikiServices":                                      reflect.TypeOf((*MediaWikiServicesHook)(nil)).Elem(),
+	"MessageCache::get":                                      reflect.TypeOf((*MessageCache__getHook)(nil)).Elem(),
+	"MessageCacheReplace":                                    reflect.TypeOf((*MessageCacheReplaceHook)(nil)).Elem(),
+	"MessagesPreLoad":                                        reflect.TypeOf((*MessagesPreLoadHook)(nil)).Elem(),
+	"MimeMagicGuessFromContent":                              reflect.TypeOf((*MimeMagicGuessFromContentHook)(nil)).Elem(),
+	"MimeMagicImproveFromExtension":                          reflect.TypeOf((*MimeMagicImproveFromExtensionHook)(nil)).Elem(),
+	"MimeMagicInit":                                          reflect.TypeOf((*MimeMagicInitHook)(nil)).Elem(),
+	"ModifyExportQuery":                                      reflect.TypeOf((*ModifyExportQueryHook)(nil)).Elem(),
+	"MovePageCheckPermissions":                               reflect.TypeOf((*MovePageCheckPermissionsHook)(nil)).Elem(),
+	"MovePageIsValidMove":                                    reflect.TypeOf((*MovePageIsValidMoveHook)(nil)).Elem(),
+	"NamespaceIsMovable":                                     reflect.TypeOf((*NamespaceIsMovableHook)(nil)).Elem(),
+	"NewDifferenceEngine":                                    reflect.TypeOf((*NewDifferenceEngineHook)(nil)).Elem(),
+	"NewPagesLineEnding":                                     reflect.TypeOf((*NewPagesLineEndingHook)(nil)).Elem(),
+	"NewRevisionFromEditComplete":                            reflect.TypeOf((*NewRevisionFromEditCompleteHook)(nil)).Elem(),
+	"OldChangesListRecentChangesLine":                        reflect.TypeOf((*OldChangesListRecentChangesLineHook)(nil)).Elem(),
+	"OpenSearchUrls":                                         reflect.TypeOf((*OpenSearchUrlsHook)(nil)).Elem(),
+	"OpportunisticLinksUpdate":                               reflect.TypeOf((*OpportunisticLinksUpdateHook)(nil)).Elem(),
+	"OtherAutoblockLogLink":                                  reflect.TypeOf((*OtherAutoblockLogLinkHook)(nil)).Elem(),
+	"OtherBlockLogLink":                                      reflect.TypeOf((*OtherBlockLogLinkHook)(nil)).Elem(),
+	"OutputPageAfterGetHeadLinksArray":                       reflect.TypeOf((*OutputPageAfterGetHeadLinksArrayHook)(nil)).Elem(),
+	"OutputPageBeforeHTML":                                   reflect.TypeOf((*OutputPageBeforeHTMLHook)(nil)).Elem(),
+	"OutputPageBodyAttributes":                               reflect.TypeOf((*OutputPageBodyAttributesHook)(nil)).Elem(),
+	"OutputPageCheckLastModified":                            reflect.TypeOf((*OutputPageCheckLastModifiedHook)(nil)).Elem(),
+	"OutputPageMakeCategoryLinks":                            reflect.TypeOf((*OutputPageMakeCategoryLinksHook)(nil)).Elem(),
+	"OutputPageParserOutput":                                 reflect.TypeOf((*OutputPageParserOutputHook)(nil)).Elem(),
+	"PageContentInsertComplete":                              reflect.TypeOf((*PageContentInsertCompleteHook)(nil)).Elem(),
+	"PageContentLanguage":                                    reflect.TypeOf((*PageContentLanguageHook)(nil)).Elem(),
+	"PageContentSave":                                        reflect.TypeOf((*PageContentSaveHook)(nil)).Elem(),
+	"PageContentSaveComplete":                                reflect.TypeOf((*PageContentSaveCompleteHook)(nil)).Elem(),
+	"PageDeletionDataUpdates":                                reflect.TypeOf((*PageDeletionDataUpdatesHook)(nil)).Elem(),
+	"PageHistoryBeforeList":                                  reflect.TypeOf((*PageHistoryBeforeListHook)(nil)).Elem(),
+	"PageHistoryLineEnding":                                  reflect.TypeOf((*PageHistoryLineEndingHook)(nil)).Elem(),
+	"PageHistoryPager::doBatchLookups":                       reflect.TypeOf((*PageHistoryPager__doBatchLookupsHook)(nil)).Elem(),
+	"PageHistoryPager::getQueryInfo":                         reflect.TypeOf((*PageHistoryPager__getQueryInfoHook)(nil)).Elem(),
+	"PageRenderingHash":                                      reflect.TypeOf((*PageRenderingHashHook)(nil)).Elem(),
+	"PageViewUpdates":                                        reflect.TypeOf((*PageViewUpdatesHook)(nil)).Elem(),
+	"ParserAfterParse":                                       reflect.TypeOf((*ParserAfterParseHook)(nil)).Elem(),
+	"ParserAfterStrip":                                       reflect.TypeOf((*ParserAfterStripHook)(nil)).Elem(),
+	"ParserAfterTidy":                                        reflect.TypeOf((*ParserAfterTidyHook)(nil)).Elem(),
+	"ParserAfterUnstrip":                                     reflect.TypeOf((*ParserAfterUnstripHook)(nil)).Elem(),
+	"ParserBeforeInternalParse":                              reflect.TypeOf((*ParserBeforeInternalParseHook)(nil)).Elem(),
+	"ParserBeforeStrip":                                      reflect.TypeOf((*ParserBeforeStripHook)(nil)).Elem(),
+	"ParserBeforeTidy":                                       reflect.TypeOf((*ParserBeforeTidyHook)(nil)).Elem(),
+	"ParserCacheSaveComplete":                                reflect.TypeOf((*ParserCacheSaveCompleteHook)(nil)).Elem(),
+	"ParserClearState":                                       reflect.TypeOf((*ParserClearStateHook)(nil)).Elem(),
+	"ParserCloned":                                           reflect.TypeOf((*ParserClonedHook)(nil)).Elem(),
+	"ParserFetchTemplate":                                    reflect.TypeOf((*ParserFetchTemplateHook)(nil)).Elem(),
+	"ParserFirstCallInit":                                    reflect.TypeOf((*ParserFirstCallInitHook)(nil)).Elem(),
+	"ParserGetVariableValueSwitch":                           reflect.TypeOf((*ParserGetVariableValueSwitchHook)(nil)).Elem(),
+	"ParserGetVariableValueTs":                               reflect.TypeOf((*ParserGetVariableValueTsHook)(nil)).Elem(),
+	"ParserGetVariableValueVarCache":                         reflect.TypeOf((*ParserGetVariableValueVarCacheHook)(nil)).Elem(),
+	"ParserLimitReportFormat":                                reflect.TypeOf((*ParserLimitReportFormatHook)(nil)).Elem(),
+	"ParserLimitReportPrepare":                               reflect.TypeOf((*ParserLimitReportPrepareHook)(nil)).Elem(),
+	"ParserMakeImageParams":                                  reflect.TypeOf((*ParserMakeImageParamsHook)(nil)).Elem(),
+	"ParserOptionsRegister":                                  reflect.TypeOf((*ParserOptionsRegisterHook)(nil)).Elem(),
+	"ParserOutputPostCacheTransform":                         reflect.TypeOf((*ParserOutputPostCacheTransformHook)(nil)).Elem(),
+	"ParserOutputStashForEdit":                               reflect.TypeOf((*ParserOutputStashForEditHook)(nil)).Elem(),
+	"ParserSectionCreate":                                    reflect.TypeOf((*ParserSectionCreateHook)(nil)).Elem(),
+	"ParserTestGlobals":                                      reflect.TypeOf((*ParserTestGlobalsHook)(nil)).Elem(),
+	"ParserTestTables":                                       reflect.TypeOf((*ParserTestTablesHook)(nil)).Elem(),
+	"PasswordPoliciesForUser":                                reflect.TypeOf((*PasswordPoliciesForUserHook)(nil)).Elem(),
+	"PerformRetroactiveAutoblock":                            reflect.TypeOf((*PerformRetroactiveAutoblockHook)(nil)).Elem(),
+	"PersonalUrls":                                           reflect.TypeOf((*PersonalUrlsHook)(nil)).Elem(),
+	"PingLimiter":                                            reflect.TypeOf((*PingLimiterHook)(nil)).Elem(),
+	"PlaceNewSection":                                        reflect.TypeOf((*PlaceNewSectionHook)(nil)).Elem(),
+	"PostLoginRedirect":                                      reflect.TypeOf((*PostLoginRedirectHook)(nil)).Elem(),
+	"PreferencesFormPreSave":                                 reflect.TypeOf((*PreferencesFormPreSaveHook)(nil)).Elem(),
+	"PreferencesGetLegend":                                   reflect.TypeOf((*PreferencesGetLegendHook)(nil)).Elem(),
+	"PrefixSearchBackend":                                    reflect.TypeOf((*PrefixSearchBackendHook)(nil)).Elem(),
+	"PrefixSearchExtractNamespace":                           reflect.TypeOf((*PrefixSearchExtractNamespaceHook)(nil)).Elem(),
+	"PrefsEmailAudit":                                        reflect.TypeOf((*PrefsEmailAuditHook)(nil)).Elem(),
+	"ProtectionForm::buildForm":                              reflect.TypeOf((*ProtectionForm__buildFormHook)(nil)).Elem(),
+	"ProtectionForm::save":                                   reflect.TypeOf((*ProtectionForm__saveHook)(nil)).Elem(),
+	"ProtectionForm::showLogExtract":                         reflect.TypeOf((*ProtectionForm__showLogExtractHook)(nil)).Elem(),
+	"RandomPageQuery":                                        reflect.TypeOf((*RandomPageQueryHook)(nil)).Elem(),
+	"RawPageViewBeforeOutput":                                reflect.TypeOf((*RawPageViewBeforeOutputHook)(nil)).Elem(),
+	"RecentChange_save":                                      reflect.TypeOf((*RecentChange_saveHook)(nil)).Elem(),
+	"RecentChangesPurgeRows":                                 reflect.TypeOf((*RecentChangesPurgeRowsHook)(nil)).Elem(),
+	"RedirectSpecialArticleRedirectParams":                   reflect.TypeOf((*RedirectSpecialArticleRedirectParamsHook)(nil)).Elem(),
+	"RejectParserCacheValue":                                 reflect.TypeOf((*RejectParserCacheValueHook)(nil)).Elem(),
+	"RequestContextCreateSkin":                               reflect.TypeOf((*RequestContextCreateSkinHook)(nil)).Elem(),
+	"RequestHasSameOriginSecurity":                           reflect.TypeOf((*RequestHasSameOriginSecurityHook)(nil)).Elem(),
+	"ResetPasswordExpiration":                                reflect.TypeOf((*ResetPasswordExpirationHook)(nil)).Elem(),
+	"ResourceLoaderForeignApiModules":                        reflect.TypeOf((*ResourceLoaderForeignApiModulesHook)(nil)).Elem(),
+	"ResourceLoaderGetConfigVars":                            reflect.TypeOf((*ResourceLoaderGetConfigVarsHook)(nil)).Elem(),
+	"ResourceLoaderJqueryMsgModuleMagicWords":                reflect.TypeOf((*ResourceLoaderJqueryMsgModuleMagicWordsHook)(nil)).Elem(),
+	"ResourceLoaderRegisterModules":                          reflect.TypeOf((*ResourceLoaderRegisterModulesHook)(nil)).Elem(),
+	"ResourceLoaderTestModules":                              reflect.TypeOf((*ResourceLoaderTestModulesHook)(nil)).Elem(),
+	"RevisionDataUpdates":                                    reflect.TypeOf((*RevisionDataUpdatesHook)(nil)).Elem(),
+	"RevisionInsertComplete":                                 reflect.TypeOf((*RevisionInsertCompleteHook)(nil)).Elem(),
+	"RevisionRecordInserted":                                 reflect.TypeOf((*RevisionRecordInsertedHook)(nil)).Elem(),
+	"SearchAfterNoDirectMatch":                               reflect.TypeOf((*SearchAfterNoDirectMatchHook)(nil)).Elem(),
+	"SearchDataForIndex":                                     reflect.TypeOf((*SearchDataForIndexHook)(nil)).Elem(),
+	"SearchGetNearMatch":                                     reflect.TypeOf((*SearchGetNearMatchHook)(nil)).Elem(),
+	"SearchGetNearMatchBefore":                               reflect.TypeOf((*SearchGetNearMatchBeforeHook)(nil)).Elem(),
+	"SearchGetNearMatchComplete":                             reflect.TypeOf((*SearchGetNearMatchCompleteHook)(nil)).Elem(),
+	"SearchIndexFields":                                      reflect.TypeOf((*SearchIndexFieldsHook)(nil)).Elem(),
+	"SearchResultInitFromTitle":                              reflect.TypeOf((*SearchResultInitFromTitleHook)(nil)).Elem(),
+	"SearchResultsAugment":                                   reflect.TypeOf((*SearchResultsAugmentHook)(nil)).Elem(),
+	"SearchableNamespaces":                                   reflect.TypeOf((*SearchableNamespacesHook)(nil)).Elem(),
+	"SecondaryDataUpdates":                                   reflect.TypeOf((*SecondaryDataUpdatesHook)(nil)).Elem(),
+	"SecuritySensitiveOperationStatus":                       reflect.TypeOf((*SecuritySensitiveOperationStatusHook)(nil)).Elem(),
+	"SelfLinkBegin":                                          reflect.TypeOf((*SelfLinkBeginHook)(nil)).Elem(),
+	"SendWatchlistEmailNotification":                         reflect.TypeOf((*SendWatchlistEmailNotificationHook)(nil)).Elem(),
+	"SessionCheckInfo":                                       reflect.TypeOf((*SessionCheckInfoHook)(nil)).Elem(),
+	"SessionMetadata":                                        reflect.TypeOf((*SessionMetadataHook)(nil)).Elem(),
+	"SetupAfterCache":                                        reflect.TypeOf((*SetupAfterCacheHook)(nil)).Elem(),
+	"ShortPagesQuery":                                        reflect.TypeOf((*ShortPagesQueryHook)(nil)).Elem(),
+	"ShowMissingArticle":                                     reflect.TypeOf((*ShowMissingArticleHook)(nil)).Elem(),
+	"ShowSearchHit":                                          reflect.TypeOf((*ShowSearchHitHook)(nil)).Elem(),
+	"ShowSearchHitTitle":                                     reflect.TypeOf((*ShowSearchHitTitleHook)(nil)).Elem(),
+	"SidebarBeforeOutput":                                    reflect.TypeOf((*SidebarBeforeOutputHook)(nil)).Elem(),
+	"SiteNoticeAfter":                                        reflect.TypeOf((*SiteNoticeAfterHook)(nil)).Elem(),
+	"SiteNoticeBefore":                                       reflect.TypeOf((*SiteNoticeBeforeHook)(nil)).Elem(),
+	"SkinAfterBottomScripts":                                 reflect.TypeOf((*SkinAfterBottomScriptsHook)(nil)).Elem(),
+	"SkinAfterContent":                                       reflect.TypeOf((*SkinAfterContentHook)(nil)).Elem(),
+	"SkinBuildSidebar":                                       reflect.TypeOf((*SkinBuildSidebarHook)(nil)).Elem(),
+	"SkinCopyrightFooter":                                    reflect.TypeOf((*SkinCopyrightFooterHook)(nil)).Elem(),
+	"SkinEditSectionLinks":                                   reflect.TypeOf((*SkinEditSectionLinksHook)(nil)).Elem(),
+	"SkinGetPoweredBy":                                       reflect.TypeOf((*SkinGetPoweredByHook)(nil)).Elem(),
+	"SkinPreloadExistence":                                   reflect.TypeOf((*SkinPreloadExistenceHook)(nil)).Elem(),
+	"SkinSubPageSubtitle":                                    reflect.TypeOf((*SkinSubPageSubtitleHook)(nil)).Elem(),
+	"SkinTemplateBuildNavUrlsNav_urlsAfterPermalink":         reflect.TypeOf((*SkinTemplateBuildNavUrlsNav_urlsAfterPermalinkHook)(nil)).Elem(),
+	"SkinTemplateGetLanguageLink":                            reflect.TypeOf((*SkinTemplateGetLanguageLinkHook)(nil)).Elem(),
+	"SkinTemplateNavigation":                                 reflect.TypeOf((*SkinTemplateNavigationHook)(nil)).Elem(),
+	"SkinTemplateNavigation::SpecialPage":                    reflect.TypeOf((*SkinTemplateNavigation__SpecialPageHook)(nil)).Elem(),
+	"SkinTemplateNavigation::Universal":                      reflect.TypeOf((*SkinTemplateNavigation__UniversalHook)(nil)).Elem(),
+	"SkinTemplateOutputPageBeforeExec":                       reflect.TypeOf((*SkinTemplateOutputPageBeforeExecHook)(nil)).Elem(),
+	"SkinTemplatePreventOtherActiveTabs":                     reflect.TypeOf((*SkinTemplatePreventOtherActiveTabsHook)(nil)).Elem(),
+	"SkinTemplateTabAction":                                  reflect.TypeOf((*SkinTemplateTabActionHook)(nil)).Elem(),
+	"SkinTemplateToolboxEnd":                                 reflect.TypeOf((*SkinTemplateToolboxEndHook)(nil)).Elem(),
+	"SoftwareInfo":                                           reflect.TypeOf((*SoftwareInfoHook)(nil)).Elem(),
+	"SpecialBlockModifyFormFields":                           reflect.TypeOf((*SpecialBlockModifyFormFieldsHook)(nil)).Elem(),
+	"SpecialContributions::formatRow::flags":                 reflect.TypeOf((*SpecialContributions__formatRow__flagsHook)(nil)).Elem(),
+	"SpecialContributions::getForm::filters":                 reflect.TypeOf((*SpecialContributions__getForm__filtersHook)(nil)).Elem(),
+	"SpecialContributionsBeforeMainOutput":                   reflect.TypeOf((*SpecialContributionsBeforeMainOutputHook)(nil)).Elem(),
+	"SpecialListusersDefaultQuery":                           reflect.TypeOf((*SpecialListusersDefaultQueryHook)(nil)).Elem(),
+	"SpecialListusersFormatRow":                              reflect.TypeOf((*SpecialListusersFormatRowHook)(nil)).Elem(),
+	"SpecialListusersHeader":                                 reflect.TypeOf((*SpecialListusersHeaderHook)(nil)).Elem(),
+	"SpecialListusersHeaderForm":                             reflect.TypeOf((*SpecialListusersHeaderFormHook)(nil)).Elem(),
+	"SpecialListusersQueryInfo":                              reflect.TypeOf((*SpecialListusersQueryInfoHook)(nil)).Elem(),
+	"SpecialLogAddLogSearchRelations":                        reflect.TypeOf((*SpecialLogAddLogSearchRelationsHook)(nil)).Elem(),
+	"SpecialMovepageAfterMove":                               reflect.TypeOf((*SpecialMovepageAfterMoveHook)(nil)).Elem(),
+	"SpecialNewPagesFilters":                                 reflect.TypeOf((*SpecialNewPagesFiltersHook)(nil)).Elem(),
+	"SpecialNewpagesConditions":                              reflect.TypeOf((*SpecialNewpagesConditionsHook)(nil)).Elem(),
+	"SpecialPageAfterExecute":                                reflect.TypeOf((*SpecialPageAfterExecuteHook)(nil)).Elem(),
+	"SpecialPageBeforeExecute":                               reflect.TypeOf((*SpecialPageBeforeExecuteHook)(nil)).Elem(),
+	"SpecialPageBeforeFormDisplay":                           reflect.TypeOf((*SpecialPageBeforeFormDisplayHook)(nil)).Elem(),
+	"SpecialPage_initList":                                   reflect.TypeOf((*SpecialPage_initListHook)(nil)).Elem(),
+	"SpecialPasswordResetOnSubmit":                           reflect.TypeOf((*SpecialPasswordResetOnSubmitHook)(nil)).Elem(),
+	"SpecialRandomGetRandomTitle":                            reflect.TypeOf((*SpecialRandomGetRandomTitleHook)(nil)).Elem(),
+	"SpecialRecentChangesPanel":                              reflect.TypeOf((*SpecialRecentChangesPanelHook)(nil)).Elem(),
+	"SpecialResetTokensTokens":                               reflect.TypeOf((*SpecialResetTokensTokensHook)(nil)).Elem(),
+	"SpecialSearchCreateLink":                                reflect.TypeOf((*SpecialSearchCreateLinkHook)(nil)).Elem(),
+	"SpecialSearchGoResult":                                  reflect.TypeOf((*SpecialSearchGoResultHook)(nil)).Elem(),
+	"SpecialSearchNogomatch":                                 reflect.TypeOf((*SpecialSearchNogomatchHook)(nil)).Elem(),
+	"SpecialSearchPowerBox":                                  reflect.TypeOf((*SpecialSearchPowerBoxHook)(nil)).Elem(),
+	"SpecialSearchProfileForm":                               reflect.TypeOf((*SpecialSearchProfileFormHook)(nil)).Elem(),
+	"SpecialSearchProfiles":                                  reflect.TypeOf((*SpecialSearchProfilesHook)(nil)).Elem(),
+	"SpecialSearchResults":                                   reflect.TypeOf((*SpecialSearchResultsHook)(nil)).Elem(),
+	"SpecialSearchResultsAppend":                             reflect.TypeOf((*SpecialSearchResultsAppendHook)(nil)).Elem(),
+	"SpecialSearchResultsPrepend":                            reflect.TypeOf((*SpecialSearchResultsPrependHook)(nil)).Elem(),
+	"SpecialSearchSetupEngine":                               reflect.TypeOf((*SpecialSearchSetupEngineHook)(nil)).Elem(),
+	"SpecialStatsAddExtra":                                   reflect.TypeOf((*SpecialStatsAddExtraHook)(nil)).Elem(),
+	"SpecialTrackingCategories::generateCatLink":             reflect.TypeOf((*SpecialTrackingCategories__generateCatLinkHook)(nil)).Elem(),
+	"SpecialTrackingCategories::preprocess":                  reflect.TypeOf((*SpecialTrackingCategories__preprocessHook)(nil)).Elem(),
+	"SpecialUploadComplete":                                  reflect.TypeOf((*SpecialUploadCompleteHook)(nil)).Elem(),
+	"SpecialVersionVersionUrl":                               reflect.TypeOf((*SpecialVersionVersionUrlHook)(nil)).Elem(),
+	"SpecialWatchlistGetNonRevisionTypes":                    reflect.TypeOf((*SpecialWatchlistGetNonRevisionTypesHook)(nil)).Elem(),
+	"TestCanonicalRedirect":                                  reflect.TypeOf((*TestCanonicalRedirectHook)(nil)).Elem(),
+	"ThumbnailBeforeProduceHTML":                             reflect.TypeOf((*ThumbnailBeforeProduceHTMLHook)(nil)).Elem(),
+	"TitleArrayFromResult":                                   reflect.TypeOf((*TitleArrayFromResultHook)(nil)).Elem(),
+	"TitleExists":                                            reflect.TypeOf((*TitleExistsHook)(nil)).Elem(),
+	"TitleGetEditNotices":                                    reflect.TypeOf((*TitleGetEditNoticesHook)(nil)).Elem(),
+	"TitleGetRestrictionTypes":                               reflect.TypeOf((*TitleGetRestrictionTypesHook)(nil)).Elem(),
+	"TitleIsAlwaysKnown":                                     reflect.TypeOf((*TitleIsAlwaysKnownHook)(nil)).Elem(),
+	"TitleIsMovable":                                         reflect.TypeOf((*TitleIsMovableHook)(nil)).Elem(),
+	"TitleMove":                                              reflect.TypeOf((*TitleMoveHook)(nil)).Elem(),
+	"TitleMoveComplete":                                      reflect.TypeOf((*TitleMoveCompleteHook)(nil)).Elem(),
+	"TitleMoveCompleting":                                    reflect.TypeOf((*TitleMoveCompletingHook)(nil)).Elem(),
+	"TitleMoveStarting":                                      reflect.TypeOf((*TitleMoveStartingHook)(nil)).Elem(),
+	"TitleQuickPermissions":                                  reflect.TypeOf((*TitleQuickPermissionsHook)(nil)).Elem(),
+	"TitleReadWhitelist":                                     reflect.TypeOf((*TitleReadWhitelistHook)(nil)).Elem(),
+	"TitleSquidURLs":                                         reflect.TypeOf((*TitleSquidURLsHook)(nil)).Elem(),
+	"UnblockUser":                                            reflect.TypeOf((*UnblockUserHook)(nil)).Elem(),
+	"UnblockUserComplete":                                    reflect.TypeOf((*UnblockUserCompleteHook)(nil)).Elem(),
+	"UndeleteForm::showHistory":                              reflect.TypeOf((*UndeleteForm__showHistoryHook)(nil)).Elem(),
+	"UndeleteForm::showRevision":                             reflect.TypeOf((*UndeleteForm__showRevisionHook)(nil)).Elem(),
+	"UndeleteForm::undelete":                                 reflect.TypeOf((*UndeleteForm__undeleteHook)(nil)).Elem(),
+	"UndeleteShowRevision":                                   reflect.TypeOf((*UndeleteShowRevisionHook)(nil)).Elem(),
+	"UnitTestsAfterDatabaseSetup":                            reflect.TypeOf((*UnitTestsAfterDatabaseSetupHook)(nil)).Elem(),
+	"UnitTestsBeforeDatabaseTeardown":                        reflect.TypeOf((*UnitTestsBeforeDatabaseTeardownHook)(nil)).Elem(),
+	"UnitTestsList":                                          reflect.TypeOf((*UnitTestsListHook)(nil)).Elem(),
+	"UnwatchArticle":                                         reflect.TypeOf((*UnwatchArticleHook)(nil)).Elem(),
+	"UnwatchArticleComplete":                                 reflect.TypeOf((*UnwatchArticleCompleteHook)(nil)).Elem(),
+	"UpdateUserMailerFormattedPageStatus":                    reflect.TypeOf((*UpdateUserMailerFormattedPageStatusHook)(nil)).Elem(),
+	"UploadComplete":                                         reflect.TypeOf((*UploadCompleteHook)(nil)).Elem(),
+	"UploadCreateFromRequest":                                reflect.TypeOf((*UploadCreateFromRequestHook)(nil)).Elem(),
+	"UploadForm:BeforeProcessing":                            reflect.TypeOf((*UploadForm_BeforeProcessingHook)(nil)).Elem(),
+	"UploadForm:getInitialPageText":                          reflect.TypeOf((*UploadForm_getInitialPageTextHook)(nil)).Elem(),
+	"UploadForm:initial":                                     reflect.TypeOf((*UploadForm_initialHook)(nil)).Elem(),
+	"UploadFormInitDescriptor":                               reflect.TypeOf((*UploadFormInitDescriptorHook)(nil)).Elem(),
+	"UploadFormSourceDescriptors":                            reflect.TypeOf((*UploadFormSourceDescriptorsHook)(nil)).Elem(),
+	"UploadStashFile":                                        reflect.TypeOf((*UploadStashFileHook)(nil)).Elem(),
+	"UploadVerification":                                     reflect.TypeOf((*UploadVerificationHook)(nil)).Elem(),
+	"UploadVerifyFile":                                       reflect.TypeOf((*UploadVerifyFileHook)(nil)).Elem(),
+	"UploadVerifyUpload":                                     reflect.TypeOf((*UploadVerifyUploadHook)(nil)).Elem(),
+	"User::mailPasswordInternal":                             reflect.TypeOf((*User__mailPasswordInternalHook)(nil)).Elem(),
+	"UserAddGroup":                                           reflect.TypeOf((*UserAddGroupHook)(nil)).Elem(),
+	"UserArrayFromResult":                                    reflect.TypeOf((*UserArrayFromResultHook)(nil)).Elem(),
+	"UserCanSendEmail":                                       reflect.TypeOf((*UserCanSendEmailHook)(nil)).Elem(),
+	"UserClearNewTalkNotification":                           reflect.TypeOf((*UserClearNewTalkNotificationHook)(nil)).Elem(),
+	"UserCreateForm":                                         reflect.TypeOf((*UserCreateFormHook)(nil)).Elem(),
+	"UserEffectiveGroups":                                    reflect.TypeOf((*UserEffectiveGroupsHook)(nil)).Elem(),
+	"UserGetAllRights":                                       reflect.TypeOf((*UserGetAllRightsHook)(nil)).Elem(),
+	"UserGetDefaultOptions":                                  reflect.TypeOf((*UserGetDefaultOptionsHook)(nil)).Elem(),
+	"UserGetEmail":                                           reflect.TypeOf((*UserGetEmailHook)(nil)).Elem(),
+	"UserGetEmailAuthenticationTimestamp":                    reflect.TypeOf((*UserGetEmailAuthenticationTimestampHook)(nil)).Elem(),
+	"UserGetLanguageObject":                                  reflect.TypeOf((*UserGetLanguageObjectHook)(nil)).Elem(),
+	"UserGetReservedNames":                                   reflect.TypeOf((*UserGetReservedNamesHook)(nil)).Elem(),
+	"UserGetRights":                                          reflect.TypeOf((*UserGetRightsHook)(nil)).Elem(),
+	"UserGetRightsRemove":                                    reflect.TypeOf((*UserGetRightsRemoveHook)(nil)).Elem(),
+	"UserGroupsChanged":                                      reflect.TypeOf((*UserGroupsChangedHook)(nil)).Elem(),
+	"UserIsBlockedFrom":                                      reflect.TypeOf((*UserIsBlockedFromHook)(nil)).Elem(),
+	"UserIsBlockedGlobally":                                  reflect.TypeOf((*UserIsBlockedGloballyHook)(nil)).Elem(),
+	"UserIsBot":                                              reflect.TypeOf((*UserIsBotHook)(nil)).Elem(),
+	"UserIsEveryoneAllowed":                                  reflect.TypeOf((*UserIsEveryoneAllowedHook)(nil)).Elem(),
+	"UserIsHidden":                                           reflect.TypeOf((*UserIsHiddenHook)(nil)).Elem(),
+	"UserIsLocked":                                           reflect.TypeOf((*UserIsLockedHook)(nil)).Elem(),
+	"UserLoadAfterLoadFromSession":                           reflect.TypeOf((*UserLoadAfterLoadFromSessionHook)(nil)).Elem(),
+	"UserLoadDefaults":                                       reflect.TypeOf((*UserLoadDefaultsHook)(nil)).Elem(),
+	"UserLoadFromDatabase":                                   reflect.TypeOf((*UserLoadFromDatabaseHook)(nil)).Elem(),
+	"UserLoadFromSession":                                    reflect.TypeOf((*UserLoadFromSessionHook)(nil)).Elem(),
+	"UserLoadOptions":                                        reflect.TypeOf((*UserLoadOptionsHook)(nil)).Elem(),
+	"UserLoggedIn":                                           reflect.TypeOf((*UserLoggedInHook)(nil)).Elem(),
+	"UserLoginComplete":                                      reflect.TypeOf((*UserLoginCompleteHook)(nil)).Elem(),
+	"UserLoginForm":                                          reflect.TypeOf((*UserLoginFormHook)(nil)).Elem(),
+	"UserLogout":                                             reflect.TypeOf((*UserLogoutHook)(nil)).Elem(),
+	"UserLogoutComplete":                                     reflect.TypeOf((*UserLogoutCompleteHook)(nil)).Elem(),
+	"UserMailerChangeReturnPath":                             reflect.TypeOf((*UserMailerChangeReturnPathHook)(nil)).Elem(),
+	"UserMailerSplitTo":                                      reflect.TypeOf((*UserMailerSplitToHook)(nil)).Elem(),
+	"UserMailerTransformContent":                             reflect.TypeOf((*UserMailerTransformContentHook)(nil)).Elem(),
+	"UserMailerTransformMessage":                             reflect.TypeOf((*UserMailerTransformMessageHook)(nil)).Elem(),
+	"UserRemoveGroup":                                        reflect.TypeOf((*UserRemoveGroupHook)(nil)).Elem(),
+	"UserRequiresHTTPS":                                      reflect.TypeOf((*UserRequiresHTTPSHook)(nil)).Elem(),
+	"UserResetAllOptions":                                    reflect.TypeOf((*UserResetAllOptionsHook)(nil)).Elem(),
+	"UserRetrieveNewTalks":                                   reflect.TypeOf((*UserRetrieveNewTalksHook)(nil)).Elem(),
+	"UserRights":                                             reflect.TypeOf((*UserRightsHook)(nil)).Elem(),
+	"UserSaveOptions":                                        reflect.TypeOf((*UserSaveOptionsHook)(nil)).Elem(),
+	"UserSaveSettings":                                       reflect.TypeOf((*UserSaveSettingsHook)(nil)).Elem(),
+	"UserSetCookies":                                         reflect.TypeOf((*UserSetCookiesHook)(nil)).Elem(),
+	"UserSetEmail":                                           reflect.TypeOf((*UserSetEmailHook)(nil)).Elem(),
+	"UserSetEmailAuthenticationTimestamp":                    reflect.TypeOf((*UserSetEmailAuthenticationTimestampHook)(nil)).Elem(),
+	"UserToolLinksEdit":                                      reflect.TypeOf((*UserToolLinksEditHook)(nil)).Elem(),
+	"UsersPagerDoBatchLookups":                               reflect.TypeOf((*UsersPagerDoBatchLookupsHook)(nil)).Elem(),
+	"ValidateExtendedMetadataCache":                          reflect.TypeOf((*ValidateExtendedMetadataCacheHook)(nil)).Elem(),
+	"WantedPages::getQueryInfo":                              reflect.TypeOf((*WantedPages__getQueryInfoHook)(nil)).Elem(),
+	"WatchArticle":                                           reflect.TypeOf((*WatchArticleHook)(nil)).Elem(),
+	"WatchArticleComplete":                                   reflect.TypeOf((*WatchArticleCompleteHook)(nil)).Elem(),
+	"WatchedItemQueryServiceExtensions":                      reflect.TypeOf((*WatchedItemQueryServiceExtensionsHook)(nil)).Elem(),
+	"WatchlistEditorBeforeFormRender":                        reflect.TypeOf((*WatchlistEditorBeforeFormRenderHook)(nil)).Elem(),
+	"WatchlistEditorBuildRemoveLine":                         reflect.TypeOf((*WatchlistEditorBuildRemoveLineHook)(nil)).Elem(),
+	"WebRequestPathInfoRouter":                               reflect.TypeOf((*WebRequestPathInfoRouterHook)(nil)).Elem(),
+	"WebResponseSetCookie":                                   reflect.TypeOf((*WebResponseSetCookieHook)(nil)).Elem(),
+	"WhatLinksHereProps":                                     reflect.TypeOf((*WhatLinksHerePropsHook)(nil)).Elem(),
+	"WikiExporter::dumpStableQuery":                          reflect.TypeOf((*WikiExporter__dumpStableQueryHook)(nil)).Elem(),
+	"WikiPageDeletionUpdates":                                reflect.TypeOf((*WikiPageDeletionUpdatesHook)(nil)).Elem(),
+	"WikiPageFactory":                                        reflect.TypeOf((*WikiPageFactoryHook)(nil)).Elem(),
+	"XmlDumpWriterOpenPage":                                  reflect.TypeOf((*XmlDumpWriterOpenPageHook)(nil)).Elem(),
+	"XmlDumpWriterWriteRevision":                             reflect.TypeOf((*XmlDumpWriterWriteRevisionHook)(nil)).Elem(),
+	"getUserPermissionsErrors":                               reflect.TypeOf((*GetUserPermissionsErrorsHook)(nil)).Elem(),
+	"getUserPermissionsErrorsExpensive":                      reflect.TypeOf((*GetUserPermissionsErrorsExpensiveHook)(nil)).Elem(),
+	"isValidEmailAddr":                                       reflect.TypeOf((*IsValidEmailAddrHook)(nil)).Elem(),
+	"isValidPassword":                                        reflect.TypeOf((*IsValidPasswordHook)(nil)).Elem(),
+	"userCan":                                                reflect.TypeOf((*UserCanHook)(nil)).Elem(),
+	"wfShellWikiCmd":                                         reflect.TypeOf((*WfShellWikiCmdHook)(nil)).Elem(),
+	"wgQueryPages":                                           reflect.TypeOf((*WgQueryPagesHook)(nil)).Elem(),
+}
@@ -3,7 +3,9 @@ package includes
 import (
 	"fmt"
 	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes/config"
 	"github.com/MangoDowner/mediawiki/includes/exception"
+	"github.com/MangoDowner/mediawiki/includes/languages"
 	"github.com/astaxie/beego/logs"
 	"reflect"
 )
@@ -15,6 +17,13 @@ import (
 // Hook function to run
 type HookFunc interface{}
 
+/**
+ * Whether handlers aborting unabortable hooks fail loudly instead of being
+ * logged; by default, in development mode (runmode = dev in app.conf)
+ * @var callable
+ */
+var failOnInvalidAbort = isDevMode
+
 /**
 * Hooks class.
 *
@@ -170,6 +179,9 @@ func (h *Hooks) callHook(event string, hook interface{}, args []interface{},
  * For hook event that are not abortable through a handler's return value,
  * use runWithoutAbort() instead.
  *
+ * The handlers run through the HookContainer of the wiki, together with its
+ * typed handlers and the ones of the extensions.
+ *
  * @param string $event Event name
  * @param array $args Array of parameters passed to hook functions
  * @param string|null $deprecatedVersion [optional] Mark hook as deprecated with version number
@@ -183,32 +195,9 @@ func (h *Hooks) callHook(event string, hook interface{}, args []interface{},
  *   returning null) is equivalent to returning true.
  */
 func (h *Hooks) Run(event string, args []interface{}, deprecatedVersion string) bool {
-	for _, hook := range h.GetHandlers(event) {
-		retVal := h.callHook(event, hook, args, deprecatedVersion, nil)
-		if retVal == nil {
-			continue
-		}
-		// Process the return value.
-		switch v := retVal.(type) {
-		case error:
-			if v != nil {
-				// error was returned. Stop processing, but no error.
-				return false
-			}
-		case bool:
-			if !v {
-				// false was returned. Stop processing, but no error.
-				return false
-			}
-		case string:
-			// String returned means error.
-			panic(exception.NewFatalError(v))
-			return false
-		default:
-			continue
-		}
-	}
-	return true
+	container := GetHookContainer()
+	return container.checkResult(container.runHandlers(h, event, args, deprecatedVersion, true,
+		container.reflectiveCall(event, args)))
 }
 
 /**
@@ -220,18 +209,47 @@ func (h *Hooks) Run(event string, args []interface{}, deprecatedVersion string)
  * @return bool Always true
  * @throws MWException If a callback is invalid, unknown
  * @throws UnexpectedValueException If a callback returns an abort value.
+ * @throws MWException If a callback returns an abort value in development mode
  * @since 1.30
  */
 func (h *Hooks) RunWithoutAbort(event string, args []interface{}, deprecatedVersion string) bool {
-	var funcName string
-	for _, hook := range h.GetHandlers(event) {
-		funcName = ""
-		retVal := h.callHook(event, hook, args, deprecatedVersion, &funcName)
-		if errA, ok := retVal.(error); ok && errA != nil {
-			// False was returned. Stop processing, but no error.
-			logs.Debug("Invalid return from %s for unabortable %s.", funcName, event)
-		}
+	container := GetHookContainer()
+	container.runHandlers(h, event, args, deprecatedVersion, false, container.reflectiveCall(event, args))
+	return true
+}
+
+/**
+ * Report a handler trying to abort an unabortable hook
+ *
+ * @param string $event Event name
+ * @param string $funcName Readable name of the handler
+ * @throws MWException In development mode
+ */
+func reportInvalidAbort(event string, funcName string) {
+	message := fmt.Sprintf("Invalid return from %s for unabortable %s.", funcName, event)
+	if failOnInvalidAbort() {
+		panic(exception.NewMWException(message))
 	}
+	logs.Warn(message)
+}
 
-	return true
-}
\ No newline at end of file
+/**
+ * @return bool Whether the wiki runs in development mode (runmode = dev in app.conf)
+ */
+func isDevMode() bool {
+	return config.Configs.GetString("runmode") == "dev"
+}
+/**
+ * Runs the hooks of the languages package on the current HookContainer,
+ * which tests may replace with ForceGlobalHookContainer()
+ */
+type globalHookRunner struct{}
+
+func (globalHookRunner) Run(event string, args []interface{}, deprecatedVersion string) bool {
+	return GetHookContainer().Run(event, args, deprecatedVersion)
+}
+
+func init() {
+	// The languages package cannot import this one to run its hooks
+	languages.HookRunner = globalHookRunner{}
+}
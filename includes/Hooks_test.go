@@ -3,6 +3,7 @@ package includes
 import (
 	"errors"
 	"fmt"
+	"github.com/MangoDowner/mediawiki/includes/exception"
 	test "github.com/MangoDowner/mediawiki/tests"
 	"testing"
 )
@@ -212,15 +213,15 @@ func TestRunWithoutAbort(t *testing.T) {
  * @covers Hooks::callHook
  */
 func TestRunWithoutAbortWarning(t *testing.T) {
+	oldFailOnInvalidAbort := failOnInvalidAbort
+	defer func() { failOnInvalidAbort = oldFailOnInvalidAbort }()
 	h := NewHooks()
 	foo := "original"
 
-	h.register("MediaWikiHooksTest001", func(param *string) error {
-		return errors.New("LAMBDA func error")
-	})
+	h.register("MediaWikiHooksTest001", abortingHook)
 
 	testFunc := func (param *string) bool {
-		fmt.Println("testFunc")
+		*param = "test"
 		return true
 	}
 
@@ -229,12 +230,27 @@ func TestRunWithoutAbortWarning(t *testing.T) {
 	})
 	h.register("MediaWikiHooksTest001", testFunc)
 
+	// The abort is logged, and the other handlers run
+	failOnInvalidAbort = func() bool { return false }
 	h.RunWithoutAbort("MediaWikiHooksTest001", []interface{}{&foo}, "")
-	test.AssetSame(
-		"test",
-		foo,
-		"Invalid return from hook-MediaWikiHooksTest001-closure for unabortable MediaWikiHooksTest001",
-	)
+	test.AssertEqual(t, "test", foo, "All hooks ran.")
+
+	// In development mode, it fails
+	failOnInvalidAbort = func() bool { return true }
+	func() {
+		defer func() {
+			err, ok := recover().(*exception.MWException)
+			test.AssertTrue(t, ok, "Aborts fail in development mode")
+			if ok {
+				test.AssertEqual(t,
+					"Invalid return from includes.abortingHook for unabortable MediaWikiHooksTest001.",
+					err.Error(),
+					"The failure names the handler",
+				)
+			}
+		}()
+		h.RunWithoutAbort("MediaWikiHooksTest001", []interface{}{&foo}, "")
+	}()
 }
 
 /**
@@ -256,6 +272,10 @@ func TestFatalError(t *testing.T) {
 	)
 }
 
+func abortingHook(param *string) error {
+	return errors.New("LAMBDA func error")
+}
+
 type NothingClass struct {
 	Calls int
 }
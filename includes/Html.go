@@ -9,6 +9,7 @@ import (
 	"html"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -67,7 +68,7 @@ type Html struct{}
  */
 
 func (h *Html) ButtonAttributes(attrs map[string]interface{}, modifiers []string) map[string]interface{} {
-	if !WgUseMediaWikiUIEverywhere {
+	if enabled, _ := NewMainConfig().Get("UseMediaWikiUIEverywhere").(bool); !enabled {
 		return attrs
 	}
 	// ensure compatibility with Xml
@@ -87,7 +88,7 @@ func (h *Html) ButtonAttributes(attrs map[string]interface{}, modifiers []string
  * @return array $attrs A modified attribute array
  */
 func (h *Html) GetTextInputAttributes(attrs map[string]interface{}) map[string]interface{} {
-	if !WgUseMediaWikiUIEverywhere {
+	if enabled, _ := NewMainConfig().Get("UseMediaWikiUIEverywhere").(bool); !enabled {
 		return attrs
 	}
 	if attrs["class"] != "" {
@@ -382,7 +383,14 @@ func (h *Html) DropDefaults(element string, attribs map[string]interface{}) map[
  *   (starting with a space if at least one attribute is output)
  */
 func (h *Html) ExpandAttributes(attribs map[string]interface{}) (ret string) {
-	for key, value := range attribs {
+	// Go maps are unordered, output the attributes sorted by name
+	keys := make([]string, 0, len(attribs))
+	for key := range attribs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := attribs[key]
 		// Support intuitive [ 'checked' => true/false ] form
 		if value == false || value == nil {
 			continue
@@ -433,19 +441,16 @@ func (h *Html) ExpandAttributes(attribs map[string]interface{}) (ret string) {
 				}
 			}
 			valueStr = strings.Join(newValue, " ")
-			newValue = strings.Split(valueStr, " ")
-			for _, v := range newValue {
+			var uniqueValue []string
+			for _, v := range strings.Split(valueStr, " ") {
 				trim := strings.Trim(v, " ")
-				if trim == "" {
+				if trim == "" || newValueMap[v] != "" {
 					continue
 				}
 				newValueMap[v] = v
+				uniqueValue = append(uniqueValue, v)
 			}
-			newValue = []string{}
-			for _, v := range newValueMap {
-				newValue = append(newValue, v)
-			}
-			valueStr = strings.Join(newValue, " ")
+			valueStr = strings.Join(uniqueValue, " ")
 		} else if reflect.ValueOf(value).Kind() == reflect.Slice {
 			panic(fmt.Sprintf("HTML attribute %s can not contain a list of values", key))
 		}
@@ -923,3 +928,17 @@ func (h *Html) IsXmlMimeType(mimetype string) bool {
 	}
 	return true
 }
+
+/**
+ * Constructs the opening html-tag with necessary doctypes depending on
+ * global variables.
+ *
+ * @param array $attribs Associative array of miscellaneous extra
+ *   attributes, passed to Html::element() of html tag.
+ * @return string Raw HTML
+ */
+func (h *Html) HtmlHeader(attribs map[string]interface{}) string {
+	ret := "<!DOCTYPE html>\n"
+	ret += h.OpenElement("html", attribs)
+	return ret
+}
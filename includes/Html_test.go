@@ -2,12 +2,15 @@ package includes
 
 import (
 	"fmt"
+	"github.com/MangoDowner/mediawiki/globals"
 	test "github.com/MangoDowner/mediawiki/tests"
 	"testing"
 )
 
 func TestButtonAttributes(t *testing.T) {
-	WgUseMediaWikiUIEverywhere = true
+	oldGlobals := globals.GLOBALS
+	defer func() { globals.GLOBALS = oldGlobals }()
+	globals.GLOBALS = map[string]interface{}{"wgUseMediaWikiUIEverywhere": true}
 	h := new(Html)
 	attrs := map[string]interface{}{
 		"class": "c1 c2 c3",
@@ -616,7 +619,7 @@ func TestWrapperInput(t *testing.T) {
 	h := new(Html)
 
 	test.AssetEqual(
-		`<input type="radio" value="testval" name="testname"/>`,
+		`<input name="testname" type="radio" value="testval"/>`,
 		h.Input("testname", "testval", "radio", nil),
 		"Input wrapper with type and value.",
 	)
@@ -635,19 +638,19 @@ func TestWrapperCheck(t *testing.T) {
 	h := new(Html)
 
 	test.AssetEqual(
-		`<input type="checkbox" value="1" name="testname"/>`,
+		`<input name="testname" type="checkbox" value="1"/>`,
 		h.Check("testname", false, nil),
 		"Checkbox wrapper unchecked.",
 	)
 
 	test.AssetEqual(
-		`<input type="checkbox" value="1" name="testname"/>`,
-		h.Check("testname", false, nil),
+		`<input checked="" name="testname" type="checkbox" value="1"/>`,
+		h.Check("testname", true, nil),
 		"Checkbox wrapper checked.",
 	)
 
 	test.AssetEqual(
-		`<input type="checkbox" value="testval" name="testname"/>`,
+		`<input name="testname" type="checkbox" value="testval"/>`,
 		h.Check("testname", false, map[string]interface{}{
 			"value":"testval",
 		}),
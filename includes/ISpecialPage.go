@@ -1,5 +1,7 @@
 package includes
 
+import "github.com/astaxie/beego/context"
+
 type ISpecialPage interface {
-	Msg()
+	Execute(subPage string, context *context.Context) error
 }
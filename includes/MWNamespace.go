@@ -4,6 +4,8 @@
 package includes
 
 import (
+	"strconv"
+
 	"github.com/MangoDowner/mediawiki/includes/consts"
 	"github.com/MangoDowner/mediawiki/includes/registration"
 )
@@ -93,18 +95,19 @@ func (m *MWNamespace) GetCanonicalNamespaces(rebuild bool) map[int]string {
 		m.canonicalNamespaces = WgCanonicalNamespaceNames
 		m.canonicalNamespaces[consts.NS_MAIN] = ""
 		// Add extension namespaces
-		attrs := registration.NewExtensionRegistry().GetAttribute("ExtensionNamespaces")
-		if len(attrs) > 0 {
-			for k, v := range attrs {
-				m.canonicalNamespaces[k] = v
+		attrs, _ := registration.GetInstance().GetAttribute("ExtensionNamespaces").(map[string]interface{})
+		for k, v := range attrs {
+			if index, err := strconv.Atoi(k); err == nil {
+				m.canonicalNamespaces[index], _ = v.(string)
 			}
 		}
-		if len(WgExtraNamespaces) == 0 {
-			for k, v := range WgExtraNamespaces {
-				m.canonicalNamespaces[k] = v
+		extraNamespaces, _ := NewMainConfig().Get("ExtraNamespaces").(map[string]interface{})
+		for k, v := range extraNamespaces {
+			if index, err := strconv.Atoi(k); err == nil {
+				m.canonicalNamespaces[index], _ = v.(string)
 			}
 		}
-		NewHooks().Run("CanonicalNamespaces", []interface{}{&m.canonicalNamespaces}, "")
+		NewHookRunner(GetHookContainer()).OnCanonicalNamespaces(&m.canonicalNamespaces)
 	}
 	return m.canonicalNamespaces
 }
@@ -0,0 +1,132 @@
+package includes
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes/config"
+	"github.com/MangoDowner/mediawiki/includes/exception"
+)
+
+/**
+ * The defaults of MainConfigSchema
+ * @var HashConfig
+ */
+var defaultSettings = config.NewHashConfig(MainConfigSchema.GetDefaults())
+
+/**
+ * The configuration of MediaWiki core: the $wg globals, then the settings of
+ * app.conf, then the defaults of MainConfigSchema.
+ *
+ * Code which gets services should use MediaWikiServices::getMainConfig()
+ * instead; this is for the code which, in PHP, uses `global $wgFoo`.
+ *
+ * @return Config
+ */
+func NewMainConfig() config.IConfig {
+	return config.NewMultiConfig(
+		config.NewGlobalVarConfig(""),
+		config.NewParsedConfig(&config.Configs, MainConfigSchema),
+		defaultSettings,
+	)
+}
+
+/**
+ * Set the $wg globals which are not set yet to their defaults, as including
+ * DefaultSettings.php does, so that the extensions merge their settings with
+ * the defaults. The settings of app.conf have precedence over the defaults.
+ *
+ * @throws ConfigException If a setting of app.conf is invalid
+ */
+func ApplyDefaultSettings() {
+	if globals.GLOBALS == nil {
+		globals.GLOBALS = make(map[string]interface{})
+	}
+	appConfig := config.NewParsedConfig(&config.Configs, MainConfigSchema)
+	for name, value := range MainConfigSchema.GetDefaults() {
+		if _, ok := globals.GLOBALS["wg"+name]; ok {
+			continue
+		}
+		if appConfig.Has(name) {
+			value = appConfig.Get(name)
+		}
+		globals.GLOBALS["wg"+name] = value
+	}
+}
+
+/**
+ * Path of the settings file of the site: the file MW_CONFIG_FILE names, or
+ * else LocalSettings.yaml, LocalSettings.yml or LocalSettings.json in the
+ * current directory.
+ *
+ * @return string Empty if there is no settings file
+ */
+func GetLocalSettingsPath() string {
+	if path := os.Getenv(config.SETTINGS_FILE_ENV); path != "" {
+		return path
+	}
+	for _, path := range []string{"LocalSettings.yaml", "LocalSettings.yml", "LocalSettings.json"} {
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return ""
+}
+
+/**
+ * Set the $wg globals to the settings of the settings file of the site, then
+ * of the MW_SETTING_* environment variables, over the defaults
+ * ApplyDefaultSettings() set, as LocalSettings.php does after
+ * DefaultSettings.php.
+ *
+ * @param string $path Settings file, or empty for none
+ * @param string[] $environ "NAME=value" strings, as os.Environ() returns
+ * @return ConfigException|null If a setting is unknown or has the wrong type;
+ *  the globals are not changed then
+ */
+func LoadLocalSettings(path string, environ []string) error {
+	loader := config.NewSettingsLoader(MainConfigSchema)
+	if path != "" {
+		if err := loader.LoadFile(path); err != nil {
+			return err
+		}
+	}
+	if err := loader.LoadEnvironment(environ); err != nil {
+		return err
+	}
+	if globals.GLOBALS == nil {
+		globals.GLOBALS = make(map[string]interface{})
+	}
+	for name, value := range loader.GetSettings() {
+		globals.GLOBALS["wg"+name] = value
+	}
+	return nil
+}
+
+/**
+ * Check the settings of MainConfigSchema, from the $wg globals and app.conf,
+ * against their types, so that startup fails instead of the code reading them.
+ *
+ * @return ConfigException|null All the invalid settings
+ */
+func ValidateMainConfig() error {
+	var errors []string
+	appConfig := config.NewParsedConfig(&config.Configs, MainConfigSchema)
+	for _, name := range MainConfigSchema.GetNames() {
+		if value, ok := globals.GLOBALS["wg"+name]; ok {
+			if err := MainConfigSchema.Validate(name, value); err != nil {
+				errors = append(errors, fmt.Sprintf("$wg%s: %s", name, err))
+			}
+		} else if appConfig.Has(name) {
+			if _, err := MainConfigSchema.ParseValue(name, config.Configs.GetString(name)); err != nil {
+				errors = append(errors, fmt.Sprintf("app.conf: %s", err))
+			}
+		}
+	}
+	if len(errors) > 0 {
+		return exception.NewConfigExceptionWithMessage("Invalid configuration:\n* " + strings.Join(errors, "\n* "))
+	}
+	return nil
+}
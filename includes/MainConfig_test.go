@@ -0,0 +1,103 @@
+package includes
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes/config"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * The tests run in includes/, app.conf is in the root of the repository
+ */
+func TestMain(m *testing.M) {
+	config.ConfigFile = "../conf/app.conf"
+	os.Exit(m.Run())
+}
+
+/**
+ * Run the test with its own globals
+ */
+func setMainConfigGlobals(t *testing.T, values map[string]interface{}) {
+	oldGlobals := globals.GLOBALS
+	globals.GLOBALS = values
+	t.Cleanup(func() {
+		globals.GLOBALS = oldGlobals
+	})
+}
+
+/**
+ * @covers MainConfigSchema
+ */
+func TestNewMainConfig(t *testing.T) {
+	setMainConfigGlobals(t, map[string]interface{}{"wgLanguageCode": "de", "wgSitename": "Wiki"})
+	mainConfig := NewMainConfig()
+	test.AssertEqual(t, "de", mainConfig.Get("LanguageCode"), `$wg globals have precedence`)
+	test.AssertEqual(t, consts.MW_VERSION, mainConfig.Get("Version"), `Defaults of the schema`)
+	test.AssertEqual(t, true, mainConfig.Get("Actions").(map[string]interface{})["edit"], `Default actions`)
+	test.AssertEqual(t, "Wiki", mainConfig.Get("Sitename"), `$wg globals the schema does not know`)
+	test.AssertTrue(t, !mainConfig.Has("Unknown"), `Unknown setting`)
+}
+
+/**
+ * @covers ::ApplyDefaultSettings
+ */
+func TestApplyDefaultSettings(t *testing.T) {
+	setMainConfigGlobals(t, map[string]interface{}{"wgLanguageCode": "de"})
+	ApplyDefaultSettings()
+	test.AssertEqual(t, "de", globals.GLOBALS["wgLanguageCode"], `Settings are kept`)
+	test.AssertEqual(t, "extensions", globals.GLOBALS["wgExtensionDirectory"], `Defaults are set`)
+	test.AssertEqual(t, len(MainConfigSchema.GetNames()), len(globals.GLOBALS), `All the settings`)
+
+	globals.GLOBALS["wgActions"].(map[string]interface{})["edit"] = false
+	test.AssertEqual(t, true, MainConfigSchema.GetDefaults()["Actions"].(map[string]interface{})["edit"],
+		`The defaults are copies`)
+}
+
+/**
+ * @covers ::ValidateMainConfig
+ */
+func TestValidateMainConfig(t *testing.T) {
+	setMainConfigGlobals(t, map[string]interface{}{})
+	ApplyDefaultSettings()
+	test.AssertEqual(t, nil, ValidateMainConfig(), `Defaults are valid`)
+
+	globals.GLOBALS["wgMsgCacheExpiry"] = "1 day"
+	globals.GLOBALS["wgEnableEmail"] = 1
+	err := ValidateMainConfig()
+	test.AssertTrue(t, err != nil, `Invalid settings`)
+	test.AssertTrue(t, strings.Contains(fmt.Sprint(err), "Invalid configuration:\n"+
+		"* $wgEnableEmail: EnableEmail must be of type boolean, integer given\n"+
+		"* $wgMsgCacheExpiry: MsgCacheExpiry must be of type integer, string given"), `All the invalid settings`)
+}
+
+/**
+ * @covers ::LoadLocalSettings
+ */
+func TestLoadLocalSettings(t *testing.T) {
+	setMainConfigGlobals(t, map[string]interface{}{})
+	ApplyDefaultSettings()
+	path := filepath.Join(t.TempDir(), "LocalSettings.yaml")
+	if err := ioutil.WriteFile(path, []byte("LanguageCode: de\nMsgCacheExpiry: 3600\nExtraNamespaces:\n  100: Portal\n"),
+		0644); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, nil, LoadLocalSettings(path, []string{"MW_SETTING_MSG_CACHE_EXPIRY=60", "MW_TEST_GLOBAL_STATE=1"}), `Valid settings`)
+	test.AssertEqual(t, "de", globals.GLOBALS["wgLanguageCode"], `Setting of the file`)
+	test.AssertEqual(t, 60, globals.GLOBALS["wgMsgCacheExpiry"], `The environment overrides the file`)
+	test.AssertEqual(t, "Portal", globals.GLOBALS["wgExtraNamespaces"].(map[string]interface{})["100"],
+		`Namespace numbers as keys`)
+	test.AssertEqual(t, true, globals.GLOBALS["wgEnableEmail"], `Defaults are kept`)
+	test.AssertEqual(t, nil, ValidateMainConfig(), `Valid configuration`)
+
+	err := LoadLocalSettings(path, []string{"MW_SETTING_LANGUAGE_CODE=en", "MW_SETTING_ENABLE_EMAIL=maybe"})
+	test.AssertTrue(t, strings.Contains(fmt.Sprint(err), "MW_SETTING_ENABLE_EMAIL"), `Invalid settings`)
+	test.AssertEqual(t, 60, globals.GLOBALS["wgMsgCacheExpiry"], `The globals are not changed on errors`)
+}
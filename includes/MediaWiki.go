@@ -4,9 +4,24 @@
 package includes
 
 import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/MangoDowner/mediawiki/includes/cache"
+	"github.com/MangoDowner/mediawiki/includes/config"
+	"github.com/MangoDowner/mediawiki/includes/libs/stats"
+	"github.com/MangoDowner/mediawiki/includes/objectcache"
 	"github.com/astaxie/beego"
+	"github.com/astaxie/beego/logs"
 )
 
+/** Largest UDP packet sent to the statsd server, in bytes */
+const STATSD_MAX_PACKET_SIZE = 512
+
+/** Most requests whose statsd data may wait to be sent */
+const STATSD_QUEUE_SIZE = 100
+
 /**
  * The MediaWiki class is the helper class for the index.php entry point.
  */
@@ -60,3 +75,106 @@ func (m *MediaWiki) main() error {
 	//}
 	return nil
 }
+
+/**
+ * This function does work that can be done *after* the
+ * user gets the HTTP response so they don't block on it
+ *
+ * The routers call it once the response of each request is sent.
+ *
+ * @param Context $ctx Context of the request
+ */
+func DoPostOutputShutdown(ctx context.Context) {
+	// Run the tasks the caches deferred, report the stats of the request and
+	// reset the per-request limits of the caches
+	objectcache.ReportRequestStats(ctx)
+	cache.SingletonGenderCache().ResetMisses()
+	services := GetMediaWikiServices()
+	EmitBufferedStatsdData(services.GetStatsdDataFactory(), services.GetMainConfig())
+}
+
+/**
+ * Send out any buffered statsd data
+ *
+ * The data is sent by a background goroutine, not to delay the request.
+ *
+ * @param IBufferingStatsdDataFactory $stats
+ * @param Config $config
+ * @since 1.31
+ */
+func EmitBufferedStatsdData(stats *stats.BufferingStatsdDataFactory, config config.IConfig) {
+	server, _ := config.Get("StatsdServer").(string)
+	if server == "" || !stats.HasData() {
+		return
+	}
+	// Empty the buffer for the next round, even if sending fails
+	data := stats.DrainData()
+	if len(data) == 0 {
+		return
+	}
+
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "8125")
+	}
+	statsdSenderOnce.Do(func() {
+		go func() {
+			for batch := range statsdQueue {
+				sendStatsdData(batch.server, batch.data)
+			}
+		}()
+	})
+	select {
+	case statsdQueue <- statsdBatch{server: server, data: data}:
+	default:
+		logs.Warn("Dropped %d statsd metrics, the sending is too slow", len(data))
+	}
+}
+
+/**
+ * Metrics waiting to be sent to a statsd server
+ */
+type statsdBatch struct {
+	server string
+	data   []stats.StatsdData
+}
+
+/** Batches of metrics EmitBufferedStatsdData() gives to the sending goroutine */
+var statsdQueue = make(chan statsdBatch, STATSD_QUEUE_SIZE)
+var statsdSenderOnce sync.Once
+
+/**
+ * @param string $server Host and port of the statsd server
+ * @param StatsdData[] $data
+ */
+func sendStatsdData(server string, data []stats.StatsdData) {
+	conn, err := net.Dial("udp", server)
+	if err != nil {
+		logs.Warn("Cannot send the statsd data to %s: %s", server, err)
+		return
+	}
+	defer conn.Close()
+
+	send := func(packet string) bool {
+		if _, err := conn.Write([]byte(packet)); err != nil {
+			logs.Warn("Cannot send the statsd data to %s: %s", server, err)
+			return false
+		}
+		return true
+	}
+	// Several metrics per packet, one per line
+	packet := ""
+	for _, item := range data {
+		line := item.String()
+		if packet != "" && len(packet)+1+len(line) > STATSD_MAX_PACKET_SIZE {
+			if !send(packet) {
+				return
+			}
+			packet = ""
+		}
+		if packet != "" {
+			packet += "\n"
+		}
+		packet += line
+	}
+	send(packet)
+}
@@ -6,7 +6,13 @@
 package includes
 
 import (
+	"sync"
+
 	"github.com/MangoDowner/mediawiki/includes/config"
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	"github.com/MangoDowner/mediawiki/includes/libs/rdbms/database"
+	"github.com/MangoDowner/mediawiki/includes/libs/stats"
+	"github.com/MangoDowner/mediawiki/includes/parser"
 )
 
 /**
@@ -16,42 +22,90 @@ import (
  * the network of service objects that defines MediaWiki's application logic.
  * It acts as an entry point to MediaWiki's dependency injection mechanism.
  *
- * Services are defined in the "wiring" array passed to the constructor,
- * or by calling defineService().
+ * Services are defined in the wirings listed in WgServiceWiringFiles,
+ * or by calling DefineService().
  *
  * @see docs/injection.txt for an overview of using dependency injection in the
  *      MediaWiki code base.
  */
-
- type MediaWikiServices struct {
-	instance interface{}
-	ServiceContainer
+type MediaWikiServices struct {
+	*ServiceContainer
 }
 
+/** @var MediaWikiServices|null */
+var mediaWikiServicesInstance *MediaWikiServices
+var mediaWikiServicesMutex sync.Mutex
+
+/** @var bool Whether GetMediaWikiServices() fails, see SetGlobalInstanceForbidden() */
+var mediaWikiServicesForbidden bool
+
 /**
  * Returns the global default instance of the top level service locator.
  *
  * @since 1.27
  *
  * The default instance is initialized using the service instantiator functions
- * defined in ServiceWiring.php.
+ * defined in the wirings of WgServiceWiringFiles.
  *
  * @note This should only be called by static functions! The instance returned here
  * should not be passed around! Objects that need access to a service should have
  * that service injected into the constructor, never a service locator!
  *
  * @return MediaWikiServices
+ * @throws MWException If access to the global instance is forbidden
  */
-func (m *MediaWikiServices) getInstance() interface{} {
-	if m.instance == nil {
+func GetMediaWikiServices() *MediaWikiServices {
+	mediaWikiServicesMutex.Lock()
+	defer mediaWikiServicesMutex.Unlock()
+	if mediaWikiServicesForbidden {
+		panic(exception.NewMWException(
+			"Premature access to the global service container. Inject the services instead."))
+	}
+	if mediaWikiServicesInstance == nil {
 		// NOTE: constructing GlobalVarConfig here is not particularly pretty,
 		// but some information from the global scope has to be injected here,
 		// even if it's just a file name or database credentials to load
 		// configuration from.
 		bootstrapConfig := config.NewGlobalVarConfig("")
-		m.instance = m.newInstance( bootstrapConfig, "load" )
+		mediaWikiServicesInstance = newMediaWikiServicesInstance(bootstrapConfig, "load")
 	}
-	return m.instance
+	return mediaWikiServicesInstance
+}
+
+/**
+ * Replaces the global MediaWikiServices instance.
+ *
+ * @since 1.28
+ *
+ * @note This is for use in PHPUnit tests only!
+ *
+ * @param MediaWikiServices $services The new MediaWikiServices object.
+ *
+ * @return MediaWikiServices The old MediaWikiServices object, so it can be restored later.
+ */
+func ForceGlobalInstance(services *MediaWikiServices) *MediaWikiServices {
+	mediaWikiServicesMutex.Lock()
+	defer mediaWikiServicesMutex.Unlock()
+	old := mediaWikiServicesInstance
+	mediaWikiServicesInstance = services
+	return old
+}
+
+/**
+ * Makes GetMediaWikiServices() fail, so that tests relying on isolated
+ * service containers notice the code still getting the global one.
+ *
+ * @note This is for use in tests only!
+ *
+ * @param bool $forbidden
+ * @return bool The old setting, so it can be restored later.
+ */
+func SetGlobalInstanceForbidden(forbidden bool) bool {
+	mediaWikiServicesMutex.Lock()
+	defer mediaWikiServicesMutex.Unlock()
+	old := mediaWikiServicesForbidden
+	mediaWikiServicesForbidden = forbidden
+	return old
 }
 
 /**
@@ -63,22 +117,25 @@ func (m *MediaWikiServices) getInstance() interface{} {
  *        'BootstrapConfig' service.
  *
  * @param string $loadWiring set this to 'load' to load the wiring files specified
- *        in the 'ServiceWiringFiles' setting in $bootstrapConfig.
+ *        in WgServiceWiringFiles.
  *
  * @return MediaWikiServices
  * @throws MWException
  * @throws \FatalError
  */
-func (m *MediaWikiServices) newInstance( bootstrapConfig config.IConfig, loadWiring string) *MediaWikiServices {
-	instance := NewMediaWikiServices()
+func newMediaWikiServicesInstance(bootstrapConfig config.IConfig, loadWiring string) *MediaWikiServices {
+	instance := NewMediaWikiServices(bootstrapConfig)
 	// Load the default wiring from the specified files.
-	if loadWiring == "load"  {
-		//wiringFiles := bootstrapConfig.Get("ServiceWiringFiles")
-		//instance.LoadWiringFiles(wiringFiles)
+	if loadWiring == "load" {
+		instance.LoadWiringFiles(WgServiceWiringFiles)
 	}
 
 	// Provide a traditional hook point to allow extensions to configure services.
-	NewHooks().Run("MediaWikiServices", []interface{}{*instance}, "")
+	hookContainer := GetHookContainer()
+	if instance.HasService("HookContainer") {
+		hookContainer = instance.GetHookContainer()
+	}
+	NewHookRunner(hookContainer).OnMediaWikiServices(instance)
 	return instance
 }
 
@@ -87,24 +144,122 @@ func (m *MediaWikiServices) newInstance( bootstrapConfig config.IConfig, loadWir
  *        This has to contain at least the information needed to set up the 'ConfigFactory'
  *        service.
  */
-func NewMediaWikiServices() *MediaWikiServices {
+func NewMediaWikiServices(bootstrapConfig config.IConfig) *MediaWikiServices {
 	this := new(MediaWikiServices)
+	this.ServiceContainer = NewServiceContainer(nil)
 	// Register the given Config object as the bootstrap config service.
-	this.DefineService( "BootstrapConfig",
-		func () {
-			//TODO: 方法参数不一样咋办?
+	DefineService(this.ServiceContainer, "BootstrapConfig",
+		func(services *ServiceContainer) (config.IConfig, error) {
+			return bootstrapConfig, nil
 		},
 	)
 	return this
 }
 
+/**
+ * Returns the Config object containing the bootstrap configuration.
+ * Bootstrap configuration would typically include database credentials
+ * and other information that may be needed before the ConfigFactory
+ * service can be instantiated.
+ *
+ * @note This should only be used during bootstrapping, in particular
+ * when creating the MainConfig service. Application logic should
+ * use getMainConfig() to get a Config instances.
+ *
+ * @since 1.27
+ * @return Config
+ */
+func (m *MediaWikiServices) GetBootstrapConfig() config.IConfig {
+	return mustGetService[config.IConfig](m.ServiceContainer, "BootstrapConfig")
+}
+
+/**
+ * @since 1.27
+ * @return ConfigFactory
+ */
+func (m *MediaWikiServices) GetConfigFactory() *config.ConfigFactory {
+	return mustGetService[*config.ConfigFactory](m.ServiceContainer, "ConfigFactory")
+}
+
+/**
+ * Returns the Config object that provides configuration for MediaWiki core.
+ *
+ * @since 1.27
+ * @return Config
+ */
+func (m *MediaWikiServices) GetMainConfig() config.IConfig {
+	return mustGetService[config.IConfig](m.ServiceContainer, "MainConfig")
+}
+
+/**
+ * @since 1.27
+ * @return IBufferingStatsdDataFactory
+ */
+func (m *MediaWikiServices) GetStatsdDataFactory() *stats.BufferingStatsdDataFactory {
+	return mustGetService[*stats.BufferingStatsdDataFactory](m.ServiceContainer, "StatsdDataFactory")
+}
+
+/**
+ * The connection to the main wiki database
+ *
+ * @return IDatabase
+ */
+func (m *MediaWikiServices) GetMainDatabase() database.IDatabase {
+	return mustGetService[database.IDatabase](m.ServiceContainer, "MainDatabase")
+}
+
+/**
+ * @since 1.36
+ * @return PageStore
+ */
+func (m *MediaWikiServices) GetPageStore() *PageStore {
+	return mustGetService[*PageStore](m.ServiceContainer, "PageStore")
+}
+
+/**
+ * @since 1.35
+ * @return UserOptionsLookup
+ */
+func (m *MediaWikiServices) GetUserOptionsLookup() *UserOptionsLookup {
+	return mustGetService[*UserOptionsLookup](m.ServiceContainer, "UserOptionsLookup")
+}
+
+/**
+ * @since 1.35
+ * @return HookContainer
+ */
+func (m *MediaWikiServices) GetHookContainer() *HookContainer {
+	return mustGetService[*HookContainer](m.ServiceContainer, "HookContainer")
+}
+
+/**
+ * @since 1.32
+ * @return Parser
+ */
+func (m *MediaWikiServices) GetParser() *parser.Parser {
+	return mustGetService[*parser.Parser](m.ServiceContainer, "Parser")
+}
+
 /**
  * @since 1.32
  * @return SpecialPageFactory
  */
 func (m *MediaWikiServices) GetSpecialPageFactory() *SpecialPageFactory {
-	return m.GetService("SpecialPageFactory")
+	return mustGetService[*SpecialPageFactory](m.ServiceContainer, "SpecialPageFactory")
 }
 
-
-
+/**
+ * Get a service the code cannot do without
+ *
+ * @param ServiceContainer $container
+ * @param string $name The service name
+ * @return object The service instance
+ * @throws Exception The error getting the service
+ */
+func mustGetService[T any](container *ServiceContainer, name string) T {
+	service, err := GetService[T](container, name)
+	if err != nil {
+		panic(err)
+	}
+	return service
+}
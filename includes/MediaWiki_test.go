@@ -0,0 +1,55 @@
+package includes
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MangoDowner/mediawiki/includes/config"
+	"github.com/MangoDowner/mediawiki/includes/libs/stats"
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * @covers MediaWiki::emitBufferedStatsdData
+ */
+func TestEmitBufferedStatsdData(t *testing.T) {
+	server, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip("Cannot listen on UDP: " + err.Error())
+	}
+	defer server.Close()
+
+	factory := stats.NewBufferingStatsdDataFactory("MediaWiki")
+	EmitBufferedStatsdData(factory, config.NewHashConfig(map[string]interface{}{
+		"StatsdServer": server.LocalAddr().String(),
+	}))
+	factory.Increment("foo")
+	factory.Timing("bar", 12.5)
+	for i := 0; i < 100; i++ {
+		factory.Increment("many")
+	}
+	EmitBufferedStatsdData(factory, config.NewHashConfig(map[string]interface{}{"StatsdServer": ""}))
+	test.AssertEqual(t, 102, factory.GetDataCount(), `Nothing is sent without a server`)
+
+	EmitBufferedStatsdData(factory, config.NewHashConfig(map[string]interface{}{
+		"StatsdServer": server.LocalAddr().String(),
+	}))
+	test.AssertEqual(t, false, factory.HasData(), `The buffer is emptied`)
+
+	var lines []string
+	buffer := make([]byte, 2*STATSD_MAX_PACKET_SIZE)
+	for len(lines) < 102 {
+		server.SetReadDeadline(time.Now().Add(5 * time.Second))
+		n, _, err := server.ReadFrom(buffer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertTrue(t, n <= STATSD_MAX_PACKET_SIZE, `Packets are small enough`)
+		lines = append(lines, strings.Split(string(buffer[:n]), "\n")...)
+	}
+	test.AssertEqual(t, "MediaWiki.foo:1|c", lines[0], `Counter`)
+	test.AssertEqual(t, "MediaWiki.bar:12.5|ms", lines[1], `Timing`)
+	test.AssertEqual(t, "MediaWiki.many:1|c", lines[101], `All the metrics are sent`)
+}
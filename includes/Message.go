@@ -1,9 +1,20 @@
 package includes
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes/cache"
+	"github.com/MangoDowner/mediawiki/includes/exception"
 	"github.com/MangoDowner/mediawiki/includes/languages"
 	"github.com/MangoDowner/mediawiki/includes/libs"
+	"github.com/MangoDowner/mediawiki/includes/parser"
+	"github.com/MangoDowner/mediawiki/includes/php"
+	"github.com/astaxie/beego/logs"
 	"reflect"
+	"strconv"
 )
 
 /**
@@ -153,11 +164,22 @@ const MESSAGE_FORMAT_TEXT = "text"
 /** Transform {{..}} constructs, HTML-escape the result */
 const MESSAGE_FORMAT_ESCAPED = "escaped"
 
-var listTypeMap = map[string]string {
-	"comma" : "commaList",
-	"semicolon" : "semicolonList",
-	"pipe" : "pipeList",
-	"text" : "listToText",
+/**
+ * Mapping from Message::listParam() types to Language methods.
+ * @var array
+ */
+var listTypeMap = map[string]func(*languages.Language, []string) string{
+	"comma":     (*languages.Language).CommaList,
+	"semicolon": (*languages.Language).SemicolonList,
+	"pipe":      (*languages.Language).PipeList,
+	"text":      (*languages.Language).ListToText,
+}
+
+func init() {
+	// The languages package cannot import this one to build its messages
+	languages.MessageFactory = func(lang *languages.Language, key string, params ...interface{}) languages.IMessage {
+		return NewMessage(key, params, lang)
+	}
 }
 
 type Message struct {
@@ -206,7 +228,7 @@ type Message struct {
 	/**
 	 * @var Title Title object to use as context.
 	 */
-	title string
+	title *Title
 
 	/**
 	 * @var Content Content object representing the message.
@@ -217,6 +239,16 @@ type Message struct {
 	 * @var string
 	 */
 	message string
+
+	/**
+	 * @var bool Whether the message was fetched, and whether it exists
+	 */
+	fetched, exists bool
+
+	/**
+	 * @var bool Whether the key is the message itself, see RawMessage
+	 */
+	raw bool
 }
 
 /**
@@ -251,7 +283,11 @@ func NewMessage(key interface{}, params []interface{}, language *languages.Langu
 	this.format = "parse"
 	this.useDatabase = true
 
-	this.keysToTry = key.([]string)
+	if keys, ok := key.([]string); ok {
+		this.keysToTry = keys
+	} else {
+		this.keysToTry = []string{key.(string)}
+	}
 	if len(this.keysToTry) == 0 {
 		panic("$key must not be an empty list")
 	}
@@ -263,6 +299,112 @@ func NewMessage(key interface{}, params []interface{}, language *languages.Langu
 	return this
 }
 
+/**
+ * Factory function accepting multiple message keys and returning a message instance
+ * for the first message which is non-empty. If all messages are empty then an
+ * instance of the first message key is returned.
+ *
+ * @since 1.18
+ *
+ * @param string|string[] $keys,... Message keys, or first argument as an array of all the
+ * message keys.
+ *
+ * @return Message
+ */
+func NewFallbackSequence(keys ...string) *Message {
+	return NewMessage(keys, nil, nil)
+}
+
+/**
+ * Transform a MessageSpecifier or a primitive value used interchangeably with
+ * specifiers (a message key string, or a key + params array) into a proper Message.
+ *
+ * Also accepts a MessageSpecifier inside an array: that's not considered a valid format
+ * but is an easy error to make due to how StatusValue stores messages internally.
+ * Further array elements are ignored in that case.
+ *
+ * @param string|array|MessageSpecifier $value
+ * @return Message
+ * @throws InvalidArgumentException
+ * @since 1.27
+ */
+func NewMessageFromSpecifier(value interface{}) *Message {
+	var params []interface{}
+	if values, ok := value.([]interface{}); ok && len(values) > 0 {
+		value, params = values[0], values[1:]
+	}
+
+	switch v := value.(type) {
+	case *Message:
+		// Message, RawMessage, ApiMessage, etc
+		message := *v
+		return &message
+	case libs.MessageSpecifier:
+		return NewMessage(v, nil, nil)
+	case string:
+		return NewMessage(v, params, nil)
+	}
+	panic(exception.NewMWException(fmt.Sprintf("Message::newFromSpecifier: invalid argument type %T", value)))
+}
+
+/**
+ * @since 1.24
+ *
+ * @return bool True if this is a multi-key message, that is, if the key provided to the
+ * constructor was a fallback list of keys to try.
+ */
+func (m *Message) IsMultiKey() bool {
+	return len(m.keysToTry) > 1
+}
+
+/**
+ * @since 1.24
+ *
+ * @return string[] The list of keys to try when fetching the message text,
+ * in order of preference.
+ */
+func (m *Message) GetKeysToTry() []string {
+	return m.keysToTry
+}
+
+/**
+ * Returns the message key.
+ *
+ * If a list of multiple possible keys was supplied to the constructor, this method may
+ * return any of these keys. After the message has been fetched, this method will return
+ * the key that was actually used to fetch the message.
+ *
+ * @since 1.21
+ *
+ * @return string
+ */
+func (m *Message) GetKey() string {
+	return m.key
+}
+
+/**
+ * Returns the message parameters.
+ *
+ * @since 1.21
+ *
+ * @return array
+ */
+func (m *Message) GetParams() []interface{} {
+	return m.parameters
+}
+
+/**
+ * Returns the message format.
+ *
+ * @since 1.21
+ *
+ * @return string
+ * @deprecated since 1.29 formatting is not stateful
+ */
+func (m *Message) GetFormat() string {
+	return m.format
+}
+
 /**
  * Returns the Language of the Message.
  *
@@ -276,7 +418,8 @@ func (m *Message) GetLanguage() *languages.Language {
 	}
 	// Defaults to false which means current user language
 	//return NewRequestContext().GetMain().GetLanguage()
-	return nil
+	// TODO: use the user language once the main request context exists
+	return getContentLanguage()
 }
 
 /**
@@ -311,6 +454,270 @@ func (m *Message) Params(params ...interface{}) *Message {
 	return m
 }
 
+/**
+ * Add parameters that are substituted after parsing or escaping.
+ * In other words the parsing process cannot access the contents
+ * of this type of parameter, and you need to make sure it is
+ * sanitized beforehand.  The parser will see "$n", instead.
+ *
+ * @since 1.17
+ *
+ * @param mixed $params,... Raw parameters as strings, or a single argument that is
+ * an array of raw parameters.
+ *
+ * @return Message $this
+ */
+func (m *Message) RawParams(params ...string) *Message {
+	for _, param := range params {
+		m.parameters = append(m.parameters, RawParam(param))
+	}
+	return m
+}
+
+/**
+ * Add parameters that are numeric and will be passed through
+ * Language::formatNum before substitution
+ *
+ * @since 1.18
+ *
+ * @param mixed $param,... Numeric parameters, or a single argument that is
+ * an array of numeric parameters.
+ *
+ * @return Message $this
+ */
+func (m *Message) NumParams(params ...interface{}) *Message {
+	for _, param := range params {
+		m.parameters = append(m.parameters, NumParam(param))
+	}
+	return m
+}
+
+/**
+ * Add parameters that are durations of time and will be passed through
+ * Language::formatDuration before substitution
+ *
+ * @since 1.22
+ *
+ * @param int|int[] $param,... Duration parameters, or a single argument that is
+ * an array of duration parameters.
+ *
+ * @return Message $this
+ */
+func (m *Message) DurationParams(params ...int) *Message {
+	for _, param := range params {
+		m.parameters = append(m.parameters, DurationParam(param))
+	}
+	return m
+}
+
+/**
+ * Add parameters that are expiration times and will be passed through
+ * Language::formatExpiry before substitution
+ *
+ * @since 1.22
+ *
+ * @param string|string[] $param,... Expiry parameters, or a single argument that is
+ * an array of expiry parameters.
+ *
+ * @return Message $this
+ */
+func (m *Message) ExpiryParams(params ...string) *Message {
+	for _, param := range params {
+		m.parameters = append(m.parameters, ExpiryParam(param))
+	}
+	return m
+}
+
+/**
+ * Add parameters that are time periods and will be passed through
+ * Language::formatTimePeriod before substitution
+ *
+ * @since 1.22
+ *
+ * @param int|int[] $param,... Time period parameters, or a single argument that is
+ * an array of time period parameters.
+ *
+ * @return Message $this
+ */
+func (m *Message) TimeperiodParams(params ...float64) *Message {
+	for _, param := range params {
+		m.parameters = append(m.parameters, TimeperiodParam(param))
+	}
+	return m
+}
+
+/**
+ * Add parameters that are file sizes and will be passed through
+ * Language::formatSize before substitution
+ *
+ * @since 1.22
+ *
+ * @param int|int[] $param,... Size parameters, or a single argument that is
+ * an array of size parameters.
+ *
+ * @return Message $this
+ */
+func (m *Message) SizeParams(params ...int64) *Message {
+	for _, param := range params {
+		m.parameters = append(m.parameters, SizeParam(param))
+	}
+	return m
+}
+
+/**
+ * Add parameters that are bitrates and will be passed through
+ * Language::formatBitrate before substitution
+ *
+ * @since 1.22
+ *
+ * @param int|int[] $param,... Bit rate parameters, or a single argument that is
+ * an array of bit rate parameters.
+ *
+ * @return Message $this
+ */
+func (m *Message) BitrateParams(params ...int64) *Message {
+	for _, param := range params {
+		m.parameters = append(m.parameters, BitrateParam(param))
+	}
+	return m
+}
+
+/**
+ * Add parameters that are plaintext and will be passed through without
+ * the content being evaluated.  Plaintext parameters are not valid as
+ * arguments to parser functions. This differs from self::rawParams in
+ * that the Message class handles escaping to match the output format.
+ *
+ * @since 1.25
+ *
+ * @param string|string[] $param,... plaintext parameters, or a single argument that is
+ * an array of plaintext parameters.
+ *
+ * @return Message $this
+ */
+func (m *Message) PlaintextParams(params ...string) *Message {
+	for _, param := range params {
+		m.parameters = append(m.parameters, PlaintextParam(param))
+	}
+	return m
+}
+
+/**
+ * Request the message in any language that is supported.
+ *
+ * As a side effect interface message status is unconditionally
+ * turned off.
+ *
+ * @since 1.17
+ * @param Language|string $lang Language code or Language object.
+ * @return Message $this
+ * @throws MWException
+ */
+func (m *Message) InLanguage(lang interface{}) *Message {
+	previousLanguage := m.language
+
+	switch l := lang.(type) {
+	case *languages.Language:
+		m.language = l
+	case string:
+		if m.language == nil || m.language.GetCode() != l {
+			m.language = languages.Factory(l)
+		}
+	default:
+		panic(exception.NewMWException(fmt.Sprintf(
+			"Message::inLanguage must be passed a String or Language object; %T given", lang)))
+	}
+
+	if m.language != previousLanguage {
+		// The language has changed. Clear the message cache.
+		m.clearMessage()
+	}
+	m.interfaces = false
+	return m
+}
+
+/**
+ * Request the message in the wiki's content language,
+ * unless it is disabled for this message.
+ *
+ * @since 1.17
+ * @see $wgForceUIMsgAsContentMsg
+ *
+ * @return Message $this
+ */
+func (m *Message) InContentLanguage() *Message {
+	if forceUIMsg, ok := globals.GLOBALS["wgForceUIMsgAsContentMsg"].([]string); ok {
+		for _, key := range forceUIMsg {
+			if key == m.key {
+				return m
+			}
+		}
+	}
+	return m.InLanguage(getContentLanguage())
+}
+
+/**
+ * Request the message in the user's current language, overriding
+ * any explicit language that was set previously.
+ *
+ * @since 1.17
+ *
+ * @return Message $this
+ */
+func (m *Message) InUserLanguage() *Message {
+	if m.language != nil {
+		// The language has changed. Clear the message cache.
+		m.clearMessage()
+	}
+	m.language = nil
+	m.interfaces = true
+	return m
+}
+
+/**
+ * Allows manipulating the interface message flag directly.
+ * Can be used to restore the flag after setting a language.
+ *
+ * @since 1.20
+ *
+ * @param bool $interface
+ *
+ * @return Message $this
+ */
+func (m *Message) SetInterfaceMessageFlag(interfaces bool) *Message {
+	m.interfaces = interfaces
+	return m
+}
+
+/**
+ * Set the Title object to use as context when transforming the message
+ *
+ * @since 1.18
+ *
+ * @param Title $title
+ *
+ * @return Message $this
+ */
+func (m *Message) Title(title *Title) *Message {
+	m.title = title
+	return m
+}
+
+/**
+ * Enable or disable database use.
+ *
+ * @since 1.17
+ *
+ * @param bool $useDatabase
+ *
+ * @return Message $this
+ */
+func (m *Message) UseDatabase(useDatabase bool) *Message {
+	m.useDatabase = useDatabase
+	m.clearMessage()
+	return m
+}
+
 /**
  * Returns the message parsed from wikitext to HTML.
  *
@@ -327,8 +734,36 @@ func (m *Message) ToString(format string) (ret string) {
 		//TODO: no warning text
 		format = m.format
 	}
-	//strings := m.FetchMessage()
-	return ret
+	if !m.Exists() {
+		// Err on the side of safety, ensure that the output
+		// is always html safe in the event the message key is
+		// missing, since in that case its highly likely the
+		// message key is user-controlled.
+		// '⧼' is used instead of '<' to side-step any
+		// double-escaping issues.
+		// (Keep synchronised with mw.Message#toString in JS.)
+		return "⧼" + php.Htmlspecialchars(m.key) + "⧽"
+	}
+	str := m.FetchMessage()
+
+	// Replace parameters before text parsing
+	str = m.replaceParameters(str, "before", format)
+
+	// Maybe transform using the full parser
+	// Wikitext is not parsed to HTML yet, so the parse formats are escaped
+	// like FORMAT_ESCAPED: the markup of the message and of its plain
+	// parameters must not reach the page as it is.
+	// TODO: parse wikitext to HTML for the parse formats
+	switch format {
+	case MESSAGE_FORMAT_TEXT:
+		str = m.transformText(str)
+	case MESSAGE_FORMAT_PARSE, MESSAGE_FORMAT_BLOCK_PARSE, MESSAGE_FORMAT_ESCAPED:
+		str = php.Htmlspecialchars(m.transformText(str))
+	}
+
+	// Raw parameter replacement
+	str = m.replaceParameters(str, "after", format)
+	return str
 }
 
 /**
@@ -397,6 +832,219 @@ func (m *Message) Escaped() (ret string) {
 	return ret
 }
 
+/**
+ * Substitutes any parameters into the message text.
+ *
+ * @since 1.17
+ *
+ * @param string $message The message text.
+ * @param string $type Either "before" or "after".
+ * @param string $format One of the FORMAT_* constants.
+ *
+ * @return string
+ */
+func (m *Message) replaceParameters(message, paramType, format string) string {
+	replacementKeys := make(map[string]string)
+	for n, param := range m.parameters {
+		extractedType, value := m.extractParam(param, format)
+		if extractedType == paramType {
+			replacementKeys["$"+strconv.Itoa(n+1)] = value
+		}
+	}
+	return php.Strtr(message, replacementKeys)
+}
+
+/**
+ * Extracts the parameter type and preprocessed the value if needed.
+ *
+ * @since 1.18
+ *
+ * @param mixed $param Parameter as defined in this class.
+ * @param string $format One of the FORMAT_* constants.
+ *
+ * @return array Array with the parameter type (either "before" or "after") and the value.
+ */
+func (m *Message) extractParam(param interface{}, format string) (string, string) {
+	switch p := param.(type) {
+	case map[string]interface{}:
+		lang := m.GetLanguage()
+		if raw, ok := p["raw"]; ok {
+			return "after", fmt.Sprint(raw)
+		} else if num, ok := p["num"]; ok {
+			// Replace number params always in before step for now.
+			// No support for combined raw and num params
+			return "before", lang.FormatNum(fmt.Sprint(num), false)
+		} else if duration, ok := p["duration"].(int); ok {
+			return "before", lang.FormatDuration(duration, nil)
+		} else if expiry, ok := p["expiry"].(string); ok {
+			return "before", lang.FormatExpiry(expiry, true, "infinity")
+		} else if period, ok := p["period"].(float64); ok {
+			return "before", lang.FormatTimePeriod(period, "", false)
+		} else if size, ok := p["size"].(int64); ok {
+			return "before", lang.FormatSize(size)
+		} else if bitrate, ok := p["bitrate"].(int64); ok {
+			return "before", lang.FormatBitrate(bitrate)
+		} else if plaintext, ok := p["plaintext"].(string); ok {
+			return "after", m.formatPlaintext(plaintext, format)
+		} else if list, ok := p["list"].([]interface{}); ok {
+			listType, _ := p["type"].(string)
+			return m.formatListParam(list, listType, format)
+		}
+		logs.Warn("Invalid parameter for message \"" + m.key + "\": " + fmt.Sprint(p))
+		return "before", "[INVALID]"
+	case *Message:
+		// Match language, flags, etc. to the current message.
+		msg := *p
+		if msg.language != m.language || msg.useDatabase != m.useDatabase {
+			// Cache depends on these parameters
+			msg.clearMessage()
+		}
+		msg.interfaces = m.interfaces
+		msg.language = m.language
+		msg.useDatabase = m.useDatabase
+		msg.title = m.title
+
+		// DWIM
+		if format == MESSAGE_FORMAT_BLOCK_PARSE {
+			format = MESSAGE_FORMAT_PARSE
+		}
+		msg.format = format
+
+		// Message objects should not be before parameters because
+		// then they'll get double escaped. If the message needs to be
+		// escaped, it'll happen right here when we call toString().
+		return "after", msg.ToString(format)
+	default:
+		return "before", fmt.Sprint(p)
+	}
+}
+
+/**
+ * Formats a message parameter wrapped with 'plaintext'. Ensures that
+ * the entire string is displayed unchanged when displayed in the output
+ * format.
+ *
+ * @since 1.25
+ *
+ * @param string $plaintext String to ensure plaintext output of
+ * @param string $format One of the FORMAT_* constants.
+ *
+ * @return string Input plaintext encoded for output to $format
+ */
+func (m *Message) formatPlaintext(plaintext, format string) string {
+	switch format {
+	case MESSAGE_FORMAT_TEXT, MESSAGE_FORMAT_PLAIN:
+		return plaintext
+	default:
+		return php.Htmlspecialchars(plaintext)
+	}
+}
+
+/**
+ * Formats a list of parameters as a concatenated string.
+ * @since 1.29
+ * @param array $params
+ * @param string $listType
+ * @param string $format One of the FORMAT_* constants.
+ * @return array Array with the parameter type (either "before" or "after") and the value.
+ */
+func (m *Message) formatListParam(params []interface{}, listType, format string) (string, string) {
+	function, ok := listTypeMap[listType]
+	if !ok {
+		logs.Warn("Invalid list type for message \"" + m.key + "\": " + listType +
+			" (params are " + fmt.Sprint(params) + ")")
+		return "before", "[INVALID]"
+	}
+	lang := m.GetLanguage()
+
+	// Handle an empty list sensibly
+	if len(params) == 0 {
+		return "before", function(lang, []string{})
+	}
+
+	// First, determine what kinds of list items we have
+	types := make(map[string]bool)
+	var (
+		vars      []string
+		list      []string
+		paramType string
+	)
+	for n, p := range params {
+		extractedType, value := m.extractParam(p, format)
+		types[extractedType] = true
+		paramType = extractedType
+		list = append(list, value)
+		vars = append(vars, "$"+strconv.Itoa(n+1))
+	}
+
+	// Easy case: all are 'before' or 'after', so just join the
+	// values and use the same type.
+	if len(types) == 1 {
+		return paramType, function(lang, list)
+	}
+
+	// Hard case: We need to process each value per its type, then
+	// return the concatenated values as 'after'. We handle this by turning
+	// the list into a RawMessage and processing that as a parameter.
+	return m.extractParam(NewRawMessage(function(lang, vars), params), format)
+}
+
+/**
+ * Wrapper for what ever method we use to transform a message.
+ *
+ * @since 1.17
+ *
+ * @param string $string The message text.
+ *
+ * @return string
+ */
+func (m *Message) transformText(str string) string {
+	options := parser.NewParserOptions("", m.GetLanguage())
+	options.SetInterfaceMessage(m.interfaces)
+	var title parser.ITitle
+	if m.title != nil {
+		title = m.title
+	}
+	return parser.NewParser(cache.SingletonGenderCache()).TransformMsg(str, options, title)
+}
+
+/**
+ * Check whether a message key has been defined currently.
+ *
+ * @since 1.17
+ *
+ * @return bool
+ */
+func (m *Message) Exists() bool {
+	_, exists := m.fetchMessage()
+	return exists
+}
+
+/**
+ * Check whether a message does not exist, or is an empty string
+ *
+ * @since 1.18
+ * @todo FIXME: Merge with isDisabled()?
+ *
+ * @return bool
+ */
+func (m *Message) IsBlank() bool {
+	message, exists := m.fetchMessage()
+	return !exists || message == ""
+}
+
+/**
+ * Check whether a message does not exist, is an empty string, or is "-".
+ *
+ * @since 1.18
+ *
+ * @return bool
+ */
+func (m *Message) IsDisabled() bool {
+	message, exists := m.fetchMessage()
+	return !exists || message == "" || message == "-"
+}
+
 /**
  * Wrapper for what ever method we use to get message contents.
  *
@@ -406,27 +1054,318 @@ func (m *Message) Escaped() (ret string) {
  * @throws MWException If message key array is empty.
  */
 func (m *Message) FetchMessage() string {
-	if m.message != "" {
-		return m.message
+	message, _ := m.fetchMessage()
+	return message
+}
+
+/**
+ * Fetch the message text, trying the keys in order until one of them
+ * is non-empty.
+ *
+ * @return array The message text, and whether it exists
+ */
+func (m *Message) fetchMessage() (string, bool) {
+	if m.fetched {
+		return m.message, m.exists
+	}
+	if m.raw {
+		// The key of a RawMessage is the message
+		m.message, m.exists, m.fetched = m.key, true, true
+		return m.message, m.exists
 	}
 	var (
-		key string
+		key     string
 		message string
+		exists  bool
 	)
-	// TODO: 补充缓存代码
-	//cache := cache2.SingletonMessageCache()
-	//for _, k := range m.keysToTry {
-	//	key = k
-	//	message := cache.Get(key, m.useDatabase, m.getLanguage())
-	//	if message != "" {
-	//		break
-	//	}
-	//}
+	messageCache := cache.SingletonMessageCache()
+	for _, key = range m.keysToTry {
+		message, exists = messageCache.Get(key, m.useDatabase, m.GetLanguage())
+		if exists && message != "" {
+			break
+		}
+	}
 	// NOTE: The constructor makes sure keysToTry isn't empty,
 	//       so we know that $key and $message are initialized.
 	m.key = key
-	m.message = message
-	return m.message
+	m.message, m.exists, m.fetched = message, exists, true
+	return m.message, m.exists
+}
+
+/**
+ * Forget the fetched message text, after a change of its language
+ * or of the database use
+ */
+func (m *Message) clearMessage() {
+	m.message, m.exists, m.fetched = "", false, false
 }
 
+/**
+ * Serialized form of a Message, see Message::serialize()
+ */
+type messageJSON struct {
+	Interface   bool              `json:"interface"`
+	Language    *string           `json:"language"`
+	Key         string            `json:"key"`
+	KeysToTry   []string          `json:"keysToTry"`
+	Parameters  []json.RawMessage `json:"parameters"`
+	Format      string            `json:"format"`
+	UseDatabase bool              `json:"useDatabase"`
+	Title       *messageTitleJSON `json:"title"`
+	Raw         bool              `json:"raw,omitempty"`
+}
 
+/**
+ * Serialized form of the Title context of a Message
+ */
+type messageTitleJSON struct {
+	Namespace int    `json:"namespace"`
+	DBkey     string `json:"dbkey"`
+}
+
+/**
+ * @see Serializable::serialize()
+ * @since 1.26
+ * @return string
+ */
+func (m *Message) MarshalJSON() ([]byte, error) {
+	data := messageJSON{
+		Interface:   m.interfaces,
+		Key:         m.key,
+		KeysToTry:   m.keysToTry,
+		Parameters:  make([]json.RawMessage, 0, len(m.parameters)),
+		Format:      m.format,
+		UseDatabase: m.useDatabase,
+		Raw:         m.raw,
+	}
+	if m.language != nil {
+		code := m.language.GetCode()
+		data.Language = &code
+	}
+	if m.title != nil {
+		data.Title = &messageTitleJSON{Namespace: m.title.GetNamespace(), DBkey: m.title.GetDBkey()}
+	}
+	for _, param := range m.parameters {
+		serialized, err := json.Marshal(param)
+		if err != nil {
+			return nil, err
+		}
+		data.Parameters = append(data.Parameters, serialized)
+	}
+	return json.Marshal(data)
+}
+
+/**
+ * @see Serializable::unserialize()
+ * @since 1.26
+ * @param string $serialized
+ */
+func (m *Message) UnmarshalJSON(serialized []byte) error {
+	var data messageJSON
+	if err := json.Unmarshal(serialized, &data); err != nil {
+		return err
+	}
+	if len(data.KeysToTry) == 0 {
+		return errors.New("Message::unserialize: the message has no keys to try")
+	}
+
+	*m = Message{
+		interfaces:  data.Interface,
+		key:         data.Key,
+		keysToTry:   data.KeysToTry,
+		parameters:  make([]interface{}, 0, len(data.Parameters)),
+		format:      data.Format,
+		useDatabase: data.UseDatabase,
+		raw:         data.Raw,
+	}
+	if data.Language != nil {
+		m.language = languages.Factory(*data.Language)
+	}
+	if data.Title != nil {
+		m.title = NewTitle().MakeTitle(data.Title.Namespace, data.Title.DBkey, "", "")
+	}
+	for _, param := range data.Parameters {
+		value, err := unserializeMessageParam(param)
+		if err != nil {
+			return err
+		}
+		m.parameters = append(m.parameters, value)
+	}
+	return nil
+}
+
+/**
+ * Restore a parameter of a serialized Message, with the types used by
+ * Message::extractParam()
+ *
+ * @param string $serialized
+ * @return mixed
+ */
+func unserializeMessageParam(serialized json.RawMessage) (interface{}, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(serialized, &object); err != nil {
+		// Plain parameter, numbers are kept as they were written
+		var value interface{}
+		decoder := json.NewDecoder(bytes.NewReader(serialized))
+		decoder.UseNumber()
+		err = decoder.Decode(&value)
+		return value, err
+	}
+
+	if _, ok := object["keysToTry"]; ok {
+		message := new(Message)
+		err := json.Unmarshal(serialized, message)
+		return message, err
+	}
+
+	param := make(map[string]interface{}, len(object))
+	for name, value := range object {
+		var err error
+		switch name {
+		case "duration":
+			var duration int
+			err = json.Unmarshal(value, &duration)
+			param[name] = duration
+		case "size", "bitrate":
+			var size int64
+			err = json.Unmarshal(value, &size)
+			param[name] = size
+		case "period":
+			var period float64
+			err = json.Unmarshal(value, &period)
+			param[name] = period
+		case "list":
+			var items []json.RawMessage
+			err = json.Unmarshal(value, &items)
+			list := make([]interface{}, 0, len(items))
+			for _, item := range items {
+				var listItem interface{}
+				if listItem, err = unserializeMessageParam(item); err != nil {
+					break
+				}
+				list = append(list, listItem)
+			}
+			param[name] = list
+		default:
+			param[name], err = unserializeMessageParam(value)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return param, nil
+}
+
+/**
+ * @since 1.18
+ *
+ * @param mixed $num
+ *
+ * @return array Array with a single "num" key.
+ */
+func NumParam(num interface{}) map[string]interface{} {
+	return map[string]interface{}{"num": num}
+}
+
+/**
+ * @since 1.22
+ *
+ * @param int $duration
+ *
+ * @return int[] Array with a single "duration" key.
+ */
+func DurationParam(duration int) map[string]interface{} {
+	return map[string]interface{}{"duration": duration}
+}
+
+/**
+ * @since 1.22
+ *
+ * @param string $expiry
+ *
+ * @return string[] Array with a single "expiry" key.
+ */
+func ExpiryParam(expiry string) map[string]interface{} {
+	return map[string]interface{}{"expiry": expiry}
+}
+
+/**
+ * @since 1.22
+ *
+ * @param number $period
+ *
+ * @return number[] Array with a single "period" key.
+ */
+func TimeperiodParam(period float64) map[string]interface{} {
+	return map[string]interface{}{"period": period}
+}
+
+/**
+ * @since 1.22
+ *
+ * @param int $size
+ *
+ * @return int[] Array with a single "size" key.
+ */
+func SizeParam(size int64) map[string]interface{} {
+	return map[string]interface{}{"size": size}
+}
+
+/**
+ * @since 1.22
+ *
+ * @param int $bitrate
+ *
+ * @return int[] Array with a single "bitrate" key.
+ */
+func BitrateParam(bitrate int64) map[string]interface{} {
+	return map[string]interface{}{"bitrate": bitrate}
+}
+
+/**
+ * @since 1.17
+ *
+ * @param mixed $raw
+ *
+ * @return array Array with a single "raw" key.
+ */
+func RawParam(raw string) map[string]interface{} {
+	return map[string]interface{}{"raw": raw}
+}
+
+/**
+ * @since 1.25
+ *
+ * @param string $plaintext
+ *
+ * @return string[] Array with a single "plaintext" key.
+ */
+func PlaintextParam(plaintext string) map[string]interface{} {
+	return map[string]interface{}{"plaintext": plaintext}
+}
+
+/**
+ * @since 1.29
+ *
+ * @param array $list
+ * @param string $type 'comma', 'semicolon', 'pipe', 'text'
+ * @return array Array with "list" and "type" keys.
+ */
+func ListParam(list []interface{}, listType string) map[string]interface{} {
+	if _, ok := listTypeMap[listType]; !ok {
+		panic(exception.NewMWException("Invalid type '" + listType + "'. Known types are: comma, semicolon, pipe, text"))
+	}
+	return map[string]interface{}{"list": list, "type": listType}
+}
+
+/**
+ * Get the content language, $wgContLang, from $wgLanguageCode
+ *
+ * @return Language
+ */
+func getContentLanguage() *languages.Language {
+	if code, ok := globals.GLOBALS["wgLanguageCode"].(string); ok && code != "" {
+		return languages.Factory(code)
+	}
+	return languages.Factory("en")
+}
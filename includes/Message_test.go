@@ -1,7 +1,13 @@
 package includes
 
 import (
+	"encoding/json"
 	"fmt"
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	"github.com/MangoDowner/mediawiki/includes/cache/localisation"
+	"github.com/MangoDowner/mediawiki/includes/languages"
+	test "github.com/MangoDowner/mediawiki/tests"
 	"testing"
 )
 
@@ -27,4 +33,385 @@ func TestParams(t *testing.T) {
 
 	result = m.Params(h.Element("span", map[string]interface{}{"dir":"auto"}, "")).Parse()
 	fmt.Println(result)
-}
\ No newline at end of file
+}
+/**
+ * @covers Message::toString
+ * @covers Message::replaceParameters
+ */
+func TestMessageTransform(t *testing.T) {
+	m := NewRawMessage("Справка {{GRAMMAR:genitive|$1}}", []interface{}{"Википедия"})
+	m.language = languages.Factory("ru")
+
+	test.AssertEqual(t,
+		"Справка Википедии",
+		m.Text(),
+		`{{GRAMMAR:}} in messages`,
+	)
+
+	test.AssertEqual(t,
+		"Справка {{GRAMMAR:genitive|Википедия}}",
+		m.Plain(),
+		`Plain messages are not transformed`,
+	)
+
+	test.AssertEqual(t,
+		"&lt;b&gt;",
+		NewRawMessage("{{GENDER:Nobody|<b>}}", nil).Escaped(),
+		`Escaped messages`,
+	)
+
+	test.AssertEqual(t,
+		"⧼no-such-message⧽",
+		WfMessage("no-such-message").Text(),
+		`Missing message`,
+	)
+}
+
+/**
+ * Reads the messages from $IP/languages/i18n instead of the working
+ * directory of the test
+ */
+func useCoreMessages() func() {
+	oldDirs := localisation.MessagesDirs
+	localisation.MessagesDirs = map[string][]string{"core": {"../languages/i18n"}}
+	localisation.SingletonLocalisationCache().UnloadAll()
+	return func() {
+		localisation.MessagesDirs = oldDirs
+		localisation.SingletonLocalisationCache().UnloadAll()
+	}
+}
+
+/**
+ * @covers Message::fetchMessage
+ * @covers MessageCache::get
+ */
+func TestFetchMessage(t *testing.T) {
+	defer useCoreMessages()()
+
+	test.AssertEqual(t, "1 minute", NewMessage("duration-minutes", []interface{}{1}, languages.Factory("en")).Text(), `{{PLURAL:}} in messages`)
+	test.AssertEqual(t, "2 Minuten", NewMessage("duration-minutes", []interface{}{2}, languages.Factory("de")).Text(), `German message`)
+	test.AssertEqual(t, ", ", NewMessage("comma-separator", nil, languages.Factory("de")).Text(), `Fallback to English, with whitespace fixed`)
+	test.AssertEqual(t, ", ", NewMessage("Comma-separator", nil, languages.Factory("de-at")).Text(), `Fallback from a variant, with a normalized key`)
+	test.AssertEqual(t, "$1 s", NewMessage("seconds-abbrev", nil, languages.Factory("x!")).Plain(), `Invalid language code`)
+}
+
+/**
+ * @covers Language::formatDuration
+ */
+func TestFormatDuration(t *testing.T) {
+	defer useCoreMessages()()
+	en := languages.Factory("en")
+
+	test.AssertEqual(t, "0 seconds", en.FormatDuration(0, nil), `Zero`)
+	test.AssertEqual(t, "1 second", en.FormatDuration(1, nil), `One second`)
+	test.AssertEqual(t, "1 minute and 5 seconds", en.FormatDuration(65, nil), `Two intervals`)
+	test.AssertEqual(t, "1 day, 1 hour, 1 minute and 1 second", en.FormatDuration(90061, nil), `Four intervals`)
+	test.AssertEqual(t, "1 millennium and 1 hour", en.FormatDuration(31556952000+3600, nil), `Millennium`)
+	test.AssertEqual(t, "1 week and 1 minute", en.FormatDuration(604860, []string{"weeks", "minutes"}), `Chosen intervals`)
+	test.AssertEqual(t, "1,234 hours", en.FormatDuration(1234*3600, []string{"hours"}), `Formatted number`)
+	test.AssertEqual(t,
+		"1 Stunde, 1 Minute und 1 Sekunde",
+		languages.Factory("de").FormatDuration(3661, nil),
+		`German`,
+	)
+}
+
+/**
+ * @covers Language::formatTimePeriod
+ */
+func TestFormatTimePeriodMessages(t *testing.T) {
+	defer useCoreMessages()()
+	en := languages.Factory("en")
+
+	test.AssertEqual(t, "1 h 1 min 1 s", en.FormatTimePeriod(3661, "", false), `Abbreviations`)
+	test.AssertEqual(t, "1 hour 1 minute 1 second", en.FormatTimePeriod(3661, "", true), `noabbrevs`)
+	test.AssertEqual(t, "2 days 1 hour", en.FormatTimePeriod(176460, "avoidminutes", true), `noabbrevs, avoidminutes`)
+	test.AssertEqual(t, "1 Stunde 1 Minute", languages.Factory("de").FormatTimePeriod(3661, "avoidseconds", true), `German`)
+}
+
+/**
+ * @covers Message::rawParams
+ * @covers Message::plaintextParams
+ * @covers Message::extractParam
+ */
+func TestRawAndPlaintextParams(t *testing.T) {
+	plaintext := "<div>foo</div> [[Bar]] {{Baz}} &lt;"
+
+	test.AssertEqual(t,
+		"<b>foo</b>",
+		NewRawMessage("$1", nil).RawParams("<b>foo</b>").Escaped(),
+		`Raw params are not escaped`,
+	)
+	test.AssertEqual(t,
+		"&lt;b&gt;foo&lt;/b&gt;",
+		NewRawMessage("$1", []interface{}{"<b>foo</b>"}).Escaped(),
+		`Normal params are escaped`,
+	)
+	test.AssertEqual(t,
+		"Hi &lt;script&gt;alert(1)&lt;/script&gt;",
+		NewRawMessage("Hi $1", []interface{}{"<script>alert(1)</script>"}).Parse(),
+		`Normal params are escaped in parse`,
+	)
+	test.AssertEqual(t,
+		"&lt;b&gt;foo&lt;/b&gt; <i>",
+		NewRawMessage("<b>foo</b> $1", nil).RawParams("<i>").ParseAsBlock(),
+		`Parse formats are escaped until wikitext is parsed, except raw params`,
+	)
+	test.AssertEqual(t,
+		"one "+plaintext,
+		NewRawMessage("one $1", nil).PlaintextParams(plaintext).Text(),
+		`Plaintext params in text`,
+	)
+	test.AssertEqual(t,
+		"one "+plaintext,
+		NewRawMessage("one $1", nil).PlaintextParams(plaintext).Plain(),
+		`Plaintext params in plain`,
+	)
+	test.AssertEqual(t,
+		"one &lt;div&gt;foo&lt;/div&gt; [[Bar]] {{Baz}} &amp;lt;",
+		NewRawMessage("one $1", nil).PlaintextParams(plaintext).Escaped(),
+		`Plaintext params in escaped`,
+	)
+	test.AssertEqual(t,
+		"one &lt;div&gt;foo&lt;/div&gt; [[Bar]] {{Baz}} &amp;lt;",
+		NewRawMessage("one $1", nil).PlaintextParams(plaintext).Parse(),
+		`Plaintext params in parse`,
+	)
+	test.AssertEqual(t,
+		"&lt;b&gt;x&lt;/b&gt; <i>",
+		NewRawMessage("$1 $2", []interface{}{NewRawMessage("<b>x</b>", nil), RawParam("<i>")}).Escaped(),
+		`Message params are escaped once`,
+	)
+	test.AssertEqual(t,
+		"$1 $2",
+		NewRawMessage("$2 $1", []interface{}{"$2", "$1"}).Plain(),
+		`Params are not replaced in other params`,
+	)
+}
+
+/**
+ * @covers Message::numParams
+ * @covers Message::durationParams
+ * @covers Message::expiryParams
+ * @covers Message::timeperiodParams
+ * @covers Message::sizeParams
+ * @covers Message::bitrateParams
+ */
+func TestFormattedParams(t *testing.T) {
+	defer useCoreMessages()()
+	en := languages.Factory("en")
+
+	test.AssertEqual(t, "123,456.789", NewRawMessage("$1", nil).NumParams(123456.789).Text(), `Number`)
+	test.AssertEqual(t,
+		"123.456,789%",
+		NewMessage("percent", []interface{}{NumParam("123456.789")}, languages.Factory("de")).Text(),
+		`Number in German`,
+	)
+	test.AssertEqual(t, "1 minute and 5 seconds", NewRawMessage("$1", nil).DurationParams(65).Text(), `Duration`)
+	test.AssertEqual(t, "1 min 5 s", NewRawMessage("$1", nil).TimeperiodParams(65).Text(), `Time period`)
+	test.AssertEqual(t, "infinite", NewRawMessage("$1", nil).ExpiryParams("infinity").Text(), `Infinite expiry`)
+	test.AssertEqual(t, "03:04, 2 January 2018", NewRawMessage("$1", nil).ExpiryParams("20180102030405").Text(), `Expiry`)
+	test.AssertEqual(t, "1,023 B", NewRawMessage("$1", nil).SizeParams(1023).Text(), `Bytes`)
+	test.AssertEqual(t, "2 KB", NewRawMessage("$1", nil).SizeParams(2048).Text(), `Kilobytes`)
+	test.AssertEqual(t, "1.5 GB", NewRawMessage("$1", nil).SizeParams(1610612736).Text(), `Gigabytes`)
+	test.AssertEqual(t, "0 B", en.FormatSize(0), `Zero bytes`)
+	test.AssertEqual(t, "1.5 Mbps", NewRawMessage("$1", nil).BitrateParams(1500000).Text(), `Bitrate`)
+	test.AssertEqual(t, "999 bps", en.FormatBitrate(999), `Bits`)
+}
+
+/**
+ * @covers Message::listParam
+ * @covers Message::formatListParam
+ */
+func TestListParam(t *testing.T) {
+	defer useCoreMessages()()
+
+	test.AssertEqual(t,
+		"a, b, c",
+		NewRawMessage("$1", []interface{}{ListParam([]interface{}{"a", "b", "c"}, "comma")}).Text(),
+		`Comma list`,
+	)
+	test.AssertEqual(t,
+		"a, b and c",
+		NewRawMessage("$1", []interface{}{ListParam([]interface{}{"a", "b", "c"}, "text")}).Text(),
+		`Text list`,
+	)
+	test.AssertEqual(t,
+		"a | b",
+		NewRawMessage("$1", []interface{}{ListParam([]interface{}{"a", "b"}, "pipe")}).Text(),
+		`Pipe list`,
+	)
+	test.AssertEqual(t,
+		"",
+		NewRawMessage("$1", []interface{}{ListParam([]interface{}{}, "semicolon")}).Text(),
+		`Empty list`,
+	)
+	test.AssertEqual(t,
+		"<b>a</b>; &lt;i&gt;b&lt;/i&gt;; 1,000",
+		NewRawMessage("$1", []interface{}{
+			ListParam([]interface{}{RawParam("<b>a</b>"), "<i>b</i>", NumParam(1000)}, "semicolon"),
+		}).Escaped(),
+		`Mixed raw and escaped items`,
+	)
+	test.AssertEqual(t,
+		"<b>a</b>, <i>b</i>",
+		NewRawMessage("$1", []interface{}{
+			ListParam([]interface{}{RawParam("<b>a</b>"), RawParam("<i>b</i>")}, "comma"),
+		}).Escaped(),
+		`Raw items only`,
+	)
+}
+
+/**
+ * Provides messages through the MessagesPreLoad hook, as if they were
+ * pages of the MediaWiki namespace
+ */
+func withPreloadedMessages(messages map[string]string) func() {
+	oldHandlers, hadHandlers := WgHooks["MessagesPreLoad"]
+	WgHooks["MessagesPreLoad"] = append(WgHooks["MessagesPreLoad"], func(title string, message *interface{}, code string) bool {
+		if text, ok := messages[title]; ok {
+			*message = text
+		}
+		return true
+	})
+	return func() {
+		if hadHandlers {
+			WgHooks["MessagesPreLoad"] = oldHandlers
+		} else {
+			delete(WgHooks, "MessagesPreLoad")
+		}
+	}
+}
+
+/**
+ * @covers Message::exists
+ * @covers Message::isBlank
+ * @covers Message::isDisabled
+ * @covers Message::newFallbackSequence
+ */
+func TestMessageExists(t *testing.T) {
+	defer useCoreMessages()()
+	defer withPreloadedMessages(map[string]string{"Blank-message": "", "Disabled-message": "-"})()
+
+	test.AssertEqual(t, true, WfMessage("comma-separator").Exists(), `Existing message`)
+	test.AssertEqual(t, false, WfMessage("comma-separator").IsBlank(), `Existing message is not blank`)
+	test.AssertEqual(t, false, WfMessage("no-such-message").Exists(), `Missing message`)
+	test.AssertEqual(t, true, WfMessage("no-such-message").IsBlank(), `Missing message is blank`)
+	test.AssertEqual(t, true, WfMessage("no-such-message").IsDisabled(), `Missing message is disabled`)
+
+	test.AssertEqual(t, true, WfMessage("blank-message").Exists(), `Blank message exists`)
+	test.AssertEqual(t, true, WfMessage("blank-message").IsBlank(), `Blank message`)
+	test.AssertEqual(t, "", WfMessage("blank-message").Text(), `Blank message text`)
+	test.AssertEqual(t, false, WfMessage("disabled-message").IsBlank(), `Disabled message is not blank`)
+	test.AssertEqual(t, true, WfMessage("disabled-message").IsDisabled(), `Disabled message`)
+	test.AssertEqual(t, false, WfMessage("blank-message").UseDatabase(false).Exists(), `Hook is not used without the database`)
+
+	m := NewFallbackSequence("no-such-message", "blank-message", "ellipsis", "and")
+	test.AssertEqual(t, true, m.IsMultiKey(), `Fallback sequence has several keys`)
+	test.AssertEqual(t, "...", m.Text(), `First non-empty message`)
+	test.AssertEqual(t, "ellipsis", m.GetKey(), `Key of the first non-empty message`)
+
+	m = NewFallbackSequence("no-such-message", "blank-message")
+	test.AssertEqual(t, "", m.Text(), `All messages are empty`)
+	test.AssertEqual(t, true, m.Exists(), `Last key exists`)
+	test.AssertEqual(t, "⧼no-such-message⧽", NewFallbackSequence("no-such-message").Text(), `No message at all`)
+}
+
+/**
+ * Sets a configuration global for the test, and returns the function
+ * restoring it
+ */
+func setGlobal(name string, value interface{}) func() {
+	if globals.GLOBALS == nil {
+		globals.GLOBALS = make(map[string]interface{})
+	}
+	oldValue, ok := globals.GLOBALS[name]
+	globals.GLOBALS[name] = value
+	return func() {
+		if ok {
+			globals.GLOBALS[name] = oldValue
+		} else {
+			delete(globals.GLOBALS, name)
+		}
+	}
+}
+
+/**
+ * @covers Message::inLanguage
+ * @covers Message::inContentLanguage
+ * @covers Message::inUserLanguage
+ */
+func TestInLanguage(t *testing.T) {
+	defer useCoreMessages()()
+	defer setGlobal("wgLanguageCode", "de")()
+
+	m := NewMessage("and", nil, languages.Factory("en"))
+	test.AssertEqual(t, " and", m.Text(), `Explicit language`)
+	test.AssertEqual(t, " und", m.InLanguage("de").Text(), `Language code`)
+	test.AssertEqual(t, " and", m.InLanguage(languages.Factory("en")).Text(), `Language object`)
+	test.AssertEqual(t, " und", m.InContentLanguage().Text(), `Content language`)
+	test.AssertEqual(t, " und", m.InUserLanguage().Text(), `User language defaults to the content language`)
+
+	defer setGlobal("wgForceUIMsgAsContentMsg", []string{"and"})()
+	test.AssertEqual(t, " and", NewMessage("and", nil, languages.Factory("en")).InContentLanguage().Text(), `$wgForceUIMsgAsContentMsg`)
+}
+
+/**
+ * @covers Message::title
+ */
+func TestMessageTitle(t *testing.T) {
+	title := NewTitle().MakeTitle(consts.NS_MAIN, "Main_Page", "", "")
+
+	test.AssertEqual(t, "Main Page", NewRawMessage("{{PAGENAME}}", nil).Title(title).Text(), `{{PAGENAME}} of the title`)
+	test.AssertEqual(t, "NO TITLE", NewRawMessage("{{PAGENAME}}", nil).Text(), `No title`)
+}
+
+/**
+ * @covers Message::serialize
+ * @covers Message::unserialize
+ * @covers Message::newFromSpecifier
+ */
+func TestMessageJSON(t *testing.T) {
+	defer useCoreMessages()()
+
+	m := NewMessage("percent", []interface{}{
+		NumParam(1234.5),
+		DurationParam(65),
+		SizeParam(2048),
+		ListParam([]interface{}{"a", RawParam("<b>")}, "comma"),
+		NewMessage("ellipsis", nil, nil),
+		7,
+	}, languages.Factory("de")).Title(NewTitle().MakeTitle(consts.NS_MAIN, "Foo", "", ""))
+
+	serialized, err := json.Marshal(m)
+	test.AssertEqual(t, nil, err, `Serialized`)
+	unserialized := new(Message)
+	err = json.Unmarshal(serialized, unserialized)
+	test.AssertEqual(t, nil, err, `Unserialized`)
+
+	again, _ := json.Marshal(unserialized)
+	test.AssertEqual(t, string(serialized), string(again), `Stable serialization`)
+	test.AssertEqual(t, m.Escaped(), unserialized.Escaped(), `Same output after unserialization`)
+	test.AssertEqual(t, "de", unserialized.GetLanguage().GetCode(), `Language`)
+	test.AssertEqual(t, "Foo", unserialized.title.GetText(), `Title`)
+
+	test.AssertEqual(t, "1,234.5%", NewMessage("percent", []interface{}{NumParam(1234.5)}, nil).InLanguage("en").Text(), `English`)
+	json.Unmarshal([]byte(`{"interface":true,"language":null,"key":"percent","keysToTry":["percent"],`+
+		`"parameters":[{"num":1234.5}],"format":"parse","useDatabase":true,"title":null}`), unserialized)
+	test.AssertEqual(t, "1.234,5%", unserialized.InLanguage("de").Text(), `Rendered later in another language`)
+
+	raw := new(Message)
+	serialized, _ = json.Marshal(NewRawMessage("<b>$1</b>", []interface{}{"x"}))
+	json.Unmarshal(serialized, raw)
+	test.AssertEqual(t, "<b>x</b>", raw.Plain(), `RawMessage`)
+
+	test.AssertEqual(t,
+		false,
+		json.Unmarshal([]byte(`{"key":"a","keysToTry":[]}`), new(Message)) == nil,
+		`No keys to try`,
+	)
+
+	spec := NewMessageFromSpecifier([]interface{}{"percent", 5})
+	test.AssertEqual(t, "5%", spec.Text(), `Key and params array`)
+	test.AssertEqual(t, "percent", NewMessageFromSpecifier(spec).GetKey(), `Message`)
+}
@@ -3,7 +3,14 @@
  */
 package includes
 
-import "math"
+import (
+	"github.com/MangoDowner/mediawiki/includes/languages"
+	"github.com/MangoDowner/mediawiki/includes/parser"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+)
 
 /**
  * This class should be covered by a general architecture document which does
@@ -366,3 +373,199 @@ func (o *OutputPage) ShowNewSectionLink() bool {
 func (o *OutputPage) ForceHideNewSectionLink() bool {
 	return o.mHideNewSectionLink
 }
+
+/**
+ * Add an HTTP header that will influence on the cache
+ *
+ * @param string $header Header name
+ * @param string[]|null $option Options for the Key header. See
+ * https://datatracker.ietf.org/doc/draft-fielding-http-key/
+ * for the list of valid options.
+ */
+func (o *OutputPage) AddVaryHeader(header string, option []string) {
+	if _, ok := o.mVaryHeader[header]; !ok {
+		o.mVaryHeader[header] = []string{}
+	}
+	for _, opt := range option {
+		found := false
+		for _, existing := range o.mVaryHeader[header] {
+			if existing == opt {
+				found = true
+				break
+			}
+		}
+		if !found {
+			o.mVaryHeader[header] = append(o.mVaryHeader[header], opt)
+		}
+	}
+}
+
+/**
+ * Return a Vary: header on which to vary caches. Based on the keys of $mVaryHeader,
+ * such as Accept-Encoding or Cookie
+ *
+ * @return string
+ */
+func (o *OutputPage) GetVaryHeader() string {
+	headers := make([]string, 0, len(o.mVaryHeader))
+	for header := range o.mVaryHeader {
+		headers = append(headers, header)
+	}
+	sort.Strings(headers)
+	return "Vary: " + strings.Join(headers, ", ")
+}
+
+/**
+ * T23672: Add Accept-Language to Vary header if there's no 'variant' parameter in GET.
+ *
+ * For example:
+ *   /w/index.php?title=Main_page will vary based on Accept-Language; but
+ *   /w/index.php?title=Main_page&variant=zh-cn will not.
+ *
+ * @param Language $lang Language of the page content
+ * @param WebRequest|null $request
+ */
+func (o *OutputPage) AddAcceptLanguage(lang *languages.Language, request languages.IWebRequest) {
+	if request != nil && request.GetVal("variant", "") != "" {
+		return
+	}
+	if !lang.HasVariants() {
+		return
+	}
+	o.AddVaryHeader("Accept-Language", nil)
+}
+
+/**
+ * Set the Vary header of the response. Responses are not cached yet, so
+ * this is the only cache control header sent so far.
+ *
+ * @param http.Header $header Headers of the response
+ * @param Title|null $title Title of the page, its language deciding on Accept-Language
+ * @param WebRequest|null $request
+ */
+func (o *OutputPage) SendCacheControl(header http.Header, title *Title, request *WebRequest) {
+	if title != nil {
+		var req languages.IWebRequest
+		if request != nil {
+			req = request
+		}
+		o.AddAcceptLanguage(title.GetPageLanguage(), req)
+	}
+	header.Set("Vary", strings.TrimPrefix(o.GetVaryHeader(), "Vary: "))
+}
+
+/**
+ * "HTML title" means the contents of "<title>".
+ * It is stored as plain, unescaped text and will be run through htmlspecialchars in the skin file.
+ *
+ * @param string|Message $name
+ */
+func (o *OutputPage) SetHTMLTitle(name string) {
+	o.mHTMLtitle = name
+}
+
+/**
+ * Return the "HTML title", i.e. the content of the "<title>" tag.
+ *
+ * @return string
+ */
+func (o *OutputPage) GetHTMLTitle() string {
+	return o.mHTMLtitle
+}
+
+/**
+ * Append $text to the body HTML
+ *
+ * @param string $text HTML
+ */
+func (o *OutputPage) AddHTML(text string) {
+	o.mBodytext += text
+}
+
+/**
+ * Add the HTML of a ParserOutput object, which has been converted to the
+ * language variant of the reader, to the body. Its title text is used as the
+ * display title.
+ *
+ * @param ParserOutput $parserOutput
+ */
+func (o *OutputPage) AddParserOutput(parserOutput *parser.ParserOutput) {
+	if text := parserOutput.GetTitleText(); text != "" {
+		o.SetDisplayTitle(text)
+	}
+	o.AddHTML(parserOutput.GetText())
+}
+
+/**
+ * Same as page title but only contains name of the page, not any other text.
+ *
+ * @since 1.32
+ * @param string $html Page title text.
+ */
+func (o *OutputPage) SetDisplayTitle(html string) {
+	o.displayTitle = html
+}
+
+/**
+ * Returns page display title.
+ *
+ * @since 1.32
+ * @return string HTML
+ */
+func (o *OutputPage) GetDisplayTitle() string {
+	return o.displayTitle
+}
+
+/**
+ * Get the body HTML
+ *
+ * @return string HTML
+ */
+func (o *OutputPage) GetHTML() string {
+	return o.mBodytext
+}
+
+/**
+ * @param Language $userLang Language of the interface
+ * @param Language $contLang Language of the wiki content
+ * @return string The doctype, opening "<html>", and head element.
+ */
+func (o *OutputPage) HeadElement(userLang, contLang *languages.Language) string {
+	h := new(Html)
+	userdir := userLang.GetDir()
+	sitedir := contLang.GetDir()
+
+	pieces := []string{
+		h.HtmlHeader(map[string]interface{}{
+			"lang":  userLang.GetHtmlCode(),
+			"dir":   userdir,
+			"class": "client-nojs",
+		}),
+		h.OpenElement("head", map[string]interface{}{}),
+		h.Element("meta", map[string]interface{}{"charset": "UTF-8"}, ""),
+		h.Element("title", map[string]interface{}{}, o.GetHTMLTitle()),
+		h.CloseElement("head"),
+	}
+
+	bodyClasses := []string{"mediawiki", userdir, "sitedir-" + sitedir}
+	pieces = append(pieces, h.OpenElement("body", map[string]interface{}{
+		"class": strings.Join(bodyClasses, " "),
+	}))
+	return strings.Join(pieces, "\n")
+}
+
+/**
+ * The body HTML wrapped in a block with the language and direction of the
+ * page content, which may differ from those of the interface.
+ *
+ * @param Language $pageLang Language of the page content
+ * @return string HTML
+ */
+func (o *OutputPage) GetContentHTML(pageLang *languages.Language) string {
+	return new(Html).RawElement("div", map[string]interface{}{
+		"id":    "mw-content-text",
+		"lang":  pageLang.GetHtmlCode(),
+		"dir":   pageLang.GetDir(),
+		"class": "mw-content-" + pageLang.GetDir(),
+	}, o.mBodytext)
+}
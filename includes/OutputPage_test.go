@@ -0,0 +1,80 @@
+package includes
+
+import (
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	"github.com/MangoDowner/mediawiki/includes/languages"
+	"github.com/MangoDowner/mediawiki/includes/parser"
+	test "github.com/MangoDowner/mediawiki/tests"
+	"net/http"
+	"testing"
+)
+
+/**
+ * @covers OutputPage::headElement
+ */
+func TestHeadElement(t *testing.T) {
+	op := NewOutputPage()
+	op.SetHTMLTitle("<Main Page>")
+
+	test.AssetEqual(
+		"<!DOCTYPE html>\n"+
+			`<html class="client-nojs" dir="rtl" lang="he">`+"\n"+
+			"<head>\n"+
+			`<meta charset="UTF-8"/>`+"\n"+
+			"<title>&lt;Main Page></title>\n"+
+			"</head>\n"+
+			`<body class="mediawiki rtl sitedir-ltr">`,
+		op.HeadElement(languages.Factory("he"), languages.Factory("en")),
+		`Interface direction on <html>, site direction on <body>`,
+	)
+
+	op.AddHTML("<p>שלום</p>")
+	test.AssetEqual(
+		`<div class="mw-content-rtl" dir="rtl" id="mw-content-text" lang="he"><p>שלום</p></div>`,
+		op.GetContentHTML(languages.Factory("he")),
+		`Content wrapper`,
+	)
+}
+
+/**
+ * @covers OutputPage::sendCacheControl
+ * @covers OutputPage::addAcceptLanguage
+ */
+func TestSendCacheControl(t *testing.T) {
+	setMainConfigGlobals(t, map[string]interface{}{"wgLanguageCode": "zh"})
+	title := NewTitle().MakeTitle(consts.NS_MAIN, "Main_Page", "", "")
+
+	header := http.Header{}
+	NewOutputPage().SendCacheControl(header, title, nil)
+	test.AssertEqual(t, "Accept-Encoding, Accept-Language", header.Get("Vary"), `Vary on the variant`)
+
+	header = http.Header{}
+	request := NewFauxRequest(map[string]string{"variant": "zh-tw"}, false, nil, "")
+	NewOutputPage().SendCacheControl(header, title, &request.WebRequest)
+	test.AssertEqual(t, "Accept-Encoding", header.Get("Vary"), `Variant given in the URL`)
+
+	header = http.Header{}
+	NewOutputPage().SendCacheControl(header, nil, nil)
+	test.AssertEqual(t, "Accept-Encoding", header.Get("Vary"), `No title`)
+
+	globals.GLOBALS["wgLanguageCode"] = "en"
+	header = http.Header{}
+	NewOutputPage().SendCacheControl(header, title, nil)
+	test.AssertEqual(t, "Accept-Encoding", header.Get("Vary"), `No variants`)
+}
+
+/**
+ * @covers OutputPage::addParserOutput
+ */
+func TestAddParserOutput(t *testing.T) {
+	options := parser.NewParserOptions("", nil)
+	options.SetTargetLanguage(languages.Factory("zh"))
+	options.SetVariant("zh-tw")
+	title := NewTitle().MakeTitle(consts.NS_MAIN, "这个", "", "")
+
+	op := NewOutputPage()
+	op.AddParserOutput(parser.NewParser(nil).Parse("-{zh-hans:内存;zh-hant:記憶體;}-", title, options))
+	test.AssertEqual(t, "記憶體", op.GetHTML(), `Converted text`)
+	test.AssertEqual(t, "這個", op.GetDisplayTitle(), `Converted title`)
+}
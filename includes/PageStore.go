@@ -0,0 +1,106 @@
+package includes
+
+import (
+	"github.com/MangoDowner/mediawiki/includes/cache"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	"github.com/MangoDowner/mediawiki/includes/libs/rdbms/database"
+)
+
+/**
+ * Reads and writes the pages of the wiki in the page table.
+ *
+ * Unlike MediaWiki, there is no revision history: the page row holds the
+ * text of the latest revision.
+ *
+ * @since 1.36
+ */
+type PageStore struct {
+	/** @var IDatabase */
+	db database.IDatabase
+}
+
+/**
+ * @param IDatabase $db The main wiki database
+ */
+func NewPageStore(db database.IDatabase) *PageStore {
+	this := new(PageStore)
+	this.db = db
+	return this
+}
+
+/**
+ * Create the page table if it does not exist yet
+ *
+ * @throws DBError
+ */
+func (p *PageStore) CreateTables() error {
+	return p.db.Query("CREATE TABLE IF NOT EXISTS page ("+
+		"page_namespace INTEGER NOT NULL, "+
+		"page_title BLOB NOT NULL, "+
+		"page_text BLOB NOT NULL default '', "+
+		"PRIMARY KEY (page_namespace, page_title))", "PageStore::createTables")
+}
+
+/**
+ * Get the texts of all the pages of a namespace
+ *
+ * @param int $namespace
+ * @return array Map of (DB key => text)
+ * @throws DBError
+ */
+func (p *PageStore) GetPageTexts(namespace int) (map[string]string, error) {
+	rows, err := p.db.Select("page", []string{"page_title", "page_text"},
+		map[string]interface{}{"page_namespace": namespace}, "PageStore::getPageTexts", nil)
+	if err != nil {
+		return nil, err
+	}
+	texts := make(map[string]string, len(rows))
+	for _, row := range rows {
+		texts[row["page_title"]] = row["page_text"]
+	}
+	return texts, nil
+}
+
+/**
+ * Save the text of a page, creating it if needed
+ *
+ * @param int $namespace
+ * @param string $dbKey
+ * @param string $text
+ * @throws DBError
+ */
+func (p *PageStore) SavePage(namespace int, dbKey string, text string) error {
+	_, err := p.db.Replace("page", []string{"page_namespace", "page_title"}, []map[string]interface{}{{
+		"page_namespace": namespace,
+		"page_title":     dbKey,
+		"page_text":      text,
+	}}, "PageStore::savePage")
+	if err != nil {
+		return err
+	}
+	if namespace == consts.NS_MEDIAWIKI {
+		cache.SingletonMessageCache().Replace(dbKey, text, true)
+	}
+	return nil
+}
+
+/**
+ * Delete a page
+ *
+ * @param int $namespace
+ * @param string $dbKey
+ * @throws DBError
+ */
+func (p *PageStore) DeletePage(namespace int, dbKey string) error {
+	_, err := p.db.Delete("page", map[string]interface{}{
+		"page_namespace": namespace,
+		"page_title":     dbKey,
+	}, "PageStore::deletePage")
+	if err != nil {
+		return err
+	}
+	if namespace == consts.NS_MEDIAWIKI {
+		cache.SingletonMessageCache().Replace(dbKey, "", false)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package includes
+
+/**
+ * Variant of the Message class.
+ *
+ * Rather than treating the message key as a lookup
+ * value (which is passed to the MessageCache and
+ * translated as necessary), a RawMessage key is
+ * treated as the actual message.
+ *
+ * All other functionality (parsing, escaping, etc.)
+ * is preserved.
+ *
+ * @since 1.21
+ */
+
+/**
+ * Call the parent constructor, then store the key as
+ * the message.
+ *
+ * @see Message::__construct
+ *
+ * @param string $text Message to use.
+ * @param array $params Parameters for the message.
+ */
+func NewRawMessage(text string, params []interface{}) *Message {
+	this := NewMessage(text, params, nil)
+	// The key is the message.
+	this.message = text
+	this.exists = true
+	this.fetched = true
+	this.raw = true
+	return this
+}
@@ -4,32 +4,57 @@
 package includes
 
 import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
 	"github.com/MangoDowner/mediawiki/includes/exception"
 )
 
 /**
-* ServiceContainer provides a generic service to manage named services using
-* lazy instantiation based on instantiator callback functions.
-*
-* Services managed by an instance of ServiceContainer may or may not implement
-* a common interface.
-*
-* @note When using ServiceContainer to manage a set of services, consider
-* creating a wrapper or a subclass that provides access to the services via
-* getter methods with more meaningful names and more specific return type
-* declarations.
-*
-* @see docs/injection.txt for an overview of using dependency injection in the
-*      MediaWiki code base.
- */ /**
- * Destroys all contained service instances that implement the DestructibleService
- * interface. This will render all services obtained from this MediaWikiServices
- * instance unusable. In particular, this will disable access to the storage backend
- * via any of these services. Any future call to getService() will throw an exception.
+ * Service wiring: map of (service name => instantiator). Core and each
+ * extension provide one, and WgServiceWiringFiles lists them.
+ *
+ * Use Instantiator() to turn typed instantiators into wiring entries.
+ */
+type ServiceWiring map[string]func(container *ServiceContainer) (interface{}, error)
+
+/**
+ * ServiceContainer provides a generic service to manage named services using
+ * lazy instantiation based on instantiator callback functions.
+ *
+ * Services managed by an instance of ServiceContainer may or may not implement
+ * a common interface. Get them with GetService(), which checks their type:
+ *
+ * @code
+ *     factory, err := GetService[*SpecialPageFactory](container, "SpecialPageFactory")
+ * @endcode
+ *
+ * @note When using ServiceContainer to manage a set of services, consider
+ * creating a wrapper or a subclass that provides access to the services via
+ * getter methods with more meaningful names and more specific return type
+ * declarations.
  *
- * @see resetGlobalInstance()
+ * @see docs/injection.txt for an overview of using dependency injection in the
+ *      MediaWiki code base.
  */
 type ServiceContainer struct {
+	*serviceRegistry
+
+	/**
+	 * @var string[] Services being created by the caller, outermost first,
+	 *  to detect circular dependencies
+	 */
+	creating []string
+}
+
+/**
+ * The state of a ServiceContainer, shared with the views of it given to
+ * the instantiators
+ */
+type serviceRegistry struct {
 	/**
 	 * @var object[]
 	 */
@@ -38,40 +63,79 @@ type ServiceContainer struct {
 	/**
 	 * @var callable[]
 	 */
-	serviceInstantiators map[string]func()
+	serviceInstantiators ServiceWiring
 
 	/**
 	 * @var callable[][]
 	 */
-	serviceManipulators [][]string
+	serviceManipulators map[string][]func(service interface{}, container *ServiceContainer) (interface{}, error)
 
 	/**
 	 * @var bool[] disabled status, per service name
 	 */
 	disabled map[string]bool
 
+	/**
+	 * @var serviceCreation[] The services being created, which the other
+	 *  callers wait for rather than create them again
+	 */
+	pending map[string]*serviceCreation
+
 	/**
 	 * @var array
 	 */
-	extraInstantiationParams []string
+	extraInstantiationParams []interface{}
 
 	/**
 	 * @var bool
 	 */
 	destroyed bool
+
+	mutex sync.Mutex
 }
 
 /**
- * @param array $extraInstantiationParams Any additional parameters to be passed to the
- * instantiator function when creating a service. This is typically used to provide
- * access to additional ServiceContainers or Config objects.
+ * A service being created by getService()
  */
-func NewServiceContainer(extraInstantiationParams []string) *ServiceContainer {
+type serviceCreation struct {
+	/** @var chan Closed once the service is created, or failed to be */
+	done chan struct{}
+	/** @var object */
+	service interface{}
+	/** @var error */
+	err error
+}
+
+/**
+ * @param array $extraInstantiationParams Any additional parameters the
+ * instantiators can get from GetExtraInstantiationParams(). This is typically
+ * used to provide access to additional ServiceContainers or Config objects.
+ */
+func NewServiceContainer(extraInstantiationParams []interface{}) *ServiceContainer {
 	this := new(ServiceContainer)
-	this.extraInstantiationParams = extraInstantiationParams
+	this.serviceRegistry = &serviceRegistry{
+		services:                 make(map[string]interface{}),
+		serviceInstantiators:     make(ServiceWiring),
+		serviceManipulators:      make(map[string][]func(interface{}, *ServiceContainer) (interface{}, error)),
+		disabled:                 make(map[string]bool),
+		pending:                  make(map[string]*serviceCreation),
+		extraInstantiationParams: extraInstantiationParams,
+	}
 	return this
 }
 
+/**
+ * Turn a typed instantiator into a ServiceWiring entry
+ *
+ * @param callable $instantiator
+ * @return callable
+ */
+func Instantiator[T any](instantiator func(container *ServiceContainer) (T, error)) func(container *ServiceContainer) (interface{}, error) {
+	return func(container *ServiceContainer) (interface{}, error) {
+		return instantiator(container)
+	}
+}
+
 /**
 * Destroys all contained service instances that implement the DestructibleService
 * interface. This will render all services obtained from this MediaWikiServices
@@ -81,21 +145,93 @@ func NewServiceContainer(extraInstantiationParams []string) *ServiceContainer {
 * @see resetGlobalInstance()
  */
 func (s *ServiceContainer) Destroy() {
-	for _, name := range s.GetServiceNames() {
-		service := s.PeekService(name)
-		if service == nil {
-			continue
-		}
-		if i, ok := service.(DestructibleService); ok {
-			i.Destroy()
-		}
-	}
+	s.mutex.Lock()
+	services := s.services
 	// Break circular references due to the $this reference in closures, by
 	// erasing the instantiator array. This allows the ServiceContainer to
 	// be deleted when it goes out of scope.
-	s.serviceInstantiators = nil
-	s.services = nil
+	s.serviceInstantiators = make(ServiceWiring)
+	s.services = make(map[string]interface{})
 	s.destroyed = true
+	s.mutex.Unlock()
+
+	for _, service := range services {
+		if i, ok := service.(DestructibleService); ok {
+			i.Destroy()
+		}
+	}
+}
+
+/**
+ * @param ServiceWiring[] $wiringFiles The wirings of core and the extensions
+ * @throws ServiceAlreadyDefinedException If several wirings define a service
+ */
+func (s *ServiceContainer) LoadWiringFiles(wiringFiles []ServiceWiring) {
+	for _, wiring := range wiringFiles {
+		s.ApplyWiring(wiring)
+	}
+}
+
+/**
+ * Registers multiple services (aka a "wiring").
+ *
+ * @param ServiceWiring $serviceInstantiators An associative array mapping
+ *        service names to instantiator functions.
+ * @throws ServiceAlreadyDefinedException If a service is already defined
+ */
+func (s *ServiceContainer) ApplyWiring(serviceInstantiators ServiceWiring) {
+	names := make([]string, 0, len(serviceInstantiators))
+	for name := range serviceInstantiators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s.defineService(name, serviceInstantiators[name])
+	}
+}
+
+/**
+ * Imports all wiring defined in $container. Wiring defined in $container
+ * will override any wiring already defined locally. However, already
+ * existing service instances will be preserved.
+ *
+ * @since 1.28
+ *
+ * @param ServiceContainer $container
+ * @param string[] $skip A list of service names to skip during import
+ */
+func (s *ServiceContainer) ImportWiring(container *ServiceContainer, skip []string) {
+	if container.serviceRegistry == s.serviceRegistry {
+		return
+	}
+	skipped := make(map[string]bool, len(skip))
+	for _, name := range skip {
+		skipped[name] = true
+	}
+
+	container.mutex.Lock()
+	instantiators := make(ServiceWiring, len(container.serviceInstantiators))
+	for name, instantiator := range container.serviceInstantiators {
+		if !skipped[name] {
+			instantiators[name] = instantiator
+		}
+	}
+	manipulators := make(map[string][]func(interface{}, *ServiceContainer) (interface{}, error))
+	for name, list := range container.serviceManipulators {
+		if !skipped[name] {
+			manipulators[name] = append([]func(interface{}, *ServiceContainer) (interface{}, error){}, list...)
+		}
+	}
+	container.mutex.Unlock()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for name, instantiator := range instantiators {
+		s.serviceInstantiators[name] = instantiator
+	}
+	for name, list := range manipulators {
+		s.serviceManipulators[name] = append(s.serviceManipulators[name], list...)
+	}
 }
 
 /**
@@ -107,6 +243,8 @@ func (s *ServiceContainer) Destroy() {
 * @return bool
  */
 func (s *ServiceContainer) HasService(name string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 	_, ok := s.serviceInstantiators[name]
 	return ok
 }
@@ -124,26 +262,37 @@ func (s *ServiceContainer) HasService(name string) bool {
  * @param string $name
  *
  * @return object|null The service instance, or null if the service has not yet been instantiated.
- * @throws RuntimeException if $name does not refer to a known service.
+ * @throws NoSuchServiceException if $name does not refer to a known service.
  */
 func (s *ServiceContainer) PeekService(name string) interface{} {
 	if !s.HasService(name) {
-		//TODO: PHP 为抛出异常
 		panic(exception.NewNoSuchServiceException(name, nil))
 	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 	return s.services[name]
 }
 
 /**
-* @return string[]
+* @return string[] Sorted names of the defined services
  */
 func (s *ServiceContainer) GetServiceNames() (result []string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 	for k := range s.serviceInstantiators {
 		result = append(result, k)
 	}
+	sort.Strings(result)
 	return result
 }
 
+/**
+ * @return array The extra instantiation parameters given to the constructor
+ */
+func (s *ServiceContainer) GetExtraInstantiationParams() []interface{} {
+	return s.extraInstantiationParams
+}
+
 /**
  * Define a new service. The service must not be known already.
  *
@@ -151,23 +300,152 @@ func (s *ServiceContainer) GetServiceNames() (result []string) {
  * @see redefineService().
  *
  * @param string $name The name of the service to register, for use with getService().
- * @param callable $instantiator Callback that returns a service instance.
- *        Will be called with this MediaWikiServices instance as the only parameter.
- *        Any extra instantiation parameters provided to the constructor will be
- *        passed as subsequent parameters when invoking the instantiator.
+ * @param callable $instantiator Callback that returns a service instance or an error.
+ *        Will be called with this ServiceContainer as the only parameter.
  *
- * @throws RuntimeException if there is already a service registered as $name.
+ * @throws ServiceAlreadyDefinedException if there is already a service registered as $name.
  */
-func (s *ServiceContainer) DefineService(name string, instantiator func()) {
-	// Assert::parameterType( 'string', $name, '$name' );
-	if s.HasService(name) {
-		panic(exception.NewServiceAlreadyDefinedException(name, nil))
+func DefineService[T any](s *ServiceContainer, name string, instantiator func(container *ServiceContainer) (T, error)) {
+	s.defineService(name, Instantiator(instantiator))
+}
+
+/**
+ * Replace an already defined service.
+ *
+ * @see defineService().
+ *
+ * @note This will fail if the service was already instantiated. If the service was previously
+ * disabled, it will be re-enabled by this call. Any manipulators registered for the service
+ * will remain in place.
+ *
+ * @param string $name The name of the service to register.
+ * @param callable $instantiator Callback function that returns a service instance or an error.
+ *
+ * @throws NoSuchServiceException if $name is not a known service.
+ * @throws CannotReplaceActiveServiceException if the service was already instantiated.
+ */
+func RedefineService[T any](s *ServiceContainer, name string, instantiator func(container *ServiceContainer) (T, error)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.serviceInstantiators[name]; !ok {
+		panic(exception.NewNoSuchServiceException(name, nil))
 	}
-	//ServiceAlreadyDefinedException( $name );
-	if s.serviceInstantiators == nil {
-		s.serviceInstantiators = make(map[string]func())
+	if _, ok := s.services[name]; ok {
+		panic(exception.NewCannotReplaceActiveServiceException(name, nil))
+	}
+	s.serviceInstantiators[name] = Instantiator(instantiator)
+	delete(s.disabled, name)
+}
+
+/**
+ * Add a service manipulator callback for the given service.
+ * This method may be used by extensions that need to wrap, replace, or re-configure a
+ * service. It would typically be called from a MediaWikiServices hook handler.
+ *
+ * The manipulator callback is called just after the service is instantiated.
+ * It gets the service instance and this container, and returns the service
+ * to use: the same instance, or a replacement.
+ *
+ * @param string $name The name of the service to manipulate.
+ * @param callable $manipulator Callback function that manipulates, wraps or
+ *        replaces a service instance.
+ *
+ * @throws NoSuchServiceException if $name is not a known service.
+ * @throws CannotReplaceActiveServiceException if the service was already instantiated.
+ */
+func AddServiceManipulator[T any](s *ServiceContainer, name string, manipulator func(service T, container *ServiceContainer) (T, error)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.serviceInstantiators[name]; !ok {
+		panic(exception.NewNoSuchServiceException(name, nil))
+	}
+	if _, ok := s.services[name]; ok {
+		panic(exception.NewCannotReplaceActiveServiceException(name, nil))
+	}
+	s.serviceManipulators[name] = append(s.serviceManipulators[name],
+		func(service interface{}, container *ServiceContainer) (interface{}, error) {
+			typed, ok := service.(T)
+			if !ok {
+				return nil, serviceTypeError[T](name, service)
+			}
+			return manipulator(typed, container)
+		})
+}
+
+/**
+ * Disables a service.
+ *
+ * @note Attempts to call getService() for a disabled service will result
+ * in a ServiceDisabledException. Calling peekService for a disabled service will
+ * return null. Disabled services are listed by getServiceNames(). A disabled
+ * service can be enabled again using redefineService().
+ *
+ * @note If the service was already active (that is, instantiated) when getting disabled,
+ * and the service instance implements DestructibleService, destroy() is called on the
+ * service instance.
+ *
+ * @see redefineService()
+ * @see resetService()
+ *
+ * @param string $name The name of the service to disable.
+ *
+ * @throws NoSuchServiceException if $name is not a known service.
+ */
+func (s *ServiceContainer) DisableService(name string) {
+	s.resetService(name, true, true)
+}
+
+/**
+ * Resets a service by dropping the service instance.
+ * If the service instances implements DestructibleService, destroy()
+ * is called on the service instance.
+ *
+ * @warning This is generally unsafe! Other services may still retain references
+ * to the stale service instance, leading to failures and inconsistencies. Subclasses
+ * may use this method to reset specific services under specific instances, but
+ * it should not be exposed to application logic.
+ *
+ * @note This is declared final so subclasses can not interfere with the expectations
+ * disableService() has when calling resetService() to destroy the service instance.
+ *
+ * @param string $name The name of the service to reset.
+ * @param bool $destroy Whether the service instance should be destroyed if it exists.
+ *        When set to false, any existing service instance will effectively be detached
+ *        from the container.
+ *
+ * @throws NoSuchServiceException if $name is not a known service.
+ */
+func (s *ServiceContainer) ResetService(name string, destroy bool) {
+	s.resetService(name, destroy, false)
+}
+
+/**
+ * Drop the service instance and set whether the service is disabled at
+ * once, so that getService() cannot create an instance in between
+ *
+ * @param string $name
+ * @param bool $destroy Whether the service instance should be destroyed if it exists
+ * @param bool $disable Whether the service gets disabled, or enabled
+ * @throws NoSuchServiceException if $name is not a known service.
+ */
+func (s *ServiceContainer) resetService(name string, destroy, disable bool) {
+	s.mutex.Lock()
+	if _, ok := s.serviceInstantiators[name]; !ok {
+		s.mutex.Unlock()
+		panic(exception.NewNoSuchServiceException(name, nil))
+	}
+	instance := s.services[name]
+	delete(s.services, name)
+	if disable {
+		s.disabled[name] = true
+	} else {
+		delete(s.disabled, name)
+	}
+	s.mutex.Unlock()
+
+	if destructible, ok := instance.(DestructibleService); ok && destroy {
+		destructible.Destroy()
 	}
-	s.serviceInstantiators[name] = instantiator
 }
 
 /**
@@ -184,25 +462,155 @@ func (s *ServiceContainer) DefineService(name string, instantiator func()) {
  *
  * @param string $name The service name
  *
- * @throws NoSuchServiceException if $name is not a known service.
- * @throws ContainerDisabledException if this container has already been destroyed.
- * @throws ServiceDisabledException if the requested service has been disabled.
- *
  * @return object The service instance
+ * @return error NoSuchServiceException if $name is not a known service,
+ *  ContainerDisabledException if this container has already been destroyed,
+ *  ServiceDisabledException if the requested service has been disabled,
+ *  or the error of the instantiator or of a manipulator
+ */
+func GetService[T any](s *ServiceContainer, name string) (T, error) {
+	var typed T
+	service, err := s.getService(name)
+	if err != nil {
+		return typed, err
+	}
+	typed, ok := service.(T)
+	if !ok {
+		return typed, serviceTypeError[T](name, service)
+	}
+	return typed, nil
+}
+
+/**
+ * @param string $name
+ * @return object
+ * @return error
+ */
+func (s *ServiceContainer) getService(name string) (interface{}, error) {
+	for i, creating := range s.creating {
+		if creating == name {
+			chain := append(append([]string{}, s.creating[i:]...), name)
+			return nil, exception.NewMWException(fmt.Sprintf(
+				"Circular dependency when creating service! %s", strings.Join(chain, " -> ")))
+		}
+	}
+
+	s.mutex.Lock()
+	if s.destroyed {
+		s.mutex.Unlock()
+		return nil, exception.NewContainerDisabledException(nil)
+	}
+	if s.disabled[name] {
+		s.mutex.Unlock()
+		return nil, exception.NewServiceDisabledException(name, nil)
+	}
+	if service, ok := s.services[name]; ok {
+		s.mutex.Unlock()
+		return service, nil
+	}
+	if creation, ok := s.pending[name]; ok {
+		// Another caller is creating it, use the same instance
+		s.mutex.Unlock()
+		<-creation.done
+		return creation.service, creation.err
+	}
+	instantiator, ok := s.serviceInstantiators[name]
+	manipulators := s.serviceManipulators[name]
+	if !ok {
+		s.mutex.Unlock()
+		return nil, exception.NewNoSuchServiceException(name, nil)
+	}
+	creation := &serviceCreation{done: make(chan struct{})}
+	s.pending[name] = creation
+	s.mutex.Unlock()
+
+	// Build outside of the lock, as the instantiators get other services
+	created := false
+	defer func() {
+		if !created {
+			// The instantiator panicked, the callers waiting get an error
+			creation.service, creation.err = nil, exception.NewMWException(
+				fmt.Sprintf("Cannot create service %s", name))
+		}
+		s.mutex.Lock()
+		delete(s.pending, name)
+		s.mutex.Unlock()
+		close(creation.done)
+	}()
+	service, err := s.createService(name, instantiator, manipulators)
+	created = true
+	if err != nil {
+		creation.err = err
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	if s.destroyed || s.disabled[name] {
+		// Destroyed or disabled in the meantime, drop the instance as they did
+		if s.destroyed {
+			err = exception.NewContainerDisabledException(nil)
+		} else {
+			err = exception.NewServiceDisabledException(name, nil)
+		}
+		s.mutex.Unlock()
+		if destructible, ok := service.(DestructibleService); ok {
+			destructible.Destroy()
+		}
+		creation.err = err
+		return nil, err
+	}
+	s.services[name] = service
+	s.mutex.Unlock()
+	creation.service = service
+	return service, nil
+}
+
+/**
+ * @param string $name
+ * @param callable $instantiator
+ * @param callable[] $manipulators
+ * @return object
+ * @return error
+ */
+func (s *ServiceContainer) createService(name string, instantiator func(*ServiceContainer) (interface{}, error),
+	manipulators []func(interface{}, *ServiceContainer) (interface{}, error)) (interface{}, error) {
+	// The instantiator gets a view of this container which knows what is
+	// being created, to detect circular dependencies
+	view := &ServiceContainer{
+		serviceRegistry: s.serviceRegistry,
+		creating:        append(append([]string{}, s.creating...), name),
+	}
+	service, err := instantiator(view)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create service %s: %w", name, err)
+	}
+	for _, manipulator := range manipulators {
+		if service, err = manipulator(service, view); err != nil {
+			return nil, fmt.Errorf("cannot manipulate service %s: %w", name, err)
+		}
+	}
+	return service, nil
+}
+
+/**
+ * @param string $name
+ * @param callable $instantiator
+ * @throws ServiceAlreadyDefinedException if there is already a service registered as $name.
+ */
+func (s *ServiceContainer) defineService(name string, instantiator func(*ServiceContainer) (interface{}, error)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.serviceInstantiators[name]; ok {
+		panic(exception.NewServiceAlreadyDefinedException(name, nil))
+	}
+	s.serviceInstantiators[name] = instantiator
+}
+
+/**
+ * @param string $name
+ * @param object $service
+ * @return error Telling that the service is not of type T
  */
-func (s *ServiceContainer) GetService(name string) (ret *SpecialPageFactory) {
-	//if s.destroyed {
-	//	panic(exception.NewNoSuchServiceException(name, nil))
-	//}
-	//if _, ok :=
-	//if ( isset( $this->disabled[$name] ) ) {
-	//throw new ServiceDisabledException( $name );
-	//}
-	//
-	//if ( !isset( $this->services[$name] ) ) {
-	//$this->services[$name] = $this->createService( $name );
-	//}
-	//
-	//return $this->services[$name];
-	return ret
+func serviceTypeError[T any](name string, service interface{}) error {
+	return fmt.Errorf("service %s is a %T, not a %s", name, service, reflect.TypeOf((*T)(nil)).Elem())
 }
@@ -0,0 +1,305 @@
+package includes
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+type testService struct {
+	Name      string
+	Destroyed bool
+}
+
+func (t *testService) Destroy() {
+	t.Destroyed = true
+}
+
+func newTestServiceInstantiator(name string) func(*ServiceContainer) (*testService, error) {
+	return func(*ServiceContainer) (*testService, error) {
+		return &testService{Name: name}, nil
+	}
+}
+
+/**
+ * @covers ServiceContainer::defineService
+ * @covers ServiceContainer::getService
+ */
+func TestGetService(t *testing.T) {
+	services := NewServiceContainer([]interface{}{"extra"})
+	calls := 0
+	DefineService(services, "Foo", func(*ServiceContainer) (*testService, error) {
+		calls++
+		return &testService{Name: "foo"}, nil
+	})
+	test.AssertTrue(t, services.HasService("Foo"), `Service defined`)
+	test.AssertEqual(t, nil, services.PeekService("Foo"), `Services are lazy`)
+
+	foo, err := GetService[*testService](services, "Foo")
+	test.AssertEqual(t, nil, err, `No error`)
+	test.AssertEqual(t, "foo", foo.Name, `Service created`)
+	again, _ := GetService[*testService](services, "Foo")
+	test.AssertTrue(t, foo == again, `Service instances are reused`)
+	test.AssertEqual(t, 1, calls, `Instantiated once`)
+	test.AssertEqual(t, "extra", services.GetExtraInstantiationParams()[0], `Extra instantiation parameters`)
+
+	_, err = GetService[DestructibleService](services, "Foo")
+	test.AssertEqual(t, nil, err, `Services can be got as their interfaces`)
+	_, err = GetService[string](services, "Foo")
+	test.AssertEqual(t, "service Foo is a *includes.testService, not a string", fmt.Sprint(err), `Service type checked`)
+
+	_, err = GetService[*testService](services, "Bar")
+	_, ok := err.(*exception.NoSuchServiceException)
+	test.AssertTrue(t, ok, `Unknown services`)
+
+	func() {
+		defer func() {
+			_, ok := recover().(*exception.ServiceAlreadyDefinedException)
+			test.AssertTrue(t, ok, `Services can be defined once`)
+		}()
+		DefineService(services, "Foo", newTestServiceInstantiator("other"))
+	}()
+}
+
+/**
+ * @covers ServiceContainer::applyWiring
+ * @covers ServiceContainer::loadWiringFiles
+ */
+func TestLoadWiringFiles(t *testing.T) {
+	services := NewServiceContainer(nil)
+	services.LoadWiringFiles([]ServiceWiring{
+		{"Foo": Instantiator(newTestServiceInstantiator("foo"))},
+		{"Bar": Instantiator(newTestServiceInstantiator("bar"))},
+	})
+	test.AssertEqual(t, fmt.Sprint([]string{"Bar", "Foo"}), fmt.Sprint(services.GetServiceNames()), `Wirings loaded`)
+
+	func() {
+		defer func() {
+			test.AssertTrue(t, recover() != nil, `Wirings cannot define a service twice`)
+		}()
+		services.ApplyWiring(ServiceWiring{"Foo": Instantiator(newTestServiceInstantiator("foo"))})
+	}()
+}
+
+/**
+ * @covers ServiceContainer::redefineService
+ * @covers ServiceContainer::addServiceManipulator
+ */
+func TestRedefineAndManipulate(t *testing.T) {
+	services := NewServiceContainer(nil)
+	DefineService(services, "Foo", newTestServiceInstantiator("foo"))
+	RedefineService(services, "Foo", newTestServiceInstantiator("redefined"))
+	AddServiceManipulator(services, "Foo", func(service *testService, container *ServiceContainer) (*testService, error) {
+		service.Name += " manipulated"
+		return service, nil
+	})
+	AddServiceManipulator(services, "Foo", func(service *testService, container *ServiceContainer) (*testService, error) {
+		return &testService{Name: "wrapped " + service.Name}, nil
+	})
+
+	foo, _ := GetService[*testService](services, "Foo")
+	test.AssertEqual(t, "wrapped redefined manipulated", foo.Name, `Manipulators run in order`)
+
+	func() {
+		defer func() {
+			_, ok := recover().(*exception.CannotReplaceActiveServiceException)
+			test.AssertTrue(t, ok, `Active services cannot be redefined`)
+		}()
+		RedefineService(services, "Foo", newTestServiceInstantiator("late"))
+	}()
+	func() {
+		defer func() {
+			_, ok := recover().(*exception.CannotReplaceActiveServiceException)
+			test.AssertTrue(t, ok, `Active services cannot be manipulated`)
+		}()
+		AddServiceManipulator(services, "Foo", func(service *testService, container *ServiceContainer) (*testService, error) {
+			return service, nil
+		})
+	}()
+	func() {
+		defer func() {
+			_, ok := recover().(*exception.NoSuchServiceException)
+			test.AssertTrue(t, ok, `Unknown services cannot be redefined`)
+		}()
+		RedefineService(services, "Bar", newTestServiceInstantiator("bar"))
+	}()
+}
+
+/**
+ * @covers ServiceContainer::importWiring
+ */
+func TestImportWiring(t *testing.T) {
+	services := NewServiceContainer(nil)
+	DefineService(services, "Foo", newTestServiceInstantiator("foo"))
+	DefineService(services, "Bar", newTestServiceInstantiator("bar"))
+	DefineService(services, "Skipped", newTestServiceInstantiator("skipped"))
+	AddServiceManipulator(services, "Foo", func(service *testService, container *ServiceContainer) (*testService, error) {
+		service.Name += " manipulated"
+		return service, nil
+	})
+	bar, _ := GetService[*testService](services, "Bar")
+
+	other := NewServiceContainer(nil)
+	DefineService(other, "Bar", newTestServiceInstantiator("other bar"))
+	DefineService(other, "Skipped", newTestServiceInstantiator("other skipped"))
+	other.ImportWiring(services, []string{"Skipped"})
+
+	foo, _ := GetService[*testService](other, "Foo")
+	test.AssertEqual(t, "foo manipulated", foo.Name, `Instantiators and manipulators imported`)
+	otherBar, _ := GetService[*testService](other, "Bar")
+	test.AssertEqual(t, "bar", otherBar.Name, `Imported wiring overrides the local one`)
+	test.AssertTrue(t, bar != otherBar, `Service instances are not imported`)
+	skipped, _ := GetService[*testService](other, "Skipped")
+	test.AssertEqual(t, "other skipped", skipped.Name, `Skipped services`)
+}
+
+/**
+ * @covers ServiceContainer::disableService
+ * @covers ServiceContainer::resetService
+ * @covers ServiceContainer::destroy
+ */
+func TestDisableAndResetService(t *testing.T) {
+	services := NewServiceContainer(nil)
+	DefineService(services, "Foo", newTestServiceInstantiator("foo"))
+	foo, _ := GetService[*testService](services, "Foo")
+
+	services.ResetService("Foo", false)
+	test.AssertEqual(t, false, foo.Destroyed, `Reset without destroying`)
+	newFoo, _ := GetService[*testService](services, "Foo")
+	test.AssertTrue(t, foo != newFoo, `New instance after reset`)
+
+	services.DisableService("Foo")
+	test.AssertEqual(t, true, newFoo.Destroyed, `Disabled services are destroyed`)
+	_, err := GetService[*testService](services, "Foo")
+	_, ok := err.(*exception.ServiceDisabledException)
+	test.AssertTrue(t, ok, `Disabled services cannot be got`)
+	RedefineService(services, "Foo", newTestServiceInstantiator("enabled"))
+	foo, _ = GetService[*testService](services, "Foo")
+	test.AssertEqual(t, "enabled", foo.Name, `Redefinition enables the service`)
+
+	services.Destroy()
+	test.AssertEqual(t, true, foo.Destroyed, `Services destroyed with the container`)
+	_, err = GetService[*testService](services, "Foo")
+	_, ok = err.(*exception.ContainerDisabledException)
+	test.AssertTrue(t, ok, `Destroyed container`)
+}
+
+/**
+ * @covers ServiceContainer::getService
+ * @covers ServiceContainer::disableService
+ */
+func TestConcurrentGetService(t *testing.T) {
+	services := NewServiceContainer(nil)
+	release := make(chan struct{})
+	var calls int32
+	var created []*testService
+	DefineService(services, "Foo", func(*ServiceContainer) (*testService, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		foo := &testService{Name: "foo"}
+		created = append(created, foo)
+		return foo, nil
+	})
+
+	results := make([]*testService, 10)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = GetService[*testService](services, "Foo")
+		}(i)
+	}
+	for atomic.LoadInt32(&calls) == 0 {
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+	test.AssertEqual(t, int32(1), atomic.LoadInt32(&calls), `Instantiated once`)
+	for _, foo := range results {
+		test.AssertTrue(t, foo == created[0], `Every caller gets the same instance`)
+	}
+
+	// Disabled while being created
+	release = make(chan struct{})
+	services.ResetService("Foo", true)
+	done := make(chan error)
+	go func() {
+		_, err := GetService[*testService](services, "Foo")
+		done <- err
+	}()
+	for atomic.LoadInt32(&calls) == 1 {
+		runtime.Gosched()
+	}
+	services.DisableService("Foo")
+	close(release)
+	_, ok := (<-done).(*exception.ServiceDisabledException)
+	test.AssertTrue(t, ok, `Service disabled while being created`)
+	test.AssertEqual(t, true, created[1].Destroyed, `Instance created for a disabled service is destroyed`)
+	test.AssertEqual(t, nil, services.PeekService("Foo"), `Instance not kept`)
+}
+
+/**
+ * @covers ServiceContainer::createService
+ */
+func TestServiceErrors(t *testing.T) {
+	services := NewServiceContainer(nil)
+	services.ApplyWiring(ServiceWiring{
+		"A": Instantiator(func(container *ServiceContainer) (*testService, error) {
+			if _, err := GetService[*testService](container, "B"); err != nil {
+				return nil, err
+			}
+			return &testService{Name: "a"}, nil
+		}),
+		"B": Instantiator(func(container *ServiceContainer) (*testService, error) {
+			if _, err := GetService[*testService](container, "C"); err != nil {
+				return nil, err
+			}
+			return &testService{Name: "b"}, nil
+		}),
+		"C": Instantiator(func(container *ServiceContainer) (*testService, error) {
+			return GetService[*testService](container, "A")
+		}),
+		"Broken": Instantiator(func(container *ServiceContainer) (*testService, error) {
+			return nil, errors.New("no database")
+		}),
+	})
+
+	_, err := GetService[*testService](services, "A")
+	test.AssertEqual(t,
+		"cannot create service A: cannot create service B: cannot create service C: "+
+			"Circular dependency when creating service! A -> B -> C -> A",
+		fmt.Sprint(err),
+		`Circular dependencies detected`,
+	)
+	_, err = GetService[*testService](services, "Broken")
+	test.AssertEqual(t, "cannot create service Broken: no database", fmt.Sprint(err), `Instantiator errors`)
+	test.AssertEqual(t, nil, services.PeekService("Broken"), `Failed services are not kept`)
+}
+
+/**
+ * @covers MediaWikiServices::newInstance
+ */
+func TestMediaWikiServices(t *testing.T) {
+	oldWiringFiles := WgServiceWiringFiles
+	defer func() { WgServiceWiringFiles = oldWiringFiles }()
+	WgServiceWiringFiles = append(WgServiceWiringFiles, ServiceWiring{
+		"FooExtension.Service": Instantiator(newTestServiceInstantiator("foo")),
+	})
+
+	services := newMediaWikiServicesInstance(nil, "load")
+	test.AssertTrue(t, services.GetSpecialPageFactory() != nil, `Core services`)
+	test.AssertTrue(t, services.GetHookContainer() == GetHookContainer(), `Hook container service`)
+	foo, _ := GetService[*testService](services.ServiceContainer, "FooExtension.Service")
+	test.AssertEqual(t, "foo", foo.Name, `Extension services`)
+
+	old := ForceGlobalInstance(services)
+	test.AssertTrue(t, GetMediaWikiServices() == services, `Global instance replaced`)
+	ForceGlobalInstance(old)
+}
@@ -0,0 +1,200 @@
+/**
+ * Default wiring for MediaWiki services.
+ *
+ * This file is loaded by MediaWikiServices, as the first of
+ * WgServiceWiringFiles. Extensions append their own wirings to it to add
+ * their services.
+ *
+ * Services defined here must not depend on services of the extensions.
+ * Instantiators get the container, from which they get the services they
+ * depend on with GetService().
+ *
+ * @see docs/injection.txt for an overview of using dependency injection in the
+ *      MediaWiki code base.
+ */
+package includes
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/MangoDowner/mediawiki/includes/cache"
+	"github.com/MangoDowner/mediawiki/includes/config"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	"github.com/MangoDowner/mediawiki/includes/libs/rdbms/database"
+	"github.com/MangoDowner/mediawiki/includes/libs/stats"
+	"github.com/MangoDowner/mediawiki/includes/objectcache"
+	"github.com/MangoDowner/mediawiki/includes/parser"
+	"github.com/MangoDowner/mediawiki/includes/registration"
+	"github.com/astaxie/beego/logs"
+)
+
+/** @var ServiceWiring */
+var CoreServiceWiring = ServiceWiring{
+	"HookContainer": Instantiator(func(services *ServiceContainer) (*HookContainer, error) {
+		statsdDataFactory, err := GetService[*stats.BufferingStatsdDataFactory](services, "StatsdDataFactory")
+		if err != nil {
+			return nil, err
+		}
+		container := GetHookContainer()
+		// Time the handlers
+		container.SetStatsdDataFactory(statsdDataFactory)
+		return container, nil
+	}),
+
+	"ConfigFactory": Instantiator(func(services *ServiceContainer) (*config.ConfigFactory, error) {
+		factory := config.NewConfigFactory()
+		factory.Register("main", func() config.IConfig { return NewMainConfig() })
+		// Configs of the extensions, by name
+		registry, _ := NewMainConfig().Get("ConfigRegistry").(map[string]interface{})
+		for name, callback := range registry {
+			if function, ok := callback.(string); ok {
+				if callback, ok = registration.GetFunction(function); !ok {
+					return nil, exception.NewConfigExceptionWithMessage(
+						fmt.Sprintf("The config builder %s of %s is not registered.", function, name))
+				}
+			}
+			factory.Register(name, callback)
+		}
+		return factory, nil
+	}),
+
+	"MainConfig": Instantiator(func(services *ServiceContainer) (config.IConfig, error) {
+		factory, err := GetService[*config.ConfigFactory](services, "ConfigFactory")
+		if err != nil {
+			return nil, err
+		}
+		return factory.MakeConfig("main"), nil
+	}),
+
+	"MainDatabase": Instantiator(func(services *ServiceContainer) (database.IDatabase, error) {
+		mainConfig, err := GetService[config.IConfig](services, "MainConfig")
+		if err != nil {
+			return nil, err
+		}
+		dbType, _ := mainConfig.Get("DBtype").(string)
+		if dbType != "sqlite" {
+			return nil, exception.NewConfigExceptionWithMessage(
+				fmt.Sprintf("Unsupported $wgDBtype \"%s\", only \"sqlite\" is supported.", dbType))
+		}
+		dbPath := ":memory:"
+		if dir, _ := mainConfig.Get("SQLiteDataDir").(string); dir != "" {
+			dbName, _ := mainConfig.Get("DBname").(string)
+			dbPath = filepath.Join(dir, dbName+".sqlite")
+		}
+		db, err := database.NewDatabaseSqlite(map[string]interface{}{"dbFilePath": dbPath})
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	}),
+
+	"PageStore": Instantiator(func(services *ServiceContainer) (*PageStore, error) {
+		db, err := GetService[database.IDatabase](services, "MainDatabase")
+		if err != nil {
+			return nil, err
+		}
+		store := NewPageStore(db)
+		if err := store.CreateTables(); err != nil {
+			return nil, err
+		}
+		return store, nil
+	}),
+
+	"Parser": Instantiator(func(services *ServiceContainer) (*parser.Parser, error) {
+		hookContainer, err := GetService[*HookContainer](services, "HookContainer")
+		if err != nil {
+			return nil, err
+		}
+		p := parser.NewParser(cache.SingletonGenderCache())
+		// Extensions register their tags and functions
+		NewHookRunner(hookContainer).OnParserFirstCallInit(&p)
+		return p, nil
+	}),
+
+	"StatsdDataFactory": Instantiator(func(services *ServiceContainer) (*stats.BufferingStatsdDataFactory, error) {
+		mainConfig, err := GetService[config.IConfig](services, "MainConfig")
+		if err != nil {
+			return nil, err
+		}
+		prefix, _ := mainConfig.Get("StatsdMetricPrefix").(string)
+		server, _ := mainConfig.Get("StatsdServer").(string)
+		factory := stats.NewBufferingStatsdDataFactory(strings.TrimRight(prefix, "."))
+		// Nothing sends the metrics without a server, do not buffer them
+		factory.SetEnabled(server != "")
+		return factory, nil
+	}),
+
+	"SpecialPageFactory": Instantiator(func(services *ServiceContainer) (*SpecialPageFactory, error) {
+		hookContainer, err := GetService[*HookContainer](services, "HookContainer")
+		if err != nil {
+			return nil, err
+		}
+		p, err := GetService[*parser.Parser](services, "Parser")
+		if err != nil {
+			return nil, err
+		}
+		mainConfig, err := GetService[config.IConfig](services, "MainConfig")
+		if err != nil {
+			return nil, err
+		}
+		return NewSpecialPageFactory(mainConfig, hookContainer, p), nil
+	}),
+
+	"UserOptionsLookup": Instantiator(func(services *ServiceContainer) (*UserOptionsLookup, error) {
+		db, err := GetService[database.IDatabase](services, "MainDatabase")
+		if err != nil {
+			return nil, err
+		}
+		lookup := NewUserOptionsLookup(db)
+		if err := lookup.CreateTables(); err != nil {
+			return nil, err
+		}
+		return lookup, nil
+	}),
+}
+
+func init() {
+	// SqlBagOStuff uses the MainDatabase service, CACHE_DB in particular
+	objectcache.MainDatabase = func() (database.IDatabase, error) {
+		return GetService[database.IDatabase](GetMediaWikiServices().ServiceContainer, "MainDatabase")
+	}
+	// The overrides of the messages are the pages of the MediaWiki namespace
+	cache.FetchMessagePages = func() map[string]string {
+		store, err := GetService[*PageStore](GetMediaWikiServices().ServiceContainer, "PageStore")
+		if err != nil {
+			logs.Warn("No MediaWiki namespace messages: %s", err)
+			return nil
+		}
+		texts, err := store.GetPageTexts(consts.NS_MEDIAWIKI)
+		if err != nil {
+			logs.Error("Cannot read the MediaWiki namespace messages: %s", err)
+			return nil
+		}
+		return texts
+	}
+	// GenderCache reads the gender preference of the users
+	cache.FetchUserGenders = func(usernames []string) map[string]string {
+		lookup, err := GetService[*UserOptionsLookup](GetMediaWikiServices().ServiceContainer, "UserOptionsLookup")
+		if err != nil {
+			logs.Warn("No gender preferences: %s", err)
+			return nil
+		}
+		genders, err := lookup.GetOptionOfUsers(usernames, "gender")
+		if err != nil {
+			logs.Error("Cannot read the gender preferences: %s", err)
+			return nil
+		}
+		return genders
+	}
+	// The caches send their stats to the StatsdDataFactory service
+	objectcache.StatsdDataFactory = func() stats.IStatsdDataFactory {
+		return GetMediaWikiServices().GetStatsdDataFactory()
+	}
+	// The config builders extension.json can name in ConfigRegistry
+	registration.RegisterFunction("GlobalVarConfig::newInstance", func() config.IConfig {
+		return config.NewInstance()
+	})
+}
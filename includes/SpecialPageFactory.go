@@ -7,8 +7,12 @@ import (
 	"fmt"
 	"github.com/MangoDowner/mediawiki/includes/config"
 	"github.com/MangoDowner/mediawiki/includes/languages"
+	"github.com/MangoDowner/mediawiki/includes/libs/rdbms/database"
 	"github.com/MangoDowner/mediawiki/includes/linker"
+	"github.com/MangoDowner/mediawiki/includes/objectcache"
+	"github.com/MangoDowner/mediawiki/includes/parser"
 	"github.com/MangoDowner/mediawiki/includes/php"
+	"github.com/MangoDowner/mediawiki/includes/registration"
 	"github.com/MangoDowner/mediawiki/includes/specials"
 	"github.com/astaxie/beego/context"
 )
@@ -53,7 +57,7 @@ type SpecialPageFactory struct {
 	aliases map[string]string
 
 	/** @var Config */
-	config interface{}
+	config config.IConfig
 
 	/** @var Language */
 	contLang *languages.Language
@@ -62,11 +66,14 @@ type SpecialPageFactory struct {
 }
 
 /**
- * @param Config $config
- * @param Language $contLang
+ * @param Config $mainConfig
+ * @param HookContainer $hookContainer
+ * @param Parser $parser
  */
-func NewSpecialPageFactory() *SpecialPageFactory {
+func NewSpecialPageFactory(mainConfig config.IConfig, hookContainer *HookContainer,
+	parser *parser.Parser) *SpecialPageFactory {
 	this := new(SpecialPageFactory)
+	this.config = mainConfig
 	//TODO: 补全
 	this.coreList = map[string]ISpecialPage{
 		// Maintenance Reports
@@ -156,7 +163,8 @@ func NewSpecialPageFactory() *SpecialPageFactory {
 		"ApiSandbox" : &specials.SpecialLog{},
 		"Statistics" : &specials.SpecialLog{},
 		"Allmessages" : &specials.SpecialLog{},
-		"Version" : &specials.SpecialLog{},
+		"Version" : specials.NewSpecialVersion(hookContainer, NewHookRunner(hookContainer), parser,
+			registration.GetInstance(), mainDatabase, wfMessageText),
 		"Lockdb" : &specials.SpecialLog{},
 		"Unlockdb" : &specials.SpecialLog{},
 
@@ -238,34 +246,43 @@ func (s *SpecialPageFactory) getPageList() map[string]ISpecialPage {
 	}
 	s.list = s.coreList
 
-	if !config.Configs.GetBool("DisableInternalSearch") {
+	if !s.config.Get("DisableInternalSearch").(bool) {
 		s.list["Search"] = &specials.SpecialLog{}
 	}
 
 	// TODO: 陆续补全后面的工厂类
-	if config.Configs.GetBool( "EmailAuthentication" ) {
+	if s.config.Get("EmailAuthentication").(bool) {
 		s.list["Confirmemail"] = &specials.SpecialLog{}
 		s.list["Invalidateemail"] = &specials.SpecialLog{}
 	}
 
-	if config.Configs.GetBool( "EnableEmail" ) {
+	if s.config.Get("EnableEmail").(bool) {
 		s.list["ChangeEmail"] = &specials.SpecialLog{}
 	}
 
-	if config.Configs.GetBool( "EnableJavaScriptTest" ) {
+	if s.config.Get("EnableJavaScriptTest").(bool) {
 		s.list["JavaScriptTest"] = &specials.SpecialLog{}
 	}
 
-	if config.Configs.GetBool( "PageLanguageUseDB" ) {
+	if s.config.Get("PageLanguageUseDB").(bool) {
 		s.list["PageLanguage"] = &specials.SpecialLog{}
 	}
 
-	if config.Configs.GetBool( "ContentHandlerUseDB" ) {
+	if s.config.Get("ContentHandlerUseDB").(bool) {
 		s.list["ChangeContentModel"] = &specials.SpecialLog{}
 	}
 
 	// Add extension special pages
-	//$this->list = array_merge( $this->list, $this->config->get( "SpecialPages" ) );
+	extPages, _ := s.config.Get("SpecialPages").(map[string]interface{})
+	for name, class := range extPages {
+		className, _ := class.(string)
+		switch page, _ := registration.GetClass(className); page := page.(type) {
+		case ISpecialPage:
+			s.list[name] = page
+		case func() ISpecialPage:
+			s.list[name] = page()
+		}
+	}
 
 	// This hook can be used to disable unwanted core special pages
 	// or conditionally register special pages.
@@ -341,10 +358,40 @@ func (s *SpecialPageFactory) ExecutePath(title *Title, context *context.Context,
 		par = bits[1]
 	}
 
+	if page, ok := s.getPageList()[name]; ok {
+		if err := page.Execute(par, context); err != nil {
+			WfLogWarning(err.Error())
+		}
+		return
+	}
+
 	page := s.GetPage(name)
 	if !page {
 
 	}
 	fmt.Println(par)
 	return
-}
\ No newline at end of file
+}
+
+/**
+ * @return IDatabase|null The main database, if there is one
+ */
+func mainDatabase() database.IDatabase {
+	if objectcache.MainDatabase == nil {
+		return nil
+	}
+	db, err := objectcache.MainDatabase()
+	if err != nil {
+		return nil
+	}
+	return db
+}
+
+/**
+ * @param string $key
+ * @param string ...$params
+ * @return string The text of the message
+ */
+func wfMessageText(key string, params ...string) string {
+	return WfMessage(key, params...).Text()
+}
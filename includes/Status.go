@@ -0,0 +1,367 @@
+package includes
+
+import (
+	"github.com/MangoDowner/mediawiki/includes/languages"
+	"github.com/MangoDowner/mediawiki/includes/libs"
+	"strconv"
+	"strings"
+)
+
+/**
+ * Generic operation result class
+ * Has warning/error list, boolean status and arbitrary value
+ *
+ * "Good" means the operation was completed with no warnings or errors.
+ *
+ * "OK" means the operation was partially or wholly completed.
+ *
+ * An operation which is not OK should have errors so that the user can be
+ * informed as to what went wrong. Calling the fatal() function sets an error
+ * message and simultaneously switches off the OK flag.
+ *
+ * The recommended pattern for Status objects is to return a Status object
+ * unconditionally, i.e. both on success and on failure -- so that the
+ * developer of the calling code is reminded that the function can fail, and
+ * so that a lack of error-handling will be explicit.
+ */
+type Status struct {
+	/** @var StatusValue */
+	*libs.StatusValue
+
+	/** @var callable|null Applied to the parameters of messages given by key */
+	CleanCallback func(param interface{}) interface{}
+}
+
+/**
+ * @param StatusValue|null $sv [optional] The StatusValue to wrap
+ */
+func NewStatus(sv *libs.StatusValue) *Status {
+	this := new(Status)
+	if sv == nil {
+		sv = libs.NewStatusValue()
+	}
+	this.StatusValue = sv
+	return this
+}
+
+/**
+ * Succinct helper method to wrap a StatusValue
+ *
+ * This is is useful when formatting StatusValue objects:
+ * @code
+ *     $this->getOutput()->addHtml( Status::wrap( $sv )->getHTML() );
+ * @endcode
+ *
+ * @param StatusValue|Status $sv
+ * @return Status
+ */
+func WrapStatus(sv *libs.StatusValue) *Status {
+	return NewStatus(sv)
+}
+
+/**
+ * Factory function for fatal errors
+ *
+ * @param string|MessageSpecifier $message Message key or object
+ * @return Status
+ */
+func NewFatalStatus(message interface{}, params ...interface{}) *Status {
+	return NewStatus(libs.NewFatalStatusValue(message, params...))
+}
+
+/**
+ * Factory function for good results
+ *
+ * @param mixed $value
+ * @return Status
+ */
+func NewGoodStatus(value interface{}) *Status {
+	return NewStatus(libs.NewGoodStatusValue(value))
+}
+
+/**
+ * Splits this Status object into two new Status objects, one which contains only
+ * the error messages, and one that contains the warnings, only.
+ *
+ * @return Status[]
+ */
+func (s *Status) SplitByErrorType() (*Status, *Status) {
+	errorsOnlyStatusValue, warningsOnlyStatusValue := s.StatusValue.SplitByErrorType()
+	errorsOnlyStatus, warningsOnlyStatus := NewStatus(errorsOnlyStatusValue), NewStatus(warningsOnlyStatusValue)
+	errorsOnlyStatus.CleanCallback = s.CleanCallback
+	warningsOnlyStatus.CleanCallback = s.CleanCallback
+	return errorsOnlyStatus, warningsOnlyStatus
+}
+
+/**
+ * Returns the wrapped StatusValue object
+ * @return StatusValue
+ * @since 1.27
+ */
+func (s *Status) GetStatusValue() *libs.StatusValue {
+	return s.StatusValue
+}
+
+/**
+ * @param array $params
+ * @return array
+ */
+func (s *Status) cleanParams(params []interface{}) []interface{} {
+	if s.CleanCallback == nil {
+		return params
+	}
+	cleanParams := make([]interface{}, len(params))
+	for i, param := range params {
+		cleanParams[i] = s.CleanCallback(param)
+	}
+	return cleanParams
+}
+
+/**
+ * @param string|Language|null $lang Language to use for processing
+ *  messages, or null to use the user language
+ * @return Language
+ */
+func (s *Status) languageFromParam(lang *languages.Language) *languages.Language {
+	if lang == nil {
+		// TODO: use the user language once the main request context exists
+		return getContentLanguage()
+	}
+	return lang
+}
+
+/**
+ * Get the error list as a wikitext formatted list
+ *
+ * @param string|bool $shortContext A short enclosing context message name, to
+ *        be used when there is a single error
+ * @param string|bool $longContext A long enclosing context message name, for a list
+ * @param string|Language $lang Language to use for processing messages
+ * @return string
+ */
+func (s *Status) GetWikiText(shortContext, longContext string, lang *languages.Language) string {
+	lang = s.languageFromParam(lang)
+
+	rawErrors := s.getRawErrors("GetWikiText")
+	var str string
+	if len(rawErrors) == 1 {
+		str = s.getErrorMessage(rawErrors[0], lang).Plain()
+		if shortContext != "" {
+			str = NewMessage(shortContext, []interface{}{str}, nil).InLanguage(lang).Plain()
+		} else if longContext != "" {
+			str = NewMessage(longContext, []interface{}{"* " + str + "\n"}, nil).InLanguage(lang).Plain()
+		}
+	} else {
+		errors := s.getErrorMessageArray(rawErrors, lang)
+		plain := make([]string, 0, len(errors))
+		for _, item := range errors {
+			plain = append(plain, item.Plain())
+		}
+		str = "* " + strings.Join(plain, "\n* ") + "\n"
+		if longContext != "" {
+			str = NewMessage(longContext, []interface{}{str}, nil).InLanguage(lang).Plain()
+		} else if shortContext != "" {
+			str = NewMessage(shortContext, []interface{}{"\n" + str + "\n"}, nil).InLanguage(lang).Plain()
+		}
+	}
+	return str
+}
+
+/**
+ * Get a bullet list of the errors as a Message object.
+ *
+ * $shortContext and $longContext can be used to wrap the error list in some text.
+ * $shortContext will be preferred when there is a single error; $longContext will be
+ * preferred when there are multiple ones. In either case, $1 will be replaced with
+ * the list of errors.
+ *
+ * $shortContext is assumed to use $1 as an inline parameter: if there is a single item,
+ * it will not be made into a list; if there are multiple items, newlines will be inserted
+ * around the list.
+ * $longContext is assumed to use $1 as a standalone parameter; it will always receive a list.
+ *
+ * If both parameters are missing, and there is only one error, no bullet will be added.
+ *
+ * @param string|string[]|bool $shortContext A message name or an array of message names.
+ * @param string|string[]|bool $longContext A message name or an array of message names.
+ * @param string|Language $lang Language to use for processing messages
+ * @return Message
+ */
+func (s *Status) GetMessage(shortContext, longContext string, lang *languages.Language) *Message {
+	lang = s.languageFromParam(lang)
+
+	rawErrors := s.getRawErrors("GetMessage")
+	var msg *Message
+	if len(rawErrors) == 1 {
+		msg = s.getErrorMessage(rawErrors[0], lang)
+		if shortContext != "" {
+			msg = NewMessage(shortContext, []interface{}{msg}, nil).InLanguage(lang)
+		} else if longContext != "" {
+			wrapper := NewRawMessage("* $1\n", []interface{}{msg})
+			msg = NewMessage(longContext, []interface{}{wrapper}, nil).InLanguage(lang)
+		}
+	} else {
+		msgs := s.getErrorMessageArray(rawErrors, lang)
+		vars := make([]string, 0, len(msgs))
+		params := make([]interface{}, 0, len(msgs))
+		for i, item := range msgs {
+			vars = append(vars, "$"+strconv.Itoa(i+1))
+			params = append(params, item)
+		}
+		msg = NewRawMessage("* "+strings.Join(vars, "\n* "), params)
+		if longContext != "" {
+			msg = NewMessage(longContext, []interface{}{msg}, nil).InLanguage(lang)
+		} else if shortContext != "" {
+			wrapper := NewRawMessage("\n$1\n", []interface{}{msg})
+			msg = NewMessage(shortContext, []interface{}{wrapper}, nil).InLanguage(lang)
+		}
+	}
+	return msg
+}
+
+/**
+ * Get the error message as HTML. This is done by parsing the wikitext error message
+ *
+ * @note the wikitext is only preprocessed so far, see Parser
+ * @param string $shortContext A short enclosing context message name, to
+ *        be used when there is a single error
+ * @param string $longContext A long enclosing context message name, for a list
+ * @param string|Language $lang Language to use for processing messages
+ * @return string
+ */
+func (s *Status) GetHTML(shortContext, longContext string, lang *languages.Language) string {
+	lang = s.languageFromParam(lang)
+	text := s.GetWikiText(shortContext, longContext, lang)
+	return NewRawMessage(text, nil).InLanguage(lang).Parse()
+}
+
+/**
+ * Get the list of errors (but not warnings)
+ *
+ * @return array A list in which each entry is an array with a message key as its first element.
+ *         The remaining array elements are the message parameters.
+ */
+func (s *Status) GetErrorsArray() [][]interface{} {
+	return s.getStatusArray("error")
+}
+
+/**
+ * Get the list of warnings (but not errors)
+ *
+ * @return array A list in which each entry is an array with a message key as its first element.
+ *         The remaining array elements are the message parameters.
+ */
+func (s *Status) GetWarningsArray() [][]interface{} {
+	return s.getStatusArray("warning")
+}
+
+/**
+ * Get the errors or warnings in the form of the error output of the API,
+ * with the message key as code and the message text in the given language
+ *
+ * @param string $type 'error' or 'warning'
+ * @param Language|null $lang Language of the texts
+ * @return array[]
+ */
+func (s *Status) GetApiArray(errorType string, lang *languages.Language) []map[string]interface{} {
+	lang = s.languageFromParam(lang)
+	result := make([]map[string]interface{}, 0)
+	if s.IsGood() {
+		return result
+	}
+	for _, item := range s.GetErrorsByType(errorType) {
+		result = append(result, map[string]interface{}{
+			"type":   errorType,
+			"code":   item.Message.GetKey(),
+			"params": item.Message.GetParams(),
+			"text":   s.getErrorMessage(item, lang).Text(),
+		})
+	}
+	return result
+}
+
+/**
+ * Returns a list of status messages of the given type (or all if false)
+ *
+ * @note: this handles RawMessage poorly
+ *
+ * @param string|bool $type
+ * @return array
+ */
+func (s *Status) getStatusArray(errorType string) [][]interface{} {
+	result := make([][]interface{}, 0)
+	for _, item := range s.GetErrors() {
+		if errorType == "" || item.Type == errorType {
+			entry := []interface{}{item.Message.GetKey()}
+			result = append(result, append(entry, item.Message.GetParams()...))
+		}
+	}
+	return result
+}
+
+/**
+ * Get the errors, with an internal error for a status which has none
+ *
+ * @param string $method Caller, for the internal error message
+ * @return array[]
+ */
+func (s *Status) getRawErrors(method string) []libs.StatusError {
+	rawErrors := s.GetErrors()
+	if len(rawErrors) == 0 {
+		if s.IsOK() {
+			s.Fatal("internalerror_info", "Status::"+method+" called for a good result, this is incorrect\n")
+		} else {
+			s.Fatal("internalerror_info", "Status::"+method+": Invalid result object: no error text but not OK\n")
+		}
+		rawErrors = s.GetErrors() // just added a fatal
+	}
+	return rawErrors
+}
+
+/**
+ * Return the message for a single error
+ *
+ * The parameters of messages given by key are escaped, the message
+ * objects are used as they are.
+ *
+ * @param mixed $error With string or MessageSpecifier
+ * @param string|Language $lang Language to use for processing messages
+ * @return Message
+ */
+func (s *Status) getErrorMessage(item libs.StatusError, lang *languages.Language) *Message {
+	var msg *Message
+	switch m := item.Message.(type) {
+	case *Message:
+		message := *m
+		msg = &message
+	case *libs.MessageValue:
+		params := s.cleanParams(m.GetParams())
+		escaped := make([]interface{}, 0, len(params))
+		for _, param := range params {
+			if text, ok := param.(string); ok {
+				param = WfEscapeWikiText(text)
+			}
+			escaped = append(escaped, param)
+		}
+		msg = NewMessage(m.GetKey(), escaped, nil)
+	default:
+		msg = NewMessage(m, nil, nil)
+	}
+	msg.InLanguage(s.languageFromParam(lang))
+	return msg
+}
+
+/**
+ * Return an array with a Message object for each error.
+ *
+ * @param array $errors
+ * @param string|Language $lang Language to use for processing messages
+ * @return Message[]
+ */
+func (s *Status) getErrorMessageArray(errors []libs.StatusError, lang *languages.Language) []*Message {
+	messages := make([]*Message, 0, len(errors))
+	for _, item := range errors {
+		messages = append(messages, s.getErrorMessage(item, lang))
+	}
+	return messages
+}
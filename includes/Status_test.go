@@ -0,0 +1,108 @@
+package includes
+
+import (
+	"fmt"
+	"github.com/MangoDowner/mediawiki/includes/languages"
+	"github.com/MangoDowner/mediawiki/includes/libs"
+	test "github.com/MangoDowner/mediawiki/tests"
+	"testing"
+)
+
+/**
+ * @covers Status::wrap
+ * @covers Status::getStatusValue
+ */
+func TestWrapStatus(t *testing.T) {
+	sv := libs.NewGoodStatusValue(5)
+	status := WrapStatus(sv)
+	status.Fatal("foo")
+
+	test.AssetEqual(false, sv.IsOK(), `The StatusValue is shared`)
+	test.AssetTrue(status.GetStatusValue() == sv, `getStatusValue`)
+	test.AssetEqual(5, status.GetValue(), `Value`)
+}
+
+/**
+ * @covers Status::getErrorsArray
+ * @covers Status::getWarningsArray
+ */
+func TestGetErrorsArray(t *testing.T) {
+	status := NewFatalStatus("foo", "bar")
+	status.Warning("baz")
+	status.Error(NewMessage("percent", []interface{}{5}, nil))
+
+	test.AssetEqual("[[foo bar] [percent 5]]", fmt.Sprint(status.GetErrorsArray()), `Errors`)
+	test.AssetEqual("[[baz]]", fmt.Sprint(status.GetWarningsArray()), `Warnings`)
+}
+
+/**
+ * @covers Status::getWikiText
+ * @covers Status::getErrorMessage
+ */
+func TestStatusGetWikiText(t *testing.T) {
+	defer useCoreMessages()()
+	en := languages.Factory("en")
+
+	status := NewFatalStatus("percent", "<b>")
+	test.AssetEqual("&#60;b&#62;%", status.GetWikiText("", "", en), `Parameters are escaped`)
+	test.AssetEqual("(&#60;b&#62;%)", status.GetWikiText("parentheses", "brackets", en), `Short context`)
+	test.AssetEqual("[* &#60;b&#62;%\n]", status.GetWikiText("", "brackets", en), `Long context`)
+
+	status.Warning(NewRawMessage("<i>raw</i>", nil))
+	test.AssetEqual("* &#60;b&#62;%\n* <i>raw</i>\n", status.GetWikiText("", "", en), `List`)
+	test.AssetEqual("[* &#60;b&#62;%\n* <i>raw</i>\n]", status.GetWikiText("parentheses", "brackets", en), `Long context of a list`)
+	test.AssetEqual("(\n* &#60;b&#62;%\n* <i>raw</i>\n\n)", status.GetWikiText("parentheses", "", en), `Short context of a list`)
+
+	test.AssetEqual(
+		"Internal error: Status::GetWikiText called for a good result, this is incorrect\n",
+		NewGoodStatus(nil).GetWikiText("", "", en),
+		`Good status`,
+	)
+
+	status = NewFatalStatus("percent", "x")
+	status.CleanCallback = func(param interface{}) interface{} {
+		return "y"
+	}
+	test.AssetEqual("y%", status.GetWikiText("", "", en), `cleanCallback`)
+}
+
+/**
+ * @covers Status::getMessage
+ * @covers Status::getHTML
+ */
+func TestStatusGetMessage(t *testing.T) {
+	defer useCoreMessages()()
+	en := languages.Factory("en")
+
+	status := NewFatalStatus("percent", 5)
+	test.AssetEqual("5%", status.GetMessage("", "", en).Text(), `Single error`)
+	test.AssetEqual("(5%)", status.GetMessage("parentheses", "brackets", en).Text(), `Short context`)
+	test.AssetEqual("[* 5%\n]", status.GetMessage("", "brackets", en).Text(), `Long context`)
+
+	status.Warning("ellipsis")
+	test.AssetEqual("* 5%\n* ...", status.GetMessage("", "", en).Text(), `List`)
+	test.AssetEqual("(\n* 5%\n* ...\n)", status.GetMessage("parentheses", "", en).Text(), `Short context of a list`)
+	test.AssetEqual("1.234%", NewFatalStatus("percent", NumParam(1234)).GetMessage("", "", languages.Factory("de")).Text(), `In German`)
+	test.AssetEqual("* 5%\n* ...\n", status.GetHTML("", "", en), `HTML`)
+}
+
+/**
+ * @covers ApiErrorFormatter::arrayFromStatus
+ */
+func TestStatusGetApiArray(t *testing.T) {
+	defer useCoreMessages()()
+
+	status := NewFatalStatus("percent", 5)
+	status.Warning("ellipsis")
+	test.AssetEqual(
+		"[map[code:percent params:[5] text:5% type:error]]",
+		fmt.Sprint(status.GetApiArray("error", languages.Factory("en"))),
+		`Errors`,
+	)
+	test.AssetEqual(
+		"[map[code:ellipsis params:[] text:... type:warning]]",
+		fmt.Sprint(status.GetApiArray("warning", languages.Factory("en"))),
+		`Warnings`,
+	)
+	test.AssetEqual(0, len(NewGoodStatus(nil).GetApiArray("error", nil)), `Good status`)
+}
@@ -6,6 +6,7 @@ package includes
 import (
 	"fmt"
 	"github.com/MangoDowner/mediawiki/includes/consts"
+	"github.com/MangoDowner/mediawiki/includes/languages"
 	"github.com/MangoDowner/mediawiki/includes/linker"
 	"github.com/MangoDowner/mediawiki/includes/php"
 	"github.com/MangoDowner/mediawiki/includes/title"
@@ -387,6 +388,60 @@ func (t *Title) CanExist() bool {
 	return t.MNamespace >= consts.NS_MAIN
 }
 
+/**
+ * Check if page exists. For historical reasons, this function simply
+ * checks for the existence of the title in the page table, and will
+ * thus return false for interwiki links, special pages and the like.
+ *
+ * Page IDs are not loaded from the database yet, so only the titles with a
+ * known ID exist, unless a TitleExists handler says otherwise.
+ *
+ * @return bool
+ */
+func (t *Title) Exists() bool {
+	exists := t.MArticleID > 0
+	NewHookRunner(GetHookContainer()).OnTitleExists(t, &exists)
+	return exists
+}
+
+/**
+ * Get the language in which the content of this page is written in
+ * wikitext. Defaults to content language.
+ *
+ * @since 1.22
+ * @return Language
+ */
+func (t *Title) GetPageLanguage() *languages.Language {
+	return getContentLanguage()
+}
+
+/**
+ * If the content language has variants, a page missing under this title
+ * may exist under the title converted to another variant. See
+ * LanguageConverter::findVariantLink().
+ *
+ * @return Title The title of the existing variant, or this title
+ */
+func (t *Title) FindVariantLink() *Title {
+	lang := t.GetPageLanguage()
+	if !lang.HasVariants() || t.IsExternal() || !t.CanExist() || t.Exists() {
+		return t
+	}
+	var found *Title
+	lang.FindVariantLink(t.GetText(), func(text string) bool {
+		nt := t.MakeTitle(t.MNamespace, text, t.MFragment, t.MInterwiki)
+		if !nt.Exists() {
+			return false
+		}
+		found = nt
+		return true
+	})
+	if found == nil {
+		return t
+	}
+	return found
+}
+
 /**
  * Get the namespace text
  *
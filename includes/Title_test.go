@@ -0,0 +1,80 @@
+package includes
+
+import (
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * Makes the pages of $titles exist
+ */
+type existingTitles struct {
+	titles map[string]bool
+}
+
+func (h *existingTitles) OnTitleExists(title *Title, exists *bool) bool {
+	if h.titles[title.GetPrefixedDBkey()] {
+		*exists = true
+	}
+	return true
+}
+
+/**
+ * Use a fresh HookContainer and content language in a test
+ */
+func setTitleTestLanguage(t *testing.T, code string, existing ...string) {
+	oldGlobals := globals.GLOBALS
+	globals.GLOBALS = map[string]interface{}{"wgLanguageCode": code}
+	container := NewHookContainer(NewHooks())
+	titles := make(map[string]bool)
+	for _, title := range existing {
+		titles[title] = true
+	}
+	container.Register("TitleExists", &existingTitles{titles})
+	old := ForceGlobalHookContainer(container)
+	t.Cleanup(func() {
+		globals.GLOBALS = oldGlobals
+		ForceGlobalHookContainer(old)
+	})
+}
+
+/**
+ * @covers Title::exists
+ */
+func TestExists(t *testing.T) {
+	setTitleTestLanguage(t, "en", "Main_Page")
+	test.AssertTrue(t, NewTitle().MakeTitle(consts.NS_MAIN, "Main Page", "", "").Exists(), `TitleExists handler`)
+	test.AssertTrue(t, !NewTitle().MakeTitle(consts.NS_MAIN, "Missing", "", "").Exists(), `Unknown page ID`)
+
+	title := NewTitle().MakeTitle(consts.NS_MAIN, "Missing", "", "")
+	title.MArticleID = 1
+	test.AssertTrue(t, title.Exists(), `Known page ID`)
+}
+
+/**
+ * @covers Title::findVariantLink
+ */
+func TestTitleFindVariantLink(t *testing.T) {
+	setTitleTestLanguage(t, "zh", "軟體", "这个")
+
+	title := NewTitle().MakeTitle(consts.NS_MAIN, "软件", "frag", "")
+	found := title.FindVariantLink()
+	test.AssertEqual(t, "軟體", found.GetPrefixedDBkey(), `Existing variant of the title`)
+	test.AssertEqual(t, "frag", found.GetFragment(), `The fragment is kept`)
+
+	title = NewTitle().MakeTitle(consts.NS_MAIN, "这个", "", "")
+	test.AssertTrue(t, title.FindVariantLink() == title, `The title exists`)
+
+	title = NewTitle().MakeTitle(consts.NS_MAIN, "不存在", "", "")
+	test.AssertTrue(t, title.FindVariantLink() == title, `No variant exists`)
+
+	title = NewTitle().MakeTitle(consts.NS_SPECIAL, "软件", "", "")
+	test.AssertTrue(t, title.FindVariantLink() == title, `Special pages cannot exist`)
+
+	setTitleTestLanguage(t, "en", "軟體")
+	title = NewTitle().MakeTitle(consts.NS_MAIN, "软件", "", "")
+	test.AssertTrue(t, title.FindVariantLink() == title, `No variants`)
+}
@@ -0,0 +1,82 @@
+package includes
+
+import (
+	"github.com/MangoDowner/mediawiki/includes/libs/rdbms/database"
+)
+
+/**
+ * Reads the preferences of the users in the user_properties table.
+ *
+ * Only the preferences which differ from $wgDefaultUserOptions are stored,
+ * the callers fall back to the defaults for the others.
+ *
+ * @since 1.35
+ */
+type UserOptionsLookup struct {
+	/** @var IDatabase */
+	db database.IDatabase
+}
+
+/**
+ * @param IDatabase $db The main wiki database
+ */
+func NewUserOptionsLookup(db database.IDatabase) *UserOptionsLookup {
+	this := new(UserOptionsLookup)
+	this.db = db
+	return this
+}
+
+/**
+ * Create the user and user_properties tables if they do not exist yet
+ *
+ * @throws DBError
+ */
+func (u *UserOptionsLookup) CreateTables() error {
+	err := u.db.Query("CREATE TABLE IF NOT EXISTS user ("+
+		"user_id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL, "+
+		"user_name BLOB NOT NULL default '' UNIQUE)", "UserOptionsLookup::createTables")
+	if err != nil {
+		return err
+	}
+	return u.db.Query("CREATE TABLE IF NOT EXISTS user_properties ("+
+		"up_user INTEGER NOT NULL, "+
+		"up_property BLOB NOT NULL, "+
+		"up_value BLOB default NULL, "+
+		"PRIMARY KEY (up_user, up_property))", "UserOptionsLookup::createTables")
+}
+
+/**
+ * Get the value of a preference of several users at once
+ *
+ * @param string[] $usernames
+ * @param string $option Name of the preference, e.g. "gender"
+ * @return array Map of (user name => value); the users who kept the
+ *  default value, or do not exist, are left out
+ * @throws DBError
+ */
+func (u *UserOptionsLookup) GetOptionOfUsers(usernames []string, option string) (map[string]string, error) {
+	options := make(map[string]string)
+	if len(usernames) == 0 {
+		return options, nil
+	}
+	users, err := u.db.Select("user", []string{"user_id", "user_name"},
+		map[string]interface{}{"user_name": usernames}, "UserOptionsLookup::getOptionOfUsers", nil)
+	if err != nil || len(users) == 0 {
+		return options, err
+	}
+	names := make(map[string]string, len(users))
+	ids := make([]string, 0, len(users))
+	for _, row := range users {
+		names[row["user_id"]] = row["user_name"]
+		ids = append(ids, row["user_id"])
+	}
+	rows, err := u.db.Select("user_properties", []string{"up_user", "up_value"},
+		map[string]interface{}{"up_user": ids, "up_property": option}, "UserOptionsLookup::getOptionOfUsers", nil)
+	if err != nil {
+		return options, err
+	}
+	for _, row := range rows {
+		options[names[row["up_user"]]] = row["up_value"]
+	}
+	return options, nil
+}
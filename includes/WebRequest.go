@@ -155,9 +155,12 @@ func (w *WebRequest) GetRawVal(name string, defaultVal interface{}) (result inte
 func (w *WebRequest) GetVal(name string, defaultVal string) (result string) {
 	val := w.GetGPCVal(w.data, name, defaultVal)
 	if php.IsArray(val) {
-		result = defaultVal
+		return defaultVal
 	}
-	return result
+	if str, ok := val.(string); ok {
+		return str
+	}
+	return defaultVal
 }
 
 /**
@@ -229,5 +232,15 @@ func (w *WebRequest) WasPosted() bool {
 	return w.GetMethod() == "POST"
 }
 
-
-
+/**
+ * Get a request header, or "" if it isn't set.
+ *
+ * @param string $name Case-insensitive header name
+ * @return string
+ */
+func (w *WebRequest) GetHeader(name string) string {
+	if w.context == nil || w.context.Input == nil {
+		return ""
+	}
+	return w.context.Input.Header(name)
+}
@@ -41,7 +41,8 @@ func (a *Action) GetActionName(c beego.Controller) (actionName string) {
 	actionName = c.GetString("action", "view")
 
 	// Check for disabled actions
-	if _, ok := includes.WgActions[actionName]; ok && includes.WgActions[actionName] == "" {
+	actions, _ := includes.NewMainConfig().Get("Actions").(map[string]interface{})
+	if enabled, ok := actions[actionName]; ok && enabled == false {
 		actionName = "nosuchaction"
 	}
 
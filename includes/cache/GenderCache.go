@@ -0,0 +1,198 @@
+/**
+ * Caches user genders when needed to use correct namespace aliases.
+ */
+package cache
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/MangoDowner/mediawiki/globals"
+)
+
+var (
+	genderCacheIns      *GenderCache
+	genderCacheInsMutex sync.Mutex
+
+	/**
+	 * Reads the "gender" preference of the users for the singleton, user
+	 * name => gender. The UserOptionsLookup service sets it once the services
+	 * are wired.
+	 * @var callable|null
+	 */
+	FetchUserGenders func(usernames []string) map[string]string
+)
+
+/**
+ * Caches user genders when needed to use correct namespace aliases.
+ *
+ * @author Niklas Laxström
+ * @since 1.18
+ */
+type GenderCache struct {
+	cache         map[string]string
+	defaultGender string
+	misses        int
+	missLimit     int
+
+	/**
+	 * @var callable Fetches the "gender" preference of the given users,
+	 *   user name => gender; users without the preference may be left out
+	 */
+	lookup func(usernames []string) map[string]string
+
+	mutex sync.Mutex
+}
+
+/**
+ * @param string $defaultGender Gender of users who did not set one, $wgDefaultUserOptions['gender']
+ * @param callable|null $lookup Reads the gender preference of users from the user store
+ */
+func NewGenderCache(defaultGender string, lookup func(usernames []string) map[string]string) *GenderCache {
+	this := new(GenderCache)
+	this.cache = make(map[string]string)
+	this.defaultGender = defaultGender
+	this.missLimit = 1000
+	this.lookup = lookup
+	return this
+}
+
+/**
+ * Get the signleton instance of this class
+ *
+ * @return GenderCache
+ */
+func SingletonGenderCache() *GenderCache {
+	genderCacheInsMutex.Lock()
+	defer genderCacheInsMutex.Unlock()
+	if genderCacheIns == nil {
+		defaultGender := "unknown"
+		if options, ok := globals.GLOBALS["wgDefaultUserOptions"].(map[string]interface{}); ok {
+			if gender, ok := options["gender"].(string); ok && gender != "" {
+				defaultGender = gender
+			}
+		}
+		genderCacheIns = NewGenderCache(defaultGender, func(usernames []string) map[string]string {
+			if FetchUserGenders == nil {
+				return nil
+			}
+			return FetchUserGenders(usernames)
+		})
+	}
+	return genderCacheIns
+}
+
+/**
+ * Destroy the singleton instance
+ */
+func DestroyGenderCacheInstance() {
+	genderCacheInsMutex.Lock()
+	defer genderCacheInsMutex.Unlock()
+	genderCacheIns = nil
+}
+
+/**
+ * Returns the default gender option in this wiki.
+ * @return string
+ */
+func (g *GenderCache) GetDefault() string {
+	return g.defaultGender
+}
+
+/**
+ * Returns the gender for given username.
+ * @param string $username
+ * @return string
+ */
+func (g *GenderCache) GetGenderOf(username string) string {
+	username = g.normalizeUsername(username)
+	if username == "" {
+		return g.defaultGender
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if gender, ok := g.cache[username]; ok {
+		return gender
+	}
+	if g.misses >= g.missLimit {
+		return g.defaultGender
+	}
+	g.misses++
+	g.doQuery([]string{username})
+	return g.cache[username]
+}
+
+/**
+ * Start counting the misses of GetGenderOf() anew, at the end of a request.
+ *
+ * The limit keeps a single request from querying the users one by one; the
+ * genders already fetched stay cached.
+ */
+func (g *GenderCache) ResetMisses() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.misses = 0
+}
+
+/**
+ * Wrapper for doQuery that processes raw LinkBatch data.
+ *
+ * @param array $data
+ */
+func (g *GenderCache) DoLinkBatch(usernames []string) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.doQuery(usernames)
+}
+
+/**
+ * Preloads genders for given list of users.
+ * @param array|string $users Usernames
+ */
+func (g *GenderCache) doQuery(users []string) {
+	var usersToCheck []string
+	for _, value := range users {
+		name := g.normalizeUsername(value)
+		if name == "" {
+			continue
+		}
+		// Skip users whose gender setting we already know
+		if _, ok := g.cache[name]; ok {
+			continue
+		}
+		// For existing users, this value will be overwritten by the correct value
+		g.cache[name] = g.defaultGender
+		usersToCheck = append(usersToCheck, name)
+	}
+
+	if len(usersToCheck) == 0 || g.lookup == nil {
+		return
+	}
+	for name, gender := range g.lookup(usersToCheck) {
+		if gender != "" {
+			g.cache[g.normalizeUsername(name)] = gender
+		}
+	}
+}
+
+/**
+ * Normalizes a user name the way Title::makeTitleSafe( NS_USER, ... ) does,
+ * dropping a "User:" prefix if there is one.
+ *
+ * @param string $username
+ * @return string
+ */
+func (g *GenderCache) normalizeUsername(username string) string {
+	username = strings.TrimSpace(strings.Replace(username, "_", " ", -1))
+	if i := strings.Index(username, ":"); i >= 0 && strings.EqualFold(username[:i], "User") {
+		username = strings.TrimSpace(username[i+1:])
+	}
+	r, size := utf8.DecodeRuneInString(username)
+	if size == 0 {
+		return username
+	}
+	return string(unicode.ToUpper(r)) + username[size:]
+}
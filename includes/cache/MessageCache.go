@@ -3,7 +3,20 @@
  */
 package cache
 
-import "sync"
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes/cache/localisation"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	"github.com/MangoDowner/mediawiki/includes/languages"
+	"github.com/MangoDowner/mediawiki/includes/libs/objectcache"
+	mwobjectcache "github.com/MangoDowner/mediawiki/includes/objectcache"
+	"strconv"
+	"strings"
+	"sync"
+)
 
 /**
  * MediaWiki message cache structure version.
@@ -11,16 +24,98 @@ import "sync"
  */
 const MSG_CACHE_VERSION = 2
 
+/**
+ * How long to wait for memcached locks
+ */
+const MSG_WAIT_SEC = 15
+
+/**
+ * How long memcached locks last
+ */
+const MSG_LOCK_TTL = 30
+
 var (
-	ins  *MessageCache
-	once sync.Once
+	ins      *MessageCache
+	insMutex sync.Mutex
+
+	/**
+	 * Reads the pages of the MediaWiki namespace for the singleton, DB key =>
+	 * page text. The PageStore service sets it once the services are wired.
+	 * @var callable|null
+	 */
+	FetchMessagePages func() map[string]string
+
+	/**
+	 * Whitespace entities which are stored in messages because textareas
+	 * would lose them, and the characters they are replaced with
+	 */
+	messageWhitespaceReplacer = strings.NewReplacer(
+		// Fix for trailing whitespace, removed by textarea
+		"&#32;", " ",
+		// Fix for NBSP, converted to space by firefox
+		"&nbsp;", "\u00A0",
+		"&#160;", "\u00A0",
+		"&shy;", "\u00AD",
+	)
 )
 
+/**
+ * Cache of messages that are defined by MediaWiki namespace pages or by hooks
+ *
+ * @ingroup Cache
+ */
 type MessageCache struct {
+	/**
+	 * Process cache of loaded messages that are defined in MediaWiki namespace
+	 *
+	 * @var array Map of (language code => key => " <MESSAGE>" or "!NONEXISTENT")
+	 */
+	mCache map[string]map[string]string
+
+	/**
+	 * @var bool[] Map of (language code => boolean)
+	 */
+	mCacheLoaded map[string]bool
+
+	/**
+	 * Should mean that database cannot be used, but check
+	 * @var bool $mDisable
+	 */
+	mDisable bool
+
+	/**
+	 * Lifetime for cache, used by object caching.
+	 * Set on construction, see __construct().
+	 */
+	mExpiry int
+
+	/** @var BagOStuff */
+	clusterCache objectcache.IBagOStuff
+
+	/**
+	 * @var callable Reads the pages of the MediaWiki namespace,
+	 *   DB key => page text
+	 */
+	fetchPages func() map[string]string
+
+	mutex sync.Mutex
 }
 
-func NewMessageCache() *MessageCache {
+/**
+ * @param BagOStuff $clusterCache
+ * @param bool $useDB Whether to look for message overrides (e.g. MediaWiki: pages)
+ * @param int $expiry Lifetime for cache. @see $mExpiry.
+ * @param callable|null $fetchPages Reads the MediaWiki namespace pages from the page table
+ */
+func NewMessageCache(clusterCache objectcache.IBagOStuff, useDB bool, expiry int,
+	fetchPages func() map[string]string) *MessageCache {
 	this := new(MessageCache)
+	this.mCache = make(map[string]map[string]string)
+	this.mCacheLoaded = make(map[string]bool)
+	this.clusterCache = clusterCache
+	this.mDisable = !useDB
+	this.mExpiry = expiry
+	this.fetchPages = fetchPages
 	return this
 }
 
@@ -31,8 +126,535 @@ func NewMessageCache() *MessageCache {
  * @return MessageCache
  */
 func SingletonMessageCache() *MessageCache {
-	once.Do(func() {
-		ins = new(MessageCache)
-	})
+	insMutex.Lock()
+	defer insMutex.Unlock()
+	if ins == nil {
+		useDB := true
+		if value, ok := globals.GLOBALS["wgUseDatabaseMessages"].(bool); ok {
+			useDB = value
+		}
+		expiry := 86400
+		if value, ok := globals.GLOBALS["wgMsgCacheExpiry"].(int); ok {
+			expiry = value
+		}
+		cacheType := consts.CACHE_ANYTHING
+		if value, ok := globals.GLOBALS["wgMessageCacheType"].(string); ok {
+			cacheType = value
+		}
+		ins = NewMessageCache(mwobjectcache.GetInstance(cacheType), useDB, expiry, func() map[string]string {
+			if FetchMessagePages == nil {
+				return nil
+			}
+			return FetchMessagePages()
+		})
+	}
 	return ins
 }
+
+/**
+ * Destroy the singleton instance
+ *
+ * @since 1.18
+ */
+func DestroyMessageCacheInstance() {
+	insMutex.Lock()
+	defer insMutex.Unlock()
+	ins = nil
+}
+
+/**
+ * Normalize message key input
+ *
+ * @param string $key Input message key to be normalized
+ * @return string Normalized message key
+ */
+func NormalizeKey(key string) string {
+	lckey := strings.Replace(key, " ", "_", -1)
+	if lckey == "" {
+		return lckey
+	}
+	if lckey[0] < 128 {
+		return strings.ToLower(lckey[:1]) + lckey[1:]
+	}
+	return getContentLanguage().Lcfirst(lckey)
+}
+
+/**
+ * Loads messages from caches or from database in this order:
+ * (1) local message cache (if $wgUseLocalMessageCache is enabled)
+ * (2) memcached
+ * (3) from the database.
+ *
+ * When successfully loading from (2) or (3), all higher level caches are
+ * updated for the newest version.
+ *
+ * Nothing is loaded if member variable mDisable is true, either manually
+ * set by calling code or if message loading fails (is this possible?).
+ *
+ * @param string $code Language to which load messages
+ * @return bool
+ */
+func (m *MessageCache) load(code string) bool {
+	// Don't do double loading...
+	if m.mCacheLoaded[code] {
+		return true
+	}
+
+	// Messages cache disabled
+	if m.mDisable {
+		return true
+	}
+
+	cacheKey := m.clusterCache.MakeKey("messages", code)
+
+	// Try the shared cache, validated against the version key
+	if cache, ok := m.getValidatedCache(code, cacheKey); ok {
+		m.mCache[code] = cache
+		m.mCacheLoaded[code] = true
+		return true
+	}
+
+	// Allow one caller at a time to rebuild the cache, the others wait for it
+	if !m.clusterCache.Lock(cacheKey, MSG_WAIT_SEC, MSG_LOCK_TTL, "") {
+		// Could not acquire the lock in time; use the database without
+		// saving anything, so the lock holder can finish its rebuild
+		m.mCache[code] = m.loadFromDB(code)
+		m.mCacheLoaded[code] = true
+		return false
+	}
+	defer m.clusterCache.Unlock(cacheKey)
+
+	// Someone else may have rebuilt the cache while we waited for the lock
+	if cache, ok := m.getValidatedCache(code, cacheKey); ok {
+		m.mCache[code] = cache
+		m.mCacheLoaded[code] = true
+		return true
+	}
+
+	cache := m.loadFromDB(code)
+	m.saveToCaches(cache, code)
+	m.mCache[code] = cache
+	m.mCacheLoaded[code] = true
+	return true
+}
+
+/**
+ * Get the messages of a language from the shared cache, if their hash
+ * matches the one in the version key
+ *
+ * @param string $code
+ * @param string $cacheKey
+ * @return array|bool The messages, or false if the cache is missing or outdated
+ */
+func (m *MessageCache) getValidatedCache(code, cacheKey string) (map[string]string, bool) {
+	value, ok := m.clusterCache.Get(cacheKey, 0)
+	if !ok {
+		return nil, false
+	}
+	cache, ok := value.(map[string]string)
+	if !ok || cache["VERSION"] != strconv.Itoa(MSG_CACHE_VERSION) {
+		return nil, false
+	}
+	hash, ok := m.clusterCache.Get(m.getValidationKey(code), 0)
+	if !ok || hash != cache["HASH"] {
+		return nil, false
+	}
+	return copyMessages(cache), true
+}
+
+/**
+ * Loads cacheable messages from the database. Messages bigger than
+ * $wgMaxMsgCacheEntrySize are assigned a special value, and are loaded
+ * on-demand from the database later.
+ *
+ * @param string $code Language code
+ * @return array Loaded messages for storing in caches
+ */
+func (m *MessageCache) loadFromDB(code string) map[string]string {
+	contentCode := getContentLanguage().GetCode()
+	cache := make(map[string]string)
+
+	if m.fetchPages != nil {
+		for title, text := range m.fetchPages() {
+			if code == contentCode {
+				// Messages in content language
+				if !strings.Contains(title, "/") {
+					cache[title] = " " + text
+				}
+			} else if strings.HasSuffix(title, "/"+code) {
+				// Messages in all other languages
+				cache[title] = " " + text
+			}
+		}
+	}
+
+	cache["VERSION"] = strconv.Itoa(MSG_CACHE_VERSION)
+	return cache
+}
+
+/**
+ * Updates cache as necessary when message page is changed
+ *
+ * Called when a page of the MediaWiki namespace is saved or deleted.
+ *
+ * @param string $title Message cache key with initial uppercase letter
+ * @param string|bool $text New contents of the page (false if deleted)
+ */
+func (m *MessageCache) Replace(title string, text string, exists bool) {
+	if m.mDisable {
+		return
+	}
+
+	_, code := m.figureMessage(title)
+	if strings.Contains(title, "/") && code == getContentLanguage().GetCode() {
+		// Content language overrides do not use the /<code> suffix
+		return
+	}
+
+	// Allow one caller at a time to avoid race conditions. The lock is
+	// waited for without holding the mutex, which would block the readers.
+	cacheKey := m.clusterCache.MakeKey("messages", code)
+	locked := m.clusterCache.Lock(cacheKey, MSG_WAIT_SEC, MSG_LOCK_TTL, "")
+	m.mutex.Lock()
+	if !locked {
+		// Update the process cache only; the shared caches are rebuilt
+		// from the database once they expire
+		if !m.mCacheLoaded[code] {
+			cache, ok := m.getValidatedCache(code, cacheKey)
+			if !ok {
+				cache = m.loadFromDB(code)
+			}
+			m.mCache[code] = cache
+			m.mCacheLoaded[code] = true
+		}
+		if exists {
+			m.mCache[code][title] = " " + text
+		} else {
+			m.mCache[code][title] = "!NONEXISTENT"
+		}
+		m.mutex.Unlock()
+		return
+	}
+	defer m.clusterCache.Unlock(cacheKey)
+
+	// Start from the newest messages of the shared cache, or the database
+	cache, ok := m.getValidatedCache(code, cacheKey)
+	if !ok {
+		cache = m.loadFromDB(code)
+	}
+	if exists {
+		cache[title] = " " + text
+	} else {
+		delete(cache, title)
+	}
+
+	// Update caches if the lock was acquired
+	m.saveToCaches(cache, code)
+	m.mCache[code] = cache
+	m.mCacheLoaded[code] = true
+	m.mutex.Unlock()
+
+	if languages.HookRunner != nil {
+		languages.HookRunner.Run("MessageCacheReplace", []interface{}{title, text}, "")
+	}
+}
+
+/**
+ * Shortcut to update caches.
+ *
+ * @param array $cache Cached messages with a version.
+ * @param string $code Language code
+ */
+func (m *MessageCache) saveToCaches(cache map[string]string, code string) {
+	delete(cache, "HASH")
+	serialized, _ := json.Marshal(cache)
+	sum := md5.Sum(serialized)
+	cache["HASH"] = hex.EncodeToString(sum[:])
+
+	m.clusterCache.Set(m.clusterCache.MakeKey("messages", code), copyMessages(cache), m.mExpiry, 0)
+	m.clusterCache.Set(m.getValidationKey(code), cache["HASH"], m.mExpiry, 0)
+}
+
+/**
+ * @param string $code
+ * @return string Key of the version of the messages in the shared cache
+ */
+func (m *MessageCache) getValidationKey(code string) string {
+	return m.clusterCache.MakeKey("messages", code, "hash", "v1")
+}
+
+/**
+ * Get a message from either the content language or the user language.
+ *
+ * First, assemble a list of languages to attempt getting the message from. This
+ * chain begins with the requested language and its fallbacks and then continues with
+ * the content language and its fallbacks. For each language in the chain, the following
+ * process will occur (in this order):
+ *  1. If a language-specific override, i.e., [[MW:msg/lang]], is available, use that.
+ *     Note: for the content language, there is no /lang subpage.
+ *  2. Fetch from the static CDB cache.
+ *  3. If available, check the database for fallback language overrides.
+ *
+ * This process provides a number of guarantees. When changing this code, make sure all
+ * of these guarantees are preserved.
+ *  * If the requested language is *not* the content language, then the CDB cache for that
+ *    specific language will take precedence over the root database page ([[MW:msg]]).
+ *  * Fallbacks will be just that: fallbacks. A fallback language will never be reached if
+ *    the message is available *anywhere* in the language for which it is a fallback.
+ *
+ * @param string $key The message key
+ * @param bool $useDB If true, look for the message in the DB, false
+ *   to use only the compiled l10n cache.
+ * @param Language|null $lang Language to get the message for, null for the
+ *   content language
+ *
+ * @return string|bool False if the message doesn't exist, otherwise the
+ *   message (which can be empty)
+ */
+func (m *MessageCache) Get(key string, useDB bool, lang *languages.Language) (string, bool) {
+	if key == "" {
+		return "", false
+	}
+
+	// Normalise title-case input (with some inlining)
+	lckey := NormalizeKey(key)
+
+	// Loop through each language in the fallback list until we find something useful
+	if lang == nil {
+		lang = getContentLanguage()
+	}
+	message, ok := m.getMessageFromFallbackChain(lang, lckey, !m.mDisable && useDB)
+
+	// If we still have no message, maybe the key was in fact a full key so try that
+	if !ok {
+		parts := strings.Split(lckey, "/")
+		// We may get calls for things that are http-urls from sidebar
+		// Let's not load nonexistent languages for those
+		// They usually have more than one slash.
+		if len(parts) == 2 && parts[1] != "" {
+			message, ok = localisation.SingletonLocalisationCache().GetSubitem(parts[1], "messages", parts[0])
+		}
+	}
+
+	// Post-processing if the message exists
+	if ok {
+		// Fix whitespace
+		message = messageWhitespaceReplacer.Replace(message)
+	}
+	return message, ok
+}
+
+/**
+ * Given a language, try and fetch messages from that language.
+ *
+ * Will also consider fallbacks of that language, the site language, and fallbacks for
+ * the site language.
+ *
+ * @see MessageCache::get
+ * @param Language|StubObject $lang Preferred language
+ * @param string $lckey Lowercase key for the message (as for localisation cache)
+ * @param bool $useDB Whether to include messages from the wiki database
+ * @return string|bool The message, or false if not found
+ */
+func (m *MessageCache) getMessageFromFallbackChain(lang *languages.Language, lckey string, useDB bool) (string, bool) {
+	alreadyTried := make(map[string]bool)
+
+	// First try the requested language.
+	if message, ok := m.getMessageForLang(lang, lckey, useDB, alreadyTried); ok {
+		return message, ok
+	}
+
+	// Now try checking the site language.
+	return m.getMessageForLang(getContentLanguage(), lckey, useDB, alreadyTried)
+}
+
+/**
+ * Given a language, try and fetch messages from that language and its fallbacks.
+ *
+ * @see MessageCache::get
+ * @param Language|StubObject $lang Preferred language
+ * @param string $lckey Lowercase key for the message (as for localisation cache)
+ * @param bool $useDB Whether to include messages from the wiki database
+ * @param bool[] $alreadyTried Contains true for each language that has been tried already
+ * @return string|bool The message, or false if not found
+ */
+func (m *MessageCache) getMessageForLang(lang *languages.Language, lckey string, useDB bool,
+	alreadyTried map[string]bool) (string, bool) {
+	langcode := lang.GetCode()
+	l10n := localisation.SingletonLocalisationCache()
+
+	// Try checking the database for the requested language
+	var uckey string
+	if useDB {
+		uckey = getContentLanguage().Ucfirst(lckey)
+		if !alreadyTried[langcode] {
+			message, ok := m.getMsgFromNamespace(m.getMessagePageName(langcode, uckey), langcode)
+			if ok {
+				return message, ok
+			}
+			alreadyTried[langcode] = true
+		}
+	}
+
+	// Check the CDB cache
+	if message, ok := l10n.GetSubitem(langcode, "messages", lckey); ok {
+		return message, ok
+	}
+
+	// Try checking the database for all of the fallback languages
+	if useDB {
+		for _, code := range l10n.GetFallbackSequence(langcode) {
+			if alreadyTried[code] {
+				continue
+			}
+			message, ok := m.getMsgFromNamespace(m.getMessagePageName(code, uckey), code)
+			if ok {
+				return message, ok
+			}
+			alreadyTried[code] = true
+		}
+	}
+
+	return "", false
+}
+
+/**
+ * Get the message page name for a given language
+ *
+ * @param string $langcode
+ * @param string $uckey Uppercase key for the message
+ * @return string The page name
+ */
+func (m *MessageCache) getMessagePageName(langcode, uckey string) string {
+	if langcode == getContentLanguage().GetCode() {
+		// Messages created in the content language will not have the /lang extension
+		return uckey
+	}
+	return uckey + "/" + langcode
+}
+
+/**
+ * Get a message from the MediaWiki namespace, with caching. The key must
+ * first be converted to two-part lang/msg form if necessary.
+ *
+ * Unlike self::get(), this function doesn't resolve fallback chains, and
+ * some callers require this behavior. LanguageConverter::parseCachedTable()
+ * and self::get() are some examples in core.
+ *
+ * @param string $title Message cache key with initial uppercase letter
+ * @param string $code Code denoting the language to try
+ * @return string|bool The message, or false if it does not exist or on error
+ */
+func (m *MessageCache) getMsgFromNamespace(title, code string) (string, bool) {
+	m.mutex.Lock()
+	m.load(code)
+	entry, ok := m.mCache[code][title]
+	m.mutex.Unlock()
+
+	if ok {
+		if strings.HasPrefix(entry, " ") {
+			// The message exists, so make sure a string is returned.
+			return entry[1:], true
+		}
+		// "!NONEXISTENT"
+		return "", false
+	}
+
+	// Messages which are not on-wiki may be provided by extensions
+	message, found := "", false
+	if languages.HookRunner != nil {
+		var preloaded interface{} = false
+		languages.HookRunner.Run("MessagesPreLoad", []interface{}{title, &preloaded, code}, "")
+		message, found = preloaded.(string)
+	}
+	return message, found
+}
+
+/**
+ * @param string $key
+ * @return array
+ */
+func (m *MessageCache) figureMessage(key string) (string, string) {
+	contentCode := getContentLanguage().GetCode()
+	pieces := strings.Split(key, "/")
+	if len(pieces) < 2 {
+		return key, contentCode
+	}
+
+	lang := pieces[len(pieces)-1]
+	if languages.NewLanguage().FetchLanguageName(lang, languages.AS_AUTONYMS, "mw") == "" {
+		return key, contentCode
+	}
+
+	message := strings.Join(pieces[:len(pieces)-1], "/")
+	return message, lang
+}
+
+/**
+ * Disable the message cache, so that only the localisation files are used
+ */
+func (m *MessageCache) Disable() {
+	m.mDisable = true
+}
+
+/**
+ * Enable the message cache again
+ */
+func (m *MessageCache) Enable() {
+	m.mDisable = false
+}
+
+/**
+ * Whether DB/cache usage is disabled for determining messages
+ *
+ * If so, this typically indicates either:
+ *   - a) load() failed to find a cached copy nor query the DB
+ *   - b) we are in a special context or error mode that cannot use the DB
+ * If the DB is ignored, any derived HTML output or cached objects may be wrong.
+ * To avoid long-term cache pollution, TTLs can be adjusted accordingly.
+ *
+ * @return bool
+ * @since 1.27
+ */
+func (m *MessageCache) IsDisabled() bool {
+	return m.mDisable
+}
+
+/**
+ * Clear all stored messages in global and local cache
+ *
+ * Mainly used after a mass rebuild
+ */
+func (m *MessageCache) Clear() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for code := range m.mCacheLoaded {
+		m.clusterCache.Delete(m.getValidationKey(code), 0)
+	}
+	m.mCache = make(map[string]map[string]string)
+	m.mCacheLoaded = make(map[string]bool)
+}
+
+/**
+ * Get the content language, $wgContLang, from $wgLanguageCode
+ *
+ * @return Language
+ */
+func getContentLanguage() *languages.Language {
+	if code, ok := globals.GLOBALS["wgLanguageCode"].(string); ok && code != "" {
+		return languages.Factory(code)
+	}
+	return languages.Factory("en")
+}
+
+/**
+ * @param array $cache
+ * @return array A copy of $cache, which the caller may change
+ */
+func copyMessages(cache map[string]string) map[string]string {
+	messages := make(map[string]string, len(cache))
+	for key, value := range cache {
+		messages[key] = value
+	}
+	return messages
+}
@@ -0,0 +1,127 @@
+package cache
+
+import (
+	"github.com/MangoDowner/mediawiki/includes/cache/localisation"
+	"github.com/MangoDowner/mediawiki/includes/languages"
+	"github.com/MangoDowner/mediawiki/includes/libs/objectcache"
+	test "github.com/MangoDowner/mediawiki/tests"
+	"testing"
+)
+
+type dummyHooks struct {
+	preloaded map[string]string
+}
+
+func (d *dummyHooks) Run(event string, args []interface{}, deprecatedVersion string) bool {
+	if event == "MessagesPreLoad" {
+		if text, ok := d.preloaded[args[0].(string)]; ok {
+			*args[1].(*interface{}) = text
+		}
+	}
+	return true
+}
+
+/**
+ * Use the core messages and a fake MediaWiki namespace for the test
+ */
+func setUpMessageCache(bag objectcache.IBagOStuff, pages map[string]string) *MessageCache {
+	localisation.MessagesDirs = map[string][]string{"core": {"../../languages/i18n"}}
+	localisation.SingletonLocalisationCache().UnloadAll()
+	return NewMessageCache(bag, true, 3600, func() map[string]string {
+		return pages
+	})
+}
+
+/**
+ * @covers MessageCache::get
+ * @covers MessageCache::getMessageFromFallbackChain
+ */
+func TestMessageFallbacks(t *testing.T) {
+	pages := map[string]string{
+		"And":            "&#32;plus",
+		"And/de":         "&#32;sowie",
+		"Custom-message": "Custom",
+		"Sub/page":       "Not a message",
+	}
+	c := setUpMessageCache(objectcache.NewHashBagOStuff(nil), pages)
+	en := languages.Factory("en")
+	de := languages.Factory("de")
+
+	message, _ := c.Get("and", true, en)
+	test.AssetEqual(" plus", message, `Override in the content language`)
+	message, _ = c.Get("and", false, en)
+	test.AssetEqual(" and", message, `Localisation cache without the database`)
+	message, _ = c.Get("And", true, de)
+	test.AssetEqual(" sowie", message, `Override in a /de subpage`)
+	message, _ = c.Get("and", false, de)
+	test.AssetEqual(" und", message, `Localisation cache of the requested language`)
+	message, _ = c.Get("custom-message", true, de)
+	test.AssetEqual("Custom", message, `Falls back to the content language override`)
+	message, _ = c.Get("and/de", false, nil)
+	test.AssetEqual(" und", message, `Full key with a language code`)
+	_, ok := c.Get("sub/page", true, en)
+	test.AssetEqual(false, ok, `Subpages are not content language messages`)
+
+	c.Disable()
+	message, _ = c.Get("and", true, en)
+	test.AssetEqual(" and", message, `Overrides are ignored when disabled`)
+	c.Enable()
+}
+
+/**
+ * @covers MessageCache::replace
+ * @covers MessageCache::load
+ */
+func TestReplaceMessage(t *testing.T) {
+	bag := objectcache.NewHashBagOStuff(nil)
+	pages := map[string]string{"And": "&#32;plus"}
+	c := setUpMessageCache(bag, pages)
+	en := languages.Factory("en")
+	de := languages.Factory("de")
+
+	message, _ := c.Get("and", true, en)
+	test.AssetEqual(" plus", message, `Override before the edit`)
+
+	c.Replace("And", "&#32;also", true)
+	message, _ = c.Get("and", true, en)
+	test.AssetEqual(" also", message, `Saved override`)
+
+	other := NewMessageCache(bag, true, 3600, func() map[string]string {
+		return pages
+	})
+	message, _ = other.Get("and", true, en)
+	test.AssetEqual(" also", message, `Saved override is shared through the object cache`)
+
+	c.Replace("And/de", "&#32;sowie", true)
+	message, _ = other.Get("and", true, de)
+	test.AssetEqual(" sowie", message, `Saved /de subpage`)
+
+	c.Replace("And", "", false)
+	message, _ = c.Get("and", true, en)
+	test.AssetEqual(" and", message, `Deleted override`)
+	message, _ = NewMessageCache(bag, true, 3600, nil).Get("and", true, en)
+	test.AssetEqual(" and", message, `Deleted override is shared through the object cache`)
+}
+
+/**
+ * @covers MessageCache::getMsgFromNamespace
+ */
+func TestMessagesPreLoad(t *testing.T) {
+	c := setUpMessageCache(objectcache.NewHashBagOStuff(nil), map[string]string{"And": "&#32;plus"})
+	oldRunner := languages.HookRunner
+	languages.HookRunner = &dummyHooks{preloaded: map[string]string{
+		"Preloaded-message": "From a hook",
+		"And":               "Not used",
+	}}
+	defer func() {
+		languages.HookRunner = oldRunner
+	}()
+	en := languages.Factory("en")
+
+	message, _ := c.Get("preloaded-message", true, en)
+	test.AssetEqual("From a hook", message, `Message from the MessagesPreLoad hook`)
+	message, _ = c.Get("and", true, en)
+	test.AssetEqual(" plus", message, `Pages take precedence over the hook`)
+	_, ok := c.Get("preloaded-message", false, en)
+	test.AssetEqual(false, ok, `Hook is not run without the database`)
+}
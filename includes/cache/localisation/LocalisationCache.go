@@ -4,11 +4,54 @@
 package localisation
 
 import (
+	"encoding/json"
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes/exception"
 	"github.com/MangoDowner/mediawiki/includes/php"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 )
 
 const VERSION = 4
 
+var (
+	localisationCacheIns  *LocalisationCache
+	localisationCacheOnce sync.Once
+
+	/**
+	 * Directories of the JSON message files, $wgMessagesDirs. The core
+	 * messages live in $IP/languages/i18n.
+	 */
+	MessagesDirs = map[string][]string{
+		"core": {"languages/i18n"},
+	}
+
+	/**
+	 * $fallback of the Messages*.php files, for the languages which do not
+	 * simply fall back to their base language. English, the last resort, is
+	 * left out.
+	 */
+	fallbackLanguages = map[string][]string{
+		"zh":        {"zh-hans"},
+		"zh-cn":     {"zh-hans"},
+		"zh-sg":     {"zh-hans"},
+		"zh-my":     {"zh-hans"},
+		"zh-hant":   {"zh-hans"},
+		"zh-tw":     {"zh-hant", "zh-hans"},
+		"zh-hk":     {"zh-hant", "zh-hans"},
+		"zh-mo":     {"zh-hk", "zh-hant", "zh-hans"},
+		"sr":        {"sr-ec"},
+		"sr-el":     {"sr-ec"},
+		"de-at":     {"de"},
+		"de-ch":     {"de"},
+		"de-formal": {"de"},
+		"pt-br":     {"pt"},
+	}
+)
+
 /**
  * Class for caching the contents of localisation files, Messages*.php
  * and *.i18n.php.
@@ -152,10 +195,17 @@ type LocalisationCache struct {
 	pluralRuleTypes interface{}
 
 	mergeableKeys interface{}
+
+	mutex sync.Mutex
 }
 
 func NewLocalisationCache() *LocalisationCache {
 	this := new(LocalisationCache)
+	this.data = make(map[string]map[string]map[string]string)
+	this.loadedItems = make(map[string]map[string]string)
+	this.loadedSubitems = make(map[string]map[string]string)
+	this.initialisedLangs = make(map[string]bool)
+	this.shallowFallbacks = make(map[string]interface{})
 	this.AllKeys = []string{
 		"fallback", "namespaceNames", "bookstoreList",
 		"magicWords", "messages", "rtl", "capitalizeAllNouns", "digitTransformTable",
@@ -179,6 +229,18 @@ func NewLocalisationCache() *LocalisationCache {
 	return this
 }
 
+/**
+ * Get the signleton instance of this class
+ *
+ * @return LocalisationCache
+ */
+func SingletonLocalisationCache() *LocalisationCache {
+	localisationCacheOnce.Do(func() {
+		localisationCacheIns = NewLocalisationCache()
+	})
+	return localisationCacheIns
+}
+
 /**
  * Get a cache item.
  *
@@ -189,89 +251,127 @@ func NewLocalisationCache() *LocalisationCache {
  * @return mixed
  */
 func (l *LocalisationCache) GetItem(code, key string) interface{} {
-	value, ok := l.loadedItems[code]
-	if !ok  {
-		l.loadItem(code, key)
-	}
-	_, ok = value[key]
-	if !ok {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if _, ok := l.loadedItems[code][key]; !ok {
 		l.loadItem(code, key)
 	}
-	_, ok = l.shallowFallbacks[code]
-	if "fallback" == key && ok {
-		return l.shallowFallbacks[code]
+
+	if fallback, ok := l.shallowFallbacks[code]; ok && "fallback" == key {
+		return fallback
 	}
 
 	return l.data[code][key]
 }
 
 /**
- * Load an item into the cache.
+ * Get a subitem, for instance a single message for a given language.
  * @param string $code
  * @param string $key
+ * @param string $subkey
+ * @return mixed|null
  */
-func (l *LocalisationCache) loadItem(code, key string) {
-	if _, ok := l.initialisedLangs[code]; !ok {
-		l.initLanguage(code)
+func (l *LocalisationCache) GetSubitem(code, key, subkey string) (string, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	_, isLoaded := l.loadedItems[code][key]
+	_, isSubLoaded := l.loadedSubitems[code][key]
+	if !isLoaded && !isSubLoaded {
+		l.loadSubItem(code, key, subkey)
 	}
 
-	// Check to see if initLanguage() loaded it for us
-	if value, ok := l.loadedItems[code]; ok {
-		if _, ok = value[key]; ok {
-			return
-		}
-	}
+	value, ok := l.data[code][key][subkey]
+	return value, ok
+}
 
-	if value, ok := l.shallowFallbacks[code]; ok {
-		l.loadItem(value.(string), key)
-		return
+/**
+ * Get the fallback languages of a language, from the most to the least
+ * preferred one. English is always the last one, unless $code is English.
+ *
+ * @param string $code
+ * @return string[]
+ */
+func (l *LocalisationCache) GetFallbackSequence(code string) []string {
+	var sequence []string
+	if fallbacks, ok := fallbackLanguages[code]; ok {
+		sequence = append(sequence, fallbacks...)
+	} else if i := strings.LastIndex(code, "-"); i > 0 {
+		// Variants such as en-gb fall back to their base language
+		sequence = append(sequence, code[:i])
+		sequence = append(sequence, l.GetFallbackSequence(code[:i])...)
+		return sequence
 	}
+	if code != "en" && !php.InArray("en", sequence) {
+		sequence = append(sequence, "en")
+	}
+	return sequence
+}
 
-	if php.InArray(key, l.SplitKeys) {
-		// TODO
-		//subKeyList := l.getSubItem(code, "list", key)
-	} else {
+/**
+ * Unload the data for a given language from the object cache.
+ * Reduces memory usage.
+ * @param string $code
+ */
+func (l *LocalisationCache) Unload(code string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.unload(code)
+}
 
+/**
+ * @param string $code
+ */
+func (l *LocalisationCache) unload(code string) {
+	delete(l.data, code)
+	delete(l.loadedItems, code)
+	delete(l.loadedSubitems, code)
+	delete(l.initialisedLangs, code)
+	delete(l.shallowFallbacks, code)
+
+	for shallowCode, fbCode := range l.shallowFallbacks {
+		if fbCode == code {
+			l.unload(shallowCode)
+		}
 	}
+}
 
-	//TODO
+/**
+ * Unload all data
+ */
+func (l *LocalisationCache) UnloadAll() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	for code := range l.initialisedLangs {
+		l.unload(code)
+	}
 }
 
 /**
- * Get a subitem, for instance a single message for a given language.
+ * Load an item into the cache.
  * @param string $code
  * @param string $key
- * @param string $subkey
- * @return mixed|null
  */
-func (l *LocalisationCache) getSubitem(code, key, subkey string) interface{} {
-	isSet1 := true
-	value, ok := l.loadedSubitems[code]
-	if ok {
-		_, ok := value[key]
-		if !ok {
-			isSet1 = false
-		}
-	} else {
-		isSet1 = false
+func (l *LocalisationCache) loadItem(code, key string) {
+	if _, ok := l.initialisedLangs[code]; !ok {
+		l.initLanguage(code)
 	}
-	isSet2 := true
-	value1, ok := l.loadedItems[code]
-	if ok {
-		_, ok := value1[key]
-		if !ok {
-			isSet1 = false
-		}
-	} else {
-		isSet1 = false
+
+	// Check to see if initLanguage() loaded it for us
+	if _, ok := l.loadedItems[code][key]; ok {
+		return
 	}
 
-	if !isSet1 && !isSet2 {
-		l.loadSubItem(code, key, subkey)
+	if value, ok := l.shallowFallbacks[code]; ok {
+		l.loadItem(value.(string), key)
+		return
 	}
 
-	return l.data[code][key][subkey]
-	return nil
+	// Items other than the messages are not read from the Messages*.php
+	// files yet, so they are simply empty
+	if l.loadedItems[code] == nil {
+		l.loadedItems[code] = make(map[string]string)
+	}
+	l.loadedItems[code][key] = "1"
 }
 
 /**
@@ -289,9 +389,6 @@ func (l *LocalisationCache) loadSubItem(code, key, subKey string) {
 	if _, ok := l.initialisedLangs[code]; !ok {
 		l.initLanguage(code)
 	}
-
-	// TODO
-	return
 }
 
 /**
@@ -299,20 +396,20 @@ func (l *LocalisationCache) loadSubItem(code, key, subKey string) {
  * @param string $code
  * @throws MWException
  */
-func (l *LocalisationCache) initLanguage(code string) (err error) {
+func (l *LocalisationCache) initLanguage(code string) {
 	if _, ok := l.initialisedLangs[code]; ok {
 		return
 	}
 	l.initialisedLangs[code] = true
 
 	// If the code is of the wrong form for a Messages*.php file, do a shallow fallback
-	//if languages.NewLanguage().IsValidBuiltInCode(code) {
-	//	l.InitShallowFallback(code, "en")
-	//	return
-	//}
+	if !isValidBuiltInCode(code) {
+		l.initLanguage("en")
+		l.InitShallowFallback(code, "en")
+		return
+	}
 
-	// TODO
-	return nil
+	l.recache(code)
 }
 
 /**
@@ -326,4 +423,125 @@ func (l *LocalisationCache) InitShallowFallback(primaryCode, fallbackCode string
 	l.loadedItems[primaryCode] = l.loadedItems[fallbackCode]
 	l.loadedSubitems[primaryCode] = l.loadedSubitems[fallbackCode]
 	l.shallowFallbacks[primaryCode] = fallbackCode
-}
\ No newline at end of file
+}
+
+/**
+ * Load localisation data for a given language for both core and extensions
+ * and save it to the persistent cache store and the process cache
+ * @param string $code
+ * @throws MWException
+ */
+func (l *LocalisationCache) recache(code string) {
+	messages := make(map[string]string)
+	// Messages of the language itself win over the ones of its fallbacks
+	for _, csCode := range append([]string{code}, l.GetFallbackSequence(code)...) {
+		for key, value := range l.readSourceMessages(csCode) {
+			if _, ok := messages[key]; !ok {
+				messages[key] = value
+			}
+		}
+	}
+
+	l.data[code] = map[string]map[string]string{"messages": messages}
+	l.loadedItems[code] = map[string]string{"messages": "1"}
+}
+
+/**
+ * Read the messages of a language from all $wgMessagesDirs, core first.
+ * @param string $code
+ * @return array
+ */
+func (l *LocalisationCache) readSourceMessages(code string) map[string]string {
+	dirs := messagesDirs()
+	names := make([]string, 0, len(dirs))
+	for name := range dirs {
+		if name != "core" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if _, ok := dirs["core"]; ok {
+		names = append([]string{"core"}, names...)
+	}
+
+	messages := make(map[string]string)
+	for _, name := range names {
+		for _, dir := range dirs[name] {
+			for key, value := range l.readJSONFile(filepath.Join(dir, code+".json")) {
+				messages[key] = value
+			}
+		}
+	}
+	return messages
+}
+
+/**
+ * MessagesDirs, with the directories of the loaded extensions added
+ * @return array Map of (name => directories)
+ */
+func messagesDirs() map[string][]string {
+	dirs := make(map[string][]string, len(MessagesDirs))
+	for name, paths := range MessagesDirs {
+		dirs[name] = append([]string{}, paths...)
+	}
+	extDirs, _ := globals.GLOBALS["wgMessagesDirs"].(map[string]interface{})
+	for name, paths := range extDirs {
+		paths, _ := paths.([]interface{})
+		for _, path := range paths {
+			if path, ok := path.(string); ok {
+				dirs[name] = append(dirs[name], path)
+			}
+		}
+	}
+	return dirs
+}
+
+/**
+ * Read a JSON file containing localisation messages.
+ * @param string $fileName Name of file to read
+ * @throws MWException If there is a syntax error in the JSON file
+ * @return array Array with a 'messages' key, or empty array if the file doesn't exist
+ */
+func (l *LocalisationCache) readJSONFile(fileName string) map[string]string {
+	fileContents, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(fileContents, &data); err != nil {
+		panic(exception.NewMWException("LocalisationCache::readJSONFile: Invalid JSON file: " + fileName))
+	}
+
+	messages := make(map[string]string)
+	for key, value := range data {
+		// Remove keys starting with '@', they're reserved for metadata and non-message data
+		if strings.HasPrefix(key, "@") {
+			continue
+		}
+		if text, ok := value.(string); ok {
+			messages[key] = text
+		}
+	}
+	return messages
+}
+
+/**
+ * Returns true if a language code string is of a valid form for the
+ * purposes of internal customisation of MediaWiki, via Messages*.php
+ * or *.json, see Language::isValidBuiltInCode().
+ *
+ * @param string $code
+ * @return bool
+ */
+func isValidBuiltInCode(code string) bool {
+	if len(code) < 2 {
+		return false
+	}
+	for _, c := range code {
+		if !(c >= 'a' && c <= 'z') && !(c >= '0' && c <= '9') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
@@ -2,43 +2,68 @@ package config
 
 import (
 	"fmt"
+	"sync"
+
 	"github.com/astaxie/beego/config"
 )
 
+/**
+ * Path of app.conf, relative to the working directory. Tests running in
+ * their package directory may point it to the file of the repository.
+ */
+var ConfigFile = "conf/app.conf"
 
 type Config struct {
 	IniConfig config.Configer
+	loadOnce  sync.Once
 }
 
 func NewConfig() *Config {
 	this := new(Config)
-	iniConfig, err := config.NewConfig("ini", "conf/app.conf")
+	this.IniConfig = loadIniConfig()
+	return this
+}
+
+/**
+ * @throws string If the file cannot be loaded
+ */
+func loadIniConfig() config.Configer {
+	iniConfig, err := config.NewConfig("ini", ConfigFile)
 	if err != nil {
 		panic(fmt.Sprintf("fail to load conf file : %s", err))
 	}
-	this.IniConfig = iniConfig
-	return this
+	return iniConfig
+}
+
+/**
+ * @return Configer The settings of app.conf, loaded on first use
+ */
+func (c *Config) ini() config.Configer {
+	c.loadOnce.Do(func() {
+		if c.IniConfig == nil {
+			c.IniConfig = loadIniConfig()
+		}
+	})
+	return c.IniConfig
 }
 
 func (c *Config) Get(key string) interface{} {
-	return c.IniConfig.String(key)
+	return c.ini().String(key)
 }
 
 func (c *Config) GetString(key string) string {
-	return c.IniConfig.String(key)
+	return c.ini().String(key)
 }
 
 func (c *Config) GetBool(key string) bool {
-	b, _ := c.IniConfig.Bool(key)
+	b, _ := c.ini().Bool(key)
 	return b
 }
 
 func (c *Config) GetList(key string) []string {
-	return c.IniConfig.Strings(key)
+	return c.ini().Strings(key)
 }
 
-
 func (c *Config) Has(key string) bool {
-	return true
+	return c.ini().String(key) != ""
 }
-
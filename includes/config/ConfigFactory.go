@@ -0,0 +1,121 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/MangoDowner/mediawiki/includes/exception"
+)
+
+/**
+ * Factory class to create Config objects
+ *
+ * Extensions register their own configuration, keyed by their name, with
+ * "ConfigRegistry" in extension.json, and get it with makeConfig().
+ *
+ * @since 1.23
+ */
+type ConfigFactory struct {
+	/**
+	 * Map of config name => callback
+	 * @var array
+	 */
+	factoryFunctions map[string]interface{}
+
+	/**
+	 * Config objects that have already been created
+	 * name => Config object
+	 * @var array
+	 */
+	configs map[string]IConfig
+
+	mutex sync.Mutex
+}
+
+func NewConfigFactory() *ConfigFactory {
+	this := new(ConfigFactory)
+	this.factoryFunctions = make(map[string]interface{})
+	this.configs = make(map[string]IConfig)
+	return this
+}
+
+/**
+ * @return string[]
+ */
+func (c *ConfigFactory) GetConfigNames() []string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	names := make([]string, 0, len(c.factoryFunctions))
+	for name := range c.factoryFunctions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/**
+ * Register a new config factory function.
+ * Will override if it's already registered.
+ *
+ * @param string $name
+ * @param callable|Config $callback A factory callback that takes this ConfigFactory
+ *        as an argument and returns a Config instance, a callback without
+ *        arguments returning a Config instance, or an existing Config instance.
+ * @throws MWException If an invalid callback is provided
+ */
+func (c *ConfigFactory) Register(name string, callback interface{}) {
+	switch callback.(type) {
+	case IConfig, func(*ConfigFactory) IConfig, func() IConfig:
+	default:
+		panic(exception.NewMWException(fmt.Sprintf("Invalid callback '%T' provided", callback)))
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.configs, name)
+	c.factoryFunctions[name] = callback
+}
+
+/**
+ * Create a given Config using the registered callback for $name.
+ * If an object was already created, the same Config object is returned.
+ *
+ * @param string $name Name of the extension/component you want a Config object for
+ *                     'main' is used for core
+ * @throws ConfigException If a factory function isn't registered for $name
+ * @return Config
+ */
+func (c *ConfigFactory) MakeConfig(name string) IConfig {
+	c.mutex.Lock()
+	if config, ok := c.configs[name]; ok {
+		c.mutex.Unlock()
+		return config
+	}
+	callback, ok := c.factoryFunctions[name]
+	c.mutex.Unlock()
+	if !ok {
+		panic(exception.NewConfigExceptionWithMessage(fmt.Sprintf("No registered builder available for %s.", name)))
+	}
+
+	// Build outside of the lock, as the callbacks may make other configs
+	var config IConfig
+	switch callback := callback.(type) {
+	case IConfig:
+		config = callback
+	case func(*ConfigFactory) IConfig:
+		config = callback(c)
+	case func() IConfig:
+		config = callback()
+	}
+	if config == nil {
+		panic(exception.NewMWException(fmt.Sprintf("The builder for %s returned a non-Config object.", name)))
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if existing, ok := c.configs[name]; ok {
+		return existing
+	}
+	c.configs[name] = config
+	return config
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * @covers ConfigFactory::register
+ * @covers ConfigFactory::makeConfig
+ * @covers ConfigFactory::getConfigNames
+ */
+func TestConfigFactory(t *testing.T) {
+	factory := NewConfigFactory()
+	calls := 0
+	factory.Register("unittest", func(f *ConfigFactory) IConfig {
+		calls++
+		return NewHashConfig(map[string]interface{}{"factory": f == factory})
+	})
+	foo := NewHashConfig(map[string]interface{}{"foo": "bar"})
+	factory.Register("foo", foo)
+	factory.Register("bar", func() IConfig { return NewGlobalVarConfig("bar") })
+	test.AssertEqual(t, "[bar foo unittest]", fmt.Sprint(factory.GetConfigNames()), `Registered names`)
+
+	conf := factory.MakeConfig("unittest")
+	test.AssertEqual(t, true, conf.Get("factory"), `The callback gets the factory`)
+	test.AssertTrue(t, conf == factory.MakeConfig("unittest"), `Configs are cached`)
+	test.AssertEqual(t, 1, calls, `The callback is called once`)
+	test.AssertTrue(t, factory.MakeConfig("foo") == IConfig(foo), `Config instances are returned as they are`)
+	_, ok := factory.MakeConfig("bar").(*GlobalVarConfig)
+	test.AssertTrue(t, ok, `Callbacks without arguments`)
+
+	factory.Register("unittest", foo)
+	test.AssertTrue(t, factory.MakeConfig("unittest") == IConfig(foo), `Registering again replaces the config`)
+
+	func() {
+		defer func() {
+			test.AssertTrue(t, strings.Contains(fmt.Sprint(recover()), "Invalid callback"), `Invalid callback`)
+		}()
+		factory.Register("invalid", "GlobalVarConfig::newInstance")
+	}()
+	defer func() {
+		test.AssertTrue(t, strings.Contains(fmt.Sprint(recover()), "No registered builder available for missing."),
+			`Unknown config`)
+	}()
+	factory.MakeConfig("missing")
+}
@@ -0,0 +1,349 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/MangoDowner/mediawiki/includes/exception"
+)
+
+/**
+ * Types of the settings, as in JSON schema. A setting may have several,
+ * separated by "|", e.g. "string|null".
+ */
+const (
+	TYPE_STRING  = "string"
+	TYPE_BOOLEAN = "boolean"
+	TYPE_INTEGER = "integer"
+	TYPE_NUMBER  = "number"
+	TYPE_ARRAY   = "array"
+	TYPE_OBJECT  = "object"
+	TYPE_NULL    = "null"
+)
+
+/**
+ * The schema of a configuration setting
+ */
+type ConfigSetting struct {
+	/** @var string Empty for any type */
+	Type string
+	/** @var mixed */
+	Default interface{}
+	/** @var string */
+	Description string
+}
+
+/**
+ * The configuration settings a component knows, with their types, defaults
+ * and descriptions
+ *
+ * @since 1.39
+ */
+type ConfigSchema struct {
+	/** @var ConfigSetting[] Map of setting name => schema */
+	settings map[string]*ConfigSetting
+}
+
+/**
+ * @param ConfigSetting[] $settings Map of setting name => schema
+ * @throws ConfigException If a default does not have the type of its setting
+ */
+func NewConfigSchema(settings map[string]*ConfigSetting) *ConfigSchema {
+	this := new(ConfigSchema)
+	this.settings = settings
+	for _, name := range this.GetNames() {
+		if err := this.Validate(name, settings[name].Default); err != nil {
+			panic(exception.NewConfigExceptionWithMessage(fmt.Sprintf("Invalid default: %s", err)))
+		}
+	}
+	return this
+}
+
+/**
+ * @param string $name
+ * @return bool Whether the schema knows the setting
+ */
+func (c *ConfigSchema) Has(name string) bool {
+	_, ok := c.settings[name]
+	return ok
+}
+
+/**
+ * @param string $name
+ * @return ConfigSetting|null
+ */
+func (c *ConfigSchema) GetSetting(name string) *ConfigSetting {
+	return c.settings[name]
+}
+
+/**
+ * @return string[] The names of the settings, sorted
+ */
+func (c *ConfigSchema) GetNames() []string {
+	names := make([]string, 0, len(c.settings))
+	for name := range c.settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/**
+ * @return array Map of setting name => default value. The arrays are
+ *  copies, which the callers may change.
+ */
+func (c *ConfigSchema) GetDefaults() map[string]interface{} {
+	defaults := make(map[string]interface{}, len(c.settings))
+	for name, setting := range c.settings {
+		defaults[name] = copyValue(setting.Default)
+	}
+	return defaults
+}
+
+/**
+ * Check that a value has the type of its setting
+ *
+ * @param string $name
+ * @param mixed $value
+ * @return error|null Null if the value is valid, or the setting unknown
+ */
+func (c *ConfigSchema) Validate(name string, value interface{}) error {
+	setting, ok := c.settings[name]
+	if !ok || setting.Type == "" {
+		return nil
+	}
+	for _, t := range strings.Split(setting.Type, "|") {
+		if hasType(value, t) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s must be of type %s, %s given", name, setting.Type, typeName(value))
+}
+
+/**
+ * Parse the text of a value, e.g. from app.conf or the environment, to the
+ * type of its setting: "true" for booleans, "42" for integers, and JSON or
+ * items separated by ";" for arrays.
+ *
+ * @param string $name
+ * @param string $text
+ * @return mixed
+ * @return error|null If the text cannot be parsed to the type of the setting
+ */
+func (c *ConfigSchema) ParseValue(name string, text string) (interface{}, error) {
+	setting, ok := c.settings[name]
+	if !ok {
+		return nil, fmt.Errorf("Unknown setting %s", name)
+	}
+	if setting.Type == "" {
+		var value interface{}
+		if json.Unmarshal([]byte(text), &value) == nil {
+			return value, nil
+		}
+		return text, nil
+	}
+	if strings.TrimSpace(text) == "null" && c.Validate(name, nil) == nil {
+		return nil, nil
+	}
+	for _, t := range strings.Split(setting.Type, "|") {
+		if value, ok := parseAs(text, t); ok {
+			return value, nil
+		}
+	}
+	return nil, fmt.Errorf("%s must be of type %s, \"%s\" given", name, setting.Type, text)
+}
+
+/**
+ * Check a value against the type of its setting, and convert it to the Go
+ * type of the default, e.g. the float64 numbers of JSON to int, or arrays to
+ * []string, so that the code reading the setting gets the type it expects.
+ *
+ * @param string $name
+ * @param mixed $value
+ * @return mixed
+ * @return error|null If the value does not have the type of the setting
+ */
+func (c *ConfigSchema) Normalize(name string, value interface{}) (interface{}, error) {
+	if err := c.Validate(name, value); err != nil {
+		return nil, err
+	}
+	setting, ok := c.settings[name]
+	if !ok || value == nil || setting.Default == nil {
+		return value, nil
+	}
+	t := reflect.TypeOf(setting.Default)
+	if reflect.TypeOf(value) == t {
+		return value, nil
+	}
+	text, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", name, err)
+	}
+	converted := reflect.New(t)
+	if err := json.Unmarshal(text, converted.Interface()); err != nil {
+		return nil, fmt.Errorf("%s must be of type %s, %s given", name, t, text)
+	}
+	return converted.Elem().Interface(), nil
+}
+
+/**
+ * @param string $text
+ * @param string $t Type
+ * @return mixed
+ * @return bool Whether the text is a value of the type
+ */
+func parseAs(text string, t string) (interface{}, bool) {
+	text = strings.TrimSpace(text)
+	switch t {
+	case TYPE_STRING:
+		return text, true
+	case TYPE_BOOLEAN:
+		value, err := strconv.ParseBool(text)
+		return value, err == nil
+	case TYPE_INTEGER:
+		value, err := strconv.Atoi(text)
+		return value, err == nil
+	case TYPE_NUMBER:
+		value, err := strconv.ParseFloat(text, 64)
+		return value, err == nil
+	case TYPE_NULL:
+		return nil, text == "null"
+	case TYPE_ARRAY:
+		if !strings.HasPrefix(text, "[") {
+			// Items separated by ";", as in app.conf
+			items := []interface{}{}
+			for _, item := range strings.Split(text, ";") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+			return items, true
+		}
+		var value []interface{}
+		return value, json.Unmarshal([]byte(text), &value) == nil
+	case TYPE_OBJECT:
+		var value map[string]interface{}
+		return value, strings.HasPrefix(text, "{") && json.Unmarshal([]byte(text), &value) == nil
+	}
+	return nil, false
+}
+
+/**
+ * @param mixed $value
+ * @param string $t Type
+ * @return bool
+ */
+func hasType(value interface{}, t string) bool {
+	if value == nil {
+		return t == TYPE_NULL
+	}
+	v := reflect.ValueOf(value)
+	switch t {
+	case TYPE_STRING:
+		return v.Kind() == reflect.String
+	case TYPE_BOOLEAN:
+		return v.Kind() == reflect.Bool
+	case TYPE_INTEGER:
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return true
+		case reflect.Float32, reflect.Float64:
+			// Numbers decoded from JSON
+			return v.Float() == math.Trunc(v.Float())
+		}
+	case TYPE_NUMBER:
+		return hasType(value, TYPE_INTEGER) || v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64
+	case TYPE_ARRAY:
+		return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+	case TYPE_OBJECT:
+		// Like PHP, an empty list is an empty object too
+		return v.Kind() == reflect.Map || (v.Kind() == reflect.Slice && v.Len() == 0)
+	}
+	return false
+}
+
+/**
+ * @param mixed $value
+ * @return string The type of the value, as named by the schema
+ */
+func typeName(value interface{}) string {
+	for _, t := range []string{TYPE_NULL, TYPE_STRING, TYPE_BOOLEAN, TYPE_INTEGER, TYPE_NUMBER, TYPE_ARRAY,
+		TYPE_OBJECT} {
+		if hasType(value, t) {
+			return t
+		}
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+/**
+ * @param mixed $value
+ * @return mixed A deep copy of the arrays, the value itself otherwise
+ */
+func copyValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			copied[k] = copyValue(v)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(value))
+		for i, v := range value {
+			copied[i] = copyValue(v)
+		}
+		return copied
+	case []string:
+		return append([]string{}, value...)
+	}
+	return value
+}
+
+/**
+ * Config of a source which only has strings, e.g. app.conf, of which the
+ * values are parsed according to a schema. It only has the settings of the
+ * schema.
+ */
+type ParsedConfig struct {
+	source IConfig
+	schema *ConfigSchema
+}
+
+/**
+ * @param Config $source Returning strings
+ * @param ConfigSchema $schema
+ */
+func NewParsedConfig(source IConfig, schema *ConfigSchema) *ParsedConfig {
+	this := new(ParsedConfig)
+	this.source = source
+	this.schema = schema
+	return this
+}
+
+/**
+ * @inheritDoc
+ */
+func (p *ParsedConfig) Get(name string) interface{} {
+	if !p.Has(name) {
+		panic(exception.NewConfigException(name))
+	}
+	value, err := p.schema.ParseValue(name, fmt.Sprint(p.source.Get(name)))
+	if err != nil {
+		panic(exception.NewConfigExceptionWithMessage(err.Error()))
+	}
+	return value
+}
+
+/**
+ * @inheritDoc
+ */
+func (p *ParsedConfig) Has(name string) bool {
+	return p.schema.Has(name) && p.source.Has(name)
+}
@@ -0,0 +1,144 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+func newTestSchema() *ConfigSchema {
+	return NewConfigSchema(map[string]*ConfigSetting{
+		"Sitename":       {Type: TYPE_STRING, Default: "MediaWiki", Description: "Name of the site"},
+		"EnableEmail":    {Type: TYPE_BOOLEAN, Default: true},
+		"MaxArticleSize": {Type: TYPE_INTEGER, Default: 2048},
+		"Ratio":          {Type: TYPE_NUMBER, Default: 0.5},
+		"Servers":        {Type: TYPE_ARRAY, Default: []interface{}{"localhost"}},
+		"Actions":        {Type: TYPE_OBJECT, Default: map[string]interface{}{"view": true}},
+		"Logo":           {Type: "string|null", Default: nil},
+		"Anything":       {Default: 1},
+	})
+}
+
+/**
+ * @covers ConfigSchema::getDefaults
+ */
+func TestConfigSchema_Defaults(t *testing.T) {
+	schema := newTestSchema()
+	test.AssertEqual(t, "[Actions Anything EnableEmail Logo MaxArticleSize Ratio Servers Sitename]",
+		fmt.Sprint(schema.GetNames()), `Sorted names`)
+	test.AssertEqual(t, "Name of the site", schema.GetSetting("Sitename").Description, `Description`)
+
+	defaults := schema.GetDefaults()
+	defaults["Actions"].(map[string]interface{})["edit"] = false
+	defaults["Servers"].([]interface{})[0] = "example.org"
+	test.AssertEqual(t, "map[view:true]", fmt.Sprint(schema.GetDefaults()["Actions"]), `Copied objects`)
+	test.AssertEqual(t, "[localhost]", fmt.Sprint(schema.GetDefaults()["Servers"]), `Copied arrays`)
+
+	defer func() {
+		test.AssertTrue(t, strings.Contains(fmt.Sprint(recover()),
+			"Invalid default: Sitename must be of type string, integer given"), `Invalid default`)
+	}()
+	NewConfigSchema(map[string]*ConfigSetting{"Sitename": {Type: TYPE_STRING, Default: 1}})
+}
+
+/**
+ * @covers ConfigSchema::validate
+ */
+func TestConfigSchema_Validate(t *testing.T) {
+	schema := newTestSchema()
+	valid := map[string][]interface{}{
+		"Sitename":       {"Wiki"},
+		"EnableEmail":    {false},
+		"MaxArticleSize": {4096, int64(1), float64(10)},
+		"Ratio":          {1, 0.25},
+		"Servers":        {[]string{"a"}, []interface{}{}},
+		"Actions":        {map[string]bool{}, []interface{}{}},
+		"Logo":           {nil, "logo.png"},
+		"Anything":       {nil, "a", []int{1}},
+		"Unknown":        {1},
+	}
+	for name, values := range valid {
+		for _, value := range values {
+			test.AssertEqual(t, nil, schema.Validate(name, value), fmt.Sprintf("%s = %#v", name, value))
+		}
+	}
+
+	invalid := map[string]interface{}{
+		"Sitename":       1,
+		"EnableEmail":    "true",
+		"MaxArticleSize": 1.5,
+		"Ratio":          "0.5",
+		"Servers":        "localhost",
+		"Actions":        []interface{}{"view"},
+		"Logo":           false,
+	}
+	for name, value := range invalid {
+		test.AssertTrue(t, schema.Validate(name, value) != nil, fmt.Sprintf("Invalid %s = %#v", name, value))
+	}
+	test.AssertEqual(t, "Logo must be of type string|null, boolean given", fmt.Sprint(schema.Validate("Logo", false)),
+		`Error message`)
+}
+
+/**
+ * @covers ConfigSchema::parseValue
+ */
+func TestConfigSchema_ParseValue(t *testing.T) {
+	schema := newTestSchema()
+	cases := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{"Sitename", " My wiki ", "My wiki"},
+		{"EnableEmail", "false", "false"},
+		{"MaxArticleSize", "4096", "4096"},
+		{"Ratio", "0.75", "0.75"},
+		{"Servers", "a;b;", "[a b]"},
+		{"Servers", `["a", "b"]`, "[a b]"},
+		{"Actions", `{"edit": false}`, "map[edit:false]"},
+		{"Logo", "null", "<nil>"},
+		{"Logo", "logo.png", "logo.png"},
+		{"Anything", `{"a": 1}`, "map[a:1]"},
+		{"Anything", "text", "text"},
+	}
+	for _, c := range cases {
+		value, err := schema.ParseValue(c.name, c.text)
+		test.AssertEqual(t, nil, err, c.name+" = "+c.text)
+		test.AssertEqual(t, c.expected, fmt.Sprint(value), c.name+" = "+c.text)
+	}
+	value, _ := schema.ParseValue("MaxArticleSize", "4096")
+	test.AssertEqual(t, 4096, value, `Integers are ints`)
+
+	for name, text := range map[string]string{
+		"EnableEmail":    "maybe",
+		"MaxArticleSize": "1.5",
+		"Actions":        "edit",
+		"Servers":        "[a",
+		"Unknown":        "1",
+	} {
+		_, err := schema.ParseValue(name, text)
+		test.AssertTrue(t, err != nil, fmt.Sprintf("Invalid %s = %s", name, text))
+	}
+}
+
+/**
+ * @covers ParsedConfig::get
+ * @covers ParsedConfig::has
+ */
+func TestParsedConfig(t *testing.T) {
+	source := NewHashConfig(map[string]interface{}{"EnableEmail": "false", "Servers": "a;b", "appname": "wiki",
+		"MaxArticleSize": "big"})
+	conf := NewParsedConfig(source, newTestSchema())
+	test.AssertEqual(t, false, conf.Get("EnableEmail"), `Parsed boolean`)
+	test.AssertEqual(t, "[a b]", fmt.Sprint(conf.Get("Servers")), `Parsed array`)
+	test.AssertTrue(t, !conf.Has("appname"), `Only the settings of the schema`)
+	test.AssertTrue(t, !conf.Has("Sitename"), `Only the settings of the source`)
+
+	defer func() {
+		test.AssertTrue(t, strings.Contains(fmt.Sprint(recover()), `MaxArticleSize must be of type integer, "big" given`),
+			`Invalid value`)
+	}()
+	conf.Get("MaxArticleSize")
+}
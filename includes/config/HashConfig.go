@@ -0,0 +1,81 @@
+package config
+
+import (
+	"sync"
+
+	"github.com/MangoDowner/mediawiki/includes/exception"
+)
+
+/**
+ * A Config instance which stores all settings as a member variable
+ *
+ * @since 1.24
+ */
+type HashConfig struct {
+	/**
+	 * Array of config settings
+	 *
+	 * @var array
+	 */
+	settings map[string]interface{}
+	mutex    sync.RWMutex
+}
+
+/**
+ * @param array $settings Any current settings to pre-load
+ */
+func NewHashConfig(settings map[string]interface{}) *HashConfig {
+	this := new(HashConfig)
+	this.settings = make(map[string]interface{}, len(settings))
+	for name, value := range settings {
+		this.settings[name] = value
+	}
+	return this
+}
+
+/**
+ * @inheritDoc
+ */
+func (h *HashConfig) Get(name string) interface{} {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	value, ok := h.settings[name]
+	if !ok {
+		panic(exception.NewConfigException(name))
+	}
+	return value
+}
+
+/**
+ * @inheritDoc
+ */
+func (h *HashConfig) Has(name string) bool {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	_, ok := h.settings[name]
+	return ok
+}
+
+/**
+ * @see MutableConfig::set
+ * @param string $name
+ * @param mixed $value
+ */
+func (h *HashConfig) Set(name string, value interface{}) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.settings[name] = value
+}
+
+/**
+ * @return array All the settings
+ */
+func (h *HashConfig) GetAll() map[string]interface{} {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	settings := make(map[string]interface{}, len(h.settings))
+	for name, value := range h.settings {
+		settings[name] = value
+	}
+	return settings
+}
@@ -24,3 +24,21 @@ type IConfig interface {
 	*/
 	Has(name string) bool
 }
+
+/**
+ * Interface for mutable configuration instances
+ *
+ * @since 1.24
+ */
+type IMutableConfig interface {
+	IConfig
+
+	/**
+	 * Set a configuration variable such a "Sitename" to something like "My Wiki"
+	 *
+	 * @param string $name Name of configuration option
+	 * @param mixed $value Value to set
+	 * @throws ConfigException
+	 */
+	Set(name string, value interface{})
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"github.com/MangoDowner/mediawiki/includes/exception"
+)
+
+/**
+ * Provides a fallback sequence for Config objects
+ *
+ * @since 1.24
+ */
+type MultiConfig struct {
+	/**
+	 * Array of Config objects to use
+	 * Order matters, the Config objects
+	 * will be checked in order to see
+	 * whether they have the requested setting
+	 *
+	 * @var Config[]
+	 */
+	configs []IConfig
+}
+
+/**
+ * @param Config[] $configs Array of Config objects.
+ */
+func NewMultiConfig(configs ...IConfig) *MultiConfig {
+	this := new(MultiConfig)
+	this.configs = configs
+	return this
+}
+
+/**
+ * @inheritDoc
+ */
+func (m *MultiConfig) Get(name string) interface{} {
+	for _, config := range m.configs {
+		if config.Has(name) {
+			return config.Get(name)
+		}
+	}
+	panic(exception.NewConfigException(name))
+}
+
+/**
+ * @inheritDoc
+ */
+func (m *MultiConfig) Has(name string) bool {
+	for _, config := range m.configs {
+		if config.Has(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * @covers HashConfig::get
+ * @covers HashConfig::has
+ * @covers HashConfig::set
+ */
+func TestHashConfig(t *testing.T) {
+	settings := map[string]interface{}{"one": 1}
+	conf := NewHashConfig(settings)
+	test.AssertEqual(t, 1, conf.Get("one"), `Pre-loaded setting`)
+	test.AssertTrue(t, !conf.Has("two"), `Unknown setting`)
+
+	conf.Set("two", 2)
+	conf.Set("one", "uno")
+	test.AssertEqual(t, "uno", conf.Get("one"), `Overridden setting`)
+	test.AssertEqual(t, 2, conf.Get("two"), `New setting`)
+	test.AssertEqual(t, 1, settings["one"], `The settings given are copied`)
+	test.AssertEqual(t, "map[one:uno two:2]", fmt.Sprint(conf.GetAll()), `All the settings`)
+
+	defer func() {
+		test.AssertTrue(t, strings.Contains(fmt.Sprint(recover()), "undefined option:'three'"), `Unknown setting`)
+	}()
+	conf.Get("three")
+}
+
+/**
+ * @covers MultiConfig::get
+ * @covers MultiConfig::has
+ */
+func TestMultiConfig(t *testing.T) {
+	conf := NewMultiConfig(
+		NewHashConfig(map[string]interface{}{"foo": "bar"}),
+		NewHashConfig(map[string]interface{}{"foo": "baz", "bar": "foo"}),
+	)
+	test.AssertEqual(t, "bar", conf.Get("foo"), `The first config has precedence`)
+	test.AssertEqual(t, "foo", conf.Get("bar"), `Fallback to the next configs`)
+	test.AssertTrue(t, conf.Has("bar"), `Setting of any config`)
+	test.AssertTrue(t, !conf.Has("baz"), `Setting of no config`)
+
+	defer func() {
+		test.AssertTrue(t, strings.Contains(fmt.Sprint(recover()), "undefined option:'baz'"), `Unknown setting`)
+	}()
+	conf.Get("baz")
+}
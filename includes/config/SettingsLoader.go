@@ -0,0 +1,213 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	"gopkg.in/yaml.v3"
+)
+
+/**
+ * Prefix of the environment variables overriding settings, e.g.
+ * MW_SETTING_LANGUAGE_CODE for LanguageCode. The other MW_* variables, e.g.
+ * MW_INSTALL_PATH, are not settings and are ignored.
+ */
+const SETTINGS_ENV_PREFIX = "MW_SETTING_"
+
+/**
+ * Environment variable naming the settings file, as MW_CONFIG_FILE names
+ * LocalSettings.php in MediaWiki. It is not a setting.
+ */
+const SETTINGS_FILE_ENV = "MW_CONFIG_FILE"
+
+/**
+ * Loads the settings of a site from a YAML or JSON file and from the
+ * environment, checking them against a ConfigSchema.
+ *
+ * The file maps setting names, without the "wg" prefix, to their values:
+ *
+ *   LanguageCode: de
+ *   EnableEmail: false
+ *
+ * The values replace the defaults of the schema; objects are not merged.
+ */
+type SettingsLoader struct {
+	schema *ConfigSchema
+	/** @var array Map of setting name => value */
+	settings map[string]interface{}
+}
+
+/**
+ * @param ConfigSchema $schema
+ */
+func NewSettingsLoader(schema *ConfigSchema) *SettingsLoader {
+	this := new(SettingsLoader)
+	this.schema = schema
+	this.settings = make(map[string]interface{})
+	return this
+}
+
+/**
+ * Load the settings of a file, YAML (.yaml, .yml) or JSON (.json) by its
+ * extension
+ *
+ * @param string $path
+ * @return ConfigException|null If the file cannot be read, or has unknown
+ *  settings or values of the wrong types
+ */
+func (s *SettingsLoader) LoadFile(path string) error {
+	text, err := ioutil.ReadFile(path)
+	if err != nil {
+		return exception.NewConfigExceptionWithMessage(err.Error())
+	}
+	var settings map[string]interface{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(text, &settings)
+	case ".json":
+		err = json.Unmarshal(text, &settings)
+	default:
+		return exception.NewConfigExceptionWithMessage(
+			fmt.Sprintf("Unsupported settings file %s, expected .yaml, .yml or .json", path))
+	}
+	if err != nil {
+		return exception.NewConfigExceptionWithMessage(fmt.Sprintf("Cannot parse %s: %s", path, err))
+	}
+
+	var errors []string
+	for _, name := range sortedKeys(settings) {
+		if !s.schema.Has(name) {
+			errors = append(errors, fmt.Sprintf("Unknown setting %s", name))
+			continue
+		}
+		value, err := s.schema.Normalize(name, stringKeys(settings[name]))
+		if err != nil {
+			errors = append(errors, err.Error())
+			continue
+		}
+		s.settings[name] = value
+	}
+	return settingsError("Invalid settings in "+path, errors)
+}
+
+/**
+ * Load the settings of the environment variables with SETTINGS_ENV_PREFIX,
+ * parsed as ConfigSchema::parseValue() does. The rest of the name is the
+ * name of the setting in upper case, its words optionally separated by "_".
+ *
+ * As the underscores are dropped, several names set the same setting:
+ * MW_SETTING_LANGUAGE_CODE and MW_SETTING_LANGUAGECODE both set LanguageCode.
+ * Setting it with both is an error, as is a name matching several settings
+ * which differ only in case.
+ *
+ * @param string[] $environ "NAME=value" strings, as os.Environ() returns
+ * @return ConfigException|null If a variable is no setting, sets the same
+ *  setting as another one, or has a value of the wrong type
+ */
+func (s *SettingsLoader) LoadEnvironment(environ []string) error {
+	names := make(map[string][]string)
+	for _, name := range s.schema.GetNames() {
+		key := strings.ToUpper(name)
+		names[key] = append(names[key], name)
+	}
+
+	var errors []string
+	variables := make(map[string]string)
+	for _, variable := range environ {
+		parts := strings.SplitN(variable, "=", 2)
+		if len(parts) != 2 || !strings.HasPrefix(parts[0], SETTINGS_ENV_PREFIX) {
+			continue
+		}
+		key := strings.Replace(strings.TrimPrefix(parts[0], SETTINGS_ENV_PREFIX), "_", "", -1)
+		if len(names[key]) == 0 {
+			errors = append(errors, fmt.Sprintf("Unknown setting %s", parts[0]))
+			continue
+		}
+		if len(names[key]) > 1 {
+			errors = append(errors, fmt.Sprintf("%s matches several settings: %s", parts[0],
+				strings.Join(names[key], ", ")))
+			continue
+		}
+		name := names[key][0]
+		if other, ok := variables[name]; ok {
+			errors = append(errors, fmt.Sprintf("%s and %s both set %s", other, parts[0], name))
+			continue
+		}
+		variables[name] = parts[0]
+		value, err := s.schema.ParseValue(name, parts[1])
+		if err == nil {
+			value, err = s.schema.Normalize(name, value)
+		}
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("%s: %s", parts[0], err))
+			continue
+		}
+		s.settings[name] = value
+	}
+	sort.Strings(errors)
+	return settingsError("Invalid settings in the environment", errors)
+}
+
+/**
+ * @return array Map of setting name => value of the settings loaded
+ */
+func (s *SettingsLoader) GetSettings() map[string]interface{} {
+	return s.settings
+}
+
+/**
+ * @param string $message
+ * @param string[] $errors
+ * @return ConfigException|null
+ */
+func settingsError(message string, errors []string) error {
+	if len(errors) == 0 {
+		return nil
+	}
+	return exception.NewConfigExceptionWithMessage(message + ":\n* " + strings.Join(errors, "\n* "))
+}
+
+/**
+ * @param array $settings
+ * @return string[]
+ */
+func sortedKeys(settings map[string]interface{}) []string {
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+/**
+ * Convert the maps YAML decodes with other keys than strings, e.g.
+ * namespace numbers, to maps with string keys, as JSON has
+ *
+ * @param mixed $value
+ * @return mixed
+ */
+func stringKeys(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			m[fmt.Sprint(key)] = stringKeys(item)
+		}
+		return m
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = stringKeys(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = stringKeys(item)
+		}
+	}
+	return value
+}
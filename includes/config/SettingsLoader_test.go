@@ -0,0 +1,124 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * Write a settings file in a temporary directory
+ */
+func writeSettingsFile(t *testing.T, name string, text string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := ioutil.WriteFile(path, []byte(text), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+/**
+ * @covers SettingsLoader::loadFile
+ */
+func TestSettingsLoader_LoadFile(t *testing.T) {
+	loader := NewSettingsLoader(newTestSchema())
+	test.AssertEqual(t, nil, loader.LoadFile(writeSettingsFile(t, "LocalSettings.yaml", `
+Sitename: Wiki
+MaxArticleSize: 4096
+Servers: [a, b]
+Actions:
+  edit: false
+Logo: null
+`)), `Valid YAML`)
+	settings := loader.GetSettings()
+	test.AssertEqual(t, "Wiki", settings["Sitename"], `String`)
+	test.AssertEqual(t, 4096, settings["MaxArticleSize"], `Integer`)
+	test.AssertEqual(t, "[a b]", fmt.Sprint(settings["Servers"]), `Array`)
+	test.AssertEqual(t, "map[edit:false]", fmt.Sprint(settings["Actions"]), `Object`)
+	test.AssertEqual(t, nil, settings["Logo"], `Null`)
+	_, ok := settings["EnableEmail"]
+	test.AssertTrue(t, !ok, `Only the settings of the file`)
+
+	loader = NewSettingsLoader(newTestSchema())
+	test.AssertEqual(t, nil, loader.LoadFile(writeSettingsFile(t, "LocalSettings.json",
+		`{"MaxArticleSize": 10, "Ratio": 1, "Actions": {"view": true}}`)), `Valid JSON`)
+	test.AssertEqual(t, 10, loader.GetSettings()["MaxArticleSize"], `JSON numbers are converted to the type of the default`)
+	test.AssertEqual(t, 1.0, loader.GetSettings()["Ratio"], `Numbers`)
+
+	err := NewSettingsLoader(newTestSchema()).LoadFile(writeSettingsFile(t, "LocalSettings.yml", `
+Sitename: 1
+Sitenmae: Wiki
+EnableEmail: "yes"
+`))
+	test.AssertTrue(t, strings.Contains(fmt.Sprint(err), "LocalSettings.yml:\n"+
+		"* EnableEmail must be of type boolean, string given\n"+
+		"* Sitename must be of type string, integer given\n"+
+		"* Unknown setting Sitenmae"), `Unknown settings and wrong types`)
+
+	err = NewSettingsLoader(newTestSchema()).LoadFile(writeSettingsFile(t, "LocalSettings.ini", "Sitename = Wiki"))
+	test.AssertTrue(t, strings.Contains(fmt.Sprint(err), "Unsupported settings file"), `Unsupported format`)
+	err = NewSettingsLoader(newTestSchema()).LoadFile(writeSettingsFile(t, "LocalSettings.json", "{"))
+	test.AssertTrue(t, strings.Contains(fmt.Sprint(err), "Cannot parse"), `Invalid JSON`)
+}
+
+/**
+ * @covers SettingsLoader::loadEnvironment
+ */
+func TestSettingsLoader_LoadEnvironment(t *testing.T) {
+	loader := NewSettingsLoader(newTestSchema())
+	test.AssertEqual(t, nil, loader.LoadFile(writeSettingsFile(t, "LocalSettings.yaml", "Sitename: Wiki\nRatio: 0.25\n")),
+		`Valid file`)
+	test.AssertEqual(t, nil, loader.LoadEnvironment([]string{
+		"HOME=/root",
+		"MW_SETTING_SITENAME=Other wiki",
+		"MW_SETTING_MAX_ARTICLE_SIZE=100",
+		"MW_SETTING_ENABLE_EMAIL=false",
+		"MW_CONFIG_FILE=LocalSettings.yaml",
+		"MW_INSTALL_PATH=/srv/mediawiki",
+	}), `Valid environment`)
+	settings := loader.GetSettings()
+	test.AssertEqual(t, "Other wiki", settings["Sitename"], `The environment overrides the file`)
+	test.AssertEqual(t, 0.25, settings["Ratio"], `Settings of the file`)
+	test.AssertEqual(t, 100, settings["MaxArticleSize"], `Words separated by "_"`)
+	test.AssertEqual(t, false, settings["EnableEmail"], `Parsed boolean`)
+
+	err := NewSettingsLoader(newTestSchema()).LoadEnvironment([]string{
+		"MW_SETTING_SITE_NAMES=Wiki",
+		"MW_SETTING_RATIO=half",
+		"MW_SETTING_MAX_ARTICLE_SIZE=1",
+		"MW_SETTING_MAXARTICLESIZE=2",
+	})
+	test.AssertTrue(t, strings.Contains(fmt.Sprint(err), "the environment:\n"+
+		"* MW_SETTING_MAX_ARTICLE_SIZE and MW_SETTING_MAXARTICLESIZE both set MaxArticleSize\n"+
+		"* MW_SETTING_RATIO: Ratio must be of type number, \"half\" given\n"+
+		"* Unknown setting MW_SETTING_SITE_NAMES"), `Unknown settings, wrong types and names of the same setting`)
+
+	schema := NewConfigSchema(map[string]*ConfigSetting{"DBname": {Default: ""}, "DbName": {Default: ""}})
+	err = NewSettingsLoader(schema).LoadEnvironment([]string{"MW_SETTING_DB_NAME=wiki"})
+	test.AssertTrue(t, strings.Contains(fmt.Sprint(err), "MW_SETTING_DB_NAME matches several settings: DBname, DbName"),
+		`Settings differing only in case`)
+}
+
+/**
+ * @covers ConfigSchema::normalize
+ */
+func TestConfigSchema_Normalize(t *testing.T) {
+	schema := NewConfigSchema(map[string]*ConfigSetting{
+		"Names":     {Type: TYPE_ARRAY, Default: []string{}},
+		"Languages": {Type: TYPE_OBJECT, Default: map[string]string{}},
+	})
+	value, err := schema.Normalize("Names", []interface{}{"a"})
+	test.AssertEqual(t, nil, err, `Valid array`)
+	test.AssertEqual(t, "[a]", fmt.Sprint(value.([]string)), `Converted to the type of the default`)
+	value, _ = schema.Normalize("Languages", map[string]interface{}{"de": "Deutsch"})
+	test.AssertEqual(t, "Deutsch", value.(map[string]string)["de"], `Converted object`)
+
+	_, err = schema.Normalize("Languages", map[string]interface{}{"de": 1})
+	test.AssertTrue(t, err != nil, `Items of the wrong type`)
+	_, err = schema.Normalize("Names", "a")
+	test.AssertTrue(t, err != nil, `Wrong type`)
+}
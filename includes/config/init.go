@@ -1,8 +1,7 @@
 package config
 
-
+/**
+ * The settings of app.conf, loaded on first use, so that the packages
+ * importing this one, and their tests, do not need the file to start
+ */
 var Configs Config
-
-func init() {
-	Configs = *(NewConfig())
-}
@@ -6,6 +6,15 @@ import "github.com/MangoDowner/mediawiki/includes/libs/rdbms/database"
  * @defgroup Constants MediaWiki constants
  */
 
+/**
+ * The running version of MediaWiki.
+ *
+ * This replaces the $wgVersion global found in earlier versions.
+ *
+ * @since 1.35
+ */
+const MW_VERSION = "1.30.2"
+
 // Obsolete aliases
 /**
  * @deprecated since 1.28
@@ -71,6 +80,15 @@ const S_IMAGE = NS_FILE
  */
 const S_IMAGE_TALK = NS_FILE_TALK
 
+/**@{
+ * Cache type; the keys of $wgObjectCaches
+ */
+const CACHE_ANYTHING = "-1" // Use anything, as long as it works
+const CACHE_NONE = "0"      // Do not cache
+const CACHE_DB = "1"        // Store cache objects in the DB
+const CACHE_MEMCACHED = "2" // MemCached, must specify servers in $wgMemCacheServers
+const CACHE_ACCEL = "3"     // APC, APCU or WinCache
+/**@}*/
 
 /** @{
  * Protocol constants for wfExpandUrl()
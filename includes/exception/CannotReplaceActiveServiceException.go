@@ -0,0 +1,30 @@
+package exception
+
+import (
+	"errors"
+	"fmt"
+)
+
+/**
+ * Exception thrown when trying to replace an already active service.
+ */
+type CannotReplaceActiveServiceException struct {
+	err error
+}
+
+/**
+ * @param string $serviceName
+ * @param Exception|null $previous
+ */
+func NewCannotReplaceActiveServiceException(serviceName string, previous error) *CannotReplaceActiveServiceException {
+	this := new(CannotReplaceActiveServiceException)
+	this.err = errors.New(serviceMessage(fmt.Sprintf("Cannot replace an active service: %s", serviceName), previous))
+	return this
+}
+
+/**
+ * @return string
+ */
+func (c *CannotReplaceActiveServiceException) Error() string {
+	return c.err.Error()
+}
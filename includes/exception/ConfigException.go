@@ -19,4 +19,21 @@ func NewConfigException(name string) *ConfigException {
 	this := new(ConfigException)
 	this.err = errors.New(fmt.Sprintf("undefined option:'%s'", name))
 	return this
-}
\ No newline at end of file
+}
+
+/**
+ * @param string $message What is wrong with the configuration
+ * @return ConfigException
+ */
+func NewConfigExceptionWithMessage(message string) *ConfigException {
+	this := new(ConfigException)
+	this.err = errors.New(message)
+	return this
+}
+
+/**
+ * @return string
+ */
+func (c *ConfigException) Error() string {
+	return c.err.Error()
+}
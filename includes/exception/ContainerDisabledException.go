@@ -1,24 +1,28 @@
 package exception
 
 import (
-	"fmt"
 	"errors"
 )
 
 /**
- * Exception thrown when a service was already defined, but the
- * caller expected it to not exist.
+ * Exception thrown when trying to access a service on a disabled container or factory.
  */
 type ContainerDisabledException struct {
 	err error
 }
 
 /**
- * @param string $serviceName
  * @param Exception|null $previous
  */
 func NewContainerDisabledException(previous error) *ContainerDisabledException {
 	this := new(ContainerDisabledException)
-	this.err = errors.New(fmt.Sprintf("Container disabled! : %s", previous))
+	this.err = errors.New(serviceMessage("Container disabled!", previous))
 	return this
 }
+
+/**
+ * @return string
+ */
+func (c *ContainerDisabledException) Error() string {
+	return c.err.Error()
+}
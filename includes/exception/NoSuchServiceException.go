@@ -18,6 +18,25 @@ type NoSuchServiceException struct {
  */
 func NewNoSuchServiceException(serviceName string, previous error) *NoSuchServiceException {
 	this := new(NoSuchServiceException)
-	this.err = errors.New(fmt.Sprintf("No such service <%s> : %s", serviceName, previous))
+	this.err = errors.New(serviceMessage(fmt.Sprintf("No such service: %s", serviceName), previous))
 	return this
-}
\ No newline at end of file
+}
+
+/**
+ * @return string
+ */
+func (n *NoSuchServiceException) Error() string {
+	return n.err.Error()
+}
+
+/**
+ * @param string $message
+ * @param Exception|null $previous
+ * @return string $message, followed by the message of $previous if any
+ */
+func serviceMessage(message string, previous error) string {
+	if previous == nil {
+		return message
+	}
+	return fmt.Sprintf("%s: %s", message, previous)
+}
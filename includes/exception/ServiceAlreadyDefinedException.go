@@ -1,8 +1,8 @@
 package exception
 
 import (
-	"fmt"
 	"errors"
+	"fmt"
 )
 
 /**
@@ -17,8 +17,15 @@ type ServiceAlreadyDefinedException struct {
  * @param string $serviceName
  * @param Exception|null $previous
  */
-func NewServiceAlreadyDefinedException(serviceName string, previous error) *NoSuchServiceException {
-	this := new(NoSuchServiceException)
-	this.err = errors.New(fmt.Sprintf("Service already defined <%s> : %s", serviceName, previous))
+func NewServiceAlreadyDefinedException(serviceName string, previous error) *ServiceAlreadyDefinedException {
+	this := new(ServiceAlreadyDefinedException)
+	this.err = errors.New(serviceMessage(fmt.Sprintf("Service already defined: %s", serviceName), previous))
 	return this
 }
+
+/**
+ * @return string
+ */
+func (s *ServiceAlreadyDefinedException) Error() string {
+	return s.err.Error()
+}
@@ -0,0 +1,30 @@
+package exception
+
+import (
+	"errors"
+	"fmt"
+)
+
+/**
+ * Exception thrown when trying to access a disabled service.
+ */
+type ServiceDisabledException struct {
+	err error
+}
+
+/**
+ * @param string $serviceName
+ * @param Exception|null $previous
+ */
+func NewServiceDisabledException(serviceName string, previous error) *ServiceDisabledException {
+	this := new(ServiceDisabledException)
+	this.err = errors.New(serviceMessage(fmt.Sprintf("Service disabled: %s", serviceName), previous))
+	return this
+}
+
+/**
+ * @return string
+ */
+func (s *ServiceDisabledException) Error() string {
+	return s.err.Error()
+}
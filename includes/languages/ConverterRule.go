@@ -0,0 +1,504 @@
+package languages
+
+import (
+	"github.com/MangoDowner/mediawiki/includes/php"
+	"sort"
+	"strings"
+)
+
+/**
+ * Valid flags of -{ }- markup, see ConverterRule::parseFlags()
+ */
+var converterRuleFlags = []string{"A", "T", "R", "D", "-", "H", "N"}
+
+/**
+ * Parser for rules of language conversion, parse rules in -{ }- tag.
+ * @ingroup Language
+ */
+type ConverterRule struct {
+	mText string // original text in -{text}-
+
+	/**
+	 * @var LanguageConverter
+	 */
+	mConverter *LanguageConverter
+
+	mRuleDisplay  string
+	mRuleTitle    string
+	mRules        string // string : the text of the rules
+	mRulesAction  string
+	mFlags        map[string]bool
+	mVariantFlags map[string]bool
+
+	/**
+	 * @var array Variant code => (from text => to text) conversion table
+	 */
+	mConvTable map[string]map[string]string
+
+	mBidtable  map[string]string            // array of the translation in each variant
+	mUnidtable map[string]map[string]string // array of the translation in each variant
+}
+
+/**
+ * @param string $text The text between -{ and }-
+ * @param LanguageConverter $converter
+ */
+func NewConverterRule(text string, converter *LanguageConverter) *ConverterRule {
+	this := new(ConverterRule)
+	this.mText = text
+	this.mConverter = converter
+	this.mRulesAction = "none"
+	this.mFlags = make(map[string]bool)
+	this.mVariantFlags = make(map[string]bool)
+	this.mConvTable = make(map[string]map[string]string)
+	this.mBidtable = make(map[string]string)
+	this.mUnidtable = make(map[string]map[string]string)
+	return this
+}
+
+/**
+ * Check if variants array in convert array.
+ *
+ * @param array|string $variants Variant language code
+ * @return string Translated text
+ */
+func (r *ConverterRule) getTextInBidtable(variants []string) string {
+	for _, variant := range variants {
+		if text, ok := r.mBidtable[variant]; ok {
+			return text
+		}
+	}
+	return ""
+}
+
+/**
+ * Parse flags with syntax -{FLAG| ... }-
+ */
+func (r *ConverterRule) parseFlags() {
+	text := r.mText
+	flags := make(map[string]bool)
+	variantFlags := make(map[string]bool)
+
+	sepPos := strings.Index(text, "|")
+	if sepPos >= 0 {
+		validFlags := converterRuleFlags
+		for _, f := range strings.Split(text[:sepPos], ";") {
+			f = strings.TrimSpace(f)
+			if f == "" {
+				continue
+			}
+			if php.InArray(f, validFlags) {
+				flags[f] = true
+			} else if php.InArray(f, r.mConverter.mVariants) {
+				variantFlags[f] = true
+			}
+		}
+		if len(flags) != 0 || len(variantFlags) != 0 {
+			// A known flag or variant was found, so this is a flag section.
+			text = text[sepPos+1:]
+		}
+	}
+
+	if len(flags) == 0 {
+		flags["S"] = true
+	} else if flags["R"] {
+		// remove other flags
+		flags = map[string]bool{"R": true}
+	} else if flags["N"] {
+		// remove other flags
+		flags = map[string]bool{"N": true}
+	} else if flags["-"] {
+		// remove other flags
+		flags = map[string]bool{"-": true}
+	} else if flags["T"] && len(flags) == 1 {
+		flags["H"] = true
+	} else if flags["H"] {
+		// replace A flag, and remove other flags except T
+		temp := map[string]bool{"+": true, "H": true}
+		if flags["T"] {
+			temp["T"] = true
+		}
+		if flags["D"] {
+			temp["D"] = true
+		}
+		flags = temp
+	} else {
+		if flags["A"] {
+			flags["+"] = true
+			flags["S"] = true
+		}
+		if flags["D"] {
+			delete(flags, "S")
+		}
+	}
+
+	r.mVariantFlags = variantFlags
+	r.mRules = text
+	r.mFlags = flags
+}
+
+/**
+ * Generate conversion table.
+ */
+func (r *ConverterRule) parseRules() {
+	// A trailing ";" ends the last choice
+	rules := strings.TrimSuffix(strings.TrimSpace(r.mRules), ";")
+	bidtable := make(map[string]string)
+	unidtable := make(map[string]map[string]string)
+	variants := r.mConverter.mVariants
+
+	// Split by ";" only where the next piece starts a new choice, so that
+	// a ";" inside a conversion result (e.g. "&amp;") is kept.
+	var choices []string
+	for _, piece := range strings.Split(rules, ";") {
+		if len(choices) == 0 || r.startsChoice(piece, variants) {
+			choices = append(choices, piece)
+		} else {
+			choices[len(choices)-1] += ";" + piece
+		}
+	}
+
+	for _, choice := range choices {
+		var u []string
+		if strings.Contains(choice, "=>") {
+			u = strings.SplitN(choice, "=>", 2)
+		} else {
+			u = []string{choice}
+		}
+		last := u[len(u)-1]
+		v := strings.SplitN(last, ":", 2)
+		if len(v) != 2 {
+			continue
+		}
+		to := strings.TrimSpace(v[1])
+		variant := strings.TrimSpace(v[0])
+		if !php.InArray(variant, variants) || to == "" {
+			continue
+		}
+		if len(u) == 1 {
+			// bidirectional
+			bidtable[variant] = to
+		} else {
+			// unidirectional
+			from := strings.TrimSpace(u[0])
+			if from == "" {
+				continue
+			}
+			if _, ok := unidtable[variant]; !ok {
+				unidtable[variant] = make(map[string]string)
+			}
+			unidtable[variant][from] = to
+		}
+	}
+	r.mBidtable = bidtable
+	r.mUnidtable = unidtable
+}
+
+/**
+ * Whether a piece of the rule text begins a new "variant:text" or
+ * "from=>variant:text" choice.
+ */
+func (r *ConverterRule) startsChoice(piece string, variants []string) bool {
+	if i := strings.Index(piece, "=>"); i >= 0 {
+		piece = piece[i+2:]
+	}
+	piece = strings.TrimSpace(piece)
+	for _, variant := range variants {
+		if strings.HasPrefix(piece, variant) &&
+			strings.HasPrefix(strings.TrimSpace(piece[len(variant):]), ":") {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * @return string
+ */
+func (r *ConverterRule) getRulesDesc() string {
+	codesep := r.mConverter.mDescCodeSep
+	varsep := r.mConverter.mDescVarSep
+	var text strings.Builder
+	for _, k := range r.mConverter.mVariants {
+		if v, ok := r.mBidtable[k]; ok {
+			text.WriteString(r.mConverter.GetVariantName(k) + codesep + v + varsep)
+		}
+	}
+	for _, k := range r.mConverter.mVariants {
+		for _, from := range sortedRuleKeys(r.mUnidtable[k]) {
+			text.WriteString(from + "⇒" + r.mConverter.GetVariantName(k) + codesep + r.mUnidtable[k][from] + varsep)
+		}
+	}
+	return text.String()
+}
+
+/**
+ * Parse rules conversion.
+ *
+ * @param string $variant
+ *
+ * @return string
+ */
+func (r *ConverterRule) getRuleConvertedStr(variant string) string {
+	bidtable := r.mBidtable
+	unidtable := r.mUnidtable
+
+	if len(bidtable) == 0 && len(unidtable) == 0 {
+		return r.mRules
+	}
+	// display current variant in bidirectional array
+	disp := r.getTextInBidtable([]string{variant})
+	// or display current variant in fallbacks
+	if disp == "" {
+		disp = r.getTextInBidtable(r.mConverter.GetVariantFallbacks(variant))
+	}
+	// or display current variant in unidirectional array
+	if disp == "" {
+		if froms := sortedRuleKeys(unidtable[variant]); len(froms) > 0 {
+			disp = unidtable[variant][froms[0]]
+		}
+	}
+	// or display the first text in the bidirectional array
+	if disp == "" {
+		for _, v := range r.mConverter.mVariants {
+			if text, ok := bidtable[v]; ok {
+				disp = text
+				break
+			}
+		}
+	}
+	return disp
+}
+
+/**
+ * @param array $table Map of (text => converted text)
+ * @return string[] The texts of the table, sorted so that the output does not
+ *  depend on the order of the map
+ */
+func sortedRuleKeys(table map[string]string) []string {
+	keys := make([]string, 0, len(table))
+	for key := range table {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+/**
+ * Similar to getRuleConvertedStr(), but this prefers to use original
+ * page title if $variant === $this->mConverter->getMainCode() and may
+ * return false in this case (so this title conversion rule will be ignored
+ * and the original title is shown).
+ *
+ * @param string $variant The variant code to display page title in
+ * @return string The converted title or "" if just page name
+ */
+func (r *ConverterRule) getRuleConvertedTitle(variant string) string {
+	if variant == r.mConverter.mMainLanguageCode {
+		// If a string targeting exactly this variant is set,
+		// use it. Otherwise, just return false, so the real
+		// page name can be shown (and because variant === main,
+		// there'll be no further automatic conversion).
+		disp := r.getTextInBidtable([]string{variant})
+		if disp != "" {
+			return disp
+		}
+		if unid, ok := r.mUnidtable[variant]; ok {
+			for _, to := range unid {
+				return to
+			}
+		}
+		return ""
+	}
+	return r.getRuleConvertedStr(variant)
+}
+
+/**
+ * Generate conversion table for all text.
+ */
+func (r *ConverterRule) generateConvTable() {
+	convTable := make(map[string]map[string]string)
+	var vmarked []string
+	add := func(variant, from, to string) {
+		if from == "" || from == to {
+			return
+		}
+		if _, ok := convTable[variant]; !ok {
+			convTable[variant] = make(map[string]string)
+		}
+		convTable[variant][from] = to
+	}
+
+	bidtable := make(map[string]string, len(r.mBidtable))
+	for k, v := range r.mBidtable {
+		bidtable[k] = v
+	}
+	for _, v := range r.mConverter.mVariants {
+		// for bidirectional array
+		// fill in the missing variants, if any,
+		// with fallbacks
+		if _, ok := bidtable[v]; !ok {
+			if vf := r.getTextInBidtable(r.mConverter.GetVariantFallbacks(v)); vf != "" {
+				bidtable[v] = vf
+			}
+		}
+
+		if _, ok := bidtable[v]; ok {
+			for _, vo := range vmarked {
+				// use syntax: -{A|zh:WordZh;zh-tw:WordTw}-
+				// or -{H|zh:WordZh;zh-tw:WordTw}-
+				// or -{-|zh:WordZh;zh-tw:WordTw}-
+				// to introduce a custom mapping between
+				// words WordZh and WordTw in the whole text
+				add(v, bidtable[vo], bidtable[v])
+				add(vo, bidtable[v], bidtable[vo])
+			}
+			vmarked = append(vmarked, v)
+		}
+		// for unidirectional array fill to convert tables
+		for from, to := range r.mUnidtable[v] {
+			add(v, from, to)
+		}
+	}
+	r.mConvTable = convTable
+}
+
+/**
+ * Parse the conversion table stored in the cache.
+ *
+ * The tables should be of the form:
+ * -{A|zh-hans:转换;zh-hant:轉換}-
+ *
+ * @param string $variant Variant language code
+ */
+func (r *ConverterRule) Apply(variant string) {
+	r.parseFlags()
+	flags := r.mFlags
+
+	// convert to specified variant
+	// syntax: -{zh-hans;zh-hant[;...]|<text to convert>}-
+	if len(r.mVariantFlags) != 0 {
+		// check if current variant in flags
+		if r.mVariantFlags[variant] {
+			// then convert <text to convert> to current language
+			r.mRules = r.mConverter.AutoConvert(r.mRules, variant)
+		} else {
+			// if current variant no in flags,
+			// then we check its fallback variants.
+			for _, v := range r.mConverter.GetVariantFallbacks(variant) {
+				// if current variant's fallback exist in flags
+				if r.mVariantFlags[v] {
+					// then convert <text to convert> to fallback language
+					r.mRules = r.mConverter.AutoConvert(r.mRules, v)
+					break
+				}
+			}
+		}
+		flags = map[string]bool{"R": true}
+		r.mFlags = flags
+	}
+
+	if !flags["R"] {
+		r.mRules = strings.Replace(r.mRules, "=&gt;", "=>", -1)
+		r.parseRules()
+	}
+	rules := r.mRules
+
+	if len(r.mBidtable) == 0 && len(r.mUnidtable) == 0 {
+		if flags["+"] || flags["-"] {
+			// fill all variants if text in -{A/H/-|text}- is non-empty but without rules
+			if rules != "" {
+				for _, v := range r.mConverter.mVariants {
+					r.mBidtable[v] = rules
+				}
+			}
+		} else if !flags["N"] && !flags["T"] {
+			r.mFlags = map[string]bool{"R": true}
+			flags = r.mFlags
+		}
+	}
+
+	switch {
+	case flags["R"]:
+		// if we don't do content convert, still strip the -{}- tags
+		r.mRuleDisplay = rules
+	case flags["N"]:
+		// process N flag: output current variant name
+		ruleVar := strings.TrimSpace(rules)
+		r.mRuleDisplay = r.mConverter.GetVariantName(ruleVar)
+	case flags["D"]:
+		// process D flag: output rules description
+		r.mRuleDisplay = r.getRulesDesc()
+	case flags["H"] || flags["-"]:
+		// process H,- flag or T only: output nothing
+		r.mRuleDisplay = ""
+	case flags["S"]:
+		r.mRuleDisplay = r.getRuleConvertedStr(variant)
+	default:
+		r.mRuleDisplay = ""
+	}
+
+	// process T flag
+	if flags["T"] {
+		r.mRuleTitle = r.getRuleConvertedTitle(variant)
+	}
+
+	// process + and - flags
+	if flags["+"] {
+		r.mRulesAction = "add"
+	} else if flags["-"] {
+		r.mRulesAction = "remove"
+	}
+	if r.mRulesAction != "none" {
+		r.generateConvTable()
+	}
+}
+
+/**
+ * Get display text on markup -{...}-
+ * @return string
+ */
+func (r *ConverterRule) GetDisplay() string {
+	return r.mRuleDisplay
+}
+
+/**
+ * Get converted title.
+ * @return string
+ */
+func (r *ConverterRule) GetTitle() string {
+	return r.mRuleTitle
+}
+
+/**
+ * Return how deal with conversion rules.
+ * @return string
+ */
+func (r *ConverterRule) GetRulesAction() string {
+	return r.mRulesAction
+}
+
+/**
+ * Get conversion table. (bidirectional and unidirectional
+ * conversion table)
+ * @return array
+ */
+func (r *ConverterRule) GetConvTable() map[string]map[string]string {
+	return r.mConvTable
+}
+
+/**
+ * Get conversion rules string.
+ * @return string
+ */
+func (r *ConverterRule) GetRules() string {
+	return r.mRules
+}
+
+/**
+ * Get conversion flags.
+ * @return array
+ */
+func (r *ConverterRule) GetFlags() map[string]bool {
+	return r.mFlags
+}
@@ -5,7 +5,24 @@ package languages
 
 // IMessage includes.Message
 type IMessage interface {
-	GetRequest(string) string
+	Plain() string
+	Text() string
+	Escaped() string
 }
 
+// IWebRequest includes.WebRequest
+type IWebRequest interface {
+	GetVal(name string, defaultVal string) string
+	GetHeader(name string) string
+}
+
+// IUser user.User
+type IUser interface {
+	IsLoggedIn() bool
+	GetOption(oname string) string
+}
 
+// IHooks includes.Hooks
+type IHooks interface {
+	Run(event string, args []interface{}, deprecatedVersion string) bool
+}
@@ -6,10 +6,26 @@
 package languages
 
 import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"github.com/MangoDowner/mediawiki/globals"
 	"github.com/MangoDowner/mediawiki/includes/cache/localisation"
-	"github.com/MangoDowner/mediawiki/includes/libs/objectcache"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	"github.com/MangoDowner/mediawiki/includes/languages/data"
+	"github.com/MangoDowner/mediawiki/includes/php"
+	"html"
+	"math"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 /**
@@ -46,6 +62,211 @@ const MESSAGES_FALLBACKS = 0
  */
 const STRICT_FALLBACKS = 1
 
+/**
+ * Unicode directional formatting characters, for embedBidi()
+ */
+const (
+	lre = "\u202A" // U+202A LEFT-TO-RIGHT EMBEDDING
+	rle = "\u202B" // U+202B RIGHT-TO-LEFT EMBEDDING
+	pdf = "\u202C" // U+202C POP DIRECTIONAL FORMATTING
+)
+
+/**
+ * Directionality test regex for embedBidi(). Matches the first strong directionality codepoint:
+ * - in group 1 if it is LTR
+ * - in group 2 if it is RTL
+ * Does not match if there is no strong directionality codepoint.
+ *
+ * The form is "(?:([strong ltr codepoint])|([strong rtl codepoint]))" .
+ *
+ * Generated by UnicodeJS (see tools/strongDir) from the UCD; see
+ * https://phabricator.wikimedia.org/diffusion/GUJS/ .
+ */
+// @codeCoverageIgnoreStart
+// phpcs:ignore Generic.Files.LineLength
+var strongDirRegex = regexp.MustCompile(`(?:([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}\x{b5}\x{ba}\x{c0}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2b8}\x{2bb}-\x{2c1}\x{2d0}\x{2d1}\x{2e0}-\x{2e4}\x{2ee}\x{370}-\x{373}\x{376}\x{377}\x{37a}-\x{37d}\x{37f}\x{386}\x{388}-\x{38a}\x{38c}\x{38e}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{482}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{55f}\x{561}-\x{587}\x{589}\x{903}-\x{939}\x{93b}\x{93d}-\x{940}\x{949}-\x{94c}\x{94e}-\x{950}\x{958}-\x{961}\x{964}-\x{980}\x{982}\x{983}\x{985}-\x{98c}\x{98f}\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}\x{9b6}-\x{9b9}\x{9bd}-\x{9c0}\x{9c7}\x{9c8}\x{9cb}\x{9cc}\x{9ce}\x{9d7}\x{9dc}\x{9dd}\x{9df}-\x{9e1}\x{9e6}-\x{9f1}\x{9f4}-\x{9fa}\x{a03}\x{a05}-\x{a0a}\x{a0f}\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}\x{a33}\x{a35}\x{a36}\x{a38}\x{a39}\x{a3e}-\x{a40}\x{a59}-\x{a5c}\x{a5e}\x{a66}-\x{a6f}\x{a72}-\x{a74}\x{a83}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ac0}\x{ac9}\x{acb}\x{acc}\x{ad0}\x{ae0}\x{ae1}\x{ae6}-\x{af0}\x{af9}\x{b02}\x{b03}\x{b05}-\x{b0c}\x{b0f}\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}\x{b33}\x{b35}-\x{b39}\x{b3d}\x{b3e}\x{b40}\x{b47}\x{b48}\x{b4b}\x{b4c}\x{b57}\x{b5c}\x{b5d}\x{b5f}-\x{b61}\x{b66}-\x{b77}\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}\x{b9a}\x{b9c}\x{b9e}\x{b9f}\x{ba3}\x{ba4}\x{ba8}-\x{baa}\x{bae}-\x{bb9}\x{bbe}\x{bbf}\x{bc1}\x{bc2}\x{bc6}-\x{bc8}\x{bca}-\x{bcc}\x{bd0}\x{bd7}\x{be6}-\x{bf2}\x{c01}-\x{c03}\x{c05}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}\x{c41}-\x{c44}\x{c58}-\x{c5a}\x{c60}\x{c61}\x{c66}-\x{c6f}\x{c7f}\x{c82}\x{c83}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cc4}\x{cc6}-\x{cc8}\x{cca}\x{ccb}\x{cd5}\x{cd6}\x{cde}\x{ce0}\x{ce1}\x{ce6}-\x{cef}\x{cf1}\x{cf2}\x{d02}\x{d03}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d40}\x{d46}-\x{d48}\x{d4a}-\x{d4c}\x{d4e}\x{d57}\x{d5f}-\x{d61}\x{d66}-\x{d75}\x{d79}-\x{d7f}\x{d82}\x{d83}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}\x{dc0}-\x{dc6}\x{dcf}-\x{dd1}\x{dd8}-\x{ddf}\x{de6}-\x{def}\x{df2}-\x{df4}\x{e01}-\x{e30}\x{e32}\x{e33}\x{e40}-\x{e46}\x{e4f}-\x{e5b}\x{e81}\x{e82}\x{e84}\x{e87}\x{e88}\x{e8a}\x{e8d}\x{e94}-\x{e97}\x{e99}-\x{e9f}\x{ea1}-\x{ea3}\x{ea5}\x{ea7}\x{eaa}\x{eab}\x{ead}-\x{eb0}\x{eb2}\x{eb3}\x{ebd}\x{ec0}-\x{ec4}\x{ec6}\x{ed0}-\x{ed9}\x{edc}-\x{edf}\x{f00}-\x{f17}\x{f1a}-\x{f34}\x{f36}\x{f38}\x{f3e}-\x{f47}\x{f49}-\x{f6c}\x{f7f}\x{f85}\x{f88}-\x{f8c}\x{fbe}-\x{fc5}\x{fc7}-\x{fcc}\x{fce}-\x{fda}\x{1000}-\x{102c}\x{1031}\x{1038}\x{103b}\x{103c}\x{103f}-\x{1057}\x{105a}-\x{105d}\x{1061}-\x{1070}\x{1075}-\x{1081}\x{1083}\x{1084}\x{1087}-\x{108c}\x{108e}-\x{109c}\x{109e}-\x{10c5}\x{10c7}\x{10cd}\x{10d0}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}\x{125a}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}\x{12c2}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1360}-\x{137c}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16f8}\x{1700}-\x{170c}\x{170e}-\x{1711}\x{1720}-\x{1731}\x{1735}\x{1736}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17b6}\x{17be}-\x{17c5}\x{17c7}\x{17c8}\x{17d4}-\x{17da}\x{17dc}\x{17e0}-\x{17e9}\x{1810}-\x{1819}\x{1820}-\x{1877}\x{1880}-\x{18a8}\x{18aa}\x{18b0}-\x{18f5}\x{1900}-\x{191e}\x{1923}-\x{1926}\x{1929}-\x{192b}\x{1930}\x{1931}\x{1933}-\x{1938}\x{1946}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{19d0}-\x{19da}\x{1a00}-\x{1a16}\x{1a19}\x{1a1a}\x{1a1e}-\x{1a55}\x{1a57}\x{1a61}\x{1a63}\x{1a64}\x{1a6d}-\x{1a72}\x{1a80}-\x{1a89}\x{1a90}-\x{1a99}\x{1aa0}-\x{1aad}\x{1b04}-\x{1b33}\x{1b35}\x{1b3b}\x{1b3d}-\x{1b41}\x{1b43}-\x{1b4b}\x{1b50}-\x{1b6a}\x{1b74}-\x{1b7c}\x{1b82}-\x{1ba1}\x{1ba6}\x{1ba7}\x{1baa}\x{1bae}-\x{1be5}\x{1be7}\x{1bea}-\x{1bec}\x{1bee}\x{1bf2}\x{1bf3}\x{1bfc}-\x{1c2b}\x{1c34}\x{1c35}\x{1c3b}-\x{1c49}\x{1c4d}-\x{1c7f}\x{1cc0}-\x{1cc7}\x{1cd3}\x{1ce1}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}\x{1cf6}\x{1d00}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}\x{1f5b}\x{1f5d}\x{1f5f}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}\x{1fc2}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{200e}\x{2071}\x{207f}\x{2090}-\x{209c}\x{2102}\x{2107}\x{210a}-\x{2113}\x{2115}\x{2119}-\x{211d}\x{2124}\x{2126}\x{2128}\x{212a}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}\x{214f}\x{2160}-\x{2188}\x{2336}-\x{237a}\x{2395}\x{249c}-\x{24e9}\x{26ac}\x{2800}-\x{28ff}\x{2c00}-\x{2c2e}\x{2c30}-\x{2c5e}\x{2c60}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}\x{2d70}\x{2d80}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{3005}-\x{3007}\x{3021}-\x{3029}\x{302e}\x{302f}\x{3031}-\x{3035}\x{3038}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312d}\x{3131}-\x{318e}\x{3190}-\x{31ba}\x{31f0}-\x{321c}\x{3220}-\x{324f}\x{3260}-\x{327b}\x{327f}-\x{32b0}\x{32c0}-\x{32cb}\x{32d0}-\x{32fe}\x{3300}-\x{3376}\x{337b}-\x{33dd}\x{33e0}-\x{33fe}\x{3400}-\x{4db5}\x{4e00}-\x{9fd5}\x{a000}-\x{a48c}\x{a4d0}-\x{a60c}\x{a610}-\x{a62b}\x{a640}-\x{a66e}\x{a680}-\x{a69d}\x{a6a0}-\x{a6ef}\x{a6f2}-\x{a6f7}\x{a722}-\x{a787}\x{a789}-\x{a7ad}\x{a7b0}-\x{a7b7}\x{a7f7}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a824}\x{a827}\x{a830}-\x{a837}\x{a840}-\x{a873}\x{a880}-\x{a8c3}\x{a8ce}-\x{a8d9}\x{a8f2}-\x{a8fd}\x{a900}-\x{a925}\x{a92e}-\x{a946}\x{a952}\x{a953}\x{a95f}-\x{a97c}\x{a983}-\x{a9b2}\x{a9b4}\x{a9b5}\x{a9ba}\x{a9bb}\x{a9bd}-\x{a9cd}\x{a9cf}-\x{a9d9}\x{a9de}-\x{a9e4}\x{a9e6}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa2f}\x{aa30}\x{aa33}\x{aa34}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa4d}\x{aa50}-\x{aa59}\x{aa5c}-\x{aa7b}\x{aa7d}-\x{aaaf}\x{aab1}\x{aab5}\x{aab6}\x{aab9}-\x{aabd}\x{aac0}\x{aac2}\x{aadb}-\x{aaeb}\x{aaee}-\x{aaf5}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab65}\x{ab70}-\x{abe4}\x{abe6}\x{abe7}\x{abe9}-\x{abec}\x{abf0}-\x{abf9}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{e000}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10100}\x{10102}\x{10107}-\x{10133}\x{10137}-\x{1013f}\x{101d0}-\x{101fc}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{10323}\x{10330}-\x{1034a}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{1039f}-\x{103c3}\x{103c8}-\x{103d5}\x{10400}-\x{1049d}\x{104a0}-\x{104a9}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{1056f}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{11000}\x{11002}-\x{11037}\x{11047}-\x{1104d}\x{11066}-\x{1106f}\x{11082}-\x{110b2}\x{110b7}\x{110b8}\x{110bb}-\x{110c1}\x{110d0}-\x{110e8}\x{110f0}-\x{110f9}\x{11103}-\x{11126}\x{1112c}\x{11136}-\x{11143}\x{11150}-\x{11172}\x{11174}-\x{11176}\x{11182}-\x{111b5}\x{111bf}-\x{111c9}\x{111cd}\x{111d0}-\x{111df}\x{111e1}-\x{111f4}\x{11200}-\x{11211}\x{11213}-\x{1122e}\x{11232}\x{11233}\x{11235}\x{11238}-\x{1123d}\x{11280}-\x{11286}\x{11288}\x{1128a}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a9}\x{112b0}-\x{112de}\x{112e0}-\x{112e2}\x{112f0}-\x{112f9}\x{11302}\x{11303}\x{11305}-\x{1130c}\x{1130f}\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{1133f}\x{11341}-\x{11344}\x{11347}\x{11348}\x{1134b}-\x{1134d}\x{11350}\x{11357}\x{1135d}-\x{11363}\x{11480}-\x{114b2}\x{114b9}\x{114bb}-\x{114be}\x{114c1}\x{114c4}-\x{114c7}\x{114d0}-\x{114d9}\x{11580}-\x{115b1}\x{115b8}-\x{115bb}\x{115be}\x{115c1}-\x{115db}\x{11600}-\x{11632}\x{1163b}\x{1163c}\x{1163e}\x{11641}-\x{11644}\x{11650}-\x{11659}\x{11680}-\x{116aa}\x{116ac}\x{116ae}\x{116af}\x{116b6}\x{116c0}-\x{116c9}\x{11700}-\x{11719}\x{11720}\x{11721}\x{11726}\x{11730}-\x{1173f}\x{118a0}-\x{118f2}\x{118ff}\x{11ac0}-\x{11af8}\x{12000}-\x{12399}\x{12400}-\x{1246e}\x{12470}-\x{12474}\x{12480}-\x{12543}\x{13000}-\x{1342e}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a60}-\x{16a69}\x{16a6e}\x{16a6f}\x{16ad0}-\x{16aed}\x{16af5}\x{16b00}-\x{16b2f}\x{16b37}-\x{16b45}\x{16b50}-\x{16b59}\x{16b5b}-\x{16b61}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16f00}-\x{16f44}\x{16f50}-\x{16f7e}\x{16f93}-\x{16f9f}\x{1b000}\x{1b001}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1bc9c}\x{1bc9f}\x{1d000}-\x{1d0f5}\x{1d100}-\x{1d126}\x{1d129}-\x{1d166}\x{1d16a}-\x{1d172}\x{1d183}\x{1d184}\x{1d18c}-\x{1d1a9}\x{1d1ae}-\x{1d1e8}\x{1d360}-\x{1d371}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}\x{1d49f}\x{1d4a2}\x{1d4a5}\x{1d4a6}\x{1d4a9}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}\x{1d4bd}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}\x{1d54a}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6da}\x{1d6dc}-\x{1d714}\x{1d716}-\x{1d74e}\x{1d750}-\x{1d788}\x{1d78a}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1d800}-\x{1d9ff}\x{1da37}-\x{1da3a}\x{1da6d}-\x{1da74}\x{1da76}-\x{1da83}\x{1da85}-\x{1da8b}\x{1f110}-\x{1f12e}\x{1f130}-\x{1f169}\x{1f170}-\x{1f19a}\x{1f1e6}-\x{1f202}\x{1f210}-\x{1f23a}\x{1f240}-\x{1f248}\x{1f250}\x{1f251}\x{20000}-\x{2a6d6}\x{2a700}-\x{2b734}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2f800}-\x{2fa1d}\x{f0000}-\x{ffffd}\x{100000}-\x{10fffd}])|([\x{590}\x{5be}\x{5c0}\x{5c3}\x{5c6}\x{5c8}-\x{5ff}\x{7c0}-\x{7ea}\x{7f4}\x{7f5}\x{7fa}-\x{815}\x{81a}\x{824}\x{828}\x{82e}-\x{858}\x{85c}-\x{89f}\x{200f}\x{fb1d}\x{fb1f}-\x{fb28}\x{fb2a}-\x{fb4f}\x{10800}-\x{1091e}\x{10920}-\x{10a00}\x{10a04}\x{10a07}-\x{10a0b}\x{10a10}-\x{10a37}\x{10a3b}-\x{10a3e}\x{10a40}-\x{10ae4}\x{10ae7}-\x{10b38}\x{10b40}-\x{10e5f}\x{10e7f}-\x{10fff}\x{1e800}-\x{1e8cf}\x{1e8d7}-\x{1edff}\x{1ef00}-\x{1efff}\x{608}\x{60b}\x{60d}\x{61b}-\x{64a}\x{66d}-\x{66f}\x{671}-\x{6d5}\x{6e5}\x{6e6}\x{6ee}\x{6ef}\x{6fa}-\x{710}\x{712}-\x{72f}\x{74b}-\x{7a5}\x{7b1}-\x{7bf}\x{8a0}-\x{8e2}\x{fb50}-\x{fd3d}\x{fd40}-\x{fdcf}\x{fdf0}-\x{fdfc}\x{fdfe}\x{fdff}\x{fe70}-\x{fefe}\x{1ee00}-\x{1eeef}\x{1eef2}-\x{1eeff}]))`)
+// @codeCoverageIgnoreEnd
+
+var (
+	/**
+	 * Cache of Language objects created by factory(), keyed by code
+	 */
+	languageObjectCache = make(map[string]*Language)
+	languageObjectCacheMutex sync.Mutex
+
+	/**
+	 * Grammar transformation rules, languages/data/grammarTransformations/<code>.json
+	 */
+	//go:embed data/grammarTransformations/*.json
+	grammarTransformationsFS embed.FS
+
+	/**
+	 * Languages written with the Turkic dotted and dotless I, where
+	 * i <=> İ and ı <=> I (LanguageTr, LanguageAz, LanguageKaa)
+	 */
+	turkicCaseLanguages = []string{"tr", "az", "kaa"}
+
+	/**
+	 * Canonical namespace names, used when a language has no localised ones
+	 */
+	canonicalNamespaceNames = map[int]string{
+		consts.NS_MEDIA:          "Media",
+		consts.NS_SPECIAL:        "Special",
+		consts.NS_MAIN:           "",
+		consts.NS_TALK:           "Talk",
+		consts.NS_USER:           "User",
+		consts.NS_USER_TALK:      "User_talk",
+		consts.NS_PROJECT:        "Project",
+		consts.NS_PROJECT_TALK:   "Project_talk",
+		consts.NS_FILE:           "File",
+		consts.NS_FILE_TALK:      "File_talk",
+		consts.NS_MEDIAWIKI:      "MediaWiki",
+		consts.NS_MEDIAWIKI_TALK: "MediaWiki_talk",
+		consts.NS_TEMPLATE:       "Template",
+		consts.NS_TEMPLATE_TALK:  "Template_talk",
+		consts.NS_HELP:           "Help",
+		consts.NS_HELP_TALK:      "Help_talk",
+		consts.NS_CATEGORY:       "Category",
+		consts.NS_CATEGORY_TALK:  "Category_talk",
+	}
+
+	/**
+	 * Gendered names of the user namespaces ($namespaceGenderAliases in
+	 * Messages*.php), keyed by language code
+	 */
+	namespaceGenderAliases = map[string]map[int]map[string]string{
+		"de": {
+			consts.NS_USER:      {"male": "Benutzer", "female": "Benutzerin"},
+			consts.NS_USER_TALK: {"male": "Benutzer_Diskussion", "female": "Benutzerin_Diskussion"},
+		},
+		"pl": {
+			consts.NS_USER:      {"male": "Użytkownik", "female": "Użytkowniczka"},
+			consts.NS_USER_TALK: {"male": "Dyskusja_użytkownika", "female": "Dyskusja_użytkowniczki"},
+		},
+		"ru": {
+			consts.NS_USER:      {"male": "Участник", "female": "Участница"},
+			consts.NS_USER_TALK: {"male": "Обсуждение_участника", "female": "Обсуждение_участницы"},
+		},
+	}
+
+	grammarReplacementRegex = regexp.MustCompile(`\$(\d+)`)
+
+	/**
+	 * Compiled regexes of the grammar transformations, keyed by pattern
+	 * @var sync.Map Map of (string => *regexp.Regexp)
+	 */
+	grammarRegexCache sync.Map
+
+	/**
+	 * Cache for language names, keyed by "<inLanguage>:<include>"
+	 */
+	languageNameCache = make(map[string]map[string]string)
+	languageNameCacheMutex sync.Mutex
+
+	/**
+	 * Cache of results of isValidCode()
+	 */
+	validCodeCache sync.Map
+
+	/**
+	 * Matches titles which are invalid, MediaWikiTitleCodec::getTitleInvalidRegex()
+	 */
+	titleInvalidRegex = regexp.MustCompile(
+		// Any character not allowed is forbidden...
+		`[^ %!"$&'()*,\-./0-9:;=?@A-Z\\^_` + "`" + `a-z~\x{80}-\x{10FFFF}+]` +
+			// URL percent encoding sequences interfere with the ability
+			// to round-trip titles -- you can't link to them consistently.
+			`|%[0-9A-Fa-f]{2}` +
+			// XML/HTML character references produce similar issues.
+			`|&[A-Za-z0-9\x{80}-\x{10FFFF}]+;` +
+			`|&#[0-9]+;` +
+			`|&#x[0-9A-Fa-f]+;`)
+
+	/**
+	 * Directory of the JSON message files, $IP/languages/i18n
+	 */
+	MessagesDir = "languages/i18n"
+
+	/**
+	 * Runs hooks such as LanguageGetTranslatedLanguageNames. Set by the
+	 * includes package, which this package cannot import.
+	 */
+	HookRunner IHooks
+
+	/**
+	 * Builds a message in the given language,
+	 * wfMessage( $key, $params )->inLanguage( $lang ). Set by the includes
+	 * package, which this package cannot import.
+	 */
+	MessageFactory func(lang *Language, key string, params ...interface{}) IMessage
+
+	/**
+	 * Names of the duration intervals, from the longest to the shortest
+	 */
+	durationIntervalNames = []string{
+		"millennia", "centuries", "decades", "years", "weeks", "days",
+		"hours", "minutes", "seconds",
+	}
+
+	/**
+	 * $separatorTransformTable of the Messages*.php files, for the languages
+	 * which do not use the English separators
+	 */
+	separatorTransformTables = map[string]map[string]string{
+		"de": {",": ".", ".": ","},
+		"fr": {",": "\u00A0", ".": ","},
+		"nl": {",": ".", ".": ","},
+		"pt": {",": "\u00A0", ".": ","},
+		"ru": {",": "\u00A0", ".": ","},
+		"uk": {",": "\u00A0", ".": ","},
+	}
+
+	/**
+	 * HTML elements which have no end tag, see Html::$voidElements
+	 */
+	voidElements = []string{
+		"area", "base", "br", "col", "embed", "hr", "img", "input", "keygen",
+		"link", "meta", "param", "source", "track", "wbr",
+	}
+
+	/**
+	 * Matches a number commafy() can group, with the sign and the decimals
+	 * captured separately
+	 */
+	commafyRegex = regexp.MustCompile(`^([-+]?)(\d+)(\.\d*)?$`)
+
+	/**
+	 * Matches an HTML entity at the start of a string, see truncateHtml()
+	 */
+	htmlEntityRegex = regexp.MustCompile(`^&(?:[A-Za-z0-9]+|#[0-9]+|#[xX][0-9A-Fa-f]+);`)
+
+	/**
+	 * Matches an explicit plural form such as "0=none"
+	 */
+	explicitPluralFormRegex = regexp.MustCompile(`\d+=`)
+
+	/**
+	 * Languages written right to left ($rtl = true in Messages*.php)
+	 */
+	rtlLanguages = []string{
+		"ar", "arc", "arz", "azb", "bcc", "bgn", "bqi", "ckb", "dv", "fa",
+		"glk", "he", "khw", "kk-arab", "kk-cn", "ks-arab", "ku-arab", "lki",
+		"lrc", "luz", "mzn", "pnb", "ps", "sd", "sdh", "skr-arab", "ug-arab",
+		"ur", "yi",
+	}
+
+	/**
+	 * Windows-1252 code points of bytes 0x80-0x9F, which differ from ISO-8859-1
+	 */
+	windows1252 = map[byte]rune{
+		0x80: '€', 0x82: '‚', 0x83: 'ƒ', 0x84: '„', 0x85: '…', 0x86: '†',
+		0x87: '‡', 0x88: 'ˆ', 0x89: '‰', 0x8A: 'Š', 0x8B: '‹', 0x8C: 'Œ',
+		0x8E: 'Ž', 0x91: '‘', 0x92: '’', 0x93: '“', 0x94: '”', 0x95: '•',
+		0x96: '–', 0x97: '—', 0x98: '˜', 0x99: '™', 0x9A: 'š', 0x9B: '›',
+		0x9C: 'œ', 0x9E: 'ž', 0x9F: 'Ÿ',
+	}
+)
+
 /**
  * Internationalisation code
  * @ingroup Language
@@ -54,12 +275,14 @@ type Language struct {
 	/**
 	 * @var LanguageConverter
 	 */
-	MConverter interface{}
+	MConverter *LanguageConverter
 
-	mVariants, mCode, mLoaded bool
+	mVariants []string
+	mCode string
+	mLoaded bool
 	MMagicExtensions []string
 	MMagicHookDone bool
-	mHtmlCode bool
+	mHtmlCode string
 	mParentLanguage bool
 
 	DateFormatStrings []string
@@ -116,42 +339,18 @@ type Language struct {
 	 * Cache for grammar rules data
 	 * @var MapCacheLRU|null
 	 */
-	grammarTransformations []string
-
-	/**
-	 * Cache for language names
-	 * @var HashBagOStuff|null
-	 */
-	languageNameCache interface{}
+	grammarTransformations map[string][][2]string
+	grammarTransformationsOnce sync.Once
 
-	/**
-	 * Unicode directional formatting characters, for embedBidi()
-	 */
-	lre string// U+202A LEFT-TO-RIGHT EMBEDDING
-	rle string // U+202B RIGHT-TO-LEFT EMBEDDING
-	pdf string // U+202C POP DIRECTIONAL FORMATTING
 
-	/**
-	 * Directionality test regex for embedBidi(). Matches the first strong directionality codepoint:
-	 * - in group 1 if it is LTR
-	 * - in group 2 if it is RTL
-	 * Does not match if there is no strong directionality codepoint.
-	 *
-	 * The form is "/(?:([strong ltr codepoint])|([strong rtl codepoint]))/u" .
-	 *
-	 * Generated by UnicodeJS (see tools/strongDir) from the UCD; see
-	 * https://phabricator.wikimedia.org/diffusion/GUJS/ .
-	 */
-	// @codeCoverageIgnoreStart
-	// phpcs:ignore Generic.Files.LineLength
-	strongDirRegex string
-	//@codeCoverageIgnoreEnd
 
 }
 
 
 func NewLanguage() *Language {
 	this := new(Language)
+	this.mCode = "en"
+	this.mHtmlCode = "en"
 	this.MWeekdayMsgs = []string{
 		"sunday", "monday", "tuesday", "wednesday", "thursday",
 		"friday", "saturday",
@@ -198,13 +397,208 @@ func NewLanguage() *Language {
 		"minutes" : 60,
 		"seconds" : 1,
 	}
-	this.lre = `\u{202A}` // U+202A LEFT-TO-RIGHT EMBEDDING
-	this.rle = `\u{202B}` // U+202B RIGHT-TO-LEFT EMBEDDING
-	this.pdf = `\u{202C}` // U+202C POP DIRECTIONAL FORMATTING
-	this.strongDirRegex = `/(?:([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}\x{b5}\x{ba}\x{c0}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2b8}\x{2bb}-\x{2c1}\x{2d0}\x{2d1}\x{2e0}-\x{2e4}\x{2ee}\x{370}-\x{373}\x{376}\x{377}\x{37a}-\x{37d}\x{37f}\x{386}\x{388}-\x{38a}\x{38c}\x{38e}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{482}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{55f}\x{561}-\x{587}\x{589}\x{903}-\x{939}\x{93b}\x{93d}-\x{940}\x{949}-\x{94c}\x{94e}-\x{950}\x{958}-\x{961}\x{964}-\x{980}\x{982}\x{983}\x{985}-\x{98c}\x{98f}\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}\x{9b6}-\x{9b9}\x{9bd}-\x{9c0}\x{9c7}\x{9c8}\x{9cb}\x{9cc}\x{9ce}\x{9d7}\x{9dc}\x{9dd}\x{9df}-\x{9e1}\x{9e6}-\x{9f1}\x{9f4}-\x{9fa}\x{a03}\x{a05}-\x{a0a}\x{a0f}\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}\x{a33}\x{a35}\x{a36}\x{a38}\x{a39}\x{a3e}-\x{a40}\x{a59}-\x{a5c}\x{a5e}\x{a66}-\x{a6f}\x{a72}-\x{a74}\x{a83}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ac0}\x{ac9}\x{acb}\x{acc}\x{ad0}\x{ae0}\x{ae1}\x{ae6}-\x{af0}\x{af9}\x{b02}\x{b03}\x{b05}-\x{b0c}\x{b0f}\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}\x{b33}\x{b35}-\x{b39}\x{b3d}\x{b3e}\x{b40}\x{b47}\x{b48}\x{b4b}\x{b4c}\x{b57}\x{b5c}\x{b5d}\x{b5f}-\x{b61}\x{b66}-\x{b77}\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}\x{b9a}\x{b9c}\x{b9e}\x{b9f}\x{ba3}\x{ba4}\x{ba8}-\x{baa}\x{bae}-\x{bb9}\x{bbe}\x{bbf}\x{bc1}\x{bc2}\x{bc6}-\x{bc8}\x{bca}-\x{bcc}\x{bd0}\x{bd7}\x{be6}-\x{bf2}\x{c01}-\x{c03}\x{c05}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}\x{c41}-\x{c44}\x{c58}-\x{c5a}\x{c60}\x{c61}\x{c66}-\x{c6f}\x{c7f}\x{c82}\x{c83}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cc4}\x{cc6}-\x{cc8}\x{cca}\x{ccb}\x{cd5}\x{cd6}\x{cde}\x{ce0}\x{ce1}\x{ce6}-\x{cef}\x{cf1}\x{cf2}\x{d02}\x{d03}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d40}\x{d46}-\x{d48}\x{d4a}-\x{d4c}\x{d4e}\x{d57}\x{d5f}-\x{d61}\x{d66}-\x{d75}\x{d79}-\x{d7f}\x{d82}\x{d83}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}\x{dc0}-\x{dc6}\x{dcf}-\x{dd1}\x{dd8}-\x{ddf}\x{de6}-\x{def}\x{df2}-\x{df4}\x{e01}-\x{e30}\x{e32}\x{e33}\x{e40}-\x{e46}\x{e4f}-\x{e5b}\x{e81}\x{e82}\x{e84}\x{e87}\x{e88}\x{e8a}\x{e8d}\x{e94}-\x{e97}\x{e99}-\x{e9f}\x{ea1}-\x{ea3}\x{ea5}\x{ea7}\x{eaa}\x{eab}\x{ead}-\x{eb0}\x{eb2}\x{eb3}\x{ebd}\x{ec0}-\x{ec4}\x{ec6}\x{ed0}-\x{ed9}\x{edc}-\x{edf}\x{f00}-\x{f17}\x{f1a}-\x{f34}\x{f36}\x{f38}\x{f3e}-\x{f47}\x{f49}-\x{f6c}\x{f7f}\x{f85}\x{f88}-\x{f8c}\x{fbe}-\x{fc5}\x{fc7}-\x{fcc}\x{fce}-\x{fda}\x{1000}-\x{102c}\x{1031}\x{1038}\x{103b}\x{103c}\x{103f}-\x{1057}\x{105a}-\x{105d}\x{1061}-\x{1070}\x{1075}-\x{1081}\x{1083}\x{1084}\x{1087}-\x{108c}\x{108e}-\x{109c}\x{109e}-\x{10c5}\x{10c7}\x{10cd}\x{10d0}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}\x{125a}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}\x{12c2}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1360}-\x{137c}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16f8}\x{1700}-\x{170c}\x{170e}-\x{1711}\x{1720}-\x{1731}\x{1735}\x{1736}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17b6}\x{17be}-\x{17c5}\x{17c7}\x{17c8}\x{17d4}-\x{17da}\x{17dc}\x{17e0}-\x{17e9}\x{1810}-\x{1819}\x{1820}-\x{1877}\x{1880}-\x{18a8}\x{18aa}\x{18b0}-\x{18f5}\x{1900}-\x{191e}\x{1923}-\x{1926}\x{1929}-\x{192b}\x{1930}\x{1931}\x{1933}-\x{1938}\x{1946}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{19d0}-\x{19da}\x{1a00}-\x{1a16}\x{1a19}\x{1a1a}\x{1a1e}-\x{1a55}\x{1a57}\x{1a61}\x{1a63}\x{1a64}\x{1a6d}-\x{1a72}\x{1a80}-\x{1a89}\x{1a90}-\x{1a99}\x{1aa0}-\x{1aad}\x{1b04}-\x{1b33}\x{1b35}\x{1b3b}\x{1b3d}-\x{1b41}\x{1b43}-\x{1b4b}\x{1b50}-\x{1b6a}\x{1b74}-\x{1b7c}\x{1b82}-\x{1ba1}\x{1ba6}\x{1ba7}\x{1baa}\x{1bae}-\x{1be5}\x{1be7}\x{1bea}-\x{1bec}\x{1bee}\x{1bf2}\x{1bf3}\x{1bfc}-\x{1c2b}\x{1c34}\x{1c35}\x{1c3b}-\x{1c49}\x{1c4d}-\x{1c7f}\x{1cc0}-\x{1cc7}\x{1cd3}\x{1ce1}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}\x{1cf6}\x{1d00}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}\x{1f5b}\x{1f5d}\x{1f5f}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}\x{1fc2}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{200e}\x{2071}\x{207f}\x{2090}-\x{209c}\x{2102}\x{2107}\x{210a}-\x{2113}\x{2115}\x{2119}-\x{211d}\x{2124}\x{2126}\x{2128}\x{212a}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}\x{214f}\x{2160}-\x{2188}\x{2336}-\x{237a}\x{2395}\x{249c}-\x{24e9}\x{26ac}\x{2800}-\x{28ff}\x{2c00}-\x{2c2e}\x{2c30}-\x{2c5e}\x{2c60}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}\x{2d70}\x{2d80}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{3005}-\x{3007}\x{3021}-\x{3029}\x{302e}\x{302f}\x{3031}-\x{3035}\x{3038}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312d}\x{3131}-\x{318e}\x{3190}-\x{31ba}\x{31f0}-\x{321c}\x{3220}-\x{324f}\x{3260}-\x{327b}\x{327f}-\x{32b0}\x{32c0}-\x{32cb}\x{32d0}-\x{32fe}\x{3300}-\x{3376}\x{337b}-\x{33dd}\x{33e0}-\x{33fe}\x{3400}-\x{4db5}\x{4e00}-\x{9fd5}\x{a000}-\x{a48c}\x{a4d0}-\x{a60c}\x{a610}-\x{a62b}\x{a640}-\x{a66e}\x{a680}-\x{a69d}\x{a6a0}-\x{a6ef}\x{a6f2}-\x{a6f7}\x{a722}-\x{a787}\x{a789}-\x{a7ad}\x{a7b0}-\x{a7b7}\x{a7f7}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a824}\x{a827}\x{a830}-\x{a837}\x{a840}-\x{a873}\x{a880}-\x{a8c3}\x{a8ce}-\x{a8d9}\x{a8f2}-\x{a8fd}\x{a900}-\x{a925}\x{a92e}-\x{a946}\x{a952}\x{a953}\x{a95f}-\x{a97c}\x{a983}-\x{a9b2}\x{a9b4}\x{a9b5}\x{a9ba}\x{a9bb}\x{a9bd}-\x{a9cd}\x{a9cf}-\x{a9d9}\x{a9de}-\x{a9e4}\x{a9e6}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa2f}\x{aa30}\x{aa33}\x{aa34}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa4d}\x{aa50}-\x{aa59}\x{aa5c}-\x{aa7b}\x{aa7d}-\x{aaaf}\x{aab1}\x{aab5}\x{aab6}\x{aab9}-\x{aabd}\x{aac0}\x{aac2}\x{aadb}-\x{aaeb}\x{aaee}-\x{aaf5}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab65}\x{ab70}-\x{abe4}\x{abe6}\x{abe7}\x{abe9}-\x{abec}\x{abf0}-\x{abf9}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{e000}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10100}\x{10102}\x{10107}-\x{10133}\x{10137}-\x{1013f}\x{101d0}-\x{101fc}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{10323}\x{10330}-\x{1034a}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{1039f}-\x{103c3}\x{103c8}-\x{103d5}\x{10400}-\x{1049d}\x{104a0}-\x{104a9}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{1056f}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{11000}\x{11002}-\x{11037}\x{11047}-\x{1104d}\x{11066}-\x{1106f}\x{11082}-\x{110b2}\x{110b7}\x{110b8}\x{110bb}-\x{110c1}\x{110d0}-\x{110e8}\x{110f0}-\x{110f9}\x{11103}-\x{11126}\x{1112c}\x{11136}-\x{11143}\x{11150}-\x{11172}\x{11174}-\x{11176}\x{11182}-\x{111b5}\x{111bf}-\x{111c9}\x{111cd}\x{111d0}-\x{111df}\x{111e1}-\x{111f4}\x{11200}-\x{11211}\x{11213}-\x{1122e}\x{11232}\x{11233}\x{11235}\x{11238}-\x{1123d}\x{11280}-\x{11286}\x{11288}\x{1128a}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a9}\x{112b0}-\x{112de}\x{112e0}-\x{112e2}\x{112f0}-\x{112f9}\x{11302}\x{11303}\x{11305}-\x{1130c}\x{1130f}\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{1133f}\x{11341}-\x{11344}\x{11347}\x{11348}\x{1134b}-\x{1134d}\x{11350}\x{11357}\x{1135d}-\x{11363}\x{11480}-\x{114b2}\x{114b9}\x{114bb}-\x{114be}\x{114c1}\x{114c4}-\x{114c7}\x{114d0}-\x{114d9}\x{11580}-\x{115b1}\x{115b8}-\x{115bb}\x{115be}\x{115c1}-\x{115db}\x{11600}-\x{11632}\x{1163b}\x{1163c}\x{1163e}\x{11641}-\x{11644}\x{11650}-\x{11659}\x{11680}-\x{116aa}\x{116ac}\x{116ae}\x{116af}\x{116b6}\x{116c0}-\x{116c9}\x{11700}-\x{11719}\x{11720}\x{11721}\x{11726}\x{11730}-\x{1173f}\x{118a0}-\x{118f2}\x{118ff}\x{11ac0}-\x{11af8}\x{12000}-\x{12399}\x{12400}-\x{1246e}\x{12470}-\x{12474}\x{12480}-\x{12543}\x{13000}-\x{1342e}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a60}-\x{16a69}\x{16a6e}\x{16a6f}\x{16ad0}-\x{16aed}\x{16af5}\x{16b00}-\x{16b2f}\x{16b37}-\x{16b45}\x{16b50}-\x{16b59}\x{16b5b}-\x{16b61}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16f00}-\x{16f44}\x{16f50}-\x{16f7e}\x{16f93}-\x{16f9f}\x{1b000}\x{1b001}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1bc9c}\x{1bc9f}\x{1d000}-\x{1d0f5}\x{1d100}-\x{1d126}\x{1d129}-\x{1d166}\x{1d16a}-\x{1d172}\x{1d183}\x{1d184}\x{1d18c}-\x{1d1a9}\x{1d1ae}-\x{1d1e8}\x{1d360}-\x{1d371}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}\x{1d49f}\x{1d4a2}\x{1d4a5}\x{1d4a6}\x{1d4a9}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}\x{1d4bd}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}\x{1d54a}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6da}\x{1d6dc}-\x{1d714}\x{1d716}-\x{1d74e}\x{1d750}-\x{1d788}\x{1d78a}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1d800}-\x{1d9ff}\x{1da37}-\x{1da3a}\x{1da6d}-\x{1da74}\x{1da76}-\x{1da83}\x{1da85}-\x{1da8b}\x{1f110}-\x{1f12e}\x{1f130}-\x{1f169}\x{1f170}-\x{1f19a}\x{1f1e6}-\x{1f202}\x{1f210}-\x{1f23a}\x{1f240}-\x{1f248}\x{1f250}\x{1f251}\x{20000}-\x{2a6d6}\x{2a700}-\x{2b734}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2f800}-\x{2fa1d}\x{f0000}-\x{ffffd}\x{100000}-\x{10fffd}])|([\x{590}\x{5be}\x{5c0}\x{5c3}\x{5c6}\x{5c8}-\x{5ff}\x{7c0}-\x{7ea}\x{7f4}\x{7f5}\x{7fa}-\x{815}\x{81a}\x{824}\x{828}\x{82e}-\x{858}\x{85c}-\x{89f}\x{200f}\x{fb1d}\x{fb1f}-\x{fb28}\x{fb2a}-\x{fb4f}\x{10800}-\x{1091e}\x{10920}-\x{10a00}\x{10a04}\x{10a07}-\x{10a0b}\x{10a10}-\x{10a37}\x{10a3b}-\x{10a3e}\x{10a40}-\x{10ae4}\x{10ae7}-\x{10b38}\x{10b40}-\x{10e5f}\x{10e7f}-\x{10fff}\x{1e800}-\x{1e8cf}\x{1e8d7}-\x{1edff}\x{1ef00}-\x{1efff}\x{608}\x{60b}\x{60d}\x{61b}-\x{64a}\x{66d}-\x{66f}\x{671}-\x{6d5}\x{6e5}\x{6e6}\x{6ee}\x{6ef}\x{6fa}-\x{710}\x{712}-\x{72f}\x{74b}-\x{7a5}\x{7b1}-\x{7bf}\x{8a0}-\x{8e2}\x{fb50}-\x{fd3d}\x{fd40}-\x{fdcf}\x{fdf0}-\x{fdfc}\x{fdfe}\x{fdff}\x{fe70}-\x{fefe}\x{1ee00}-\x{1eeef}\x{1eef2}-\x{1eeff}]))/u`
 	return this
 }
 
+/**
+ * Get a cached or new language object for a given language code
+ * @param string $code
+ * @return Language
+ */
+func Factory(code string) *Language {
+	languageObjectCacheMutex.Lock()
+	defer languageObjectCacheMutex.Unlock()
+	if lang, ok := languageObjectCache[code]; ok {
+		return lang
+	}
+	lang := newFromCode(code)
+	languageObjectCache[code] = lang
+	return lang
+}
+
+/**
+ * Create a language object for a given language code
+ * @param string $code
+ * @return Language
+ */
+func newFromCode(code string) *Language {
+	lang := NewLanguage()
+	lang.mCode = code
+	lang.mHtmlCode = new(LanguageCode).Bcp47(code)
+	switch code {
+	case "zh":
+		lang.MConverter = NewZhConverter(lang)
+	case "sr":
+		lang.MConverter = NewSrConverter(lang)
+	default:
+		lang.MConverter = NewFakeConverter(lang)
+	}
+	lang.mVariants = lang.MConverter.GetVariants()
+	return lang
+}
+
+/**
+ * Get the internal language code for this language object
+ *
+ * NOTE: The return value of this function is NOT HTML-safe and must be escaped with
+ * htmlspecialchars() or similar
+ *
+ * @return string
+ */
+func (l *Language) GetCode() string {
+	return l.mCode
+}
+
+/**
+ * Get the code in BCP 47 format which we can use
+ * inside of html lang="" tags.
+ *
+ * NOTE: The return value of this function is NOT HTML-safe and must be escaped with
+ * htmlspecialchars() or similar.
+ *
+ * @since 1.19
+ * @return string
+ */
+func (l *Language) GetHtmlCode() string {
+	if l.mHtmlCode == "" {
+		return new(LanguageCode).Bcp47(l.mCode)
+	}
+	return l.mHtmlCode
+}
+
+/**
+ * Return the LanguageConverter used in the Language
+ *
+ * @since 1.19
+ * @return LanguageConverter
+ */
+func (l *Language) GetConverter() *LanguageConverter {
+	if l.MConverter == nil {
+		l.MConverter = NewFakeConverter(l)
+	}
+	return l.MConverter
+}
+
+/**
+ * convert text to a variant
+ *
+ * @param string $text text to convert
+ * @param string|bool $variant variant to convert to, or "" to use the user's preferred
+ *      variant (if logged in), or the project default variant
+ * @return string the converted string
+ */
+func (l *Language) AutoConvert(text, variant string) string {
+	return l.GetConverter().AutoConvert(text, variant)
+}
+
+/**
+ * convert text to all supported variants
+ *
+ * @param string $text
+ * @return array
+ */
+func (l *Language) AutoConvertToAllVariants(text string) map[string]string {
+	return l.GetConverter().AutoConvertToAllVariants(text)
+}
+
+/**
+ * convert text to a variant, parsing -{ }- conversion markup
+ *
+ * @param string $text
+ * @param string $variant
+ * @return string
+ */
+func (l *Language) ConvertTo(text, variant string) string {
+	return l.GetConverter().ConvertTo(text, variant)
+}
+
+/**
+ * convert text to the variant preferred by the request and user
+ *
+ * @param string $text
+ * @param WebRequest|null $request
+ * @param User|null $user
+ * @return string
+ */
+func (l *Language) Convert(text string, request IWebRequest, user IUser) string {
+	return l.GetConverter().Convert(text, request, user)
+}
+
+/**
+ * Convert a page title to the given variant
+ *
+ * @param string $nsText
+ * @param string $text
+ * @param string $variant
+ * @return string Converted title text
+ */
+func (l *Language) ConvertTitle(nsText, text, variant string) string {
+	return l.GetConverter().ConvertTitle(nsText, text, variant)
+}
+
+/**
+ * Check if this is a language with variants
+ *
+ * @since 1.19
+ * @return bool
+ */
+func (l *Language) HasVariants() bool {
+	return len(l.GetVariants()) > 1
+}
+
+/**
+ * Check if the language has the specific variant
+ *
+ * @since 1.19
+ * @param string $variant
+ * @return bool
+ */
+func (l *Language) HasVariant(variant string) bool {
+	return variant != "" && l.GetConverter().ValidateVariant(variant) == variant
+}
+
+/**
+ * Get the list of variants supported by this language
+ * see sample implementation in LanguageZh.php
+ *
+ * @return string[] An array of language codes
+ */
+func (l *Language) GetVariants() []string {
+	return l.GetConverter().GetVariants()
+}
+
+/**
+ * @param WebRequest|null $request
+ * @param User|null $user
+ * @return string
+ */
+func (l *Language) GetPreferredVariant(request IWebRequest, user IUser) string {
+	return l.GetConverter().GetPreferredVariant(request, user)
+}
+
+/**
+ * @param WebRequest|null $request
+ * @return string
+ */
+func (l *Language) GetURLVariant(request IWebRequest) string {
+	return l.GetConverter().GetURLVariant(request)
+}
+
+/**
+ * If a language supports multiple variants, it is
+ * possible that non-existing link in one variant
+ * actually exists in another variant. this function
+ * tries to find it. See e.g. LanguageZh.php
+ * The input parameters may be modified upon return
+ *
+ * @param string $link The name of the link
+ * @param callable $exists Returns true if a page with the given title exists
+ * @return string
+ */
+func (l *Language) FindVariantLink(link string, exists func(string) bool) string {
+	return l.GetConverter().FindVariantLink(link, exists)
+}
+
 /**
  * Convert a string to uppercase
  *
@@ -214,12 +608,68 @@ func NewLanguage() *Language {
  * @return string
  */
 func (l *Language) Uc(str string, first bool) string {
-	if !first {
-		return strings.ToUpper(str)
+	if first {
+		return l.Ucfirst(str)
+	}
+	if l.isTurkicCase() {
+		return strings.ToUpperSpecial(unicode.TurkishCase, str)
+	}
+	return strings.ToUpper(str)
+}
+
+/**
+ * @param string $str
+ * @return string
+ */
+func (l *Language) Ucfirst(str string) string {
+	r, size := utf8.DecodeRuneInString(str)
+	if size == 0 {
+		return str
+	}
+	if l.isTurkicCase() {
+		return string(unicode.TurkishCase.ToUpper(r)) + str[size:]
+	}
+	return string(unicode.ToUpper(r)) + str[size:]
+}
+
+/**
+ * @param string $str
+ * @param bool $first
+ * @return mixed|string
+ */
+func (l *Language) Lc(str string, first bool) string {
+	if first {
+		return l.Lcfirst(str)
+	}
+	if l.isTurkicCase() {
+		return strings.ToLowerSpecial(unicode.TurkishCase, str)
+	}
+	return strings.ToLower(str)
+}
+
+/**
+ * @param string $str
+ * @return mixed|string
+ */
+func (l *Language) Lcfirst(str string) string {
+	r, size := utf8.DecodeRuneInString(str)
+	if size == 0 {
+		return str
 	}
-	return  strings.ToUpper(string([]rune(str)[0])) + string([]rune(str)[1:])
+	if l.isTurkicCase() {
+		return string(unicode.TurkishCase.ToLower(r)) + str[size:]
+	}
+	return string(unicode.ToLower(r)) + str[size:]
 }
 
+/**
+ * Whether the language maps i/I to İ/ı rather than to I/i
+ *
+ * @return bool
+ */
+func (l *Language) isTurkicCase() bool {
+	return php.InArray(l.mCode, turkicCaseLanguages)
+}
 
 /**
  * Return a case-folded representation of $s
@@ -241,94 +691,1430 @@ func (l *Language) CaseFold(s string) string {
 }
 
 /**
- * Returns true if a language code is of a valid form for the purposes of
- * internal customisation of MediaWiki, via Messages*.php or *.json.
+ * Grammatical transformations, needed for inflected languages
+ * Invoked by putting {{grammar:case|word}} in a message.
  *
- * @param string $code
+ * The rules can be defined in $wgGrammarForms global or computed
+ * dynamically by overriding this method in language class.
  *
- * @throws MWException
- * @since 1.18
- * @return bool
+ * @param string $word
+ * @param string $case
+ * @return string
  */
-func (l *Language) IsValidBuiltInCode(code string) bool {
-	//TODO: 已去除无意义的string类型判断
-	b, _ := regexp.Match(`^[a-z0-9-]{2,}$`, []byte(code))
-	return b
+func (l *Language) ConvertGrammar(word, grammarCase string) string {
+	if grammarForms, ok := globals.GLOBALS["wgGrammarForms"].(map[string]map[string]map[string]string); ok {
+		if form, ok := grammarForms[l.mCode][grammarCase][word]; ok {
+			return form
+		}
+	}
+
+	grammarTransformations := l.GetGrammarTransformations()
+	forms, ok := grammarTransformations[grammarCase]
+	if !ok {
+		return word
+	}
+	for _, rule := range forms {
+		regex := compileGrammarRegex(rule[0])
+		if regex.MatchString(word) {
+			// PHP replacements may be followed directly by letters ("$1я"),
+			// which Go would read as part of the group name.
+			replacement := grammarReplacementRegex.ReplaceAllString(rule[1], "$${$1}")
+			word = regex.ReplaceAllString(word, replacement)
+			break
+		}
+	}
+	return word
 }
 
 /**
- * Returns true if a language code is an IETF tag known to MediaWiki.
+ * Compile a regex of the grammar transformations once for all the languages
  *
- * @param string $tag
- *
- * @since 1.21
- * @return bool
+ * @param string $pattern
+ * @return Regexp
+ * @throws MWException
  */
-func (l *Language) IsKnownLanguageTag(tag string) bool {
-	// Quick escape for invalid input to avoid exceptions down the line
-	// when code tries to process tags which are not valid at all.
-	if l.IsValidBuiltInCode(tag) {
-		return false
+func compileGrammarRegex(pattern string) *regexp.Regexp {
+	if regex, ok := grammarRegexCache.Load(pattern); ok {
+		return regex.(*regexp.Regexp)
 	}
-	// TODO MediaWiki\Languages\Data\Names::$names[$tag]
-	if l.FetchLanguageName(tag, tag, tag) != "" {
-		return true
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		panic(exception.NewMWException("An error occurred while processing grammar: " + err.Error()))
 	}
-	return false
+	cached, _ := grammarRegexCache.LoadOrStore(pattern, regex)
+	return cached.(*regexp.Regexp)
 }
 
 /**
- * Get an array of language names, indexed by code.
- * @param null|string $inLanguage Code of language in which to return the names
- * 		Use self::AS_AUTONYMS for autonyms (native names)
- * @param string $include One of:
- * 		self::ALL all available languages
- * 		'mw' only if the language is defined in MediaWiki or wgExtraLanguageNames (default)
- * 		self::SUPPORTED only if the language is in 'mw' *and* has a message file
- * @return array Language code => language name (sorted by key)
- * @since 1.20
+ * Get the grammar transformations data for the language.
+ * Used like grammar forms, with {{GRAMMAR}} and cases,
+ * but uses pairs of regexes and replacements instead of code.
+ *
+ * @return array[] Array of grammar transformations.
+ * @throws MWException
+ * @since 1.28
  */
-func (l *Language) FetchLanguageNames(inLanguage, include string) (ret map[string]string) {
-	if inLanguage == "" {
-		inLanguage = AS_AUTONYMS
-	}
-	if include == "" {
-		include = "mw"
-	}
-	if AS_AUTONYMS == "" {
-		inLanguage = "null"
-	}
-	//cacheKey := fmt.Sprintf("%s:%s", inLanguage, include)
-	if l.languageNameCache == nil {
-		l.languageNameCache = objectcache.NewHashBagOStuff(map[string]interface{}{"maxKeys" : 20})
-	}
-	//ret = l.languageNameCache.Get(cacheKey)
-	return ret
+func (l *Language) GetGrammarTransformations() map[string][][2]string {
+	l.grammarTransformationsOnce.Do(func() {
+		l.grammarTransformations = make(map[string][][2]string)
+		data, err := grammarTransformationsFS.ReadFile("data/grammarTransformations/" + l.mCode + ".json")
+		if err != nil {
+			return
+		}
+		var raw map[string]json.RawMessage
+		if err := json.Unmarshal(data, &raw); err != nil {
+			panic(exception.NewMWException("Invalid grammar data for \"" + l.mCode + "\"."))
+		}
+		aliases := make(map[string]string)
+		for grammarCase, value := range raw {
+			if grammarCase == "@metadata" {
+				continue
+			}
+			// Some names of grammar rules are aliases for other rules.
+			// In such cases the value is a string rather than object,
+			// so load the actual rules.
+			var alias string
+			if json.Unmarshal(value, &alias) == nil {
+				aliases[grammarCase] = alias
+				continue
+			}
+			var rules [][2]string
+			if err := json.Unmarshal(value, &rules); err != nil {
+				panic(exception.NewMWException("Invalid grammar data for \"" + l.mCode + "\"."))
+			}
+			l.grammarTransformations[grammarCase] = rules
+		}
+		for grammarCase, alias := range aliases {
+			l.grammarTransformations[grammarCase] = l.grammarTransformations[alias]
+		}
+	})
+	return l.grammarTransformations
 }
 
 /**
- * @param string $code The code of the language for which to get the name
- * @param null|string $inLanguage Code of language in which to return the name
- *   (SELF::AS_AUTONYMS for autonyms)
+ * Provides an alternative text depending on specified gender.
+ * Usage {{gender:username|masculine|feminine|unknown}}.
+ * username is optional, in which case the gender of current user is used,
+ * but only in (some) interface messages; otherwise default gender is used.
+ *
+ * If no forms are given, an empty string is returned. If only one form is
+ * given, it will be returned unconditionally. These details are implied by
+ * the caller and cannot be overridden in subclasses.
+ *
+ * If three forms are given, the default is to use the third (unknown) form.
+ * If fewer than three forms are given, the default is to use the first (masculine) form.
+ * These details can be overridden in subclasses.
+ *
+ * @param string $gender
+ * @param array $forms
+ *
+ * @return string
+ */
+func (l *Language) ConvertGender(gender string, forms []string) string {
+	if len(forms) == 0 {
+		return ""
+	}
+	forms = l.preConvertPlural(forms, 2)
+	if gender == "male" {
+		return forms[0]
+	}
+	if gender == "female" {
+		return forms[1]
+	}
+	if len(forms) > 2 {
+		return forms[2]
+	}
+	return forms[0]
+}
+
+/**
+ * Checks that convertPlural was given an array and pads it to requested
+ * amount of forms by copying the last one.
+ *
+ * @param array $forms Array of forms given to convertPlural
+ * @param int $count How many forms should there be at least
+ * @return array Padded array of forms or an exception if not an array
+ */
+func (l *Language) preConvertPlural(forms []string, count int) []string {
+	for len(forms) < count {
+		forms = append(forms, forms[len(forms)-1])
+	}
+	return forms
+}
+
+/**
+ * Get a namespace value by key
+ *
+ * @par Example:
+ * @code
+ * $mw_ns = $wgContLang->getNsText( NS_MEDIAWIKI );
+ * echo $mw_ns; // prints 'MediaWiki'
+ * @endcode
+ *
+ * @param int $index The array key of the namespace to return
+ * @return string|bool String if the namespace value exists, otherwise false
+ */
+func (l *Language) GetNsText(index int) (string, bool) {
+	name, ok := canonicalNamespaceNames[index]
+	return name, ok
+}
+
+/**
+ * Returns gender-dependent namespace alias if available.
+ * See https://www.mediawiki.org/wiki/Manual:$wgExtraGenderNamespaces
+ * @param int $index Namespace index
+ * @param string $gender Gender key (male, female... )
+ * @return string
+ * @since 1.18
+ */
+func (l *Language) GetGenderNsText(index int, gender string) (string, bool) {
+	if ns, ok := namespaceGenderAliases[l.mCode][index]; ok {
+		if name, ok := ns[gender]; ok {
+			return name, true
+		}
+	}
+	return l.GetNsText(index)
+}
+
+/**
+ * Whether this language uses gender-dependent namespace aliases.
+ * See https://www.mediawiki.org/wiki/Manual:$wgExtraGenderNamespaces
+ * @return bool
+ * @since 1.18
+ */
+func (l *Language) NeedsGenderDistinction() bool {
+	_, ok := namespaceGenderAliases[l.mCode]
+	return ok
+}
+
+/**
+ * For right-to-left language support
+ *
+ * @return bool
+ */
+func (l *Language) IsRTL() bool {
+	return php.InArray(l.mCode, rtlLanguages)
+}
+
+/**
+ * Return the correct HTML 'dir' attribute value for this language.
+ * @return string
+ */
+func (l *Language) GetDir() string {
+	if l.IsRTL() {
+		return "rtl"
+	}
+	return "ltr"
+}
+
+/**
+ * Return 'left' or 'right' as appropriate alignment for line-start
+ * for this language's text direction.
+ *
+ * Should be equivalent to CSS3 'start' text-align value....
+ *
+ * @return string
+ */
+func (l *Language) AlignStart() string {
+	if l.IsRTL() {
+		return "right"
+	}
+	return "left"
+}
+
+/**
+ * Return 'right' or 'left' as appropriate alignment for line-end
+ * for this language's text direction.
+ *
+ * Should be equivalent to CSS3 'end' text-align value....
+ *
+ * @return string
+ */
+func (l *Language) AlignEnd() string {
+	if l.IsRTL() {
+		return "left"
+	}
+	return "right"
+}
+
+/**
+ * A hidden direction mark (LRM or RLM), depending on the language direction.
+ * Unlike getDirMark(), this function returns the character as an HTML entity.
+ * This function should be used when the output is guaranteed to be HTML,
+ * because it makes the output HTML source code more readable. When
+ * the output is plain text or can be escaped, getDirMark() should be used.
+ *
+ * @param bool $opposite Get the direction mark opposite to your language
+ * @return string
+ * @since 1.20
+ */
+func (l *Language) GetDirMarkEntity(opposite bool) string {
+	if opposite != l.IsRTL() {
+		return "&rlm;"
+	}
+	return "&lrm;"
+}
+
+/**
+ * A hidden direction mark (LRM or RLM), depending on the language direction.
+ * This function produces them as invisible Unicode characters and
+ * the output may be hard to read and debug, so it should only be used
+ * when the output is plain text or can be escaped. When the output is
+ * HTML, use getDirMarkEntity() instead.
+ *
+ * @param bool $opposite Get the direction mark opposite to your language
+ * @return string
+ */
+func (l *Language) GetDirMark(opposite bool) string {
+	lrm := "\u200E" // U+200E LEFT-TO-RIGHT MARK
+	rlm := "\u200F" // U+200F RIGHT-TO-LEFT MARK
+	if opposite != l.IsRTL() {
+		return rlm
+	}
+	return lrm
+}
+
+/**
+ * Wraps argument with unicode control characters for directionality safety
+ *
+ * This solves the problem where directionality-neutral characters at the edge of
+ * the argument string get interpreted with the wrong directionality from the
+ * enclosing context, giving renderings that look corrupted like "(Ben_(WMF".
+ *
+ * The wrapping is LRE...PDF or RLE...PDF, depending on the detected
+ * directionality of the argument string, using the BIDI algorithm's own "First
+ * strong directional codepoint" rule. Essentially, this works round the fact that
+ * there is no embedding equivalent of U+2068 FSI (isolation with heuristic
+ * direction inference). The latter is cleaner but still not widely supported.
+ *
+ * @param string $text Text to wrap
+ * @return string Text, wrapped in LRE...PDF or RLE...PDF or nothing
+ */
+func (l *Language) EmbedBidi(text string) string {
+	switch strongDirFromContent(text) {
+	case "ltr":
+		// Wrap in LEFT-TO-RIGHT EMBEDDING ... POP DIRECTIONAL FORMATTING
+		return lre + text + pdf
+	case "rtl":
+		// Wrap in RIGHT-TO-LEFT EMBEDDING ... POP DIRECTIONAL FORMATTING
+		return rle + text + pdf
+	}
+	// No strong directionality: do not wrap
+	return text
+}
+
+/**
+ * Gets directionality of the first strongly directional codepoint, for embedBidi()
+ *
+ * This is the rule the BIDI algorithm uses to determine the directionality of
+ * paragraphs ( http://unicode.org/reports/tr9/#The_Paragraph_Level ) and
+ * FSI isolates ( http://unicode.org/reports/tr9/#Explicit_Directional_Isolates ).
+ *
+ * TODO: Does not handle BIDI control characters inside the text.
+ * TODO: Does not handle unallocated characters.
+ *
+ * @param string $text Text to test
+ * @return null|string Directionality ('ltr' or 'rtl') or null
+ */
+func strongDirFromContent(text string) string {
+	matches := strongDirRegex.FindStringSubmatchIndex(text)
+	if matches == nil {
+		return ""
+	}
+	if matches[2] < 0 {
+		return "rtl"
+	}
+	return "ltr"
+}
+
+/**
+ * @return string
+ */
+func (l *Language) Fallback8bitEncoding() string {
+	// TODO: per-language encodings from the LocalisationCache
+	return "windows-1252"
+}
+
+/**
+ * Titles and URLs which are not valid UTF-8 are assumed to be in the
+ * legacy 8-bit encoding of the language and converted to UTF-8.
+ *
+ * @param string $s
+ * @return string
+ */
+func (l *Language) CheckTitleEncoding(s string) string {
+	if utf8.ValidString(s) {
+		return s
+	}
+	var ret strings.Builder
+	for i := 0; i < len(s); i++ {
+		if r, ok := windows1252[s[i]]; ok {
+			ret.WriteRune(r)
+		} else {
+			ret.WriteRune(rune(s[i]))
+		}
+	}
+	return ret.String()
+}
+
+/**
+ * Returns true if a language code is of a valid form for the purposes of
+ * internal customisation of MediaWiki, via Messages*.php or *.json.
+ *
+ * @param string $code
+ *
+ * @throws MWException
+ * @since 1.18
+ * @return bool
+ */
+func (l *Language) IsValidBuiltInCode(code string) bool {
+	//TODO: 已去除无意义的string类型判断
+	b, _ := regexp.Match(`^[a-z0-9-]{2,}$`, []byte(code))
+	return b
+}
+
+/**
+ * Checks whether any localisation is available for that language tag
+ * in MediaWiki (MessagesXx.php or xx.json exists).
+ *
+ * @param string $code Language tag (in lower case)
+ * @return bool Whether language is supported
+ * @since 1.21
+ */
+func (l *Language) IsSupportedLanguage(code string) bool {
+	if !l.IsValidBuiltInCode(code) {
+		return false
+	}
+	if code == "qqq" {
+		return false
+	}
+	return l.isReadable(l.GetJsonMessagesFileName(code))
+}
+
+/**
+ * Returns true if a language code string is of a valid form, whether or
+ * not it exists. This includes codes which are used solely for
+ * customisation via the MediaWiki namespace.
+ *
+ * @param string $code
+ *
+ * @return bool
+ */
+func (l *Language) IsValidCode(code string) bool {
+	if valid, ok := validCodeCache.Load(code); ok {
+		return valid.(bool)
+	}
+	// People think language codes are html safe, so enforce it.
+	// Ideally we should only allow a-zA-Z0-9-
+	// but, .+ and other chars are often used for {{int:}} hacks
+	// see bugs T39564, T39587, T38938
+	valid :=
+		// Protect against path traversal
+		!strings.ContainsAny(code, ":/\\\x00&<>'\"") &&
+			!titleInvalidRegex.MatchString(code)
+	validCodeCache.Store(code, valid)
+	return valid
+}
+
+/**
+ * Returns true if a language code is an IETF tag known to MediaWiki.
+ *
+ * @param string $tag
+ *
+ * @since 1.21
+ * @return bool
+ */
+func (l *Language) IsKnownLanguageTag(tag string) bool {
+	// Quick escape for invalid input to avoid exceptions down the line
+	// when code tries to process tags which are not valid at all.
+	if !l.IsValidBuiltInCode(tag) {
+		return false
+	}
+	if _, ok := data.Names[tag]; ok {
+		return true
+	}
+	if l.FetchLanguageName(tag, tag, "") != "" {
+		return true
+	}
+	return false
+}
+
+/**
+ * @param string $code
+ * @return string
+ * @since 1.23
+ */
+func (l *Language) GetJsonMessagesFileName(code string) string {
+	if !l.IsValidBuiltInCode(code) {
+		panic(exception.NewMWException("Invalid language code \"" + code + "\""))
+	}
+	return filepath.Join(MessagesDir, code+".json")
+}
+
+/**
+ * @param string $file
+ * @return bool
+ */
+func (l *Language) isReadable(file string) bool {
+	f, err := os.Open(file)
+	if err != nil {
+		return false
+	}
+	f.Close()
+	return true
+}
+
+/**
+ * Get an array of language names, indexed by code.
+ * @param null|string $inLanguage Code of language in which to return the names
+ * 		Use self::AS_AUTONYMS for autonyms (native names)
+ * @param string $include One of:
+ * 		self::ALL all available languages
+ * 		'mw' only if the language is defined in MediaWiki or wgExtraLanguageNames (default)
+ * 		self::SUPPORTED only if the language is in 'mw' *and* has a message file
+ * @return array Language code => language name (sorted by key)
+ * @since 1.20
+ */
+func (l *Language) FetchLanguageNames(inLanguage, include string) map[string]string {
+	// The callers may change the names
+	cached := l.fetchLanguageNamesCached(inLanguage, include)
+	names := make(map[string]string, len(cached))
+	for code, name := range cached {
+		names[code] = name
+	}
+	return names
+}
+
+/**
+ * @param null|string $inLanguage
+ * @param string $include
+ * @return array Language code => language name, shared by the callers, who
+ *  must not change it
+ */
+func (l *Language) fetchLanguageNamesCached(inLanguage, include string) map[string]string {
+	if include == "" {
+		include = "mw"
+	}
+	cacheKey := inLanguage
+	if inLanguage == AS_AUTONYMS {
+		cacheKey = "null"
+	}
+	cacheKey += ":" + include
+
+	languageNameCacheMutex.Lock()
+	ret, ok := languageNameCache[cacheKey]
+	languageNameCacheMutex.Unlock()
+	if ok {
+		return ret
+	}
+
+	// Not under the lock: the hooks may fetch language names too
+	ret = l.fetchLanguageNamesUncached(inLanguage, include)
+	languageNameCacheMutex.Lock()
+	defer languageNameCacheMutex.Unlock()
+	if cached, ok := languageNameCache[cacheKey]; ok {
+		// Computed meanwhile by another caller
+		return cached
+	}
+	languageNameCache[cacheKey] = ret
+	return ret
+}
+
+/**
+ * Uncached helper for fetchLanguageNames
+ * @param null|string $inLanguage Code of language in which to return the names
+ *		Use self::AS_AUTONYMS for autonyms (native names)
+ * @param string $include One of:
+ *		self::ALL all available languages
+ *		'mw' only if the language is defined in MediaWiki or wgExtraLanguageNames (default)
+ *		self::SUPPORTED only if the language is in 'mw' *and* has a message file
+ * @return array Language code => language name
+ */
+func (l *Language) fetchLanguageNamesUncached(inLanguage, include string) map[string]string {
+	// If passed an invalid language code to use, fallback to en
+	if inLanguage != AS_AUTONYMS && !l.IsValidCode(inLanguage) {
+		inLanguage = "en"
+	}
+
+	names := make(map[string]string)
+
+	if inLanguage != AS_AUTONYMS {
+		for code, name := range l.getCldrNames(inLanguage) {
+			names[code] = name
+		}
+		if HookRunner != nil {
+			HookRunner.Run("LanguageGetTranslatedLanguageNames", []interface{}{&names, inLanguage}, "")
+		}
+	}
+
+	mwNames := make(map[string]string)
+	for code, name := range data.Names {
+		mwNames[code] = name
+	}
+	if extraLanguageNames, ok := globals.GLOBALS["wgExtraLanguageNames"].(map[string]string); ok {
+		for code, name := range extraLanguageNames {
+			mwNames[code] = name
+		}
+	}
+	if usePigLatinVariant, ok := globals.GLOBALS["wgUsePigLatinVariant"].(bool); ok && usePigLatinVariant {
+		mwNames["en-x-piglatin"] = "Igpay Atinlay"
+	}
+
+	for mwCode, mwName := range mwNames {
+		// - Prefer own MediaWiki native name when not using the hook
+		// - For other names just add if not added through the hook
+		if _, ok := names[mwCode]; mwCode == inLanguage || !ok {
+			names[mwCode] = mwName
+		}
+	}
+
+	if include == ALL {
+		return names
+	}
+
+	returnMw := make(map[string]string)
+	for coreCode := range mwNames {
+		returnMw[coreCode] = names[coreCode]
+	}
+
+	if include == SUPPORTED {
+		namesMwFile := make(map[string]string)
+		// We do this using a foreach over the codes instead of a directory
+		// loop so that messages files in extensions will work correctly.
+		for code := range returnMw {
+			if l.IsValidBuiltInCode(code) && l.isReadable(l.GetJsonMessagesFileName(code)) {
+				namesMwFile[code] = names[code]
+			}
+		}
+		return namesMwFile
+	}
+
+	// 'mw' option; default if it's not one of the other two options (all/mwfile)
+	return returnMw
+}
+
+/**
+ * CLDR names of the languages in $inLanguage, falling back from e.g.
+ * "de-at" to "de"
+ *
+ * @param string $inLanguage
+ * @return array
+ */
+func (l *Language) getCldrNames(inLanguage string) map[string]string {
+	for code := inLanguage; code != ""; {
+		if names, ok := data.CldrNames[code]; ok {
+			return names
+		}
+		i := strings.LastIndex(code, "-")
+		if i < 0 {
+			break
+		}
+		code = code[:i]
+	}
+	return nil
+}
+
+/**
+ * @param string $code The code of the language for which to get the name
+ * @param null|string $inLanguage Code of language in which to return the name
+ *   (SELF::AS_AUTONYMS for autonyms)
  * @param string $include See fetchLanguageNames()
  * @return string Language name or empty
  * @since 1.20
  */
 func (l *Language) FetchLanguageName(code, inLanguage, include string) string {
-	if inLanguage == "" {
-		inLanguage = AS_AUTONYMS
-	}
 	if include == "" {
 		include = ALL
 	}
 	code = strings.ToLower(code)
-	arr := l.FetchLanguageNames(inLanguage, include)
-	if value, ok := arr[code]; ok {
+	array := l.fetchLanguageNamesCached(inLanguage, include)
+	if value, ok := array[code]; ok {
 		return value
 	}
 	return ""
 }
 
+/**
+ * Intended for tests that may change configuration in a way that invalidates caches.
+ *
+ * @since 1.32
+ */
+func ClearCaches() {
+	languageObjectCacheMutex.Lock()
+	languageObjectCache = make(map[string]*Language)
+	languageObjectCacheMutex.Unlock()
+
+	languageNameCacheMutex.Lock()
+	languageNameCache = make(map[string]map[string]string)
+	languageNameCacheMutex.Unlock()
+
+	localisation.SingletonLocalisationCache().UnloadAll()
+}
+
+/**
+ * Get message object in this language. Only for use inside this class.
+ *
+ * @param string $msg Message name
+ * @param mixed $params,... Message parameters
+ * @return Message
+ */
+func (l *Language) msg(key string, params ...interface{}) IMessage {
+	if MessageFactory == nil {
+		panic(exception.NewMWException("Language::msg: no message factory for " + key))
+	}
+	return MessageFactory(l, key, params...)
+}
+
+/**
+ * Normally we output all numbers in plain en_US style, that is
+ * 293,291.235 for twohundredninetythreethousand-twohundredninetyone
+ * point twohundredthirtyfive. However this is not suitable for all
+ * languages, some such as Bengali (bn) want ২,৯৩,২৯১.২৩৫ and others such as
+ * Icelandic just want to use commas instead of dots, and dots instead
+ * of commas like "293.291,235".
+ *
+ * See $separatorTransformTable on MessageIs.php for
+ * the , => . and . => , implementation.
+ *
+ * @param int|float $number The string to be formatted, should be an integer
+ *        or a floating point number.
+ * @param bool $nocommafy Set to true for special numbers like dates
+ * @return string
+ */
+func (l *Language) FormatNum(number string, nocommafy bool) string {
+	if !nocommafy {
+		number = l.commafy(number)
+	}
+	if table, ok := separatorTransformTables[l.mCode]; ok {
+		number = php.Strtr(number, table)
+	}
+	return number
+}
+
+/**
+ * @param string $number
+ * @return string
+ */
+func (l *Language) ParseFormattedNumber(number string) string {
+	if table, ok := separatorTransformTables[l.mCode]; ok {
+		flipped := make(map[string]string, len(table))
+		for from, to := range table {
+			flipped[to] = from
+		}
+		number = php.Strtr(number, flipped)
+	}
+	return strings.Replace(number, ",", "", -1)
+}
+
+/**
+ * Adds commas to a given number
+ * @since 1.19
+ * @param mixed $number
+ * @return string
+ */
+func (l *Language) commafy(number string) string {
+	m := commafyRegex.FindStringSubmatch(number)
+	if m == nil {
+		return number
+	}
+	// default grouping is at thousands
+	var digits strings.Builder
+	for i, digit := range m[2] {
+		if i > 0 && (len(m[2])-i)%3 == 0 {
+			digits.WriteByte(',')
+		}
+		digits.WriteRune(digit)
+	}
+	return m[1] + digits.String() + m[3]
+}
+
+/**
+ * Take a list of strings and build a locale-friendly comma-separated
+ * list, using the local comma-separator message.
+ * The last two strings are chained with an "and".
+ * NOTE: This function will only work with standard numeric array keys (0, 1, 2…)
+ *
+ * @param string[] $l
+ * @return string
+ */
+func (l *Language) ListToText(list []string) string {
+	m := len(list) - 1
+	if m < 0 {
+		return ""
+	}
+	var and, space, comma string
+	if m > 0 {
+		and = l.msg("and").Escaped()
+		space = l.msg("word-separator").Escaped()
+		if m > 1 {
+			comma = l.msg("comma-separator").Escaped()
+		}
+	}
+	s := list[m]
+	for i := m - 1; i >= 0; i-- {
+		if i == m-1 {
+			s = list[i] + and + space + s
+		} else {
+			s = list[i] + comma + s
+		}
+	}
+	return s
+}
+
+/**
+ * Take a list of strings and build a locale-friendly comma-separated
+ * list, using the local comma-separator message.
+ * @param string[] $list Array of strings to put in a comma list
+ * @return string
+ */
+func (l *Language) CommaList(list []string) string {
+	return strings.Join(list, l.msg("comma-separator").Escaped())
+}
+
+/**
+ * Take a list of strings and build a locale-friendly semicolon-separated
+ * list, using the local semicolon-separator message.
+ * @param string[] $list Array of strings to put in a semicolon list
+ * @return string
+ */
+func (l *Language) SemicolonList(list []string) string {
+	return strings.Join(list, l.msg("semicolon-separator").Escaped())
+}
+
+/**
+ * Same as commaList, but separate it with the pipe instead.
+ * @param string[] $list Array of strings to put in a pipe list
+ * @return string
+ */
+func (l *Language) PipeList(list []string) string {
+	return strings.Join(list, l.msg("pipe-separator").Escaped())
+}
+
+/**
+ * Truncate a string to a specified length in bytes, appending an optional
+ * string (e.g. for ellipsis)
+ *
+ * If $length is negative, the string will be truncated from the beginning
+ *
+ * @since 1.31
+ *
+ * @param string $string String to truncate
+ * @param int $length Maximum length in bytes
+ * @param string $ellipsis String to append to the end of truncated text
+ * @param bool $adjustLength Subtract length of ellipsis from $length
+ *
+ * @return string
+ */
+func (l *Language) TruncateForDatabase(str string, length int, ellipsis string, adjustLength bool) string {
+	return l.truncateInternal(
+		str, length, ellipsis, adjustLength,
+		func(s string) int {
+			return len(s)
+		},
+		func(s string, n int) string {
+			return l.removeBadCharLast(s[:n])
+		},
+		func(s string, n int) string {
+			return l.removeBadCharFirst(s[len(s)-n:])
+		},
+	)
+}
+
+/**
+ * Truncate a string to a specified number of characters, appending an optional
+ * string (e.g. for ellipsis).
+ *
+ * This provides multibyte version of truncateForDatabase() method of this class,
+ * suitable for truncation based on number of characters, instead of number of bytes.
+ *
+ * If $length is negative, the string will be truncated from the beginning.
+ *
+ * @since 1.31
+ *
+ * @param string $string String to truncate
+ * @param int $length Maximum number of characters
+ * @param string $ellipsis String to append to the end of truncated text
+ * @param bool $adjustLength Subtract length of ellipsis from $length
+ *
+ * @return string
+ */
+func (l *Language) TruncateForVisual(str string, length int, ellipsis string, adjustLength bool) string {
+	return l.truncateInternal(
+		str, length, ellipsis, adjustLength,
+		utf8.RuneCountInString,
+		func(s string, n int) string {
+			return string([]rune(s)[:n])
+		},
+		func(s string, n int) string {
+			runes := []rune(s)
+			return string(runes[len(runes)-n:])
+		},
+	)
+}
+
+/**
+ * Internal method used for truncation. This method abstracts text truncation into
+ * one common method, allowing users to provide length measurement function and
+ * function for finding substring.
+ *
+ * For usages, see truncateForDatabase and truncateForVisual.
+ *
+ * @param string $string String to truncate
+ * @param int $length Maximum length of final text
+ * @param string $ellipsis String to append to the end of truncated text
+ * @param bool $adjustLength Subtract length of ellipsis from $length
+ * @param callable $measureLength Callable function used for determining the length of text
+ * @param callable $getSubstring Callable function used for getting the substrings
+ *
+ * @return string
+ */
+func (l *Language) truncateInternal(str string, length int, ellipsis string, adjustLength bool,
+	measureLength func(s string) int, head, tail func(s string, n int) string) string {
+	// Check if there is no need to truncate
+	if measureLength(str) <= abs(length) {
+		return str // no need to truncate
+	}
+
+	// Use the localized ellipsis character
+	if ellipsis == "..." {
+		ellipsis = l.msg("ellipsis").Escaped()
+	}
+	if length == 0 {
+		return ellipsis // convention
+	}
+
+	stringOriginal := str
+	// If ellipsis length is >= $length then we can't apply $adjustLength
+	if adjustLength && measureLength(ellipsis) >= abs(length) {
+		str = ellipsis // this can be slightly unexpected
+	} else {
+		// Otherwise, truncate and add ellipsis...
+		ellipsisLength := 0
+		if adjustLength {
+			ellipsisLength = measureLength(ellipsis)
+		}
+		if length > 0 {
+			length -= ellipsisLength
+			str = head(str, length) // xyz...
+			str = strings.TrimRightFunc(str, unicode.IsSpace)
+			str = str + ellipsis
+		} else {
+			length += ellipsisLength
+			str = tail(str, -length) // ...xyz
+			str = strings.TrimLeftFunc(str, unicode.IsSpace)
+			str = ellipsis + str
+		}
+	}
+
+	// Do not truncate if the ellipsis makes the string longer/equal (T24181).
+	// This check is *not* redundant if $adjustLength, due to the single case where
+	// LEN($ellipsis) > ABS($limit arg); $stringOriginal could be shorter than $string.
+	if measureLength(str) < measureLength(stringOriginal) {
+		return str
+	}
+	return stringOriginal
+}
+
+/**
+ * Remove bytes that represent an incomplete Unicode character
+ * at the end of string (e.g. bytes of the char are missing)
+ *
+ * @param string $string
+ * @return string
+ */
+func (l *Language) removeBadCharLast(str string) string {
+	// Look back for the first byte of the last character, at most 3 bytes
+	for i := len(str) - 1; i >= 0 && i >= len(str)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(str[i]) {
+			if !utf8.FullRuneInString(str[i:]) {
+				// We chopped in the middle of a character; remove it
+				return str[:i]
+			}
+			break
+		}
+	}
+	return str
+}
+
+/**
+ * Remove bytes that represent an incomplete Unicode character
+ * at the start of string (e.g. bytes of the char are missing)
+ *
+ * @param string $string
+ * @return string
+ */
+func (l *Language) removeBadCharFirst(str string) string {
+	// We chopped in the middle of a character; remove the whole thing
+	for str != "" && !utf8.RuneStart(str[0]) {
+		str = str[1:]
+	}
+	return str
+}
+
+/**
+ * Truncate a string of valid HTML to a specified length in characters,
+ * appending an optional string (e.g. for ellipses), and return valid HTML
+ *
+ * This is only intended for styled/linked text, such as HTML with
+ * tags like <span> and <a>, were the tags are self-contained (valid HTML).
+ * Also, this will not detect things like "display:none" CSS.
+ *
+ * Note: since 1.18 you do not need to leave extra room in $length for ellipses.
+ *
+ * @param string $text HTML string to truncate
+ * @param int $length (zero/positive) Maximum length (including ellipses)
+ * @param string $ellipsis String to append to the truncated text
+ * @return string
+ */
+func (l *Language) TruncateHtml(text string, length int, ellipsis string) string {
+	// Use the localized ellipsis character
+	if ellipsis == "..." {
+		ellipsis = l.msg("ellipsis").Escaped()
+	}
+	// Check if there is clearly no need to truncate
+	if length <= 0 {
+		return ellipsis // no text shown, nothing to format (convention)
+	} else if utf8.RuneCountInString(text) <= length {
+		return text // string short enough even *with* HTML (short-circuit)
+	}
+
+	ellipsisLength := utf8.RuneCountInString(html.UnescapeString(ellipsis))
+	neLength := length - ellipsisLength // non-ellipsis len if truncated
+	if neLength < 0 {
+		neLength = 0
+	}
+	dispLen := 0          // innerHTML legth so far
+	ret := ""             // accumulated result string
+	var openTags []string // open tag stack
+	maybeLen := -1        // possible truncation state
+	var maybeTags []string
+	saveState := func() {
+		if maybeLen < 0 {
+			maybeLen = len(ret)
+			maybeTags = append([]string(nil), openTags...)
+		}
+	}
+
+	for pos := 0; pos < len(text); {
+		if text[pos] == '<' {
+			if end := strings.IndexByte(text[pos:], '>'); end > 0 {
+				tag := text[pos : pos+end+1]
+				ret += tag
+				openTags = l.truncateEndBracket(tag, openTags)
+				pos += end + 1
+				continue
+			}
+		}
+
+		// The next displayed character, or an entity such as "&#160;"
+		size := len(htmlEntityRegex.FindString(text[pos:]))
+		if size == 0 {
+			_, size = utf8.DecodeRuneInString(text[pos:])
+		}
+		if neLength == 0 {
+			// Save state without this character. We want to *hit* the first
+			// display char (to get tags) but not *use* it if truncating.
+			saveState()
+		}
+		ret += text[pos : pos+size]
+		pos += size
+		dispLen++
+
+		// Consider truncation once the display length has reached the maximum.
+		if dispLen >= neLength {
+			saveState()
+		}
+		if dispLen > length && dispLen > ellipsisLength {
+			// String in fact does need truncation, the truncation point was OK.
+			ret, openTags = ret[:maybeLen]+ellipsis, maybeTags
+			break
+		}
+	}
+
+	// Close the tags left open by the truncation or by bad HTML
+	for i := len(openTags) - 1; i >= 0; i-- {
+		ret += "</" + openTags[i] + ">"
+	}
+	return ret
+}
+
+/**
+ * truncateHtml() helper function
+ * (a) push or pop $tag from $openTags as needed
+ * (b) clear $tag value
+ * @param string $tag Current HTML tag name we are looking at, e.g. "<span class="x">"
+ * @param array $openTags Open tag stack (not accounting for $tag)
+ * @return array The updated stack
+ */
+func (l *Language) truncateEndBracket(tag string, openTags []string) []string {
+	inner := strings.TrimSpace(tag[1 : len(tag)-1])
+	closing := strings.HasPrefix(inner, "/")
+	selfClosing := strings.HasSuffix(inner, "/")
+	fields := strings.Fields(strings.Trim(inner, "/"))
+	if len(fields) == 0 || strings.HasPrefix(fields[0], "!") {
+		// Empty tags, comments and doctypes do not need closing
+		return openTags
+	}
+	name := fields[0]
+
+	if closing {
+		if len(openTags) > 0 && strings.EqualFold(openTags[len(openTags)-1], name) {
+			openTags = openTags[:len(openTags)-1] // tag closed
+		}
+	} else if !selfClosing && !php.InArray(strings.ToLower(name), voidElements) {
+		openTags = append(openTags, name) // tag opened (didn't close itself)
+	}
+	return openTags
+}
+
+/**
+ * Plural form transformations, needed for some languages.
+ * For example, there are 3 form of plural in Russian and Polish,
+ * depending on "count mod 10". See [[w:Plural]]
+ * For English it is pretty simple.
+ *
+ * Invoked by putting {{plural:count|wordform1|wordform2}}
+ * or {{plural:count|wordform1|wordform2|wordform3}}
+ *
+ * Example: {{plural:{{NUMBEROFARTICLES}}|article|articles}}
+ *
+ * @param int $count Non-localized number
+ * @param array $forms Different plural forms
+ * @return string Correct form of plural for $count in this language
+ */
+func (l *Language) ConvertPlural(count float64, forms []string) string {
+	// Handle explicit n=pluralform cases
+	form, forms, ok := l.handleExplicitPluralForms(count, forms)
+	if ok {
+		return form
+	}
+	if len(forms) == 0 {
+		return ""
+	}
+
+	pluralForm := l.GetPluralRuleIndexNumber(count)
+	if pluralForm > len(forms)-1 {
+		pluralForm = len(forms) - 1
+	}
+	return forms[pluralForm]
+}
+
+/**
+ * Handles explicit plural forms for Language::convertPlural()
+ *
+ * In {{PLURAL:$1|0=nothing|one|many}}, 0=nothing will be returned if $1 equals zero.
+ * These explicit plural forms are taken out from the list of forms and
+ * the remaining forms are returned, unless the explicit form matches.
+ *
+ * @param int $count Non-localized quantifier
+ * @param array $forms Different plural forms
+ * @return array|string The matched explicit form, or the remaining forms
+ */
+func (l *Language) handleExplicitPluralForms(count float64, forms []string) (string, []string, bool) {
+	countText := strconv.FormatFloat(count, 'f', -1, 64)
+	remaining := make([]string, 0, len(forms))
+	for _, form := range forms {
+		if explicitPluralFormRegex.MatchString(form) {
+			pos := strings.Index(form, "=")
+			if form[:pos] == countText {
+				return form[pos+1:], nil, true
+			}
+			continue
+		}
+		remaining = append(remaining, form)
+	}
+	return "", remaining, false
+}
+
+/**
+ * Find the index number of the plural rule appropriate for the given number
+ * @param int $number
+ * @return int The index number of the plural rule
+ */
+func (l *Language) GetPluralRuleIndexNumber(number float64) int {
+	return getPluralRules(l.mCode).index(newPluralOperands(number))
+}
+
+/**
+ * Get the plural rule types for the language
+ * @since 1.22
+ * @return array Associative array with plural form number and plural form type
+ */
+func (l *Language) GetPluralRuleTypes() []string {
+	return getPluralRules(l.mCode).types
+}
+
+/**
+ * Takes a number of seconds and turns it into a text using values such as hours and minutes.
+ *
+ * @since 1.20
+ *
+ * @param int $seconds The amount of seconds.
+ * @param array $chosenIntervals The intervals to enable.
+ *
+ * @return string
+ */
+func (l *Language) FormatDuration(seconds int, chosenIntervals []string) string {
+	intervals := l.GetDurationIntervals(seconds, chosenIntervals)
+
+	var segments []string
+	for _, intervalName := range durationIntervalNames {
+		intervalValue, ok := intervals[intervalName]
+		if !ok {
+			continue
+		}
+		// Messages: duration-seconds, duration-minutes, duration-hours, duration-days, duration-weeks,
+		// duration-years, duration-decades, duration-centuries, duration-millennia
+		message := l.msg("duration-"+intervalName, l.FormatNum(strconv.Itoa(intervalValue), false))
+		segments = append(segments, message.Escaped())
+	}
+
+	return l.ListToText(segments)
+}
+
+/**
+ * Takes a number of seconds and returns an array with a set of corresponding intervals.
+ * For example 65 will be turned into [ minutes => 1, seconds => 5 ].
+ *
+ * @since 1.20
+ *
+ * @param int $seconds The amount of seconds.
+ * @param array $chosenIntervals The intervals to enable.
+ *
+ * @return array
+ */
+func (l *Language) GetDurationIntervals(seconds int, chosenIntervals []string) map[string]int {
+	if len(chosenIntervals) == 0 {
+		chosenIntervals = []string{
+			"millennia", "centuries", "decades", "years", "days",
+			"hours", "minutes", "seconds",
+		}
+	}
+
+	var sortedNames []string
+	for _, name := range durationIntervalNames {
+		if php.InArray(name, chosenIntervals) {
+			sortedNames = append(sortedNames, name)
+		}
+	}
+
+	segments := make(map[string]int)
+	if len(sortedNames) == 0 {
+		return segments
+	}
+	smallestInterval := sortedNames[len(sortedNames)-1]
+
+	for _, name := range sortedNames {
+		length := l.durationIntervals[name]
+		value := seconds / length
+		if value > 0 || (name == smallestInterval && len(segments) == 0) {
+			seconds -= value * length
+			segments[name] = value
+		}
+	}
+
+	return segments
+}
+
+/**
+ * Formats a time given in seconds into a string representation of that time.
+ *
+ * @param int|float $seconds
+ * @param string $avoid 'avoidseconds' to not show seconds when over an hour,
+ *   'avoidminutes' to not show minutes nor seconds when over two days
+ * @param bool $noabbrevs Use 'seconds' and friends instead of 'seconds-abbrev'
+ * @since 1.20 the format can be an array
+ * @return string
+ */
+func (l *Language) FormatTimePeriod(seconds float64, avoid string, noabbrevs bool) string {
+	secondsMsg, minutesMsg, hoursMsg, daysMsg := "seconds-abbrev", "minutes-abbrev", "hours-abbrev", "days-abbrev"
+	if noabbrevs {
+		secondsMsg, minutesMsg, hoursMsg, daysMsg = "seconds", "minutes", "hours", "days"
+	}
+	format := func(key string, number float64) string {
+		return l.msg(key, l.FormatNum(strconv.FormatFloat(number, 'f', -1, 64), false)).Text()
+	}
+
+	var s string
+	if math.Round(seconds*10) < 100 {
+		s = l.FormatNum(fmt.Sprintf("%.1f", math.Round(seconds*10)/10), false)
+		s = l.msg(secondsMsg, s).Text()
+	} else if math.Round(seconds) < 60 {
+		s = format(secondsMsg, math.Round(seconds))
+	} else if math.Round(seconds) < 3600 {
+		minutes := math.Floor(seconds / 60)
+		secondsPart := math.Round(math.Mod(seconds, 60))
+		if secondsPart == 60 {
+			secondsPart = 0
+			minutes++
+		}
+		s = format(minutesMsg, minutes)
+		s += " "
+		s += format(secondsMsg, secondsPart)
+	} else if math.Round(seconds) <= 2*86400 {
+		hours := math.Floor(seconds / 3600)
+		minutes := math.Floor((seconds - hours*3600) / 60)
+		secondsPart := math.Round(seconds - hours*3600 - minutes*60)
+		if secondsPart == 60 {
+			secondsPart = 0
+			minutes++
+		}
+		if minutes == 60 {
+			minutes = 0
+			hours++
+		}
+		s = format(hoursMsg, hours)
+		s += " "
+		s += format(minutesMsg, minutes)
+		if avoid != "avoidseconds" && avoid != "avoidminutes" {
+			s += " " + format(secondsMsg, secondsPart)
+		}
+	} else {
+		days := math.Floor(seconds / 86400)
+		if avoid == "avoidminutes" {
+			hours := math.Round((seconds - days*86400) / 3600)
+			if hours == 24 {
+				hours = 0
+				days++
+			}
+			s = format(daysMsg, days)
+			s += " "
+			s += format(hoursMsg, hours)
+		} else if avoid == "avoidseconds" {
+			hours := math.Floor((seconds - days*86400) / 3600)
+			minutes := math.Round((seconds - days*86400 - hours*3600) / 60)
+			if minutes == 60 {
+				minutes = 0
+				hours++
+			}
+			if hours == 24 {
+				hours = 0
+				days++
+			}
+			s = format(daysMsg, days)
+			s += " "
+			s += format(hoursMsg, hours)
+			s += " "
+			s += format(minutesMsg, minutes)
+		} else {
+			s = format(daysMsg, days)
+			s += " "
+			s += l.FormatTimePeriod(seconds-days*86400, avoid, noabbrevs)
+		}
+	}
+	return s
+}
+
+/**
+ * Format a size in bytes for output, using an appropriate
+ * unit (B, KB, MB, GB, TB, PB, EB, ZB or YB) according to the magnitude in question
+ *
+ * @param int $size Size to format
+ * @return string Plain text (not HTML)
+ */
+func (l *Language) FormatSize(size int64) string {
+	return l.formatComputingNumbers(size, 1024, "size-$1bytes")
+}
+
+/**
+ * Format a bitrate for output, using an appropriate
+ * unit (bps, kbps, Mbps, Gbps, Tbps, Pbps, Ebps, Zbps, or Ybps) according to
+ * the magnitude in question.
+ *
+ * This use base 1000. For base 1024 use formatSize(), for another base
+ * see formatComputingNumbers().
+ *
+ * @param int $bps
+ * @return string
+ */
+func (l *Language) FormatBitrate(bps int64) string {
+	return l.formatComputingNumbers(bps, 1000, "bitrate-$1bits")
+}
+
+/**
+ * @param int $size Size of the unit
+ * @param int $boundary Size boundary (1000, or 1024 in most cases)
+ * @param string $messageKey Message key to be uesd
+ * @return string
+ */
+func (l *Language) formatComputingNumbers(size int64, boundary float64, messageKey string) string {
+	if size <= 0 {
+		number := l.FormatNum(strconv.FormatInt(size, 10), false)
+		return l.msg(strings.Replace(messageKey, "$1", "", -1), number).Text()
+	}
+	sizes := []string{"", "kilo", "mega", "giga", "tera", "peta", "exa", "zeta", "yotta"}
+	index := 0
+	value := float64(size)
+
+	maxIndex := len(sizes) - 1
+	for value >= boundary && index < maxIndex {
+		index++
+		value /= boundary
+	}
+
+	// For small sizes no decimal places necessary
+	if index > 1 {
+		// For MB and bigger two decimal places are smarter
+		value = math.Round(value*100) / 100
+	} else {
+		value = math.Round(value)
+	}
+	msg := strings.Replace(messageKey, "$1", sizes[index], -1)
+
+	return l.msg(msg, l.FormatNum(strconv.FormatFloat(value, 'f', -1, 64), false)).Text()
+}
+
+/**
+ * Decode an expiry (block, protection, etc) which has come from the DB
+ *
+ * @param string $expiry Database expiry String
+ * @param bool $format True to process using language functions, false
+ *   to return the timestamp as it is
+ * @param string $infinity If $format is not true, use this string for infinite expiry
+ * @return string
+ * @since 1.18
+ */
+func (l *Language) FormatExpiry(expiry string, format bool, infinity string) string {
+	if expiry == "" || expiry == "infinity" || expiry == "infinite" {
+		if format {
+			return l.msg("infiniteblock").Text()
+		}
+		return infinity
+	}
+	if format {
+		return l.Timeanddate(expiry)
+	}
+	return expiry
+}
+
+/**
+ * The date and time of a TS_MW timestamp, in the "H:i, j F Y" format
+ *
+ * @todo use the date formats of the language and the user preference
+ * @param mixed $ts The time format which needs to be turned into a
+ *   date('YmdHis') format with wfTimestamp(TS_MW,$ts)
+ * @return string
+ */
+func (l *Language) Timeanddate(ts string) string {
+	t, err := time.Parse("20060102150405", ts)
+	if err != nil {
+		return ts
+	}
+	month := strings.ToLower(t.Month().String())
+	return fmt.Sprintf("%02d:%02d, %d %s %d", t.Hour(), t.Minute(), t.Day(), l.msg(month).Text(), t.Year())
+}
+
+/**
+ * @param int $n
+ * @return int The absolute value of $n
+ */
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
 /**
  * Get special page names, as an associative array
  *   canonical name => array of valid names, including aliases
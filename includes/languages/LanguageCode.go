@@ -0,0 +1,161 @@
+/**
+ * Methods for dealing with language codes.
+ */
+package languages
+
+import "strings"
+
+/**
+ * Mapping of deprecated language codes that were used in previous
+ * versions of MediaWiki to up-to-date, current language codes.
+ * These may or may not be valid BCP 47 codes; they are included here
+ * because MediaWiki renamed these particular codes at some point.
+ *
+ * @since 1.30
+ */
+var deprecatedLanguageCodeMapping = map[string]string{
+	// Note that als is actually a valid ISO 639 code (Tosk Albanian), but it
+	// was previously used in MediaWiki for Alsatian, which comes under gsw
+	"als":          "gsw",       // T25215
+	"bat-smg":      "sgs",       // T27522
+	"be-x-old":     "be-tarask", // T11823
+	"fiu-vro":      "vro",       // T31186
+	"roa-rup":      "rup",       // T17988
+	"zh-classical": "lzh",       // T30443
+	"zh-min-nan":   "nan",       // T30442
+	"zh-yue":       "yue",       // T30441
+}
+
+/**
+ * Mapping of non-standard language codes used in MediaWiki to
+ * standardized BCP 47 codes.  These are not deprecated (yet?):
+ * IANA may eventually recognize the subtag, in which case the `-x-`
+ * can be removed.
+ *
+ * @since 1.32
+ */
+var nonStandardLanguageCodeMapping = map[string]string{
+	// All codes returned by Language::fetchLanguageNames() validated
+	// against IANA registry at
+	//   https://www.iana.org/assignments/language-subtag-registry/language-subtag-registry
+	// with help of validator at
+	//   http://schneegans.de/lv/
+	"cbk-zam":     "cbk", // T124657
+	"de-formal":   "de-x-formal",
+	"eml":         "egl", // T36217
+	"en-rtl":      "en-x-rtl",
+	"es-formal":   "es-x-formal",
+	"hu-formal":   "hu-x-formal",
+	"map-bms":     "jv-x-bms",   // [[en:Banyumasan_dialect]] T125073
+	"mo":          "ro-Cyrl-MD", // T125073
+	"nrm":         "nrf",        // [[en:Norman_language]] T25216
+	"nl-informal": "nl-x-informal",
+	"roa-tara":    "nap-x-tara", // [[en:Tarantino_dialect]]
+	"simple":      "en-simple",
+	"sr-ec":       "sr-Cyrl", // T117845
+	"sr-el":       "sr-Latn", // T117845
+}
+
+/**
+ * Methods for dealing with language codes.
+ * @todo Move some of the code-related static methods out of Language into this class
+ *
+ * @since 1.29
+ * @ingroup Language
+ */
+type LanguageCode struct{}
+
+/**
+ * Returns a mapping of deprecated language codes that were used in previous
+ * versions of MediaWiki to up-to-date, current language codes.
+ *
+ * This array is merged into $wgDummyLanguageCodes in Setup.php, along with
+ * the fake language codes 'qqq' and 'qqx', which are used internally by
+ * MediaWiki's localisation system.
+ *
+ * @return string[]
+ *
+ * @since 1.29
+ */
+func (l *LanguageCode) GetDeprecatedCodeMapping() map[string]string {
+	return deprecatedLanguageCodeMapping
+}
+
+/**
+ * Returns a mapping of non-standard language codes used by
+ * (current and previous version of) MediaWiki, mapped to standard
+ * BCP 47 names.
+ *
+ * This array is exported to JavaScript to ensure
+ * mediawiki.language.bcp47 stays in sync with LanguageCode::bcp47().
+ *
+ * @return string[]
+ *
+ * @since 1.32
+ */
+func (l *LanguageCode) GetNonstandardLanguageCodeMapping() map[string]string {
+	result := make(map[string]string)
+	for code := range deprecatedLanguageCodeMapping {
+		// Deprecated codes are mapped by their BCP 47 form
+		result[code] = l.Bcp47(code)
+	}
+	for code, bcp47 := range nonStandardLanguageCodeMapping {
+		result[code] = bcp47
+	}
+	return result
+}
+
+/**
+ * Replace deprecated language codes that were used in previous
+ * versions of MediaWiki to up-to-date, current language codes.
+ * Other values will returned unchanged.
+ *
+ * @param string $code Old language code
+ * @return string New language code
+ *
+ * @since 1.30
+ */
+func (l *LanguageCode) ReplaceDeprecatedCodes(code string) string {
+	if newCode, ok := deprecatedLanguageCodeMapping[code]; ok {
+		return newCode
+	}
+	return code
+}
+
+/**
+ * Get the normalised IETF language tag
+ * See unit test for examples.
+ * See mediawiki.language.bcp47 for the JavaScript implementation.
+ *
+ * @param string $code The language code.
+ * @return string The language code which complying with BCP 47 standards.
+ *
+ * @since 1.31
+ */
+func (l *LanguageCode) Bcp47(code string) string {
+	code = strings.ToLower(code)
+	if bcp47, ok := nonStandardLanguageCodeMapping[code]; ok {
+		return bcp47
+	}
+	code = l.ReplaceDeprecatedCodes(code)
+
+	codeSegment := strings.Split(code, "-")
+	codeBCP := make([]string, len(codeSegment))
+	for segNo, seg := range codeSegment {
+		switch {
+		// when previous segment is x, it is a private segment and should be lc
+		case segNo > 0 && codeSegment[segNo-1] == "x":
+			codeBCP[segNo] = seg
+		// ISO 3166 country code
+		case len(seg) == 2 && segNo > 0:
+			codeBCP[segNo] = strings.ToUpper(seg)
+		// ISO 15924 script code
+		case len(seg) == 4 && segNo > 0:
+			codeBCP[segNo] = strings.ToUpper(seg[:1]) + seg[1:]
+		// Use lowercase for other cases
+		default:
+			codeBCP[segNo] = seg
+		}
+	}
+	return strings.Join(codeBCP, "-")
+}
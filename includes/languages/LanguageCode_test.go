@@ -0,0 +1,33 @@
+package languages
+
+import (
+	test "github.com/MangoDowner/mediawiki/tests"
+	"testing"
+)
+
+/**
+ * @covers LanguageCode::bcp47
+ */
+func TestBcp47(t *testing.T) {
+	lc := new(LanguageCode)
+
+	cases := [][2]string{
+		// Extracted from BCP 47 (list not exhaustive)
+		{"en-ca-x-ca", "en-CA-x-ca"},
+		{"sgn-be-fr", "sgn-BE-FR"},
+		{"az-latn-x-latn", "az-Latn-x-latn"},
+		{"zh-hans", "zh-Hans"},
+		{"zh-hant-tw", "zh-Hant-TW"},
+		{"sr-latn-rs", "sr-Latn-RS"},
+		{"en-us-x-twain", "en-US-x-twain"},
+		{"EN", "en"},
+		// MediaWiki specific codes
+		{"simple", "en-simple"},
+		{"sr-ec", "sr-Cyrl"},
+		{"zh-classical", "lzh"},
+		{"be-x-old", "be-tarask"},
+	}
+	for _, c := range cases {
+		test.AssetEqual(c[1], lc.Bcp47(c[0]), c[0])
+	}
+}
@@ -0,0 +1,616 @@
+/**
+ * Contains the LanguageConverter class and ConverterRule class
+ */
+package languages
+
+import (
+	"github.com/MangoDowner/mediawiki/includes/php"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/**
+ * languages which have variants
+ * @var string[]
+ */
+var LanguagesWithVariants = []string{
+	"gan", "iu", "kk", "ku", "shi", "sr", "tg", "uz", "zh",
+}
+
+/**
+ * Bump this whenever the conversion table format has changed.
+ */
+const CONVERTER_CACHE_VERSION_KEY = "VERSION 7"
+
+/**
+ * Max nesting depth of -{ }- conversion markup.
+ */
+const CONVERTER_MAX_DEPTH = 10
+
+/**
+ * Matches the pieces of HTML which must not be converted: whole script, pre
+ * and code blocks, tags with their attributes, and character entities.
+ */
+var converterNoConvertRegex = regexp.MustCompile(
+	`(?is)<script[\s>].*?</script>|<pre[\s>].*?</pre>|<code[\s>].*?</code>|<[^>]*>|&[a-z#][a-z0-9]*;`)
+
+/**
+ * Base class for language conversion.
+ * @ingroup Language
+ */
+type LanguageConverter struct {
+	mMainLanguageCode string
+
+	/**
+	 * @var string[]
+	 */
+	mVariants []string
+
+	/**
+	 * @var array Variant code => fallback variant codes, in order of preference
+	 */
+	mVariantFallbacks map[string][]string
+
+	/**
+	 * @var array Variant code => readable name of the variant
+	 */
+	mVariantNames map[string]string
+
+	mTablesLoaded sync.Once
+
+	/**
+	 * @var ReplacementArray[]
+	 */
+	mTables map[string]*ReplacementArray
+
+	/**
+	 * @var Language
+	 */
+	mLangObj *Language
+
+	mDescCodeSep string
+	mDescVarSep  string
+
+	/**
+	 * Loads the built-in conversion tables. Left nil for languages
+	 * that only declare variants.
+	 */
+	loadDefaultTables func() map[string]*ReplacementArray
+}
+
+/**
+ * Per-conversion state. Conversion rules added with -{A|...}- or
+ * -{H|...}- only apply to the rest of the text being converted, and
+ * -{T|...}- sets the title for that text only, so they are not kept on the
+ * (shared) converter.
+ */
+type converterState struct {
+	manual    map[string]*ReplacementArray
+	ruleTitle string
+}
+
+/**
+ * @param Language $langobj
+ * @param string $maincode The main language code of this language
+ * @param string[] $variants The supported variants of this language
+ * @param array $variantfallbacks The fallback language of each variant
+ * @param array $variantNames Readable names of the variants, used by -{N|}-
+ *   and -{D|}- markup
+ */
+func NewLanguageConverter(langobj *Language, maincode string, variants []string,
+	variantfallbacks map[string][]string, variantNames map[string]string) *LanguageConverter {
+	this := new(LanguageConverter)
+	this.mLangObj = langobj
+	this.mMainLanguageCode = maincode
+	this.mVariants = variants
+	this.mVariantFallbacks = variantfallbacks
+	if this.mVariantFallbacks == nil {
+		this.mVariantFallbacks = make(map[string][]string)
+	}
+	this.mVariantNames = variantNames
+	if this.mVariantNames == nil {
+		this.mVariantNames = make(map[string]string)
+	}
+	this.mDescCodeSep = ":"
+	this.mDescVarSep = ";"
+	return this
+}
+
+/**
+ * A converter used for languages without variants: the language code is
+ * the only variant, and every conversion returns the text unchanged
+ * (conversion markup is still unwrapped).
+ *
+ * @param Language $langobj
+ * @return LanguageConverter
+ */
+func NewFakeConverter(langobj *Language) *LanguageConverter {
+	code := langobj.GetCode()
+	return NewLanguageConverter(langobj, code, []string{code}, nil, nil)
+}
+
+/**
+ * Get all valid variants.
+ * Call this instead of using $this->mVariants directly.
+ *
+ * @return string[] Contains all valid variants
+ */
+func (c *LanguageConverter) GetVariants() []string {
+	return c.mVariants
+}
+
+/**
+ * In case some variant is not defined in the markup, we need
+ * to have some fallback. For example, in zh, normally people
+ * will define zh-hans and zh-hant, but less so for zh-sg or zh-hk.
+ * when zh-sg is preferred but not defined, we will pick zh-hans
+ * in this case. Right now this is only used by zh.
+ *
+ * @param string $variant The language code of the variant
+ * @return string[] The codes of the fallback languages, in order
+ */
+func (c *LanguageConverter) GetVariantFallbacks(variant string) []string {
+	if fallbacks, ok := c.mVariantFallbacks[variant]; ok {
+		return fallbacks
+	}
+	return []string{c.mMainLanguageCode}
+}
+
+/**
+ * Get the readable name of a variant, or the code if it has none.
+ *
+ * @param string $variant
+ * @return string
+ */
+func (c *LanguageConverter) GetVariantName(variant string) string {
+	if name, ok := c.mVariantNames[variant]; ok {
+		return name
+	}
+	return variant
+}
+
+/**
+ * Validate the variant
+ * @param string|null $variant The variant to validate
+ * @return mixed Returns the variant if it is valid, "" otherwise
+ */
+func (c *LanguageConverter) ValidateVariant(variant string) string {
+	if variant == "" {
+		return ""
+	}
+	// Our internal variants are always lower-case; the variant we
+	// are validating may have mixed case.
+	variant = strings.ToLower(variant)
+	if strings.HasPrefix(variant, "zh-") {
+		// Script subtags are sent by some browsers, e.g. zh-Hans-CN
+		variant = strings.Replace(variant, "-hans-", "-", 1)
+		variant = strings.Replace(variant, "-hant-", "-", 1)
+	}
+	if php.InArray(variant, c.mVariants) {
+		return variant
+	}
+	return ""
+}
+
+/**
+ * Get the variant specified in the URL
+ *
+ * @param WebRequest $request
+ * @return mixed Variant if one found, "" otherwise.
+ */
+func (c *LanguageConverter) GetURLVariant(request IWebRequest) string {
+	if request == nil {
+		return ""
+	}
+	ret := request.GetVal("variant", "")
+	if ret == "" {
+		ret = request.GetVal("uselang", "")
+	}
+	return c.ValidateVariant(ret)
+}
+
+/**
+ * Determine if the user has a variant set.
+ *
+ * @param User $user
+ * @return mixed Variant if one found, "" otherwise.
+ */
+func (c *LanguageConverter) GetUserVariant(user IUser) string {
+	// Get language variant preference from logged in users
+	if user == nil || !user.IsLoggedIn() {
+		return ""
+	}
+	ret := user.GetOption("variant-" + c.mMainLanguageCode)
+	if ret == "" {
+		ret = user.GetOption("variant")
+	}
+	return c.ValidateVariant(ret)
+}
+
+/**
+ * Determine the language variant from the Accept-Language header.
+ *
+ * @param WebRequest $request
+ * @return mixed Variant if one found, "" otherwise.
+ */
+func (c *LanguageConverter) GetHeaderVariant(request IWebRequest) string {
+	if request == nil {
+		return ""
+	}
+	// See if some supported language variant is set in the
+	// HTTP header.
+	languages := parseAcceptLanguage(request.GetHeader("Accept-Language"))
+	var fallbackLanguages []string
+	for _, language := range languages {
+		if variant := c.ValidateVariant(language); variant != "" {
+			return variant
+		}
+		// To see if there are fallbacks in current language.
+		// We record these fallback variants, and process
+		// them later.
+		if fallbacks, ok := c.mVariantFallbacks[language]; ok {
+			fallbackLanguages = append(fallbackLanguages, fallbacks...)
+		}
+	}
+	// process fallback languages now
+	for _, language := range fallbackLanguages {
+		if variant := c.ValidateVariant(language); variant != "" {
+			return variant
+		}
+	}
+	return ""
+}
+
+/**
+ * Get preferred language variant.
+ *
+ * The variant given with ?variant= (or ?uselang=) wins, then the user
+ * preference for logged-in users, then the Accept-Language header.
+ * Pages served this way must vary on Accept-Language, see
+ * OutputPage::addAcceptLanguage().
+ *
+ * @param WebRequest|null $request
+ * @param User|null $user
+ * @return string The preferred language code
+ */
+func (c *LanguageConverter) GetPreferredVariant(request IWebRequest, user IUser) string {
+	if req := c.GetURLVariant(request); req != "" {
+		return req
+	}
+	if req := c.GetUserVariant(user); req != "" {
+		return req
+	}
+	if req := c.GetHeaderVariant(request); req != "" {
+		return req
+	}
+	return c.mMainLanguageCode
+}
+
+/**
+ * Load default conversion tables.
+ */
+func (c *LanguageConverter) loadTables() {
+	c.mTablesLoaded.Do(func() {
+		if c.loadDefaultTables != nil {
+			c.mTables = c.loadDefaultTables()
+		}
+		if c.mTables == nil {
+			c.mTables = make(map[string]*ReplacementArray)
+		}
+	})
+}
+
+/**
+ * Translate a string to a variant.
+ * Doesn't parse rules or do any of that other stuff, for that use
+ * convert() or convertTo().
+ *
+ * @param string $text Text to convert
+ * @param string $variant Variant language code
+ * @return string Translated text
+ */
+func (c *LanguageConverter) Translate(text, variant string) string {
+	return c.translate(text, variant, nil)
+}
+
+func (c *LanguageConverter) translate(text, variant string, state *converterState) string {
+	// If $text is empty or only includes spaces, do nothing
+	// Otherwise translate it
+	if strings.TrimSpace(text) == "" {
+		return text
+	}
+	c.loadTables()
+	table := c.mTables[variant]
+	if state != nil {
+		if manual, ok := state.manual[variant]; ok && !manual.IsEmpty() {
+			// Rules added by markup take precedence over the built-in table
+			merged := NewReplacementArray(nil)
+			merged.Merge(table)
+			merged.Merge(manual)
+			table = merged
+		}
+	}
+	if table == nil {
+		return text
+	}
+	return table.Replace(text)
+}
+
+/**
+ * Dictionary-based conversion.
+ * This function would not parse the conversion rules.
+ * If you want to parse rules, try to use convert() or
+ * convertTo().
+ *
+ * HTML tags, entities and the contents of script, pre and code elements
+ * are left untouched.
+ *
+ * @param string $text The text to be converted
+ * @param bool|string $toVariant The target language code
+ * @return string The converted text
+ */
+func (c *LanguageConverter) AutoConvert(text, toVariant string) string {
+	return c.autoConvert(text, toVariant, nil)
+}
+
+func (c *LanguageConverter) autoConvert(text, toVariant string, state *converterState) string {
+	if toVariant == "" || !php.InArray(toVariant, c.mVariants) || text == "" {
+		return text
+	}
+	var ret strings.Builder
+	startPos := 0
+	for _, loc := range converterNoConvertRegex.FindAllStringIndex(text, -1) {
+		ret.WriteString(c.translate(text[startPos:loc[0]], toVariant, state))
+		ret.WriteString(text[loc[0]:loc[1]])
+		startPos = loc[1]
+	}
+	ret.WriteString(c.translate(text[startPos:], toVariant, state))
+	return ret.String()
+}
+
+/**
+ * Call translate() to convert text to all valid variants.
+ *
+ * @param string $text The text to be converted
+ * @return array Variant => converted text
+ */
+func (c *LanguageConverter) AutoConvertToAllVariants(text string) map[string]string {
+	ret := make(map[string]string, len(c.mVariants))
+	for _, variant := range c.mVariants {
+		ret[variant] = c.Translate(text, variant)
+	}
+	return ret
+}
+
+/**
+ * Convert text to a variant, parsing -{ }- conversion markup.
+ *
+ * @param string $text Text to convert
+ * @param string $variant The target variant code
+ * @return string Converted text
+ */
+func (c *LanguageConverter) ConvertTo(text, variant string) string {
+	ret, _ := c.ConvertWithRuleTitle(text, variant)
+	return ret
+}
+
+/**
+ * Convert text to a variant, also returning the title set in the text with
+ * -{T|...}- markup, or "" if there is none.
+ *
+ * @param string $text Text to convert
+ * @param string $variant The target variant code
+ * @return array Converted text and title
+ */
+func (c *LanguageConverter) ConvertWithRuleTitle(text, variant string) (string, string) {
+	state := &converterState{manual: make(map[string]*ReplacementArray)}
+	return c.recursiveConvertTopLevel(text, variant, 0, state), state.ruleTitle
+}
+
+/**
+ * Convert text to the variant preferred for the given request and user.
+ *
+ * @param string $text Text to be converted
+ * @param WebRequest|null $request
+ * @param User|null $user
+ * @return string Converted text
+ */
+func (c *LanguageConverter) Convert(text string, request IWebRequest, user IUser) string {
+	return c.ConvertTo(text, c.GetPreferredVariant(request, user))
+}
+
+/**
+ * Recursively convert text on the outside. Allow to use nested
+ * markups to custom rules.
+ *
+ * @param string $text Text to be converted
+ * @param string $variant The target variant code
+ * @param int $depth Depth of recursion
+ * @return string Converted text
+ */
+func (c *LanguageConverter) recursiveConvertTopLevel(text, variant string, depth int,
+	state *converterState) string {
+	var out strings.Builder
+	startPos := 0
+	for startPos < len(text) {
+		pos := strings.Index(text[startPos:], "-{")
+		if pos < 0 {
+			break
+		}
+		pos += startPos
+		// Markup found
+		// Append initial segment
+		out.WriteString(c.autoConvert(text[startPos:pos], variant, state))
+		// Advance position
+		startPos = pos + 2
+		// Do recursive conversion
+		out.WriteString(c.recursiveConvertRule(text, variant, &startPos, depth+1, state))
+	}
+	// Append remaining text
+	if startPos < len(text) {
+		out.WriteString(c.autoConvert(text[startPos:], variant, state))
+	}
+	return out.String()
+}
+
+/**
+ * Recursively convert text on the inside.
+ *
+ * @param string $text Text to be converted
+ * @param string $variant The target variant code
+ * @param int &$startPos
+ * @param int $depth Depth of recursion
+ * @return string Converted text
+ */
+func (c *LanguageConverter) recursiveConvertRule(text, variant string, startPos *int, depth int,
+	state *converterState) string {
+	if depth > CONVERTER_MAX_DEPTH {
+		// Too deeply nested; leave the opening markup as plain text.
+		return "-{"
+	}
+	var inner strings.Builder
+	for *startPos < len(text) {
+		rest := text[*startPos:]
+		open := strings.Index(rest, "-{")
+		closing := strings.Index(rest, "}-")
+		if closing < 0 {
+			break
+		}
+		if open >= 0 && open < closing {
+			// Nested markup: convert it and append the result to the rule
+			inner.WriteString(rest[:open])
+			*startPos += open + 2
+			inner.WriteString(c.recursiveConvertRule(text, variant, startPos, depth+1, state))
+			continue
+		}
+		inner.WriteString(rest[:closing])
+		*startPos += closing + 2
+		rule := NewConverterRule(inner.String(), c)
+		rule.Apply(variant)
+		c.applyManualConv(rule, state)
+		return rule.GetDisplay()
+	}
+	// Unclosed markup: output it as it was written
+	ret := "-{" + inner.String() + text[*startPos:]
+	*startPos = len(text)
+	return ret
+}
+
+/**
+ * Apply manual conversion rules.
+ *
+ * @param ConverterRule $convRule
+ */
+func (c *LanguageConverter) applyManualConv(convRule *ConverterRule, state *converterState) {
+	// Use syntax -{T|zh-cn:TitleCN; zh-tw:TitleTw}- to custom
+	// title conversion.
+	if title := convRule.GetTitle(); title != "" {
+		state.ruleTitle = title
+	}
+
+	// merge/remove manual conversion rules to/from global table
+	convTable := convRule.GetConvTable()
+	action := convRule.GetRulesAction()
+	for variant, pairs := range convTable {
+		if !php.InArray(variant, c.mVariants) {
+			continue
+		}
+		if _, ok := state.manual[variant]; !ok {
+			state.manual[variant] = NewReplacementArray(nil)
+		}
+		if action == "add" {
+			state.manual[variant].MergeArray(pairs)
+		} else if action == "remove" {
+			state.manual[variant].RemoveArray(pairs)
+		}
+	}
+}
+
+/**
+ * Auto convert a page title to a readable string in the given variant.
+ *
+ * @param string $nsText Namespace text, "" for the main namespace
+ * @param string $text Title text
+ * @param string $variant The target variant code
+ * @return string Converted title text
+ */
+func (c *LanguageConverter) ConvertTitle(nsText, text, variant string) string {
+	text = strings.Replace(text, "_", " ", -1)
+	ret := c.Translate(text, variant)
+	if nsText != "" {
+		ret = c.Translate(strings.Replace(nsText, "_", " ", -1), variant) + ":" + ret
+	}
+	return ret
+}
+
+/**
+ * If a language supports multiple variants, it is possible that
+ * non-existing link in one variant actually exists in another variant.
+ * This function tries to find it. See e.g. LanguageZh.php
+ * The input parameters may be modified upon return
+ *
+ * @param string $link The name of the link
+ * @param callable $exists Returns true if a page with the given title exists
+ * @return string The title of an existing variant of the link, or $link
+ */
+func (c *LanguageConverter) FindVariantLink(link string, exists func(string) bool) string {
+	if link == "" || exists == nil || exists(link) {
+		return link
+	}
+	if strings.Contains(link, "-{") {
+		// Titles with conversion markup are handled by the parser
+		return link
+	}
+	for _, variant := range c.mVariants {
+		v := c.Translate(link, variant)
+		if v != link && exists(v) {
+			return v
+		}
+	}
+	return link
+}
+
+/**
+ * Parse an Accept-Language header into language codes, most preferred
+ * first. Codes with q=0 are dropped.
+ *
+ * @param string $header
+ * @return string[]
+ */
+func parseAcceptLanguage(header string) []string {
+	type langQ struct {
+		code string
+		q    float64
+	}
+	var langs []langQ
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		code := strings.ToLower(strings.TrimSpace(fields[0]))
+		if code == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		if q > 0 {
+			langs = append(langs, langQ{code, q})
+		}
+	}
+	sort.SliceStable(langs, func(i, j int) bool {
+		return langs[i].q > langs[j].q
+	})
+	ret := make([]string, 0, len(langs))
+	for _, l := range langs {
+		ret = append(ret, l.code)
+	}
+	return ret
+}
@@ -0,0 +1,249 @@
+package languages
+
+import (
+	test "github.com/MangoDowner/mediawiki/tests"
+	"testing"
+)
+
+type dummyWebRequest struct {
+	values  map[string]string
+	headers map[string]string
+}
+
+func (d *dummyWebRequest) GetVal(name string, defaultVal string) string {
+	if v, ok := d.values[name]; ok {
+		return v
+	}
+	return defaultVal
+}
+
+func (d *dummyWebRequest) GetHeader(name string) string {
+	return d.headers[name]
+}
+
+type dummyUser struct {
+	options map[string]string
+}
+
+func (d *dummyUser) IsLoggedIn() bool {
+	return true
+}
+
+func (d *dummyUser) GetOption(oname string) string {
+	return d.options[oname]
+}
+
+/**
+ * @covers LanguageConverter::getPreferredVariant
+ * @covers LanguageConverter::getHeaderVariant
+ * @covers LanguageConverter::getURLVariant
+ */
+func TestGetPreferredVariant(t *testing.T) {
+	lang := Factory("zh")
+
+	test.AssertEqual(t,
+		"zh",
+		lang.GetPreferredVariant(nil, nil),
+		`Main language code without request`,
+	)
+
+	test.AssertEqual(t,
+		"zh-tw",
+		lang.GetPreferredVariant(&dummyWebRequest{
+			headers: map[string]string{"Accept-Language": "en;q=0.5, zh-tw;q=0.8"},
+		}, nil),
+		`Variant from Accept-Language header, by quality`,
+	)
+
+	test.AssertEqual(t,
+		"zh-cn",
+		lang.GetPreferredVariant(&dummyWebRequest{
+			headers: map[string]string{"Accept-Language": "zh-Hans-CN"},
+		}, nil),
+		`Script subtag in Accept-Language header`,
+	)
+
+	test.AssertEqual(t,
+		"zh-hant",
+		lang.GetPreferredVariant(&dummyWebRequest{
+			values:  map[string]string{"variant": "zh-hant"},
+			headers: map[string]string{"Accept-Language": "zh-cn"},
+		}, &dummyUser{options: map[string]string{"variant": "zh-hk"}}),
+		`URL variant wins over user preference and header`,
+	)
+
+	test.AssertEqual(t,
+		"zh-hk",
+		lang.GetPreferredVariant(&dummyWebRequest{
+			headers: map[string]string{"Accept-Language": "zh-cn"},
+		}, &dummyUser{options: map[string]string{"variant": "zh-hk"}}),
+		`User preference wins over header`,
+	)
+
+	test.AssertEqual(t,
+		"zh",
+		lang.GetPreferredVariant(&dummyWebRequest{
+			values: map[string]string{"variant": "sr-el"},
+		}, nil),
+		`Invalid URL variant is ignored`,
+	)
+}
+
+/**
+ * @covers LanguageConverter::autoConvert
+ * @covers ReplacementArray::replace
+ */
+func TestAutoConvert(t *testing.T) {
+	lang := Factory("zh")
+
+	test.AssertEqual(t,
+		"這個軟體",
+		lang.AutoConvert("这个软件", "zh-tw"),
+		`Regional phrase is preferred over characters`,
+	)
+
+	test.AssertEqual(t,
+		"這個軟件",
+		lang.AutoConvert("这个软件", "zh-hk"),
+		`Regional phrase for zh-hk`,
+	)
+
+	test.AssertEqual(t,
+		"这个软件",
+		lang.AutoConvert("這個軟體", "zh-cn"),
+		`Traditional to mainland`,
+	)
+
+	test.AssertEqual(t,
+		`<a title="这个">這個</a>`,
+		lang.AutoConvert(`<a title="这个">这个</a>`, "zh-hant"),
+		`HTML attributes are not converted`,
+	)
+
+	test.AssertEqual(t,
+		"Beograd Njegoš",
+		Factory("sr").AutoConvert("Београд Његош", "sr-el"),
+		`Cyrillic to Latin`,
+	)
+
+	test.AssertEqual(t,
+		"Његош",
+		Factory("sr").AutoConvert("Njegoš", "sr-ec"),
+		`Latin digraphs to Cyrillic`,
+	)
+}
+
+/**
+ * @covers LanguageConverter::convertTo
+ * @covers ConverterRule::apply
+ */
+func TestConvertMarkup(t *testing.T) {
+	lang := Factory("zh")
+
+	test.AssertEqual(t,
+		"这个",
+		lang.ConvertTo("-{这个}-", "zh-tw"),
+		`Raw text in markup is not converted`,
+	)
+
+	test.AssertEqual(t,
+		"記憶體",
+		lang.ConvertTo("-{zh-cn:内存;zh-tw:記憶體;}-", "zh-tw"),
+		`Bidirectional rule`,
+	)
+
+	test.AssertEqual(t,
+		"内存",
+		lang.ConvertTo("-{zh-hans:内存;zh-hant:記憶體;}-", "zh-sg"),
+		`Bidirectional rule with variant fallback`,
+	)
+
+	test.AssertEqual(t,
+		"A甲 甲",
+		lang.ConvertTo("-{A|zh-hans:A乙;zh-hant:A甲}- -{H|zh-hans:乙;zh-hant:甲}-乙", "zh-tw"),
+		`Rules added with A and H flags apply to the rest of the text`,
+	)
+
+	test.AssertEqual(t,
+		"乙",
+		lang.ConvertTo("乙", "zh-tw"),
+		`Added rules do not leak to later conversions`,
+	)
+
+	test.AssertEqual(t,
+		"繁體",
+		lang.ConvertTo("-{N|zh-hant}-", "zh-cn"),
+		`Variant name`,
+	)
+
+	text, title := lang.GetConverter().ConvertWithRuleTitle("-{T|zh-cn:标题;zh-tw:標題}-這個", "zh-cn")
+	test.AssertEqual(t, "这个", text, `Title rule is not displayed`)
+	test.AssertEqual(t, "标题", title, `Title rule`)
+
+	test.AssertEqual(t,
+		"a -{ b",
+		lang.ConvertTo("a -{ b", "zh-cn"),
+		`Unclosed markup is left as it is`,
+	)
+}
+
+/**
+ * @covers LanguageConverter::findVariantLink
+ */
+func TestFindVariantLink(t *testing.T) {
+	lang := Factory("zh")
+	exists := func(title string) bool {
+		return title == "軟體"
+	}
+
+	test.AssertEqual(t,
+		"軟體",
+		lang.FindVariantLink("软件", exists),
+		`Existing variant of a link`,
+	)
+
+	test.AssertEqual(t,
+		"不存在",
+		lang.FindVariantLink("不存在", exists),
+		`No variant exists`,
+	)
+}
+
+/**
+ * @covers ReplacementArray::replace
+ * @covers ReplacementArray::setPair
+ */
+func TestReplacementArray(t *testing.T) {
+	r := NewReplacementArray(map[string]string{"a": "1"})
+	r.SetPair("ab", "2")
+	r.MergeArray(map[string]string{"abc": "3", "b": "4"})
+	test.AssertEqual(t, "3 2 1 4", r.Replace("abc ab a b"), `Longest keys first`)
+	r.RemovePair("abc")
+	test.AssertEqual(t, "2c", r.Replace("abc"), `Removed key`)
+
+	// The tables are shared by the requests
+	lang := Factory("zh")
+	done := make(chan string)
+	for i := 0; i < 8; i++ {
+		go func() {
+			done <- lang.AutoConvert("这个软件", "zh-tw")
+		}()
+	}
+	for i := 0; i < 8; i++ {
+		test.AssertEqual(t, "這個軟體", <-done, `Concurrent conversions`)
+	}
+}
+
+/**
+ * @covers ConverterRule::getRulesDesc
+ * @covers ConverterRule::getRuleConvertedStr
+ */
+func TestUnidirectionalRules(t *testing.T) {
+	lang := Factory("zh")
+	for i := 0; i < 10; i++ {
+		test.AssertEqual(t, "乙⇒大陆：丙；甲⇒大陆：丁；",
+			lang.ConvertTo("-{D|甲=>zh-cn:丁;乙=>zh-cn:丙}-", "zh-cn"), `Rules described in a stable order`)
+		test.AssertEqual(t, "丙", lang.ConvertTo("-{甲=>zh-cn:丁;乙=>zh-cn:丙}-", "zh-cn"),
+			`Same rule displayed every time`)
+	}
+}
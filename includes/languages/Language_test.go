@@ -3,6 +3,9 @@ package languages
 import (
 	"fmt"
 	test "github.com/MangoDowner/mediawiki/tests"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -32,39 +35,406 @@ func TestUc(t *testing.T) {
 func TestBuiltInCodeValidation(t *testing.T) {
 	l := new(Language)
 
-	test.AssetEqual(
+	test.AssertEqual(t,
 		true,
 		l.IsValidBuiltInCode("fr"),
 		`Two letters, minor case`,
 	)
 
-	test.AssetEqual(
+	test.AssertEqual(t,
 		false,
 		l.IsValidBuiltInCode("EN"),
 		`Two letters, upper case`,
 	)
 
-	test.AssetEqual(
+	test.AssertEqual(t,
 		true,
 		l.IsValidBuiltInCode("tyv"),
 		`Three letters`,
 	)
 
-	test.AssetEqual(
+	test.AssertEqual(t,
 		true,
 		l.IsValidBuiltInCode("be-tarask"),
 		`With dash`,
 	)
 
-	test.AssetEqual(
+	test.AssertEqual(t,
 		true,
 		l.IsValidBuiltInCode("be-x-old"),
 		`With extension (two dashes)`,
 	)
 
-	test.AssetEqual(
+	test.AssertEqual(t,
 		false,
 		l.IsValidBuiltInCode("be_tarask"),
 		`Reject underscores`,
 	)
-}
\ No newline at end of file
+}
+/**
+ * @covers Language::uc
+ * @covers Language::lc
+ * @covers Language::ucfirst
+ * @covers Language::lcfirst
+ */
+func TestTurkishCase(t *testing.T) {
+	tr := Factory("tr")
+
+	test.AssertEqual(t, "İSTANBUL", tr.Uc("istanbul", false), `Dotted i is uppercased to İ`)
+	test.AssertEqual(t, "ılık", tr.Lc("ILIK", false), `Dotless I is lowercased to ı`)
+	test.AssertEqual(t, "İzmir", tr.Ucfirst("izmir"), `ucfirst with dotted i`)
+	test.AssertEqual(t, "ırmak", tr.Lcfirst("Irmak"), `lcfirst with dotless I`)
+	test.AssertEqual(t, "Istanbul", Factory("en").Ucfirst("istanbul"), `Other languages are not affected`)
+	test.AssertEqual(t, tr.CaseFold("ılık"), tr.CaseFold("ILIK"), `Case folding uses the language rules`)
+	test.AssertEqual(t, "", tr.Ucfirst(""), `Empty string`)
+}
+
+/**
+ * @covers Language::convertGrammar
+ * @covers Language::getGrammarTransformations
+ */
+func TestConvertGrammar(t *testing.T) {
+	ru := Factory("ru")
+
+	test.AssertEqual(t, "Википедии", ru.ConvertGrammar("Википедия", "genitive"), `Genitive`)
+	test.AssertEqual(t, "Викисловаре", ru.ConvertGrammar("Викисловарь", "prepositional"), `Prepositional`)
+	test.AssertEqual(t, "по-русски", ru.ConvertGrammar("русский", "languageadverb"), `Replacement followed by letters`)
+	test.AssertEqual(t, "Викиновости", ru.ConvertGrammar("Викиновости", "nominative"), `Unknown case`)
+	test.AssertEqual(t, "Wikipedia", Factory("en").ConvertGrammar("Wikipedia", "genitive"), `Language without rules`)
+}
+
+/**
+ * @covers Language::gender
+ */
+func TestConvertGender(t *testing.T) {
+	l := NewLanguage()
+
+	test.AssertEqual(t, "he", l.ConvertGender("male", []string{"he", "she", "they"}), `Male`)
+	test.AssertEqual(t, "she", l.ConvertGender("female", []string{"he", "she", "they"}), `Female`)
+	test.AssertEqual(t, "they", l.ConvertGender("unknown", []string{"he", "she", "they"}), `Unknown`)
+	test.AssertEqual(t, "he", l.ConvertGender("unknown", []string{"he", "she"}), `Unknown without third form`)
+	test.AssertEqual(t, "they", l.ConvertGender("female", []string{"they"}), `Single form`)
+	test.AssertEqual(t, "", l.ConvertGender("male", []string{}), `No forms`)
+}
+
+/**
+ * @covers Language::isRTL
+ * @covers Language::getDir
+ * @covers Language::getDirMark
+ * @covers Language::getDirMarkEntity
+ */
+func TestDirectionality(t *testing.T) {
+	en := Factory("en")
+	he := Factory("he")
+
+	test.AssertEqual(t, false, en.IsRTL(), `English is LTR`)
+	test.AssertEqual(t, true, he.IsRTL(), `Hebrew is RTL`)
+	test.AssertEqual(t, "rtl", he.GetDir(), `Direction of Hebrew`)
+	test.AssertEqual(t, "‎", en.GetDirMark(false), `LRM for LTR`)
+	test.AssertEqual(t, "‎", he.GetDirMark(true), `Opposite mark for RTL`)
+	test.AssertEqual(t, "&rlm;", he.GetDirMarkEntity(false), `RLM entity for RTL`)
+	test.AssertEqual(t, "&rlm;", en.GetDirMarkEntity(true), `Opposite entity for LTR`)
+}
+
+/**
+ * @covers Language::embedBidi
+ */
+func TestEmbedBidi(t *testing.T) {
+	l := NewLanguage()
+
+	test.AssertEqual(t, "‪Ben_(WMF)‬", l.EmbedBidi("Ben_(WMF)"), `LTR text`)
+	test.AssertEqual(t, "‫(שלום) abc‬", l.EmbedBidi("(שלום) abc"), `First strong character is RTL`)
+	test.AssertEqual(t, "123 (!)", l.EmbedBidi("123 (!)"), `No strong directionality`)
+	test.AssertEqual(t, "", l.EmbedBidi(""), `Empty string`)
+}
+
+/**
+ * @covers Language::checkTitleEncoding
+ */
+func TestCheckTitleEncoding(t *testing.T) {
+	l := NewLanguage()
+
+	test.AssertEqual(t, "Café", l.CheckTitleEncoding("Café"), `Valid UTF-8 is left alone`)
+	test.AssertEqual(t, "Café €", l.CheckTitleEncoding("Caf\xe9 \x80"), `Windows-1252 is converted`)
+}
+
+type dummyHooks struct {
+	translated map[string]string
+	/** @var bool Whether the handler fetches language names itself */
+	reentrant bool
+}
+
+func (d *dummyHooks) Run(event string, args []interface{}, deprecatedVersion string) bool {
+	if event == "LanguageGetTranslatedLanguageNames" && d.reentrant {
+		names := args[0].(*map[string]string)
+		(*names)["de"] = NewLanguage().FetchLanguageName("de", AS_AUTONYMS, "") + " (fr)"
+	}
+	if event == "LanguageGetTranslatedLanguageNames" && args[1].(string) == "fr" {
+		names := args[0].(*map[string]string)
+		for code, name := range d.translated {
+			(*names)[code] = name
+		}
+	}
+	return true
+}
+
+/**
+ * @covers Language::fetchLanguageNames
+ * @covers Language::fetchLanguageName
+ */
+func TestFetchLanguageNames(t *testing.T) {
+	l := NewLanguage()
+	oldRunner := HookRunner
+	HookRunner = &dummyHooks{translated: map[string]string{"de": "langue allemande"}}
+	defer func() {
+		HookRunner = oldRunner
+		ClearCaches()
+	}()
+	ClearCaches()
+
+	test.AssertEqual(t, "Deutsch", l.FetchLanguageName("de", AS_AUTONYMS, ""), `Autonym`)
+	test.AssertEqual(t, "German", l.FetchLanguageName("de", "en", ""), `Name in English`)
+	test.AssertEqual(t, "Deutsch", l.FetchLanguageName("de", "de", ""), `Own name is preferred in the language itself`)
+	test.AssertEqual(t, "Deutsch", l.FetchLanguageName("de", "de-at", ""), `CLDR names fall back to the base language`)
+	test.AssertEqual(t, "langue allemande", l.FetchLanguageName("de", "fr", ""), `Names from the LanguageGetTranslatedLanguageNames hook`)
+	test.AssertEqual(t, "中文（简体）‎", l.FetchLanguageName("ZH-HANS", AS_AUTONYMS, ""), `Code is case-insensitive`)
+	test.AssertEqual(t, "", l.FetchLanguageName("xyz", AS_AUTONYMS, ""), `Unknown language`)
+	test.AssertEqual(t, "English", l.FetchLanguageName("en", "<bad>", ""), `Invalid language falls back to English`)
+
+	test.AssertEqual(t,
+		len(l.FetchLanguageNames(AS_AUTONYMS, "mw")),
+		len(l.FetchLanguageNames("en", "mw")),
+		`The mw list has the same codes in every language`,
+	)
+	test.AssertEqual(t, 0, len(l.FetchLanguageNames(AS_AUTONYMS, SUPPORTED)), `No message files`)
+
+	names := l.FetchLanguageNames("en", "mw")
+	names["de"] = "Changed"
+	test.AssertEqual(t, "German", l.FetchLanguageNames("en", "mw")["de"], `Callers get a copy`)
+
+	ClearCaches()
+	HookRunner = &dummyHooks{reentrant: true}
+	test.AssertEqual(t, "Deutsch (fr)", l.FetchLanguageName("de", "fr", ""), `Hooks may fetch language names`)
+}
+
+/**
+ * @covers Language::isSupportedLanguage
+ * @covers Language::isValidCode
+ * @covers Language::isKnownLanguageTag
+ */
+func TestLanguageCodeChecks(t *testing.T) {
+	l := NewLanguage()
+	oldDir := MessagesDir
+	MessagesDir = t.TempDir()
+	defer func() {
+		MessagesDir = oldDir
+		ClearCaches()
+	}()
+	ClearCaches()
+	for _, code := range []string{"en", "de", "qqq"} {
+		if err := os.WriteFile(filepath.Join(MessagesDir, code+".json"), []byte("{}"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	test.AssertEqual(t, true, l.IsSupportedLanguage("de"), `Language with a message file`)
+	test.AssertEqual(t, false, l.IsSupportedLanguage("fr"), `Language without a message file`)
+	test.AssertEqual(t, false, l.IsSupportedLanguage("qqq"), `Message documentation`)
+	test.AssertEqual(t, 2, len(l.FetchLanguageNames(AS_AUTONYMS, SUPPORTED)), `Supported languages`)
+
+	test.AssertEqual(t, true, l.IsValidCode("be-tarask"), `Valid code`)
+	test.AssertEqual(t, true, l.IsValidCode("Foo.Bar"), `Used for {{int:}} hacks`)
+	test.AssertEqual(t, false, l.IsValidCode("../foo"), `Path traversal`)
+	test.AssertEqual(t, false, l.IsValidCode("a<b"), `HTML`)
+	test.AssertEqual(t, false, l.IsValidCode("a%2F"), `Percent encoding`)
+
+	test.AssertEqual(t, true, l.IsKnownLanguageTag("de"), `Known tag`)
+	test.AssertEqual(t, false, l.IsKnownLanguageTag("xyz"), `Unknown tag`)
+	test.AssertEqual(t, false, l.IsKnownLanguageTag("de_at"), `Invalid tag`)
+}
+
+type dummyMessage struct {
+	text string
+}
+
+func (d *dummyMessage) Plain() string   { return d.text }
+func (d *dummyMessage) Text() string    { return d.text }
+func (d *dummyMessage) Escaped() string { return d.text }
+
+/**
+ * Replaces the message factory of the includes package, with messages
+ * which only have their parameters replaced
+ */
+func withDummyMessages(messages map[string]string) func() {
+	oldFactory := MessageFactory
+	MessageFactory = func(lang *Language, key string, params ...interface{}) IMessage {
+		text := messages[key]
+		for i, param := range params {
+			text = strings.Replace(text, fmt.Sprintf("$%d", i+1), fmt.Sprint(param), -1)
+		}
+		return &dummyMessage{text}
+	}
+	return func() {
+		MessageFactory = oldFactory
+	}
+}
+
+var dummyMessages = map[string]string{
+	"and":                 " and",
+	"word-separator":      " ",
+	"comma-separator":     ", ",
+	"semicolon-separator": "; ",
+	"pipe-separator":      " | ",
+	"ellipsis":            "...",
+	"seconds-abbrev":      "$1 s",
+	"minutes-abbrev":      "$1 min",
+	"hours-abbrev":        "$1 h",
+	"days-abbrev":         "$1 d",
+}
+
+/**
+ * @covers Language::formatNum
+ * @covers Language::parseFormattedNumber
+ */
+func TestFormatNum(t *testing.T) {
+	en := Factory("en")
+	de := Factory("de")
+
+	test.AssertEqual(t, "1,234,567.891", en.FormatNum("1234567.891", false), `Thousands are grouped`)
+	test.AssertEqual(t, "-1,234", en.FormatNum("-1234", false), `Negative number`)
+	test.AssertEqual(t, "123", en.FormatNum("123", false), `Nothing to group`)
+	test.AssertEqual(t, "1234", en.FormatNum("1234", true), `nocommafy`)
+	test.AssertEqual(t, "1.234.567,5", de.FormatNum("1234567.5", false), `Separators are swapped`)
+	test.AssertEqual(t, "1234567.891", en.ParseFormattedNumber("1,234,567.891"), `Parse en`)
+	test.AssertEqual(t, "1234567.5", de.ParseFormattedNumber("1.234.567,5"), `Parse de`)
+}
+
+/**
+ * @covers Language::convertPlural
+ * @covers Language::handleExplicitPluralForms
+ * @covers Language::getPluralRuleIndexNumber
+ */
+func TestConvertPlural(t *testing.T) {
+	en := Factory("en")
+	forms := []string{"one", "other"}
+
+	test.AssertEqual(t, "one", en.ConvertPlural(1, forms), `Singular`)
+	test.AssertEqual(t, "other", en.ConvertPlural(0, forms), `Zero is plural in English`)
+	test.AssertEqual(t, "other", en.ConvertPlural(1.5, forms), `Fraction`)
+	test.AssertEqual(t, "none", en.ConvertPlural(0, []string{"0=none", "one", "other"}), `Explicit form`)
+	test.AssertEqual(t, "other", en.ConvertPlural(2, []string{"0=none", "one", "other"}), `Explicit forms are skipped`)
+	test.AssertEqual(t, "one", en.ConvertPlural(5, []string{"one"}), `Missing forms`)
+	test.AssertEqual(t, "", en.ConvertPlural(5, []string{}), `No forms`)
+
+	test.AssertEqual(t, "one", Factory("fr").ConvertPlural(0, forms), `Zero is singular in French`)
+	test.AssertEqual(t, "other", Factory("zh").ConvertPlural(1, []string{"other"}), `No plural in Chinese`)
+
+	ru := Factory("ru")
+	ruForms := []string{"one", "few", "many"}
+	for count, answer := range map[float64]string{1: "one", 21: "one", 3: "few", 24: "few", 5: "many", 11: "many", 12: "many", 111: "many"} {
+		test.AssertEqual(t, answer, ru.ConvertPlural(count, ruForms), fmt.Sprintf(`Russian %v`, count))
+	}
+
+	pl := Factory("pl")
+	plForms := []string{"one", "few", "many", "other"}
+	for count, answer := range map[float64]string{1: "one", 22: "few", 12: "many", 25: "many", 1.5: "other"} {
+		test.AssertEqual(t, answer, pl.ConvertPlural(count, plForms), fmt.Sprintf(`Polish %v`, count))
+	}
+
+	ar := Factory("ar")
+	arForms := []string{"zero", "one", "two", "few", "many", "other"}
+	for count, answer := range map[float64]string{0: "zero", 1: "one", 2: "two", 103: "few", 111: "many", 100: "other"} {
+		test.AssertEqual(t, answer, ar.ConvertPlural(count, arForms), fmt.Sprintf(`Arabic %v`, count))
+	}
+}
+
+/**
+ * @covers Language::getDurationIntervals
+ */
+func TestGetDurationIntervals(t *testing.T) {
+	l := NewLanguage()
+
+	test.AssertEqual(t, fmt.Sprint(map[string]int{"minutes": 1, "seconds": 5}), fmt.Sprint(l.GetDurationIntervals(65, nil)), `Minutes and seconds`)
+	test.AssertEqual(t, fmt.Sprint(map[string]int{"seconds": 0}), fmt.Sprint(l.GetDurationIntervals(0, nil)), `Zero`)
+	test.AssertEqual(t, fmt.Sprint(map[string]int{"days": 8, "hours": 1}), fmt.Sprint(l.GetDurationIntervals(8*86400+3600, nil)), `Weeks are not used by default`)
+	test.AssertEqual(t, fmt.Sprint(map[string]int{"weeks": 1, "days": 1, "hours": 1}), fmt.Sprint(l.GetDurationIntervals(8*86400+3600, []string{"weeks", "days", "hours"})), `Chosen intervals`)
+	test.AssertEqual(t, fmt.Sprint(map[string]int{"hours": 0}), fmt.Sprint(l.GetDurationIntervals(59, []string{"hours"})), `Smallest chosen interval`)
+	test.AssertEqual(t, fmt.Sprint(map[string]int{"millennia": 2, "years": 1}), fmt.Sprint(l.GetDurationIntervals(2*31556952000+31556952, nil)), `Millennia`)
+}
+
+/**
+ * @covers Language::formatTimePeriod
+ */
+func TestFormatTimePeriod(t *testing.T) {
+	defer withDummyMessages(dummyMessages)()
+	l := Factory("en")
+
+	test.AssertEqual(t, "9.5 s", l.FormatTimePeriod(9.45, "", false), `Tenths of seconds`)
+	test.AssertEqual(t, "10 s", l.FormatTimePeriod(9.95, "", false), `Rounded to seconds`)
+	test.AssertEqual(t, "1 min 0 s", l.FormatTimePeriod(59.55, "", false), `Rounded to a minute`)
+	test.AssertEqual(t, "1 h 0 min 0 s", l.FormatTimePeriod(3599.55, "", false), `Rounded to an hour`)
+	test.AssertEqual(t, "1 h 0 min", l.FormatTimePeriod(3599.55, "avoidseconds", false), `avoidseconds`)
+	test.AssertEqual(t, "2 d 1 h 1 min 1 s", l.FormatTimePeriod(176460.55, "", false), `Days`)
+	test.AssertEqual(t, "2 d 1 h 1 min", l.FormatTimePeriod(176460.55, "avoidseconds", false), `Days, avoidseconds`)
+	test.AssertEqual(t, "2 d 1 h", l.FormatTimePeriod(176460.55, "avoidminutes", false), `Days, avoidminutes`)
+	test.AssertEqual(t, "3 d 0 h", l.FormatTimePeriod(3*86400-600, "avoidminutes", false), `Rounded to a day`)
+}
+
+/**
+ * @covers Language::listToText
+ * @covers Language::commaList
+ * @covers Language::semicolonList
+ * @covers Language::pipeList
+ */
+func TestLists(t *testing.T) {
+	defer withDummyMessages(dummyMessages)()
+	l := Factory("en")
+
+	test.AssertEqual(t, "", l.ListToText([]string{}), `Empty list`)
+	test.AssertEqual(t, "a", l.ListToText([]string{"a"}), `One item`)
+	test.AssertEqual(t, "a and b", l.ListToText([]string{"a", "b"}), `Two items`)
+	test.AssertEqual(t, "a, b, c and d", l.ListToText([]string{"a", "b", "c", "d"}), `Four items`)
+	test.AssertEqual(t, "a, b", l.CommaList([]string{"a", "b"}), `Comma list`)
+	test.AssertEqual(t, "a; b", l.SemicolonList([]string{"a", "b"}), `Semicolon list`)
+	test.AssertEqual(t, "a | b", l.PipeList([]string{"a", "b"}), `Pipe list`)
+}
+
+/**
+ * @covers Language::truncateForDatabase
+ * @covers Language::truncateForVisual
+ */
+func TestTruncate(t *testing.T) {
+	defer withDummyMessages(dummyMessages)()
+	l := Factory("en")
+
+	test.AssertEqual(t, "12345...", l.TruncateForDatabase("123456789", 8, "...", true), `Ellipsis is counted`)
+	test.AssertEqual(t, "...6789", l.TruncateForDatabase("123456789", -7, "...", true), `From the beginning`)
+	test.AssertEqual(t, "123456789", l.TruncateForDatabase("123456789", 9, "...", true), `No need to truncate`)
+	test.AssertEqual(t, "123456789", l.TruncateForDatabase("123456789", 8, "...", false), `Ellipsis would make it longer`)
+	test.AssertEqual(t, "...", l.TruncateForDatabase("123456789", 0, "...", true), `Zero length`)
+	test.AssertEqual(t, "ab…", l.TruncateForDatabase("ab   cdef", 5, "…", false), `Trailing spaces are removed`)
+	test.AssertEqual(t, "ññ...", l.TruncateForDatabase("ñññññ", 8, "...", true), `Multibyte character is not split at the end`)
+	test.AssertEqual(t, "...ññ", l.TruncateForDatabase("ñññññ", -8, "...", true), `Multibyte character is not split at the start`)
+	test.AssertEqual(t, "ñ...", l.TruncateForVisual("ñññññ", 4, "...", true), `Characters are counted`)
+	test.AssertEqual(t, "ñññññ", l.TruncateForVisual("ñññññ", 5, "...", true), `Visual length is short enough`)
+	test.AssertEqual(t, "...ñ", l.TruncateForVisual("ñññññ", -4, "...", true), `Visual from the beginning`)
+}
+
+/**
+ * @covers Language::truncateHtml
+ */
+func TestTruncateHtml(t *testing.T) {
+	defer withDummyMessages(dummyMessages)()
+	l := Factory("en")
+
+	test.AssertEqual(t, "...", l.TruncateHtml("<b>abc</b>", 0, "..."), `Zero length`)
+	test.AssertEqual(t, "<b>abc</b>", l.TruncateHtml("<b>abc</b>", 10, "..."), `Short enough`)
+	test.AssertEqual(t, "<b>ab...</b>", l.TruncateHtml("<b>abcdef</b>", 5, "..."), `Open tag is closed`)
+	test.AssertEqual(t, `<a href="x">a...</a>`, l.TruncateHtml(`<a href="x">ab</a>cdefg`, 4, "..."), `Tag with attributes`)
+	test.AssertEqual(t, "<b>abc</b>de", l.TruncateHtml("<b>abc</b>de", 8, "..."), `Only the displayed text is counted`)
+	test.AssertEqual(t, "<i>abc</i>", l.TruncateHtml("<i>abc", 5, "..."), `Bad HTML is closed`)
+	test.AssertEqual(t, "&amp;...", l.TruncateHtml("&amp;&amp;&amp;&amp;&amp;", 4, "..."), `Entities are one character`)
+	test.AssertEqual(t, "ññ...", l.TruncateHtml("ñññññññ", 5, "..."), `Multibyte characters`)
+	test.AssertEqual(t, "a<br>b...", l.TruncateHtml("a<br>bcdefgh", 5, "..."), `Void elements are not closed`)
+	test.AssertEqual(t, "<p><b>a...</b></p>", l.TruncateHtml("<p><b>abcdef</b></p>", 4, "..."), `Nested tags`)
+}
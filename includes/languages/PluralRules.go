@@ -0,0 +1,255 @@
+/**
+ * Plural rules of the languages, from the CLDR plurals.xml with the
+ * overrides of plurals-mediawiki.xml
+ */
+package languages
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+/**
+ * The plural rules of a language. The index of the matching rule selects the
+ * form in {{PLURAL:}}; the last type, "other", matches when no rule does.
+ */
+type pluralRules struct {
+	/**
+	 * Plural rule types, e.g. [ "one", "few", "other" ]
+	 */
+	types []string
+
+	/**
+	 * Find the index of the rule matching the operands
+	 */
+	index func(op pluralOperands) int
+}
+
+/**
+ * The operands of a number used by the CLDR plural rules
+ */
+type pluralOperands struct {
+	/** absolute value of the source number */
+	n float64
+	/** integer digits of n */
+	i int64
+	/** number of visible fraction digits in n */
+	v int
+	/** visible fractional digits in n */
+	f int64
+}
+
+var (
+	pluralRulesOneOther = &pluralRules{
+		types: []string{"one", "other"},
+		index: func(op pluralOperands) int {
+			if op.i == 1 && op.v == 0 {
+				return 0
+			}
+			return 1
+		},
+	}
+
+	pluralRulesZeroOneOther = &pluralRules{
+		types: []string{"one", "other"},
+		index: func(op pluralOperands) int {
+			if op.i == 0 || op.i == 1 {
+				return 0
+			}
+			return 1
+		},
+	}
+
+	pluralRulesOther = &pluralRules{
+		types: []string{"other"},
+		index: func(op pluralOperands) int {
+			return 0
+		},
+	}
+
+	pluralRulesEastSlavic = &pluralRules{
+		types: []string{"one", "few", "other"},
+		index: func(op pluralOperands) int {
+			switch {
+			case op.v != 0:
+				return 2
+			case op.i%10 == 1 && op.i%100 != 11:
+				return 0
+			case inRange(op.i%10, 2, 4) && !inRange(op.i%100, 12, 14):
+				return 1
+			}
+			return 2
+		},
+	}
+
+	pluralRulesSouthSlavic = &pluralRules{
+		types: []string{"one", "few", "other"},
+		index: func(op pluralOperands) int {
+			switch {
+			case op.v == 0 && op.i%10 == 1 && op.i%100 != 11,
+				op.f%10 == 1 && op.f%100 != 11:
+				return 0
+			case op.v == 0 && inRange(op.i%10, 2, 4) && !inRange(op.i%100, 12, 14),
+				inRange(op.f%10, 2, 4) && !inRange(op.f%100, 12, 14):
+				return 1
+			}
+			return 2
+		},
+	}
+
+	pluralRulesPolish = &pluralRules{
+		types: []string{"one", "few", "many", "other"},
+		index: func(op pluralOperands) int {
+			switch {
+			case op.v != 0:
+				return 3
+			case op.i == 1:
+				return 0
+			case inRange(op.i%10, 2, 4) && !inRange(op.i%100, 12, 14):
+				return 1
+			}
+			return 2
+		},
+	}
+
+	pluralRulesCzech = &pluralRules{
+		types: []string{"one", "few", "many", "other"},
+		index: func(op pluralOperands) int {
+			switch {
+			case op.v != 0:
+				return 2
+			case op.i == 1:
+				return 0
+			case inRange(op.i, 2, 4):
+				return 1
+			}
+			return 3
+		},
+	}
+
+	pluralRulesArabic = &pluralRules{
+		types: []string{"zero", "one", "two", "few", "many", "other"},
+		index: func(op pluralOperands) int {
+			switch {
+			case op.v != 0:
+				return 5
+			case op.i == 0:
+				return 0
+			case op.i == 1:
+				return 1
+			case op.i == 2:
+				return 2
+			case inRange(op.i%100, 3, 10):
+				return 3
+			case inRange(op.i%100, 11, 99):
+				return 4
+			}
+			return 5
+		},
+	}
+
+	pluralRulesHebrew = &pluralRules{
+		types: []string{"one", "two", "many", "other"},
+		index: func(op pluralOperands) int {
+			switch {
+			case op.v != 0:
+				return 3
+			case op.i == 1:
+				return 0
+			case op.i == 2:
+				return 1
+			case op.i > 10 && op.i%10 == 0:
+				return 2
+			}
+			return 3
+		},
+	}
+
+	/**
+	 * Plural rules of the languages which do not use the English ones
+	 */
+	languagePluralRules = map[string]*pluralRules{
+		"fr":  pluralRulesZeroOneOther,
+		"hy":  pluralRulesZeroOneOther,
+		"kab": pluralRulesZeroOneOther,
+		"pt":  pluralRulesZeroOneOther,
+
+		"id":  pluralRulesOther,
+		"ja":  pluralRulesOther,
+		"km":  pluralRulesOther,
+		"ko":  pluralRulesOther,
+		"lo":  pluralRulesOther,
+		"ms":  pluralRulesOther,
+		"my":  pluralRulesOther,
+		"th":  pluralRulesOther,
+		"vi":  pluralRulesOther,
+		"yue": pluralRulesOther,
+		"zh":  pluralRulesOther,
+
+		"be":        pluralRulesEastSlavic,
+		"be-tarask": pluralRulesEastSlavic,
+		"ru":        pluralRulesEastSlavic,
+		"uk":        pluralRulesEastSlavic,
+
+		"bs": pluralRulesSouthSlavic,
+		"hr": pluralRulesSouthSlavic,
+		"sh": pluralRulesSouthSlavic,
+		"sr": pluralRulesSouthSlavic,
+
+		"pl": pluralRulesPolish,
+		"cs": pluralRulesCzech,
+		"sk": pluralRulesCzech,
+		"ar": pluralRulesArabic,
+		"he": pluralRulesHebrew,
+	}
+)
+
+/**
+ * Get the plural rules of a language, falling back from variants such as
+ * sr-ec to the base language and to the English rules
+ *
+ * @param string $code
+ * @return pluralRules
+ */
+func getPluralRules(code string) *pluralRules {
+	for {
+		if rules, ok := languagePluralRules[code]; ok {
+			return rules
+		}
+		i := strings.LastIndex(code, "-")
+		if i <= 0 {
+			return pluralRulesOneOther
+		}
+		code = code[:i]
+	}
+}
+
+/**
+ * @param float $number
+ * @return pluralOperands
+ */
+func newPluralOperands(number float64) pluralOperands {
+	op := pluralOperands{n: math.Abs(number)}
+	text := strconv.FormatFloat(op.n, 'f', -1, 64)
+	intPart, fraction := text, ""
+	if i := strings.Index(text, "."); i >= 0 {
+		intPart, fraction = text[:i], text[i+1:]
+	}
+	op.i, _ = strconv.ParseInt(intPart, 10, 64)
+	op.v = len(fraction)
+	if fraction != "" {
+		op.f, _ = strconv.ParseInt(fraction, 10, 64)
+	}
+	return op
+}
+
+/**
+ * @param int $value
+ * @param int $min
+ * @param int $max
+ * @return bool Whether $min <= $value <= $max
+ */
+func inRange(value, min, max int64) bool {
+	return value >= min && value <= max
+}
@@ -0,0 +1,192 @@
+/**
+ * Wrapper around strtr() that holds replacements
+ */
+package languages
+
+import (
+	"sort"
+	"strings"
+)
+
+/**
+ * Wrapper around strtr() that holds replacements
+ *
+ * Keys are matched longest-first at every position of the subject, and
+ * replaced text is never scanned again, mirroring PHP's strtr() with an
+ * array argument.
+ *
+ * Replace() may be called concurrently, e.g. on the conversion tables the
+ * requests share, as long as the replacements are not changed meanwhile.
+ */
+type ReplacementArray struct {
+	data map[string]string
+
+	/**
+	 * Key lengths (in bytes) present in data, longest first; kept up to
+	 * date by the setters
+	 * @var int[]
+	 */
+	lengths []int
+}
+
+/**
+ * Create an object with the specified replacement array
+ * The array should have the same form as the replacement array for strtr()
+ * @param array $data
+ */
+func NewReplacementArray(data map[string]string) *ReplacementArray {
+	this := new(ReplacementArray)
+	this.data = make(map[string]string, len(data))
+	for from, to := range data {
+		this.data[from] = to
+	}
+	this.computeLengths()
+	return this
+}
+
+/**
+ * Set the whole replacement array at once
+ * @param array $data
+ */
+func (r *ReplacementArray) SetArray(data map[string]string) {
+	r.data = make(map[string]string, len(data))
+	for from, to := range data {
+		r.data[from] = to
+	}
+	r.computeLengths()
+}
+
+/**
+ * @return array
+ */
+func (r *ReplacementArray) GetArray() map[string]string {
+	return r.data
+}
+
+/**
+ * Set an element of the replacement array
+ * @param string $from
+ * @param string $to
+ */
+func (r *ReplacementArray) SetPair(from, to string) {
+	if from == "" {
+		return
+	}
+	if r.data == nil {
+		r.data = make(map[string]string)
+	}
+	r.data[from] = to
+	i := sort.Search(len(r.lengths), func(i int) bool {
+		return r.lengths[i] <= len(from)
+	})
+	if i == len(r.lengths) || r.lengths[i] != len(from) {
+		r.lengths = append(r.lengths, 0)
+		copy(r.lengths[i+1:], r.lengths[i:])
+		r.lengths[i] = len(from)
+	}
+}
+
+/**
+ * @param array $data
+ */
+func (r *ReplacementArray) MergeArray(data map[string]string) {
+	if r.data == nil {
+		r.data = make(map[string]string, len(data))
+	}
+	for from, to := range data {
+		if from != "" {
+			r.data[from] = to
+		}
+	}
+	r.computeLengths()
+}
+
+/**
+ * @param ReplacementArray $other
+ */
+func (r *ReplacementArray) Merge(other *ReplacementArray) {
+	if other == nil {
+		return
+	}
+	r.MergeArray(other.data)
+}
+
+/**
+ * @param string $from
+ */
+func (r *ReplacementArray) RemovePair(from string) {
+	delete(r.data, from)
+	r.computeLengths()
+}
+
+/**
+ * @param array $data
+ */
+func (r *ReplacementArray) RemoveArray(data map[string]string) {
+	for from := range data {
+		delete(r.data, from)
+	}
+	r.computeLengths()
+}
+
+/**
+ * Set the key lengths from the keys of the data
+ */
+func (r *ReplacementArray) computeLengths() {
+	seen := make(map[int]bool)
+	r.lengths = nil
+	for from := range r.data {
+		if !seen[len(from)] {
+			seen[len(from)] = true
+			r.lengths = append(r.lengths, len(from))
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(r.lengths)))
+}
+
+/**
+ * Returns true if the array has no replacement pairs
+ * @return bool
+ */
+func (r *ReplacementArray) IsEmpty() bool {
+	return len(r.data) == 0
+}
+
+/**
+ * @param string $subject
+ * @return string
+ */
+func (r *ReplacementArray) Replace(subject string) string {
+	if len(r.data) == 0 || subject == "" {
+		return subject
+	}
+
+	var b strings.Builder
+	b.Grow(len(subject))
+	for i := 0; i < len(subject); {
+		matched := false
+		for _, l := range r.lengths {
+			if i+l > len(subject) {
+				continue
+			}
+			if to, ok := r.data[subject[i:i+l]]; ok {
+				b.WriteString(to)
+				i += l
+				matched = true
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+		// Copy one whole UTF-8 sequence so that a multibyte character is
+		// never split by a later, shorter match.
+		j := i + 1
+		for j < len(subject) && subject[j]&0xC0 == 0x80 {
+			j++
+		}
+		b.WriteString(subject[i:j])
+		i = j
+	}
+	return b.String()
+}
@@ -0,0 +1,75 @@
+/**
+ * Serbian (Српски / Srpski) specific code.
+ */
+package languages
+
+/**
+ * Serbian Cyrillic => Latin transliteration
+ */
+var srToLatin = map[string]string{
+	"а": "a", "б": "b", "в": "v", "г": "g", "д": "d",
+	"ђ": "đ", "е": "e", "ж": "ž", "з": "z", "и": "i",
+	"ј": "j", "к": "k", "л": "l", "љ": "lj", "м": "m",
+	"н": "n", "њ": "nj", "о": "o", "п": "p", "р": "r",
+	"с": "s", "т": "t", "ћ": "ć", "у": "u", "ф": "f",
+	"х": "h", "ц": "c", "ч": "č", "џ": "dž", "ш": "š",
+
+	"А": "A", "Б": "B", "В": "V", "Г": "G", "Д": "D",
+	"Ђ": "Đ", "Е": "E", "Ж": "Ž", "З": "Z", "И": "I",
+	"Ј": "J", "К": "K", "Л": "L", "Љ": "Lj", "М": "M",
+	"Н": "N", "Њ": "Nj", "О": "O", "П": "P", "Р": "R",
+	"С": "S", "Т": "T", "Ћ": "Ć", "У": "U", "Ф": "F",
+	"Х": "H", "Ц": "C", "Ч": "Č", "Џ": "Dž", "Ш": "Š",
+}
+
+/**
+ * Serbian Latin => Cyrillic transliteration. Digraphs are matched before
+ * single letters because ReplacementArray prefers the longest key.
+ */
+var srToCyrillic = map[string]string{
+	"a": "а", "b": "б", "c": "ц", "č": "ч", "ć": "ћ",
+	"d": "д", "dž": "џ", "đ": "ђ", "e": "е", "f": "ф",
+	"g": "г", "h": "х", "i": "и", "j": "ј", "k": "к",
+	"l": "л", "lj": "љ", "m": "м", "n": "н", "nj": "њ",
+	"o": "о", "p": "п", "r": "р", "s": "с", "š": "ш",
+	"t": "т", "u": "у", "v": "в", "z": "з", "ž": "ж",
+
+	"A": "А", "B": "Б", "C": "Ц", "Č": "Ч", "Ć": "Ћ",
+	"D": "Д", "Dž": "Џ", "DŽ": "Џ", "Đ": "Ђ", "E": "Е",
+	"F": "Ф", "G": "Г", "H": "Х", "I": "И", "J": "Ј",
+	"K": "К", "L": "Л", "Lj": "Љ", "LJ": "Љ", "M": "М",
+	"N": "Н", "Nj": "Њ", "NJ": "Њ", "O": "О", "P": "П",
+	"R": "Р", "S": "С", "Š": "Ш", "T": "Т", "U": "У",
+	"V": "В", "Z": "З", "Ž": "Ж",
+}
+
+/**
+ * Serbian is written in both Cyrillic (sr-ec) and Latin (sr-el) script;
+ * the plain "sr" variant shows the text as it was written.
+ *
+ * @ingroup Language
+ */
+func NewSrConverter(langobj *Language) *LanguageConverter {
+	variants := []string{"sr", "sr-ec", "sr-el"}
+	variantfallbacks := map[string][]string{
+		"sr":    {"sr-ec"},
+		"sr-ec": {"sr"},
+		"sr-el": {"sr"},
+	}
+	variantNames := map[string]string{
+		"sr":    "Ћир./Lat.",
+		"sr-ec": "Ћирилица",
+		"sr-el": "Latinica",
+	}
+	this := NewLanguageConverter(langobj, "sr", variants, variantfallbacks, variantNames)
+	this.loadDefaultTables = srLoadDefaultTables
+	return this
+}
+
+func srLoadDefaultTables() map[string]*ReplacementArray {
+	return map[string]*ReplacementArray{
+		"sr-ec": NewReplacementArray(srToCyrillic),
+		"sr-el": NewReplacementArray(srToLatin),
+		"sr":    NewReplacementArray(nil),
+	}
+}
@@ -0,0 +1,119 @@
+/**
+ * Simplified / Traditional Chinese conversion tables
+ *
+ * Character pairs are listed once and expanded into zh2Hant and zh2Hans on
+ * load; traditional characters that map to the same simplified character
+ * are listed in zh2HansExtra. Regional phrase tables are applied on top of
+ * the script tables and therefore have keys in both scripts.
+ */
+package languages
+
+/**
+ * Simplified => Traditional character pairs
+ */
+var zhCharPairs = [][2]string{
+	{"这", "這"}, {"个", "個"}, {"们", "們"}, {"来", "來"}, {"时", "時"},
+	{"为", "為"}, {"说", "說"}, {"国", "國"}, {"会", "會"}, {"对", "對"},
+	{"发", "發"}, {"学", "學"}, {"后", "後"}, {"过", "過"}, {"还", "還"},
+	{"进", "進"}, {"没", "沒"}, {"动", "動"}, {"当", "當"}, {"经", "經"},
+	{"开", "開"}, {"问", "問"}, {"长", "長"}, {"样", "樣"}, {"现", "現"},
+	{"与", "與"}, {"关", "關"}, {"机", "機"}, {"实", "實"}, {"书", "書"},
+	{"电", "電"}, {"话", "話"}, {"语", "語"}, {"汉", "漢"}, {"简", "簡"},
+	{"体", "體"}, {"华", "華"}, {"东", "東"}, {"车", "車"}, {"马", "馬"},
+	{"鸟", "鳥"}, {"门", "門"}, {"见", "見"}, {"页", "頁"}, {"维", "維"},
+	{"软", "軟"}, {"网", "網"}, {"络", "絡"}, {"视", "視"}, {"听", "聽"},
+	{"读", "讀"}, {"写", "寫"}, {"认", "認"}, {"识", "識"}, {"区", "區"},
+	{"编", "編"}, {"辑", "輯"}, {"历", "歷"}, {"录", "錄"}, {"户", "戶"},
+	{"帐", "帳"}, {"设", "設"}, {"讨", "討"}, {"论", "論"},
+	{"条", "條"}, {"图", "圖"}, {"档", "檔"}, {"类", "類"}, {"处", "處"},
+	{"复", "復"}, {"钟", "鐘"}, {"计", "計"}, {"数", "數"},
+	{"据", "據"}, {"库", "庫"}, {"标", "標"}, {"题", "題"}, {"频", "頻"},
+	{"术", "術"}, {"专", "專"}, {"业", "業"}, {"无", "無"}, {"线", "線"},
+	{"阅", "閱"}, {"览", "覽"}, {"签", "簽"}, {"应", "應"}, {"该", "該"},
+}
+
+/**
+ * Traditional characters that have no pair of their own in zhCharPairs
+ */
+var zh2HansExtra = map[string]string{
+	"髮": "发",
+	"曆": "历",
+	"鍾": "钟",
+	"複": "复",
+	"籤": "签",
+}
+
+/**
+ * Phrases which differ beyond the character level. Applied after the
+ * character pairs because keys are matched longest first.
+ */
+var zh2HantPhrases = map[string]string{
+	"头发": "頭髮",
+	"理发": "理髮",
+	"日历": "日曆",
+	"复杂": "複雜",
+	"重复": "重複",
+	"钟表": "鐘錶",
+}
+
+var (
+	zh2Hant = map[string]string{}
+	zh2Hans = map[string]string{}
+
+	zh2TW = map[string]string{
+		"软件": "軟體", "軟件": "軟體",
+		"网络": "網路", "網絡": "網路",
+		"信息": "資訊",
+		"打印": "列印",
+		"鼠标": "滑鼠", "鼠標": "滑鼠",
+		"视频": "影片", "視頻": "影片",
+		"程序": "程式",
+		"硬盘": "硬碟", "硬盤": "硬碟",
+		"内存": "記憶體", "內存": "記憶體",
+		"默认": "預設", "默認": "預設",
+	}
+
+	zh2HK = map[string]string{
+		"软件": "軟件", "軟體": "軟件",
+		"网络": "網絡", "網路": "網絡",
+		"信息": "資訊",
+		"鼠标": "滑鼠", "鼠標": "滑鼠",
+		"视频": "視頻", "影片": "視頻",
+		"硬盘": "硬碟", "硬盤": "硬碟",
+		"内存": "記憶體", "內存": "記憶體",
+	}
+
+	zh2CN = map[string]string{
+		"軟體":  "软件",
+		"網路":  "网络",
+		"資訊":  "信息",
+		"列印":  "打印",
+		"滑鼠":  "鼠标",
+		"程式":  "程序",
+		"硬碟":  "硬盘",
+		"記憶體": "内存",
+		"預設":  "默认",
+	}
+
+	zh2SG = map[string]string{
+		"軟體":  "软件",
+		"網路":  "网络",
+		"資訊":  "信息",
+		"滑鼠":  "鼠标",
+		"硬碟":  "硬盘",
+		"記憶體": "内存",
+	}
+)
+
+func init() {
+	for _, pair := range zhCharPairs {
+		zh2Hant[pair[0]] = pair[1]
+		zh2Hans[pair[1]] = pair[0]
+	}
+	for from, to := range zh2HansExtra {
+		zh2Hans[from] = to
+	}
+	for from, to := range zh2HantPhrases {
+		zh2Hant[from] = to
+	}
+}
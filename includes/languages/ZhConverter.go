@@ -0,0 +1,68 @@
+/**
+ * Chinese specific code.
+ */
+package languages
+
+/**
+ * Chinese converter routine.
+ *
+ * @ingroup Language
+ */
+func NewZhConverter(langobj *Language) *LanguageConverter {
+	variants := []string{
+		"zh", "zh-hans", "zh-hant", "zh-cn", "zh-hk", "zh-mo", "zh-my", "zh-sg", "zh-tw",
+	}
+	variantfallbacks := map[string][]string{
+		"zh":      {"zh-hans", "zh-hant", "zh-cn", "zh-tw", "zh-hk", "zh-sg", "zh-mo", "zh-my"},
+		"zh-hans": {"zh-cn", "zh-sg", "zh-my"},
+		"zh-hant": {"zh-tw", "zh-hk", "zh-mo"},
+		"zh-cn":   {"zh-hans", "zh-sg", "zh-my"},
+		"zh-sg":   {"zh-hans", "zh-cn", "zh-my"},
+		"zh-my":   {"zh-hans", "zh-sg", "zh-cn"},
+		"zh-tw":   {"zh-hant", "zh-hk", "zh-mo"},
+		"zh-hk":   {"zh-hant", "zh-mo", "zh-tw"},
+		"zh-mo":   {"zh-hant", "zh-hk", "zh-tw"},
+	}
+	variantNames := map[string]string{
+		"zh":      "原文",
+		"zh-hans": "简体",
+		"zh-hant": "繁體",
+		"zh-cn":   "大陆",
+		"zh-tw":   "台灣",
+		"zh-hk":   "香港",
+		"zh-mo":   "澳門",
+		"zh-sg":   "新加坡",
+		"zh-my":   "大马",
+	}
+	this := NewLanguageConverter(langobj, "zh", variants, variantfallbacks, variantNames)
+	this.mDescCodeSep = "："
+	this.mDescVarSep = "；"
+	this.loadDefaultTables = zhLoadDefaultTables
+	return this
+}
+
+func zhLoadDefaultTables() map[string]*ReplacementArray {
+	hans := NewReplacementArray(zh2Hans)
+	hant := NewReplacementArray(zh2Hant)
+
+	cn := NewReplacementArray(zh2Hans)
+	cn.MergeArray(zh2CN)
+	hk := NewReplacementArray(zh2Hant)
+	hk.MergeArray(zh2HK)
+	sg := NewReplacementArray(zh2Hans)
+	sg.MergeArray(zh2SG)
+	tw := NewReplacementArray(zh2Hant)
+	tw.MergeArray(zh2TW)
+
+	return map[string]*ReplacementArray{
+		"zh":      NewReplacementArray(nil),
+		"zh-hans": hans,
+		"zh-hant": hant,
+		"zh-cn":   cn,
+		"zh-hk":   hk,
+		"zh-mo":   hk,
+		"zh-my":   sg,
+		"zh-sg":   sg,
+		"zh-tw":   tw,
+	}
+}
@@ -0,0 +1,68 @@
+package data
+
+/**
+ * Language names translated into other languages, from the Unicode CLDR
+ * (common/main/<code>.xml, localeDisplayNames/languages), keyed by the
+ * language of the names.
+ *
+ * @ingroup Language
+ */
+var CldrNames = map[string]map[string]string{
+	"en": {
+		"af": "Afrikaans", "am": "Amharic", "an": "Aragonese", "ar": "Arabic",
+		"as": "Assamese", "ast": "Asturian", "az": "Azerbaijani", "ba": "Bashkir",
+		"be": "Belarusian", "bg": "Bulgarian", "bn": "Bangla", "bo": "Tibetan",
+		"br": "Breton", "bs": "Bosnian", "ca": "Catalan", "ce": "Chechen",
+		"ceb": "Cebuano", "ckb": "Central Kurdish", "co": "Corsican", "cs": "Czech",
+		"cy": "Welsh", "da": "Danish", "de": "German", "de-at": "Austrian German",
+		"de-ch": "Swiss High German", "dv": "Divehi", "el": "Greek", "en": "English",
+		"en-ca": "Canadian English", "en-gb": "British English", "eo": "Esperanto",
+		"es": "Spanish", "et": "Estonian", "eu": "Basque", "fa": "Persian",
+		"fi": "Finnish", "fo": "Faroese", "fr": "French", "fy": "Western Frisian",
+		"ga": "Irish", "gd": "Scottish Gaelic", "gl": "Galician", "gsw": "Swiss German",
+		"gu": "Gujarati", "ha": "Hausa", "haw": "Hawaiian", "he": "Hebrew",
+		"hi": "Hindi", "hr": "Croatian", "hsb": "Upper Sorbian", "ht": "Haitian Creole",
+		"hu": "Hungarian", "hy": "Armenian", "ia": "Interlingua", "id": "Indonesian",
+		"ig": "Igbo", "is": "Icelandic", "it": "Italian", "ja": "Japanese",
+		"jv": "Javanese", "ka": "Georgian", "kk": "Kazakh", "km": "Khmer",
+		"kn": "Kannada", "ko": "Korean", "ku": "Kurdish", "ky": "Kyrgyz",
+		"la": "Latin", "lb": "Luxembourgish", "lo": "Lao", "lt": "Lithuanian",
+		"lv": "Latvian", "mg": "Malagasy", "mi": "Maori", "mk": "Macedonian",
+		"ml": "Malayalam", "mn": "Mongolian", "mr": "Marathi", "ms": "Malay",
+		"mt": "Maltese", "my": "Burmese", "nb": "Norwegian Bokmål", "ne": "Nepali",
+		"nl": "Dutch", "nn": "Norwegian Nynorsk", "oc": "Occitan", "pa": "Punjabi",
+		"pl": "Polish", "ps": "Pashto", "pt": "Portuguese", "pt-br": "Brazilian Portuguese",
+		"qu": "Quechua", "rm": "Romansh", "ro": "Romanian", "ru": "Russian",
+		"sa": "Sanskrit", "sd": "Sindhi", "si": "Sinhala", "sk": "Slovak",
+		"sl": "Slovenian", "so": "Somali", "sq": "Albanian", "sr": "Serbian",
+		"sv": "Swedish", "sw": "Swahili", "ta": "Tamil", "te": "Telugu",
+		"tg": "Tajik", "th": "Thai", "tk": "Turkmen", "tr": "Turkish",
+		"tt": "Tatar", "ug": "Uyghur", "uk": "Ukrainian", "ur": "Urdu",
+		"uz": "Uzbek", "vi": "Vietnamese", "vo": "Volapük", "wa": "Walloon",
+		"xh": "Xhosa", "yi": "Yiddish", "yo": "Yoruba", "yue": "Cantonese",
+		"zh": "Chinese", "zh-hans": "Simplified Chinese", "zh-hant": "Traditional Chinese",
+		"zu": "Zulu",
+	},
+	"de": {
+		"ar": "Arabisch", "cs": "Tschechisch", "da": "Dänisch", "de": "Deutsch",
+		"de-at": "Österreichisches Deutsch", "de-ch": "Schweizer Hochdeutsch",
+		"el": "Griechisch", "en": "Englisch", "es": "Spanisch", "fa": "Persisch",
+		"fi": "Finnisch", "fr": "Französisch", "he": "Hebräisch", "hu": "Ungarisch",
+		"it": "Italienisch", "ja": "Japanisch", "ko": "Koreanisch", "nl": "Niederländisch",
+		"pl": "Polnisch", "pt": "Portugiesisch", "ru": "Russisch", "sv": "Schwedisch",
+		"tr": "Türkisch", "uk": "Ukrainisch", "zh": "Chinesisch",
+	},
+	"fr": {
+		"ar": "arabe", "de": "allemand", "en": "anglais", "es": "espagnol",
+		"fr": "français", "he": "hébreu", "it": "italien", "ja": "japonais",
+		"ko": "coréen", "nl": "néerlandais", "pl": "polonais", "pt": "portugais",
+		"ru": "russe", "tr": "turc", "zh": "chinois",
+	},
+	"zh": {
+		"ar": "阿拉伯语", "de": "德语", "en": "英语", "es": "西班牙语",
+		"fr": "法语", "he": "希伯来语", "it": "意大利语", "ja": "日语",
+		"ko": "韩语", "nl": "荷兰语", "pl": "波兰语", "pt": "葡萄牙语",
+		"ru": "俄语", "tr": "土耳其语", "zh": "中文", "zh-hans": "简体中文",
+		"zh-hant": "繁体中文",
+	},
+}
@@ -0,0 +1,201 @@
+/**
+ * Language names for use in the user interface.
+ */
+package data
+
+/**
+ * These determine things like interwikis, language selectors, and so on.
+ * Safe to change without running scripts on the respective sites.
+ *
+ * \xE2\x80\x8E is the left-to-right marker and
+ * \xE2\x80\x8F is the right-to-left marker.
+ * They are required for ensuring the correct display of brackets in
+ * mixed rtl/ltr environment.
+ *
+ * Some writing systems require some extra work, for example
+ * Arabic, Hebrew, Tamil, Malayalam and Hindi
+ *
+ * @ingroup Language
+ */
+var Names = map[string]string{
+	"aa":           "Qafár af",                        // Afar
+	"ab":           "Аҧсшәа",                          // Abkhaz
+	"ace":          "Acèh",                            // Aceh
+	"af":           "Afrikaans",                       // Afrikaans
+	"ak":           "Akan",                            // Akan
+	"als":          "Alemannisch",                     // Alemannic -- not a valid code, for compatibility. See gsw.
+	"am":           "አማርኛ",                            // Amharic
+	"an":           "aragonés",                        // Aragonese
+	"ang":          "Ænglisc",                         // Old English
+	"ar":           "العربية",                         // Arabic
+	"arc":          "ܐܪܡܝܐ",                           // Aramaic
+	"arz":          "مصرى",                            // Egyptian Spoken Arabic
+	"as":           "অসমীয়া",                         // Assamese
+	"ast":          "asturianu",                       // Asturian
+	"av":           "авар",                            // Avar
+	"ay":           "Aymar aru",                       // Aymara
+	"az":           "azərbaycanca",                    // Azerbaijani
+	"azb":          "تۆرکجه",                          // South Azerbaijani
+	"ba":           "башҡортса",                       // Bashkir
+	"bar":          "Boarisch",                        // Bavarian (Austro-Bavarian and South Tyrolean)
+	"bat-smg":      "žemaitėška",                      // Samogitian (deprecated code, 'sgs' in ISO 693-3 since 2010-06-30 )
+	"be":           "беларуская",                      // Belarusian normative
+	"be-tarask":    "беларуская (тарашкевіца)‎",       // Belarusian in Taraskievica orthography
+	"be-x-old":     "беларуская (тарашкевіца)‎",       // (be-tarask compat)
+	"bg":           "български",                       // Bulgarian
+	"bh":           "भोजपुरी",                         // Bihari macro language. Falls back to Bhojpuri (bho)
+	"bi":           "Bislama",                         // Bislama
+	"bm":           "bamanankan",                      // Bambara
+	"bn":           "বাংলা",                           // Bengali
+	"bo":           "བོད་ཡིག",                         // Tibetan
+	"br":           "brezhoneg",                       // Breton
+	"bs":           "bosanski",                        // Bosnian
+	"ca":           "català",                          // Catalan
+	"ce":           "нохчийн",                         // Chechen
+	"ceb":          "Cebuano",                         // Cebuano
+	"ckb":          "کوردی",                           // Central Kurdish
+	"co":           "corsu",                           // Corsican
+	"cs":           "čeština",                         // Czech
+	"cu":           "словѣньскъ / ⰔⰎⰑⰂⰡⰐⰠⰔⰍⰟ",         // Old Church Slavonic (ancient language)
+	"cv":           "Чӑвашла",                         // Chuvash
+	"cy":           "Cymraeg",                         // Welsh
+	"da":           "dansk",                           // Danish
+	"de":           "Deutsch",                         // German ("Du")
+	"de-at":        "Österreichisches Deutsch",        // Austrian German
+	"de-ch":        "Schweizer Hochdeutsch",           // Swiss Standard German
+	"de-formal":    "Deutsch (Sie-Form)‎",             // German - formal address ("Sie")
+	"dv":           "ދިވެހިބަސް",                      // Dhivehi
+	"dz":           "ཇོང་ཁ",                           // Dzongkha (Bhutan)
+	"el":           "Ελληνικά",                        // Greek
+	"en":           "English",                         // English
+	"en-ca":        "Canadian English",                // Canadian English
+	"en-gb":        "British English",                 // British English
+	"eo":           "Esperanto",                       // Esperanto
+	"es":           "español",                         // Spanish
+	"et":           "eesti",                           // Estonian
+	"eu":           "euskara",                         // Basque
+	"fa":           "فارسی",                           // Persian
+	"fi":           "suomi",                           // Finnish
+	"fo":           "føroyskt",                        // Faroese
+	"fr":           "français",                        // French
+	"fy":           "Frysk",                           // Frisian
+	"ga":           "Gaeilge",                         // Irish
+	"gan":          "贛語",                              // Gan (multiple scripts - defaults to Traditional)
+	"gd":           "Gàidhlig",                        // Scots Gaelic
+	"gl":           "galego",                          // Galician
+	"gsw":          "Alemannisch",                     // Alemannic
+	"gu":           "ગુજરાતી",                         // Gujarati
+	"gv":           "Gaelg",                           // Manx
+	"ha":           "Hausa",                           // Hausa
+	"haw":          "Hawaiʻi",                         // Hawaiian
+	"he":           "עברית",                           // Hebrew
+	"hi":           "हिन्दी",                          // Hindi
+	"hr":           "hrvatski",                        // Croatian
+	"hsb":          "hornjoserbsce",                   // Upper Sorbian
+	"ht":           "Kreyòl ayisyen",                  // Haitian Creole French
+	"hu":           "magyar",                          // Hungarian
+	"hy":           "հայերեն",                         // Armenian
+	"ia":           "interlingua",                     // Interlingua (IALA)
+	"id":           "Bahasa Indonesia",                // Indonesian
+	"ie":           "Interlingue",                     // Interlingue (Occidental)
+	"ig":           "Igbo",                            // Igbo
+	"io":           "Ido",                             // Ido
+	"is":           "íslenska",                        // Icelandic
+	"it":           "italiano",                        // Italian
+	"ja":           "日本語",                             // Japanese
+	"jv":           "Basa Jawa",                       // Javanese
+	"ka":           "ქართული",                         // Georgian
+	"kaa":          "Qaraqalpaqsha",                   // Karakalpak
+	"kk":           "қазақша",                         // Kazakh (multiple scripts - defaults to Cyrillic)
+	"km":           "ភាសាខ្មែរ",                       // Khmer
+	"kn":           "ಕನ್ನಡ",                           // Kannada
+	"ko":           "한국어",                             // Korean
+	"ku":           "kurdî",                           // Kurdish (multiple scripts - defaults to Latin)
+	"ky":           "Кыргызча",                        // Kirghiz
+	"la":           "Latina",                          // Latin
+	"lb":           "Lëtzebuergesch",                  // Luxembourgish
+	"li":           "Limburgs",                        // Limburgian
+	"lo":           "ລາວ",                             // Laotian
+	"lt":           "lietuvių",                        // Lithuanian
+	"lv":           "latviešu",                        // Latvian
+	"lzh":          "文言",                              // Literary Chinese, T179217
+	"mg":           "Malagasy",                        // Malagasy
+	"mi":           "Māori",                           // Maori
+	"mk":           "македонски",                      // Macedonian
+	"ml":           "മലയാളം",                          // Malayalam
+	"mn":           "монгол",                          // Halh Mongolian (Cyrillic) (ISO 639-3: khk)
+	"mr":           "मराठी",                           // Marathi
+	"ms":           "Bahasa Melayu",                   // Malay
+	"mt":           "Malti",                           // Maltese
+	"my":           "မြန်မာဘာသာ",                      // Burmese
+	"nan":          "Bân-lâm-gú",                      // Min-nan, T10217
+	"nb":           "norsk bokmål",                    // Norwegian (Bokmal)
+	"ne":           "नेपाली",                          // Nepali
+	"nl":           "Nederlands",                      // Dutch
+	"nl-informal":  "Nederlands (informeel)‎",         // Dutch (informal address ("je"))
+	"nn":           "norsk nynorsk",                   // Norwegian (Nynorsk)
+	"no":           "norsk",                           // Norwegian macro language (falls back to nb).
+	"oc":           "occitan",                         // Occitan
+	"pa":           "ਪੰਜਾਬੀ",                          // Eastern Punjabi (Gurmukhi script) (pan)
+	"pl":           "polski",                          // Polish
+	"pnb":          "پنجابی",                          // Western Punjabi
+	"ps":           "پښتو",                            // Pashto
+	"pt":           "português",                       // Portuguese
+	"pt-br":        "português do Brasil",             // Brazilian Portuguese
+	"qu":           "Runa Simi",                       // Southern Quechua
+	"rm":           "rumantsch",                       // Raeto-Romance
+	"ro":           "română",                          // Romanian
+	"ru":           "русский",                         // Russian
+	"rup":          "armãneashti",                     // Aromanian
+	"sa":           "संस्कृतम्",                       // Sanskrit
+	"sco":          "Scots",                           // Scots
+	"sd":           "سنڌي",                            // Sindhi
+	"sgs":          "žemaitėška",                      // Samogitian
+	"sh":           "srpskohrvatski / српскохрватски", // Serbo-Croatian (multiple scripts - defaults to Latin)
+	"si":           "සිංහල",                           // Sinhalese
+	"simple":       "Simple English",                  // Simple English
+	"sk":           "slovenčina",                      // Slovak
+	"sl":           "slovenščina",                     // Slovenian
+	"so":           "Soomaaliga",                      // Somali
+	"sq":           "shqip",                           // Albanian
+	"sr":           "српски / srpski",                 // Serbian (multiple scripts - defaults to Cyrillic)
+	"sr-ec":        "српски (ћирилица)‎",              // Serbian Cyrillic ekavian
+	"sr-el":        "srpski (latinica)‎",              // Serbian Latin ekavian
+	"sv":           "svenska",                         // Swedish
+	"sw":           "Kiswahili",                       // Swahili
+	"ta":           "தமிழ்",                           // Tamil
+	"te":           "తెలుగు",                          // Telugu
+	"tg":           "тоҷикӣ",                          // Tajiki (falls back to tg-cyrl)
+	"th":           "ไทย",                             // Thai
+	"tk":           "Türkmençe",                       // Turkmen
+	"tl":           "Tagalog",                         // Tagalog
+	"tr":           "Türkçe",                          // Turkish
+	"tt":           "татарча/tatarça",                 // Tatar (multiple scripts - defaults to Cyrillic)
+	"ug":           "ئۇيغۇرچە / Uyghurche",            // Uyghur (multiple scripts - defaults to Arabic)
+	"uk":           "українська",                      // Ukrainian
+	"ur":           "اردو",                            // Urdu
+	"uz":           "oʻzbekcha/ўзбекча",               // Uzbek (multiple scripts - defaults to Latin)
+	"vec":          "vèneto",                          // Venetian
+	"vi":           "Tiếng Việt",                      // Vietnamese
+	"vo":           "Volapük",                         // Volapük
+	"wa":           "walon",                           // Walloon
+	"wuu":          "吴语",                              // Wu Chinese
+	"xh":           "isiXhosa",                        // Xhosan
+	"yi":           "ייִדיש",                          // Yiddish
+	"yo":           "Yorùbá",                          // Yoruba
+	"yue":          "粵語",                              // Cantonese
+	"za":           "Vahcuengh",                       // Zhuang
+	"zh":           "中文",                              // (Zhōng Wén) - Chinese
+	"zh-classical": "文言",                              // Classical Chinese/Literary Chinese -- (see T10217)
+	"zh-cn":        "中文（中国大陆）‎",                       // Chinese (PRC)
+	"zh-hans":      "中文（简体）‎",                         // Mandarin Chinese (Simplified Chinese script) (cmn-hans)
+	"zh-hant":      "中文（繁體）‎",                         // Mandarin Chinese (Traditional Chinese script) (cmn-hant)
+	"zh-hk":        "中文（香港）‎",                         // Chinese (Hong Kong)
+	"zh-min-nan":   "Bân-lâm-gú",                      // Min-nan -- (see T10217)
+	"zh-mo":        "中文（澳門）‎",                         // Chinese (Macau)
+	"zh-my":        "中文（马来西亚）‎",                       // Chinese (Malaysia)
+	"zh-sg":        "中文（新加坡）‎",                        // Chinese (Singapore)
+	"zh-tw":        "中文（台灣）‎",                         // Chinese (Taiwan)
+	"zh-yue":       "粵語",                              // Cantonese -- (see T10217)
+	"zu":           "isiZulu",                         // Zulu
+}
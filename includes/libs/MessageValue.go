@@ -0,0 +1,45 @@
+package libs
+
+/**
+ * Value object representing a message for i18n: a message key with its
+ * parameters, which is turned into a Message when it is rendered.
+ *
+ * StatusValue stores the errors and warnings given by their key as such
+ * values.
+ */
+type MessageValue struct {
+	key    string
+	params []interface{}
+}
+
+/**
+ * @param string $key
+ * @param array $params Message parameters
+ */
+func NewMessageValue(key string, params []interface{}) *MessageValue {
+	this := new(MessageValue)
+	this.key = key
+	this.params = params
+	if this.params == nil {
+		this.params = make([]interface{}, 0)
+	}
+	return this
+}
+
+/**
+ * Get the message key
+ *
+ * @return string
+ */
+func (m *MessageValue) GetKey() string {
+	return m.key
+}
+
+/**
+ * Get the parameter array
+ *
+ * @return array
+ */
+func (m *MessageValue) GetParams() []interface{} {
+	return m.params
+}
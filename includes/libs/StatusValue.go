@@ -0,0 +1,362 @@
+package libs
+
+import (
+	"fmt"
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	"strings"
+)
+
+/**
+ * Generic operation result class
+ * Has warning/error list, boolean status and arbitrary value
+ *
+ * "Good" means the operation was completed with no warnings or errors.
+ *
+ * "OK" means the operation was partially or wholly completed.
+ *
+ * An operation which is not OK should have errors so that the user can be
+ * informed as to what went wrong. Calling the fatal() function sets an error
+ * message and simultaneously switches off the OK flag.
+ *
+ * The recommended pattern for Status objects is to return a StatusValue
+ * unconditionally, i.e. both on success and on failure -- so that the
+ * developer of the calling code is reminded that the function can fail, and
+ * so that a lack of error-handling will be explicit.
+ *
+ * The use of Message objects should be avoided when serializability is needed.
+ *
+ * @since 1.25
+ */
+type StatusValue struct {
+	/** @var bool */
+	ok bool
+
+	/** @var array[] */
+	errors []StatusError
+
+	/** @var mixed */
+	Value interface{}
+
+	/** @var bool[] Map of (key => bool) to indicate success of each part of batch operations */
+	Success map[int]bool
+
+	/** @var int Counter for batch operations */
+	SuccessCount int
+
+	/** @var int Counter for batch operations */
+	FailCount int
+}
+
+/**
+ * An error or a warning collected by a StatusValue
+ */
+type StatusError struct {
+	/** @var string Either "error" or "warning" */
+	Type string
+
+	/** @var MessageSpecifier */
+	Message MessageSpecifier
+}
+
+func NewStatusValue() *StatusValue {
+	this := new(StatusValue)
+	this.ok = true
+	this.errors = make([]StatusError, 0)
+	this.Success = make(map[int]bool)
+	return this
+}
+
+/**
+ * Factory function for fatal errors
+ *
+ * @param string|MessageSpecifier $message Message key or object
+ * @return static
+ */
+func NewFatalStatusValue(message interface{}, params ...interface{}) *StatusValue {
+	result := NewStatusValue()
+	result.Fatal(message, params...)
+	return result
+}
+
+/**
+ * Factory function for good results
+ *
+ * @param mixed $value
+ * @return static
+ */
+func NewGoodStatusValue(value interface{}) *StatusValue {
+	result := NewStatusValue()
+	result.Value = value
+	return result
+}
+
+/**
+ * Splits this StatusValue object into two new StatusValue objects, one which contains only
+ * the error messages, and one that contains the warnings, only. The returned array is
+ * defined as:
+ * [
+ *     0 => object(StatusValue) # the StatusValue with error messages, only
+ *     1 => object(StatusValue) # The StatusValue with warning messages, only
+ * ]
+ *
+ * @return StatusValue[]
+ */
+func (s *StatusValue) SplitByErrorType() (*StatusValue, *StatusValue) {
+	errorsOnlyStatusValue := s.clone()
+	warningsOnlyStatusValue := s.clone()
+	warningsOnlyStatusValue.ok = true
+
+	errorsOnlyStatusValue.errors = make([]StatusError, 0)
+	warningsOnlyStatusValue.errors = make([]StatusError, 0)
+	for _, item := range s.errors {
+		if item.Type == "warning" {
+			warningsOnlyStatusValue.errors = append(warningsOnlyStatusValue.errors, item)
+		} else {
+			errorsOnlyStatusValue.errors = append(errorsOnlyStatusValue.errors, item)
+		}
+	}
+
+	return errorsOnlyStatusValue, warningsOnlyStatusValue
+}
+
+/**
+ * Returns whether the operation completed and didn't have any error or
+ * warnings
+ *
+ * @return bool
+ */
+func (s *StatusValue) IsGood() bool {
+	return s.ok && len(s.errors) == 0
+}
+
+/**
+ * Returns whether the operation completed
+ *
+ * @return bool
+ */
+func (s *StatusValue) IsOK() bool {
+	return s.ok
+}
+
+/**
+ * @return mixed
+ */
+func (s *StatusValue) GetValue() interface{} {
+	return s.Value
+}
+
+/**
+ * Get the list of errors
+ *
+ * Each error has a type, "error" or "warning", and a MessageSpecifier;
+ * message keys are stored as MessageValue with their parameters
+ *
+ * @return array[]
+ */
+func (s *StatusValue) GetErrors() []StatusError {
+	return s.errors
+}
+
+/**
+ * Change operation status
+ *
+ * @param bool $ok
+ */
+func (s *StatusValue) SetOK(ok bool) {
+	s.ok = ok
+}
+
+/**
+ * Change operation result
+ *
+ * @param bool $ok Whether the operation completed
+ * @param mixed $value
+ */
+func (s *StatusValue) SetResult(ok bool, value interface{}) {
+	s.ok = ok
+	s.Value = value
+}
+
+/**
+ * Add a new warning
+ *
+ * @param string|MessageSpecifier $message Message key or object
+ */
+func (s *StatusValue) Warning(message interface{}, params ...interface{}) {
+	s.errors = append(s.errors, StatusError{Type: "warning", Message: newStatusMessage(message, params)})
+}
+
+/**
+ * Add an error, do not set fatal flag
+ * This can be used for non-fatal errors
+ *
+ * @param string|MessageSpecifier $message Message key or object
+ */
+func (s *StatusValue) Error(message interface{}, params ...interface{}) {
+	s.errors = append(s.errors, StatusError{Type: "error", Message: newStatusMessage(message, params)})
+}
+
+/**
+ * Add an error and set OK to false, indicating that the operation
+ * as a whole was fatal
+ *
+ * @param string|MessageSpecifier $message Message key or object
+ */
+func (s *StatusValue) Fatal(message interface{}, params ...interface{}) {
+	s.errors = append(s.errors, StatusError{Type: "error", Message: newStatusMessage(message, params)})
+	s.ok = false
+}
+
+/**
+ * Merge another status object into this one
+ *
+ * @param StatusValue $other Other StatusValue object
+ * @param bool $overwriteValue Whether to override the "value" member
+ */
+func (s *StatusValue) Merge(other *StatusValue, overwriteValue bool) {
+	s.errors = append(s.errors, other.errors...)
+	s.ok = s.ok && other.ok
+	if overwriteValue {
+		s.Value = other.Value
+	}
+	s.SuccessCount += other.SuccessCount
+	s.FailCount += other.FailCount
+}
+
+/**
+ * Returns a list of status messages of the given type
+ *
+ * @param string $type
+ * @return array
+ */
+func (s *StatusValue) GetErrorsByType(errorType string) []StatusError {
+	result := make([]StatusError, 0)
+	for _, item := range s.errors {
+		if item.Type == errorType {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+/**
+ * Returns true if the specified message is present as a warning or error
+ *
+ * @param string|MessageSpecifier $message Message key or object to search for
+ *
+ * @return bool
+ */
+func (s *StatusValue) HasMessage(message interface{}) bool {
+	key := messageKey(message)
+	for _, item := range s.errors {
+		if item.Message.GetKey() == key {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * If the specified source message exists, replace it with the specified
+ * destination message, but keep the same parameters as in the original error.
+ *
+ * Note, due to the lack of tools for comparing IStatusMessage objects, this
+ * function will not work when using such an object as the search parameter.
+ *
+ * @param MessageSpecifier|string $source Message key or object to search for
+ * @param MessageSpecifier|string $dest Replacement message key or object
+ * @return bool Return true if the replacement was done, false otherwise.
+ */
+func (s *StatusValue) ReplaceMessage(source, dest interface{}) bool {
+	replaced := false
+	key := messageKey(source)
+	for index, item := range s.errors {
+		if item.Message.GetKey() != key {
+			continue
+		}
+		if destKey, ok := dest.(string); ok {
+			// Keep the parameters of the original error
+			s.errors[index].Message = NewMessageValue(destKey, item.Message.GetParams())
+		} else {
+			s.errors[index].Message = newStatusMessage(dest, nil)
+		}
+		replaced = true
+	}
+	return replaced
+}
+
+/**
+ * @return string
+ */
+func (s *StatusValue) String() string {
+	status := "Error"
+	if s.IsOK() {
+		status = "OK"
+	}
+	errorcount := "no errors detected"
+	if len(s.errors) > 0 {
+		errorcount = fmt.Sprintf("collected %d error(s) on the way", len(s.errors))
+	}
+	valstr := "no value set"
+	if s.Value != nil {
+		valstr = fmt.Sprintf("%T value set", s.Value)
+	}
+	out := fmt.Sprintf("<%s, %s, %s>", status, errorcount, valstr)
+
+	if len(s.errors) > 0 {
+		hdr := fmt.Sprintf("+-%s-+-%s-+-%s-+\n", strings.Repeat("-", 4), strings.Repeat("-", 25), strings.Repeat("-", 40))
+		out += "\n"
+		out += hdr
+		for i, item := range s.errors {
+			params := make([]string, 0, len(item.Message.GetParams()))
+			for _, param := range item.Message.GetParams() {
+				params = append(params, fmt.Sprint(param))
+			}
+			out += fmt.Sprintf("| %4d | %-25.25s | %-40.40s |\n", i+1, item.Message.GetKey(), strings.Join(params, " "))
+		}
+		out += hdr
+	}
+	return out
+}
+
+/**
+ * @return StatusValue A copy which does not share the list of errors
+ */
+func (s *StatusValue) clone() *StatusValue {
+	copied := *s
+	copied.errors = append([]StatusError{}, s.errors...)
+	copied.Success = make(map[int]bool, len(s.Success))
+	for key, value := range s.Success {
+		copied.Success[key] = value
+	}
+	return &copied
+}
+
+/**
+ * @param string|MessageSpecifier $message Message key or object
+ * @param array $params Parameters of a message key
+ * @return MessageSpecifier
+ */
+func newStatusMessage(message interface{}, params []interface{}) MessageSpecifier {
+	switch m := message.(type) {
+	case string:
+		return NewMessageValue(m, params)
+	case MessageSpecifier:
+		if len(params) != 0 {
+			panic(exception.NewMWException("StatusValue: parameters must be empty for a MessageSpecifier"))
+		}
+		return m
+	}
+	panic(exception.NewMWException(fmt.Sprintf("StatusValue: invalid message type %T", message)))
+}
+
+/**
+ * @param string|MessageSpecifier $message
+ * @return string Message key
+ */
+func messageKey(message interface{}) string {
+	if specifier, ok := message.(MessageSpecifier); ok {
+		return specifier.GetKey()
+	}
+	return fmt.Sprint(message)
+}
@@ -0,0 +1,133 @@
+package libs
+
+import (
+	"fmt"
+	test "github.com/MangoDowner/mediawiki/tests"
+	"testing"
+)
+
+/**
+ * @covers StatusValue::newGood
+ * @covers StatusValue::newFatal
+ */
+func TestNewStatusValue(t *testing.T) {
+	status := NewGoodStatusValue("value")
+	test.AssertEqual(t, true, status.IsGood(), `Good status`)
+	test.AssertEqual(t, true, status.IsOK(), `Good status is OK`)
+	test.AssertEqual(t, "value", status.GetValue(), `Value`)
+
+	status = NewFatalStatusValue("foo", "bar")
+	test.AssertEqual(t, false, status.IsGood(), `Fatal status is not good`)
+	test.AssertEqual(t, false, status.IsOK(), `Fatal status is not OK`)
+	test.AssertEqual(t, 1, len(status.GetErrors()), `One error`)
+	test.AssertEqual(t, "foo", status.GetErrors()[0].Message.GetKey(), `Message key`)
+	test.AssertEqual(t, "[bar]", fmt.Sprint(status.GetErrors()[0].Message.GetParams()), `Message params`)
+}
+
+/**
+ * @covers StatusValue::warning
+ * @covers StatusValue::error
+ * @covers StatusValue::fatal
+ * @covers StatusValue::getErrorsByType
+ */
+func TestStatusValueErrors(t *testing.T) {
+	status := NewStatusValue()
+	status.Warning("warning-message")
+	test.AssertEqual(t, false, status.IsGood(), `Warnings are not good`)
+	test.AssertEqual(t, true, status.IsOK(), `Warnings are OK`)
+
+	status.Error(NewMessageValue("error-message", []interface{}{1}))
+	test.AssertEqual(t, true, status.IsOK(), `Errors are OK`)
+	test.AssertEqual(t, 1, len(status.GetErrorsByType("warning")), `One warning`)
+	test.AssertEqual(t, 1, len(status.GetErrorsByType("error")), `One error`)
+
+	status.Fatal("fatal-message")
+	test.AssertEqual(t, false, status.IsOK(), `Fatal errors are not OK`)
+	test.AssertEqual(t, 2, len(status.GetErrorsByType("error")), `Two errors`)
+
+	status.SetResult(true, 5)
+	test.AssertEqual(t, true, status.IsOK(), `setResult`)
+	test.AssertEqual(t, 5, status.GetValue(), `setResult value`)
+
+	defer func() {
+		test.AssertTrue(t, recover() != nil, `Parameters of a MessageSpecifier`)
+	}()
+	status.Warning(NewMessageValue("foo", nil), "bar")
+}
+
+/**
+ * @covers StatusValue::merge
+ */
+func TestStatusValueMerge(t *testing.T) {
+	status1 := NewGoodStatusValue(1)
+	status1.Warning("warning-message")
+	status1.SuccessCount = 1
+	status2 := NewFatalStatusValue("fatal-message")
+	status2.Value = 2
+	status2.FailCount = 1
+
+	status1.Merge(status2, false)
+	test.AssertEqual(t, false, status1.IsOK(), `Merged fatal error`)
+	test.AssertEqual(t, 2, len(status1.GetErrors()), `Merged errors`)
+	test.AssertEqual(t, 1, status1.GetValue(), `Value is kept`)
+	test.AssertEqual(t, 1, status1.SuccessCount, `Success count`)
+	test.AssertEqual(t, 1, status1.FailCount, `Fail count`)
+
+	status1.Merge(status2, true)
+	test.AssertEqual(t, 2, status1.GetValue(), `Value is overwritten`)
+}
+
+/**
+ * @covers StatusValue::hasMessage
+ * @covers StatusValue::replaceMessage
+ */
+func TestStatusValueMessages(t *testing.T) {
+	status := NewStatusValue()
+	status.Warning("foo", "param")
+	status.Error(NewMessageValue("bar", nil))
+
+	test.AssertEqual(t, true, status.HasMessage("foo"), `Message key`)
+	test.AssertEqual(t, true, status.HasMessage(NewMessageValue("bar", nil)), `MessageSpecifier`)
+	test.AssertEqual(t, false, status.HasMessage("baz"), `Missing message`)
+
+	test.AssertEqual(t, true, status.ReplaceMessage("foo", "baz"), `Replaced`)
+	test.AssertEqual(t, false, status.HasMessage("foo"), `Replaced message is gone`)
+	test.AssertEqual(t, "[param]", fmt.Sprint(status.GetErrors()[0].Message.GetParams()), `Parameters are kept`)
+	test.AssertEqual(t, false, status.ReplaceMessage("foo", "baz"), `Nothing to replace`)
+}
+
+/**
+ * @covers StatusValue::splitByErrorType
+ */
+func TestSplitByErrorType(t *testing.T) {
+	status := NewFatalStatusValue("fatal-message")
+	status.Warning("warning-message")
+	status.Success[0] = true
+
+	errors, warnings := status.SplitByErrorType()
+	test.AssertEqual(t, false, errors.IsOK(), `Errors are not OK`)
+	test.AssertEqual(t, 1, len(errors.GetErrors()), `One error`)
+	test.AssertEqual(t, "fatal-message", errors.GetErrors()[0].Message.GetKey(), `Error`)
+	test.AssertEqual(t, true, warnings.IsOK(), `Warnings are OK`)
+	test.AssertEqual(t, "warning-message", warnings.GetErrors()[0].Message.GetKey(), `Warning`)
+	test.AssertEqual(t, 2, len(status.GetErrors()), `Original status is unchanged`)
+}
+
+/**
+ * @covers StatusValue::__toString
+ */
+func TestStatusValueString(t *testing.T) {
+	test.AssertEqual(t, "<OK, no errors detected, no value set>", NewStatusValue().String(), `Good status`)
+	test.AssertEqual(t,
+		"<Error, collected 1 error(s) on the way, string value set>\n"+
+			"+------+---------------------------+------------------------------------------+\n"+
+			"|    1 | foo                       | bar 1                                    |\n"+
+			"+------+---------------------------+------------------------------------------+\n",
+		func() string {
+			status := NewFatalStatusValue("foo", "bar", 1)
+			status.Value = "value"
+			return status.String()
+		}(),
+		`Fatal status`,
+	)
+}
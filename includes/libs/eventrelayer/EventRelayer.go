@@ -0,0 +1,20 @@
+package eventrelayer
+
+/**
+ * Base class for reliable event relay
+ */
+type IEventRelayer interface {
+	/**
+	 * @param string $channel
+	 * @param mixed $event
+	 * @return bool Success
+	 */
+	Notify(channel string, event map[string]interface{}) bool
+
+	/**
+	 * @param string $channel
+	 * @param array $events List of events
+	 * @return bool Success
+	 */
+	NotifyMulti(channel string, events []map[string]interface{}) bool
+}
@@ -0,0 +1,33 @@
+package eventrelayer
+
+/**
+ * No-op class for publishing messages into a PubSub system
+ */
+type EventRelayerNull struct {
+}
+
+/**
+ * @param array $params
+ */
+func NewEventRelayerNull(params map[string]interface{}) *EventRelayerNull {
+	this := new(EventRelayerNull)
+	return this
+}
+
+/**
+ * @param string $channel
+ * @param mixed $event
+ * @return bool Success
+ */
+func (e *EventRelayerNull) Notify(channel string, event map[string]interface{}) bool {
+	return true
+}
+
+/**
+ * @param string $channel
+ * @param array $events List of events
+ * @return bool Success
+ */
+func (e *EventRelayerNull) NotifyMulti(channel string, events []map[string]interface{}) bool {
+	return true
+}
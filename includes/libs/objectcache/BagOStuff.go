@@ -1,4 +1,18 @@
 package objectcache
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	"github.com/MangoDowner/mediawiki/includes/libs/stats"
+	"github.com/astaxie/beego/logs"
+)
+
 /** Possible values for getLastError() */
 const ERR_NONE = 0 // no error
 const ERR_NO_RESPONSE = 1 // no response
@@ -12,6 +26,11 @@ const READ_VERIFIED = 2 // promise that caller can tell when keys are stale
 const WRITE_SYNC = 1 // synchronously write to all locations for replicated stores
 const WRITE_CACHE_ONLY = 2 // Only change state of the in-memory cache
 
+/** Operations of the per-keygroup stats */
+const METRIC_OP_GET_HIT = "get_hit"
+const METRIC_OP_GET_MISS = "get_miss"
+const METRIC_OP_SET = "set"
+
 /**
  * Class representing a cache/ephemeral data store
  *
@@ -36,46 +55,400 @@ const WRITE_CACHE_ONLY = 2 // Only change state of the in-memory cache
  * @ingroup Cache
  */
 type BagOStuff struct {
-	/** @var array[] Lock tracking */
-	locks []string
+	/** @var IBagOStuff Subclass instance, whose methods the generic ones below are built on */
+	store bagOStuffStore
+	/** @var bagLock[] Lock tracking */
+	locks map[string]*bagLock
 	/** @var int ERR_* class constant */
 	lastError int
 	/** @var string */
 	keyspace string
 	/** @var LoggerInterface */
 	logger interface{}
-	/** @var callback|null */
-	asyncHandler func()
+	/** @var callback|null Runs the given callback, possibly deferred */
+	asyncHandler func(callback func())
 	/** @var int Seconds */
 	syncTimeout int
 
 	/** @var bool */
 	debugMode bool
-	/** @var array */
-	duplicateKeyLookups map[string]int
-	/** @var bool */
+	/** @var bool Whether the requests log the keys they fetch more than once */
 	reportDupes bool
-	/** @var bool */
-	dupeTrackScheduled bool
+
+	/** @var IStatsdDataFactory|null Sink of the per-keygroup stats of the requests */
+	stats stats.IStatsdDataFactory
 
 	/** @var callable[] */
 	busyCallbacks []string
 
 	/** @var float|null */
-	wallClockOverride float64
+	wallClockOverride *float64
 
 	/** @var int[] Map of (ATTR_* class constant => QOS_* class constant) */
 	attrMap map[int]int
 
+	mutex sync.Mutex
 }
 
-func NewBagOStuff() *BagOStuff {
+/**
+ * Lock tracking entry
+ */
+type bagLock struct {
+	/** @var string Reentry class, see lock() */
+	class string
+	/** @var int Reentry depth */
+	depth int
+}
+
+/**
+ * Callback used by merge() to derive the new value of a key from its current one.
+ * It is given the cache, the key, the current value and whether the key exists.
+ * It returns the new value, or false as second result to leave the key alone.
+ */
+type MergeCallback func(cache IBagOStuff, key string, value interface{}, exists bool) (interface{}, bool)
+
+/**
+ * Methods of the cache stores, which BagOStuff subclasses implement
+ */
+type IBagOStuff interface {
+	IExpiringStore
+
+	/**
+	 * Get an item with the given key
+	 *
+	 * @param string $key
+	 * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+	 * @return mixed Returns false on failure and if the item does not exist
+	 */
+	Get(key string, flags int) (interface{}, bool)
+
+	/**
+	 * Get an item with the given key, along with its CAS token
+	 *
+	 * @param string $key
+	 * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+	 * @return array (value, CAS token, whether the item exists)
+	 */
+	GetWithToken(key string, flags int) (interface{}, string, bool)
+
+	/**
+	 * Get an associative array containing the item for each of the keys that have items.
+	 *
+	 * @param array $keys List of strings
+	 * @param int $flags Bitfield; supports READ_LATEST [optional]
+	 * @return array Map of (key => value) for existing keys
+	 */
+	GetMulti(keys []string, flags int) map[string]interface{}
+
+	/**
+	 * Set an item
+	 *
+	 * @param string $key
+	 * @param mixed $value
+	 * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+	 * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+	 * @return bool Success
+	 */
+	Set(key string, value interface{}, exptime, flags int) bool
+
+	/**
+	 * Batch insertion
+	 *
+	 * @param array $data $key => $value assoc array
+	 * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+	 * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+	 * @return bool Success
+	 */
+	SetMulti(data map[string]interface{}, exptime, flags int) bool
+
+	/**
+	 * Insert an item if it does not already exist
+	 *
+	 * @param string $key
+	 * @param mixed $value
+	 * @param int $exptime
+	 * @return bool Success
+	 */
+	Add(key string, value interface{}, exptime int) bool
+
+	/**
+	 * Check and set an item
+	 *
+	 * @param mixed $casToken Token from getWithToken()
+	 * @param string $key
+	 * @param mixed $value
+	 * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+	 * @return bool Success; false if the item changed since $casToken was fetched
+	 */
+	Cas(casToken string, key string, value interface{}, exptime int) bool
+
+	/**
+	 * Merge changes into the existing cache value (possibly creating a new one)
+	 *
+	 * The callback function returns the new value given the current value
+	 * (which will be false if not present), and takes the arguments:
+	 * (this BagOStuff, cache key, current value, whether it exists).
+	 *
+	 * @param string $key
+	 * @param callable $callback Callback method to be executed
+	 * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+	 * @param int $attempts The amount of times to attempt a merge in case of failure
+	 * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+	 * @return bool Success
+	 */
+	Merge(key string, callback MergeCallback, exptime, attempts, flags int) bool
+
+	/**
+	 * Delete an item
+	 *
+	 * @param string $key
+	 * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+	 * @return bool True if the item was deleted or not found, false on failure
+	 */
+	Delete(key string, flags int) bool
+
+	/**
+	 * Batch deletion
+	 *
+	 * @param string[] $keys List of keys
+	 * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+	 * @return bool Success
+	 */
+	DeleteMulti(keys []string, flags int) bool
+
+	/**
+	 * Reset the TTL on a key if it exists
+	 *
+	 * @param string $key
+	 * @param int $expiry
+	 * @return bool Success Returns false if there is no key
+	 */
+	ChangeTTL(key string, expiry int) bool
+
+	/**
+	 * Increase stored value of $key by $value while preserving its TTL
+	 *
+	 * @param string $key Key to increase
+	 * @param int $value Value to add to $key (Default 1)
+	 * @return int|bool New value or false on failure
+	 */
+	Incr(key string, value int) (int, bool)
+
+	/**
+	 * Decrease stored value of $key by $value while preserving its TTL
+	 *
+	 * @param string $key
+	 * @param int $value
+	 * @return int|bool New value or false on failure
+	 */
+	Decr(key string, value int) (int, bool)
+
+	/**
+	 * Increase stored value of $key by $value while preserving its TTL
+	 *
+	 * This will create the key with value $init and TTL $ttl instead if not present
+	 *
+	 * @param string $key
+	 * @param int $ttl
+	 * @param int $value
+	 * @param int $init
+	 * @return int|bool New value or false on failure
+	 */
+	IncrWithInit(key string, ttl, value, init int) (int, bool)
+
+	/**
+	 * Acquire an advisory lock on a key string
+	 *
+	 * @param string $key
+	 * @param int $timeout Lock wait timeout; 0 for non-blocking [optional]
+	 * @param int $expiry Lock expiry [optional]; 1 day maximum
+	 * @param string $rclass Allow reentry if set and the current lock used this value
+	 * @return bool Success
+	 */
+	Lock(key string, timeout, expiry int, rclass string) bool
+
+	/**
+	 * Release an advisory lock on a key string
+	 *
+	 * @param string $key
+	 * @return bool Success
+	 */
+	Unlock(key string) bool
+
+	/**
+	 * Get a lightweight exclusive self-unlocking lock
+	 *
+	 * @param string $key
+	 * @param int $timeout Lock wait timeout; 0 for non-blocking [optional]
+	 * @param int $expiry Lock expiry [optional]; 1 day maximum
+	 * @param string $rclass Allow reentry if set and the current lock used this value
+	 * @return callable|null Function releasing the lock; null on failure
+	 */
+	GetScopedLock(key string, timeout, expiry int, rclass string) func()
+
+	/**
+	 * Get the "last error" registered; clearLastError() should be called manually
+	 * @return int ERR_* constant for the "last error" registry
+	 */
+	GetLastError() int
+
+	/**
+	 * Clear the "last error" registry
+	 */
+	ClearLastError()
+
+	/**
+	 * Make a cache key, scoped to this instance's keyspace.
+	 *
+	 * @param string $class Key class
+	 * @param string $component [optional] Key component (starting with a key collection name)
+	 * @return string Colon-delimited list of $keyspace followed by escaped components of $args
+	 */
+	MakeKey(components ...string) string
+
+	/**
+	 * Make a global cache key.
+	 *
+	 * @param string $class Key class
+	 * @param string $component [optional] Key component (starting with a key collection name)
+	 * @return string Colon-delimited list of $keyspace followed by escaped components of $args
+	 */
+	MakeGlobalKey(components ...string) string
+}
+
+/**
+ * Methods the subclasses must provide for the generic BagOStuff ones to work
+ */
+type bagOStuffStore interface {
+	IBagOStuff
+
+	/**
+	 * @param string $key
+	 * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+	 * @return mixed Returns false on failure and if the item does not exist
+	 */
+	doGet(key string, flags int) (interface{}, bool)
+}
+
+/**
+ * Methods of the subclasses which tell I/O errors apart from missing keys
+ * and lost races. The generic merge() and lock() give up on the errors
+ * instead of retrying; the results are those of the public methods, along
+ * with an ERR_* constant. The "last error" cannot tell, as it is shared by
+ * all the callers of the instance.
+ */
+type checkedBagOStuffStore interface {
+	/**
+	 * @param string $key
+	 * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+	 * @return array (value, CAS token, whether the item exists)
+	 * @return int ERR_* constant
+	 */
+	doGetWithToken(key string, flags int) (interface{}, string, bool, int)
+
+	/**
+	 * @param string $key
+	 * @param mixed $value
+	 * @param int $exptime
+	 * @return bool Success
+	 * @return int ERR_* constant
+	 */
+	doAdd(key string, value interface{}, exptime int) (bool, int)
+}
+
+/**
+ * Methods of the subclasses with a native CAS which tell I/O errors apart
+ * from lost races, see checkedBagOStuffStore
+ */
+type checkedCasBagOStuffStore interface {
+	/**
+	 * @param mixed $casToken
+	 * @param string $key
+	 * @param mixed $value
+	 * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+	 * @return bool Success
+	 * @return int ERR_* constant
+	 */
+	doCas(casToken string, key string, value interface{}, exptime int) (bool, int)
+}
+
+/**
+ * Methods the subclasses relying on the generic incr() provide for it to
+ * preserve the TTL of the items
+ */
+type expiringBagOStuffStore interface {
+	/**
+	 * @param string $key
+	 * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+	 * @return mixed Returns false on failure and if the item does not exist
+	 * @return int Absolute expiry of the item, or TTL_INDEFINITE
+	 */
+	doGetWithExpiry(key string, flags int) (interface{}, int, bool)
+}
+
+/**
+ * $params include:
+ *   - keyspace: Default keyspace for $this->makeKey()
+ *   - reportDupes: Whether to emit warning log messages for all keys that were
+ *      requested more than once by a request (see RequestStats).
+ *   - stats: IStatsdDataFactory to send the per-keygroup hit, miss and set
+ *      counts of the requests to (see RequestStats).
+ *   - syncTimeout: How long to wait with WRITE_SYNC in seconds.
+ *   - asyncHandler: Callable to use for scheduling tasks after the web request ends.
+ *      In CLI mode, it should run the task immediately.
+ * @param BagOStuff $store Subclass instance implementing the storage
+ * @param array $params
+ */
+func NewBagOStuff(store bagOStuffStore, params map[string]interface{}) *BagOStuff {
 	this := new(BagOStuff)
+	this.store = store
+	this.locks = make(map[string]*bagLock)
 	this.lastError = ERR_NONE
 	this.keyspace = "local"
+	if keyspace, ok := params["keyspace"].(string); ok {
+		this.keyspace = keyspace
+	}
+	this.asyncHandler, _ = params["asyncHandler"].(func(callback func()))
+	this.reportDupes, _ = params["reportDupes"].(bool)
+	this.syncTimeout = 3
+	if syncTimeout, ok := numberParam(params, "syncTimeout"); ok {
+		this.syncTimeout = int(syncTimeout)
+	}
+	this.stats, _ = params["stats"].(stats.IStatsdDataFactory)
+	if _, ok := this.stats.(*stats.NullStatsdDataFactory); ok {
+		this.stats = nil // nothing to count for
+	}
+	this.attrMap = make(map[int]int)
 	return this
 }
 
+/**
+ * Get a numeric constructor parameter, which may have any number type, as
+ * the parameters decoded from the configuration do
+ *
+ * @param array $params
+ * @param string $name
+ * @return float
+ * @return bool Whether the parameter is set
+ * @throws MWException If the parameter is not a number
+ */
+func numberParam(params map[string]interface{}, name string) (float64, bool) {
+	value, ok := params[name]
+	if !ok || value == nil {
+		return 0, false
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	panic(exception.NewMWException(fmt.Sprintf("$%s parameter must be a number, %T given", name, value)))
+}
+
 /**
  * Get an item with the given key
  *
@@ -87,51 +460,636 @@ func NewBagOStuff() *BagOStuff {
  *
  * @param string $key
  * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
- * @param int|null $oldFlags [unused]
  * @return mixed Returns false on failure and if the item does not exist
  */
-func (h *HashBagOStuff) Get(key string, flags, oldFlags int) bool {
-	// B/C for ( $key, &$casToken = null, $flags = 0 )
-	if oldFlags != 0 {
-		flags = oldFlags
+func (b *BagOStuff) Get(key string, flags int) (interface{}, bool) {
+	return b.store.doGet(key, flags)
+}
+
+/**
+ * The CAS token is the serialized value unless the subclass provides a
+ * cheaper one; it changes whenever the value does.
+ *
+ * @param string $key
+ * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+ * @return array (value, CAS token, whether the item exists)
+ */
+func (b *BagOStuff) GetWithToken(key string, flags int) (interface{}, string, bool) {
+	value, ok := b.store.Get(key, flags)
+	if !ok {
+		return nil, "", false
 	}
+	return value, b.makeCasToken(value), true
+}
+
+/**
+ * @param mixed $value
+ * @return string CAS token of the value, for the subclasses without a cheaper one
+ */
+func (b *BagOStuff) makeCasToken(value interface{}) string {
+	return fmt.Sprintf("%T:%#v", value, value)
+}
+
+/**
+ * Check and set an item
+ *
+ * This is done under the item lock, so it is only atomic with regard to
+ * other cas()/merge()/incr() calls; subclasses with native CAS override it.
+ *
+ * @param mixed $casToken
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @return bool Success
+ */
+func (b *BagOStuff) Cas(casToken string, key string, value interface{}, exptime int) bool {
+	if !b.store.Lock(key, 0, 6, "") {
+		return false // non-blocking
+	}
+	defer b.store.Unlock(key)
+
+	_, curCasToken, ok := b.store.GetWithToken(key, READ_LATEST)
+	if !ok || casToken != curCasToken {
+		return false // item was deleted or changed
+	}
+	return b.store.Set(key, value, exptime, 0)
+}
+
+/**
+ * Merge changes into the existing cache value (possibly creating a new one)
+ *
+ * @param string $key
+ * @param callable $callback Callback method to be executed
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @param int $attempts The amount of times to attempt a merge in case of failure
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool Success
+ */
+func (b *BagOStuff) Merge(key string, callback MergeCallback, exptime, attempts, flags int) bool {
+	return b.mergeViaLock(key, callback, exptime, attempts, flags)
+}
+
+/**
+ * @see BagOStuff::merge()
+ *
+ * @param string $key
+ * @param callable $callback Callback method to be executed
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @param int $attempts The amount of times to attempt a merge in case of failure
+ * @return bool Success
+ */
+func (b *BagOStuff) mergeViaCas(key string, callback MergeCallback, exptime, attempts int) bool {
+	for {
+		currentValue, casToken, exists, errCode := b.tryGetWithToken(key, READ_LATEST)
+		if errCode != ERR_NONE {
+			return false // don't spam retries (retry only on races)
+		}
+
+		// Derive the new value from the old value
+		value, ok := callback(b.store, key, currentValue, exists)
+
+		success := true // do nothing
+		if ok && !exists {
+			// Try to create the key, failing if it gets created in the meantime
+			success, errCode = b.tryAdd(key, value, exptime)
+		} else if ok {
+			// Try to update the key, failing if it gets changed in the meantime
+			success, errCode = b.tryCas(casToken, key, value, exptime)
+		}
+		if errCode != ERR_NONE {
+			return false // IO error; don't spam retries
+		}
 
-	h.trackDuplicateKeys(key)
-	// TODO
-	return h.doGet(key, flags)
+		attempts--
+		if success || attempts <= 0 {
+			return success
+		}
+	}
 }
 
 /**
+ * @see BagOStuff::merge()
+ *
+ * @param string $key
+ * @param callable $callback Callback method to be executed
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @param int $attempts The amount of times to attempt a merge in case of failure
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool Success
+ */
+func (b *BagOStuff) mergeViaLock(key string, callback MergeCallback, exptime, attempts, flags int) bool {
+	timeout := 3
+	if attempts <= 1 {
+		timeout = 0 // clear one-way races
+	}
+
+	if !b.store.Lock(key, timeout, 6, "") {
+		return false
+	}
+
+	currentValue, _, exists, errCode := b.tryGetWithToken(key, READ_LATEST)
+
+	var success bool
+	if errCode != ERR_NONE {
+		success = false
+	} else if value, ok := callback(b.store, key, currentValue, exists); !ok {
+		success = true // do nothing
+	} else {
+		success = b.store.Set(key, value, exptime, flags) // set the new value
+	}
+
+	if !b.store.Unlock(key) {
+		// this should never happen
+		logs.Error("Could not release lock for key '%s'.", key)
+	}
+
+	return success
+}
+
+/**
+ * getWithToken(), with the error of the subclasses which report it
+ *
  * @param string $key
  * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
- * @return mixed Returns false on failure and if the item does not exist
+ * @return array (value, CAS token, whether the item exists)
+ * @return int ERR_* constant
  */
-func (h *HashBagOStuff) doGet(key string, flags int) bool {
+func (b *BagOStuff) tryGetWithToken(key string, flags int) (interface{}, string, bool, int) {
+	if store, ok := b.store.(checkedBagOStuffStore); ok {
+		return store.doGetWithToken(key, flags)
+	}
+	value, casToken, exists := b.store.GetWithToken(key, flags)
+	return value, casToken, exists, ERR_NONE
+}
+
+/**
+ * add(), with the error of the subclasses which report it
+ *
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime
+ * @return bool Success
+ * @return int ERR_* constant
+ */
+func (b *BagOStuff) tryAdd(key string, value interface{}, exptime int) (bool, int) {
+	if store, ok := b.store.(checkedBagOStuffStore); ok {
+		return store.doAdd(key, value, exptime)
+	}
+	return b.store.Add(key, value, exptime), ERR_NONE
+}
+
+/**
+ * cas(), with the error of the subclasses which report it
+ *
+ * @param mixed $casToken
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @return bool Success
+ * @return int ERR_* constant
+ */
+func (b *BagOStuff) tryCas(casToken string, key string, value interface{}, exptime int) (bool, int) {
+	if store, ok := b.store.(checkedCasBagOStuffStore); ok {
+		return store.doCas(casToken, key, value, exptime)
+	}
+	return b.store.Cas(casToken, key, value, exptime), ERR_NONE
+}
+
+/**
+ * Reset the TTL on a key if it exists
+ *
+ * @param string $key
+ * @param int $expiry
+ * @return bool Success Returns false if there is no key
+ */
+func (b *BagOStuff) ChangeTTL(key string, expiry int) bool {
+	value, ok := b.store.Get(key, READ_LATEST)
+	if !ok {
+		return false
+	}
+	return b.store.Set(key, value, expiry, 0)
+}
+
+/**
+ * Acquire an advisory lock on a key string
+ *
+ * Note that if reentry is enabled, duplicate calls ignore $expiry. Without
+ * reentry, a second call for a key this instance holds waits for the lock
+ * like any other caller would, as the holder may be another goroutine.
+ *
+ * @param string $key
+ * @param int $timeout Lock wait timeout; 0 for non-blocking [optional]
+ * @param int $expiry Lock expiry [optional]; 1 day maximum
+ * @param string $rclass Allow reentry if set and the current lock used this value
+ * @return bool Success
+ */
+func (b *BagOStuff) Lock(key string, timeout, expiry int, rclass string) bool {
+	// Allow lock reentry if specified
+	b.mutex.Lock()
+	if lock, ok := b.locks[key]; ok && rclass != "" && lock.class == rclass {
+		lock.depth++
+		b.mutex.Unlock()
+		return true
+	}
+	b.mutex.Unlock()
+
+	if expiry <= 0 || expiry > TTL_DAY {
+		expiry = TTL_DAY
+	}
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+	for {
+		if locked, errCode := b.tryAdd(key+":lock", 1, expiry); locked {
+			break // locked!
+		} else if errCode != ERR_NONE {
+			return false // network partition?
+		}
+		if !time.Now().Before(deadline) {
+			return false
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	b.mutex.Lock()
+	b.locks[key] = &bagLock{class: rclass, depth: 1}
+	b.mutex.Unlock()
+
 	return true
 }
 
+/**
+ * Release an advisory lock on a key string
+ *
+ * @param string $key
+ * @return bool Success
+ */
+func (b *BagOStuff) Unlock(key string) bool {
+	b.mutex.Lock()
+	lock, ok := b.locks[key]
+	if !ok {
+		b.mutex.Unlock()
+		return true
+	}
+	lock.depth--
+	if lock.depth > 0 {
+		b.mutex.Unlock()
+		return true
+	}
+	delete(b.locks, key)
+	b.mutex.Unlock()
+
+	return b.store.Delete(key+":lock", 0)
+}
 
 /**
- * Track the number of times that a given key has been used.
+ * Get a lightweight exclusive self-unlocking lock
+ *
+ * Note that the lock is not released if it was held longer than $expiry,
+ * as it might then already belong to someone else.
+ *
  * @param string $key
+ * @param int $timeout Lock wait timeout; 0 for non-blocking [optional]
+ * @param int $expiry Lock expiry [optional]; 1 day maximum
+ * @param string $rclass Allow reentry if set and the current lock used this value
+ * @return callable|null Function releasing the lock; null on failure
+ */
+func (b *BagOStuff) GetScopedLock(key string, timeout, expiry int, rclass string) func() {
+	if expiry <= 0 || expiry > TTL_DAY {
+		expiry = TTL_DAY
+	}
+
+	if !b.store.Lock(key, timeout, expiry, rclass) {
+		return nil
+	}
+
+	lSince := b.getCurrentTime() // lock timestamp
+
+	return func() {
+		latency := .050 // latency skew (err towards keeping lock present)
+		age := b.getCurrentTime() - lSince + latency
+		if age+latency >= float64(expiry) {
+			logs.Warn("Lock for %s held too long (%v sec).", key, age)
+			return // expired; it's not "safe" to delete the key
+		}
+		b.store.Unlock(key)
+	}
+}
+
+/**
+ * Get an associative array containing the item for each of the keys that have items.
+ *
+ * @param array $keys List of strings
+ * @param int $flags Bitfield; supports READ_LATEST [optional]
+ * @return array Map of (key => value) for existing keys
  */
-func (h *HashBagOStuff) trackDuplicateKeys(key string) {
-	if !h.reportDupes {
-		return
+func (b *BagOStuff) GetMulti(keys []string, flags int) map[string]interface{} {
+	res := make(map[string]interface{})
+	for _, key := range keys {
+		if value, ok := b.store.Get(key, flags); ok {
+			res[key] = value
+		}
 	}
-	if _, ok := h.duplicateKeyLookups[key]; !ok {
-		// Track that we have seen this key. This N-1 counting style allows
-		// easy filtering with array_filter() later.
-		h.duplicateKeyLookups[key] = 0
-		return
+	return res
+}
+
+/**
+ * Batch insertion
+ *
+ * @param array $data $key => $value assoc array
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool Success
+ */
+func (b *BagOStuff) SetMulti(data map[string]interface{}, exptime, flags int) bool {
+	res := true
+	for key, value := range data {
+		if !b.store.Set(key, value, exptime, flags) {
+			res = false
+		}
 	}
+	return res
+}
 
- 	h.duplicateKeyLookups[key] += 1
- 	if h.dupeTrackScheduled {
- 		return
+/**
+ * Batch deletion
+ *
+ * @param string[] $keys List of keys
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool Success
+ */
+func (b *BagOStuff) DeleteMulti(keys []string, flags int) bool {
+	res := true
+	for _, key := range keys {
+		if !b.store.Delete(key, flags) {
+			res = false
+		}
 	}
+	return res
+}
 
- 	h.dupeTrackScheduled = true
-	// Schedule a callback that logs keys processed more than once by get().
-	// TODO 去除重复key的错误打印日志
-}
\ No newline at end of file
+/**
+ * Insert an item if it does not already exist
+ *
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime
+ * @return bool Success
+ */
+func (b *BagOStuff) Add(key string, value interface{}, exptime int) bool {
+	if _, ok := b.store.Get(key, READ_LATEST); ok {
+		return false // key already set
+	}
+	return b.store.Set(key, value, exptime, 0)
+}
+
+/**
+ * Increase stored value of $key by $value while preserving its TTL
+ *
+ * The TTL is only known to subclasses which provide doGetWithExpiry() or
+ * override this; for the others, the key is kept without expiry.
+ *
+ * @param string $key Key to increase
+ * @param int $value Value to add to $key (Default 1)
+ * @return int|bool New value or false on failure
+ */
+func (b *BagOStuff) Incr(key string, value int) (int, bool) {
+	if !b.store.Lock(key, 6, 6, "") {
+		return 0, false
+	}
+	defer b.store.Unlock(key)
+
+	current, expiry, ok := b.getWithExpiry(key, READ_LATEST)
+	if !ok {
+		return 0, false
+	}
+	n, ok := b.isInteger(current)
+	if !ok {
+		return 0, false
+	}
+	n += value
+	if n < 0 {
+		n = 0
+	}
+	if !b.store.Set(key, n, expiry, 0) {
+		return 0, false
+	}
+
+	return n, true
+}
+
+/**
+ * Get an item with its expiry, as get() does
+ *
+ * @param string $key
+ * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+ * @return mixed Returns false on failure and if the item does not exist
+ * @return int Absolute expiry of the item, or TTL_INDEFINITE if unknown
+ */
+func (b *BagOStuff) getWithExpiry(key string, flags int) (interface{}, int, bool) {
+	store, ok := b.store.(expiringBagOStuffStore)
+	if !ok {
+		value, ok := b.store.Get(key, flags)
+		return value, TTL_INDEFINITE, ok
+	}
+	return store.doGetWithExpiry(key, flags)
+}
+
+/**
+ * Decrease stored value of $key by $value while preserving its TTL
+ *
+ * @param string $key
+ * @param int $value
+ * @return int|bool New value or false on failure
+ */
+func (b *BagOStuff) Decr(key string, value int) (int, bool) {
+	return b.store.Incr(key, -value)
+}
+
+/**
+ * Increase stored value of $key by $value while preserving its TTL
+ *
+ * This will create the key with value $init and TTL $ttl instead if not present
+ *
+ * @param string $key
+ * @param int $ttl
+ * @param int $value
+ * @param int $init
+ * @return int|bool New value or false on failure
+ */
+func (b *BagOStuff) IncrWithInit(key string, ttl, value, init int) (int, bool) {
+	newValue, ok := b.store.Incr(key, value)
+	if !ok {
+		// No key set; initialize
+		if b.store.Add(key, init, ttl) {
+			newValue, ok = init, true
+		}
+	}
+	if !ok {
+		// Raced out initializing; increment
+		newValue, ok = b.store.Incr(key, value)
+	}
+
+	return newValue, ok
+}
+
+/**
+ * Get the "last error" registered; clearLastError() should be called manually
+ * @return int ERR_* constant for the "last error" registry
+ */
+func (b *BagOStuff) GetLastError() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.lastError
+}
+
+/**
+ * Clear the "last error" registry
+ */
+func (b *BagOStuff) ClearLastError() {
+	b.setLastError(ERR_NONE)
+}
+
+/**
+ * Set the "last error" registry
+ * @param int $err ERR_* constant
+ */
+func (b *BagOStuff) setLastError(err int) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.lastError = err
+}
+
+/**
+ * @param int $flag ATTR_* class constant
+ * @return int QOS_* class constant
+ */
+func (b *BagOStuff) GetQoS(flag int) int {
+	if qos, ok := b.attrMap[flag]; ok {
+		return qos
+	}
+	return QOS_UNKNOWN
+}
+
+/**
+ * Check if a value is an integer
+ *
+ * @param mixed $value
+ * @return int|bool The integer, or false if the value is not one
+ */
+func (b *BagOStuff) isInteger(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case int32:
+		return int(v), true
+	case string:
+		if v == "" || strings.TrimLeft(v, "0123456789") != "" {
+			return 0, false
+		}
+		n, err := strconv.Atoi(v)
+		return n, err == nil
+	}
+	return 0, false
+}
+
+/**
+ * Construct a cache key.
+ *
+ * @since 1.27
+ * @param string $keyspace
+ * @param array $args
+ * @return string Colon-delimited list of $keyspace followed by escaped components of $args
+ */
+func (b *BagOStuff) makeKeyInternal(keyspace string, args []string) string {
+	key := keyspace
+	for _, arg := range args {
+		arg = strings.Replace(arg, ":", "%3A", -1)
+		key = key + ":" + arg
+	}
+	return strings.Replace(key, " ", "_", -1)
+}
+
+/**
+ * Make a global cache key.
+ *
+ * @since 1.27
+ * @param string $class Key class
+ * @param string $component [optional] Key component (starting with a key collection name)
+ * @return string Colon-delimited list of $keyspace followed by escaped components of $args
+ */
+func (b *BagOStuff) MakeGlobalKey(components ...string) string {
+	return b.makeKeyInternal("global", components)
+}
+
+/**
+ * Make a cache key, scoped to this instance's keyspace.
+ *
+ * @since 1.27
+ * @param string $class Key class
+ * @param string $component [optional] Key component (starting with a key collection name)
+ * @return string Colon-delimited list of $keyspace followed by escaped components of $args
+ */
+func (b *BagOStuff) MakeKey(components ...string) string {
+	return b.makeKeyInternal(b.keyspace, components)
+}
+
+/**
+ * Mark BagOStuff as an IExpiringStore, whose TTL_* constants apply to it
+ */
+func (b *BagOStuff) IExpiringStore() {}
+
+/**
+ * @return float UNIX timestamp
+ * @codeCoverageIgnore
+ */
+func (b *BagOStuff) getCurrentTime() float64 {
+	if b.wallClockOverride != nil {
+		return *b.wallClockOverride
+	}
+	return float64(time.Now().UnixNano()) / 1e9
+}
+
+/**
+ * @param float|null &$time Mock UNIX timestamp for testing
+ * @codeCoverageIgnore
+ */
+func (b *BagOStuff) SetMockTime(time *float64) {
+	b.wallClockOverride = time
+}
+
+/**
+ * Convert an optionally relative time to an absolute time
+ * @param int $exptime
+ * @return int
+ */
+func (b *BagOStuff) convertToExpiry(exptime int) int {
+	if exptime != 0 && exptime < 10*365*24*3600 {
+		// Relative TTL
+		return int(b.getCurrentTime()) + exptime
+	}
+	return exptime
+}
+
+/**
+ * @param string $key
+ * @return string The key group, i.e. the first component after the keyspace
+ */
+func (b *BagOStuff) determineKeyClassForStats(key string) string {
+	parts := strings.SplitN(key, ":", 3)
+	class := parts[0]
+	if len(parts) > 1 {
+		class = parts[1]
+	}
+	// Dots are special in StatsD
+	return strings.Replace(class, ".", "_", -1)
+}
+
+
+/**
+ * @return BagOStuff The generic part of the cache, for the subclasses
+ *  embedding it
+ */
+func (b *BagOStuff) getBagOStuff() *BagOStuff {
+	return b
+}
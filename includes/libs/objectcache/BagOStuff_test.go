@@ -1,13 +1,411 @@
 package objectcache
 
 import (
+	"fmt"
+	"sync"
 	"testing"
+	"time"
+
+	test "github.com/MangoDowner/mediawiki/tests"
 )
 
 /**
- * @covers BagOStuff::__construct
- * @covers BagOStuff::trackDuplicateKeys
+ * Store only implementing the methods every BagOStuff subclass must have,
+ * and doGetWithExpiry() for incr(), so that the generic BagOStuff methods
+ * get tested
+ */
+type simpleBagOStuff struct {
+	*BagOStuff
+	bag   map[string][2]interface{}
+	mutex sync.Mutex
+}
+
+func newSimpleBagOStuff() *simpleBagOStuff {
+	this := new(simpleBagOStuff)
+	this.BagOStuff = NewBagOStuff(this, nil)
+	this.bag = make(map[string][2]interface{})
+	return this
+}
+
+func (s *simpleBagOStuff) doGet(key string, flags int) (interface{}, bool) {
+	value, _, ok := s.doGetWithExpiry(key, flags)
+	return value, ok
+}
+
+func (s *simpleBagOStuff) doGetWithExpiry(key string, flags int) (interface{}, int, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	item, ok := s.bag[key]
+	if !ok {
+		return nil, 0, false
+	}
+	et := item[KEY_EXP].(int)
+	if et != TTL_INDEFINITE && float64(et) < s.getCurrentTime() {
+		delete(s.bag, key)
+		return nil, 0, false
+	}
+	return item[KEY_VAL], et, true
+}
+
+func (s *simpleBagOStuff) Set(key string, value interface{}, exptime, flags int) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.bag[key] = [2]interface{}{value, s.convertToExpiry(exptime)}
+	return true
+}
+
+func (s *simpleBagOStuff) Delete(key string, flags int) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.bag, key)
+	return true
+}
+
+/**
+ * Run the tests every BagOStuff backend must pass
+ *
+ * @param TestingT $t
+ * @param string $name Backend name, for the names of the subtests
+ * @param callable $newCache Returns an empty cache instance
+ */
+func runBagOStuffTests(t *testing.T, name string, newCache func() IBagOStuff) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, cache IBagOStuff)
+	}{
+		{"MakeKey", bagOStuffTestMakeKey},
+		{"GetSetDelete", bagOStuffTestGetSetDelete},
+		{"Add", bagOStuffTestAdd},
+		{"Cas", bagOStuffTestCas},
+		{"Merge", bagOStuffTestMerge},
+		{"ChangeTTL", bagOStuffTestChangeTTL},
+		{"Incr", bagOStuffTestIncr},
+		{"IncrWithInit", bagOStuffTestIncrWithInit},
+		{"Multi", bagOStuffTestMulti},
+		{"Locking", bagOStuffTestLocking},
+		{"ScopedLock", bagOStuffTestGetScopedLock},
+	}
+	t.Run(name, func(t *testing.T) {
+		for _, bagTest := range tests {
+			t.Run(bagTest.name, func(t *testing.T) {
+				bagTest.run(t, newCache())
+			})
+		}
+	})
+}
+
+/**
+ * @covers BagOStuff::makeGlobalKey
+ * @covers BagOStuff::makeKeyInternal
+ */
+func bagOStuffTestMakeKey(t *testing.T, cache IBagOStuff) {
+	test.AssertEqual(t, "local:first:second", cache.MakeKey("first", "second"), `Local key`)
+	test.AssertEqual(t, "global:a%3Ab:c_d", cache.MakeGlobalKey("a:b", "c d"), `Global key with escaping`)
+}
+
+/**
+ * @covers BagOStuff::get
+ * @covers BagOStuff::set
+ * @covers BagOStuff::delete
+ */
+func bagOStuffTestGetSetDelete(t *testing.T, cache IBagOStuff) {
+	key := cache.MakeKey("test")
+	test.AssertTrue(t, cache.Set(key, "value", 0, 0), `Set succeeds`)
+	value, ok := cache.Get(key, 0)
+	test.AssertEqual(t, "value", value, `Value is stored`)
+	test.AssertEqual(t, true, ok, `Key exists`)
+
+	test.AssertTrue(t, cache.Delete(key, 0), `Delete succeeds`)
+	_, ok = cache.Get(key, 0)
+	test.AssertEqual(t, false, ok, `Key was deleted`)
+	test.AssertTrue(t, cache.Delete(key, 0), `Deleting a missing key succeeds`)
+
+	cache.Set(key, "value", -10, 0)
+	_, ok = cache.Get(key, 0)
+	test.AssertEqual(t, false, ok, `Key expired`)
+}
+
+/**
+ * @covers BagOStuff::add
+ */
+func bagOStuffTestAdd(t *testing.T, cache IBagOStuff) {
+	key := cache.MakeKey("test")
+	test.AssertTrue(t, cache.Add(key, "add", 0), `Add a missing key`)
+	test.AssertEqual(t, false, cache.Add(key, "add2", 0), `Adding an existing key fails`)
+	value, _ := cache.Get(key, 0)
+	test.AssertEqual(t, "add", value, `Value was not overwritten`)
+}
+
+/**
+ * @covers BagOStuff::getWithToken
+ * @covers BagOStuff::cas
+ */
+func bagOStuffTestCas(t *testing.T, cache IBagOStuff) {
+	key := cache.MakeKey("test")
+	_, _, ok := cache.GetWithToken(key, 0)
+	test.AssertEqual(t, false, ok, `No token for a missing key`)
+
+	cache.Set(key, 1, 0, 0)
+	value, token, _ := cache.GetWithToken(key, 0)
+	test.AssertEqual(t, 1, value, `Value comes with the token`)
+	test.AssertTrue(t, cache.Cas(token, key, 2, 0), `CAS with the current token`)
+	test.AssertEqual(t, false, cache.Cas(token, key, 3, 0), `CAS with a stale token`)
+	value, _ = cache.Get(key, 0)
+	test.AssertEqual(t, 2, value, `Only the first CAS was applied`)
+
+	cache.Delete(key, 0)
+	test.AssertEqual(t, false, cache.Cas(token, key, 4, 0), `CAS on a deleted key`)
+}
+
+/**
+ * @covers BagOStuff::merge
+ * @covers BagOStuff::mergeViaLock
+ * @covers BagOStuff::mergeViaCas
+ */
+func bagOStuffTestMerge(t *testing.T, cache IBagOStuff) {
+	key := cache.MakeKey("test")
+	calls := 0
+	callback := func(cache IBagOStuff, key string, value interface{}, exists bool) (interface{}, bool) {
+		calls++
+		if !exists {
+			return "merged", true
+		}
+		return value.(string) + "merged", true
+	}
+
+	test.AssertTrue(t, cache.Merge(key, callback, 5, 1, 0), `Merge creates the key`)
+	value, _ := cache.Get(key, 0)
+	test.AssertEqual(t, "merged", value, `Value after the first merge`)
+	test.AssertTrue(t, cache.Merge(key, callback, 5, 1, 0), `Merge updates the key`)
+	value, _ = cache.Get(key, 0)
+	test.AssertEqual(t, "mergedmerged", value, `Value after the second merge`)
+	test.AssertEqual(t, 2, calls, `Callback ran once per merge`)
+
+	noop := func(cache IBagOStuff, key string, value interface{}, exists bool) (interface{}, bool) {
+		return nil, false
+	}
+	test.AssertTrue(t, cache.Merge(key, noop, 5, 1, 0), `Callback may leave the key alone`)
+	value, _ = cache.Get(key, 0)
+	test.AssertEqual(t, "mergedmerged", value, `Value was kept`)
+
+	// Concurrent merges must not lose updates
+	counter := cache.MakeKey("counter")
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cache.Merge(counter, func(cache IBagOStuff, key string, value interface{}, exists bool) (interface{}, bool) {
+				if !exists {
+					return 1, true
+				}
+				return value.(int) + 1, true
+			}, 0, 100, 0)
+		}()
+	}
+	wg.Wait()
+	value, _ = cache.Get(counter, 0)
+	test.AssertEqual(t, 10, value, `Concurrent merges`)
+}
+
+/**
+ * @covers BagOStuff::changeTTL
+ */
+func bagOStuffTestChangeTTL(t *testing.T, cache IBagOStuff) {
+	key := cache.MakeKey("test")
+	test.AssertEqual(t, false, cache.ChangeTTL(key, 10), `No TTL change for a missing key`)
+
+	cache.Set(key, "value", 10, 0)
+	test.AssertTrue(t, cache.ChangeTTL(key, -10), `TTL changed`)
+	_, ok := cache.Get(key, 0)
+	test.AssertEqual(t, false, ok, `Key expired after the TTL change`)
+}
+
+/**
+ * @covers BagOStuff::incr
+ * @covers BagOStuff::decr
+ */
+func bagOStuffTestIncr(t *testing.T, cache IBagOStuff) {
+	key := cache.MakeKey("test")
+	_, ok := cache.Incr(key, 1)
+	test.AssertEqual(t, false, ok, `Incr of a missing key fails`)
+
+	cache.Set(key, 0, 0, 0)
+	n, _ := cache.Incr(key, 1)
+	test.AssertEqual(t, 1, n, `Value after incr`)
+	n, _ = cache.Incr(key, 5)
+	test.AssertEqual(t, 6, n, `Value after incr by 5`)
+	n, _ = cache.Decr(key, 2)
+	test.AssertEqual(t, 4, n, `Value after decr`)
+	n, _ = cache.Decr(key, 10)
+	test.AssertEqual(t, 0, n, `Values do not go below zero`)
+
+	cache.Set(key, "41", 0, 0)
+	n, _ = cache.Incr(key, 1)
+	test.AssertEqual(t, 42, n, `Digit strings are integers`)
+
+	cache.Set(key, "text", 0, 0)
+	_, ok = cache.Incr(key, 1)
+	test.AssertEqual(t, false, ok, `Incr of a non-integer fails`)
+}
+
+/**
+ * @covers BagOStuff::incrWithInit
+ */
+func bagOStuffTestIncrWithInit(t *testing.T, cache IBagOStuff) {
+	key := cache.MakeKey("test")
+	n, _ := cache.IncrWithInit(key, 0, 1, 3)
+	test.AssertEqual(t, 3, n, `Key initialized`)
+	n, _ = cache.IncrWithInit(key, 0, 1, 3)
+	test.AssertEqual(t, 4, n, `Key incremented`)
+}
+
+/**
+ * @covers BagOStuff::getMulti
+ * @covers BagOStuff::setMulti
+ * @covers BagOStuff::deleteMulti
+ */
+func bagOStuffTestMulti(t *testing.T, cache IBagOStuff) {
+	key1 := cache.MakeKey("test-1")
+	key2 := cache.MakeKey("test-2")
+	key3 := cache.MakeKey("test-3")
+	test.AssertTrue(t, cache.SetMulti(map[string]interface{}{key1: 1, key2: "two"}, 0, 0), `SetMulti succeeds`)
+
+	values := cache.GetMulti([]string{key1, key2, key3}, 0)
+	test.AssertEqual(t,
+		fmt.Sprint(map[string]interface{}{key1: 1, key2: "two"}),
+		fmt.Sprint(values),
+		`GetMulti only returns existing keys`,
+	)
+
+	test.AssertTrue(t, cache.DeleteMulti([]string{key1, key3}, 0), `DeleteMulti succeeds`)
+	values = cache.GetMulti([]string{key1, key2, key3}, 0)
+	test.AssertEqual(t,
+		fmt.Sprint(map[string]interface{}{key2: "two"}),
+		fmt.Sprint(values),
+		`Keys were deleted`,
+	)
+}
+
+/**
+ * @covers BagOStuff::lock
+ * @covers BagOStuff::unlock
+ */
+func bagOStuffTestLocking(t *testing.T, cache IBagOStuff) {
+	key := cache.MakeKey("test")
+	test.AssertTrue(t, cache.Lock(key, 0, 6, ""), `Lock acquired`)
+	test.AssertEqual(t, false, cache.Lock(key, 0, 6, ""), `Lock is held`)
+	test.AssertTrue(t, cache.Unlock(key), `Lock released`)
+
+	test.AssertTrue(t, cache.Lock(key, 0, 6, "x"), `Lock with a reentry class`)
+	test.AssertTrue(t, cache.Lock(key, 0, 6, "x"), `Lock reentered`)
+	test.AssertEqual(t, false, cache.Lock(key, 0, 6, "y"), `Other class can not reenter`)
+	test.AssertTrue(t, cache.Unlock(key), `Lock depth decreased`)
+	test.AssertEqual(t, false, cache.Lock(key, 0, 6, ""), `Lock still held once`)
+	test.AssertTrue(t, cache.Unlock(key), `Lock released`)
+	test.AssertTrue(t, cache.Lock(key, 0, 6, ""), `Lock acquired again`)
+}
+
+/**
+ * @covers BagOStuff::getScopedLock
  */
-func testReportDupes(t *testing.T) {
+func bagOStuffTestGetScopedLock(t *testing.T, cache IBagOStuff) {
+	key := cache.MakeKey("test")
+	release := cache.GetScopedLock(key, 0, 30, "")
+	test.AssertTrue(t, release != nil, `Scoped lock acquired`)
+	test.AssertTrue(t, cache.GetScopedLock(key, 0, 30, "") == nil, `Scoped lock is held`)
+	release()
+	test.AssertTrue(t, cache.GetScopedLock(key, 0, 30, "") != nil, `Scoped lock acquired again`)
+}
+
+/**
+ * @covers BagOStuff
+ */
+func TestBagOStuff(t *testing.T) {
+	runBagOStuffTests(t, "BagOStuff", func() IBagOStuff {
+		return newSimpleBagOStuff()
+	})
+}
+
+/**
+ * Store whose reads and adds fail with the given error, and which does not
+ * record it as the last error
+ */
+type failingBagOStuff struct {
+	*simpleBagOStuff
+	errCode int
+}
+
+func newFailingBagOStuff(errCode int) *failingBagOStuff {
+	this := new(failingBagOStuff)
+	this.simpleBagOStuff = newSimpleBagOStuff()
+	this.BagOStuff = NewBagOStuff(this, nil)
+	this.errCode = errCode
+	return this
+}
+
+func (f *failingBagOStuff) doGetWithToken(key string, flags int) (interface{}, string, bool, int) {
+	return nil, "", false, f.errCode
+}
+
+func (f *failingBagOStuff) doAdd(key string, value interface{}, exptime int) (bool, int) {
+	return false, f.errCode
+}
+
+/**
+ * @covers BagOStuff::lock
+ * @covers BagOStuff::mergeViaLock
+ * @covers BagOStuff::mergeViaCas
+ */
+func TestOperationErrors(t *testing.T) {
+	cache := newFailingBagOStuff(ERR_UNREACHABLE)
+	start := time.Now()
+	test.AssertEqual(t, false, cache.Lock("key", 3, 6, ""), `Lock fails`)
+	test.AssertTrue(t, time.Since(start) < time.Second, `Lock gives up on errors without waiting`)
+	test.AssertEqual(t, ERR_NONE, cache.GetLastError(), `Decided without the last error`)
+
+	calls := 0
+	callback := func(cache IBagOStuff, key string, value interface{}, exists bool) (interface{}, bool) {
+		calls++
+		return 1, true
+	}
+	test.AssertEqual(t, false, cache.mergeViaCas("key", callback, 0, 10), `Merge via CAS fails`)
+	test.AssertEqual(t, 0, calls, `Read errors are not retried`)
+
+	// Races are retried, even if another caller leaves a last error
+	racing := newFailingBagOStuff(ERR_NONE)
+	racing.setLastError(ERR_UNEXPECTED)
+	test.AssertEqual(t, false, racing.mergeViaCas("key", callback, 0, 3), `Merge via CAS loses the races`)
+	test.AssertEqual(t, 3, calls, `Races are retried`)
+}
+
+/**
+ * @covers BagOStuff::incr
+ * @covers HashBagOStuff::incr
+ * @covers RESTBagOStuff::doGetWithExpiry
+ */
+func TestIncrPreservesTTL(t *testing.T) {
+	server := newRESTTestServer()
+	defer server.Close()
+
+	caches := map[string]IBagOStuff{
+		"BagOStuff":     newSimpleBagOStuff(),
+		"HashBagOStuff": NewHashBagOStuff(nil),
+		"RESTBagOStuff": NewRESTBagOStuff(map[string]interface{}{"url": server.URL + "/v1/cache"}),
+	}
+	for name, cache := range caches {
+		now := 1500000000.0
+		cache.(interface{ SetMockTime(*float64) }).SetMockTime(&now)
+		key := cache.MakeKey("test")
+		cache.Set(key, 1, TTL_MINUTE, 0)
+		n, _ := cache.Incr(key, 1)
+		test.AssertEqual(t, 2, n, name+`: value after incr`)
 
-}
\ No newline at end of file
+		now += TTL_MINUTE - 1
+		value, _ := cache.Get(key, 0)
+		test.AssertEqual(t, 2, value, name+`: key kept until its TTL passes`)
+		now += 2
+		_, ok := cache.Get(key, 0)
+		test.AssertEqual(t, false, ok, name+`: key expired with the TTL it had before incr`)
+	}
+}
@@ -0,0 +1,80 @@
+package objectcache
+
+/**
+ * A BagOStuff object with no objects in it. Used to provide a no-op object to calling code.
+ *
+ * @ingroup Cache
+ */
+type EmptyBagOStuff struct {
+	*BagOStuff
+}
+
+/**
+ * @param array $params
+ */
+func NewEmptyBagOStuff(params map[string]interface{}) *EmptyBagOStuff {
+	this := new(EmptyBagOStuff)
+	this.BagOStuff = NewBagOStuff(this, params)
+	return this
+}
+
+/**
+ * @param string $key
+ * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+ * @return mixed Returns false on failure and if the item does not exist
+ */
+func (e *EmptyBagOStuff) doGet(key string, flags int) (interface{}, bool) {
+	return nil, false
+}
+
+/**
+ * @param string $key
+ * @param mixed $value
+ * @param int $exp
+ * @param int $flags
+ * @return bool
+ */
+func (e *EmptyBagOStuff) Add(key string, value interface{}, exp int) bool {
+	return true
+}
+
+/**
+ * @param string $key
+ * @param int $value
+ * @return int|bool
+ */
+func (e *EmptyBagOStuff) Incr(key string, value int) (int, bool) {
+	return 0, false
+}
+
+/**
+ * @param string $key
+ * @param mixed $value
+ * @param int $exp
+ * @param int $flags
+ * @return bool
+ */
+func (e *EmptyBagOStuff) Set(key string, value interface{}, exp, flags int) bool {
+	return true
+}
+
+/**
+ * @param string $key
+ * @param int $flags
+ * @return bool
+ */
+func (e *EmptyBagOStuff) Delete(key string, flags int) bool {
+	return true
+}
+
+/**
+ * @param string $key
+ * @param callable $callback
+ * @param int $exptime
+ * @param int $attempts
+ * @param int $flags
+ * @return bool
+ */
+func (e *EmptyBagOStuff) Merge(key string, callback MergeCallback, exptime, attempts, flags int) bool {
+	return true // faster
+}
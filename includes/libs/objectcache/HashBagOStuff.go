@@ -4,60 +4,357 @@
 package objectcache
 
 import (
+	"container/list"
 	"github.com/MangoDowner/mediawiki/includes/consts"
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	"strconv"
+	"sync"
 )
+
 const KEY_VAL = 0
 const KEY_EXP = 1
+const KEY_CAS = 2
 
 /**
  * Simple store for keeping values in an associative array for the current process.
  *
- * Data will not persist and is not shared with other processes.
+ * Data will not persist and is not shared with other processes. The store is
+ * safe for concurrent use and evicts the least recently used keys once it
+ * holds maxKeys of them, so it can be used as the process cache of other
+ * services.
  *
  * @ingroup Cache
  */
 type HashBagOStuff struct {
-	BagOStuff
-	/** @var mixed[] */
-	bag map[string]interface{}
+	*BagOStuff
+	/** @var list.Element[] Map of (key => element of $order) */
+	bag map[string]*list.Element
+	/** @var list.List Items of $bag, from the least to the most recently used */
+	order *list.List
 	/** @var int Max entries allowed */
 	maxCacheKeys int
+	/** @var int Last CAS token given out */
+	casCounter int
+	/** @var HashBagOStuffStats */
+	stats HashBagOStuffStats
+
+	mutex sync.Mutex
+}
+
+/**
+ * Entry of the HashBagOStuff LRU list
+ */
+type hashBagItem struct {
+	key string
+	/** @var array Map of (KEY_VAL => value, KEY_EXP => expiry, KEY_CAS => CAS token) */
+	fields [3]interface{}
+}
+
+/**
+ * Usage counters of a HashBagOStuff
+ */
+type HashBagOStuffStats struct {
+	/** @var int Reads that found the key */
+	Hits int
+	/** @var int Reads that did not find the key, or found it expired */
+	Misses int
+	/** @var int Keys removed to stay within maxKeys */
+	Evictions int
+	/** @var int Keys removed because their TTL passed */
+	Expirations int
 }
 
 /**
  * @param array $params Additional parameters include:
- *   - maxKeys : only allow this many keys (using oldest-first eviction)
+ *   - maxKeys : only allow this many keys (using least-recently-used eviction)
  */
 func NewHashBagOStuff(params map[string]interface{}) *HashBagOStuff {
 	this := new(HashBagOStuff)
-	if value, ok := params["maxKeys"]; ok {
-		this.maxCacheKeys = value.(int)
-	} else {
-		this.maxCacheKeys = consts.INF
+	this.BagOStuff = NewBagOStuff(this, params)
+	this.bag = make(map[string]*list.Element)
+	this.order = list.New()
+	this.maxCacheKeys = consts.INF
+	if maxKeys, ok := numberParam(params, "maxKeys"); ok {
+		if maxKeys <= 0 || maxKeys != float64(int(maxKeys)) {
+			panic(exception.NewMWException("$maxKeys parameter must be an integer above zero"))
+		}
+		this.maxCacheKeys = int(maxKeys)
 	}
+	return this
+}
 
-	if this.maxCacheKeys <= 0 {
-		panic("$maxKeys parameter must be above zero")
+/**
+ * @param string $key
+ * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+ * @return mixed Returns false on failure and if the item does not exist
+ */
+func (h *HashBagOStuff) doGet(key string, flags int) (interface{}, bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	item, ok := h.read(key)
+	if !ok {
+		return nil, false
 	}
-	return this
+	return item.fields[KEY_VAL], true
+}
+
+/**
+ * @param string $key
+ * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+ * @return array (value, CAS token, whether the item exists)
+ */
+func (h *HashBagOStuff) GetWithToken(key string, flags int) (interface{}, string, bool) {
+	h.mutex.Lock()
+	item, ok := h.read(key)
+	h.mutex.Unlock()
+	if !ok {
+		return nil, "", false
+	}
+	return item.fields[KEY_VAL], item.fields[KEY_CAS].(string), true
+}
+
+/**
+ * Look up an item for a caller, updating the counters
+ *
+ * @param string $key
+ * @return hashBagItem|bool
+ */
+func (h *HashBagOStuff) read(key string) (*hashBagItem, bool) {
+	item, ok := h.get(key)
+	if !ok {
+		h.stats.Misses++
+		return nil, false
+	}
+	h.stats.Hits++
+	// Refresh key position for maxCacheKeys eviction
+	h.order.MoveToBack(h.bag[key])
+	return item, true
+}
+
+/**
+ * @param string $key
+ * @return hashBagItem|bool Unexpired item, if any
+ */
+func (h *HashBagOStuff) get(key string) (*hashBagItem, bool) {
+	elem, ok := h.bag[key]
+	if !ok || h.expire(key) {
+		return nil, false
+	}
+	return elem.Value.(*hashBagItem), true
+}
+
+/**
+ * Set an item
+ *
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool Success
+ */
+func (h *HashBagOStuff) Set(key string, value interface{}, exptime, flags int) bool {
+	h.mutex.Lock()
+	h.set(key, value, h.convertToExpiry(exptime))
+	h.mutex.Unlock()
+	return true
+}
+
+/**
+ * @param string $key
+ * @param mixed $value
+ * @param int $expiry Absolute expiry, or TTL_INDEFINITE
+ */
+func (h *HashBagOStuff) set(key string, value interface{}, expiry int) {
+	h.casCounter++
+	fields := [3]interface{}{value, expiry, strconv.Itoa(h.casCounter)}
+	if elem, ok := h.bag[key]; ok {
+		// Refresh key position for maxCacheKeys eviction
+		elem.Value.(*hashBagItem).fields = fields
+		h.order.MoveToBack(elem)
+		return
+	}
+
+	h.bag[key] = h.order.PushBack(&hashBagItem{key: key, fields: fields})
+	if h.order.Len() > h.maxCacheKeys {
+		oldest := h.order.Front().Value.(*hashBagItem)
+		if !h.expire(oldest.key) {
+			h.remove(oldest.key)
+			h.stats.Evictions++
+		}
+	}
+}
+
+/**
+ * Insert an item if it does not already exist
+ *
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime
+ * @return bool Success
+ */
+func (h *HashBagOStuff) Add(key string, value interface{}, exptime int) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if _, ok := h.get(key); ok {
+		return false
+	}
+	h.set(key, value, h.convertToExpiry(exptime))
+	return true
+}
+
+/**
+ * Check and set an item
+ *
+ * @param mixed $casToken
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @return bool Success
+ */
+func (h *HashBagOStuff) Cas(casToken string, key string, value interface{}, exptime int) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	item, ok := h.get(key)
+	if !ok || item.fields[KEY_CAS].(string) != casToken {
+		return false
+	}
+	h.set(key, value, h.convertToExpiry(exptime))
+	return true
 }
 
-func (l *HashBagOStuff) expire(key string) bool {
-	et := (l.bag[key]).(map[int]int)[KEY_EXP]
-	// TODO
-	if et == TTL_INDEFINITE  {
+/**
+ * Merge changes into the existing cache value (possibly creating a new one)
+ *
+ * @param string $key
+ * @param callable $callback Callback method to be executed
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @param int $attempts The amount of times to attempt a merge in case of failure
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool Success
+ */
+func (h *HashBagOStuff) Merge(key string, callback MergeCallback, exptime, attempts, flags int) bool {
+	return h.mergeViaCas(key, callback, exptime, attempts)
+}
+
+/**
+ * Increase stored value of $key by $value while preserving its TTL
+ *
+ * @param string $key Key to increase
+ * @param int $value Value to add to $key (Default 1)
+ * @return int|bool New value or false on failure
+ */
+func (h *HashBagOStuff) Incr(key string, value int) (int, bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	item, ok := h.get(key)
+	if !ok {
+		return 0, false
+	}
+	n, ok := h.isInteger(item.fields[KEY_VAL])
+	if !ok {
+		return 0, false
+	}
+	n += value
+	if n < 0 {
+		n = 0
+	}
+	h.set(key, n, item.fields[KEY_EXP].(int))
+	return n, true
+}
+
+/**
+ * Reset the TTL on a key if it exists
+ *
+ * @param string $key
+ * @param int $expiry
+ * @return bool Success Returns false if there is no key
+ */
+func (h *HashBagOStuff) ChangeTTL(key string, expiry int) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	item, ok := h.get(key)
+	if !ok {
 		return false
 	}
+	item.fields[KEY_EXP] = h.convertToExpiry(expiry)
 	return true
 }
 
-func (l *HashBagOStuff) Set(key string, value interface{}, exptime, flags int)  {
+/**
+ * Delete an item
+ *
+ * @param string $key
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool True if the item was deleted or not found, false on failure
+ */
+func (h *HashBagOStuff) Delete(key string, flags int) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.remove(key)
+	return true
+}
+
+/**
+ * @param string $key
+ */
+func (h *HashBagOStuff) remove(key string) {
+	elem, ok := h.bag[key]
+	if !ok {
+		return
+	}
+	delete(h.bag, key)
+	h.order.Remove(elem)
+}
+
+/**
+ * Clear all values in cache
+ */
+func (h *HashBagOStuff) Clear() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.bag = make(map[string]*list.Element)
+	h.order.Init()
+}
+
+/**
+ * @return int Number of keys in the cache, including not yet purged expired ones
+ */
+func (h *HashBagOStuff) Len() int {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return h.order.Len()
+}
 
+/**
+ * @return HashBagOStuffStats Counters since creation or the last resetStats()
+ */
+func (h *HashBagOStuff) GetStats() HashBagOStuffStats {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return h.stats
+}
+
+/**
+ * Reset the hit/miss/eviction counters
+ */
+func (h *HashBagOStuff) ResetStats() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.stats = HashBagOStuffStats{}
 }
 
 /**
- * @return float UNIX timestamp
- * @codeCoverageIgnore
+ * @param string $key
+ * @return bool Whether the item expired, in which case it was removed
  */
-func (l *HashBagOStuff) getCurrentTime()  {
-}
\ No newline at end of file
+func (h *HashBagOStuff) expire(key string) bool {
+	et := h.bag[key].Value.(*hashBagItem).fields[KEY_EXP].(int)
+	if et == TTL_INDEFINITE || float64(et) >= h.getCurrentTime() {
+		return false
+	}
+
+	h.remove(key)
+	h.stats.Expirations++
+
+	return true
+}
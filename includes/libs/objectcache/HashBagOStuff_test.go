@@ -1,6 +1,10 @@
 package objectcache
 
 import (
+	test "github.com/MangoDowner/mediawiki/tests"
+	"fmt"
+	"strconv"
+	"sync"
 	"testing"
 )
 
@@ -9,20 +13,189 @@ import (
  * @covers HashBagOStuff::expire
  */
 func TestExpire(t *testing.T) {
-	//cache := NewHashBagOStuff(map[string]interface{}{})
-	//cache.Set("foo", 1)
-	//cache.Set("bar", 1， 10)
-	//cache.Set("baz", 1， -10)
-	//
-	//test.AssetEqual(
-	//	1,
-	//	cache.Get("bar"),
-	//	`Key not expired`,
-	//)
-	//
-	//test.AssetEqual(
-	//	false,
-	//	cache.Get("baz"),
-	//	`Key expired`,
-	//)
-}
\ No newline at end of file
+	cache := NewHashBagOStuff(map[string]interface{}{})
+	cache.Set("foo", 1, 0, 0)
+	cache.Set("bar", 1, 10, 0)
+	cache.Set("baz", 1, -10, 0)
+
+	value, _ := cache.Get("bar", 0)
+	test.AssetEqual(
+		1,
+		value,
+		`Key not expired`,
+	)
+
+	_, ok := cache.Get("baz", 0)
+	test.AssetEqual(
+		false,
+		ok,
+		`Key expired`,
+	)
+}
+
+/**
+ * @covers HashBagOStuff::lock
+ * @covers HashBagOStuff::unlock
+ */
+func TestLock(t *testing.T) {
+	cache := NewHashBagOStuff(map[string]interface{}{})
+
+	test.AssetEqual(true, cache.Lock("key", 0, 6, ""), `Lock acquired`)
+	test.AssetEqual(false, cache.Lock("key", 0, 6, ""), `Lock is held`)
+	test.AssetEqual(true, cache.Unlock("key"), `Lock released`)
+	test.AssetEqual(true, cache.Unlock("key"), `Unlocking a free key is a no-op`)
+	test.AssetEqual(true, cache.Lock("key", 0, 6, ""), `Lock acquired again`)
+}
+
+/**
+ * @covers HashBagOStuff
+ */
+func TestHashBagOStuff(t *testing.T) {
+	runBagOStuffTests(t, "HashBagOStuff", func() IBagOStuff {
+		return NewHashBagOStuff(nil)
+	})
+}
+
+/**
+ * @covers HashBagOStuff::__construct
+ */
+func TestConstructBadZero(t *testing.T) {
+	defer func() {
+		test.AssetTrue(recover() != nil, `maxKeys must be above zero`)
+	}()
+	NewHashBagOStuff(map[string]interface{}{"maxKeys": 0})
+}
+
+/**
+ * @covers HashBagOStuff::__construct
+ */
+func TestConstructMaxKeysTypes(t *testing.T) {
+	for _, maxKeys := range []interface{}{3, int64(3), float64(3), uint(3)} {
+		cache := NewHashBagOStuff(map[string]interface{}{"maxKeys": maxKeys})
+		test.AssertEqual(t, 3, cache.maxCacheKeys, fmt.Sprintf("maxKeys of type %T", maxKeys))
+	}
+	for _, maxKeys := range []interface{}{"3", 2.5, -1.0} {
+		func() {
+			defer func() {
+				test.AssertTrue(t, recover() != nil, fmt.Sprintf("Invalid maxKeys %#v", maxKeys))
+			}()
+			NewHashBagOStuff(map[string]interface{}{"maxKeys": maxKeys})
+		}()
+	}
+}
+
+/**
+ * @covers HashBagOStuff::set
+ */
+func TestEvictionSet(t *testing.T) {
+	cache := NewHashBagOStuff(map[string]interface{}{"maxKeys": 3})
+	for _, key := range []string{"foo", "bar", "baz", "quux"} {
+		cache.Set(key, 1, 0, 0)
+	}
+
+	_, ok := cache.Get("foo", 0)
+	test.AssetEqual(false, ok, `Oldest key evicted`)
+	test.AssetEqual(3, cache.Len(), `Only maxKeys keys are kept`)
+	test.AssetEqual(1, cache.GetStats().Evictions, `Eviction counted`)
+}
+
+/**
+ * @covers HashBagOStuff::doGet
+ * @covers HashBagOStuff::set
+ */
+func TestEvictionGet(t *testing.T) {
+	cache := NewHashBagOStuff(map[string]interface{}{"maxKeys": 3})
+	cache.Set("foo", 1, 0, 0)
+	cache.Set("bar", 1, 0, 0)
+	cache.Set("baz", 1, 0, 0)
+	// Make foo the most recently used key
+	cache.Get("foo", 0)
+	cache.Set("quux", 1, 0, 0)
+
+	_, ok := cache.Get("foo", 0)
+	test.AssetEqual(true, ok, `Recently read key kept`)
+	_, ok = cache.Get("bar", 0)
+	test.AssetEqual(false, ok, `Least recently used key evicted`)
+
+	// Overwriting a key also refreshes it
+	cache.Set("baz", 2, 0, 0)
+	cache.Set("bar", 1, 0, 0)
+	value, _ := cache.Get("baz", 0)
+	test.AssetEqual(2, value, `Recently written key kept`)
+	_, ok = cache.Get("quux", 0)
+	test.AssetEqual(false, ok, `Least recently used key evicted after a write`)
+}
+
+/**
+ * @covers BagOStuff::setMockTime
+ * @covers HashBagOStuff::expire
+ */
+func TestMockTime(t *testing.T) {
+	now := 1500000000.0
+	cache := NewHashBagOStuff(nil)
+	cache.SetMockTime(&now)
+
+	cache.Set("short", 1, TTL_PROC_SHORT, 0)
+	cache.Set("minute", 1, TTL_MINUTE, 0)
+	cache.Set("forever", 1, TTL_INDEFINITE, 0)
+
+	now += TTL_PROC_SHORT
+	_, ok := cache.Get("short", 0)
+	test.AssetEqual(true, ok, `Key is valid until its TTL passes`)
+
+	now += 1
+	_, ok = cache.Get("short", 0)
+	test.AssetEqual(false, ok, `Key expired`)
+	_, ok = cache.Get("minute", 0)
+	test.AssetEqual(true, ok, `Key with a longer TTL is valid`)
+
+	now += TTL_YEAR
+	_, ok = cache.Get("minute", 0)
+	test.AssetEqual(false, ok, `Key with a longer TTL expired`)
+	_, ok = cache.Get("forever", 0)
+	test.AssetEqual(true, ok, `Key without TTL never expires`)
+	test.AssetEqual(2, cache.GetStats().Expirations, `Expirations counted`)
+}
+
+/**
+ * @covers HashBagOStuff::getStats
+ * @covers HashBagOStuff::resetStats
+ */
+func TestStats(t *testing.T) {
+	cache := NewHashBagOStuff(nil)
+	cache.Set("foo", 1, 0, 0)
+	cache.Get("foo", 0)
+	cache.Get("foo", 0)
+	cache.Get("bar", 0)
+	cache.GetMulti([]string{"foo", "bar"}, 0)
+
+	test.AssetEqual(
+		HashBagOStuffStats{Hits: 3, Misses: 2},
+		cache.GetStats(),
+		`Hits and misses counted`,
+	)
+	cache.ResetStats()
+	test.AssetEqual(HashBagOStuffStats{}, cache.GetStats(), `Counters reset`)
+}
+
+/**
+ * @covers HashBagOStuff
+ */
+func TestConcurrentAccess(t *testing.T) {
+	cache := NewHashBagOStuff(map[string]interface{}{"maxKeys": 50})
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				key := strconv.Itoa((i * j) % 80)
+				cache.Set(key, j, TTL_MINUTE, 0)
+				cache.Get(key, 0)
+				cache.Incr(key, 1)
+			}
+		}(i)
+	}
+	wg.Wait()
+	test.AssetTrue(cache.Len() <= 50, `maxKeys holds under concurrent writes`)
+}
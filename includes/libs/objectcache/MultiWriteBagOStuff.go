@@ -0,0 +1,330 @@
+package objectcache
+
+import (
+	"github.com/MangoDowner/mediawiki/includes/exception"
+)
+
+/** @var int Seconds to keep a value copied from a lower cache tier to the higher ones */
+const UPGRADE_TTL = 3600
+
+/**
+ * A cache class that replicates all writes to multiple child caches. Reads
+ * are implemented by reading from the caches in the order they are given in
+ * the configuration until a cache gives a positive result.
+ *
+ * Note that cache key construction will use the first cache backend in the list,
+ * so make sure that the other backends can handle such keys (e.g. via encoding).
+ *
+ * Configure with:
+ *
+ * @code
+ * $wgObjectCaches['db-replicated'] = array(
+ *	'class' => 'MultiWriteBagOStuff',
+ *	'caches' => array( 'hash', 'db' ),
+ *	'replication' => 'async',
+ * );
+ * @endcode
+ *
+ * @ingroup Cache
+ */
+type MultiWriteBagOStuff struct {
+	*BagOStuff
+	/** @var BagOStuff[] */
+	caches []IBagOStuff
+	/** @var bool Use async secondary writes */
+	asyncWrites bool
+}
+
+/**
+ * $params include:
+ *   - caches: A numbered array of BagOStuff instances, from the fastest
+ *      tier to the most durable one. Required.
+ *   - replication: Either 'sync' or 'async'. This controls whether writes
+ *      to secondary tiers are done via the asyncHandler callback, if any.
+ *      This is intended for configurations in which the first tier is a
+ *      fast cache whose writes are needed by the request, while the others
+ *      may be written to after the response is sent. Use WRITE_SYNC
+ *      to force all writes to be synchronous anyway.
+ *
+ * @param array $params
+ */
+func NewMultiWriteBagOStuff(params map[string]interface{}) *MultiWriteBagOStuff {
+	this := new(MultiWriteBagOStuff)
+	this.BagOStuff = NewBagOStuff(this, params)
+	this.caches, _ = params["caches"].([]IBagOStuff)
+	if len(this.caches) == 0 {
+		panic(exception.NewMWException("MultiWriteBagOStuff requires a non-empty 'caches' parameter"))
+	}
+	for _, cache := range this.caches {
+		if cache == nil {
+			panic(exception.NewMWException("MultiWriteBagOStuff 'caches' must be BagOStuff instances"))
+		}
+	}
+
+	this.asyncWrites = params["replication"] == "async" && this.asyncHandler != nil
+	// The tiers report their own stats
+	this.stats = nil
+
+	// Claim no more quality of service than the weakest tier provides
+	for _, flag := range []int{ATTR_EMULATION, ATTR_SYNCWRITES} {
+		for _, cache := range this.caches {
+			withQoS, ok := cache.(interface{ GetQoS(flag int) int })
+			if !ok {
+				continue
+			}
+			qos := withQoS.GetQoS(flag)
+			if current, ok := this.attrMap[flag]; qos != QOS_UNKNOWN && (!ok || qos < current) {
+				this.attrMap[flag] = qos
+			}
+		}
+	}
+	return this
+}
+
+/**
+ * @param string $key
+ * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+ * @return mixed Returns false on failure and if the item does not exist
+ */
+func (m *MultiWriteBagOStuff) doGet(key string, flags int) (interface{}, bool) {
+	var missIndexes []int
+	var value interface{}
+	found := false
+	for i, cache := range m.caches {
+		if value, found = cache.Get(key, flags); found {
+			break // found
+		}
+		missIndexes = append(missIndexes, i)
+	}
+
+	if found && len(missIndexes) > 0 && flags&READ_VERIFIED == READ_VERIFIED {
+		// Backfill the value to the higher (and often faster/smaller) cache tiers
+		m.doWrite(missIndexes, m.asyncWrites, func(cache IBagOStuff) bool {
+			return cache.Set(key, value, UPGRADE_TTL, 0)
+		})
+	}
+
+	return value, found
+}
+
+/**
+ * Set an item
+ *
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool Success
+ */
+func (m *MultiWriteBagOStuff) Set(key string, value interface{}, exptime, flags int) bool {
+	return m.doWrite(m.allCaches(), m.usesAsyncWritesGivenFlags(flags), func(cache IBagOStuff) bool {
+		return cache.Set(key, value, exptime, flags)
+	})
+}
+
+/**
+ * Delete an item
+ *
+ * @param string $key
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool True if the item was deleted or not found, false on failure
+ */
+func (m *MultiWriteBagOStuff) Delete(key string, flags int) bool {
+	return m.doWrite(m.allCaches(), m.usesAsyncWritesGivenFlags(flags), func(cache IBagOStuff) bool {
+		return cache.Delete(key, flags)
+	})
+}
+
+/**
+ * Insert an item if it does not already exist
+ *
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime
+ * @return bool Success
+ */
+func (m *MultiWriteBagOStuff) Add(key string, value interface{}, exptime int) bool {
+	// Try the write to the top-tier cache
+	if !m.caches[0].Add(key, value, exptime) {
+		return false
+	}
+	// Relay the add() using set() if it succeeded. This is meant to handle certain
+	// migration scenarios where the same store might get written to twice for certain
+	// keys. In that case, it does not make sense to return false due to "self-conflicts".
+	return m.doWrite(m.allCaches()[1:], m.asyncWrites, func(cache IBagOStuff) bool {
+		return cache.Set(key, value, exptime, 0)
+	})
+}
+
+/**
+ * Increase stored value of $key by $value while preserving its TTL
+ *
+ * @param string $key Key to increase
+ * @param int $value Value to add to $key (Default 1)
+ * @return int|bool New value or false on failure
+ */
+func (m *MultiWriteBagOStuff) Incr(key string, value int) (int, bool) {
+	var res int
+	ok := m.doWrite(m.allCaches(), m.asyncWrites, func(cache IBagOStuff) bool {
+		n, ok := cache.Incr(key, value)
+		if cache == m.caches[0] {
+			res = n
+		}
+		return ok
+	})
+	return res, ok
+}
+
+/**
+ * Merge changes into the existing cache value (possibly creating a new one)
+ *
+ * The merge is done on the first cache, whose result is then copied to the
+ * others, so that the callback runs once and all the tiers agree.
+ *
+ * @param string $key
+ * @param callable $callback Callback method to be executed
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @param int $attempts The amount of times to attempt a merge in case of failure
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool Success
+ */
+func (m *MultiWriteBagOStuff) Merge(key string, callback MergeCallback, exptime, attempts, flags int) bool {
+	var merged interface{}
+	changed := false
+	ok := m.caches[0].Merge(key, func(cache IBagOStuff, key string, value interface{}, exists bool) (interface{}, bool) {
+		merged, changed = callback(cache, key, value, exists)
+		return merged, changed
+	}, exptime, attempts, flags)
+	if !ok || !changed {
+		return ok
+	}
+
+	return m.doWrite(m.allCaches()[1:], m.usesAsyncWritesGivenFlags(flags), func(cache IBagOStuff) bool {
+		return cache.Set(key, merged, exptime, flags)
+	})
+}
+
+/**
+ * Reset the TTL on a key if it exists
+ *
+ * @param string $key
+ * @param int $expiry
+ * @return bool Success Returns false if there is no key
+ */
+func (m *MultiWriteBagOStuff) ChangeTTL(key string, expiry int) bool {
+	return m.doWrite(m.allCaches(), m.asyncWrites, func(cache IBagOStuff) bool {
+		return cache.ChangeTTL(key, expiry)
+	})
+}
+
+/**
+ * Acquire an advisory lock on a key string
+ *
+ * Only the first cache is locked, as the others are just replicas of it;
+ * this also avoids deadlocks.
+ *
+ * @param string $key
+ * @param int $timeout Lock wait timeout; 0 for non-blocking [optional]
+ * @param int $expiry Lock expiry [optional]; 1 day maximum
+ * @param string $rclass Allow reentry if set and the current lock used this value
+ * @return bool Success
+ */
+func (m *MultiWriteBagOStuff) Lock(key string, timeout, expiry int, rclass string) bool {
+	return m.caches[0].Lock(key, timeout, expiry, rclass)
+}
+
+/**
+ * Release an advisory lock on a key string
+ *
+ * @param string $key
+ * @return bool Success
+ */
+func (m *MultiWriteBagOStuff) Unlock(key string) bool {
+	// Only the first cache is locked
+	return m.caches[0].Unlock(key)
+}
+
+/**
+ * Get the "last error" registered; clearLastError() should be called manually
+ * @return int ERR_* constant for the "last error" registry
+ */
+func (m *MultiWriteBagOStuff) GetLastError() int {
+	return m.caches[0].GetLastError()
+}
+
+/**
+ * Clear the "last error" registry
+ */
+func (m *MultiWriteBagOStuff) ClearLastError() {
+	m.caches[0].ClearLastError()
+}
+
+/**
+ * Make a cache key, scoped to the keyspace of the first cache.
+ *
+ * @param string $class Key class
+ * @param string $component [optional] Key component (starting with a key collection name)
+ * @return string Colon-delimited list of $keyspace followed by escaped components of $args
+ */
+func (m *MultiWriteBagOStuff) MakeKey(components ...string) string {
+	return m.caches[0].MakeKey(components...)
+}
+
+/**
+ * Make a global cache key, as the first cache does.
+ *
+ * @param string $class Key class
+ * @param string $component [optional] Key component (starting with a key collection name)
+ * @return string Colon-delimited list of $keyspace followed by escaped components of $args
+ */
+func (m *MultiWriteBagOStuff) MakeGlobalKey(components ...string) string {
+	return m.caches[0].MakeGlobalKey(components...)
+}
+
+/**
+ * Apply a write method to the backing caches specified by $indexes (in order)
+ *
+ * The first cache is always written to synchronously.
+ *
+ * @param int[] $indexes List of backing cache indexes
+ * @param bool $asyncWrites
+ * @param callable $write Performs the write on a cache, returning whether it succeeded
+ * @return bool Whether the synchronous writes succeeded
+ */
+func (m *MultiWriteBagOStuff) doWrite(indexes []int, asyncWrites bool, write func(cache IBagOStuff) bool) bool {
+	ret := true
+	for _, index := range indexes {
+		cache := m.caches[index]
+		if index > 0 && asyncWrites {
+			// Secondary write in async mode: do not block this HTTP request
+			m.asyncHandler(func() {
+				write(cache)
+			})
+		} else if !write(cache) {
+			ret = false
+		}
+	}
+	return ret
+}
+
+/**
+ * @return int[] Indexes of all the backing caches
+ */
+func (m *MultiWriteBagOStuff) allCaches() []int {
+	indexes := make([]int, len(m.caches))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	return indexes
+}
+
+/**
+ * @param int $flags
+ * @return bool
+ */
+func (m *MultiWriteBagOStuff) usesAsyncWritesGivenFlags(flags int) bool {
+	if flags&WRITE_SYNC == WRITE_SYNC {
+		return false
+	}
+	return m.asyncWrites
+}
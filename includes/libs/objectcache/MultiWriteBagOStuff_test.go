@@ -0,0 +1,140 @@
+package objectcache
+
+import (
+	"testing"
+
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * @covers MultiWriteBagOStuff
+ */
+func TestMultiWriteBagOStuff(t *testing.T) {
+	runBagOStuffTests(t, "MultiWriteBagOStuff", func() IBagOStuff {
+		return NewMultiWriteBagOStuff(map[string]interface{}{
+			"caches": []IBagOStuff{NewHashBagOStuff(nil), NewHashBagOStuff(nil)},
+		})
+	})
+}
+
+/**
+ * @covers MultiWriteBagOStuff::__construct
+ */
+func TestMultiWriteConstruct(t *testing.T) {
+	func() {
+		defer func() {
+			test.AssetTrue(recover() != nil, `Caches are required`)
+		}()
+		NewMultiWriteBagOStuff(map[string]interface{}{})
+	}()
+
+	cache := NewMultiWriteBagOStuff(map[string]interface{}{
+		"caches": []IBagOStuff{
+			NewRESTBagOStuff(map[string]interface{}{"url": "http://localhost:7231/v1/cache"}),
+			NewSqlBagOStuff(map[string]interface{}{"server": newMemoryDatabase()}),
+		},
+	})
+	test.AssetEqual(QOS_SYNCWRITES_NONE, cache.GetQoS(ATTR_SYNCWRITES), `Weakest tier QoS`)
+	test.AssetEqual(QOS_EMULATION_SQL, cache.GetQoS(ATTR_EMULATION), `Emulation of any tier`)
+}
+
+/**
+ * @covers MultiWriteBagOStuff::set
+ * @covers MultiWriteBagOStuff::doGet
+ */
+func TestMultiWriteSetAndGet(t *testing.T) {
+	fast, durable := NewHashBagOStuff(nil), NewHashBagOStuff(nil)
+	cache := NewMultiWriteBagOStuff(map[string]interface{}{"caches": []IBagOStuff{fast, durable}})
+
+	cache.Set("key", "value", 0, 0)
+	value, _ := fast.Get("key", 0)
+	test.AssetEqual("value", value, `Written to the fast tier`)
+	value, _ = durable.Get("key", 0)
+	test.AssetEqual("value", value, `Written to the durable tier`)
+
+	durable.Set("key", "durable", 0, 0)
+	value, _ = cache.Get("key", 0)
+	test.AssetEqual("value", value, `Read from the first tier with the key`)
+
+	fast.Delete("key", 0)
+	value, _ = cache.Get("key", 0)
+	test.AssetEqual("durable", value, `Read from the durable tier on miss`)
+	_, ok := fast.Get("key", 0)
+	test.AssetEqual(false, ok, `Unverified values are not copied to the fast tier`)
+
+	cache.Get("key", READ_VERIFIED)
+	value, _ = fast.Get("key", 0)
+	test.AssetEqual("durable", value, `Verified values are copied to the fast tier`)
+
+	cache.Delete("key", 0)
+	_, ok = durable.Get("key", 0)
+	test.AssetEqual(false, ok, `Deleted from the durable tier`)
+}
+
+/**
+ * @covers MultiWriteBagOStuff::add
+ * @covers MultiWriteBagOStuff::lock
+ */
+func TestMultiWriteAddAndLock(t *testing.T) {
+	fast, durable := NewHashBagOStuff(nil), NewHashBagOStuff(nil)
+	cache := NewMultiWriteBagOStuff(map[string]interface{}{"caches": []IBagOStuff{fast, durable}})
+
+	durable.Set("key", "stale", 0, 0)
+	test.AssetTrue(cache.Add("key", "value", 0), `Add only checks the fast tier`)
+	value, _ := durable.Get("key", 0)
+	test.AssetEqual("value", value, `Add is relayed to the durable tier`)
+	test.AssetEqual(false, cache.Add("key", "other", 0), `Add fails on existing keys`)
+
+	test.AssetTrue(cache.Lock("key", 0, 10, ""), `Lock acquired`)
+	_, ok := durable.Get("key:lock", 0)
+	test.AssetEqual(false, ok, `Only the fast tier is locked`)
+	test.AssetTrue(cache.Unlock("key"), `Lock released`)
+}
+
+/**
+ * @covers MultiWriteBagOStuff::doWrite
+ */
+func TestMultiWriteAsync(t *testing.T) {
+	var deferred []func()
+	fast, durable := NewHashBagOStuff(nil), NewHashBagOStuff(nil)
+	cache := NewMultiWriteBagOStuff(map[string]interface{}{
+		"caches":      []IBagOStuff{fast, durable},
+		"replication": "async",
+		"asyncHandler": func(callback func()) {
+			deferred = append(deferred, callback)
+		},
+	})
+
+	cache.Set("key", "value", 0, 0)
+	_, ok := fast.Get("key", 0)
+	test.AssetEqual(true, ok, `Fast tier written synchronously`)
+	_, ok = durable.Get("key", 0)
+	test.AssetEqual(false, ok, `Durable tier write deferred`)
+	for _, callback := range deferred {
+		callback()
+	}
+	_, ok = durable.Get("key", 0)
+	test.AssetEqual(true, ok, `Durable tier written by the deferred update`)
+
+	deferred = nil
+	cache.Set("sync", "value", 0, WRITE_SYNC)
+	_, ok = durable.Get("sync", 0)
+	test.AssetEqual(true, ok, `WRITE_SYNC writes all tiers synchronously`)
+	test.AssetEqual(0, len(deferred), `Nothing deferred with WRITE_SYNC`)
+}
+
+/**
+ * @covers MultiWriteBagOStuff::merge
+ */
+func TestMultiWriteMerge(t *testing.T) {
+	fast, durable := NewHashBagOStuff(nil), NewHashBagOStuff(nil)
+	cache := NewMultiWriteBagOStuff(map[string]interface{}{"caches": []IBagOStuff{fast, durable}})
+
+	durable.Set("key", 10, 0, 0)
+	cache.Merge("key", func(cache IBagOStuff, key string, value interface{}, exists bool) (interface{}, bool) {
+		n, _ := value.(int)
+		return n + 1, true
+	}, 0, 1, 0)
+	value, _ := durable.Get("key", 0)
+	test.AssetEqual(1, value, `Tiers get the value merged on the fast tier`)
+}
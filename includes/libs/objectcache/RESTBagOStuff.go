@@ -1,4 +1,22 @@
 package objectcache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	"github.com/MangoDowner/mediawiki/includes/php"
+	"github.com/astaxie/beego/logs"
+)
+
 /**
  * Default connection timeout in seconds. The kernel retransmits the SYN
  * packet after 1 second, so 1.2 seconds allows for 1 retransmit without
@@ -11,6 +29,12 @@ const DEFAULT_CONN_TIMEOUT = 1.2
  */
 const DEFAULT_REQ_TIMEOUT = 3.0
 
+/**
+ * Default number of requests getMulti(), setMulti() and deleteMulti() run
+ * at the same time
+ */
+const DEFAULT_MAX_CONNS_PER_HOST = 50
+
 /**
  * Interface to key-value storage behind an HTTP server.
  *
@@ -28,6 +52,10 @@ const DEFAULT_REQ_TIMEOUT = 3.0
  *
  * `DELETE /v1/sessions/12345758`
  *
+ * Values are sent gob-encoded along with their expiry, which is enforced
+ * when reading them back. Values of types other than the basic ones must be
+ * registered with gob.Register().
+ *
  * Configure with:
  *
  * @code
@@ -38,23 +66,99 @@ const DEFAULT_REQ_TIMEOUT = 3.0
  * @endcode
  */
 type RESTBagOStuff struct {
-	BagOStuff
-
+	*BagOStuff
 
 	/**
 	 * @var MultiHttpClient
 	 */
-	client interface{}
+	client *http.Client
 
 	/**
 	 * REST URL to use for storage.
 	 * @var string
 	 */
 	url string
+
+	/** @var int Maximum number of concurrent requests of the *Multi() methods */
+	maxConnsPerHost int
+}
+
+/**
+ * Serialized form of the stored values
+ */
+type restBagValue struct {
+	Value interface{}
+	/** @var int Absolute expiry, or TTL_INDEFINITE */
+	Exptime int
+}
+
+func init() {
+	gob.Register(map[string]interface{}{})
+	gob.Register(map[string]string{})
+	gob.Register([]interface{}{})
 }
 
+/**
+ * @param array $params Additional parameters include:
+ *   - url : base URL of the storage, required
+ *   - client : *http.Client to use instead of making one
+ *   - connTimeout : connection timeout in seconds
+ *   - reqTimeout : request timeout in seconds
+ *   - maxConnsPerHost : maximum number of concurrent requests of the
+ *      *Multi() methods, and of connections of the HTTP client
+ *   - proxy : HTTP proxy URL
+ */
 func NewRESTBagOStuff(params map[string]interface{}) *RESTBagOStuff {
 	this := new(RESTBagOStuff)
+	baseURL, _ := params["url"].(string)
+	if baseURL == "" {
+		panic(exception.NewMWException("URL parameter is required"))
+	}
+
+	this.maxConnsPerHost = DEFAULT_MAX_CONNS_PER_HOST
+	if value, ok := numberParam(params, "maxConnsPerHost"); ok {
+		if value < 1 {
+			panic(exception.NewMWException("$maxConnsPerHost parameter must be above zero"))
+		}
+		this.maxConnsPerHost = int(value)
+	}
+
+	if client, ok := params["client"].(*http.Client); ok {
+		this.client = client
+	} else {
+		// Pass through some params to the HTTP client.
+		connTimeout := DEFAULT_CONN_TIMEOUT
+		if value, ok := numberParam(params, "connTimeout"); ok {
+			connTimeout = value
+		}
+		reqTimeout := DEFAULT_REQ_TIMEOUT
+		if value, ok := numberParam(params, "reqTimeout"); ok {
+			reqTimeout = value
+		}
+		transport := &http.Transport{
+			DialContext: (&net.Dialer{
+				Timeout: time.Duration(connTimeout * float64(time.Second)),
+			}).DialContext,
+			MaxConnsPerHost: this.maxConnsPerHost,
+		}
+		if proxy, ok := params["proxy"].(string); ok {
+			proxyURL, err := url.Parse(proxy)
+			if err != nil {
+				panic(exception.NewMWException("Invalid proxy parameter: " + proxy))
+			}
+			transport.Proxy = http.ProxyURL(proxyURL)
+		}
+		this.client = &http.Client{
+			Transport: transport,
+			Timeout:   time.Duration(reqTimeout * float64(time.Second)),
+		}
+	}
+
+	this.BagOStuff = NewBagOStuff(this, params)
+	// Make sure URL ends with /
+	this.url = strings.TrimRight(baseURL, "/") + "/"
+	// Default config, R+W > N; no locks on reads though; writes go straight to state-machine
+	this.attrMap[ATTR_SYNCWRITES] = QOS_SYNCWRITES_QC
 	return this
 }
 
@@ -63,6 +167,338 @@ func NewRESTBagOStuff(params map[string]interface{}) *RESTBagOStuff {
  * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
  * @return mixed Returns false on failure and if the item does not exist
  */
-func (h *RESTBagOStuff) doGet(key string, flags int) bool {
-	return true
-}
\ No newline at end of file
+func (r *RESTBagOStuff) doGet(key string, flags int) (interface{}, bool) {
+	value, _, ok := r.doGetWithExpiry(key, flags)
+	return value, ok
+}
+
+/**
+ * @param string $key
+ * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+ * @return mixed Returns false on failure and if the item does not exist
+ * @return int Absolute expiry of the item, or TTL_INDEFINITE
+ */
+func (r *RESTBagOStuff) doGetWithExpiry(key string, flags int) (interface{}, int, bool) {
+	value, exptime, ok, _ := r.fetch(key)
+	return value, exptime, ok
+}
+
+/**
+ * @param string $key
+ * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+ * @return array (value, CAS token, whether the item exists)
+ * @return int ERR_* constant
+ */
+func (r *RESTBagOStuff) doGetWithToken(key string, flags int) (interface{}, string, bool, int) {
+	value, _, ok, errCode := r.fetch(key)
+	if !ok {
+		return nil, "", false, errCode
+	}
+	return value, r.makeCasToken(value), true, ERR_NONE
+}
+
+/**
+ * @param string $key
+ * @return mixed Returns false on failure and if the item does not exist
+ * @return int Absolute expiry of the item, or TTL_INDEFINITE
+ * @return int ERR_* constant
+ */
+func (r *RESTBagOStuff) fetch(key string) (interface{}, int, bool, int) {
+	rcode, rdesc, rbody := r.run("GET", key, nil, nil)
+	if rcode == http.StatusOK {
+		return r.unserialize(key, rbody)
+	}
+	if rcode == 0 || (rcode >= 400 && rcode != http.StatusNotFound) {
+		return nil, 0, false, r.handleError("Failed to fetch "+key, rcode, rdesc)
+	}
+	return nil, 0, false, ERR_NONE
+}
+
+/**
+ * Set an item
+ *
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool Success
+ */
+func (r *RESTBagOStuff) Set(key string, value interface{}, exptime, flags int) bool {
+	// @TODO: respect WRITE_SYNC (e.g. EACH_QUORUM)
+	success, _ := r.put(key, value, exptime, nil)
+	return success
+}
+
+/**
+ * Insert an item if it does not already exist
+ *
+ * This sends "If-None-Match: *", so it is atomic with servers supporting
+ * conditional requests. Items which the server still has but which have
+ * expired are replaced with "If-Match" on their ETag, so that of several
+ * callers replacing the same expired item, only the first one succeeds.
+ * With servers not sending ETags, expired items are only replaced once the
+ * server purges them.
+ *
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime
+ * @return bool Success
+ */
+func (r *RESTBagOStuff) Add(key string, value interface{}, exptime int) bool {
+	success, _ := r.doAdd(key, value, exptime)
+	return success
+}
+
+/**
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime
+ * @return bool Success
+ * @return int ERR_* constant
+ */
+func (r *RESTBagOStuff) doAdd(key string, value interface{}, exptime int) (bool, int) {
+	headers := map[string]string{"If-None-Match": "*"}
+	if success, errCode := r.put(key, value, exptime, headers); success || errCode != ERR_NONE {
+		return success, errCode
+	}
+	// The item exists, but may only be kept by the server after expiring
+	rcode, rdesc, rbody, rheaders := r.request("GET", key, nil, nil)
+	switch rcode {
+	case http.StatusOK:
+		if _, _, ok, errCode := r.unserialize(key, rbody); ok || errCode != ERR_NONE {
+			return false, errCode
+		}
+		etag := rheaders.Get("ETag")
+		if etag == "" {
+			logs.Warn("Cannot replace expired %s: the server sends no ETag", key)
+			return false, ERR_NONE
+		}
+		// Fails if another caller replaced it since
+		return r.put(key, value, exptime, map[string]string{"If-Match": etag})
+	case http.StatusNotFound:
+		// Deleted in the meantime; do not delete what others may have added since
+		return r.put(key, value, exptime, headers)
+	}
+	return false, r.handleError("Failed to fetch "+key, rcode, rdesc)
+}
+
+/**
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @param array $headers Additional request headers
+ * @return bool Success
+ * @return int ERR_* constant; ERR_NONE if a precondition failed
+ */
+func (r *RESTBagOStuff) put(key string, value interface{}, exptime int, headers map[string]string) (bool, int) {
+	var body bytes.Buffer
+	err := gob.NewEncoder(&body).Encode(restBagValue{Value: value, Exptime: r.convertToExpiry(exptime)})
+	if err != nil {
+		logs.Error("Failed to serialize %s : %s", key, err)
+		r.setLastError(ERR_UNEXPECTED)
+		return false, ERR_UNEXPECTED
+	}
+
+	rcode, rdesc, _ := r.run("PUT", key, body.Bytes(), headers)
+	switch rcode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+		return true, ERR_NONE
+	case http.StatusPreconditionFailed:
+		return false, ERR_NONE
+	}
+	return false, r.handleError("Failed to store "+key, rcode, rdesc)
+}
+
+/**
+ * Delete an item
+ *
+ * @param string $key
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool True if the item was deleted or not found, false on failure
+ */
+func (r *RESTBagOStuff) Delete(key string, flags int) bool {
+	// @TODO: respect WRITE_SYNC (e.g. EACH_QUORUM)
+	rcode, rdesc, _ := r.run("DELETE", key, nil, nil)
+	switch rcode {
+	case http.StatusOK, http.StatusNoContent, http.StatusResetContent, http.StatusNotFound, http.StatusGone:
+		return true
+	}
+	r.handleError("Failed to delete "+key, rcode, rdesc)
+	return false
+}
+
+/**
+ * Get an associative array containing the item for each of the keys that have items.
+ *
+ * The requests are run concurrently.
+ *
+ * @param array $keys List of strings
+ * @param int $flags Bitfield; supports READ_LATEST [optional]
+ * @return array Map of (key => value) for existing keys
+ */
+func (r *RESTBagOStuff) GetMulti(keys []string, flags int) map[string]interface{} {
+	res := make(map[string]interface{})
+	var mutex sync.Mutex
+	r.runMulti(keys, func(key string) bool {
+		value, ok := r.Get(key, flags)
+		if ok {
+			mutex.Lock()
+			res[key] = value
+			mutex.Unlock()
+		}
+		return true
+	})
+	return res
+}
+
+/**
+ * Batch insertion
+ *
+ * The requests are run concurrently.
+ *
+ * @param array $data $key => $value assoc array
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool Success
+ */
+func (r *RESTBagOStuff) SetMulti(data map[string]interface{}, exptime, flags int) bool {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	return r.runMulti(keys, func(key string) bool {
+		return r.Set(key, data[key], exptime, flags)
+	})
+}
+
+/**
+ * Batch deletion
+ *
+ * The requests are run concurrently.
+ *
+ * @param string[] $keys List of keys
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool Success
+ */
+func (r *RESTBagOStuff) DeleteMulti(keys []string, flags int) bool {
+	return r.runMulti(keys, func(key string) bool {
+		return r.Delete(key, flags)
+	})
+}
+
+/**
+ * Run a callback for each key concurrently, with at most maxConnsPerHost
+ * callbacks running at a time
+ *
+ * @param string[] $keys
+ * @param callable $callback Returns whether it succeeded for the key
+ * @return bool Whether all of the callbacks succeeded
+ */
+func (r *RESTBagOStuff) runMulti(keys []string, callback func(key string) bool) bool {
+	res := true
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, r.maxConnsPerHost)
+	for _, key := range keys {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(key string) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			if !callback(key) {
+				mutex.Lock()
+				res = false
+				mutex.Unlock()
+			}
+		}(key)
+	}
+	wg.Wait()
+	return res
+}
+
+/**
+ * Execute an HTTP request for a key
+ *
+ * @param string $method
+ * @param string $key
+ * @param string $body
+ * @param array $headers Map of (header name => value)
+ * @return array (response code, response description or error, response body);
+ *   the code is 0 if no response was received
+ */
+func (r *RESTBagOStuff) run(method, key string, body []byte, headers map[string]string) (int, string, []byte) {
+	rcode, rdesc, rbody, _ := r.request(method, key, body, headers)
+	return rcode, rdesc, rbody
+}
+
+/**
+ * Execute an HTTP request for a key, as run() does
+ *
+ * @param string $method
+ * @param string $key
+ * @param string $body
+ * @param array $headers Map of (header name => value)
+ * @return array (response code, response description or error, response body,
+ *   response headers)
+ */
+func (r *RESTBagOStuff) request(method, key string, body []byte, headers map[string]string) (int, string, []byte, http.Header) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, r.url+php.Rawurlencode(key), reader)
+	if err != nil {
+		return 0, err.Error(), nil, nil
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return 0, err.Error(), nil, nil
+	}
+	defer resp.Body.Close()
+
+	rbody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err.Error(), nil, nil
+	}
+	return resp.StatusCode, resp.Status, rbody, resp.Header
+}
+
+/**
+ * @param string $key
+ * @param string $data Serialized restBagValue
+ * @return mixed Returns false if the value is corrupt or expired
+ * @return int Absolute expiry, or TTL_INDEFINITE
+ * @return int ERR_* constant; ERR_UNEXPECTED if the value is corrupt
+ */
+func (r *RESTBagOStuff) unserialize(key string, data []byte) (interface{}, int, bool, int) {
+	var item restBagValue
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&item); err != nil {
+		logs.Error("Failed to unserialize %s : %s", key, err)
+		r.setLastError(ERR_UNEXPECTED)
+		return nil, 0, false, ERR_UNEXPECTED
+	}
+	if item.Exptime != TTL_INDEFINITE && float64(item.Exptime) < r.getCurrentTime() {
+		return nil, 0, false, ERR_NONE
+	}
+	return item.Value, item.Exptime, true, ERR_NONE
+}
+
+/**
+ * Handle storage error
+ * @param string $msg Error message
+ * @param int $rcode Error code from client
+ * @param string $rerr Error message from client
+ * @return int ERR_* constant
+ */
+func (r *RESTBagOStuff) handleError(msg string, rcode int, rerr string) int {
+	logs.Error("%s : (%d) %s", msg, rcode, rerr)
+	errCode := ERR_UNEXPECTED
+	if rcode == 0 {
+		errCode = ERR_UNREACHABLE
+	}
+	r.setLastError(errCode)
+	return errCode
+}
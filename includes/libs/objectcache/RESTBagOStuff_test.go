@@ -1,14 +1,278 @@
 package objectcache
 
 import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	test "github.com/MangoDowner/mediawiki/tests"
 )
 
 /**
- * @covers HashBagOStuff::doGet
- * @covers HashBagOStuff::expire
+ * Key-value HTTP server storing the request bodies under the URL path
+ */
+type restTestServer struct {
+	*httptest.Server
+	data map[string][]byte
+	/** @var int[] Map of key => version, sent as ETag */
+	versions map[string]int
+	mutex    sync.Mutex
+	/** @var bool Whether to send no ETags */
+	noETags bool
+	/** @var callable Called before serving the first PUT with If-Match */
+	beforeReplace func()
+	/** @var int Status code to answer with instead of serving the request */
+	failWith int
+	/** @var time.Duration Time to wait before answering */
+	delay time.Duration
+	/** @var int Number of requests being served */
+	inFlight int
+	/** @var int Highest value of $inFlight */
+	maxInFlight int
+}
+
+func newRESTTestServer() *restTestServer {
+	this := new(restTestServer)
+	this.data = make(map[string][]byte)
+	this.versions = make(map[string]int)
+	this.Server = httptest.NewServer(http.HandlerFunc(this.serve))
+	return this
+}
+
+func (s *restTestServer) serve(w http.ResponseWriter, req *http.Request) {
+	s.mutex.Lock()
+	s.inFlight++
+	if s.inFlight > s.maxInFlight {
+		s.maxInFlight = s.inFlight
+	}
+	delay := s.delay
+	s.mutex.Unlock()
+	defer func() {
+		s.mutex.Lock()
+		s.inFlight--
+		s.mutex.Unlock()
+	}()
+	time.Sleep(delay)
+
+	if req.Header.Get("If-Match") != "" && s.beforeReplace != nil {
+		beforeReplace := s.beforeReplace
+		s.beforeReplace = nil
+		beforeReplace()
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.failWith != 0 {
+		w.WriteHeader(s.failWith)
+		return
+	}
+	key := strings.TrimPrefix(req.URL.EscapedPath(), "/v1/cache/")
+	switch req.Method {
+	case "GET":
+		body, ok := s.data[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if !s.noETags {
+			w.Header().Set("ETag", fmt.Sprintf(`"%d"`, s.versions[key]))
+		}
+		w.Write(body)
+	case "PUT":
+		_, ok := s.data[key]
+		if ok && req.Header.Get("If-None-Match") == "*" {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		if etag := req.Header.Get("If-Match"); etag != "" && (!ok || etag != fmt.Sprintf(`"%d"`, s.versions[key])) {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		s.data[key], _ = ioutil.ReadAll(req.Body)
+		s.versions[key]++
+		w.WriteHeader(http.StatusNoContent)
+	case "DELETE":
+		delete(s.data, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+/**
+ * @covers RESTBagOStuff
  */
-func TestGet(t *testing.T) {
+func TestRESTBagOStuff(t *testing.T) {
+	server := newRESTTestServer()
+	defer server.Close()
 
+	runBagOStuffTests(t, "RESTBagOStuff", func() IBagOStuff {
+		server.data = make(map[string][]byte)
+		return NewRESTBagOStuff(map[string]interface{}{"url": server.URL + "/v1/cache"})
+	})
 }
 
+/**
+ * @covers RESTBagOStuff::__construct
+ */
+func TestRESTConstruct(t *testing.T) {
+	func() {
+		defer func() {
+			test.AssetTrue(recover() != nil, `URL parameter is required`)
+		}()
+		NewRESTBagOStuff(map[string]interface{}{})
+	}()
+
+	cache := NewRESTBagOStuff(map[string]interface{}{"url": "http://localhost:7231/v1/sessions"})
+	test.AssetEqual("http://localhost:7231/v1/sessions/", cache.url, `URL ends with a slash`)
+	test.AssetEqual(
+		time.Duration(DEFAULT_REQ_TIMEOUT*float64(time.Second)),
+		cache.client.Timeout,
+		`Default request timeout`,
+	)
+	test.AssetEqual(QOS_SYNCWRITES_QC, cache.GetQoS(ATTR_SYNCWRITES), `Writes go straight to the store`)
+
+	cache = NewRESTBagOStuff(map[string]interface{}{"url": "http://localhost:7231/", "reqTimeout": 2,
+		"maxConnsPerHost": int64(4)})
+	test.AssertEqual(t, 2*time.Second, cache.client.Timeout, `Integer timeouts`)
+	test.AssertEqual(t, 4, cache.maxConnsPerHost, `Maximum number of connections`)
+	func() {
+		defer func() {
+			test.AssertTrue(t, recover() != nil, `Timeouts must be numbers`)
+		}()
+		NewRESTBagOStuff(map[string]interface{}{"url": "http://localhost:7231/", "connTimeout": "1s"})
+	}()
+}
+
+/**
+ * @covers RESTBagOStuff::add
+ */
+func TestRESTAddExpired(t *testing.T) {
+	server := newRESTTestServer()
+	defer server.Close()
+	now := 1500000000.0
+	newCache := func() *RESTBagOStuff {
+		cache := NewRESTBagOStuff(map[string]interface{}{"url": server.URL})
+		cache.SetMockTime(&now)
+		return cache
+	}
+	cache, other := newCache(), newCache()
+
+	cache.Set("lock", "old", TTL_MINUTE, 0)
+	now += TTL_MINUTE + 1
+	// Another caller replaces the expired item between the GET and PUT of add()
+	otherAdded := false
+	server.beforeReplace = func() {
+		otherAdded = other.Add("lock", "other", TTL_MINUTE)
+	}
+	test.AssertEqual(t, false, cache.Add("lock", "mine", TTL_MINUTE), `Add fails if raced out`)
+	test.AssertEqual(t, true, otherAdded, `The first caller replaces the expired item`)
+	value, _ := cache.Get("lock", 0)
+	test.AssertEqual(t, "other", value, `The item of the first caller is kept`)
+
+	now += TTL_MINUTE + 1
+	test.AssertEqual(t, true, cache.Add("lock", "mine", TTL_MINUTE), `Expired items are replaced`)
+	value, _ = cache.Get("lock", 0)
+	test.AssertEqual(t, "mine", value, `Replaced item`)
+
+	server.noETags = true
+	now += TTL_MINUTE + 1
+	test.AssertEqual(t, false, cache.Add("lock", "new", TTL_MINUTE), `No replacement without ETags`)
+	test.AssertEqual(t, ERR_NONE, cache.GetLastError(), `Not an error`)
+}
+
+/**
+ * @covers RESTBagOStuff::doGet
+ * @covers RESTBagOStuff::set
+ * @covers RESTBagOStuff::delete
+ */
+func TestRESTRequests(t *testing.T) {
+	server := newRESTTestServer()
+	defer server.Close()
+	cache := NewRESTBagOStuff(map[string]interface{}{"url": server.URL + "/v1/cache/"})
+
+	cache.Set("key with:spaces", map[string]interface{}{"a": []interface{}{1, "b"}}, 0, 0)
+	_, ok := server.data["key%20with%3Aspaces"]
+	test.AssetEqual(true, ok, `Keys are URL-encoded`)
+	value, _ := cache.Get("key with:spaces", 0)
+	test.AssetEqual(
+		`map[a:[1 b]]`,
+		fmt.Sprint(value),
+		`Values keep their types`,
+	)
+
+	_, ok = cache.Get("missing", 0)
+	test.AssetEqual(false, ok, `404 is a miss`)
+	test.AssetEqual(ERR_NONE, cache.GetLastError(), `404 is not an error`)
+	test.AssetTrue(cache.Delete("missing", 0), `Deleting a missing key succeeds`)
+
+	server.data["corrupt"] = []byte("not gob")
+	_, ok = cache.Get("corrupt", 0)
+	test.AssetEqual(false, ok, `Corrupt values are a miss`)
+	test.AssetEqual(ERR_UNEXPECTED, cache.GetLastError(), `Corrupt values are an error`)
+}
+
+/**
+ * @covers RESTBagOStuff::handleError
+ */
+func TestRESTErrors(t *testing.T) {
+	server := newRESTTestServer()
+	cache := NewRESTBagOStuff(map[string]interface{}{
+		"url":        server.URL,
+		"reqTimeout": 0.2,
+	})
+
+	server.failWith = http.StatusServiceUnavailable
+	_, ok := cache.Get("key", 0)
+	test.AssetEqual(false, ok, `5xx is a miss`)
+	test.AssetEqual(ERR_UNEXPECTED, cache.GetLastError(), `5xx is an error`)
+	cache.ClearLastError()
+	test.AssetEqual(false, cache.Set("key", 1, 0, 0), `Set fails on 5xx`)
+	test.AssetEqual(false, cache.Delete("key", 0), `Delete fails on 5xx`)
+	test.AssetEqual(ERR_UNEXPECTED, cache.GetLastError(), `Write errors are recorded`)
+
+	server.failWith = 0
+	server.delay = time.Second
+	cache.ClearLastError()
+	test.AssetEqual(false, cache.Set("key", 1, 0, 0), `Set fails on timeout`)
+	test.AssetEqual(ERR_UNREACHABLE, cache.GetLastError(), `Timeouts leave the store unreachable`)
+
+	server.Close()
+	cache.ClearLastError()
+	_, ok = cache.Get("key", 0)
+	test.AssetEqual(false, ok, `Unreachable store is a miss`)
+	test.AssetEqual(ERR_UNREACHABLE, cache.GetLastError(), `Store is unreachable`)
+}
+
+/**
+ * @covers RESTBagOStuff::getMulti
+ * @covers RESTBagOStuff::setMulti
+ * @covers RESTBagOStuff::deleteMulti
+ */
+func TestRESTMultiConcurrency(t *testing.T) {
+	server := newRESTTestServer()
+	defer server.Close()
+	cache := NewRESTBagOStuff(map[string]interface{}{"url": server.URL})
+
+	server.delay = 50 * time.Millisecond
+	cache.SetMulti(map[string]interface{}{"a": 1, "b": 2, "c": 3}, 0, 0)
+	values := cache.GetMulti([]string{"a", "b", "c", "d"}, 0)
+	test.AssetEqual(3, len(values), `GetMulti returns the existing keys`)
+	test.AssetTrue(server.maxInFlight > 1, `Requests are run concurrently`)
+	test.AssetTrue(cache.DeleteMulti([]string{"a", "b"}, 0), `DeleteMulti succeeds`)
+
+	server.maxInFlight = 0
+	server.delay = 10 * time.Millisecond
+	cache = NewRESTBagOStuff(map[string]interface{}{"url": server.URL, "maxConnsPerHost": 2})
+	keys := make([]string, 20)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key-%d", i)
+	}
+	cache.GetMulti(keys, 0)
+	test.AssertTrue(t, server.maxInFlight <= 2, fmt.Sprintf("At most maxConnsPerHost requests, %d run", server.maxInFlight))
+}
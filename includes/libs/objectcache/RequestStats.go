@@ -0,0 +1,228 @@
+package objectcache
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/astaxie/beego/logs"
+)
+
+/**
+ * Collects what one request does with the caches: the keys it fetches more
+ * than once, and its per-keygroup hit, miss and set counts.
+ *
+ * The cache instances are shared by the concurrent requests, so each request
+ * goes through its own views of them, see wrap(), and reports its collector
+ * when it ends.
+ */
+type RequestStats struct {
+	/** @var array Map of (cache => its counts for the request) */
+	caches map[*BagOStuff]*bagRequestStats
+
+	mutex sync.Mutex
+}
+
+/**
+ * Counts of one cache for a request
+ */
+type bagRequestStats struct {
+	/** @var array Map of (key => number of get() calls since the first one) */
+	duplicateKeyLookups map[string]int
+	/** @var int[] Map of (metric => count) */
+	opStats map[string]int
+}
+
+/**
+ * Key of the RequestStats in the context of a request
+ */
+type requestStatsKey struct{}
+
+func NewRequestStats() *RequestStats {
+	this := new(RequestStats)
+	this.caches = make(map[*BagOStuff]*bagRequestStats)
+	return this
+}
+
+/**
+ * @param Context $ctx Context of the request
+ * @param RequestStats $stats
+ * @return Context The context carrying $stats
+ */
+func WithRequestStats(ctx context.Context, stats *RequestStats) context.Context {
+	return context.WithValue(ctx, requestStatsKey{}, stats)
+}
+
+/**
+ * @param Context $ctx Context of the request
+ * @return RequestStats|null The collector the context carries
+ */
+func RequestStatsFromContext(ctx context.Context) *RequestStats {
+	stats, _ := ctx.Value(requestStatsKey{}).(*RequestStats)
+	return stats
+}
+
+/**
+ * Get a view of a cache counting the operations of the request
+ *
+ * @param BagOStuff $cache
+ * @return BagOStuff
+ */
+func (r *RequestStats) Wrap(cache IBagOStuff) IBagOStuff {
+	bag, ok := cache.(interface{ getBagOStuff() *BagOStuff })
+	if !ok {
+		return cache
+	}
+	return &requestBagOStuff{IBagOStuff: cache, bag: bag.getBagOStuff(), stats: r}
+}
+
+/**
+ * Track the number of times that a given key has been used.
+ *
+ * @param BagOStuff $bag
+ * @param string $key
+ */
+func (r *RequestStats) trackDuplicateKeys(bag *BagOStuff, key string) {
+	if !bag.reportDupes {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	counts := r.getCounts(bag)
+	if _, ok := counts.duplicateKeyLookups[key]; !ok {
+		// Track that we have seen this key. This N-1 counting style allows
+		// easy filtering with array_filter() later.
+		counts.duplicateKeyLookups[key] = 0
+		return
+	}
+	counts.duplicateKeyLookups[key] += 1
+}
+
+/**
+ * Count a get() in the per-keygroup stats
+ *
+ * @param BagOStuff $bag
+ * @param string $key
+ * @param bool $hit Whether the key was found
+ */
+func (r *RequestStats) updateGetStats(bag *BagOStuff, key string, hit bool) {
+	if hit {
+		r.updateOpStats(bag, METRIC_OP_GET_HIT, key)
+	} else {
+		r.updateOpStats(bag, METRIC_OP_GET_MISS, key)
+	}
+}
+
+/**
+ * Count an operation in the per-keygroup stats
+ *
+ * @param BagOStuff $bag
+ * @param string $op One of the METRIC_OP_* constants
+ * @param string $key
+ */
+func (r *RequestStats) updateOpStats(bag *BagOStuff, op string, key string) {
+	if bag.stats == nil {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.getCounts(bag).opStats["objectcache."+bag.determineKeyClassForStats(key)+"."+op+"_call"]++
+}
+
+/**
+ * @param BagOStuff $bag
+ * @return bagRequestStats
+ */
+func (r *RequestStats) getCounts(bag *BagOStuff) *bagRequestStats {
+	counts, ok := r.caches[bag]
+	if !ok {
+		counts = &bagRequestStats{
+			duplicateKeyLookups: make(map[string]int),
+			opStats:             make(map[string]int),
+		}
+		r.caches[bag] = counts
+	}
+	return counts
+}
+
+/**
+ * Log the keys fetched more than once, and send the per-keygroup stats to
+ * the stats sinks of the caches. The collector is then empty.
+ */
+func (r *RequestStats) Report() {
+	r.mutex.Lock()
+	caches := r.caches
+	r.caches = make(map[*BagOStuff]*bagRequestStats)
+	r.mutex.Unlock()
+
+	for bag, counts := range caches {
+		keys := make([]string, 0, len(counts.duplicateKeyLookups))
+		for key, count := range counts.duplicateKeyLookups {
+			if count > 0 {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			logs.Warn(`Duplicate get(): "%s" fetched %d times`, key, counts.duplicateKeyLookups[key]+1)
+		}
+
+		metrics := make([]string, 0, len(counts.opStats))
+		for metric := range counts.opStats {
+			metrics = append(metrics, metric)
+		}
+		sort.Strings(metrics)
+		for _, metric := range metrics {
+			bag.stats.UpdateCount(metric, counts.opStats[metric])
+		}
+	}
+}
+
+/**
+ * View of a cache counting the fetches and writes of a request
+ */
+type requestBagOStuff struct {
+	IBagOStuff
+	/** @var BagOStuff The generic part of the cache, with its settings */
+	bag *BagOStuff
+	/** @var RequestStats */
+	stats *RequestStats
+}
+
+func (r *requestBagOStuff) Get(key string, flags int) (interface{}, bool) {
+	r.stats.trackDuplicateKeys(r.bag, key)
+	value, ok := r.IBagOStuff.Get(key, flags)
+	r.stats.updateGetStats(r.bag, key, ok)
+	return value, ok
+}
+
+func (r *requestBagOStuff) GetWithToken(key string, flags int) (interface{}, string, bool) {
+	r.stats.trackDuplicateKeys(r.bag, key)
+	value, casToken, ok := r.IBagOStuff.GetWithToken(key, flags)
+	r.stats.updateGetStats(r.bag, key, ok)
+	return value, casToken, ok
+}
+
+func (r *requestBagOStuff) GetMulti(keys []string, flags int) map[string]interface{} {
+	for _, key := range keys {
+		r.stats.trackDuplicateKeys(r.bag, key)
+	}
+	values := r.IBagOStuff.GetMulti(keys, flags)
+	for _, key := range keys {
+		_, ok := values[key]
+		r.stats.updateGetStats(r.bag, key, ok)
+	}
+	return values
+}
+
+func (r *requestBagOStuff) Set(key string, value interface{}, exptime, flags int) bool {
+	r.stats.updateOpStats(r.bag, METRIC_OP_SET, key)
+	return r.IBagOStuff.Set(key, value, exptime, flags)
+}
+
+func (r *requestBagOStuff) SetMulti(data map[string]interface{}, exptime, flags int) bool {
+	for key := range data {
+		r.stats.updateOpStats(r.bag, METRIC_OP_SET, key)
+	}
+	return r.IBagOStuff.SetMulti(data, exptime, flags)
+}
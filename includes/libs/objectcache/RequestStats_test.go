@@ -0,0 +1,85 @@
+package objectcache
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/includes/libs/stats"
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * @covers RequestStats::trackDuplicateKeys
+ * @covers RequestStats::report
+ */
+func TestReportDupes(t *testing.T) {
+	sink := stats.NewBufferingStatsdDataFactory("")
+	cache := NewHashBagOStuff(map[string]interface{}{
+		"reportDupes": true,
+		"stats":       sink,
+	})
+	collector := NewRequestStats()
+	view := collector.Wrap(cache)
+
+	view.Get(cache.MakeKey("foo", "a"), 0)
+	view.Set(cache.MakeKey("foo", "a"), 1, 0, 0)
+	view.Get(cache.MakeKey("foo", "a"), 0)
+	view.Get(cache.MakeKey("foo", "b"), 0)
+	view.GetMulti([]string{cache.MakeKey("bar", "x"), cache.MakeKey("bar", "x")}, 0)
+	cache.Get(cache.MakeKey("foo", "a"), 0)
+	test.AssertEqual(t, false, sink.HasData(), `Stats sent at the end of the request`)
+	test.AssertEqual(t,
+		fmt.Sprint(map[string]int{"local:foo:a": 1, "local:foo:b": 0, "local:bar:x": 1}),
+		fmt.Sprint(collector.caches[cache.BagOStuff].duplicateKeyLookups),
+		`Fetches of the request counted per key`,
+	)
+
+	collector.Report()
+	test.AssertEqual(t,
+		"[objectcache.bar.get_miss_call:2|c objectcache.foo.get_hit_call:1|c "+
+			"objectcache.foo.get_miss_call:2|c objectcache.foo.set_call:1|c]",
+		fmt.Sprint(sink.GetData()),
+		`Stats per key group`,
+	)
+	test.AssertEqual(t, 0, len(collector.caches), `Counts reset`)
+}
+
+/**
+ * @covers RequestStats::updateOpStats
+ */
+func TestNoStats(t *testing.T) {
+	cache := NewHashBagOStuff(map[string]interface{}{
+		"stats": stats.NewNullStatsdDataFactory(),
+	})
+	collector := NewRequestStats()
+	view := collector.Wrap(cache)
+	view.Get("key", 0)
+	view.Get("key", 0)
+	test.AssertEqual(t, 0, len(collector.caches), `Nothing counted`)
+}
+
+/**
+ * @covers RequestStats::wrap
+ */
+func TestConcurrentRequestStats(t *testing.T) {
+	sink := stats.NewBufferingStatsdDataFactory("")
+	cache := NewHashBagOStuff(map[string]interface{}{"stats": sink})
+	first, second := NewRequestStats(), NewRequestStats()
+
+	done := make(chan bool)
+	for _, collector := range []*RequestStats{first, second, first} {
+		go func(view IBagOStuff) {
+			view.Set(cache.MakeKey("foo", "a"), 1, 0, 0)
+			done <- true
+		}(collector.Wrap(cache))
+	}
+	for i := 0; i < 3; i++ {
+		<-done
+	}
+
+	first.Report()
+	test.AssertEqual(t, "[objectcache.foo.set_call:2|c]", fmt.Sprint(sink.GetData()), `Writes of the first request`)
+	sink.ClearData()
+	second.Report()
+	test.AssertEqual(t, "[objectcache.foo.set_call:1|c]", fmt.Sprint(sink.GetData()), `Writes of the second request`)
+}
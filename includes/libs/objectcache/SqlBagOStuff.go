@@ -0,0 +1,782 @@
+package objectcache
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/md5"
+	"encoding/gob"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"strconv"
+
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	"github.com/MangoDowner/mediawiki/includes/libs/rdbms/database"
+	"github.com/astaxie/beego/logs"
+)
+
+/** @var int Seconds to wait between two purges of expired rows */
+const GARBAGE_COLLECT_DELAY_SEC = 1
+
+/** @var string DB timestamp of the items that never expire */
+const SQL_MAX_DATETIME = "99991231235959"
+
+/** @var int Rows to delete per query when purging */
+const PURGE_BATCH_SIZE = 100
+
+/**
+ * Class to store objects in the database
+ *
+ * Items are kept in the `objectcache` table, with the columns `keyname`
+ * (unique), `value` and `exptime`. Keys can be spread over several tables
+ * ("objectcache0", "objectcache1"...) with the "shards" parameter, and over
+ * several servers, which are picked by consistent hashing of the key.
+ *
+ * Integers are stored as they are, so that they can be incremented; other
+ * values are gob-encoded and compressed.
+ *
+ * Configure with:
+ *
+ * @code
+ * $wgObjectCaches['db'] = array(
+ *	'class' => 'SqlBagOStuff',
+ *	'server' => $dbw,
+ *	'shards' => 4,
+ * );
+ * @endcode
+ *
+ * @ingroup Cache
+ */
+type SqlBagOStuff struct {
+	*BagOStuff
+	/** @var IDatabase[] Database handles by server index */
+	conns []database.IDatabase
+	/** @var int */
+	shards int
+	/** @var string */
+	tableName string
+	/** @var int Purge roughly every this many writes; 0 disables purging */
+	purgePeriod int
+	/** @var int Maximum number of rows to purge at once */
+	purgeLimit int
+	/** @var float UNIX timestamp */
+	lastGarbageCollect float64
+}
+
+/**
+ * Serialized form of the values other than integers
+ */
+type sqlBagValue struct {
+	Value interface{}
+}
+
+/**
+ * Constructor. Parameters are:
+ *   - server:      A database handle to use
+ *   - servers:     A list of database handles. Each key is assigned to one
+ *                  of them by consistent hashing. Required if "server" is not set.
+ *   - shards:      The number of tables to use for data storage on each server.
+ *                  If this is more than 1, table names will be formed in the style
+ *                  objectcacheNNN where NNN is the shard index, between 0 and
+ *                  shards-1. The number of digits will be the minimum number
+ *                  required to hold the largest shard index. Data will be
+ *                  distributed across all tables by key hash. This is for
+ *                  MySQL bugs 61735 <https://bugs.mysql.com/bug.php?id=61735>
+ *                  and 61736 <https://bugs.mysql.com/bug.php?id=61736>.
+ *   - tableName:   The table name to use, default is "objectcache".
+ *   - purgePeriod: The average number of object cache writes in between
+ *                  garbage collection operations, where expired entries
+ *                  are removed from the database. Or in other words, the
+ *                  reciprocal of the probability of purging on any given
+ *                  write. If this is set to zero, purging will never be done.
+ *   - purgeLimit:  Maximum number of rows to purge at once.
+ *
+ * @param array $params
+ */
+func NewSqlBagOStuff(params map[string]interface{}) *SqlBagOStuff {
+	this := new(SqlBagOStuff)
+	this.BagOStuff = NewBagOStuff(this, params)
+	if servers, ok := params["servers"].([]database.IDatabase); ok {
+		this.conns = servers
+	} else if server, ok := params["server"].(database.IDatabase); ok {
+		this.conns = []database.IDatabase{server}
+	}
+	if len(this.conns) == 0 {
+		panic(exception.NewMWException("SqlBagOStuff requires a 'server' or 'servers' parameter"))
+	}
+
+	this.shards = 1
+	if shards, ok := params["shards"].(int); ok && shards > 0 {
+		this.shards = shards
+	}
+	this.tableName = "objectcache"
+	if tableName, ok := params["tableName"].(string); ok && tableName != "" {
+		this.tableName = tableName
+	}
+	this.purgePeriod = 10
+	if purgePeriod, ok := params["purgePeriod"].(int); ok {
+		this.purgePeriod = purgePeriod
+	}
+	this.purgeLimit = 100
+	if purgeLimit, ok := params["purgeLimit"].(int); ok {
+		this.purgeLimit = purgeLimit
+	}
+
+	this.attrMap[ATTR_EMULATION] = QOS_EMULATION_SQL
+	this.attrMap[ATTR_SYNCWRITES] = QOS_SYNCWRITES_NONE
+	return this
+}
+
+/**
+ * Get the server index and table name for a given key
+ *
+ * @param string $key
+ * @return array Server index and table name
+ */
+func (s *SqlBagOStuff) getTableByKey(key string) (int, string) {
+	tableIndex := 0
+	if s.shards > 1 {
+		hash := md5.Sum([]byte(key))
+		n, _ := strconv.ParseInt(hex.EncodeToString(hash[:4]), 16, 64)
+		tableIndex = int(n&0x7fffffff) % s.shards
+	}
+
+	serverIndex := 0
+	if len(s.conns) > 1 {
+		// Consistent hashing, so that adding a server only moves its share of keys
+		best := ""
+		for i := range s.conns {
+			hash := md5.Sum([]byte(strconv.Itoa(i) + "\x00" + key))
+			if h := hex.EncodeToString(hash[:]); best == "" || h < best {
+				best, serverIndex = h, i
+			}
+		}
+	}
+
+	return serverIndex, s.getTableNameByShard(tableIndex)
+}
+
+/**
+ * Get the table name for a given shard index
+ *
+ * @param int $index
+ * @return string
+ */
+func (s *SqlBagOStuff) getTableNameByShard(index int) string {
+	if s.shards > 1 {
+		decimals := len(strconv.Itoa(s.shards - 1))
+		return fmt.Sprintf("%s%0*d", s.tableName, decimals, index)
+	}
+	return s.tableName
+}
+
+/**
+ * Create the shard tables on all databases, unless they exist already.
+ * The schema is the one of the objectcache table in maintenance/tables.sql.
+ *
+ * @return DBError|null
+ */
+func (s *SqlBagOStuff) CreateTables() error {
+	for _, db := range s.conns {
+		for i := 0; i < s.shards; i++ {
+			tableName := s.getTableNameByShard(i)
+			queries := []string{
+				fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s ("+
+					"keyname BLOB NOT NULL default '' PRIMARY KEY, value BLOB, exptime TEXT)", tableName),
+				// Index names are per database in SQLite
+				fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s_exptime ON %s (exptime)", tableName, tableName),
+			}
+			for _, sql := range queries {
+				if err := db.Query(sql, "SqlBagOStuff::createTables"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+/**
+ * @param string $key
+ * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+ * @return mixed Returns false on failure and if the item does not exist
+ */
+func (s *SqlBagOStuff) doGet(key string, flags int) (interface{}, bool) {
+	value, _, ok, _ := s.fetch(key)
+	return value, ok
+}
+
+/**
+ * @param string $key
+ * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+ * @return array (value, CAS token, whether the item exists)
+ */
+func (s *SqlBagOStuff) GetWithToken(key string, flags int) (interface{}, string, bool) {
+	value, casToken, ok, _ := s.fetch(key)
+	return value, casToken, ok
+}
+
+/**
+ * @param string $key
+ * @param int $flags Bitfield of BagOStuff::READ_* constants [optional]
+ * @return array (value, CAS token, whether the item exists)
+ * @return int ERR_* constant
+ */
+func (s *SqlBagOStuff) doGetWithToken(key string, flags int) (interface{}, string, bool, int) {
+	return s.fetch(key)
+}
+
+/**
+ * @param string $key
+ * @return array (value, serialized value, whether the item exists)
+ * @return int ERR_* constant
+ */
+func (s *SqlBagOStuff) fetch(key string) (interface{}, string, bool, int) {
+	serverIndex, tableName := s.getTableByKey(key)
+	db := s.conns[serverIndex]
+	row, err := db.SelectRow(tableName, []string{"value", "exptime"},
+		map[string]interface{}{"keyname": key}, "SqlBagOStuff::doGet", nil)
+	if err != nil {
+		return nil, "", false, s.handleReadError(err, serverIndex)
+	}
+	if row == nil {
+		logs.Debug("get: no matching rows")
+		return nil, "", false, ERR_NONE
+	}
+	if s.isExpired(db, row["exptime"]) {
+		logs.Debug("get: key has expired")
+		return nil, "", false, ERR_NONE
+	}
+	value, ok := s.unserialize(key, row["value"])
+	if !ok {
+		return nil, "", false, ERR_UNEXPECTED
+	}
+	return value, row["value"], true, ERR_NONE
+}
+
+/**
+ * Get an associative array containing the item for each of the keys that have items.
+ *
+ * Keys are fetched with one query per table.
+ *
+ * @param array $keys List of strings
+ * @param int $flags Bitfield; supports READ_LATEST [optional]
+ * @return array Map of (key => value) for existing keys
+ */
+func (s *SqlBagOStuff) GetMulti(keys []string, flags int) map[string]interface{} {
+	values := make(map[string]interface{})
+	for serverIndex, keysByTable := range s.groupKeys(keys) {
+		db := s.conns[serverIndex]
+		for tableName, tableKeys := range keysByTable {
+			rows, err := db.Select(tableName, []string{"keyname", "value", "exptime"},
+				map[string]interface{}{"keyname": tableKeys}, "SqlBagOStuff::getMulti", nil)
+			if err != nil {
+				s.handleReadError(err, serverIndex)
+				continue
+			}
+			for _, row := range rows {
+				if s.isExpired(db, row["exptime"]) {
+					continue
+				}
+				if value, ok := s.unserialize(row["keyname"], row["value"]); ok {
+					values[row["keyname"]] = value
+				}
+			}
+		}
+	}
+	return values
+}
+
+/**
+ * @param string[] $keys
+ * @return array Map of (server index => table name => list of keys)
+ */
+func (s *SqlBagOStuff) groupKeys(keys []string) map[int]map[string][]string {
+	groups := make(map[int]map[string][]string)
+	for _, key := range keys {
+		serverIndex, tableName := s.getTableByKey(key)
+		if groups[serverIndex] == nil {
+			groups[serverIndex] = make(map[string][]string)
+		}
+		groups[serverIndex][tableName] = append(groups[serverIndex][tableName], key)
+	}
+	return groups
+}
+
+/**
+ * Set an item
+ *
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool Success
+ */
+func (s *SqlBagOStuff) Set(key string, value interface{}, exptime, flags int) bool {
+	return s.SetMulti(map[string]interface{}{key: value}, exptime, flags)
+}
+
+/**
+ * Batch insertion
+ *
+ * Keys are written with one query per table.
+ *
+ * @param array $data $key => $value assoc array
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool Success
+ */
+func (s *SqlBagOStuff) SetMulti(data map[string]interface{}, exptime, flags int) bool {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+
+	result := true
+	for serverIndex, keysByTable := range s.groupKeys(keys) {
+		db := s.conns[serverIndex]
+		encExpiry := s.encodeExpiry(db, exptime)
+		for tableName, tableKeys := range keysByTable {
+			rows := make([]map[string]interface{}, 0, len(tableKeys))
+			for _, key := range tableKeys {
+				serialized, ok := s.serialize(key, data[key])
+				if !ok {
+					result = false
+					continue
+				}
+				rows = append(rows, map[string]interface{}{
+					"keyname": key,
+					"value":   serialized,
+					"exptime": encExpiry,
+				})
+			}
+			if len(rows) == 0 {
+				continue
+			}
+			_, err := db.Replace(tableName, []string{"keyname"}, rows, "SqlBagOStuff::setMulti")
+			if err != nil {
+				s.handleWriteError(err, serverIndex)
+				result = false
+			}
+		}
+	}
+
+	s.occasionallyGarbageCollect()
+	return result
+}
+
+/**
+ * Insert an item if it does not already exist
+ *
+ * Expired rows for the key are removed first, then the row is inserted
+ * unless another one got there in the meantime.
+ *
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime
+ * @return bool Success
+ */
+func (s *SqlBagOStuff) Add(key string, value interface{}, exptime int) bool {
+	success, _ := s.doAdd(key, value, exptime)
+	return success
+}
+
+/**
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime
+ * @return bool Success
+ * @return int ERR_* constant
+ */
+func (s *SqlBagOStuff) doAdd(key string, value interface{}, exptime int) (bool, int) {
+	serialized, ok := s.serialize(key, value)
+	if !ok {
+		return false, ERR_UNEXPECTED
+	}
+	serverIndex, tableName := s.getTableByKey(key)
+	db := s.conns[serverIndex]
+
+	_, err := db.Delete(tableName, map[string]interface{}{
+		"keyname": key,
+		"exptime": database.NewExpression("<", db.Timestamp(int(s.getCurrentTime()))),
+	}, "SqlBagOStuff::add")
+	if err != nil {
+		return false, s.handleWriteError(err, serverIndex)
+	}
+	affected, err := db.Insert(tableName, []map[string]interface{}{{
+		"keyname": key,
+		"value":   serialized,
+		"exptime": s.encodeExpiry(db, exptime),
+	}}, "SqlBagOStuff::add", map[string]interface{}{"IGNORE": true})
+	if err != nil {
+		return false, s.handleWriteError(err, serverIndex)
+	}
+
+	return affected > 0, ERR_NONE
+}
+
+/**
+ * Check and set an item
+ *
+ * The CAS token is the serialized value, which the row must still have.
+ *
+ * @param mixed $casToken
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @return bool Success
+ */
+func (s *SqlBagOStuff) Cas(casToken string, key string, value interface{}, exptime int) bool {
+	success, _ := s.doCas(casToken, key, value, exptime)
+	return success
+}
+
+/**
+ * @param mixed $casToken
+ * @param string $key
+ * @param mixed $value
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @return bool Success
+ * @return int ERR_* constant
+ */
+func (s *SqlBagOStuff) doCas(casToken string, key string, value interface{}, exptime int) (bool, int) {
+	serialized, ok := s.serialize(key, value)
+	if !ok {
+		return false, ERR_UNEXPECTED
+	}
+	serverIndex, tableName := s.getTableByKey(key)
+	db := s.conns[serverIndex]
+
+	// Only update the row if it is the same as the one read by getWithToken()
+	affected, err := db.Update(tableName,
+		map[string]interface{}{"value": serialized, "exptime": s.encodeExpiry(db, exptime)},
+		map[string]interface{}{
+			"keyname": key,
+			"value":   casToken,
+			"exptime": database.NewExpression(">=", db.Timestamp(int(s.getCurrentTime()))),
+		},
+		"SqlBagOStuff::cas",
+	)
+	if err != nil {
+		return false, s.handleWriteError(err, serverIndex)
+	}
+
+	return affected > 0, ERR_NONE
+}
+
+/**
+ * Merge changes into the existing cache value (possibly creating a new one)
+ *
+ * @param string $key
+ * @param callable $callback Callback method to be executed
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @param int $attempts The amount of times to attempt a merge in case of failure
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool Success
+ */
+func (s *SqlBagOStuff) Merge(key string, callback MergeCallback, exptime, attempts, flags int) bool {
+	return s.mergeViaCas(key, callback, exptime, attempts)
+}
+
+/**
+ * Increase stored value of $key by $value while preserving its TTL
+ *
+ * @param string $key Key to increase
+ * @param int $value Value to add to $key (Default 1)
+ * @return int|bool New value or false on failure
+ */
+func (s *SqlBagOStuff) Incr(key string, value int) (int, bool) {
+	serverIndex, tableName := s.getTableByKey(key)
+	db := s.conns[serverIndex]
+
+	for attempt := 0; attempt < 10; attempt++ {
+		current, casToken, ok, _ := s.fetch(key)
+		if !ok {
+			return 0, false
+		}
+		n, ok := s.isInteger(current)
+		if !ok {
+			return 0, false
+		}
+		n += value
+		if n < 0 {
+			n = 0
+		}
+
+		affected, err := db.Update(tableName,
+			map[string]interface{}{"value": strconv.Itoa(n)},
+			map[string]interface{}{"keyname": key, "value": casToken},
+			"SqlBagOStuff::incr",
+		)
+		if err != nil {
+			s.handleWriteError(err, serverIndex)
+			return 0, false
+		}
+		if affected > 0 {
+			return n, true
+		}
+		// Raced out; try again
+	}
+
+	return 0, false
+}
+
+/**
+ * Reset the TTL on a key if it exists
+ *
+ * @param string $key
+ * @param int $expiry
+ * @return bool Success Returns false if there is no key
+ */
+func (s *SqlBagOStuff) ChangeTTL(key string, expiry int) bool {
+	serverIndex, tableName := s.getTableByKey(key)
+	db := s.conns[serverIndex]
+
+	affected, err := db.Update(tableName,
+		map[string]interface{}{"exptime": s.encodeExpiry(db, expiry)},
+		map[string]interface{}{
+			"keyname": key,
+			"exptime": database.NewExpression(">=", db.Timestamp(int(s.getCurrentTime()))),
+		},
+		"SqlBagOStuff::changeTTL",
+	)
+	if err != nil {
+		s.handleWriteError(err, serverIndex)
+		return false
+	}
+
+	return affected > 0
+}
+
+/**
+ * Delete an item
+ *
+ * @param string $key
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool True if the item was deleted or not found, false on failure
+ */
+func (s *SqlBagOStuff) Delete(key string, flags int) bool {
+	return s.DeleteMulti([]string{key}, flags)
+}
+
+/**
+ * Batch deletion
+ *
+ * Keys are deleted with one query per table.
+ *
+ * @param string[] $keys List of keys
+ * @param int $flags Bitfield of BagOStuff::WRITE_* constants
+ * @return bool Success
+ */
+func (s *SqlBagOStuff) DeleteMulti(keys []string, flags int) bool {
+	result := true
+	for serverIndex, keysByTable := range s.groupKeys(keys) {
+		db := s.conns[serverIndex]
+		for tableName, tableKeys := range keysByTable {
+			_, err := db.Delete(tableName, map[string]interface{}{"keyname": tableKeys}, "SqlBagOStuff::deleteMulti")
+			if err != nil {
+				s.handleWriteError(err, serverIndex)
+				result = false
+			}
+		}
+	}
+
+	s.occasionallyGarbageCollect()
+	return result
+}
+
+/**
+ * Delete objects from the database which expire before a certain date.
+ *
+ * @param int $timestamp UNIX timestamp
+ * @param callable|null $progressCallback Given the percentage of the tables done
+ * @param int $limit Maximum number of rows to delete in total; 0 for no limit
+ * @return bool Success
+ */
+func (s *SqlBagOStuff) DeleteObjectsExpiringBefore(timestamp int, progressCallback func(percent float64), limit int) bool {
+	keysDeletedCount := 0
+	tablesDone := 0
+	for serverIndex, db := range s.conns {
+		dbTimestamp := db.Timestamp(timestamp)
+		for i := 0; i < s.shards; i++ {
+			tableName := s.getTableNameByShard(i)
+			for limit <= 0 || keysDeletedCount < limit {
+				batchSize := PURGE_BATCH_SIZE
+				if limit > 0 && limit-keysDeletedCount < batchSize {
+					batchSize = limit - keysDeletedCount
+				}
+				rows, err := db.Select(tableName, []string{"keyname"},
+					map[string]interface{}{"exptime": database.NewExpression("<", dbTimestamp)},
+					"SqlBagOStuff::deleteObjectsExpiringBefore",
+					map[string]interface{}{"LIMIT": batchSize, "ORDER BY": "exptime"},
+				)
+				if err == nil && len(rows) > 0 {
+					keys := make([]string, 0, len(rows))
+					for _, row := range rows {
+						keys = append(keys, row["keyname"])
+					}
+					_, err = db.Delete(tableName, map[string]interface{}{
+						"keyname": keys,
+						"exptime": database.NewExpression("<", dbTimestamp),
+					}, "SqlBagOStuff::deleteObjectsExpiringBefore")
+					keysDeletedCount += len(keys)
+				}
+				if err != nil {
+					s.handleWriteError(err, serverIndex)
+					return false
+				}
+				if len(rows) < batchSize {
+					break
+				}
+			}
+			tablesDone++
+			if progressCallback != nil {
+				progressCallback(100 * float64(tablesDone) / float64(s.shards*len(s.conns)))
+			}
+		}
+	}
+
+	return true
+}
+
+/**
+ * Delete content of shard tables in every server.
+ * Return true if the operation is successful, false otherwise.
+ * @return bool
+ */
+func (s *SqlBagOStuff) DeleteAll() bool {
+	for serverIndex, db := range s.conns {
+		for i := 0; i < s.shards; i++ {
+			_, err := db.Delete(s.getTableNameByShard(i), map[string]interface{}{"*": true}, "SqlBagOStuff::deleteAll")
+			if err != nil {
+				s.handleWriteError(err, serverIndex)
+				return false
+			}
+		}
+	}
+	return true
+}
+
+/**
+ * Purge the expired rows every $purgePeriod writes on average
+ */
+func (s *SqlBagOStuff) occasionallyGarbageCollect() {
+	if s.purgePeriod <= 0 || rand.Intn(s.purgePeriod) != 0 {
+		return
+	}
+	now := s.getCurrentTime()
+	s.mutex.Lock()
+	// Avoid repeating the delete within a few seconds
+	if now-s.lastGarbageCollect <= GARBAGE_COLLECT_DELAY_SEC {
+		s.mutex.Unlock()
+		return
+	}
+	s.lastGarbageCollect = now
+	s.mutex.Unlock()
+
+	s.DeleteObjectsExpiringBefore(int(now), nil, s.purgeLimit)
+}
+
+/**
+ * @param IDatabase $db
+ * @param int $exptime Either an interval in seconds or a unix timestamp for expiry
+ * @return string DB timestamp
+ */
+func (s *SqlBagOStuff) encodeExpiry(db database.IDatabase, exptime int) string {
+	expiry := s.convertToExpiry(exptime)
+	if expiry == TTL_INDEFINITE {
+		return SQL_MAX_DATETIME
+	}
+	return db.Timestamp(expiry)
+}
+
+/**
+ * @param IDatabase $db
+ * @param string $exptime DB timestamp
+ * @return bool
+ */
+func (s *SqlBagOStuff) isExpired(db database.IDatabase, exptime string) bool {
+	return exptime != SQL_MAX_DATETIME && exptime < db.Timestamp(int(s.getCurrentTime()))
+}
+
+/**
+ * Serialize an object and, if possible, compress the representation.
+ * On typical message and page data, this can provide a 3X decrease
+ * in storage requirements.
+ *
+ * @param string $key
+ * @param mixed $data
+ * @return string|bool False on failure
+ */
+func (s *SqlBagOStuff) serialize(key string, data interface{}) (string, bool) {
+	if n, ok := data.(int); ok {
+		return strconv.Itoa(n), true
+	}
+
+	var buf bytes.Buffer
+	w, _ := flate.NewWriter(&buf, flate.DefaultCompression)
+	err := gob.NewEncoder(w).Encode(sqlBagValue{Value: data})
+	if err == nil {
+		err = w.Close()
+	}
+	if err != nil {
+		logs.Error("Failed to serialize %s : %s", key, err)
+		s.setLastError(ERR_UNEXPECTED)
+		return "", false
+	}
+	return buf.String(), true
+}
+
+/**
+ * Unserialize and, if necessary, decompress an object.
+ *
+ * @param string $key
+ * @param string $serial
+ * @return mixed
+ */
+func (s *SqlBagOStuff) unserialize(key string, serial string) (interface{}, bool) {
+	if n, err := strconv.Atoi(serial); err == nil {
+		return n, true
+	}
+
+	var item sqlBagValue
+	data, err := ioutil.ReadAll(flate.NewReader(bytes.NewReader([]byte(serial))))
+	if err == nil {
+		err = gob.NewDecoder(bytes.NewReader(data)).Decode(&item)
+	}
+	if err != nil {
+		logs.Error("Failed to unserialize %s : %s", key, err)
+		s.setLastError(ERR_UNEXPECTED)
+		return nil, false
+	}
+	return item.Value, true
+}
+
+/**
+ * Handle a DBError which occurred during a read operation.
+ *
+ * @param DBError $exception
+ * @param int $serverIndex
+ * @return int ERR_* constant
+ */
+func (s *SqlBagOStuff) handleReadError(err error, serverIndex int) int {
+	errCode := ERR_UNEXPECTED
+	if _, ok := err.(*database.DBConnectionError); ok {
+		errCode = ERR_UNREACHABLE
+	}
+	s.setLastError(errCode)
+	logs.Error("DBError from server %d: %s", serverIndex, err)
+	return errCode
+}
+
+/**
+ * Handle a DBQueryError which occurred during a write operation.
+ *
+ * @param DBError $exception
+ * @param int $serverIndex
+ * @return int ERR_* constant
+ */
+func (s *SqlBagOStuff) handleWriteError(err error, serverIndex int) int {
+	return s.handleReadError(err, serverIndex)
+}
@@ -0,0 +1,416 @@
+package objectcache
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MangoDowner/mediawiki/includes/libs/rdbms/database"
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * Database keeping its tables in memory, with "keyname" as unique key
+ */
+type memoryDatabase struct {
+	tables map[string][]map[string]string
+	/** @var error Error to fail the queries with */
+	failWith error
+	mutex    sync.Mutex
+}
+
+func newMemoryDatabase() *memoryDatabase {
+	this := new(memoryDatabase)
+	this.tables = make(map[string][]map[string]string)
+	return this
+}
+
+func (m *memoryDatabase) GetType() string {
+	return "memory"
+}
+
+func (m *memoryDatabase) GetSoftwareLink() string {
+	return "memory"
+}
+
+func (m *memoryDatabase) GetServerVersion() string {
+	return ""
+}
+
+/**
+ * Tables are created on the first insert, so only CREATE statements are
+ * accepted, and they do nothing.
+ */
+func (m *memoryDatabase) Query(sql string, fname string) error {
+	if !strings.HasPrefix(strings.ToUpper(strings.TrimSpace(sql)), "CREATE ") {
+		return database.NewDBError("Unsupported query: " + sql)
+	}
+	return nil
+}
+
+func (m *memoryDatabase) Select(table string, vars []string, conds map[string]interface{}, fname string,
+	options map[string]interface{}) ([]map[string]string, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.failWith != nil {
+		return nil, m.failWith
+	}
+	var rows []map[string]string
+	for _, row := range m.tables[table] {
+		if m.matches(row, conds) {
+			res := make(map[string]string)
+			for _, field := range vars {
+				res[field] = row[field]
+			}
+			rows = append(rows, res)
+		}
+	}
+	if orderBy, ok := options["ORDER BY"].(string); ok {
+		sort.SliceStable(rows, func(i, j int) bool { return rows[i][orderBy] < rows[j][orderBy] })
+	}
+	if limit, ok := options["LIMIT"].(int); ok && len(rows) > limit {
+		rows = rows[:limit]
+	}
+	return rows, nil
+}
+
+func (m *memoryDatabase) SelectRow(table string, vars []string, conds map[string]interface{}, fname string,
+	options map[string]interface{}) (map[string]string, error) {
+	rows, err := m.Select(table, vars, conds, fname, options)
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	return rows[0], nil
+}
+
+func (m *memoryDatabase) Insert(table string, rows []map[string]interface{}, fname string,
+	options map[string]interface{}) (int, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.failWith != nil {
+		return 0, m.failWith
+	}
+	affected := 0
+	for _, row := range rows {
+		if m.find(table, fmt.Sprint(row["keyname"])) >= 0 {
+			if options["IGNORE"] == true {
+				continue
+			}
+			return affected, database.NewDBError("Duplicate entry for key 'keyname'")
+		}
+		m.tables[table] = append(m.tables[table], m.toRow(row))
+		affected++
+	}
+	return affected, nil
+}
+
+func (m *memoryDatabase) Update(table string, values map[string]interface{}, conds map[string]interface{},
+	fname string) (int, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.failWith != nil {
+		return 0, m.failWith
+	}
+	affected := 0
+	for _, row := range m.tables[table] {
+		if m.matches(row, conds) {
+			for field, value := range values {
+				row[field] = fmt.Sprint(value)
+			}
+			affected++
+		}
+	}
+	return affected, nil
+}
+
+func (m *memoryDatabase) Replace(table string, uniqueIndexes []string, rows []map[string]interface{},
+	fname string) (int, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.failWith != nil {
+		return 0, m.failWith
+	}
+	affected := 0
+	for _, row := range rows {
+		if i := m.find(table, fmt.Sprint(row["keyname"])); i >= 0 {
+			m.tables[table][i] = m.toRow(row)
+		} else {
+			m.tables[table] = append(m.tables[table], m.toRow(row))
+		}
+		affected++
+	}
+	return affected, nil
+}
+
+func (m *memoryDatabase) Delete(table string, conds map[string]interface{}, fname string) (int, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.failWith != nil {
+		return 0, m.failWith
+	}
+	affected := 0
+	var kept []map[string]string
+	for _, row := range m.tables[table] {
+		if _, all := conds["*"]; all || m.matches(row, conds) {
+			affected++
+		} else {
+			kept = append(kept, row)
+		}
+	}
+	m.tables[table] = kept
+	return affected, nil
+}
+
+func (m *memoryDatabase) Timestamp(ts int) string {
+	return time.Unix(int64(ts), 0).UTC().Format("20060102150405")
+}
+
+func (m *memoryDatabase) matches(row map[string]string, conds map[string]interface{}) bool {
+	for field, cond := range conds {
+		value := row[field]
+		switch c := cond.(type) {
+		case *database.Expression:
+			cmp := strings.Compare(value, fmt.Sprint(c.Value))
+			ok := map[string]bool{
+				"=": cmp == 0, "!=": cmp != 0, "<": cmp < 0, ">": cmp > 0, "<=": cmp <= 0, ">=": cmp >= 0,
+			}[c.Op]
+			if !ok {
+				return false
+			}
+		case []string:
+			found := false
+			for _, v := range c {
+				found = found || v == value
+			}
+			if !found {
+				return false
+			}
+		default:
+			if value != fmt.Sprint(c) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (m *memoryDatabase) find(table, key string) int {
+	for i, row := range m.tables[table] {
+		if row["keyname"] == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *memoryDatabase) toRow(row map[string]interface{}) map[string]string {
+	res := make(map[string]string)
+	for field, value := range row {
+		res[field] = fmt.Sprint(value)
+	}
+	return res
+}
+
+/**
+ * @covers SqlBagOStuff
+ */
+func TestSqlBagOStuff(t *testing.T) {
+	runBagOStuffTests(t, "SqlBagOStuff", func() IBagOStuff {
+		return NewSqlBagOStuff(map[string]interface{}{"server": newMemoryDatabase()})
+	})
+}
+
+/**
+ * @covers SqlBagOStuff::__construct
+ */
+func TestSqlConstruct(t *testing.T) {
+	func() {
+		defer func() {
+			test.AssertTrue(t, recover() != nil, `A server is required`)
+		}()
+		NewSqlBagOStuff(map[string]interface{}{})
+	}()
+
+	cache := NewSqlBagOStuff(map[string]interface{}{"server": newMemoryDatabase()})
+	test.AssertEqual(t, QOS_EMULATION_SQL, cache.GetQoS(ATTR_EMULATION), `Emulated with SQL`)
+	test.AssertEqual(t, 10, cache.purgePeriod, `Default purge period`)
+}
+
+/**
+ * @covers SqlBagOStuff::getTableByKey
+ */
+func TestSqlShards(t *testing.T) {
+	db1, db2 := newMemoryDatabase(), newMemoryDatabase()
+	cache := NewSqlBagOStuff(map[string]interface{}{
+		"servers": []database.IDatabase{db1, db2},
+		"shards":  12,
+	})
+
+	data := make(map[string]interface{})
+	keys := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		key := "key" + strconv.Itoa(i)
+		data[key] = i
+		keys = append(keys, key)
+	}
+	test.AssertTrue(t, cache.SetMulti(data, 0, 0), `SetMulti succeeds`)
+	test.AssertEqual(t, fmt.Sprint(data), fmt.Sprint(cache.GetMulti(keys, 0)), `GetMulti finds all the keys`)
+
+	for _, db := range []*memoryDatabase{db1, db2} {
+		test.AssertTrue(t, len(db.tables) > 1, `Keys are spread over the tables`)
+		for tableName := range db.tables {
+			test.AssertEqual(t, true, strings.HasPrefix(tableName, "objectcache") && len(tableName) == 13,
+				`Table names are zero-padded`)
+		}
+	}
+
+	serverIndex, tableName := cache.getTableByKey("key5")
+	test.AssertTrue(t, 
+		[]*memoryDatabase{db1, db2}[serverIndex].find(tableName, "key5") >= 0,
+		`Key stored in its table`,
+	)
+	value, _ := cache.Get("key5", 0)
+	test.AssertEqual(t, 5, value, `Key read from its table`)
+
+	test.AssertTrue(t, cache.DeleteMulti(keys, 0), `DeleteMulti succeeds`)
+	test.AssertEqual(t, 0, len(cache.GetMulti(keys, 0)), `All the keys are deleted`)
+}
+
+/**
+ * @covers SqlBagOStuff::deleteObjectsExpiringBefore
+ * @covers SqlBagOStuff::occasionallyGarbageCollect
+ */
+func TestSqlExpiry(t *testing.T) {
+	now := 1500000000.0
+	db := newMemoryDatabase()
+	cache := NewSqlBagOStuff(map[string]interface{}{"server": db, "purgePeriod": 0})
+	cache.SetMockTime(&now)
+
+	cache.Set("short", "value", 10, 0)
+	cache.Set("long", "value", 100, 0)
+	cache.Set("forever", "value", 0, 0)
+	test.AssertEqual(t, SQL_MAX_DATETIME, db.tables["objectcache"][2]["exptime"], `Indefinite TTL`)
+
+	now += 50
+	_, ok := cache.Get("short", 0)
+	test.AssertEqual(t, false, ok, `Expired key is a miss`)
+	test.AssertEqual(t, 3, len(db.tables["objectcache"]), `Expired rows are kept until purged`)
+	test.AssertEqual(t, false, cache.ChangeTTL("short", 100), `Expired keys cannot be revived`)
+	test.AssertTrue(t, cache.Add("short", "new", 10), `Expired keys can be added`)
+
+	now += 1000
+	var progress []float64
+	test.AssertTrue(t, cache.DeleteObjectsExpiringBefore(int(now), func(percent float64) {
+		progress = append(progress, percent)
+	}, 0), `Purge succeeds`)
+	test.AssertEqual(t, "[forever]", fmt.Sprint(keysOf(db.tables["objectcache"])), `Expired rows are purged`)
+	test.AssertEqual(t, "[100]", fmt.Sprint(progress), `Progress is reported`)
+
+	cache.purgePeriod = 1
+	cache.Set("short", "value", 10, 0)
+	now += 1000
+	cache.Set("other", "value", 0, 0)
+	test.AssertEqual(t, "[forever other]", fmt.Sprint(keysOf(db.tables["objectcache"])), `Writes purge expired rows`)
+
+	test.AssertTrue(t, cache.DeleteAll(), `DeleteAll succeeds`)
+	test.AssertEqual(t, 0, len(db.tables["objectcache"]), `All rows are deleted`)
+}
+
+/**
+ * @covers SqlBagOStuff::serialize
+ * @covers SqlBagOStuff::unserialize
+ */
+func TestSqlSerialization(t *testing.T) {
+	db := newMemoryDatabase()
+	cache := NewSqlBagOStuff(map[string]interface{}{"server": db})
+
+	cache.Set("int", 42, 0, 0)
+	test.AssertEqual(t, "42", db.tables["objectcache"][0]["value"], `Integers are stored as they are`)
+	n, _ := cache.Incr("int", 8)
+	test.AssertEqual(t, 50, n, `Stored integers can be incremented`)
+
+	text := strings.Repeat("Some long text. ", 100)
+	cache.Set("text", text, 0, 0)
+	test.AssertTrue(t, len(db.tables["objectcache"][1]["value"]) < len(text)/10, `Other values are compressed`)
+	value, _ := cache.Get("text", 0)
+	test.AssertEqual(t, text, value, `Compressed values are read back`)
+
+	db.tables["objectcache"][1]["value"] = "corrupt"
+	_, ok := cache.Get("text", 0)
+	test.AssertEqual(t, false, ok, `Corrupt values are a miss`)
+	test.AssertEqual(t, ERR_UNEXPECTED, cache.GetLastError(), `Corrupt values are an error`)
+}
+
+/**
+ * @covers SqlBagOStuff::handleReadError
+ * @covers SqlBagOStuff::handleWriteError
+ */
+func TestSqlErrors(t *testing.T) {
+	db := newMemoryDatabase()
+	cache := NewSqlBagOStuff(map[string]interface{}{"server": db})
+
+	db.failWith = database.NewDBError("Deadlock found")
+	_, ok := cache.Get("key", 0)
+	test.AssertEqual(t, false, ok, `Failed read is a miss`)
+	test.AssertEqual(t, ERR_UNEXPECTED, cache.GetLastError(), `Query errors are unexpected`)
+	cache.ClearLastError()
+	test.AssertEqual(t, false, cache.Set("key", 1, 0, 0), `Set fails`)
+	test.AssertEqual(t, false, cache.Add("key", 1, 0), `Add fails`)
+	test.AssertEqual(t, ERR_UNEXPECTED, cache.GetLastError(), `Write errors are recorded`)
+
+	db.failWith = database.NewDBConnectionError("")
+	cache.ClearLastError()
+	_, ok = cache.Get("key", 0)
+	test.AssertEqual(t, false, ok, `Unreachable server is a miss`)
+	test.AssertEqual(t, ERR_UNREACHABLE, cache.GetLastError(), `Server is unreachable`)
+}
+
+/**
+ * @covers SqlBagOStuff::add
+ */
+func TestSqlConcurrentAdd(t *testing.T) {
+	db := newMemoryDatabase()
+	caches := []*SqlBagOStuff{
+		NewSqlBagOStuff(map[string]interface{}{"server": db, "purgePeriod": 0}),
+		NewSqlBagOStuff(map[string]interface{}{"server": db, "purgePeriod": 0}),
+	}
+
+	for round := 0; round < 50; round++ {
+		key := "key" + strconv.Itoa(round)
+		var wg sync.WaitGroup
+		var mutex sync.Mutex
+		added := 0
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(cache *SqlBagOStuff, i int) {
+				defer wg.Done()
+				// Unrelated writes on the same database
+				cache.SetMulti(map[string]interface{}{"other" + strconv.Itoa(i): i}, 0, 0)
+				if cache.Add(key, i, 0) {
+					mutex.Lock()
+					added++
+					mutex.Unlock()
+				}
+			}(caches[i%2], i)
+		}
+		wg.Wait()
+		test.AssertEqual(t, 1, added, `Only one caller adds the key`)
+	}
+}
+
+/**
+ * @param array $rows
+ * @return string[] Sorted key names of the rows
+ */
+func keysOf(rows []map[string]string) []string {
+	keys := make([]string, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, row["keyname"])
+	}
+	sort.Strings(keys)
+	return keys
+}
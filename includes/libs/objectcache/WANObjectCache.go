@@ -0,0 +1,1311 @@
+package objectcache
+
+import (
+	"crypto/md5"
+	"encoding/gob"
+	"encoding/hex"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	"github.com/MangoDowner/mediawiki/includes/libs/eventrelayer"
+	"github.com/astaxie/beego/logs"
+)
+
+/** Max time expected to pass between delete() and DB commit finishing */
+const MAX_COMMIT_DELAY = 3
+
+/** Max replication+snapshot lag before applying TTL_LAGGED or disallowing set() */
+const MAX_READ_LAG = 7
+
+/** Seconds to tombstone keys on delete() */
+const HOLDOFF_TTL = 11 // MAX_COMMIT_DELAY + MAX_READ_LAG + 1
+
+/** Seconds to keep dependency purge keys around */
+const CHECK_KEY_TTL = TTL_YEAR
+
+/** Seconds to keep lock keys around */
+const LOCK_TTL = 10
+
+/** Default remaining TTL at which to consider pre-emptive regeneration */
+const LOW_TTL = 30
+
+/** Never consider performing "popularity" refreshes until a key reaches this age */
+const AGE_NEW = 60
+
+/** The time length of the "popularity" refresh window for hot keys */
+const HOT_TTR = 900
+
+/** Hits/second for a refresh to be expected within the "popularity" window */
+const HIT_RATE_HIGH = 1
+
+/** Seconds to ramp up to the "popularity" refresh chance after a key is no longer new */
+const RAMPUP_TTL = 30
+
+/** Idiom for getWithSetCallback() callbacks to avoid calling set() */
+const TTL_UNCACHEABLE = -1
+
+/** Idiom for getWithSetCallback() callbacks meaning "no regeneration mutex" */
+const TSE_NONE = -1
+
+/** Max TTL to store keys when a data sourced is lagged */
+const TTL_LAGGED = 30
+
+/** Idiom for delete() for "no hold-off" */
+const HOLDOFF_NONE = 0
+
+/** Idiom for getWithSetCallback() for "no minimum required as-of timestamp" */
+const MIN_TIMESTAMP_NONE = 0.0
+
+/** Tiny negative float to use when CTL comes up >= 0 due to clock skew */
+const TINY_NEGATIVE = -0.000001
+
+/** Seconds to keep interim values of tombstoned keys around */
+const INTERIM_KEY_TTL = 1
+
+/** Cache format version number */
+const VERSION = 1
+
+/** Value flag: the value is stale and should be treated as expired */
+const FLG_STALE = 1
+
+/** Error code of relay failures, next to the BagOStuff ERR_* ones */
+const ERR_RELAY = 4
+
+/** Default process cache name and max key count */
+const PC_PRIMARY = "primary:1000"
+
+const VALUE_KEY_PREFIX = "WANCache:v:"
+const INTERIM_KEY_PREFIX = "WANCache:i:"
+const TIME_KEY_PREFIX = "WANCache:t:"
+const MUTEX_KEY_PREFIX = "WANCache:m:"
+
+const PURGE_VAL_PREFIX = "PURGED:"
+
+const VFLD_DATA = "WOC:d"    // key to the tombstone entry timestamp
+const VFLD_VERSION = "WOC:v" // key to collection cache version number
+
+const DEFAULT_PURGE_CHANNEL = "wancache-purge"
+
+/**
+ * Multi-datacenter aware caching interface
+ *
+ * All operations go to the local datacenter cache, except for delete(),
+ * touchCheckKey(), and resetCheckKey(), which broadcast to all datacenters.
+ *
+ * This class is intended for caching data from primary stores.
+ * If the get() method does not return a value, then the caller
+ * should query the new value and backfill the cache using set().
+ * The preferred way to do this logic is through getWithSetCallback().
+ * When querying the store on cache miss, the closest DB replica
+ * should be used. Try to avoid heavyweight DB master or quorum reads.
+ * When the source data changes, a purge method should be called.
+ * Since purges are expensive, they should be avoided. One can do so if:
+ *   - a) The object cached is immutable; or
+ *   - b) Validity is checked on each use (e.g. whether the cached
+ *        object derives from the latest source version); or
+ *   - c) The object cached is used for non-critical purposes
+ *
+ * The simplest purge method is delete().
+ *
+ * There are three supported ways to handle broadcasted operations:
+ *   - a) Configure the 'purge' EventRelayer to point to a valid PubSub endpoint
+ *        that has subscribed listeners on the cache servers applying the cache updates.
+ *   - b) Ignore the 'purge' EventRelayer configuration (default is NullEventRelayer)
+ *        and set up mcrouter as the underlying cache backend, using one of the memcached
+ *        BagOStuff classes as 'cache'. Use OperationSelectorRoute in the mcrouter settings
+ *        to configure 'set' and 'delete' operations to go to all DCs via AllAsyncRoute and
+ *        configure other operations to go to the local DC via PoolRoute (for reference,
+ *        see https://github.com/facebook/mcrouter/wiki/List-of-Route-Handles).
+ *   - c) Ignore the 'purge' EventRelayer configuration (default is NullEventRelayer)
+ *        and set up dynomite as cache middleware between the web servers and either
+ *        memcached or redis. This will broadcast all key setting operations, not just purges,
+ *        which can be useful for cache warming. Writes are eventually consistent via the
+ *        Dynamo replication model (see https://github.com/Netflix/dynomite).
+ *
+ * Broadcasted operations like delete() and touchCheckKey() are done asynchronously
+ * in all datacenters this way, though the local one should likely be near immediate.
+ *
+ * This means that callers in all datacenters may see older values for however many
+ * milliseconds that the purge took to reach that datacenter. As with any cache, this
+ * should not be relied on for cases where reads are used to determine writes to source
+ * (e.g. non-cache) data stores, except when reading immutable data.
+ *
+ * All values are wrapped in metadata arrays. Keys use a "WANCache:" prefix
+ * to avoid collisions with keys that are not wrapped as metadata arrays. The
+ * prefixes are as follows:
+ *   - a) "WANCache:v" : used for regular value keys
+ *   - b) "WANCache:i" : used for temporarily storing values of tombstoned keys
+ *   - c) "WANCache:t" : used for storing timestamp "check" keys
+ *   - d) "WANCache:m" : used for temporary mutex keys to avoid cache stampedes
+ *
+ * @ingroup Cache
+ * @since 1.26
+ */
+type WANObjectCache struct {
+	/** @var BagOStuff The local datacenter cache */
+	cache IBagOStuff
+	/** @var HashBagOStuff[] Map of group PHP instance caches */
+	processCaches map[string]*HashBagOStuff
+	/** @var string Purge channel name */
+	purgeChannel string
+	/** @var EventRelayer Bus that handles purge broadcasts */
+	purgeRelayer eventrelayer.IEventRelayer
+
+	/** @var int ERR_* constant for the "last error" registry */
+	lastRelayError int
+
+	/** @var float|null */
+	wallClockOverride *float64
+
+	mutex sync.Mutex
+}
+
+/**
+ * Wrapped form of the values stored in the cache
+ */
+type wanCacheValue struct {
+	/** @var int Cache format version number */
+	Version int
+	Value   interface{}
+	/** @var int Original TTL */
+	TTL int
+	/** @var float Creation timestamp */
+	Time float64
+	/** @var int FLG_* bitfield */
+	Flags int
+}
+
+/**
+ * Parsed form of the purge values
+ */
+type wanPurge struct {
+	/** @var float Purge timestamp */
+	time float64
+	/** @var int Hold-off seconds */
+	holdoff int
+}
+
+/**
+ * Callback for getWithSetCallback(), with the arguments:
+ *   - $oldValue: current cache value or null if not present
+ *   - &$ttl: a reference to the TTL which can be altered
+ *   - &$setOpts: a reference to options for set() which can be altered
+ *   - $oldAsOf: generation timestamp of $oldValue or 0 if not present
+ * It returns the new value, or false as second result to skip caching it.
+ */
+type WANCacheCallback func(oldValue interface{}, ttl *int, setOpts map[string]interface{}, oldAsOf float64) (interface{}, bool)
+
+func init() {
+	gob.Register(wanCacheValue{})
+}
+
+/**
+ * @param array $params
+ *   - cache    : BagOStuff object for a persistent cache
+ *   - channels : Map of (action => channel string). Actions include "purge".
+ *   - relayers : Map of (action => EventRelayer object). Actions include "purge".
+ */
+func NewWANObjectCache(params map[string]interface{}) *WANObjectCache {
+	this := new(WANObjectCache)
+	cache, ok := params["cache"].(IBagOStuff)
+	if !ok {
+		panic(exception.NewMWException("WANObjectCache requires a 'cache' BagOStuff"))
+	}
+	this.cache = cache
+	this.processCaches = make(map[string]*HashBagOStuff)
+	this.purgeChannel = DEFAULT_PURGE_CHANNEL
+	if channels, ok := params["channels"].(map[string]string); ok && channels["purge"] != "" {
+		this.purgeChannel = channels["purge"]
+	}
+	this.purgeRelayer = eventrelayer.NewEventRelayerNull(nil)
+	if relayers, ok := params["relayers"].(map[string]eventrelayer.IEventRelayer); ok && relayers["purge"] != nil {
+		this.purgeRelayer = relayers["purge"]
+	}
+	return this
+}
+
+/**
+ * Fetch the value of a key from cache
+ *
+ * If supplied, $curTTL is set to the remaining TTL (current time left):
+ *   - a) INF; if $key exists, has no TTL, and is not expired by $checkKeys
+ *   - b) float (>=0); if $key exists, has a TTL, and is not expired by $checkKeys
+ *   - c) float (<0); if $key is tombstoned, stale, or existing but expired by $checkKeys
+ *   - d) null; if $key does not exist and is not tombstoned
+ *
+ * If a key is tombstoned, $curTTL will reflect the time since delete().
+ *
+ * The timestamp of $key will be checked against the last-purge timestamp
+ * of each of $checkKeys. Those $checkKeys not in cache will have the last-purge
+ * initialized to the current timestamp. If any of $checkKeys have a timestamp
+ * greater than that of $key, then $curTTL will reflect how long ago $key
+ * became invalid. Callers can use $curTTL to know when the value is stale.
+ * The $checkKeys parameter allow mass invalidations by updating a single key:
+ *   - a) Each "check" key represents "last purged" of some source data
+ *   - b) Callers pass in relevant "check" keys as $checkKeys in get()
+ *   - c) When the source data that "check" keys represent changes,
+ *        the touchCheckKey() method is called on them
+ *
+ * @param string $key Cache key made from makeKey() or makeGlobalKey()
+ * @param array $checkKeys List of "check" keys
+ * @return array (value, $curTTL or null, as-of timestamp of the value, whether it was found)
+ */
+func (w *WANObjectCache) Get(key string, checkKeys []string) (interface{}, *float64, float64, bool) {
+	values, curTTLs, asOfs := w.GetMulti([]string{key}, checkKeys)
+	var curTTL *float64
+	if ttl, ok := curTTLs[key]; ok {
+		curTTL = &ttl
+	}
+	value, ok := values[key]
+	return value, curTTL, asOfs[key], ok
+}
+
+/**
+ * Fetch the value of several keys from cache
+ *
+ * @see WANObjectCache::get()
+ *
+ * @param array $keys List of cache keys made from makeKey() or makeGlobalKey()
+ * @param array $checkKeys List of "check" keys to apply to all $keys
+ * @return array (Map of (key => value) for keys that exist and are not tombstoned,
+ *   map of (key => $curTTL) for keys that exist or are tombstoned,
+ *   map of (key => as-of timestamp) for keys that exist)
+ */
+func (w *WANObjectCache) GetMulti(keys []string, checkKeys []string) (map[string]interface{}, map[string]float64, map[string]float64) {
+	result := make(map[string]interface{})
+	curTTLs := make(map[string]float64)
+	asOfs := make(map[string]float64)
+
+	valueKeys := w.prefixCacheKeys(keys, VALUE_KEY_PREFIX)
+	timeKeys := w.prefixCacheKeys(checkKeys, TIME_KEY_PREFIX)
+
+	// Fetch all of the raw values
+	keysGet := append(append([]string{}, valueKeys...), timeKeys...)
+	wrappedValues := w.cache.GetMulti(keysGet, 0)
+	// Time used to compare/init "check" keys (derived after getMulti() to be pessimistic)
+	now := w.getCurrentTime()
+
+	// Collect timestamps from all "check" keys
+	purgeValues := w.processCheckKeys(timeKeys, wrappedValues, now)
+
+	// Get the main cache value for each key and validate them
+	for i, vKey := range valueKeys {
+		wrapped, ok := wrappedValues[vKey]
+		if !ok {
+			continue // not found
+		}
+		key := keys[i]
+
+		value, curTTL, ok := w.unwrap(wrapped, now)
+		if curTTL == nil {
+			continue // wrong format
+		}
+		if ok {
+			result[key] = value
+			asOf := wrapped.(wanCacheValue).Time
+			// Force dependant keys to be invalid for a while after purging
+			// to reduce race conditions involving stale data getting cached
+			for _, purge := range purgeValues {
+				safeTimestamp := purge.time + float64(purge.holdoff)
+				if safeTimestamp >= asOf {
+					// How long ago this value was expired by *this* check key
+					ago := math.Min(purge.time-now, TINY_NEGATIVE)
+					// How long ago this value was expired by *any* known check key
+					*curTTL = math.Min(*curTTL, ago)
+				}
+			}
+			asOfs[key] = asOf
+		}
+		curTTLs[key] = *curTTL
+	}
+
+	return result, curTTLs, asOfs
+}
+
+/**
+ * @param array $timeKeys List of prefixed time check keys
+ * @param array $wrappedValues
+ * @param float $now
+ * @return array List of purge value arrays
+ */
+func (w *WANObjectCache) processCheckKeys(timeKeys []string, wrappedValues map[string]interface{}, now float64) []wanPurge {
+	purgeValues := make([]wanPurge, 0, len(timeKeys))
+	for _, timeKey := range timeKeys {
+		purge, ok := w.parsePurgeValue(wrappedValues[timeKey])
+		if !ok {
+			// Key is not set or invalid; regenerate
+			newVal := w.makePurgeValue(now, HOLDOFF_TTL)
+			w.cache.Add(timeKey, newVal, CHECK_KEY_TTL)
+			purge, _ = w.parsePurgeValue(newVal)
+		}
+		purgeValues = append(purgeValues, purge)
+	}
+	return purgeValues
+}
+
+/**
+ * Set the value of a key in cache
+ *
+ * Simply calling this method when source data changes is not valid because
+ * the changes do not replicate to the other WAN sites. In that case, delete()
+ * should be used instead. This method is intended for use on cache misses.
+ *
+ * If the data was read from a snapshot-isolated transactions (e.g. the default
+ * REPEATABLE-READ in innoDB), use 'since' to avoid the following race condition:
+ *   - a) T1 starts
+ *   - b) T2 updates a row, calls delete(), and commits
+ *   - c) The HOLDOFF_TTL passes, expiring the delete() tombstone
+ *   - d) T1 reads the row and calls set() due to a cache miss
+ *   - e) Stale value is stuck in cache
+ *
+ * Setting 'lag' and 'since' help avoids keys getting stuck in stale states.
+ *
+ * @param string $key Cache key
+ * @param mixed $value
+ * @param int $ttl Seconds to live. Special values are:
+ *   - IExpiringStore::TTL_INDEFINITE: Cache forever
+ * @param array $opts Options map:
+ *   - lag     : Seconds of replica DB lag. Typically, this is either the replica DB lag
+ *               before the data was read or, if applicable, the replica DB lag before
+ *               the snapshot-isolated transaction the data was read from started.
+ *               Use false to indicate that replication is not running.
+ *               Default: 0 seconds
+ *   - since   : UNIX timestamp of the data in $value. Typically, this is either
+ *               the current time the data was read or (if applicable) the time when
+ *               the snapshot-isolated transaction the data was read from started.
+ *               Default: 0 seconds
+ *   - pending : Whether this data is possibly from an uncommitted write transaction.
+ *               Generally, other threads should not see values from the future and
+ *               they certainly should not see ones that ended up getting rolled back.
+ *               Default: false
+ *   - lockTSE : if excessive replication/snapshot lag is detected, then store the value
+ *               with this TTL and flag it as stale. This is only useful if the reads for
+ *               this key use getWithSetCallback() with "lockTSE" set.
+ *               Default: WANObjectCache::TSE_NONE
+ * @return bool Success
+ */
+func (w *WANObjectCache) Set(key string, value interface{}, ttl int, opts map[string]interface{}) bool {
+	now := w.getCurrentTime()
+	lockTSE := w.intOpt(opts, "lockTSE", TSE_NONE)
+	age := 0.0
+	if _, ok := opts["since"]; ok {
+		age = math.Max(0, now-w.floatOpt(opts, "since", now))
+	}
+	lag := w.floatOpt(opts, "lag", 0)
+	lagKnown := opts["lag"] != false
+
+	// Do not cache potentially uncommitted data as it might get rolled back
+	if pending, _ := opts["pending"].(bool); pending {
+		logs.Debug("Rejected set() for %s due to pending writes.", key)
+		return true // no-op the write for being unsafe
+	}
+
+	flags := 0 // additional wrapped value flags
+	// Check if there's a risk of writing stale data after the purge tombstone expired
+	if !lagKnown || lag+age > MAX_READ_LAG {
+		if lockTSE >= 0 {
+			// Case A: read lag with "lockTSE"; save but record value as stale
+			ttl = w.maxInt(1, lockTSE) // set() expects seconds
+			flags = FLG_STALE          // mark as stale
+		} else if age > MAX_READ_LAG {
+			// Case B: any long-running transaction; ignore this set()
+			logs.Debug("Rejected set() for %s due to snapshot lag.", key)
+			return true // no-op the write for being unsafe
+		} else if !lagKnown || lag > MAX_READ_LAG {
+			// Case C: high replication lag; lower TTL instead of ignoring all set()s
+			if ttl <= 0 || ttl > TTL_LAGGED {
+				ttl = TTL_LAGGED
+			}
+			logs.Warn("Lowered set() TTL for %s due to replication lag.", key)
+		} else {
+			// Case D: medium length request with medium replication lag; ignore this set()
+			logs.Debug("Rejected set() for %s due to high read lag.", key)
+			return true // no-op the write for being unsafe
+		}
+	}
+
+	// Wrap that value with time/TTL/version metadata
+	wrapped := w.wrap(value, ttl, now)
+	wrapped.Flags = flags
+
+	return w.cache.Merge(VALUE_KEY_PREFIX+key, func(cache IBagOStuff, key string, cWrapped interface{}, exists bool) (interface{}, bool) {
+		if _, isTombstone := cWrapped.(string); isTombstone {
+			return nil, false // key is tombstoned; do nothing
+		}
+		return wrapped, true
+	}, ttl, 1, 0)
+}
+
+/**
+ * Purge a key from all datacenters
+ *
+ * This should only be called when the underlying data (being cached)
+ * changes in a significant way. This deletes the key and starts a hold-off
+ * period where the key cannot be written to for a few seconds (HOLDOFF_TTL).
+ * This is done to avoid the following race condition:
+ *   - a) Some DB data changes and delete() is called on a corresponding key
+ *   - b) A request refills the key with a stale value from a lagged DB
+ *   - c) The stale value is stuck there until the key is expired/evicted
+ *
+ * If the key is already tombstoned, another delete() just resets the hold-off.
+ *
+ * When using potentially long-running ACID transactions, a good pattern is
+ * to use a pre-commit hook to issue the delete. This means that immediately
+ * after commit, callers will see the tombstone in cache upon purge relay.
+ * It also avoids the following race condition:
+ *   - a) T1 begins, changes a row, and calls delete()
+ *   - b) The HOLDOFF_TTL passes, expiring the delete() tombstone
+ *   - c) T2 starts, reads the row and calls set() due to a cache miss
+ *   - d) T1 finally commits
+ *   - e) Stale value is stuck in cache
+ *
+ * @param string $key Cache key
+ * @param int $ttl Tombstone TTL; Default: WANObjectCache::HOLDOFF_TTL
+ * @return bool True if the item was purged or not found, false on failure
+ */
+func (w *WANObjectCache) Delete(key string, ttl int) bool {
+	if ttl <= 0 {
+		// Publish the purge to all datacenters
+		return w.relayDelete(VALUE_KEY_PREFIX + key)
+	}
+	// Publish the purge to all datacenters
+	return w.relayPurge(VALUE_KEY_PREFIX+key, ttl, HOLDOFF_NONE)
+}
+
+/**
+ * Fetch the value of a timestamp "check" key
+ *
+ * The key will be *initialized* to the current time if not set,
+ * so only call this method if this behavior is actually desired
+ *
+ * The timestamp can be used to check whether a cached value is valid.
+ * Callers should not assume that this returns the same timestamp in
+ * all datacenters due to relay delays.
+ *
+ * The level of staleness can roughly be estimated from this key, but
+ * if the key was evicted from cache, such calculations may show the
+ * time since expiry as ~0 seconds.
+ *
+ * Note that "check" keys won't collide with other regular keys.
+ *
+ * @param string $key
+ * @return float UNIX timestamp
+ */
+func (w *WANObjectCache) GetCheckKeyTime(key string) float64 {
+	return w.GetMultiCheckKeyTime([]string{key})[key]
+}
+
+/**
+ * Fetch the values of each timestamp "check" key
+ *
+ * @see WANObjectCache::getCheckKeyTime()
+ *
+ * @param array $keys
+ * @return float[] Map of (key => UNIX timestamp)
+ */
+func (w *WANObjectCache) GetMultiCheckKeyTime(keys []string) map[string]float64 {
+	rawKeys := w.prefixCacheKeys(keys, TIME_KEY_PREFIX)
+	rawValues := w.cache.GetMulti(rawKeys, 0)
+
+	times := make(map[string]float64)
+	for i, rawKey := range rawKeys {
+		purge, ok := w.parsePurgeValue(rawValues[rawKey])
+		if !ok {
+			now := w.getCurrentTime()
+			w.cache.Add(rawKey, w.makePurgeValue(now, HOLDOFF_TTL), CHECK_KEY_TTL)
+			purge.time = now
+		}
+		times[keys[i]] = purge.time
+	}
+	return times
+}
+
+/**
+ * Purge a "check" key from all datacenters, invalidating keys that use it
+ *
+ * This should only be called when the underlying data (being cached)
+ * changes in a significant way, and it is impractical to call delete()
+ * on all keys that should be changed. When get() is called on those
+ * keys, the relevant "check" keys must be supplied for this to work.
+ *
+ * The "check" key essentially represents a last-modified time of an entity.
+ * When the key is touched, the timestamp will be updated to the current time.
+ * Keys using the "check" key via get(), getMulti(), or getWithSetCallback() will
+ * be invalidated. This approach is useful if many keys depend on a single entity.
+ *
+ * The timestamp of the "check" key is treated as being HOLDOFF_TTL seconds in the
+ * future by get*() methods in order to avoid race conditions where keys are updated
+ * with stale values (e.g. from a lagged replica DB). A high TTL is set on the "check"
+ * key, making it possible to know the timestamp of the last change to the corresponding
+ * entities in most cases. This might use more cache space than resetCheckKey().
+ *
+ * When a few important keys get a large number of hits, a high cache time is usually
+ * desired as well as "lockTSE" logic. The resetCheckKey() method is less appropriate
+ * in such cases since the "time since expiry" cannot be inferred, causing any get()
+ * after the reset to treat the key as being "hot", resulting in more stale value usage.
+ *
+ * Note that "check" keys won't collide with other regular keys.
+ *
+ * @see WANObjectCache::get()
+ * @see WANObjectCache::getWithSetCallback()
+ * @see WANObjectCache::resetCheckKey()
+ *
+ * @param string $key Cache key
+ * @param int $holdoff HOLDOFF_TTL or HOLDOFF_NONE constant
+ * @return bool True if the item was purged or not found, false on failure
+ */
+func (w *WANObjectCache) TouchCheckKey(key string, holdoff int) bool {
+	// Publish the purge to all datacenters
+	return w.relayPurge(TIME_KEY_PREFIX+key, CHECK_KEY_TTL, holdoff)
+}
+
+/**
+ * Delete a "check" key from all datacenters, invalidating keys that use it
+ *
+ * This is similar to touchCheckKey() in that keys using it via get(), getMulti(),
+ * or getWithSetCallback() will be invalidated. The differences are:
+ *   - a) The "check" key will be deleted from all caches and lazily
+ *        re-initialized when accessed (rather than set everywhere)
+ *   - b) Thus, dependent keys will be known to be stale, but not
+ *        for how long (they are treated as "just" purged), which
+ *        effects any lockTSE logic in getWithSetCallback()
+ *   - c) Since "check" keys are initialized only on the server the key hashes
+ *        to, any temporary ejection of that server will cause the value to be
+ *        seen as purged as a new server will initialize the "check" key.
+ *
+ * The advantage here is that the "check" keys, which have high TTLs, will only
+ * be created when a get*() method actually uses that key. This is better when
+ * a large number of "check" keys are invalided in a short period of time.
+ *
+ * Note that "check" keys won't collide with other regular keys.
+ *
+ * @see WANObjectCache::get()
+ * @see WANObjectCache::getWithSetCallback()
+ * @see WANObjectCache::touchCheckKey()
+ *
+ * @param string $key Cache key
+ * @return bool True if the item was purged or not found, false on failure
+ */
+func (w *WANObjectCache) ResetCheckKey(key string) bool {
+	// Publish the purge to all datacenters
+	return w.relayDelete(TIME_KEY_PREFIX + key)
+}
+
+/**
+ * Method to fetch/regenerate cache keys
+ *
+ * On cache miss, the key will be set to the callback result via set()
+ * (unless the callback returns false) and that result will be returned.
+ * The arguments supplied to the callback are:
+ *   - $oldValue: current cache value or null if not present
+ *   - &$ttl: a reference to the TTL which can be altered
+ *   - &$setOpts: a reference to options for set() which can be altered
+ *   - $oldAsOf: generation UNIX timestamp of $oldValue or 0 if not present
+ *
+ * It is strongly recommended to set the 'lag' and 'since' fields to avoid race conditions
+ * that can cause stale values to get stuck at keys. Usually, callbacks ignore the current
+ * value, but it can be used to maintain "most recent X" values that come from time or
+ * sequence based source data, provided that the "as of" id/time is tracked. Note that
+ * preemptive regeneration and $checkKeys can result in a non-false current value.
+ *
+ * Usage of $checkKeys is similar to get() and getMulti(). However, rather than the caller
+ * having to inspect a "current time left" variable (e.g. $curTTL, $curTTLs), a cache
+ * regeneration will automatically be triggered using the callback.
+ *
+ * The simplest way to avoid stampedes for hot keys is to use
+ * the 'lockTSE' option in $opts. If cache purges are needed, also:
+ *   - a) Pass $key into $checkKeys
+ *   - b) Use touchCheckKey( $key ) instead of delete( $key )
+ *
+ * @param string $key Cache key made from makeKey() or makeGlobalKey()
+ * @param int $ttl Seconds to live for key updates. Special values are:
+ *   - IExpiringStore::TTL_INDEFINITE: Cache forever
+ *   - WANObjectCache::TTL_UNCACHEABLE: Do not cache at all
+ * @param callable $callback Value generation function
+ * @param array $opts Options map:
+ *   - checkKeys: List of "check" keys. The key at $key will be seen as invalid when either
+ *      touchCheckKey() or resetCheckKey() is called on any of these keys.
+ *      Default: [].
+ *   - lockTSE: If the key is tombstoned or expired (by checkKeys) less than this many seconds
+ *      ago, then try to have a single thread handle cache regeneration at any given time.
+ *      Other threads will try to use stale values if possible. If, on miss, the time since
+ *      expiration is low, the assumption is that the key is hot and that a stampede is worth
+ *      avoiding. Setting this above WANObjectCache::HOLDOFF_TTL makes no difference. The
+ *      higher this is set, the higher the worst-case staleness can be.
+ *      Use WANObjectCache::TSE_NONE to disable this logic.
+ *      Default: WANObjectCache::TSE_NONE.
+ *   - busyValue: If no value exists and another thread is currently regenerating it, use this
+ *      as a fallback value (or a callback to generate such a value). This assures that cache
+ *      stampedes cannot happen if the value falls out of cache. This can be used as insurance
+ *      against cache regeneration becoming very slow for some reason (greater than the TTL).
+ *      Default: null.
+ *   - pcTTL: Process cache the value in this PHP instance for this many seconds. This avoids
+ *      network I/O when a key is read several times. This will not cache when the callback
+ *      returns false, however. Note that any purges will not be seen while process cached;
+ *      since the callback should use replica DBs and they may be lagged or have snapshot
+ *      isolation anyway, this should not typically matter.
+ *      Default: WANObjectCache::TTL_UNCACHEABLE.
+ *   - pcGroup: Process cache group to use instead of the primary one. If set, this must be
+ *      of the format ALPHANUMERIC_NAME:MAX_KEY_SIZE, e.g. "mydata:10". Use this for storing
+ *      large values, small yet numerous values, or some values with a high cost of eviction.
+ *      It is generally preferable to use a class constant when setting this value.
+ *      This has no effect unless pcTTL is used.
+ *      Default: WANObjectCache::PC_PRIMARY.
+ *   - version: Integer version number. This allows for callers to make breaking changes to
+ *      how values are stored while maintaining compatability and correct cache purges. New
+ *      versions are stored alongside older versions concurrently. Avoid storing class objects
+ *      however, as this reduces compatibility (due to serialization).
+ *      Default: null.
+ *   - minAsOf: Reject values if they were generated before this UNIX timestamp.
+ *      This is useful if the source of a key is suspected of having possibly changed
+ *      recently, and the caller wants any such changes to be reflected.
+ *      Default: WANObjectCache::MIN_TIMESTAMP_NONE.
+ *   - hotTTR: Expected time-till-refresh (TTR) for keys that average ~1 hit/second (1 Hz).
+ *      Keys with a hit rate higher than 1Hz will refresh sooner than this TTR and vise versa.
+ *      Such refreshes won't happen until keys are "ageNew" seconds old. The TTR is useful at
+ *      reducing the impact of missed cache purges, since the effect of a heavily referenced
+ *      key being stale is worse than that of a rarely referenced key. Unlike simply lowering
+ *      $ttl, seldomly used keys are largely unaffected by this option, which makes it possible
+ *      to have a high hit rate for the "long-tail" of less-used keys.
+ *      Default: WANObjectCache::HOT_TTR.
+ *   - lowTTL: Consider pre-emptive updates when the current TTL (seconds) of the key is less
+ *      than this. It becomes more likely over time, becoming certain once the key is expired.
+ *      Default: WANObjectCache::LOW_TTL.
+ *   - ageNew: Consider popularity refreshes only once a key reaches this age in seconds.
+ *      Default: WANObjectCache::AGE_NEW.
+ *   - nested: Whether the call is made from within the callback of another
+ *      getWithSetCallback() call. The process cache is not used then, as it is not lag-safe
+ *      with regard to HOLDOFF_TTL. Go has no thread-local callback stack, so the callbacks
+ *      must say so themselves; other goroutines are not affected by the pending callbacks.
+ *      Default: false.
+ * @return mixed Value found or written to the key; false as second result if the
+ *   callback declined to give one
+ */
+func (w *WANObjectCache) GetWithSetCallback(key string, ttl int, callback WANCacheCallback, opts map[string]interface{}) (interface{}, bool) {
+	pcTTL := w.intOpt(opts, "pcTTL", TTL_UNCACHEABLE)
+
+	// Try the process cache if enabled and the cache callback is not within a cache callback.
+	// Process cache use in nested callbacks is not lag-safe with regard to HOLDOFF_TTL since
+	// the in-memory value is further lagged than the shared one since it uses a blind TTL.
+	var procCache *HashBagOStuff
+	if nested, _ := opts["nested"].(bool); pcTTL >= 0 && !nested {
+		group, ok := opts["pcGroup"].(string)
+		if !ok {
+			group = PC_PRIMARY
+		}
+		procCache = w.getProcessCache(group)
+		if value, ok := procCache.Get(key, 0); ok {
+			return value, true
+		}
+	}
+
+	var value interface{}
+	var ok bool
+	if version, versioned := opts["version"]; versioned && version != nil {
+		// Fetch the value over the network
+		asOf := 0.0
+		cur, curOk := w.doGetWithSetCallback(
+			key,
+			ttl,
+			func(oldValue interface{}, ttl *int, setOpts map[string]interface{}, oldAsOf float64) (interface{}, bool) {
+				var oldData interface{}
+				if old, ok := oldValue.(map[string]interface{}); ok {
+					oldData = old[VFLD_DATA]
+				} else {
+					// VFLD_DATA is not set if an old, unversioned, key is present
+					oldAsOf = 0
+				}
+				data, ok := callback(oldData, ttl, setOpts, oldAsOf)
+				if !ok {
+					return nil, false
+				}
+				return map[string]interface{}{VFLD_DATA: data, VFLD_VERSION: version}, true
+			},
+			opts,
+			&asOf,
+		)
+		if current, isMap := cur.(map[string]interface{}); curOk && isMap && current[VFLD_VERSION] == version {
+			// Value created or existed before with version; use it
+			value, ok = current[VFLD_DATA], true
+		} else if curOk {
+			// Value existed before with a different version; use variant key.
+			// Reflect purges to $key by requiring that this key value be newer.
+			variantOpts := make(map[string]interface{})
+			for name, opt := range opts {
+				variantOpts[name] = opt
+			}
+			variantOpts["version"] = nil
+			variantOpts["minAsOf"] = asOf
+			sum := md5.Sum([]byte(key))
+			value, ok = w.doGetWithSetCallback(
+				"cache-variant:"+hex.EncodeToString(sum[:])+":"+w.formatVersion(version),
+				ttl,
+				callback,
+				variantOpts,
+				nil,
+			)
+		}
+	} else {
+		value, ok = w.doGetWithSetCallback(key, ttl, callback, opts, nil)
+	}
+
+	// Update the process cache if enabled
+	if procCache != nil && ok {
+		procCache.Set(key, value, pcTTL, 0)
+	}
+
+	return value, ok
+}
+
+/**
+ * Do the actual I/O for getWithSetCallback() when needed
+ *
+ * @see WANObjectCache::getWithSetCallback()
+ *
+ * @param string $key
+ * @param int $ttl
+ * @param callback $callback
+ * @param array $opts Options map for getWithSetCallback()
+ * @param float &$asOf Cache generation timestamp of returned value [returned]
+ * @return mixed
+ */
+func (w *WANObjectCache) doGetWithSetCallback(key string, ttl int, callback WANCacheCallback,
+	opts map[string]interface{}, asOf *float64) (interface{}, bool) {
+	lowTTL := w.intOpt(opts, "lowTTL", w.minInt(LOW_TTL, ttl))
+	lockTSE := w.intOpt(opts, "lockTSE", TSE_NONE)
+	checkKeys, _ := opts["checkKeys"].([]string)
+	busyValue, checkBusy := opts["busyValue"]
+	checkBusy = checkBusy && busyValue != nil
+	popWindow := w.intOpt(opts, "hotTTR", HOT_TTR)
+	ageNew := w.intOpt(opts, "ageNew", AGE_NEW)
+	minTime := w.floatOpt(opts, "minAsOf", MIN_TIMESTAMP_NONE)
+	versioned := opts["version"] != nil
+	if asOf == nil {
+		asOf = new(float64)
+	}
+
+	// Get the current key value
+	cValue, curTTL, cAsOf, cOk := w.Get(key, checkKeys)
+	*asOf = cAsOf
+	value, ok := cValue, cOk // return value
+
+	preCallbackTime := w.getCurrentTime()
+	// Determine if a cached value regeneration is needed or desired
+	if ok &&
+		*curTTL > 0 &&
+		w.isValid(value, versioned, *asOf, minTime) &&
+		!w.worthRefreshExpiring(*curTTL, lowTTL) &&
+		!w.worthRefreshPopular(*asOf, ageNew, popWindow, preCallbackTime) {
+		return value, true
+	}
+
+	// A deleted key with a negative TTL left must be tombstoned
+	isTombstone := curTTL != nil && !ok
+	if isTombstone && lockTSE <= 0 {
+		// Use the INTERIM value for tombstoned keys to reduce regeneration load
+		lockTSE = INTERIM_KEY_TTL
+	}
+	// Assume a key is hot if requested soon after invalidation
+	isHot := curTTL != nil && *curTTL <= 0 && math.Abs(*curTTL) <= float64(lockTSE)
+	// Use the mutex if there is no value and a busy fallback is given
+	checkBusy = checkBusy && !ok
+	// Decide whether a single thread should handle regenerations.
+	// This avoids stampedes when $checkKeys are bumped and when preemptive
+	// renegerations take too long. It also reduces regenerations while $key
+	// is tombstoned. This balances cache freshness with avoiding DB load.
+	useMutex := isHot || (isTombstone && lockTSE > 0) || checkBusy
+
+	lockAcquired := false
+	if useMutex {
+		if w.cache.Add(MUTEX_KEY_PREFIX+key, 1, LOCK_TTL) {
+			// Lock acquired; this thread should update the key
+			lockAcquired = true
+		} else if ok && w.isValid(value, versioned, *asOf, minTime) {
+			// If it cannot be acquired; then the stale value can be used
+			return value, true
+		} else {
+			// Use the INTERIM value for tombstoned keys to reduce regeneration load.
+			// For hot keys, either another thread has the lock or the lock failed;
+			// use the INTERIM value from the last thread that regenerated it.
+			if value, ok := w.getInterimValue(key, versioned, minTime, asOf); ok {
+				return value, true
+			}
+			// Use the busy fallback value if nothing else
+			if checkBusy {
+				if busyCallback, isCallback := busyValue.(func() interface{}); isCallback {
+					return busyCallback(), true
+				}
+				return busyValue, true
+			}
+		}
+	}
+
+	if callback == nil {
+		panic(exception.NewMWException("Invalid cache miss callback provided."))
+	}
+
+	// Generate the new value from the callback...
+	setOpts := make(map[string]interface{})
+	value, ok = callback(cValue, &ttl, setOpts, *asOf)
+	valueIsCacheable := ok && ttl >= 0
+
+	// When delete() is called, writes are write-holed by the tombstone,
+	// so use a special INTERIM key to pass the new value around threads.
+	if isTombstone && lockTSE > 0 && valueIsCacheable {
+		tempTTL := w.maxInt(1, lockTSE) // set() expects seconds
+		newAsOf := w.getCurrentTime()
+		wrapped := w.wrap(value, tempTTL, newAsOf)
+		// Avoid using set() to avoid pointless mcrouter broadcasting
+		w.setInterimValue(key, wrapped, tempTTL)
+	}
+
+	if valueIsCacheable {
+		setOpts["lockTSE"] = lockTSE
+		// Use best known "since" timestamp if not provided
+		if _, ok := setOpts["since"]; !ok {
+			setOpts["since"] = preCallbackTime
+		}
+		// Update the cache; this will fail if the key is tombstoned
+		w.Set(key, value, ttl, setOpts)
+	}
+
+	if lockAcquired {
+		// Avoid using delete() to avoid pointless mcrouter broadcasting
+		w.cache.ChangeTTL(MUTEX_KEY_PREFIX+key, int(preCallbackTime)-60)
+	}
+
+	return value, ok
+}
+
+/**
+ * @param string $key
+ * @param bool $versioned
+ * @param float $minTime
+ * @param mixed $asOf
+ * @return mixed
+ */
+func (w *WANObjectCache) getInterimValue(key string, versioned bool, minTime float64, asOf *float64) (interface{}, bool) {
+	wrapped, _ := w.cache.Get(INTERIM_KEY_PREFIX+key, 0)
+	value, _, ok := w.unwrap(wrapped, w.getCurrentTime())
+	if ok && w.isValid(value, versioned, *asOf, minTime) {
+		*asOf = wrapped.(wanCacheValue).Time
+		return value, true
+	}
+	return nil, false
+}
+
+/**
+ * @param string $key
+ * @param array $wrapped
+ * @param int $tempTTL
+ */
+func (w *WANObjectCache) setInterimValue(key string, wrapped wanCacheValue, tempTTL int) {
+	w.cache.Merge(INTERIM_KEY_PREFIX+key, func(cache IBagOStuff, key string, value interface{}, exists bool) (interface{}, bool) {
+		return wrapped, true
+	}, tempTTL, 1, 0)
+}
+
+/**
+ * @see BagOStuff::makeKey()
+ * @param string $class Key class
+ * @param string $component [optional] Key component (starting with a key collection name)
+ * @return string
+ */
+func (w *WANObjectCache) MakeKey(components ...string) string {
+	return w.cache.MakeKey(components...)
+}
+
+/**
+ * @see BagOStuff::makeGlobalKey()
+ * @param string $class Key class
+ * @param string $component [optional] Key component (starting with a key collection name)
+ * @return string
+ */
+func (w *WANObjectCache) MakeGlobalKey(components ...string) string {
+	return w.cache.MakeGlobalKey(components...)
+}
+
+/**
+ * Get the "last error" registered; clearLastError() should be called manually
+ * @return int ERR_* class constant for the "last error" registry
+ */
+func (w *WANObjectCache) GetLastError() int {
+	w.mutex.Lock()
+	lastRelayError := w.lastRelayError
+	w.mutex.Unlock()
+	if lastRelayError != ERR_NONE {
+		return lastRelayError
+	}
+	return w.cache.GetLastError()
+}
+
+/**
+ * Clear the "last error" registry
+ */
+func (w *WANObjectCache) ClearLastError() {
+	w.cache.ClearLastError()
+	w.mutex.Lock()
+	w.lastRelayError = ERR_NONE
+	w.mutex.Unlock()
+}
+
+/**
+ * Clear the in-process caches; useful for testing
+ */
+func (w *WANObjectCache) ClearProcessCache() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.processCaches = make(map[string]*HashBagOStuff)
+}
+
+/**
+ * @param float|null &$time Mock UNIX timestamp for testing
+ * @codeCoverageIgnore
+ */
+func (w *WANObjectCache) SetMockTime(time *float64) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.wallClockOverride = time
+	if cache, ok := w.cache.(interface{ SetMockTime(*float64) }); ok {
+		cache.SetMockTime(time)
+	}
+	for _, procCache := range w.processCaches {
+		procCache.SetMockTime(time)
+	}
+}
+
+/**
+ * Do the actual async bus purge of a key
+ *
+ * This must set the key to "PURGED:<UNIX timestamp>:<holdoff>"
+ *
+ * @param string $key Cache key
+ * @param int $ttl How long to keep the tombstone [seconds]
+ * @param int $holdoff HOLDOFF_* constant controlling how long to ignore sets for this key
+ * @return bool Success
+ */
+func (w *WANObjectCache) relayPurge(key string, ttl, holdoff int) bool {
+	if _, ok := w.purgeRelayer.(*eventrelayer.EventRelayerNull); ok {
+		// This handles the mcrouter and the single-DC case
+		return w.cache.Set(key, w.makePurgeValue(w.getCurrentTime(), holdoff), ttl, 0)
+	}
+
+	event := map[string]interface{}{
+		"cmd": "set",
+		"key": key,
+		"val": PURGE_VAL_PREFIX + "$UNIXTIME$:" + strconv.Itoa(holdoff),
+		"ttl": w.maxInt(ttl, 1),
+		"sbt": true, // substitute $UNIXTIME$ with actual microtime
+	}
+
+	return w.notify(event)
+}
+
+/**
+ * Do the actual async bus delete of a key
+ *
+ * @param string $key Cache key
+ * @return bool Success
+ */
+func (w *WANObjectCache) relayDelete(key string) bool {
+	if _, ok := w.purgeRelayer.(*eventrelayer.EventRelayerNull); ok {
+		// Some other proxy handles broadcasting or there is only one datacenter
+		return w.cache.Delete(key, 0)
+	}
+
+	return w.notify(map[string]interface{}{"cmd": "delete", "key": key})
+}
+
+/**
+ * @param array $event
+ * @return bool Success
+ */
+func (w *WANObjectCache) notify(event map[string]interface{}) bool {
+	ok := w.purgeRelayer.Notify(w.purgeChannel, event)
+	if !ok {
+		w.mutex.Lock()
+		w.lastRelayError = ERR_RELAY
+		w.mutex.Unlock()
+	}
+	return ok
+}
+
+/**
+ * Check if a key should be regenerated (using random probability)
+ *
+ * This returns false if $curTTL >= $lowTTL. Otherwise, the chance
+ * of returning true increases steadily from 0% to 100% as the $curTTL
+ * moves from $lowTTL to 0 seconds. This handles widely varying
+ * levels of cache access traffic.
+ *
+ * @param float $curTTL Approximate TTL left on the key if present
+ * @param float $lowTTL Consider a refresh when $curTTL is less than this
+ * @return bool
+ */
+func (w *WANObjectCache) worthRefreshExpiring(curTTL float64, lowTTL int) bool {
+	if lowTTL <= 0 {
+		return false
+	} else if curTTL >= float64(lowTTL) {
+		return false
+	} else if curTTL <= 0 {
+		return true
+	}
+
+	chance := 1 - curTTL/float64(lowTTL)
+
+	return rand.Float64() < chance
+}
+
+/**
+ * Check if a key is fetched often enough to be worth refreshing, using
+ * a random chance that grows with the age of the key
+ *
+ * @param float $asOf UNIX timestamp of the value
+ * @param int $ageNew Age of key when this might recommend refreshing (seconds)
+ * @param int $timeTillRefresh Age of key when it should be refreshed if popular (seconds)
+ * @param float $now The current UNIX timestamp
+ * @return bool
+ */
+func (w *WANObjectCache) worthRefreshPopular(asOf float64, ageNew, timeTillRefresh int, now float64) bool {
+	age := now - asOf
+	timeOld := age - float64(ageNew)
+	if timeOld <= 0 {
+		return false
+	}
+
+	// Lifecycle is: new, ramp-up refresh chance, full refresh chance.
+	// Note that the "expected # of refreshes" for the ramp-up time range is half of what it
+	// would be if P(refresh) was at its full value during that time range.
+	refreshWindowSec := math.Max(float64(timeTillRefresh-ageNew)-RAMPUP_TTL/2, 1)
+	// P(refresh) * (# hits in $refreshWindowSec) = (expected # of refreshes)
+	// P(refresh) * ($refreshWindowSec * $popularHitsPerSec) = 1
+	// P(refresh) = 1/($refreshWindowSec * $popularHitsPerSec)
+	chance := 1 / (HIT_RATE_HIGH * refreshWindowSec)
+
+	// Ramp up $chance from 0 to its nominal value over RAMPUP_TTL seconds to avoid stampedes
+	if timeOld <= RAMPUP_TTL {
+		chance *= timeOld / RAMPUP_TTL
+	}
+
+	return rand.Float64() < chance
+}
+
+/**
+ * Check whether $value is appropriately versioned and not older than $minTime (if set)
+ *
+ * @param array $value
+ * @param bool $versioned
+ * @param float $asOf The time $value was generated
+ * @param float $minTime The last time the main value was generated (0.0 if unknown)
+ * @return bool
+ */
+func (w *WANObjectCache) isValid(value interface{}, versioned bool, asOf, minTime float64) bool {
+	if versioned {
+		if fields, ok := value.(map[string]interface{}); !ok || fields[VFLD_VERSION] == nil {
+			return false
+		}
+	}
+	if minTime > 0 && asOf < minTime {
+		return false
+	}
+	return true
+}
+
+/**
+ * Do not use this method outside WANObjectCache
+ *
+ * @param mixed $value
+ * @param int $ttl [0=forever]
+ * @param float $now Unix Current timestamp just before calling set()
+ * @return array
+ */
+func (w *WANObjectCache) wrap(value interface{}, ttl int, now float64) wanCacheValue {
+	return wanCacheValue{
+		Version: VERSION,
+		Value:   value,
+		TTL:     ttl,
+		Time:    now,
+	}
+}
+
+/**
+ * Do not use this method outside WANObjectCache
+ *
+ * @param array|string|bool $wrapped
+ * @param float $now Unix Current timestamp (preferrably pre-query)
+ * @return array (mixed; false if absent/tombstoned/invalid, current time left)
+ */
+func (w *WANObjectCache) unwrap(wrapped interface{}, now float64) (interface{}, *float64, bool) {
+	// Check if the value is a tombstone
+	if purge, ok := w.parsePurgeValue(wrapped); ok {
+		// Purged values should always have a negative current $ttl
+		curTTL := math.Min(purge.time-now, TINY_NEGATIVE)
+		return nil, &curTTL, false
+	}
+
+	value, ok := wrapped.(wanCacheValue)
+	if !ok || value.Version != VERSION {
+		return nil, nil, false // not found, wrong format or wrong version
+	}
+
+	var curTTL float64
+	if value.Flags&FLG_STALE == FLG_STALE {
+		// Treat as expired, with the cache time as the expiration
+		age := now - value.Time
+		curTTL = math.Min(-age, TINY_NEGATIVE)
+	} else if value.TTL > 0 {
+		// Get the approximate time left on the key
+		age := now - value.Time
+		curTTL = math.Max(float64(value.TTL)-age, 0.0)
+	} else {
+		// Key had no TTL, so the time left is unbounded
+		curTTL = math.Inf(1)
+	}
+
+	return value.Value, &curTTL, true
+}
+
+/**
+ * @param array $keys
+ * @param string $prefix
+ * @return string[]
+ */
+func (w *WANObjectCache) prefixCacheKeys(keys []string, prefix string) []string {
+	res := make([]string, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, prefix+key)
+	}
+	return res
+}
+
+/**
+ * @param string $value Wrapped value like "PURGED:<timestamp>:<holdoff>"
+ * @return array|bool Array containing a UNIX timestamp (float) and holdoff period (integer),
+ *  or false if value isn't a valid purge value
+ */
+func (w *WANObjectCache) parsePurgeValue(value interface{}) (wanPurge, bool) {
+	str, ok := value.(string)
+	if !ok {
+		return wanPurge{}, false
+	}
+	segments := strings.SplitN(str, ":", 3)
+	if len(segments) < 2 || segments[0]+":" != PURGE_VAL_PREFIX {
+		return wanPurge{}, false
+	}
+	purgeTime, err := strconv.ParseFloat(segments[1], 64)
+	if err != nil {
+		return wanPurge{}, false
+	}
+	purge := wanPurge{time: purgeTime, holdoff: HOLDOFF_TTL}
+	if len(segments) == 3 {
+		// Back-compat with old purge values without holdoff
+		if holdoff, err := strconv.Atoi(segments[2]); err == nil {
+			purge.holdoff = holdoff
+		}
+	}
+	return purge, true
+}
+
+/**
+ * @param float $timestamp
+ * @param int $holdoff In seconds
+ * @return string Wrapped purge value
+ */
+func (w *WANObjectCache) makePurgeValue(timestamp float64, holdoff int) string {
+	return PURGE_VAL_PREFIX + strconv.FormatFloat(timestamp, 'f', -1, 64) + ":" + strconv.Itoa(holdoff)
+}
+
+/**
+ * @param string $group
+ * @return HashBagOStuff
+ */
+func (w *WANObjectCache) getProcessCache(group string) *HashBagOStuff {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if procCache, ok := w.processCaches[group]; ok {
+		return procCache
+	}
+	maxKeys := 1000
+	if parts := strings.SplitN(group, ":", 2); len(parts) == 2 {
+		if n, err := strconv.Atoi(parts[1]); err == nil && n > 0 {
+			maxKeys = n
+		}
+	}
+	procCache := NewHashBagOStuff(map[string]interface{}{"maxKeys": maxKeys})
+	procCache.SetMockTime(w.wallClockOverride)
+	w.processCaches[group] = procCache
+	return procCache
+}
+
+/**
+ * @return float UNIX timestamp
+ * @codeCoverageIgnore
+ */
+func (w *WANObjectCache) getCurrentTime() float64 {
+	if w.wallClockOverride != nil {
+		return *w.wallClockOverride
+	}
+	return float64(time.Now().UnixNano()) / 1e9
+}
+
+/**
+ * @param mixed $version Integer version number
+ * @return string
+ */
+func (w *WANObjectCache) formatVersion(version interface{}) string {
+	if n, ok := version.(int); ok {
+		return strconv.Itoa(n)
+	}
+	panic(exception.NewMWException("The 'version' option must be an integer"))
+}
+
+/**
+ * @param array $opts
+ * @param string $name
+ * @param int $default
+ * @return int
+ */
+func (w *WANObjectCache) intOpt(opts map[string]interface{}, name string, defaultValue int) int {
+	switch value := opts[name].(type) {
+	case int:
+		return value
+	case float64:
+		return int(value)
+	}
+	return defaultValue
+}
+
+/**
+ * @param array $opts
+ * @param string $name
+ * @param float $default
+ * @return float
+ */
+func (w *WANObjectCache) floatOpt(opts map[string]interface{}, name string, defaultValue float64) float64 {
+	switch value := opts[name].(type) {
+	case int:
+		return float64(value)
+	case float64:
+		return value
+	}
+	return defaultValue
+}
+
+func (w *WANObjectCache) minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func (w *WANObjectCache) maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
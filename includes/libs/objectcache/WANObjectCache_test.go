@@ -0,0 +1,405 @@
+package objectcache
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/includes/libs/eventrelayer"
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * Relayer applying the purge events to the caches of every datacenter
+ */
+type memoryEventRelayer struct {
+	caches []IBagOStuff
+	now    *float64
+	events []map[string]interface{}
+	/** @var bool Whether to refuse events */
+	broken bool
+}
+
+func (m *memoryEventRelayer) Notify(channel string, event map[string]interface{}) bool {
+	return m.NotifyMulti(channel, []map[string]interface{}{event})
+}
+
+func (m *memoryEventRelayer) NotifyMulti(channel string, events []map[string]interface{}) bool {
+	if m.broken {
+		return false
+	}
+	for _, event := range events {
+		m.events = append(m.events, event)
+		key := event["key"].(string)
+		for _, cache := range m.caches {
+			switch event["cmd"] {
+			case "set":
+				value := event["val"].(string)
+				if event["sbt"] == true {
+					value = strings.Replace(value, "$UNIXTIME$", strconv.FormatFloat(*m.now, 'f', -1, 64), 1)
+				}
+				cache.Set(key, value, event["ttl"].(int), 0)
+			case "delete":
+				cache.Delete(key, 0)
+			}
+		}
+	}
+	return true
+}
+
+/**
+ * @param float &$now Mock time
+ * @return WANObjectCache with its underlying cache
+ */
+func setUpWANObjectCache(now *float64) (*WANObjectCache, *HashBagOStuff) {
+	bag := NewHashBagOStuff(nil)
+	cache := NewWANObjectCache(map[string]interface{}{"cache": bag})
+	cache.SetMockTime(now)
+	return cache, bag
+}
+
+/**
+ * @covers WANObjectCache::set
+ * @covers WANObjectCache::get
+ * @covers WANObjectCache::makeKey
+ */
+func TestWANSetAndGet(t *testing.T) {
+	now := 1500000000.0
+	cache, _ := setUpWANObjectCache(&now)
+	key := cache.MakeKey("x", "y")
+
+	_, curTTL, _, ok := cache.Get(key, nil)
+	test.AssertEqual(t, false, ok, `Missing key`)
+	test.AssertTrue(t, curTTL == nil, `No TTL for a missing key`)
+
+	cache.Set(key, "value", 30, nil)
+	now += 10
+	value, curTTL, asOf, _ := cache.Get(key, nil)
+	test.AssertEqual(t, "value", value, `Value was set`)
+	test.AssertEqual(t, 20.0, *curTTL, `Remaining TTL`)
+	test.AssertEqual(t, 1500000000.0, asOf, `As-of timestamp`)
+
+	cache.Set(key, "forever", TTL_INDEFINITE, nil)
+	_, curTTL, _, _ = cache.Get(key, nil)
+	test.AssertEqual(t, true, *curTTL > TTL_YEAR, `Key without TTL has unbounded time left`)
+
+	values, curTTLs, _ := cache.GetMulti([]string{key, "missing"}, nil)
+	test.AssertEqual(t, 1, len(values), `GetMulti only returns existing keys`)
+	_, ok = curTTLs["missing"]
+	test.AssertEqual(t, false, ok, `No TTL for a missing key in GetMulti`)
+}
+
+/**
+ * @covers WANObjectCache::set
+ */
+func TestWANSetLag(t *testing.T) {
+	now := 1500000000.0
+	cache, _ := setUpWANObjectCache(&now)
+
+	cache.Set("pending", 1, 30, map[string]interface{}{"pending": true})
+	_, _, _, ok := cache.Get("pending", nil)
+	test.AssertEqual(t, false, ok, `Uncommitted data is not cached`)
+
+	cache.Set("old", 1, 30, map[string]interface{}{"since": now - 10})
+	_, _, _, ok = cache.Get("old", nil)
+	test.AssertEqual(t, false, ok, `Data from old snapshots is not cached`)
+
+	cache.Set("lagged", 1, 300, map[string]interface{}{"lag": 8})
+	_, curTTL, _, _ := cache.Get("lagged", nil)
+	test.AssertEqual(t, float64(TTL_LAGGED), *curTTL, `TTL lowered for lagged data`)
+
+	cache.Set("unknown-lag", 1, 300, map[string]interface{}{"lag": false})
+	_, curTTL, _, _ = cache.Get("unknown-lag", nil)
+	test.AssertEqual(t, float64(TTL_LAGGED), *curTTL, `TTL lowered without replication`)
+
+	cache.Set("medium-lag", 1, 300, map[string]interface{}{"lag": 4, "since": now - 4})
+	_, _, _, ok = cache.Get("medium-lag", nil)
+	test.AssertEqual(t, false, ok, `Medium lag in a medium request is not cached`)
+
+	cache.Set("stale", 1, 300, map[string]interface{}{"lag": 8, "lockTSE": 5})
+	value, curTTL, _, _ := cache.Get("stale", nil)
+	test.AssertEqual(t, 1, value, `Lagged data is kept with lockTSE`)
+	test.AssertTrue(t, *curTTL < 0, `Lagged data is stale with lockTSE`)
+}
+
+/**
+ * @covers WANObjectCache::getWithSetCallback
+ * @covers WANObjectCache::doGetWithSetCallback
+ */
+func TestWANGetWithSetCallback(t *testing.T) {
+	now := 1500000000.0
+	cache, _ := setUpWANObjectCache(&now)
+	calls := 0
+	callback := func(oldValue interface{}, ttl *int, setOpts map[string]interface{}, oldAsOf float64) (interface{}, bool) {
+		calls++
+		return "value-" + strconv.Itoa(calls), true
+	}
+	opts := map[string]interface{}{"lowTTL": 0}
+
+	value, _ := cache.GetWithSetCallback("key", 30, callback, opts)
+	test.AssertEqual(t, "value-1", value, `Value computed on miss`)
+	value, _ = cache.GetWithSetCallback("key", 30, callback, opts)
+	test.AssertEqual(t, "value-1", value, `Value cached`)
+	test.AssertEqual(t, 1, calls, `Callback ran once`)
+
+	now += 31
+	value, _ = cache.GetWithSetCallback("key", 30, callback, opts)
+	test.AssertEqual(t, "value-2", value, `Value recomputed after expiry`)
+
+	var oldValue interface{}
+	cache.Delete("key", 0)
+	cache.GetWithSetCallback("key", 30, func(old interface{}, ttl *int, setOpts map[string]interface{}, oldAsOf float64) (interface{}, bool) {
+		oldValue = old
+		*ttl = TTL_UNCACHEABLE
+		return "uncached", true
+	}, opts)
+	test.AssertEqual(t, nil, oldValue, `No old value after delete`)
+	_, _, _, ok := cache.Get("key", nil)
+	test.AssertEqual(t, false, ok, `TTL_UNCACHEABLE values are not cached`)
+
+	_, ok = cache.GetWithSetCallback("key", 30, func(interface{}, *int, map[string]interface{}, float64) (interface{}, bool) {
+		return nil, false
+	}, opts)
+	test.AssertEqual(t, false, ok, `Callback may decline to give a value`)
+}
+
+/**
+ * @covers WANObjectCache::getWithSetCallback
+ * @covers WANObjectCache::getProcessCache
+ */
+func TestWANProcessCache(t *testing.T) {
+	now := 1500000000.0
+	cache, bag := setUpWANObjectCache(&now)
+	calls := 0
+	callback := func(interface{}, *int, map[string]interface{}, float64) (interface{}, bool) {
+		calls++
+		return calls, true
+	}
+	opts := map[string]interface{}{"pcTTL": 5, "lowTTL": 0}
+
+	cache.GetWithSetCallback("key", 30, callback, opts)
+	bag.Clear()
+	value, _ := cache.GetWithSetCallback("key", 30, callback, opts)
+	test.AssertEqual(t, 1, value, `Value served from the process cache`)
+
+	now += 6
+	value, _ = cache.GetWithSetCallback("key", 30, callback, opts)
+	test.AssertEqual(t, 2, value, `Process cache entry expired`)
+
+	cache.ClearProcessCache()
+	bag.Clear()
+	value, _ = cache.GetWithSetCallback("key", 30, callback, opts)
+	test.AssertEqual(t, 3, value, `Process cache cleared`)
+
+	// Only the calls which say they are nested skip the process cache
+	nestedOpts := map[string]interface{}{"pcTTL": 5, "lowTTL": 0, "nested": true}
+	cache.GetWithSetCallback("outer", 30, func(interface{}, *int, map[string]interface{}, float64) (interface{}, bool) {
+		bag.Clear()
+		value, _ = cache.GetWithSetCallback("key", 30, callback, opts)
+		test.AssertEqual(t, 3, value, `Process cache used while a callback runs`)
+		value, _ = cache.GetWithSetCallback("key", 30, callback, nestedOpts)
+		test.AssertEqual(t, 4, value, `Nested call skips the process cache`)
+		return "outer", true
+	}, nil)
+}
+
+/**
+ * @covers WANObjectCache::delete
+ * @covers WANObjectCache::relayPurge
+ */
+func TestWANDeleteTombstone(t *testing.T) {
+	now := 1500000000.0
+	cache, bag := setUpWANObjectCache(&now)
+
+	cache.Set("key", "value", 300, nil)
+	cache.Delete("key", HOLDOFF_TTL)
+	now += 1
+	_, curTTL, _, ok := cache.Get("key", nil)
+	test.AssertEqual(t, false, ok, `Key is tombstoned`)
+	test.AssertEqual(t, -1.0, *curTTL, `Tombstone TTL reflects the time since delete()`)
+
+	cache.Set("key", "stale", 300, nil)
+	_, _, _, ok = cache.Get("key", nil)
+	test.AssertEqual(t, false, ok, `Set() is ignored during the hold-off`)
+
+	calls := 0
+	callback := func(interface{}, *int, map[string]interface{}, float64) (interface{}, bool) {
+		calls++
+		return "fresh", true
+	}
+	value, _ := cache.GetWithSetCallback("key", 300, callback, nil)
+	test.AssertEqual(t, "fresh", value, `Value computed while tombstoned`)
+	// Another thread is regenerating the value
+	bag.Add(MUTEX_KEY_PREFIX+"key", 1, LOCK_TTL)
+	value, _ = cache.GetWithSetCallback("key", 300, callback, nil)
+	test.AssertEqual(t, "fresh", value, `Interim value used while tombstoned`)
+	test.AssertEqual(t, 1, calls, `Callback ran once during the hold-off`)
+
+	now += HOLDOFF_TTL
+	cache.Set("key", "value", 300, nil)
+	value, _, _, _ = cache.Get("key", nil)
+	test.AssertEqual(t, "value", value, `Set() works after the hold-off`)
+
+	cache.Delete("key", 0)
+	_, curTTL, _, _ = cache.Get("key", nil)
+	test.AssertTrue(t, curTTL == nil, `Delete without hold-off leaves no tombstone`)
+}
+
+/**
+ * @covers WANObjectCache::touchCheckKey
+ * @covers WANObjectCache::resetCheckKey
+ * @covers WANObjectCache::getCheckKeyTime
+ */
+func TestWANCheckKeys(t *testing.T) {
+	now := 1500000000.0
+	cache, _ := setUpWANObjectCache(&now)
+	checkKeys := []string{"check"}
+
+	test.AssertEqual(t, now, cache.GetCheckKeyTime("check"), `Check key initialized`)
+	now += HOLDOFF_TTL + 1
+	test.AssertEqual(t, now-HOLDOFF_TTL-1, cache.GetCheckKeyTime("check"), `Check key time kept`)
+
+	cache.Set("key", "value", 300, nil)
+	_, curTTL, _, ok := cache.Get("key", checkKeys)
+	test.AssertEqual(t, true, ok && *curTTL > 0, `Value is newer than the check key`)
+
+	now += 1
+	cache.TouchCheckKey("check", HOLDOFF_TTL)
+	test.AssertEqual(t, now, cache.GetCheckKeyTime("check"), `Check key touched`)
+	now += 1
+	value, curTTL, _, _ := cache.Get("key", checkKeys)
+	test.AssertEqual(t, "value", value, `Value still returned`)
+	test.AssertEqual(t, -1.0, *curTTL, `Value expired by the check key`)
+
+	calls := 0
+	callback := func(interface{}, *int, map[string]interface{}, float64) (interface{}, bool) {
+		calls++
+		return "regenerated", true
+	}
+	opts := map[string]interface{}{"checkKeys": checkKeys, "lowTTL": 0}
+	value, _ = cache.GetWithSetCallback("key", 300, callback, opts)
+	test.AssertEqual(t, "regenerated", value, `Value regenerated after touchCheckKey()`)
+
+	now += HOLDOFF_TTL + 1
+	cache.Set("key", "value", 300, nil)
+	now += 1
+	cache.ResetCheckKey("check")
+	_, curTTL, _, _ = cache.Get("key", checkKeys)
+	test.AssertTrue(t, *curTTL < 0, `Value expired by resetCheckKey()`)
+}
+
+/**
+ * @covers WANObjectCache::doGetWithSetCallback
+ */
+func TestWANLockTSEAndBusyValue(t *testing.T) {
+	now := 1500000000.0
+	cache, bag := setUpWANObjectCache(&now)
+	checkKeys := []string{"check"}
+	cache.GetCheckKeyTime("check")
+	now += HOLDOFF_TTL + 1
+	cache.Set("key", "old", 300, nil)
+	now += 1
+
+	calls := 0
+	callback := func(interface{}, *int, map[string]interface{}, float64) (interface{}, bool) {
+		calls++
+		return "new", true
+	}
+
+	// Another thread is regenerating the value
+	bag.Add(MUTEX_KEY_PREFIX+"key", 1, LOCK_TTL)
+	cache.TouchCheckKey("check", HOLDOFF_NONE)
+	now += 1
+	opts := map[string]interface{}{"checkKeys": checkKeys, "lockTSE": 30, "lowTTL": 0}
+	value, _ := cache.GetWithSetCallback("key", 300, callback, opts)
+	test.AssertEqual(t, "old", value, `Stale value used while another thread regenerates`)
+	test.AssertEqual(t, 0, calls, `Callback not run without the mutex`)
+
+	bag.Delete(MUTEX_KEY_PREFIX+"key", 0)
+	value, _ = cache.GetWithSetCallback("key", 300, callback, opts)
+	test.AssertEqual(t, "new", value, `Value regenerated with the mutex`)
+	_, ok := bag.Get(MUTEX_KEY_PREFIX+"key", 0)
+	test.AssertEqual(t, false, ok, `Mutex released`)
+
+	bag.Add(MUTEX_KEY_PREFIX+"busy", 1, LOCK_TTL)
+	value, _ = cache.GetWithSetCallback("busy", 300, callback, map[string]interface{}{"busyValue": "busy"})
+	test.AssertEqual(t, "busy", value, `Busy value used while another thread regenerates`)
+	value, _ = cache.GetWithSetCallback("busy", 300, callback, map[string]interface{}{
+		"busyValue": func() interface{} { return "busy callback" },
+	})
+	test.AssertEqual(t, "busy callback", value, `Busy value callback`)
+	test.AssertEqual(t, 1, calls, `Callback only ran with the mutex`)
+}
+
+/**
+ * @covers WANObjectCache::getWithSetCallback
+ * @covers WANObjectCache::isValid
+ */
+func TestWANVersionAndMinAsOf(t *testing.T) {
+	now := 1500000000.0
+	cache, _ := setUpWANObjectCache(&now)
+	value := func(text string) WANCacheCallback {
+		return func(interface{}, *int, map[string]interface{}, float64) (interface{}, bool) {
+			return text, true
+		}
+	}
+	opts := func(version int) map[string]interface{} {
+		return map[string]interface{}{"version": version, "lowTTL": 0}
+	}
+
+	result, _ := cache.GetWithSetCallback("key", 300, value("v1"), opts(1))
+	test.AssertEqual(t, "v1", result, `Versioned value computed`)
+	result, _ = cache.GetWithSetCallback("key", 300, value("other"), opts(1))
+	test.AssertEqual(t, "v1", result, `Versioned value cached`)
+	result, _ = cache.GetWithSetCallback("key", 300, value("v2"), opts(2))
+	test.AssertEqual(t, "v2", result, `New version computed in a variant key`)
+	result, _ = cache.GetWithSetCallback("key", 300, value("other"), opts(2))
+	test.AssertEqual(t, "v2", result, `New version cached`)
+	result, _ = cache.GetWithSetCallback("key", 300, value("other"), opts(1))
+	test.AssertEqual(t, "v1", result, `Old version kept`)
+
+	now += 1
+	result, _ = cache.GetWithSetCallback("key", 300, value("newer"), map[string]interface{}{
+		"version": 1,
+		"lowTTL":  0,
+		"minAsOf": now,
+	})
+	test.AssertEqual(t, "newer", result, `Value older than minAsOf regenerated`)
+}
+
+/**
+ * @covers WANObjectCache::relayPurge
+ * @covers WANObjectCache::relayDelete
+ */
+func TestWANRelayer(t *testing.T) {
+	now := 1500000000.0
+	local := NewHashBagOStuff(nil)
+	remote := NewHashBagOStuff(nil)
+	relayer := &memoryEventRelayer{caches: []IBagOStuff{local, remote}, now: &now}
+	cache := NewWANObjectCache(map[string]interface{}{
+		"cache":    local,
+		"channels": map[string]string{"purge": "test-purge"},
+		"relayers": map[string]eventrelayer.IEventRelayer{"purge": relayer},
+	})
+	cache.SetMockTime(&now)
+	remoteCache := NewWANObjectCache(map[string]interface{}{"cache": remote})
+	remoteCache.SetMockTime(&now)
+
+	remoteCache.Set("key", "value", 300, nil)
+	cache.Delete("key", HOLDOFF_TTL)
+	test.AssertEqual(t, 1, len(relayer.events), `Purge relayed`)
+	_, curTTL, _, ok := remoteCache.Get("key", nil)
+	test.AssertEqual(t, false, ok, `Purge applied in the remote datacenter`)
+	test.AssertTrue(t, curTTL != nil && *curTTL < 0, `Remote key is tombstoned`)
+
+	cache.TouchCheckKey("check", HOLDOFF_TTL)
+	test.AssertEqual(t, now, remoteCache.GetCheckKeyTime("check"), `Check key touched remotely`)
+	cache.ResetCheckKey("check")
+	test.AssertEqual(t, "delete", relayer.events[2]["cmd"], `Reset relayed as delete`)
+
+	relayer.broken = true
+	test.AssertEqual(t, false, cache.Delete("key", HOLDOFF_TTL), `Relay failure`)
+	test.AssertEqual(t, ERR_RELAY, cache.GetLastError(), `Relay failure recorded`)
+	cache.ClearLastError()
+	test.AssertEqual(t, ERR_NONE, cache.GetLastError(), `Last error cleared`)
+}
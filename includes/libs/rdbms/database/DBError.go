@@ -0,0 +1,40 @@
+package database
+
+import "errors"
+
+/**
+ * Database error base class
+ * @ingroup Database
+ */
+type DBError struct {
+	error
+}
+
+/**
+ * @param string $error Text of the error
+ */
+func NewDBError(error string) *DBError {
+	this := new(DBError)
+	this.error = errors.New(error)
+	return this
+}
+
+/**
+ * Error thrown when the server cannot be connected to
+ * @ingroup Database
+ */
+type DBConnectionError struct {
+	*DBError
+}
+
+/**
+ * @param string $error Text of the error
+ */
+func NewDBConnectionError(error string) *DBConnectionError {
+	this := new(DBConnectionError)
+	if error == "" {
+		error = "Cannot access the database"
+	}
+	this.DBError = NewDBError(error)
+	return this
+}
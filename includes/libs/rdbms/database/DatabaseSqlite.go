@@ -0,0 +1,406 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+/**
+ * This is the SQLite database abstraction layer.
+ *
+ * Queries go through database/sql, so the binary must link a driver
+ * registering itself under the name given by the "driver" parameter,
+ * e.g. github.com/mattn/go-sqlite3 for "sqlite3".
+ *
+ * @ingroup Database
+ */
+type DatabaseSqlite struct {
+	/** @var DB */
+	conn *sql.DB
+	/** @var string File path for SQLite database file, or ":memory:" */
+	dbPath string
+}
+
+/**
+ * Additional params include:
+ *   - dbFilePath : File path for SQLite database file, ":memory:" by default,
+ *                  which keeps the database in memory for the lifetime of the handle
+ *   - driver     : Name of the database/sql driver, "sqlite3" by default
+ *
+ * @param array $params
+ * @throws DBConnectionError If the driver is not linked in
+ */
+func NewDatabaseSqlite(params map[string]interface{}) (*DatabaseSqlite, error) {
+	this := new(DatabaseSqlite)
+	this.dbPath = ":memory:"
+	if dbPath, ok := params["dbFilePath"].(string); ok && dbPath != "" {
+		this.dbPath = dbPath
+	}
+	driver := "sqlite3"
+	if name, ok := params["driver"].(string); ok && name != "" {
+		driver = name
+	}
+
+	conn, err := sql.Open(driver, this.dbPath)
+	if err != nil {
+		return nil, NewDBConnectionError(fmt.Sprintf("Cannot open SQLite database %s: %s", this.dbPath, err))
+	}
+	// Every connection to ":memory:" gets its own database, so keep only one
+	conn.SetMaxOpenConns(1)
+	this.conn = conn
+	return this, nil
+}
+
+/**
+ * @return string
+ */
+func (d *DatabaseSqlite) GetType() string {
+	return "sqlite"
+}
+
+/**
+ * @return string Wikitext of a link to the server software's web site
+ */
+func (d *DatabaseSqlite) GetSoftwareLink() string {
+	return "[https://www.sqlite.org/ SQLite]"
+}
+
+/**
+ * @return string Version information from the database
+ */
+func (d *DatabaseSqlite) GetServerVersion() string {
+	var version string
+	if err := d.conn.QueryRow("SELECT sqlite_version()").Scan(&version); err != nil {
+		return ""
+	}
+	return version
+}
+
+/**
+ * Run an SQL query, e.g. to create the tables
+ *
+ * @param string $sql SQL query
+ * @param string $fname Name of the calling function
+ * @throws DBError
+ */
+func (d *DatabaseSqlite) Query(sql string, fname string) error {
+	_, err := d.exec(sql, nil, fname)
+	return err
+}
+
+func (d *DatabaseSqlite) Select(table string, vars []string, conds map[string]interface{}, fname string,
+	options map[string]interface{}) ([]map[string]string, error) {
+	query, args, err := d.selectSQLText(table, vars, conds, options)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := d.conn.Query(query, args...)
+	if err != nil {
+		return nil, d.queryError(err, query, fname)
+	}
+	defer rows.Close()
+
+	fields, err := rows.Columns()
+	if err != nil {
+		return nil, d.queryError(err, query, fname)
+	}
+	var res []map[string]string
+	for rows.Next() {
+		values := make([]sql.NullString, len(fields))
+		dest := make([]interface{}, len(fields))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, d.queryError(err, query, fname)
+		}
+		row := make(map[string]string, len(fields))
+		for i, field := range fields {
+			row[field] = values[i].String
+		}
+		res = append(res, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, d.queryError(err, query, fname)
+	}
+	return res, nil
+}
+
+func (d *DatabaseSqlite) SelectRow(table string, vars []string, conds map[string]interface{}, fname string,
+	options map[string]interface{}) (map[string]string, error) {
+	opts := map[string]interface{}{"LIMIT": 1}
+	for name, value := range options {
+		if name != "LIMIT" {
+			opts[name] = value
+		}
+	}
+	rows, err := d.Select(table, vars, conds, fname, opts)
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	return rows[0], nil
+}
+
+func (d *DatabaseSqlite) Insert(table string, rows []map[string]interface{}, fname string,
+	options map[string]interface{}) (int, error) {
+	verb := "INSERT"
+	if options["IGNORE"] == true {
+		verb = "INSERT OR IGNORE"
+	}
+	return d.insertRows(verb, table, rows, fname)
+}
+
+func (d *DatabaseSqlite) Update(table string, values map[string]interface{}, conds map[string]interface{},
+	fname string) (int, error) {
+	query, args, err := d.updateSQLText(table, values, conds)
+	if err != nil {
+		return 0, err
+	}
+	return d.exec(query, args, fname)
+}
+
+/**
+ * SQLite replaces the rows conflicting on any of the unique indexes of the
+ * table by itself, so $uniqueIndexes is only there for the interface.
+ */
+func (d *DatabaseSqlite) Replace(table string, uniqueIndexes []string, rows []map[string]interface{},
+	fname string) (int, error) {
+	return d.insertRows("REPLACE", table, rows, fname)
+}
+
+func (d *DatabaseSqlite) Delete(table string, conds map[string]interface{}, fname string) (int, error) {
+	query, args, err := d.deleteSQLText(table, conds)
+	if err != nil {
+		return 0, err
+	}
+	return d.exec(query, args, fname)
+}
+
+/**
+ * SQLite has no timestamp type, MediaWiki timestamps (TS_MW) are used
+ */
+func (d *DatabaseSqlite) Timestamp(ts int) string {
+	return time.Unix(int64(ts), 0).UTC().Format("20060102150405")
+}
+
+/**
+ * Closes the database connection
+ *
+ * @throws DBError
+ */
+func (d *DatabaseSqlite) Close() error {
+	if err := d.conn.Close(); err != nil {
+		return NewDBError(err.Error())
+	}
+	return nil
+}
+
+/**
+ * @param string $verb "INSERT", "INSERT OR IGNORE" or "REPLACE"
+ * @param string $table
+ * @param array $rows
+ * @param string $fname
+ * @return int Number of rows inserted or replaced
+ * @throws DBError
+ */
+func (d *DatabaseSqlite) insertRows(verb string, table string, rows []map[string]interface{}, fname string) (int, error) {
+	affectedRows := 0
+	for _, row := range rows {
+		query, args := d.insertSQLText(verb, table, row)
+		affected, err := d.exec(query, args, fname)
+		if err != nil {
+			return affectedRows, err
+		}
+		affectedRows += affected
+	}
+	return affectedRows, nil
+}
+
+/**
+ * @param string $query
+ * @param array $args Values of the placeholders
+ * @param string $fname
+ * @return int Number of rows affected by the query, which is its own even
+ *  when other queries run concurrently
+ * @throws DBError
+ */
+func (d *DatabaseSqlite) exec(query string, args []interface{}, fname string) (int, error) {
+	result, err := d.conn.Exec(query, args...)
+	if err != nil {
+		return 0, d.queryError(err, query, fname)
+	}
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return 0, nil
+	}
+	return int(affectedRows), nil
+}
+
+/**
+ * @param error $error Error of the driver
+ * @param string $sql
+ * @param string $fname
+ * @return DBError
+ */
+func (d *DatabaseSqlite) queryError(error error, sql string, fname string) *DBError {
+	return NewDBError(fmt.Sprintf("Error: %s\nFunction: %s\nQuery: %s", error, fname, sql))
+}
+
+/**
+ * @return string SQL text of the query
+ * @return array Values of the placeholders
+ * @throws DBError
+ */
+func (d *DatabaseSqlite) selectSQLText(table string, vars []string, conds map[string]interface{},
+	options map[string]interface{}) (string, []interface{}, error) {
+	fields := "*"
+	if len(vars) > 0 {
+		quoted := make([]string, len(vars))
+		for i, field := range vars {
+			quoted[i] = d.addIdentifierQuotes(field)
+		}
+		fields = strings.Join(quoted, ", ")
+	}
+	where, args, err := d.makeWhere(conds)
+	if err != nil {
+		return "", nil, err
+	}
+	sql := "SELECT " + fields + " FROM " + d.addIdentifierQuotes(table) + where
+	if orderBy, ok := options["ORDER BY"].(string); ok && orderBy != "" {
+		sql += " ORDER BY " + d.addIdentifierQuotes(orderBy)
+	}
+	if limit, ok := options["LIMIT"].(int); ok {
+		sql += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	// SQLite locks the whole database on writes, FOR UPDATE is not needed
+	return sql, args, nil
+}
+
+/**
+ * @return string SQL text of the query
+ * @return array Values of the placeholders
+ */
+func (d *DatabaseSqlite) insertSQLText(verb string, table string, row map[string]interface{}) (string, []interface{}) {
+	fields := sortedFields(row)
+	quoted := make([]string, len(fields))
+	placeholders := make([]string, len(fields))
+	args := make([]interface{}, len(fields))
+	for i, field := range fields {
+		quoted[i] = d.addIdentifierQuotes(field)
+		placeholders[i] = "?"
+		args[i] = row[field]
+	}
+	return fmt.Sprintf("%s INTO %s (%s) VALUES (%s)", verb, d.addIdentifierQuotes(table),
+		strings.Join(quoted, ", "), strings.Join(placeholders, ", ")), args
+}
+
+/**
+ * @return string SQL text of the query
+ * @return array Values of the placeholders
+ * @throws DBError
+ */
+func (d *DatabaseSqlite) updateSQLText(table string, values map[string]interface{},
+	conds map[string]interface{}) (string, []interface{}, error) {
+	fields := sortedFields(values)
+	set := make([]string, len(fields))
+	args := make([]interface{}, 0, len(fields))
+	for i, field := range fields {
+		set[i] = d.addIdentifierQuotes(field) + " = ?"
+		args = append(args, values[field])
+	}
+	where, whereArgs, err := d.makeWhere(conds)
+	if err != nil {
+		return "", nil, err
+	}
+	return "UPDATE " + d.addIdentifierQuotes(table) + " SET " + strings.Join(set, ", ") + where,
+		append(args, whereArgs...), nil
+}
+
+/**
+ * @return string SQL text of the query
+ * @return array Values of the placeholders
+ * @throws DBError
+ */
+func (d *DatabaseSqlite) deleteSQLText(table string, conds map[string]interface{}) (string, []interface{}, error) {
+	if len(conds) == 0 {
+		return "", nil, NewDBError("Database::delete() called with no conditions")
+	}
+	sql := "DELETE FROM " + d.addIdentifierQuotes(table)
+	if _, all := conds["*"]; all {
+		return sql, nil, nil
+	}
+	where, args, err := d.makeWhere(conds)
+	if err != nil {
+		return "", nil, err
+	}
+	return sql + where, args, nil
+}
+
+/**
+ * Makes the WHERE clause of the conditions, ANDed in field order
+ *
+ * @param array $conds Map of (field => value or Expression)
+ * @return string The clause, with its leading space, or "" without conditions
+ * @return array Values of the placeholders
+ * @throws DBError
+ */
+func (d *DatabaseSqlite) makeWhere(conds map[string]interface{}) (string, []interface{}, error) {
+	if len(conds) == 0 {
+		return "", nil, nil
+	}
+	var clauses []string
+	var args []interface{}
+	for _, field := range sortedFields(conds) {
+		quoted := d.addIdentifierQuotes(field)
+		switch cond := conds[field].(type) {
+		case *Expression:
+			switch cond.Op {
+			case "=", "!=", "<", ">", "<=", ">=":
+			default:
+				return "", nil, NewDBError(fmt.Sprintf("Invalid operator \"%s\" for field %s", cond.Op, field))
+			}
+			clauses = append(clauses, quoted+" "+cond.Op+" ?")
+			args = append(args, cond.Value)
+		case []string:
+			if len(cond) == 0 {
+				// Nothing can match an empty list
+				clauses = append(clauses, "0")
+				continue
+			}
+			placeholders := make([]string, len(cond))
+			for i, value := range cond {
+				placeholders[i] = "?"
+				args = append(args, value)
+			}
+			clauses = append(clauses, quoted+" IN ("+strings.Join(placeholders, ",")+")")
+		default:
+			clauses = append(clauses, quoted+" = ?")
+			args = append(args, cond)
+		}
+	}
+	return " WHERE " + strings.Join(clauses, " AND "), args, nil
+}
+
+/**
+ * @param string $s
+ * @return string
+ */
+func (d *DatabaseSqlite) addIdentifierQuotes(s string) string {
+	return `"` + strings.Replace(s, `"`, `""`, -1) + `"`
+}
+
+/**
+ * @param array $values Map of (field => value)
+ * @return string[] The sorted fields, to get the same SQL text every time
+ */
+func sortedFields(values map[string]interface{}) []string {
+	fields := make([]string, 0, len(values))
+	for field := range values {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
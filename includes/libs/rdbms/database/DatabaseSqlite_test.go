@@ -0,0 +1,74 @@
+package database
+
+import (
+	"fmt"
+	"testing"
+
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * @covers DatabaseSqlite::__construct
+ */
+func TestNewDatabaseSqlite(t *testing.T) {
+	_, err := NewDatabaseSqlite(map[string]interface{}{"driver": "no-such-driver"})
+	_, ok := err.(*DBConnectionError)
+	test.AssetTrue(ok, `Drivers which are not linked in`)
+}
+
+/**
+ * @covers DatabaseSqlite::selectSQLText
+ * @covers DatabaseSqlite::makeWhere
+ */
+func TestSqliteSelectSQLText(t *testing.T) {
+	db := new(DatabaseSqlite)
+	sql, args, err := db.selectSQLText("objectcache", []string{"keyname", "value"}, map[string]interface{}{
+		"keyname": []string{"a", "b"},
+		"exptime": NewExpression(">=", "20200101000000"),
+	}, map[string]interface{}{"ORDER BY": "keyname", "LIMIT": 10, "FOR UPDATE": true})
+	test.AssetEqual(nil, err, `No error`)
+	test.AssetEqual(
+		`SELECT "keyname", "value" FROM "objectcache" WHERE "exptime" >= ? AND "keyname" IN (?,?) `+
+			`ORDER BY "keyname" LIMIT 10`,
+		sql,
+		`SELECT query`,
+	)
+	test.AssetEqual(fmt.Sprint([]interface{}{"20200101000000", "a", "b"}), fmt.Sprint(args), `Placeholder values`)
+
+	sql, _, _ = db.selectSQLText("objectcache", nil, map[string]interface{}{"keyname": []string{}}, nil)
+	test.AssetEqual(`SELECT * FROM "objectcache" WHERE 0`, sql, `Empty lists match nothing`)
+
+	_, _, err = db.selectSQLText("objectcache", nil, map[string]interface{}{"keyname": NewExpression("LIKE", "a")}, nil)
+	_, ok := err.(*DBError)
+	test.AssetTrue(ok, `Invalid operators`)
+}
+
+/**
+ * @covers DatabaseSqlite::insertSQLText
+ * @covers DatabaseSqlite::updateSQLText
+ * @covers DatabaseSqlite::deleteSQLText
+ */
+func TestSqliteWriteSQLText(t *testing.T) {
+	db := new(DatabaseSqlite)
+	sql, args := db.insertSQLText("INSERT OR IGNORE", "objectcache", map[string]interface{}{
+		"value": "v", "keyname": "k", "exptime": "20300101000000",
+	})
+	test.AssetEqual(
+		`INSERT OR IGNORE INTO "objectcache" ("exptime", "keyname", "value") VALUES (?, ?, ?)`,
+		sql,
+		`INSERT query`,
+	)
+	test.AssetEqual(fmt.Sprint([]interface{}{"20300101000000", "k", "v"}), fmt.Sprint(args), `INSERT values`)
+
+	sql, args, _ = db.updateSQLText("objectcache", map[string]interface{}{"value": "w"},
+		map[string]interface{}{"keyname": "k"})
+	test.AssetEqual(`UPDATE "objectcache" SET "value" = ? WHERE "keyname" = ?`, sql, `UPDATE query`)
+	test.AssetEqual(fmt.Sprint([]interface{}{"w", "k"}), fmt.Sprint(args), `UPDATE values`)
+
+	sql, _, _ = db.deleteSQLText("objectcache", map[string]interface{}{"*": "*"})
+	test.AssetEqual(`DELETE FROM "objectcache"`, sql, `Deleting all rows`)
+	_, _, err := db.deleteSQLText("objectcache", nil)
+	test.AssetTrue(err != nil, `Deleting without conditions`)
+
+	test.AssetEqual(`"a""b"`, db.addIdentifierQuotes(`a"b`), `Identifier quoting`)
+}
@@ -83,5 +83,152 @@ const DBO_COMPRESS = 512
  * @ingroup Database
  */
 type IDatabase interface {
+	/**
+	 * Get the type of the DBMS (e.g. "mysql", "sqlite")
+	 *
+	 * @return string
+	 */
+	GetType() string
 
+	/**
+	 * Returns a wikitext link to the DB's website, e.g.,
+	 *   return "[https://www.mysql.com/ MySQL]";
+	 * Should at least contain plain text, if for some reason
+	 * your database has no website.
+	 *
+	 * @return string Wikitext of a link to the server software's web site
+	 */
+	GetSoftwareLink() string
+
+	/**
+	 * A string describing the current software version, like from
+	 * mysql_get_server_info().
+	 *
+	 * @return string Version information from the database server.
+	 */
+	GetServerVersion() string
+
+	/**
+	 * Run an SQL query which returns no rows, e.g. to create the tables
+	 *
+	 * @param string $sql SQL query
+	 * @param string $fname Name of the calling function
+	 * @throws DBError
+	 */
+	Query(sql string, fname string) error
+
+	/**
+	 * Execute a SELECT query constructed using the various parameters provided
+	 *
+	 * Conditions map field names to the values they must equal; an Expression
+	 * value compares the field with another operator, and a list value matches
+	 * any of its elements.
+	 *
+	 * @param string $table Table name
+	 * @param string[] $vars Field names
+	 * @param array $conds Map of (field => value or Expression)
+	 * @param string $fname Caller function name
+	 * @param array $options Query options, including "LIMIT", "ORDER BY" and "FOR UPDATE"
+	 * @return array List of rows, each a map of (field => value)
+	 * @throws DBError
+	 */
+	Select(table string, vars []string, conds map[string]interface{}, fname string,
+		options map[string]interface{}) ([]map[string]string, error)
+
+	/**
+	 * Wrapper to IDatabase::select() that only fetches one row
+	 *
+	 * @param string $table Table name
+	 * @param string[] $vars Field names
+	 * @param array $conds Map of (field => value or Expression)
+	 * @param string $fname Caller function name
+	 * @param array $options Query options
+	 * @return array|null Map of (field => value), or null if no row matched
+	 * @throws DBError
+	 */
+	SelectRow(table string, vars []string, conds map[string]interface{}, fname string,
+		options map[string]interface{}) (map[string]string, error)
+
+	/**
+	 * INSERT wrapper, inserts an array into a table
+	 *
+	 * With the "IGNORE" option, rows conflicting with existing ones on a
+	 * unique index are skipped and not counted in the affected rows.
+	 *
+	 * @param string $table Table name
+	 * @param array $rows List of maps of (field => value)
+	 * @param string $fname Calling function name
+	 * @param array $options Query options
+	 * @return int Number of rows inserted
+	 * @throws DBError
+	 */
+	Insert(table string, rows []map[string]interface{}, fname string, options map[string]interface{}) (int, error)
+
+	/**
+	 * UPDATE wrapper
+	 *
+	 * @param string $table Table name
+	 * @param array $values Map of (field => new value)
+	 * @param array $conds Map of (field => value or Expression)
+	 * @param string $fname Calling function name
+	 * @return int Number of rows updated
+	 * @throws DBError
+	 */
+	Update(table string, values map[string]interface{}, conds map[string]interface{}, fname string) (int, error)
+
+	/**
+	 * REPLACE query wrapper
+	 *
+	 * Rows conflicting with existing ones on any of the unique indexes
+	 * replace them; the others are inserted.
+	 *
+	 * @param string $table Table name
+	 * @param string[] $uniqueIndexes Fields forming the unique indexes
+	 * @param array $rows List of maps of (field => value)
+	 * @param string $fname Calling function name
+	 * @return int Number of rows inserted or replaced
+	 * @throws DBError
+	 */
+	Replace(table string, uniqueIndexes []string, rows []map[string]interface{}, fname string) (int, error)
+
+	/**
+	 * DELETE query wrapper
+	 *
+	 * @param string $table Table name
+	 * @param array $conds Map of (field => value or Expression); use "*" as field to delete all rows
+	 * @param string $fname Name of the calling function
+	 * @return int Number of rows deleted
+	 * @throws DBError
+	 */
+	Delete(table string, conds map[string]interface{}, fname string) (int, error)
+
+	/**
+	 * Convert a timestamp in one of the formats accepted by wfTimestamp()
+	 * to the format used for inserting into timestamp fields in this DBMS.
+	 *
+	 * @param int $ts UNIX timestamp
+	 * @return string
+	 */
+	Timestamp(ts int) string
+}
+
+/**
+ * Comparison of a field with a value, for the conditions of IDatabase queries
+ */
+type Expression struct {
+	/** @var string One of "=", "!=", "<", ">", "<=", ">=" */
+	Op string
+	/** @var mixed */
+	Value interface{}
+}
+
+/**
+ * @param string $op One of "=", "!=", "<", ">", "<=", ">="
+ * @param mixed $value
+ */
+func NewExpression(op string, value interface{}) *Expression {
+	this := new(Expression)
+	this.Op = op
+	this.Value = value
+	return this
 }
@@ -0,0 +1,275 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+/**
+ * A version constraint, e.g. ">= 1.29.0" or "^2.0 || ^3.0"
+ */
+type Constraint interface {
+	/**
+	 * @param Version $version
+	 * @return bool Whether $version satisfies the constraint
+	 */
+	Matches(version *Version) bool
+	String() string
+}
+
+/**
+ * Comparison of the versions with one version
+ */
+type singleConstraint struct {
+	operator string
+	version  *Version
+}
+
+func (c *singleConstraint) Matches(version *Version) bool {
+	cmp := version.Compare(c.version)
+	switch c.operator {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+func (c *singleConstraint) String() string {
+	return c.operator + " " + c.version.String()
+}
+
+/**
+ * Constraints which versions satisfy all of (conjunctive) or one of
+ */
+type multiConstraint struct {
+	constraints []Constraint
+	conjunctive bool
+}
+
+func (c *multiConstraint) Matches(version *Version) bool {
+	for _, constraint := range c.constraints {
+		if constraint.Matches(version) != c.conjunctive {
+			return !c.conjunctive
+		}
+	}
+	return c.conjunctive
+}
+
+func (c *multiConstraint) String() string {
+	parts := make([]string, len(c.constraints))
+	for i, constraint := range c.constraints {
+		parts[i] = constraint.String()
+	}
+	if c.conjunctive {
+		return "[" + strings.Join(parts, " ") + "]"
+	}
+	return "[" + strings.Join(parts, " || ") + "]"
+}
+
+/**
+ * Satisfied by all the versions, e.g. "*"
+ */
+type matchAllConstraint struct {
+}
+
+func (c *matchAllConstraint) Matches(version *Version) bool {
+	return true
+}
+
+func (c *matchAllConstraint) String() string {
+	return "*"
+}
+
+var (
+	orRegex       = regexp.MustCompile(`\s*\|\|?\s*`)
+	hyphenRegex   = regexp.MustCompile(`^(\S+)\s+-\s+(\S+)$`)
+	operatorRegex = regexp.MustCompile(`^(<>|!=|>=?|<=?|==?)\s*(.*)$`)
+	wildcardRegex = regexp.MustCompile(`(?i)^v?(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:\.[x*])+$`)
+)
+
+/**
+ * Parses a constraint string, as VersionParser::parseConstraints() does:
+ * "||" separates the alternatives, spaces or commas the constraints that
+ * must all be satisfied. Supported are the operators (>=, <, !=, ...),
+ * wildcards (1.2.*), hyphen ranges (1.0 - 2.0), and the tilde (~1.2) and
+ * caret (^1.2) operators.
+ *
+ * @param string $constraints
+ * @return Constraint
+ * @return error If the constraints are not valid
+ */
+func ParseConstraints(constraints string) (Constraint, error) {
+	text := strings.TrimSpace(constraints)
+	if text == "" {
+		return nil, fmt.Errorf("Could not parse version constraint \"%s\"", constraints)
+	}
+
+	var alternatives []Constraint
+	for _, group := range orRegex.Split(text, -1) {
+		constraint, err := parseConjunction(group)
+		if err != nil {
+			return nil, fmt.Errorf("Could not parse version constraint \"%s\": %s", constraints, err)
+		}
+		alternatives = append(alternatives, constraint)
+	}
+	if len(alternatives) == 1 {
+		return alternatives[0], nil
+	}
+	return &multiConstraint{constraints: alternatives}, nil
+}
+
+/**
+ * @param string $group Constraints which must all be satisfied
+ * @return Constraint
+ */
+func parseConjunction(group string) (Constraint, error) {
+	if match := hyphenRegex.FindStringSubmatch(group); match != nil {
+		return parseHyphenRange(match[1], match[2])
+	}
+
+	var tokens []string
+	pending := ""
+	for _, token := range strings.Fields(strings.Replace(group, ",", " ", -1)) {
+		// Operators may be separated from their version, e.g. ">= 1.29"
+		if operatorRegex.MatchString(token) && operatorRegex.FindStringSubmatch(token)[2] == "" {
+			pending += token
+			continue
+		}
+		tokens = append(tokens, pending+token)
+		pending = ""
+	}
+	if pending != "" || len(tokens) == 0 {
+		return nil, fmt.Errorf("missing version after \"%s\"", pending)
+	}
+
+	var constraints []Constraint
+	for _, token := range tokens {
+		parsed, err := parseConstraint(token)
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, parsed...)
+	}
+	if len(constraints) == 1 {
+		return constraints[0], nil
+	}
+	return &multiConstraint{constraints: constraints, conjunctive: true}, nil
+}
+
+/**
+ * @param string $constraint A single constraint, e.g. "~1.2" or ">=1.0"
+ * @return Constraint[] Constraints which must all be satisfied
+ */
+func parseConstraint(constraint string) ([]Constraint, error) {
+	// Stability flags, e.g. "1.0@dev", do not apply to the versions of extensions
+	if at := strings.Index(constraint, "@"); at >= 0 {
+		constraint = constraint[:at]
+	}
+	if constraint == "*" || strings.EqualFold(constraint, "x") {
+		return []Constraint{&matchAllConstraint{}}, nil
+	}
+
+	switch {
+	case strings.HasPrefix(constraint, "~") && !strings.HasPrefix(constraint, "~="):
+		version, err := NormalizeVersion(constraint[1:])
+		if err != nil {
+			return nil, err
+		}
+		// ~1.2 means >=1.2 <2.0, ~1.2.3 means >=1.2.3 <1.3
+		position := version.precision - 2
+		if position < 0 {
+			position = 0
+		}
+		return between(version, version.bump(position)), nil
+
+	case strings.HasPrefix(constraint, "^"):
+		version, err := NormalizeVersion(constraint[1:])
+		if err != nil {
+			return nil, err
+		}
+		// Only changes of the first non-zero part may break compatibility
+		position := 0
+		if version.parts[0] == 0 && version.precision > 1 {
+			position = 1
+			if version.parts[1] == 0 && version.precision > 2 {
+				position = 2
+			}
+		}
+		return between(version, version.bump(position)), nil
+	}
+
+	if match := wildcardRegex.FindStringSubmatch(constraint); match != nil {
+		version, err := NormalizeVersion(strings.TrimRight(constraint, ".xX*"))
+		if err != nil {
+			return nil, err
+		}
+		return between(version, version.bump(version.precision-1)), nil
+	}
+
+	operator, text := "==", constraint
+	if match := operatorRegex.FindStringSubmatch(constraint); match != nil {
+		operator, text = match[1], match[2]
+	}
+	version, err := NormalizeVersion(text)
+	if err != nil {
+		return nil, err
+	}
+	switch operator {
+	case "=":
+		operator = "=="
+	case "<>":
+		operator = "!="
+	case ">=", "<":
+		// Pre-releases of the version satisfy >= but not <, e.g. 1.31.0-alpha >= 1.31
+		version = version.dev()
+	}
+	return []Constraint{&singleConstraint{operator: operator, version: version}}, nil
+}
+
+/**
+ * @param string $from
+ * @param string $to Included if complete, e.g. "1.0 - 2.0" includes 2.0.5
+ *  but "1.0 - 2.0.0" does not
+ * @return Constraint
+ */
+func parseHyphenRange(from, to string) (Constraint, error) {
+	lower, err := NormalizeVersion(from)
+	if err != nil {
+		return nil, err
+	}
+	upper, err := NormalizeVersion(to)
+	if err != nil {
+		return nil, err
+	}
+	var upperConstraint Constraint = &singleConstraint{operator: "<=", version: upper}
+	if upper.precision < 3 {
+		upperConstraint = &singleConstraint{operator: "<", version: upper.bump(upper.precision - 1)}
+	}
+	return &multiConstraint{
+		constraints: []Constraint{&singleConstraint{operator: ">=", version: lower.dev()}, upperConstraint},
+		conjunctive: true,
+	}, nil
+}
+
+/**
+ * @param Version $lower Included
+ * @param Version $upper Excluded
+ * @return Constraint[]
+ */
+func between(lower, upper *Version) []Constraint {
+	return []Constraint{
+		&singleConstraint{operator: ">=", version: lower.dev()},
+		&singleConstraint{operator: "<", version: upper},
+	}
+}
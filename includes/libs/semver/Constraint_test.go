@@ -0,0 +1,86 @@
+package semver
+
+import (
+	"testing"
+
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * @covers VersionParser::normalize
+ */
+func TestNormalizeVersion(t *testing.T) {
+	cases := map[string]string{
+		"1.30.2":       "1.30.2.0",
+		"v2.0":         "2.0.0.0",
+		"1.31.0-alpha": "1.31.0.0-alpha",
+		"1.2.0-rc.1":   "1.2.0.0-RC1",
+		"1.0.0-beta2":  "1.0.0.0-beta2",
+		"1.0-dev":      "1.0.0.0-dev",
+		"1.0.0+build":  "1.0.0.0",
+	}
+	for version, expected := range cases {
+		normalized, err := NormalizeVersion(version)
+		test.AssetEqual(nil, err, version)
+		test.AssetEqual(expected, normalized.String(), version)
+	}
+	for _, version := range []string{"", "foo", "1.2.3.4.5", "dev-master"} {
+		_, err := NormalizeVersion(version)
+		test.AssetTrue(err != nil, `Invalid version: `+version)
+	}
+}
+
+/**
+ * @covers VersionParser::parseConstraints
+ * @covers Constraint::matches
+ */
+func TestParseConstraints(t *testing.T) {
+	cases := []struct {
+		constraint string
+		version    string
+		expected   bool
+	}{
+		{">= 1.29.0", "1.30.2", true},
+		{">= 1.31.0", "1.30.2", false},
+		{">= 1.31.0", "1.31.0-alpha", true},
+		{">=1.30", "1.31.0-alpha", true},
+		{"< 1.31", "1.31.0-alpha", false},
+		{"1.30.2", "1.30.2", true},
+		{"=1.30.1", "1.30.2", false},
+		{"!= 1.30.2", "1.30.2", false},
+		{"<> 1.30.1", "1.30.2", true},
+		{"> 1.30.2", "1.30.2", false},
+		{"<= 1.30.2", "1.30.2", true},
+		{"1.30.*", "1.30.9", true},
+		{"1.30.x", "1.31.0", false},
+		{"*", "0.0.1", true},
+		{"~1.2", "1.9.0", true},
+		{"~1.2", "2.0.0", false},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"^1.2.3", "1.9.0", true},
+		{"^1.2.3", "1.2.2", false},
+		{"^1.2.3", "2.0.0", false},
+		{"^0.3", "0.3.5", true},
+		{"^0.3", "0.4.0", false},
+		{"^0.0.3", "0.0.4", false},
+		{"1.0 - 2.0", "2.0.5", true},
+		{"1.0 - 2.0", "2.1.0", false},
+		{"1.0 - 2.0.0", "2.0.5", false},
+		{">= 1.27, < 1.30", "1.29.1", true},
+		{">=1.27 <1.30", "1.30.0", false},
+		{"^1.0 || ^2.0", "2.3.0", true},
+		{"^1.0 || ^2.0", "3.0.0", false},
+		{"1.0.0@dev", "1.0.0", true},
+	}
+	for _, c := range cases {
+		constraint, err := ParseConstraints(c.constraint)
+		test.AssetEqual(nil, err, c.constraint)
+		version, _ := NormalizeVersion(c.version)
+		test.AssetEqual(c.expected, constraint.Matches(version), c.constraint+" matching "+c.version)
+	}
+	for _, constraint := range []string{"", ">=", "foo", "^bar || 1.0", "~"} {
+		_, err := ParseConstraints(constraint)
+		test.AssetTrue(err != nil, `Invalid constraint: `+constraint)
+	}
+}
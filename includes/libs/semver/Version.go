@@ -0,0 +1,154 @@
+/**
+ * Parsing and comparison of versions, as composer/semver does.
+ */
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+/**
+ * Stabilities of the versions, from the least stable
+ */
+const (
+	STABILITY_DEV = iota
+	STABILITY_ALPHA
+	STABILITY_BETA
+	STABILITY_RC
+	STABILITY_STABLE
+	STABILITY_PATCH
+)
+
+var stabilities = map[string]int{
+	"dev":    STABILITY_DEV,
+	"a":      STABILITY_ALPHA,
+	"alpha":  STABILITY_ALPHA,
+	"b":      STABILITY_BETA,
+	"beta":   STABILITY_BETA,
+	"rc":     STABILITY_RC,
+	"stable": STABILITY_STABLE,
+	"p":      STABILITY_PATCH,
+	"pl":     STABILITY_PATCH,
+	"patch":  STABILITY_PATCH,
+}
+
+var stabilityNames = []string{"dev", "alpha", "beta", "RC", "", "patch"}
+
+var versionRegex = regexp.MustCompile(`(?i)^v?(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:\.(\d+))?` +
+	`(?:[._-]?(stable|beta|b|rc|alpha|a|patch|pl|p)(?:[.-]?(\d+))?)?([.-]?dev)?$`)
+
+/**
+ * A normalized version: four numbers, a stability and the number of the
+ * pre-release or patch
+ */
+type Version struct {
+	parts           [4]int
+	stability       int
+	stabilityNumber int
+	/** @var int Number of parts given, e.g. 2 for "1.30" */
+	precision int
+}
+
+/**
+ * Normalizes a version string, as VersionParser::normalize() does
+ *
+ * @param string $version e.g. "1.30.2", "v2.0", "1.31.0-alpha" or "1.2.0-rc.1"
+ * @return Version
+ * @return error If the version is not valid
+ */
+func NormalizeVersion(version string) (*Version, error) {
+	text := strings.TrimSpace(version)
+	// Build metadata is ignored
+	if plus := strings.Index(text, "+"); plus >= 0 {
+		text = text[:plus]
+	}
+	match := versionRegex.FindStringSubmatch(text)
+	if match == nil {
+		return nil, fmt.Errorf("Invalid version string \"%s\"", version)
+	}
+
+	v := &Version{stability: STABILITY_STABLE}
+	for i := 0; i < 4; i++ {
+		if match[i+1] == "" {
+			break
+		}
+		v.parts[i], _ = strconv.Atoi(match[i+1])
+		v.precision++
+	}
+	if match[5] != "" {
+		v.stability = stabilities[strings.ToLower(match[5])]
+		v.stabilityNumber, _ = strconv.Atoi(match[6])
+	}
+	if match[7] != "" {
+		v.stability = STABILITY_DEV
+	}
+	return v, nil
+}
+
+/**
+ * @param Version $other
+ * @return int -1, 0 or 1 when the version is lower, equal or greater than $other
+ */
+func (v *Version) Compare(other *Version) int {
+	for i := range v.parts {
+		if c := compareInts(v.parts[i], other.parts[i]); c != 0 {
+			return c
+		}
+	}
+	if c := compareInts(v.stability, other.stability); c != 0 {
+		return c
+	}
+	return compareInts(v.stabilityNumber, other.stabilityNumber)
+}
+
+/**
+ * @return string The normalized version, e.g. "1.30.2.0" or "1.31.0.0-alpha"
+ */
+func (v *Version) String() string {
+	text := fmt.Sprintf("%d.%d.%d.%d", v.parts[0], v.parts[1], v.parts[2], v.parts[3])
+	if v.stability != STABILITY_STABLE {
+		text += "-" + stabilityNames[v.stability]
+		if v.stabilityNumber > 0 {
+			text += strconv.Itoa(v.stabilityNumber)
+		}
+	}
+	return text
+}
+
+/**
+ * @param int $position Index of the part to increment, the next ones being reset
+ * @return Version The lowest dev version of the next release at $position,
+ *  e.g. 1.3.0.0-dev for 1.2.5 at 1
+ */
+func (v *Version) bump(position int) *Version {
+	next := &Version{stability: STABILITY_DEV, precision: 4}
+	copy(next.parts[:position], v.parts[:position])
+	next.parts[position] = v.parts[position] + 1
+	return next
+}
+
+/**
+ * @return Version The version with the dev stability, lower than all the
+ *  pre-releases of the version
+ */
+func (v *Version) dev() *Version {
+	if v.stability != STABILITY_STABLE {
+		return v
+	}
+	dev := *v
+	dev.stability = STABILITY_DEV
+	return &dev
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,219 @@
+package stats
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/**
+ * A factory for application metric data.
+ *
+ * This class prepends a context-specific prefix to each metric key and keeps
+ * a reference to each constructed metric in an internal array buffer.
+ *
+ * @since 1.25
+ */
+type BufferingStatsdDataFactory struct {
+	/** @var StatsdData[] */
+	buffer []StatsdData
+	/** @var bool */
+	enabled bool
+	/** @var string */
+	prefix string
+
+	mutex sync.Mutex
+}
+
+var (
+	metricKeySeparatorRegex = regexp.MustCompile(`[:.]+`)
+	metricKeyInvalidRegex   = regexp.MustCompile(`(?i)[^a-z0-9.]+`)
+)
+
+/**
+ * @param string $prefix Prepended to the metric keys, followed by a dot
+ */
+func NewBufferingStatsdDataFactory(prefix string) *BufferingStatsdDataFactory {
+	this := new(BufferingStatsdDataFactory)
+	this.prefix = prefix
+	this.enabled = true
+	return this
+}
+
+/**
+ * Normalize a metric key for StatsD
+ *
+ * Replace occurences of '::' with dots and any other non-alphanumeric
+ * characters with underscores. Combine runs of dots or underscores.
+ * Then trim leading or trailing dots or underscores.
+ *
+ * @param string $key
+ * @since 1.26
+ * @return string
+ */
+func (b *BufferingStatsdDataFactory) normalizeMetricKey(key string) string {
+	key = metricKeySeparatorRegex.ReplaceAllString(key, ".")
+	key = metricKeyInvalidRegex.ReplaceAllString(key, "_")
+	key = strings.Trim(key, "_.")
+	return strings.NewReplacer("._", ".", "_.", ".").Replace(key)
+}
+
+/**
+ * Buffer the metric, unless the factory is disabled
+ *
+ * @param string $key
+ * @param mixed $value
+ * @param string $metric One of the STATSD_METRIC_* constants
+ */
+func (b *BufferingStatsdDataFactory) produceStatsdData(key string, value interface{}, metric string) {
+	key = b.normalizeMetricKey(key)
+	if b.prefix != "" {
+		key = b.prefix + "." + key
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if !b.enabled {
+		return
+	}
+	b.buffer = append(b.buffer, StatsdData{Key: key, Value: value, Metric: metric})
+}
+
+/**
+ * This function creates a 'timing' StatsdData.
+ *
+ * @param string $key The metric(s) to set.
+ * @param float $time The elapsed time (ms) to log
+ */
+func (b *BufferingStatsdDataFactory) Timing(key string, time float64) {
+	b.produceStatsdData(key, time, STATSD_METRIC_TIMING)
+}
+
+/**
+ * This function creates a 'gauge' StatsdData.
+ *
+ * @param string $key The metric(s) to set.
+ * @param float $value The value for the stats.
+ */
+func (b *BufferingStatsdDataFactory) Gauge(key string, value float64) {
+	b.produceStatsdData(key, value, STATSD_METRIC_GAUGE)
+}
+
+/**
+ * This function creates a 'set' StatsdData object
+ *
+ * @param string $key The metric(s) to set.
+ * @param string $value One of the unique events
+ */
+func (b *BufferingStatsdDataFactory) Set(key string, value string) {
+	b.produceStatsdData(key, value, STATSD_METRIC_SET)
+}
+
+/**
+ * This function creates a 'increment' StatsdData object.
+ *
+ * @param string $key The metric(s) to increment.
+ */
+func (b *BufferingStatsdDataFactory) Increment(key string) {
+	b.UpdateCount(key, 1)
+}
+
+/**
+ * This function creates a 'decrement' StatsdData object.
+ *
+ * @param string $key The metric(s) to decrement.
+ */
+func (b *BufferingStatsdDataFactory) Decrement(key string) {
+	b.UpdateCount(key, -1)
+}
+
+/**
+ * This function creates a 'updateCount' StatsdData object.
+ *
+ * @param string $key The metric(s) to decrement.
+ * @param int $delta The delta to add to the each metric
+ */
+func (b *BufferingStatsdDataFactory) UpdateCount(key string, delta int) {
+	b.produceStatsdData(key, delta, STATSD_METRIC_COUNT)
+}
+
+/**
+ * @since 1.31
+ * @return bool
+ */
+func (b *BufferingStatsdDataFactory) HasData() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return len(b.buffer) > 0
+}
+
+/**
+ * @since 1.31
+ * @return StatsdData[]
+ */
+func (b *BufferingStatsdDataFactory) GetData() []StatsdData {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return append([]StatsdData(nil), b.buffer...)
+}
+
+/**
+ * @since 1.31
+ */
+func (b *BufferingStatsdDataFactory) ClearData() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.buffer = nil
+}
+
+/**
+ * Get the buffered data and empty the buffer at once, so that the data
+ * buffered meanwhile is neither lost nor taken twice
+ *
+ * @return StatsdData[]
+ */
+func (b *BufferingStatsdDataFactory) DrainData() []StatsdData {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	data := b.buffer
+	b.buffer = nil
+	return data
+}
+
+/**
+ * @since 1.31
+ * @return int
+ */
+func (b *BufferingStatsdDataFactory) GetDataCount() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return len(b.buffer)
+}
+
+/**
+ * Set collection enable status.
+ * @param bool $enabled Will collection be enabled?
+ * @return void
+ */
+func (b *BufferingStatsdDataFactory) SetEnabled(enabled bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.enabled = enabled
+}
+
+/**
+ * @param StatsdData $data
+ * @return string Line of the StatsD protocol
+ */
+func (d StatsdData) String() string {
+	var value string
+	switch v := d.Value.(type) {
+	case float64:
+		value = strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		value = strconv.Itoa(v)
+	case string:
+		value = v
+	}
+	return d.Key + ":" + value + "|" + d.Metric
+}
@@ -0,0 +1,76 @@
+package stats
+
+import (
+	"fmt"
+	"testing"
+
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * @covers BufferingStatsdDataFactory::normalizeMetricKey
+ */
+func TestNormalizeMetricKey(t *testing.T) {
+	factory := NewBufferingStatsdDataFactory("")
+	tests := map[string]string{
+		"Foo::bar":          "Foo.bar",
+		"objectcache.a.b":   "objectcache.a.b",
+		"resourceloader/x":  "resourceloader_x",
+		"..leading.dots..":  "leading.dots",
+		"a._b_.c":           "a.b.c",
+		"some key:with,all": "some_key.with_all",
+	}
+	for key, expected := range tests {
+		test.AssetEqual(expected, factory.normalizeMetricKey(key), key)
+	}
+}
+
+/**
+ * @covers BufferingStatsdDataFactory::produceStatsdData
+ * @covers BufferingStatsdDataFactory::getData
+ */
+func TestBuffering(t *testing.T) {
+	factory := NewBufferingStatsdDataFactory("MediaWiki")
+	test.AssetEqual(false, factory.HasData(), `Empty buffer`)
+
+	factory.Increment("objectcache.foo.get_hit_call")
+	factory.UpdateCount("objectcache.foo.set_call", 3)
+	factory.Timing("hooks::run", 1.5)
+	test.AssetEqual(
+		"[MediaWiki.objectcache.foo.get_hit_call:1|c MediaWiki.objectcache.foo.set_call:3|c MediaWiki.hooks.run:1.5|ms]",
+		fmt.Sprint(factory.GetData()),
+		`Metrics are prefixed and buffered`,
+	)
+
+	factory.SetEnabled(false)
+	factory.Increment("ignored")
+	test.AssetEqual(3, factory.GetDataCount(), `Disabled factory ignores metrics`)
+	factory.ClearData()
+	test.AssetEqual(false, factory.HasData(), `Buffer cleared`)
+}
+
+/**
+ * @covers BufferingStatsdDataFactory::drainData
+ */
+func TestDrainData(t *testing.T) {
+	factory := NewBufferingStatsdDataFactory("")
+	done := make(chan bool)
+	go func() {
+		for i := 0; i < 1000; i++ {
+			factory.Increment("foo")
+		}
+		done <- true
+	}()
+
+	drained, finished := 0, false
+	for !finished {
+		select {
+		case <-done:
+			finished = true
+		default:
+		}
+		drained += len(factory.DrainData())
+	}
+	test.AssertEqual(t, 1000, drained, `Every metric is drained exactly once`)
+	test.AssertEqual(t, false, factory.HasData(), `The buffer is empty`)
+}
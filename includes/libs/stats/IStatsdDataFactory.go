@@ -0,0 +1,71 @@
+package stats
+
+/**
+ * Data of a StatsD metric
+ */
+type StatsdData struct {
+	/** @var string Metric key */
+	Key string
+	/** @var mixed Metric value */
+	Value interface{}
+	/** @var string One of the STATSD_METRIC_* constants */
+	Metric string
+}
+
+/** StatsD metric types */
+const STATSD_METRIC_TIMING = "ms"
+const STATSD_METRIC_GAUGE = "g"
+const STATSD_METRIC_COUNT = "c"
+const STATSD_METRIC_SET = "s"
+
+/**
+ * Factory of the StatsD metrics, to which services report what they are doing
+ */
+type IStatsdDataFactory interface {
+	/**
+	 * This function creates a 'timing' StatsdData.
+	 *
+	 * @param string $key The metric(s) to set.
+	 * @param float $time The elapsed time (ms) to log
+	 */
+	Timing(key string, time float64)
+
+	/**
+	 * This function creates a 'gauge' StatsdData.
+	 *
+	 * @param string $key The metric(s) to set.
+	 * @param float $value The value for the stats.
+	 */
+	Gauge(key string, value float64)
+
+	/**
+	 * This function creates a 'set' StatsdData object
+	 * A "Set" is a count of unique events.
+	 *
+	 * @param string $key The metric(s) to set.
+	 * @param string $value One of the unique events
+	 */
+	Set(key string, value string)
+
+	/**
+	 * This function creates a 'increment' StatsdData object.
+	 *
+	 * @param string $key The metric(s) to increment.
+	 */
+	Increment(key string)
+
+	/**
+	 * This function creates a 'decrement' StatsdData object.
+	 *
+	 * @param string $key The metric(s) to decrement.
+	 */
+	Decrement(key string)
+
+	/**
+	 * This function creates a 'updateCount' StatsdData object.
+	 *
+	 * @param string $key The metric(s) to decrement.
+	 * @param int $delta The delta to add to the each metric
+	 */
+	UpdateCount(key string, delta int)
+}
@@ -0,0 +1,64 @@
+package stats
+
+/**
+ * @author Addshore
+ * @since 1.27
+ */
+type NullStatsdDataFactory struct {
+}
+
+func NewNullStatsdDataFactory() *NullStatsdDataFactory {
+	return new(NullStatsdDataFactory)
+}
+
+/**
+ * This function creates a 'timing' StatsdData.
+ *
+ * @param string $key The metric(s) to set.
+ * @param float $time The elapsed time (ms) to log
+ */
+func (n *NullStatsdDataFactory) Timing(key string, time float64) {
+}
+
+/**
+ * This function creates a 'gauge' StatsdData.
+ *
+ * @param string $key The metric(s) to set.
+ * @param float $value The value for the stats.
+ */
+func (n *NullStatsdDataFactory) Gauge(key string, value float64) {
+}
+
+/**
+ * This function creates a 'set' StatsdData object
+ *
+ * @param string $key The metric(s) to set.
+ * @param string $value One of the unique events
+ */
+func (n *NullStatsdDataFactory) Set(key string, value string) {
+}
+
+/**
+ * This function creates a 'increment' StatsdData object.
+ *
+ * @param string $key The metric(s) to increment.
+ */
+func (n *NullStatsdDataFactory) Increment(key string) {
+}
+
+/**
+ * This function creates a 'decrement' StatsdData object.
+ *
+ * @param string $key The metric(s) to decrement.
+ */
+func (n *NullStatsdDataFactory) Decrement(key string) {
+}
+
+/**
+ * This function creates a 'updateCount' StatsdData object.
+ *
+ * @param string $key The metric(s) to decrement.
+ * @param int $delta The delta to add to the each metric
+ */
+func (n *NullStatsdDataFactory) UpdateCount(key string, delta int) {
+}
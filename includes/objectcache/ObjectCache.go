@@ -0,0 +1,463 @@
+/**
+ * Functions to get cache objects.
+ */
+package objectcache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	libobjectcache "github.com/MangoDowner/mediawiki/includes/libs/objectcache"
+	"github.com/MangoDowner/mediawiki/includes/libs/rdbms/database"
+	"github.com/MangoDowner/mediawiki/includes/libs/stats"
+)
+
+/**
+ * Functions to get cache objects
+ *
+ * The word "cache" has two main dictionary meanings, and both
+ * are used in this factory class. They are:
+ *
+ *   - a) Cache (the computer science definition).
+ *        A place to store copies or computations on existing data for
+ *        higher access speeds.
+ *   - b) Storage.
+ *        A place to store lightweight data that is not canonically
+ *        stored anywhere else (e.g. a "hoard" of objects).
+ *
+ * The former should always use strongly consistent stores, so callers don't
+ * have to deal with stale reads. The latter may be eventually consistent, but
+ * callers can use BagOStuff:READ_LATEST to see the latest available data.
+ *
+ * Primary entry points:
+ *
+ * - ObjectCache::getLocalClusterInstance()
+ *   Purpose: Memory cache for very hot keys.
+ *   Stored only on the individual web server (typically APC or APCu for web requests,
+ *   and EmptyBagOStuff in CLI mode).
+ *   Not replicated to the other servers.
+ *
+ * - ObjectCache::getSessionInstance()
+ *   Purpose: Storage of the session data, as configured by $wgSessionCacheType.
+ *
+ * - ObjectCache::getInstance( $cacheType )
+ *   Purpose: Special cases (like tiered memory/disk caches).
+ *   Get a specific cache type by key in $wgObjectCaches.
+ *
+ * All the above cache instances (BagOStuff and WANObjectCache) have their makeKey()
+ * method scoped to the *current* wiki ID. Use makeGlobalKey() to avoid this scoping
+ * when using keys that need to be shared amongst wikis.
+ *
+ * @ingroup Cache
+ */
+
+/** @var BagOStuff[] Map of (id => BagOStuff) */
+var instances = make(map[string]libobjectcache.IBagOStuff)
+var instancesMutex sync.Mutex
+
+/**
+ * Provides the handle to the main wiki database, which SqlBagOStuff
+ * instances configured without servers use, or the error making it
+ * unavailable, e.g. an unsupported $wgDBtype. The MainDatabase service once
+ * MediaWikiServices is set up.
+ * @var callable|null
+ */
+var MainDatabase func() (database.IDatabase, error)
+
+/**
+ * Provides the stats sink of the caches whose parameters name none, the
+ * StatsdDataFactory service once MediaWikiServices is set up.
+ * @var callable|null
+ */
+var StatsdDataFactory func() stats.IStatsdDataFactory
+
+/** @var callable[] Tasks the caches deferred to the end of the request */
+var deferredUpdates []func()
+var deferredUpdatesMutex sync.Mutex
+
+/**
+ * Constructors of the BagOStuff classes that $wgObjectCaches can refer to
+ * @var callable[] Map of (class name => constructor)
+ */
+var objectCacheClasses = map[string]func(params map[string]interface{}) libobjectcache.IBagOStuff{
+	"EmptyBagOStuff": func(params map[string]interface{}) libobjectcache.IBagOStuff {
+		return libobjectcache.NewEmptyBagOStuff(params)
+	},
+	"HashBagOStuff": func(params map[string]interface{}) libobjectcache.IBagOStuff {
+		return libobjectcache.NewHashBagOStuff(params)
+	},
+	"RESTBagOStuff": func(params map[string]interface{}) libobjectcache.IBagOStuff {
+		return libobjectcache.NewRESTBagOStuff(params)
+	},
+	"SqlBagOStuff": func(params map[string]interface{}) libobjectcache.IBagOStuff {
+		return libobjectcache.NewSqlBagOStuff(params)
+	},
+	"MultiWriteBagOStuff": func(params map[string]interface{}) libobjectcache.IBagOStuff {
+		return libobjectcache.NewMultiWriteBagOStuff(params)
+	},
+}
+
+/**
+ * Default value of $wgObjectCaches
+ *
+ * @return array Map of (id => cache parameters)
+ */
+func defaultObjectCaches() map[string]map[string]interface{} {
+	return map[string]map[string]interface{}{
+		consts.CACHE_NONE: {"class": "EmptyBagOStuff", "reportDupes": false},
+		consts.CACHE_DB:   {"class": "SqlBagOStuff", "loggroup": "SQLBagOStuff"},
+
+		consts.CACHE_ANYTHING: {"factory": NewAnything},
+		consts.CACHE_ACCEL:    {"factory": GetLocalServerInstance},
+
+		"hash": {"class": "HashBagOStuff", "reportDupes": false},
+	}
+}
+
+/**
+ * Get a cached instance of the specified type of cache object.
+ *
+ * @param string $id A key in $wgObjectCaches.
+ * @return BagOStuff
+ */
+func GetInstance(id string) libobjectcache.IBagOStuff {
+	instancesMutex.Lock()
+	cache, ok := instances[id]
+	instancesMutex.Unlock()
+	if ok {
+		return cache
+	}
+
+	// Build outside of the lock, as the factories may get other instances
+	cache = NewFromId(id)
+
+	instancesMutex.Lock()
+	defer instancesMutex.Unlock()
+	if existing, ok := instances[id]; ok {
+		return existing // raced out
+	}
+	instances[id] = cache
+	return cache
+}
+
+/**
+ * Create a new cache object of the specified type.
+ *
+ * @param string $id A key in $wgObjectCaches.
+ * @return BagOStuff
+ * @throws MWException
+ */
+func NewFromId(id string) libobjectcache.IBagOStuff {
+	params, ok := getObjectCaches()[id]
+	if !ok {
+		panic(exception.NewMWException(fmt.Sprintf(
+			"Invalid object cache type \"%s\" requested. It is not present in $wgObjectCaches.", id)))
+	}
+	return NewFromParams(params)
+}
+
+/**
+ * Create a new cache object from parameters.
+ *
+ * @param array $params Must have 'factory' or 'class' property.
+ *  - factory: Callback passed $params that returns BagOStuff.
+ *  - class: BagOStuff subclass constructed with $params.
+ *  - keyspace: Default keyspace, the wiki ID unless set.
+ *  - stats: Stats sink, $StatsdDataFactory unless set.
+ *  - asyncHandler: Runs the deferred tasks, at the end of the request
+ *    (see ReportRequestStats()) unless set.
+ *  - caches: For MultiWriteBagOStuff, a list of cache IDs or cache parameters.
+ *  - Other parameters are passed to the factory or class.
+ * @return BagOStuff
+ * @throws MWException
+ */
+func NewFromParams(params map[string]interface{}) libobjectcache.IBagOStuff {
+	// Do not change the caller's copy, which may be $wgObjectCaches itself
+	conf := make(map[string]interface{}, len(params))
+	for name, value := range params {
+		conf[name] = value
+	}
+	if _, ok := conf["keyspace"]; !ok {
+		if keyspace := getDefaultKeyspace(); keyspace != "" {
+			conf["keyspace"] = keyspace
+		}
+	}
+
+	if _, ok := conf["stats"]; !ok && StatsdDataFactory != nil {
+		conf["stats"] = StatsdDataFactory()
+	}
+	if _, ok := conf["asyncHandler"]; !ok {
+		conf["asyncHandler"] = addDeferredUpdate
+	}
+
+	if factory, ok := conf["factory"].(func(params map[string]interface{}) libobjectcache.IBagOStuff); ok {
+		return factory(conf)
+	}
+	class, _ := conf["class"].(string)
+	constructor, ok := objectCacheClasses[class]
+	if !ok {
+		panic(exception.NewMWException(fmt.Sprintf(
+			"The definition of cache type \"%v\" lacks both factory and class parameters.", params)))
+	}
+
+	useMainDatabase := false
+	switch class {
+	case "SqlBagOStuff":
+		_, hasServer := conf["server"]
+		_, hasServers := conf["servers"]
+		if !hasServer && !hasServers {
+			// Use the main wiki database
+			conf["server"] = getMainDatabase()
+			useMainDatabase = true
+		}
+	case "MultiWriteBagOStuff":
+		conf["caches"] = resolveCaches(conf["caches"])
+	}
+	cache := constructor(conf)
+	if sqlCache, ok := cache.(*libobjectcache.SqlBagOStuff); ok && useMainDatabase {
+		// Nothing installs the tables of the main database yet
+		if err := sqlCache.CreateTables(); err != nil {
+			panic(exception.NewMWException(fmt.Sprintf("Cannot create the objectcache tables: %s", err)))
+		}
+	}
+	return cache
+}
+
+/**
+ * @return IDatabase
+ * @throws MWException If there is no main database
+ */
+func getMainDatabase() database.IDatabase {
+	if MainDatabase == nil {
+		panic(exception.NewMWException("SqlBagOStuff requires a 'server' or the main wiki database."))
+	}
+	db, err := MainDatabase()
+	if err != nil {
+		panic(exception.NewMWException(fmt.Sprintf("SqlBagOStuff cannot use the main wiki database: %s", err)))
+	}
+	return db
+}
+
+/**
+ * @param array $caches List of cache IDs or cache parameters
+ * @return BagOStuff[]
+ */
+func resolveCaches(caches interface{}) []libobjectcache.IBagOStuff {
+	var specs []interface{}
+	switch v := caches.(type) {
+	case []libobjectcache.IBagOStuff:
+		return v
+	case []string:
+		for _, id := range v {
+			specs = append(specs, id)
+		}
+	case []interface{}:
+		specs = v
+	}
+
+	resolved := make([]libobjectcache.IBagOStuff, 0, len(specs))
+	for _, spec := range specs {
+		switch v := spec.(type) {
+		case libobjectcache.IBagOStuff:
+			resolved = append(resolved, v)
+		case string:
+			resolved = append(resolved, GetInstance(v))
+		case map[string]interface{}:
+			resolved = append(resolved, NewFromParams(v))
+		default:
+			panic(exception.NewMWException(fmt.Sprintf("Invalid MultiWriteBagOStuff cache \"%v\".", spec)))
+		}
+	}
+	return resolved
+}
+
+/**
+ * Factory function for CACHE_ANYTHING (referenced from DefaultSettings.php)
+ *
+ * CACHE_ANYTHING means that stuff has to be cached, not caring much what the
+ * cache type is: the first configured one of $wgMainCacheType,
+ * $wgMessageCacheType and $wgParserCacheType, or CACHE_DB.
+ *
+ * @param array $params
+ * @return BagOStuff
+ */
+func NewAnything(params map[string]interface{}) libobjectcache.IBagOStuff {
+	objectCaches := getObjectCaches()
+	for _, name := range []string{"wgMainCacheType", "wgMessageCacheType", "wgParserCacheType"} {
+		candidate, _ := globals.GLOBALS[name].(string)
+		if candidate == "" || candidate == consts.CACHE_NONE || candidate == consts.CACHE_ANYTHING {
+			continue
+		}
+		if _, ok := objectCaches[candidate]; ok {
+			return GetInstance(candidate)
+		}
+	}
+
+	if MainDatabase == nil {
+		// No database to fall back to yet, e.g. during installation
+		return GetInstance(consts.CACHE_NONE)
+	}
+	if _, err := MainDatabase(); err != nil {
+		// The database cannot be used, e.g. no driver is linked in
+		return GetInstance(consts.CACHE_NONE)
+	}
+	return GetInstance(consts.CACHE_DB)
+}
+
+/**
+ * Factory function for CACHE_ACCEL (referenced from DefaultSettings.php)
+ *
+ * The process is shared by all the requests a server handles, so this is
+ * a HashBagOStuff kept for the lifetime of the process.
+ *
+ * @param array $params
+ * @return BagOStuff
+ */
+func GetLocalServerInstance(params map[string]interface{}) libobjectcache.IBagOStuff {
+	conf := map[string]interface{}{"maxKeys": 10000}
+	for name, value := range params {
+		if name != "factory" {
+			conf[name] = value
+		}
+	}
+	return libobjectcache.NewHashBagOStuff(conf)
+}
+
+/**
+ * Get the main cluster-local cache object.
+ *
+ * @since 1.27
+ * @return BagOStuff
+ */
+func GetLocalClusterInstance() libobjectcache.IBagOStuff {
+	mainCacheType, ok := globals.GLOBALS["wgMainCacheType"].(string)
+	if !ok {
+		mainCacheType = consts.CACHE_NONE
+	}
+	return GetInstance(mainCacheType)
+}
+
+/**
+ * Get the cache object for storing the session data.
+ *
+ * @return BagOStuff
+ */
+func GetSessionInstance() libobjectcache.IBagOStuff {
+	sessionCacheType, ok := globals.GLOBALS["wgSessionCacheType"].(string)
+	if !ok {
+		sessionCacheType = consts.CACHE_ANYTHING
+	}
+	return GetInstance(sessionCacheType)
+}
+
+/**
+ * Schedule a task of a cache for the end of the request, as the asyncHandler
+ * of the caches NewFromParams() creates
+ *
+ * @param callable $callback
+ */
+func addDeferredUpdate(callback func()) {
+	deferredUpdatesMutex.Lock()
+	defer deferredUpdatesMutex.Unlock()
+	deferredUpdates = append(deferredUpdates, callback)
+}
+
+/**
+ * Give a request its collector of the duplicate fetches and the per-keygroup
+ * stats of the caches, see GetRequestInstance()
+ *
+ * @param Context $ctx Context of the request
+ * @return Context
+ */
+func WithRequestStats(ctx context.Context) context.Context {
+	return libobjectcache.WithRequestStats(ctx, libobjectcache.NewRequestStats())
+}
+
+/**
+ * Get a cached instance of the specified type, counting the operations of
+ * the request in the collector of its context
+ *
+ * @param Context $ctx Context of the request
+ * @param string $id A key in $wgObjectCaches.
+ * @return BagOStuff
+ */
+func GetRequestInstance(ctx context.Context, id string) libobjectcache.IBagOStuff {
+	cache := GetInstance(id)
+	if stats := libobjectcache.RequestStatsFromContext(ctx); stats != nil {
+		return stats.Wrap(cache)
+	}
+	return cache
+}
+
+/**
+ * Run the tasks the caches deferred to the end of the request, such as
+ * asynchronous writes to the other tiers, and report the duplicate fetches
+ * and the per-keygroup stats the request collected; call this at the end of
+ * each request.
+ *
+ * @note The caches are shared by the concurrent requests, so the deferred
+ * tasks of a request may be run at the end of another one.
+ *
+ * @param Context $ctx Context of the request
+ */
+func ReportRequestStats(ctx context.Context) {
+	for {
+		deferredUpdatesMutex.Lock()
+		updates := deferredUpdates
+		deferredUpdates = nil
+		deferredUpdatesMutex.Unlock()
+		if len(updates) == 0 {
+			break
+		}
+		// The tasks may defer further ones, run with the next round
+		for _, update := range updates {
+			update()
+		}
+	}
+
+	if stats := libobjectcache.RequestStatsFromContext(ctx); stats != nil {
+		stats.Report()
+	}
+}
+
+/**
+ * Clear all the cached instances, and drop the tasks they deferred.
+ */
+func Clear() {
+	instancesMutex.Lock()
+	defer instancesMutex.Unlock()
+	instances = make(map[string]libobjectcache.IBagOStuff)
+	deferredUpdatesMutex.Lock()
+	defer deferredUpdatesMutex.Unlock()
+	deferredUpdates = nil
+}
+
+/**
+ * @return array $wgObjectCaches, or its default value
+ */
+func getObjectCaches() map[string]map[string]interface{} {
+	if objectCaches, ok := globals.GLOBALS["wgObjectCaches"].(map[string]map[string]interface{}); ok {
+		return objectCaches
+	}
+	return defaultObjectCaches()
+}
+
+/**
+ * Get the default keyspace for this wiki, from $wgCachePrefix or the wiki ID
+ *
+ * @return string
+ */
+func getDefaultKeyspace() string {
+	if cachePrefix, ok := globals.GLOBALS["wgCachePrefix"].(string); ok && cachePrefix != "" {
+		return cachePrefix
+	}
+	dbName, _ := globals.GLOBALS["wgDBname"].(string)
+	if prefix, _ := globals.GLOBALS["wgDBprefix"].(string); prefix != "" {
+		return dbName + "-" + prefix
+	}
+	return dbName
+}
@@ -0,0 +1,186 @@
+package objectcache
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	libobjectcache "github.com/MangoDowner/mediawiki/includes/libs/objectcache"
+	"github.com/MangoDowner/mediawiki/includes/libs/stats"
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * Sets a configuration global for the test, and returns the function
+ * restoring it
+ */
+func setGlobal(name string, value interface{}) func() {
+	if globals.GLOBALS == nil {
+		globals.GLOBALS = make(map[string]interface{})
+	}
+	oldValue, ok := globals.GLOBALS[name]
+	globals.GLOBALS[name] = value
+	return func() {
+		if ok {
+			globals.GLOBALS[name] = oldValue
+		} else {
+			delete(globals.GLOBALS, name)
+		}
+	}
+}
+
+/**
+ * @covers ObjectCache::getInstance
+ * @covers ObjectCache::newFromId
+ */
+func TestGetInstance(t *testing.T) {
+	defer Clear()
+
+	_, ok := GetInstance("hash").(*libobjectcache.HashBagOStuff)
+	test.AssetEqual(true, ok, `Default cache types`)
+	test.AssetTrue(GetInstance("hash") == GetInstance("hash"), `Instances are reused`)
+	test.AssetTrue(NewFromId("hash") != GetInstance("hash"), `newFromId() makes new instances`)
+	_, ok = GetInstance(consts.CACHE_NONE).(*libobjectcache.EmptyBagOStuff)
+	test.AssetEqual(true, ok, `CACHE_NONE does not cache`)
+
+	func() {
+		defer func() {
+			test.AssetTrue(recover() != nil, `Unknown cache types are rejected`)
+		}()
+		GetInstance("no-such-cache")
+	}()
+}
+
+/**
+ * @covers ObjectCache::newFromParams
+ */
+func TestNewFromParams(t *testing.T) {
+	defer Clear()
+	defer setGlobal("wgCachePrefix", "testwiki")()
+
+	cache := NewFromParams(map[string]interface{}{"class": "HashBagOStuff"})
+	test.AssetEqual("testwiki:key", cache.MakeKey("key"), `Keyspace defaults to $wgCachePrefix`)
+	cache = NewFromParams(map[string]interface{}{"class": "HashBagOStuff", "keyspace": "other"})
+	test.AssetEqual("other:key", cache.MakeKey("key"), `Keyspace can be set`)
+
+	called := false
+	NewFromParams(map[string]interface{}{
+		"factory": func(params map[string]interface{}) libobjectcache.IBagOStuff {
+			called = true
+			return libobjectcache.NewHashBagOStuff(params)
+		},
+	})
+	test.AssetEqual(true, called, `Factory is used`)
+
+	func() {
+		defer func() {
+			test.AssetTrue(recover() != nil, `Class or factory is required`)
+		}()
+		NewFromParams(map[string]interface{}{"class": "NoSuchBagOStuff"})
+	}()
+}
+
+/**
+ * @covers ObjectCache::newFromParams
+ * @covers MultiWriteBagOStuff
+ */
+func TestMultiWriteFromConfig(t *testing.T) {
+	defer Clear()
+	defer setGlobal("wgObjectCaches", map[string]map[string]interface{}{
+		"hash": {"class": "HashBagOStuff"},
+		"tiered": {
+			"class":  "MultiWriteBagOStuff",
+			"caches": []interface{}{"hash", map[string]interface{}{"class": "HashBagOStuff"}},
+		},
+	})()
+
+	cache := GetInstance("tiered")
+	_, ok := cache.(*libobjectcache.MultiWriteBagOStuff)
+	test.AssetEqual(true, ok, `MultiWriteBagOStuff built from $wgObjectCaches`)
+	cache.Set("key", "value", 0, 0)
+	value, _ := GetInstance("hash").Get("key", 0)
+	test.AssetEqual("value", value, `Caches referred to by ID are shared`)
+}
+
+/**
+ * @covers ObjectCache::getLocalClusterInstance
+ * @covers ObjectCache::getSessionInstance
+ * @covers ObjectCache::newAnything
+ */
+func TestCacheTypes(t *testing.T) {
+	defer Clear()
+
+	_, ok := GetLocalClusterInstance().(*libobjectcache.EmptyBagOStuff)
+	test.AssetEqual(true, ok, `$wgMainCacheType defaults to CACHE_NONE`)
+	_, ok = GetSessionInstance().(*libobjectcache.EmptyBagOStuff)
+	test.AssetEqual(true, ok, `CACHE_ANYTHING without a database does not cache`)
+	Clear()
+
+	defer setGlobal("wgMainCacheType", consts.CACHE_ACCEL)()
+	defer setGlobal("wgSessionCacheType", consts.CACHE_ANYTHING)()
+	test.AssetTrue(GetLocalClusterInstance() == GetInstance(consts.CACHE_ACCEL), `$wgMainCacheType is used`)
+	test.AssetTrue(GetSessionInstance() == GetLocalClusterInstance(), `CACHE_ANYTHING uses $wgMainCacheType`)
+}
+
+/**
+ * @covers ObjectCache::reportRequestStats
+ */
+func TestReportRequestStats(t *testing.T) {
+	defer Clear()
+	sink := stats.NewBufferingStatsdDataFactory("MediaWiki")
+	oldStats := StatsdDataFactory
+	StatsdDataFactory = func() stats.IStatsdDataFactory { return sink }
+	defer func() { StatsdDataFactory = oldStats }()
+
+	ctx := WithRequestStats(context.Background())
+	cache := GetRequestInstance(ctx, "hash")
+	cache.Set(cache.MakeKey("foo", "a"), 1, 0, 0)
+	cache.Get(cache.MakeKey("foo", "a"), 0)
+	// A concurrent request counts on its own
+	other := WithRequestStats(context.Background())
+	GetRequestInstance(other, "hash").Get(cache.MakeKey("bar", "a"), 0)
+	GetInstance("hash").Get(cache.MakeKey("baz", "a"), 0)
+	test.AssertEqual(t, false, sink.HasData(), `Stats are buffered until the end of the request`)
+	ReportRequestStats(ctx)
+	test.AssertEqual(t,
+		"[MediaWiki.objectcache.foo.get_hit_call:1|c MediaWiki.objectcache.foo.set_call:1|c]",
+		fmt.Sprint(sink.GetData()),
+		`Caches report the stats of the request to $StatsdDataFactory`,
+	)
+	sink.ClearData()
+	ReportRequestStats(other)
+	test.AssertEqual(t, "[MediaWiki.objectcache.bar.get_miss_call:1|c]", fmt.Sprint(sink.GetData()),
+		`Stats of the other request`)
+}
+
+/**
+ * @covers ObjectCache::newFromParams
+ * @covers ObjectCache::reportRequestStats
+ */
+func TestDeferredUpdates(t *testing.T) {
+	defer Clear()
+	defer setGlobal("wgObjectCaches", map[string]map[string]interface{}{
+		"hash": {"class": "HashBagOStuff"},
+		"tiered": {
+			"class":       "MultiWriteBagOStuff",
+			"caches":      []interface{}{map[string]interface{}{"class": "HashBagOStuff"}, "hash"},
+			"replication": "async",
+		},
+	})()
+
+	cache, tier := GetInstance("tiered"), GetInstance("hash")
+	test.AssertTrue(t, cache.Set("key", "value", 0, 0), `Set`)
+	_, ok := tier.Get("key", 0)
+	test.AssertEqual(t, false, ok, `Writes to the other tiers wait for the end of the request`)
+	ReportRequestStats(context.Background())
+	value, _ := tier.Get("key", 0)
+	test.AssertEqual(t, "value", value, `Writes to the other tiers are done at the end of the request`)
+
+	cache.Set("key", "other", 0, 0)
+	Clear()
+	ReportRequestStats(context.Background())
+	value, _ = tier.Get("key", 0)
+	test.AssertEqual(t, "value", value, `Clear() drops the deferred writes`)
+}
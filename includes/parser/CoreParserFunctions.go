@@ -0,0 +1,133 @@
+/**
+ * Parser functions provided by MediaWiki core
+ */
+package parser
+
+import "strconv"
+
+/**
+ * Various core parser functions, registered in Parser::firstCallInit()
+ * @ingroup Parser
+ *
+ * @param Parser $parser
+ * @return void
+ */
+func RegisterCoreParserFunctions(parser *Parser) {
+	noHashFunctions := map[string]ParserFunction{
+		"lc":      Lc,
+		"lcfirst": Lcfirst,
+		"uc":      Uc,
+		"ucfirst": Ucfirst,
+		"grammar": Grammar,
+		"gender":  Gender,
+		"plural":  Plural,
+	}
+	for id, callback := range noHashFunctions {
+		parser.SetFunctionHook(id, callback)
+	}
+}
+
+/**
+ * @param array $args
+ * @param int $i
+ * @return string The argument, or empty if it is missing
+ */
+func arg(args []string, i int) string {
+	if i < len(args) {
+		return args[i]
+	}
+	return ""
+}
+
+/**
+ * @param Parser $parser
+ * @param string $s
+ * @return string
+ */
+func Lcfirst(parser *Parser, args []string) string {
+	return parser.GetFunctionLang().Lcfirst(arg(args, 0))
+}
+
+/**
+ * @param Parser $parser
+ * @param string $s
+ * @return string
+ */
+func Ucfirst(parser *Parser, args []string) string {
+	return parser.GetFunctionLang().Ucfirst(arg(args, 0))
+}
+
+/**
+ * @param Parser $parser
+ * @param string $s
+ * @return string
+ */
+func Lc(parser *Parser, args []string) string {
+	return parser.GetFunctionLang().Lc(arg(args, 0), false)
+}
+
+/**
+ * @param Parser $parser
+ * @param string $s
+ * @return string
+ */
+func Uc(parser *Parser, args []string) string {
+	return parser.GetFunctionLang().Uc(arg(args, 0), false)
+}
+
+/**
+ * @param Parser $parser
+ * @param string $case
+ * @param string $word
+ * @return string
+ */
+func Grammar(parser *Parser, args []string) string {
+	return parser.GetFunctionLang().ConvertGrammar(arg(args, 1), arg(args, 0))
+}
+
+/**
+ * @param Parser $parser
+ * @param string $username
+ * @return string
+ */
+func Gender(parser *Parser, args []string) string {
+	forms := args[1:]
+	// Some shortcuts to avoid loading user data unnecessarily
+	if len(forms) == 0 {
+		return ""
+	} else if len(forms) == 1 {
+		return forms[0]
+	}
+
+	username := arg(args, 0)
+	genderCache := parser.GetGenderCache()
+	// default
+	gender := "unknown"
+	if genderCache != nil {
+		gender = genderCache.GetDefault()
+		options := parser.GetOptions()
+		// check parameter, or use the ParserOptions if in interface message
+		if username != "" {
+			gender = genderCache.GetGenderOf(username)
+		} else if options != nil && options.GetInterfaceMessage() && options.GetUser() != "" {
+			gender = genderCache.GetGenderOf(options.GetUser())
+		}
+	}
+	return parser.GetFunctionLang().ConvertGender(gender, forms)
+}
+
+/**
+ * @param Parser $parser
+ * @param string $text
+ * @param string ...$forms
+ * @return string
+ */
+func Plural(parser *Parser, args []string) string {
+	text := parser.GetFunctionLang().ParseFormattedNumber(arg(args, 0))
+	count, _ := strconv.ParseFloat(text, 64)
+	var forms []string
+	if len(args) > 1 {
+		forms = args[1:]
+	}
+	return parser.GetFunctionLang().ConvertPlural(count, forms)
+}
@@ -0,0 +1,57 @@
+package parser
+
+import (
+	"github.com/MangoDowner/mediawiki/includes/cache"
+	"github.com/MangoDowner/mediawiki/includes/languages"
+	test "github.com/MangoDowner/mediawiki/tests"
+	"testing"
+)
+
+/**
+ * @covers CoreParserFunctions::gender
+ * @covers CoreParserFunctions::grammar
+ */
+func TestTransformMsg(t *testing.T) {
+	genderCache := cache.NewGenderCache("unknown", func(usernames []string) map[string]string {
+		return map[string]string{"Alice": "female", "Bob": "male"}
+	})
+	parser := NewParser(genderCache)
+	options := NewParserOptions("Alice", languages.Factory("ru"))
+
+	test.AssetEqual(
+		"Участница Alice: она",
+		parser.TransformMsg("{{GENDER:Alice|Участник|Участница}} Alice: {{gender:User:alice|он|она|они}}", options, nil),
+		`Gender from the user preference`,
+	)
+
+	test.AssetEqual(
+		"они",
+		parser.TransformMsg("{{GENDER:Carol|он|она|они}}", options, nil),
+		`Default gender`,
+	)
+
+	options.SetInterfaceMessage(true)
+	test.AssetEqual(
+		"она",
+		parser.TransformMsg("{{GENDER:|он|она}}", options, nil),
+		`Gender of the current user in interface messages`,
+	)
+
+	test.AssetEqual(
+		"статья Википедии",
+		parser.TransformMsg("статья {{GRAMMAR:genitive|{{ucfirst:википедия}}}}", options, nil),
+		`Nested functions`,
+	)
+
+	test.AssetEqual(
+		"{{SITENAME}} {{GENDER:Bob|[[User:Bob|he]]|she}",
+		parser.TransformMsg("{{SITENAME}} {{GENDER:Bob|[[User:Bob|he]]|she}", options, nil),
+		`Unknown templates and unclosed braces are left as they are`,
+	)
+
+	test.AssetEqual(
+		"[[User:Bob|he]]",
+		parser.TransformMsg("{{GENDER:Bob|[[User:Bob|he]]|she}}", options, nil),
+		`Pipes inside links do not split arguments`,
+	)
+}
@@ -0,0 +1,426 @@
+/**
+ * PHP parser that converts wiki markup to HTML.
+ */
+package parser
+
+import (
+	"fmt"
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	"github.com/MangoDowner/mediawiki/includes/languages"
+	"sort"
+	"strings"
+	"sync"
+)
+
+/**
+ * Callback of a parser function, {{name:arg1|arg2|...}}. The arguments are
+ * expanded and trimmed.
+ */
+type ParserFunction func(parser *Parser, args []string) string
+
+/**
+ * Callback of an extension tag, <tag attrib="value">input</tag>. $input
+ * is null for self-closing tags.
+ */
+type ParserTagHook func(input *string, args map[string]string, parser *Parser) string
+
+/**
+ * Gender preferences of users, see GenderCache
+ */
+type IGenderCache interface {
+	GetDefault() string
+	GetGenderOf(username string) string
+}
+
+/**
+ * Title of the page being parsed, see Title
+ */
+type ITitle interface {
+	GetText() string
+	GetPrefixedText() string
+}
+
+/**
+ * Only the {{..}} preprocessing and the -{ }- language conversion are
+ * implemented so far; wikitext is not rendered to HTML.
+ *
+ * @warning $wgUser or $wgTitle or $wgRequest or $wgLang. Keep them away!
+ *
+ * @ingroup Parser
+ */
+type Parser struct {
+	/**
+	 * @var array Function hooks, lowercase ID => callback
+	 */
+	mFunctionHooks map[string]ParserFunction
+
+	/**
+	 * @var array Extension tag hooks, lowercase tag name => callback
+	 */
+	mTagHooks map[string]ParserTagHook
+
+	/**
+	 * @var ParserOptions
+	 */
+	mOptions *ParserOptions
+
+	/**
+	 * @var GenderCache
+	 */
+	mGenderCache IGenderCache
+
+	/**
+	 * @var Title|null Title context, used for self-link rendering and similar things
+	 */
+	mTitle ITitle
+
+	mutex sync.Mutex
+}
+
+/**
+ * @param GenderCache $genderCache Used by {{GENDER:}}
+ */
+func NewParser(genderCache IGenderCache) *Parser {
+	this := new(Parser)
+	this.mFunctionHooks = make(map[string]ParserFunction)
+	this.mTagHooks = make(map[string]ParserTagHook)
+	this.mGenderCache = genderCache
+	RegisterCoreParserFunctions(this)
+	return this
+}
+
+/**
+ * Create an HTML-style tag, e.g. "<yourtag>special text</yourtag>"
+ * The callback should have the following form:
+ *    function myParserHook( $text, $params, $parser, $frame ) { ... }
+ *
+ * Transform and return $text. Use $parser for any required context, e.g. use
+ * $parser->getTitle() and $parser->getOptions() not $wgTitle or $wgOut->mParserOptions
+ *
+ * Tags are only registered so far: wikitext is not rendered to HTML yet.
+ *
+ * @param string $tag The tag to use, e.g. 'hook' for "<hook>"
+ * @param callable $callback The callback function (and object) to use for the tag
+ * @throws MWException
+ * @return callable|null The old value of the mTagHooks array associated with the hook
+ */
+func (p *Parser) SetHook(tag string, callback ParserTagHook) ParserTagHook {
+	tag = strings.ToLower(tag)
+	if m := strings.IndexAny(tag, "<>\r\n"); m >= 0 {
+		panic(exception.NewMWException(fmt.Sprintf("Invalid character %q in setHook('%s', ...) call", tag[m], tag)))
+	}
+	old := p.mTagHooks[tag]
+	p.mTagHooks[tag] = callback
+	return old
+}
+
+/**
+ * Accessor
+ *
+ * @return array
+ */
+func (p *Parser) GetTags() []string {
+	tags := make([]string, 0, len(p.mTagHooks))
+	for tag := range p.mTagHooks {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+/**
+ * Create a function, e.g. {{sum:1|2|3}}
+ * The callback function should have the form:
+ *    function myParserFunction( &$parser, $arg1, $arg2, $arg3 ) { ... }
+ *
+ * @param string $id The magic word ID
+ * @param callable $callback The callback function (and object) to use
+ *
+ * @return string|callable The old callback function for this name, if any
+ */
+func (p *Parser) SetFunctionHook(id string, callback ParserFunction) ParserFunction {
+	id = strings.ToLower(id)
+	old := p.mFunctionHooks[id]
+	p.mFunctionHooks[id] = callback
+	return old
+}
+
+/**
+ * Get all registered function hook identifiers
+ *
+ * @return array
+ */
+func (p *Parser) GetFunctionHooks() []string {
+	ids := make([]string, 0, len(p.mFunctionHooks))
+	for id := range p.mFunctionHooks {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+/**
+ * Get the ParserOptions object
+ *
+ * @return ParserOptions
+ */
+func (p *Parser) GetOptions() *ParserOptions {
+	return p.mOptions
+}
+
+/**
+ * Get a language object for use in parser functions such as {{FORMATNUM:}}
+ * @return Language
+ */
+func (p *Parser) GetFunctionLang() *languages.Language {
+	if p.mOptions != nil && p.mOptions.GetUserLangObj() != nil {
+		return p.mOptions.GetUserLangObj()
+	}
+	return languages.Factory("en")
+}
+
+/**
+ * Get the target language for the content being parsed. This is usually the
+ * language that the content is in.
+ *
+ * Pages have no language of their own yet, so the content language is used
+ * unless the options say otherwise.
+ *
+ * @since 1.19
+ *
+ * @return Language
+ */
+func (p *Parser) GetTargetLanguage() *languages.Language {
+	if p.mOptions != nil {
+		if target := p.mOptions.GetTargetLanguage(); target != nil {
+			return target
+		}
+		if p.mOptions.GetInterfaceMessage() {
+			return p.GetFunctionLang()
+		}
+	}
+	if code, ok := globals.GLOBALS["wgLanguageCode"].(string); ok && code != "" {
+		return languages.Factory(code)
+	}
+	return languages.Factory("en")
+}
+
+/**
+ * Accessor for the Title object
+ *
+ * @return Title|null
+ */
+func (p *Parser) GetTitle() ITitle {
+	return p.mTitle
+}
+
+/**
+ * @return GenderCache
+ */
+func (p *Parser) GetGenderCache() IGenderCache {
+	return p.mGenderCache
+}
+
+/**
+ * Convert wikitext to HTML
+ * Do not call this function recursively.
+ *
+ * Only variables and parser functions are expanded so far, after which the
+ * text is converted to the variant in the options, following its -{ }-
+ * markup.
+ *
+ * @param string $text Text we want to parse
+ * @param Title $title
+ * @param ParserOptions $options
+ * @return ParserOutput A ParserOutput
+ */
+func (p *Parser) Parse(text string, title ITitle, options *ParserOptions) *ParserOutput {
+	if options == nil {
+		options = NewParserOptions("", nil)
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.mOptions = options
+	p.mTitle = title
+	defer func() {
+		p.mOptions = nil
+		p.mTitle = nil
+	}()
+
+	text = p.replaceVariables(text)
+	if options.GetDisableContentConversion() || options.GetInterfaceMessage() {
+		return NewParserOutput(text)
+	}
+	lang := p.GetTargetLanguage()
+	variant := options.GetVariant()
+	if variant == "" {
+		variant = lang.GetPreferredVariant(nil, nil)
+	}
+	text, titleText := lang.GetConverter().ConvertWithRuleTitle(text, variant)
+	output := NewParserOutput(text)
+	if titleText != "" {
+		output.SetTitleText(titleText)
+	} else if title != nil {
+		output.SetTitleText(lang.ConvertTitle("", title.GetPrefixedText(), variant))
+	}
+	return output
+}
+
+/**
+ * Wrapper for preprocess()
+ *
+ * @param string $text The text to preprocess
+ * @param ParserOptions $options
+ * @param Title|null $title Title object or null to use $wgTitle
+ * @return string
+ */
+func (p *Parser) TransformMsg(text string, options *ParserOptions, title ITitle) string {
+	if !strings.Contains(text, "{{") {
+		return text
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.mOptions = options
+	p.mTitle = title
+	defer func() {
+		p.mOptions = nil
+		p.mTitle = nil
+	}()
+	return p.replaceVariables(text)
+}
+
+/**
+ * Replace magic variables, templates, and template arguments
+ * with the appropriate text.
+ *
+ * @param string $text The text to transform
+ * @return string
+ */
+func (p *Parser) replaceVariables(text string) string {
+	var out strings.Builder
+	for {
+		start := strings.Index(text, "{{")
+		if start < 0 {
+			break
+		}
+		end := findClosingBraces(text, start+2)
+		if end < 0 {
+			break
+		}
+		out.WriteString(text[:start])
+		out.WriteString(p.braceSubstitution(text[start+2 : end]))
+		text = text[end+2:]
+	}
+	out.WriteString(text)
+	return out.String()
+}
+
+/**
+ * Return the text of a template, after recursively
+ * replacing any variables or templates within the template.
+ *
+ * Templates are not transcluded here; anything which is not a registered
+ * function is returned as it is, with its arguments expanded.
+ *
+ * @param string $piece The parts of the template
+ * @return string
+ */
+func (p *Parser) braceSubstitution(piece string) string {
+	parts := splitTemplateArgs(piece)
+	colonPos := strings.Index(parts[0], ":")
+	if colonPos >= 0 {
+		id := strings.ToLower(strings.TrimSpace(parts[0][:colonPos]))
+		if callback, ok := p.mFunctionHooks[id]; ok {
+			args := make([]string, 0, len(parts))
+			args = append(args, strings.TrimSpace(p.replaceVariables(parts[0][colonPos+1:])))
+			for _, part := range parts[1:] {
+				args = append(args, strings.TrimSpace(p.replaceVariables(part)))
+			}
+			return callback(p, args)
+		}
+	}
+	if len(parts) == 1 {
+		if value, ok := p.getVariableValue(strings.TrimSpace(parts[0])); ok {
+			return value
+		}
+	}
+	return "{{" + p.replaceVariables(piece) + "}}"
+}
+
+/**
+ * Return value of a magic variable (like PAGENAME)
+ *
+ * @param string $index Magic variable identifier
+ * @return string|bool The value, or false if it is not a variable
+ */
+func (p *Parser) getVariableValue(index string) (string, bool) {
+	switch index {
+	case "PAGENAME":
+		if p.mTitle == nil {
+			return "NO TITLE", true
+		}
+		return p.mTitle.GetText(), true
+	case "FULLPAGENAME":
+		if p.mTitle == nil {
+			return "NO TITLE", true
+		}
+		return p.mTitle.GetPrefixedText(), true
+	}
+	return "", false
+}
+
+/**
+ * Find the "}}" closing the "{{" which ends just before $offset
+ *
+ * @param string $text
+ * @param int $offset
+ * @return int Position of the closing braces, or -1
+ */
+func findClosingBraces(text string, offset int) int {
+	depth := 1
+	for i := offset; i < len(text)-1; {
+		switch text[i : i+2] {
+		case "{{":
+			depth++
+			i += 2
+		case "}}":
+			depth--
+			if depth == 0 {
+				return i
+			}
+			i += 2
+		default:
+			i++
+		}
+	}
+	return -1
+}
+
+/**
+ * Split the inside of a template on the pipes which are not part of a
+ * nested template or link
+ *
+ * @param string $piece
+ * @return array
+ */
+func splitTemplateArgs(piece string) []string {
+	var parts []string
+	depth, last := 0, 0
+	for i := 0; i < len(piece); i++ {
+		switch {
+		case strings.HasPrefix(piece[i:], "{{"), strings.HasPrefix(piece[i:], "[["):
+			depth++
+			i++
+		case strings.HasPrefix(piece[i:], "}}"), strings.HasPrefix(piece[i:], "]]"):
+			if depth > 0 {
+				depth--
+			}
+			i++
+		case piece[i] == '|' && depth == 0:
+			parts = append(parts, piece[last:i])
+			last = i + 1
+		}
+	}
+	return append(parts, piece[last:])
+}
@@ -0,0 +1,159 @@
+/**
+ * Options for the PHP parser
+ */
+package parser
+
+import "github.com/MangoDowner/mediawiki/includes/languages"
+
+/**
+ * @brief Set options of the Parser
+ *
+ * How to add an option in core:
+ *  1. Add it to one of the arrays in ParserOptions::setDefaults()
+ *  2. If necessary, add an entry to ParserOptions::$inCacheKey
+ *  3. Add a getter and setter in the section for that.
+ *
+ * @ingroup Parser
+ */
+type ParserOptions struct {
+	/**
+	 * Stored user object
+	 * @var User
+	 */
+	mUser string
+
+	/**
+	 * Language object of the user language
+	 * @var Language
+	 */
+	mUserLang *languages.Language
+
+	/**
+	 * Parsing an interface message?
+	 * @var bool
+	 */
+	mInterfaceMessage bool
+
+	/**
+	 * Target language for the parse
+	 * @var Language|null
+	 */
+	mTargetLanguage *languages.Language
+
+	/**
+	 * Whether content conversion should be disabled
+	 * @var bool
+	 */
+	mDisableContentConversion bool
+
+	/**
+	 * Language variant the output is converted to
+	 * @var string
+	 */
+	mVariant string
+}
+
+/**
+ * @param User|null $user
+ * @param Language|null $lang
+ */
+func NewParserOptions(user string, lang *languages.Language) *ParserOptions {
+	this := new(ParserOptions)
+	this.mUser = user
+	this.mUserLang = lang
+	return this
+}
+
+/**
+ * Current user
+ * @return User
+ */
+func (p *ParserOptions) GetUser() string {
+	return p.mUser
+}
+
+/**
+ * Get the user language used by the parser for this page and split the parser cache.
+ *
+ * @return Language
+ */
+func (p *ParserOptions) GetUserLangObj() *languages.Language {
+	return p.mUserLang
+}
+
+/**
+ * Parsing an interface message?
+ * @return bool
+ */
+func (p *ParserOptions) GetInterfaceMessage() bool {
+	return p.mInterfaceMessage
+}
+
+/**
+ * Parsing an interface message?
+ * @param bool|null $x New value (null is no change)
+ * @return bool Old value
+ */
+func (p *ParserOptions) SetInterfaceMessage(x bool) bool {
+	old := p.mInterfaceMessage
+	p.mInterfaceMessage = x
+	return old
+}
+
+/**
+ * Target language for the parse
+ * @return Language|null
+ */
+func (p *ParserOptions) GetTargetLanguage() *languages.Language {
+	return p.mTargetLanguage
+}
+
+/**
+ * Target language for the parse
+ * @param Language|null $x New value
+ * @return Language|null Old value
+ */
+func (p *ParserOptions) SetTargetLanguage(x *languages.Language) *languages.Language {
+	old := p.mTargetLanguage
+	p.mTargetLanguage = x
+	return old
+}
+
+/**
+ * Whether content conversion should be disabled
+ * @return bool
+ */
+func (p *ParserOptions) GetDisableContentConversion() bool {
+	return p.mDisableContentConversion
+}
+
+/**
+ * Whether content conversion should be disabled
+ * @param bool|null $x New value (null is no change)
+ * @return bool Old value
+ */
+func (p *ParserOptions) SetDisableContentConversion(x bool) bool {
+	old := p.mDisableContentConversion
+	p.mDisableContentConversion = x
+	return old
+}
+
+/**
+ * Language variant the output is converted to, usually the one preferred
+ * by the reader. The main language code is used if empty.
+ * @return string
+ */
+func (p *ParserOptions) GetVariant() string {
+	return p.mVariant
+}
+
+/**
+ * Language variant the output is converted to
+ * @param string $x New value
+ * @return string Old value
+ */
+func (p *ParserOptions) SetVariant(x string) string {
+	old := p.mVariant
+	p.mVariant = x
+	return old
+}
@@ -0,0 +1,54 @@
+/**
+ * Output of the PHP parser.
+ */
+package parser
+
+/**
+ * @ingroup Parser
+ */
+type ParserOutput struct {
+	/**
+	 * @var string $mText The output text
+	 */
+	mText string
+
+	/**
+	 * @var string $mTitleText Title text of the chosen language variant, as HTML.
+	 */
+	mTitleText string
+}
+
+/**
+ * @param string $text
+ */
+func NewParserOutput(text string) *ParserOutput {
+	this := new(ParserOutput)
+	this.mText = text
+	return this
+}
+
+/**
+ * Get the output HTML
+ *
+ * @return string HTML
+ */
+func (p *ParserOutput) GetText() string {
+	return p.mText
+}
+
+/**
+ * @return string
+ */
+func (p *ParserOutput) GetTitleText() string {
+	return p.mTitleText
+}
+
+/**
+ * @param string $t
+ * @return string Old value
+ */
+func (p *ParserOutput) SetTitleText(t string) string {
+	old := p.mTitleText
+	p.mTitleText = t
+	return old
+}
@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/includes/languages"
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * @covers Parser::setHook
+ * @covers Parser::getTags
+ */
+func TestSetHook(t *testing.T) {
+	parser := NewParser(nil)
+	hook := func(input *string, args map[string]string, parser *Parser) string { return "" }
+	test.AssetTrue(parser.SetHook("Ref", hook) == nil, `No previous hook`)
+	test.AssetTrue(parser.SetHook("gallery", hook) == nil, `No previous hook`)
+	test.AssetTrue(parser.SetHook("ref", hook) != nil, `Tags are case-insensitive`)
+	test.AssetEqual("[gallery ref]", fmt.Sprint(parser.GetTags()), `Sorted tags`)
+
+	defer func() {
+		test.AssetTrue(strings.Contains(fmt.Sprint(recover()), "Invalid character"), `Invalid tag name`)
+	}()
+	parser.SetHook("<ref>", hook)
+}
+
+type dummyTitle struct {
+	text string
+}
+
+func (t *dummyTitle) GetText() string {
+	return t.text
+}
+
+func (t *dummyTitle) GetPrefixedText() string {
+	return t.text
+}
+
+/**
+ * @covers Parser::parse
+ */
+func TestParseLanguageConversion(t *testing.T) {
+	parser := NewParser(nil)
+	options := NewParserOptions("", nil)
+	options.SetTargetLanguage(languages.Factory("zh"))
+	options.SetVariant("zh-tw")
+
+	output := parser.Parse("-{zh-hans:内存;zh-hant:記憶體;}-", &dummyTitle{"这个"}, options)
+	test.AssertEqual(t, "記憶體", output.GetText(), `Conversion markup`)
+	test.AssertEqual(t, "這個", output.GetTitleText(), `Converted title`)
+
+	output = parser.Parse("-{T|zh-cn:标题;zh-tw:標題}-{{PAGENAME}}", &dummyTitle{"这个"}, options)
+	test.AssertEqual(t, "這個", output.GetText(), `Variables are expanded before the conversion`)
+	test.AssertEqual(t, "標題", output.GetTitleText(), `Title rule`)
+
+	options.SetDisableContentConversion(true)
+	output = parser.Parse("-{zh-hans:内存;zh-hant:記憶體;}-", nil, options)
+	test.AssertEqual(t, "-{zh-hans:内存;zh-hant:記憶體;}-", output.GetText(), `Conversion disabled`)
+	test.AssertEqual(t, "", output.GetTitleText(), `No title text without conversion`)
+
+	options.SetDisableContentConversion(false)
+	options.SetInterfaceMessage(true)
+	output = parser.Parse("-{这个}-", nil, options)
+	test.AssertEqual(t, "-{这个}-", output.GetText(), `Interface messages are not converted`)
+}
@@ -3,6 +3,7 @@ package php
 import (
 	"html"
 	"net/url"
+	"strings"
 )
 
 /**
@@ -28,6 +29,22 @@ func Urlencode(str string) string {
 	return url.QueryEscape(str)
 }
 
+/**
+ * URL-encode according to RFC 3986
+ * @link http://php.net/manual/en/function.rawurlencode.php
+ * @param string $str <p>
+ * The URL to be encoded.
+ * </p>
+ * @return string a string in which all non-alphanumeric characters except
+ * -_.~ have been replaced with a percent
+ * (%) sign followed by two hex digits.
+ * @since 4.0
+ * @since 5.0
+ */
+func Rawurlencode(str string) string {
+	return strings.Replace(url.QueryEscape(str), "+", "%20", -1)
+}
+
 /**
  * Decodes URL-encoded string
  * @link http://php.net/manual/en/function.urldecode.php
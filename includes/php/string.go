@@ -1,6 +1,9 @@
 package php
 
-import "strings"
+import (
+	"sort"
+	"strings"
+)
 
 /**
  * @param string $str The string being translated.
@@ -8,10 +11,25 @@ import "strings"
  * @return string A copy of str, translating all occurrences of each character in from to the corresponding character in to.
  */
 func Strtr(content string, replaces map[string]string) string {
-	for old, new := range replaces {
-		content = strings.ReplaceAll(content, old, new)
+	// The longest keys are tried first and replaced text is never searched
+	// again, so that pairs such as "," => "." and "." => "," swap characters
+	keys := make([]string, 0, len(replaces))
+	for old := range replaces {
+		if old != "" {
+			keys = append(keys, old)
+		}
 	}
-	return content
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	oldNew := make([]string, 0, 2*len(keys))
+	for _, old := range keys {
+		oldNew = append(oldNew, old, replaces[old])
+	}
+	return strings.NewReplacer(oldNew...).Replace(content)
 }
 
 /**
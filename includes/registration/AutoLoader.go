@@ -0,0 +1,73 @@
+package registration
+
+import "sync"
+
+/**
+ * Go cannot load code from a class or function name the way PHP does, so
+ * extensions register the functions and classes their manifests refer to,
+ * typically from init():
+ *
+ * @code
+ *     func init() {
+ *         registration.RegisterFunction("FooHooks::onBeforeInitialize", onBeforeInitialize)
+ *         registration.RegisterClass("SpecialFoo", func() includes.ISpecialPage { return NewSpecialFoo() })
+ *     }
+ * @endcode
+ *
+ * @var array Map of (function name => function)
+ */
+var functions = make(map[string]interface{})
+
+/**
+ * @var array Map of (class name => instance or constructor)
+ */
+var classes = make(map[string]interface{})
+var autoLoaderMutex sync.RWMutex
+
+/**
+ * Register a function that manifests can name as hook handler or callback
+ *
+ * @param string $name Name of the function, e.g. "FooHooks::onBeforeInitialize"
+ * @param callable $function
+ */
+func RegisterFunction(name string, function interface{}) {
+	autoLoaderMutex.Lock()
+	defer autoLoaderMutex.Unlock()
+	functions[name] = function
+}
+
+/**
+ * @param string $name
+ * @return callable|null The function, and whether it is registered
+ */
+func GetFunction(name string) (interface{}, bool) {
+	autoLoaderMutex.RLock()
+	defer autoLoaderMutex.RUnlock()
+	function, ok := functions[name]
+	return function, ok
+}
+
+/**
+ * Register a class that manifests can name, e.g. as special page or
+ * content handler. What $class is depends on the use of the class: an
+ * instance, or a function constructing one.
+ *
+ * @param string $name Name of the class, e.g. "SpecialFoo"
+ * @param mixed $class
+ */
+func RegisterClass(name string, class interface{}) {
+	autoLoaderMutex.Lock()
+	defer autoLoaderMutex.Unlock()
+	classes[name] = class
+}
+
+/**
+ * @param string $name
+ * @return mixed|null The class, and whether it is registered
+ */
+func GetClass(name string) (interface{}, bool) {
+	autoLoaderMutex.RLock()
+	defer autoLoaderMutex.RUnlock()
+	class, ok := classes[name]
+	return class, ok
+}
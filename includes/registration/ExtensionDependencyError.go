@@ -0,0 +1,85 @@
+package registration
+
+import (
+	"errors"
+	"strings"
+)
+
+/**
+ * The dependencies of the extensions which are not satisfied, all of them
+ *
+ * @since 1.31
+ */
+type ExtensionDependencyError struct {
+	err error
+
+	/**
+	 * @var DependencyError[]
+	 */
+	Errors []*DependencyError
+
+	/**
+	 * @var string[]
+	 */
+	MissingExtensions []string
+
+	/**
+	 * @var string[]
+	 */
+	MissingSkins []string
+
+	/**
+	 * @var string[]
+	 */
+	IncompatibleExtensions []string
+
+	/**
+	 * @var string[]
+	 */
+	IncompatibleSkins []string
+
+	/**
+	 * @var bool
+	 */
+	IncompatibleCore bool
+
+	/**
+	 * @var bool
+	 */
+	IncompatibleGo bool
+}
+
+/**
+ * @param DependencyError[] $errors Each error from VersionChecker::checkArray()
+ */
+func NewExtensionDependencyError(errs []*DependencyError) *ExtensionDependencyError {
+	this := new(ExtensionDependencyError)
+	this.Errors = errs
+	msgs := make([]string, 0, len(errs))
+	for _, info := range errs {
+		msgs = append(msgs, info.Msg)
+		switch info.Type {
+		case "incompatible-core":
+			this.IncompatibleCore = true
+		case "incompatible-go":
+			this.IncompatibleGo = true
+		case "missing-extensions":
+			this.MissingExtensions = append(this.MissingExtensions, info.Missing)
+		case "missing-skins":
+			this.MissingSkins = append(this.MissingSkins, info.Missing)
+		case "incompatible-extensions":
+			this.IncompatibleExtensions = append(this.IncompatibleExtensions, info.Incompatible)
+		case "incompatible-skins":
+			this.IncompatibleSkins = append(this.IncompatibleSkins, info.Incompatible)
+		}
+	}
+	this.err = errors.New(strings.Join(msgs, "\n"))
+	return this
+}
+
+/**
+ * @return string
+ */
+func (e *ExtensionDependencyError) Error() string {
+	return e.err.Error()
+}
@@ -0,0 +1,312 @@
+package registration
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+/**
+ * Description of a property of extension.json and skin.json
+ */
+type schemaProperty struct {
+	/** @var string[] JSON types of the value: string, integer, boolean, object or array */
+	types []string
+	/** @var string JSON types of the values of an object or the elements of an array, if checked */
+	items []string
+	/** @var int Oldest manifest version having the property */
+	since int
+}
+
+/**
+ * Schema of the manifests, common to the versions unless noted. Go has no
+ * autoloader nor PHP entry points, so the keys about these are accepted
+ * and ignored.
+ * @var array Map of (key => schemaProperty)
+ */
+var manifestSchema = map[string]*schemaProperty{
+	"manifest_version": {types: []string{"integer"}},
+	"name":             {types: []string{"string"}},
+	"namemsg":          {types: []string{"string"}},
+	"type":             {types: []string{"string"}},
+	"version":          {types: []string{"string"}},
+	"author":           {types: []string{"string", "array"}, items: []string{"string"}},
+	"url":              {types: []string{"string"}},
+	"description":      {types: []string{"string"}},
+	"descriptionmsg":   {types: []string{"string"}},
+	"license-name":     {types: []string{"string"}},
+	"requires":         {types: []string{"object"}, items: []string{"string", "object"}},
+	"callback":         {types: []string{"string"}},
+
+	"Hooks":                   {types: []string{"object"}, items: []string{"string", "array"}},
+	"SpecialPages":            {types: []string{"object"}, items: []string{"string"}},
+	"Actions":                 {types: []string{"object"}, items: []string{"string", "boolean"}},
+	"MessagesDirs":            {types: []string{"object"}, items: []string{"string", "array"}},
+	"namespaces":              {types: []string{"array"}, items: []string{"object"}},
+	"ResourceModules":         {types: []string{"object"}, items: []string{"object"}},
+	"ResourceFileModulePaths": {types: []string{"object"}, items: []string{"string"}},
+	"ContentHandlers":         {types: []string{"object"}, items: []string{"string"}},
+	"ValidSkinNames":          {types: []string{"object"}, items: []string{"string", "object"}},
+	"ConfigRegistry":          {types: []string{"object"}, items: []string{"string"}},
+
+	"config":                   {types: []string{"object"}},
+	"config_prefix":            {types: []string{"string"}, since: 2},
+	"attributes":               {types: []string{"object"}, items: []string{"object"}, since: 2},
+	"AutoloadClasses":          {types: []string{"object"}},
+	"AutoloadNamespaces":       {types: []string{"object"}},
+	"ExtensionMessagesFiles":   {types: []string{"object"}},
+	"load_composer_autoloader": {types: []string{"boolean"}},
+}
+
+/**
+ * Merge strategies of the config settings
+ * @var string[]
+ */
+var mergeStrategies = []string{"array_merge", "array_plus", "array_plus_2d", "array_replace_recursive", "provide_default"}
+
+/**
+ * Validate extension.json and skin.json files against the schema
+ *
+ * @since 1.29
+ */
+type ExtensionJsonValidator struct {
+}
+
+func NewExtensionJsonValidator() *ExtensionJsonValidator {
+	this := new(ExtensionJsonValidator)
+	return this
+}
+
+/**
+ * @param array $info Decoded manifest
+ * @param int $version Manifest version
+ * @return string[] Errors, of the form "key: message"; empty if the manifest is valid
+ */
+func (v *ExtensionJsonValidator) Validate(info map[string]interface{}, version int) (errors []string) {
+	if name, ok := info["name"].(string); !ok || name == "" {
+		errors = append(errors, "name: The property name is required")
+	}
+
+	keys := make([]string, 0, len(info))
+	for key := range info {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if strings.HasPrefix(key, "@") {
+			// Comments
+			continue
+		}
+		property, ok := manifestSchema[key]
+		if !ok || property.since > version {
+			if version >= 2 {
+				errors = append(errors, fmt.Sprintf("%s: The property %s is not defined and the definition "+
+					"does not allow additional properties", key, key))
+			}
+			// Version 1 manifests have attributes at the top level
+			continue
+		}
+		errors = append(errors, v.validateProperty(key, info[key], property)...)
+	}
+
+	if config, ok := info["config"].(map[string]interface{}); ok {
+		errors = append(errors, v.validateConfig(config, version)...)
+	}
+	if namespaces, ok := info["namespaces"].([]interface{}); ok {
+		for i, ns := range namespaces {
+			ns, _ := ns.(map[string]interface{})
+			errors = append(errors, v.validateRequired(fmt.Sprintf("namespaces[%d]", i), ns, map[string]string{
+				"id": "integer", "constant": "string", "name": "string",
+			})...)
+		}
+	}
+	return errors
+}
+
+/**
+ * @param string $key
+ * @param mixed $value
+ * @param schemaProperty $property
+ * @return string[] Errors
+ */
+func (v *ExtensionJsonValidator) validateProperty(key string, value interface{}, property *schemaProperty) (errors []string) {
+	if !hasType(value, property.types) {
+		return []string{fmt.Sprintf("%s: %s value found, but %s is required",
+			key, jsonType(value), strings.Join(property.types, " or "))}
+	}
+	if property.items == nil {
+		return nil
+	}
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for _, name := range sortedKeys(value) {
+			errors = append(errors, v.validateItem(key+"."+name, value[name], property.items)...)
+		}
+	case []interface{}:
+		for i, item := range value {
+			errors = append(errors, v.validateItem(fmt.Sprintf("%s[%d]", key, i), item, property.items)...)
+		}
+	}
+	return errors
+}
+
+/**
+ * @param string $key
+ * @param mixed $value
+ * @param string[] $types
+ * @return string[] Errors
+ */
+func (v *ExtensionJsonValidator) validateItem(key string, value interface{}, types []string) []string {
+	if !hasType(value, types) {
+		return []string{fmt.Sprintf("%s: %s value found, but %s is required",
+			key, jsonType(value), strings.Join(types, " or "))}
+	}
+	return nil
+}
+
+/**
+ * @param array $config The "config" property
+ * @param int $version Manifest version
+ * @return string[] Errors
+ */
+func (v *ExtensionJsonValidator) validateConfig(config map[string]interface{}, version int) (errors []string) {
+	for _, name := range sortedKeys(config) {
+		key := "config." + name
+		if version == 1 {
+			if name == "_prefix" {
+				if _, ok := config[name].(string); !ok {
+					errors = append(errors, key+": The prefix must be a string")
+				}
+				continue
+			}
+			if value, ok := config[name].(map[string]interface{}); ok {
+				errors = append(errors, v.validateMergeStrategy(key+"."+MERGE_STRATEGY, value[MERGE_STRATEGY])...)
+			}
+			continue
+		}
+
+		setting, ok := config[name].(map[string]interface{})
+		if !ok {
+			errors = append(errors, fmt.Sprintf("%s: %s value found, but object is required", key, jsonType(config[name])))
+			continue
+		}
+		if _, ok := setting["value"]; !ok {
+			errors = append(errors, key+": The property value is required")
+		}
+		errors = append(errors, v.validateMergeStrategy(key+".merge_strategy", setting["merge_strategy"])...)
+		if path, ok := setting["path"]; ok {
+			if _, ok := path.(bool); !ok {
+				errors = append(errors, key+".path: The property path must be a boolean")
+			}
+		}
+	}
+	return errors
+}
+
+/**
+ * @param string $key
+ * @param string|null $strategy
+ * @return string[] Errors
+ */
+func (v *ExtensionJsonValidator) validateMergeStrategy(key string, strategy interface{}) []string {
+	if strategy == nil {
+		return nil
+	}
+	name, _ := strategy.(string)
+	for _, known := range mergeStrategies {
+		if name == known {
+			return nil
+		}
+	}
+	return []string{fmt.Sprintf("%s: Unknown merge strategy \"%v\", expected one of %s",
+		key, strategy, strings.Join(mergeStrategies, ", "))}
+}
+
+/**
+ * @param string $key
+ * @param array $value
+ * @param array $required Map of (property => JSON type)
+ * @return string[] Errors
+ */
+func (v *ExtensionJsonValidator) validateRequired(key string, value map[string]interface{},
+	required map[string]string) (errors []string) {
+	if value == nil {
+		return []string{key + ": object value is required"}
+	}
+	for _, name := range sortedKeys(toInterfaceMap(required)) {
+		item, ok := value[name]
+		if !ok {
+			errors = append(errors, fmt.Sprintf("%s: The property %s is required", key, name))
+		} else if !hasType(item, []string{required[name]}) {
+			errors = append(errors, fmt.Sprintf("%s.%s: %s value found, but %s is required",
+				key, name, jsonType(item), required[name]))
+		}
+	}
+	return errors
+}
+
+/**
+ * @param mixed $value Decoded JSON value
+ * @param string[] $types
+ * @return bool
+ */
+func hasType(value interface{}, types []string) bool {
+	valueType := jsonType(value)
+	for _, t := range types {
+		if t == valueType || (t == "number" && valueType == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * @param mixed $value Decoded JSON value
+ * @return string JSON type of the value
+ */
+func jsonType(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+/**
+ * @param array $m
+ * @return string[] The keys of $m, sorted
+ */
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+/**
+ * @param array $m
+ * @return array
+ */
+func toInterfaceMap(m map[string]string) map[string]interface{} {
+	res := make(map[string]interface{}, len(m))
+	for key, value := range m {
+		res[key] = value
+	}
+	return res
+}
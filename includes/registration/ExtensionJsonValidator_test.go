@@ -0,0 +1,47 @@
+package registration
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * @covers ExtensionJsonValidator::validate
+ */
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		manifest string
+		version  int
+		expected string
+	}{
+		{`{"name": "Foo", "@note": "comments are allowed"}`, 2, `[]`},
+		{`{"name": "Foo", "FooAttribute": [1]}`, 1, `[]`},
+		{`{"name": "Foo", "FooAttribute": [1]}`, 2,
+			`[FooAttribute: The property FooAttribute is not defined and the definition does not allow additional properties]`},
+		{`{"name": "Foo", "config_prefix": "eg"}`, 1, `[]`},
+		{`{"author": 1}`, 2, `[name: The property name is required author: integer value found, but string or array is required]`},
+		{`{"name": "Foo", "Hooks": {"Bar": 1}}`, 2, `[Hooks.Bar: integer value found, but string or array is required]`},
+		{`{"name": "Foo", "config": {"_prefix": "eg", "Bar": {"a": 1, "_merge_strategy": "array_plus"}}}`, 1, `[]`},
+		{`{"name": "Foo", "config": {"Bar": {"a": 1, "_merge_strategy": "array_minus"}}}`, 1,
+			`[config.Bar._merge_strategy: Unknown merge strategy "array_minus", expected one of ` +
+				`array_merge, array_plus, array_plus_2d, array_replace_recursive, provide_default]`},
+		{`{"name": "Foo", "config": {"Bar": {"value": 1, "path": false}}}`, 2, `[]`},
+		{`{"name": "Foo", "config": {"Bar": 1, "Baz": {"path": "yes"}}}`, 2,
+			`[config.Bar: integer value found, but object is required config.Baz: The property value is required ` +
+				`config.Baz.path: The property path must be a boolean]`},
+		{`{"name": "Foo", "namespaces": [{"id": 3000, "constant": "NS_FOO", "name": "Foo"}]}`, 2, `[]`},
+		{`{"name": "Foo", "namespaces": [{"id": 1.5, "name": "Foo"}]}`, 2,
+			`[namespaces[0]: The property constant is required namespaces[0].id: number value found, but integer is required]`},
+	}
+	validator := NewExtensionJsonValidator()
+	for _, c := range cases {
+		var info map[string]interface{}
+		if err := json.Unmarshal([]byte(c.manifest), &info); err != nil {
+			t.Fatal(err)
+		}
+		test.AssetEqual(c.expected, fmt.Sprint(validator.Validate(info, c.version)), c.manifest)
+	}
+}
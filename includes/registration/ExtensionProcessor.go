@@ -0,0 +1,389 @@
+package registration
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+/**
+ * What the manifests of the loaded extensions and skins define, in the
+ * form it is cached
+ */
+type ExtractedInfo struct {
+	/** @var array Map of (global name => value) */
+	Globals map[string]interface{} `json:"globals"`
+	/** @var array Map of (global name => merge strategy), for the globals not using array_merge */
+	MergeStrategies map[string]string `json:"mergeStrategies"`
+	/** @var array Map of (attribute name => value) */
+	Attributes map[string]interface{} `json:"attributes"`
+	/** @var array Map of (extension name => credits) */
+	Credits map[string]map[string]interface{} `json:"credits"`
+	/** @var array Map of (extension name => function name) of the callbacks to run once loaded */
+	Callbacks map[string]string `json:"callbacks"`
+}
+
+/**
+ * Keys of the manifests which are globals of the same name, with the
+ * prefix "wg", merged with array_merge
+ * @var string[]
+ */
+var globalSettings = []string{"SpecialPages", "Actions", "ContentHandlers", "ValidSkinNames", "ConfigRegistry"}
+
+/**
+ * Extracts the information of the manifests, to be exported by ExtensionRegistry
+ *
+ * @since 1.25
+ */
+type ExtensionProcessor struct {
+	info *ExtractedInfo
+	/** @var array Map of (config global name => extension which set it) */
+	configOwners map[string]string
+}
+
+func NewExtensionProcessor() *ExtensionProcessor {
+	this := new(ExtensionProcessor)
+	this.info = &ExtractedInfo{
+		Globals:         make(map[string]interface{}),
+		MergeStrategies: make(map[string]string),
+		Attributes:      make(map[string]interface{}),
+		Credits:         make(map[string]map[string]interface{}),
+		Callbacks:       make(map[string]string),
+	}
+	this.configOwners = make(map[string]string)
+	return this
+}
+
+/**
+ * @param string $path Absolute path of the JSON file
+ * @param array $info Decoded and validated manifest
+ * @param int $version Manifest version
+ * @return error If the manifest refers to unknown functions or classes, or
+ *  conflicts with the manifests processed before
+ */
+func (e *ExtensionProcessor) ExtractInfo(path string, info map[string]interface{}, version int) error {
+	dir := filepath.Dir(path)
+	name := info["name"].(string)
+	if credits, ok := e.info.Credits[name]; ok {
+		return fmt.Errorf("It was attempted to load %s twice, from %s and %s.", name, credits["path"], path)
+	}
+
+	steps := []func() error{
+		func() error { return e.extractCredits(path, info) },
+		func() error { return e.extractHooks(info) },
+		func() error { return e.extractGlobalSettings(info) },
+		func() error { return e.extractMessagesDirs(dir, info) },
+		func() error { return e.extractNamespaces(info) },
+		func() error { return e.extractResourceModules(dir, info) },
+		func() error { return e.extractConfig(name, dir, info, version) },
+		func() error { return e.extractAttributes(info, version) },
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	if callback, ok := info["callback"].(string); ok {
+		if _, ok := GetFunction(callback); !ok {
+			return fmt.Errorf("%s: callback %s is not registered", path, callback)
+		}
+		e.info.Callbacks[name] = callback
+	}
+	return nil
+}
+
+/**
+ * @return ExtractedInfo
+ */
+func (e *ExtensionProcessor) GetExtractedInfo() *ExtractedInfo {
+	return e.info
+}
+
+/**
+ * Get the requirements for the provided info
+ *
+ * @since 1.26
+ * @param array $info
+ * @return array Where keys are the name to have a constraint on,
+ * 		like 'MediaWiki'. Values are a constraint string like "1.26.1".
+ */
+func (e *ExtensionProcessor) GetRequirements(info map[string]interface{}) map[string]interface{} {
+	requires, _ := info["requires"].(map[string]interface{})
+	return requires
+}
+
+/**
+ * @param string $path
+ * @param array $info
+ */
+func (e *ExtensionProcessor) extractCredits(path string, info map[string]interface{}) error {
+	credits := map[string]interface{}{"path": path, "type": "extension"}
+	for _, key := range []string{"name", "namemsg", "type", "version", "author", "url", "description",
+		"descriptionmsg", "license-name"} {
+		if value, ok := info[key]; ok {
+			credits[key] = value
+		}
+	}
+	e.info.Credits[info["name"].(string)] = credits
+	return nil
+}
+
+/**
+ * Hook handlers are kept in the Hooks attribute, which HookContainer runs
+ *
+ * @param array $info
+ */
+func (e *ExtensionProcessor) extractHooks(info map[string]interface{}) error {
+	hooks, _ := info["Hooks"].(map[string]interface{})
+	for _, name := range sortedKeys(hooks) {
+		var handlers []interface{}
+		switch value := hooks[name].(type) {
+		case string:
+			handlers = []interface{}{value}
+		case []interface{}:
+			handlers = value
+		}
+		for _, handler := range handlers {
+			function, ok := handler.(string)
+			if !ok {
+				return fmt.Errorf("handler of hook %s is not a function name", name)
+			}
+			if _, ok := GetFunction(function); !ok {
+				return fmt.Errorf("handler %s of hook %s is not registered", function, name)
+			}
+		}
+		e.storeToArray("Hooks", map[string]interface{}{name: handlers}, e.info.Attributes)
+	}
+	return nil
+}
+
+/**
+ * @param array $info
+ */
+func (e *ExtensionProcessor) extractGlobalSettings(info map[string]interface{}) error {
+	for _, key := range globalSettings {
+		values, ok := info[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if key == "ConfigRegistry" {
+			for name, builder := range values {
+				if builder, ok := builder.(string); ok {
+					if _, ok := GetFunction(builder); !ok {
+						return fmt.Errorf("function %s of %s %s is not registered", builder, key, name)
+					}
+				}
+			}
+		} else if key != "ValidSkinNames" {
+			for name, class := range values {
+				if class, ok := class.(string); ok {
+					if _, ok := GetClass(class); !ok {
+						return fmt.Errorf("class %s of %s %s is not registered", class, key, name)
+					}
+				}
+			}
+		}
+		e.storeToArray("wg"+key, values, e.info.Globals)
+	}
+	return nil
+}
+
+/**
+ * @param string $dir Directory of the manifest
+ * @param array $info
+ */
+func (e *ExtensionProcessor) extractMessagesDirs(dir string, info map[string]interface{}) error {
+	messagesDirs, _ := info["MessagesDirs"].(map[string]interface{})
+	for _, name := range sortedKeys(messagesDirs) {
+		var paths []interface{}
+		switch value := messagesDirs[name].(type) {
+		case string:
+			paths = []interface{}{value}
+		case []interface{}:
+			paths = value
+		}
+		dirs := make([]interface{}, 0, len(paths))
+		for _, path := range paths {
+			path, ok := path.(string)
+			if !ok {
+				return fmt.Errorf("messages directory of %s is not a string", name)
+			}
+			dirs = append(dirs, filepath.Join(dir, path))
+		}
+		e.storeToArray("wgMessagesDirs", map[string]interface{}{name: dirs}, e.info.Globals)
+	}
+	return nil
+}
+
+/**
+ * Namespaces are added to the ExtensionNamespaces attribute unless they
+ * are conditional, in which case the extension registers them itself.
+ *
+ * @param array $info
+ */
+func (e *ExtensionProcessor) extractNamespaces(info map[string]interface{}) error {
+	namespaces, _ := info["namespaces"].([]interface{})
+	for _, ns := range namespaces {
+		ns := ns.(map[string]interface{})
+		id := strconv.Itoa(int(ns["id"].(float64)))
+		if conditional, _ := ns["conditional"].(bool); !conditional {
+			e.storeToArray("ExtensionNamespaces", map[string]interface{}{id: ns["name"]}, e.info.Attributes)
+		}
+		if protection, ok := ns["protection"]; ok {
+			e.storeToArray("wgNamespaceProtection", map[string]interface{}{id: protection}, e.info.Globals)
+		}
+		if subpages, _ := ns["subpages"].(bool); subpages {
+			e.storeToArray("wgNamespacesWithSubpages", map[string]interface{}{id: true}, e.info.Globals)
+		}
+		if content, _ := ns["content"].(bool); content {
+			e.storeToArray("wgContentNamespaces", []interface{}{ns["id"]}, e.info.Globals)
+		}
+		if model, ok := ns["defaultcontentmodel"].(string); ok {
+			e.storeToArray("wgNamespaceContentModels", map[string]interface{}{id: model}, e.info.Globals)
+		}
+	}
+	return nil
+}
+
+/**
+ * The localBasePath of the modules is made absolute, and defaults to
+ * the one of ResourceFileModulePaths.
+ *
+ * @param string $dir Directory of the manifest
+ * @param array $info
+ */
+func (e *ExtensionProcessor) extractResourceModules(dir string, info map[string]interface{}) error {
+	defaultPaths, _ := info["ResourceFileModulePaths"].(map[string]interface{})
+	modules, _ := info["ResourceModules"].(map[string]interface{})
+	for _, name := range sortedKeys(modules) {
+		module := make(map[string]interface{})
+		for key, value := range modules[name].(map[string]interface{}) {
+			module[key] = value
+		}
+		if _, hasClass := module["class"]; !hasClass {
+			for key, value := range defaultPaths {
+				if _, ok := module[key]; !ok {
+					module[key] = value
+				}
+			}
+		}
+		if localBasePath, ok := module["localBasePath"].(string); ok {
+			module["localBasePath"] = filepath.Join(dir, localBasePath)
+		}
+		e.storeToArray("wgResourceModules", map[string]interface{}{name: module}, e.info.Globals)
+	}
+	return nil
+}
+
+/**
+ * Set configuration settings, with their merge strategies
+ *
+ * @param string $extName Name of the extension
+ * @param string $dir Directory of the manifest
+ * @param array $info
+ * @param int $version Manifest version
+ */
+func (e *ExtensionProcessor) extractConfig(extName string, dir string, info map[string]interface{}, version int) error {
+	config, _ := info["config"].(map[string]interface{})
+	prefix := "wg"
+	if version == 1 {
+		if p, ok := config["_prefix"].(string); ok {
+			prefix = p
+		}
+	} else if p, ok := info["config_prefix"].(string); ok {
+		prefix = p
+	}
+
+	for _, name := range sortedKeys(config) {
+		if version == 1 && name == "_prefix" {
+			continue
+		}
+		value, strategy := config[name], ""
+		if version == 1 {
+			if m, ok := value.(map[string]interface{}); ok {
+				if s, ok := m[MERGE_STRATEGY].(string); ok {
+					strategy = s
+					value = withoutKey(m, MERGE_STRATEGY)
+				}
+			}
+		} else {
+			setting := value.(map[string]interface{})
+			value = setting["value"]
+			strategy, _ = setting["merge_strategy"].(string)
+			if path, _ := setting["path"].(bool); path {
+				if p, ok := value.(string); ok {
+					value = filepath.Join(dir, p)
+				}
+			}
+		}
+
+		key := prefix + name
+		if owner, ok := e.configOwners[key]; ok {
+			return fmt.Errorf("The configuration setting '%s' was already set by %s, and cannot be set again by %s.",
+				name, owner, extName)
+		}
+		e.configOwners[key] = extName
+		e.info.Globals[key] = value
+		if strategy != "" && strategy != "array_merge" {
+			e.info.MergeStrategies[key] = strategy
+		}
+	}
+	return nil
+}
+
+/**
+ * Attributes are the data extensions give to each other: for version 2,
+ * those under "attributes", by extension; for version 1, the top-level
+ * keys which are not part of the schema.
+ *
+ * @param array $info
+ * @param int $version Manifest version
+ */
+func (e *ExtensionProcessor) extractAttributes(info map[string]interface{}, version int) error {
+	if version == 1 {
+		for key, value := range info {
+			if _, known := manifestSchema[key]; !known && !strings.HasPrefix(key, "@") {
+				e.storeToArray(key, value, e.info.Attributes)
+			}
+		}
+		return nil
+	}
+	attributes, _ := info["attributes"].(map[string]interface{})
+	for _, extName := range sortedKeys(attributes) {
+		for name, value := range attributes[extName].(map[string]interface{}) {
+			e.storeToArray(extName+name, value, e.info.Attributes)
+		}
+	}
+	return nil
+}
+
+/**
+ * Stores $value to $array; merging with the value there, if any
+ *
+ * @param string $name
+ * @param mixed $value
+ * @param array &$array
+ */
+func (e *ExtensionProcessor) storeToArray(name string, value interface{}, array map[string]interface{}) {
+	if existing, ok := array[name]; ok {
+		array[name] = arrayMergeRecursive(existing, value)
+	} else {
+		array[name] = value
+	}
+}
+
+/**
+ * @param array $m
+ * @param string $key
+ * @return array Copy of $m without $key
+ */
+func withoutKey(m map[string]interface{}, key string) map[string]interface{} {
+	res := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if k != key {
+			res[k] = v
+		}
+	}
+	return res
+}
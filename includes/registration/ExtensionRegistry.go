@@ -1,5 +1,26 @@
 package registration
 
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	libobjectcache "github.com/MangoDowner/mediawiki/includes/libs/objectcache"
+	"github.com/MangoDowner/mediawiki/includes/objectcache"
+)
+
 /**
  * "requires" key that applies to MediaWiki core/$wgVersion
  */
@@ -32,8 +53,7 @@ const CACHE_VERSION = 7
  *
  * @since 1.26
  */
-const MERGE_STRATEGY = "_merge_strategy";
-
+const MERGE_STRATEGY = "_merge_strategy"
 
 /**
  * ExtensionRegistry class
@@ -50,14 +70,14 @@ type ExtensionRegistry struct {
 	 *
 	 * @var array
 	 */
-	loaded []string
+	loaded map[string]map[string]interface{}
 
 	/**
 	 * List of paths that should be loaded
 	 *
-	 * @var array
+	 * @var array Map of (path => mtime)
 	 */
-	queued []string
+	queued map[string]int64
 
 	/**
 	 * Whether we are done loading things
@@ -72,26 +92,630 @@ type ExtensionRegistry struct {
 	 *
 	 * @var array
 	 */
-	attributes map[string][]string
+	attributes map[string]interface{}
 
 	/**
-	 * @var ExtensionRegistry
+	 * @var BagOStuff|null Cache of the processed manifests, the local server cache if null
 	 */
-	instance *ExtensionRegistry
+	cache libobjectcache.IBagOStuff
+
+	mutex sync.RWMutex
+}
+
+/**
+ * @var ExtensionRegistry
+ */
+var instance *ExtensionRegistry
+var instanceMutex sync.Mutex
+
+/**
+ * @codeCoverageIgnore
+ * @return ExtensionRegistry
+ */
+func GetInstance() *ExtensionRegistry {
+	instanceMutex.Lock()
+	defer instanceMutex.Unlock()
+	if instance == nil {
+		instance = NewExtensionRegistry()
+	}
+	return instance
 }
 
 func NewExtensionRegistry() *ExtensionRegistry {
 	this := new(ExtensionRegistry)
+	this.loaded = make(map[string]map[string]interface{})
+	this.queued = make(map[string]int64)
+	this.attributes = make(map[string]interface{})
 	return this
 }
 
+/**
+ * Set the cache of the processed manifests
+ *
+ * @param BagOStuff $cache
+ */
+func (e *ExtensionRegistry) SetCache(cache libobjectcache.IBagOStuff) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.cache = cache
+}
+
+/**
+ * @param string $path Absolute path to the JSON file
+ * @throws MWException If the file cannot be read, or loading is finished
+ */
+func (e *ExtensionRegistry) Queue(path string) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.finished {
+		panic(exception.NewMWException(fmt.Sprintf("The following paths tried to load late: %s", path)))
+	}
+	stat, err := os.Stat(path)
+	if err != nil {
+		panic(exception.NewMWException(fmt.Sprintf("Unable to open file %s: %s", path, err)))
+	}
+	e.queued[path] = stat.ModTime().UnixNano()
+}
+
+/**
+ * Get the current load queue. Not intended to be used
+ * outside of the installer.
+ *
+ * @return array Map of (path => mtime)
+ */
+func (e *ExtensionRegistry) GetQueue() map[string]int64 {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	queue := make(map[string]int64, len(e.queued))
+	for path, mtime := range e.queued {
+		queue[path] = mtime
+	}
+	return queue
+}
+
+/**
+ * Clear the current load queue. Not intended to be used
+ * outside of the installer.
+ */
+func (e *ExtensionRegistry) ClearQueue() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.queued = make(map[string]int64)
+}
+
+/**
+ * After this is called, no more extensions can be loaded
+ *
+ * @since 1.29
+ */
+func (e *ExtensionRegistry) FinishLoading() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.finished = true
+}
+
+/**
+ * Load the queued manifests, from the cache if none changed since it was
+ * filled, and export what they define.
+ *
+ * @return error Listing all the problems of the manifests
+ */
+func (e *ExtensionRegistry) LoadFromQueue() error {
+	queue := e.GetQueue()
+	if len(queue) == 0 {
+		return nil
+	}
+
+	cache := e.getCache()
+	// The cache key is based on the paths and the mtimes of the files
+	key := cache.MakeGlobalKey("registration", e.getCacheKey(queue))
+	var data *ExtractedInfo
+	if cached, ok := cache.Get(key, 0); ok {
+		if text, ok := cached.(string); ok {
+			data = new(ExtractedInfo)
+			if json.Unmarshal([]byte(text), data) != nil {
+				data = nil
+			}
+		}
+	}
+	if data == nil {
+		var err error
+		if data, err = e.ReadFromQueue(queue); err != nil {
+			return err
+		}
+		if text, err := json.Marshal(data); err == nil {
+			cache.Set(key, string(text), libobjectcache.TTL_DAY, 0)
+		}
+	}
+
+	if err := e.exportExtractedData(data); err != nil {
+		return err
+	}
+	e.ClearQueue()
+	return nil
+}
+
+/**
+ * Process a queue of extensions and return their extracted data
+ *
+ * @param array $queue Map of (path => mtime)
+ * @return ExtractedInfo
+ * @return error Listing all the problems of the manifests; ExtensionDependencyError
+ *  if they are valid but their dependencies are not satisfied
+ */
+func (e *ExtensionRegistry) ReadFromQueue(queue map[string]int64) (*ExtractedInfo, error) {
+	paths := make([]string, 0, len(queue))
+	for path := range queue {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	processor := NewExtensionProcessor()
+	validator := NewExtensionJsonValidator()
+	var errors []string
+	extDependencies := make(map[string]map[string]interface{})
+	for _, path := range paths {
+		text, err := ioutil.ReadFile(path)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("Unable to open file %s: %s", path, err))
+			continue
+		}
+		var info map[string]interface{}
+		if err := json.Unmarshal(text, &info); err != nil {
+			errors = append(errors, fmt.Sprintf("%s is not a valid JSON file: %s", path, err))
+			continue
+		}
+
+		version := 1
+		if v, ok := info["manifest_version"].(float64); ok {
+			version = int(v)
+		}
+		if version < OLDEST_MANIFEST_VERSION || version > MANIFEST_VERSION {
+			errors = append(errors, fmt.Sprintf("%s: unsupported manifest_version: %d", path, version))
+			continue
+		}
+		if problems := validator.Validate(info, version); len(problems) > 0 {
+			for _, problem := range problems {
+				errors = append(errors, path+": "+problem)
+			}
+			continue
+		}
+		if err := processor.ExtractInfo(path, info, version); err != nil {
+			errors = append(errors, err.Error())
+			continue
+		}
+		if requires := processor.GetRequirements(info); len(requires) > 0 {
+			extDependencies[info["name"].(string)] = requires
+		}
+	}
+	if len(errors) > 0 {
+		return nil, exception.NewMWException("Could not load the extensions:\n* " + strings.Join(errors, "\n* "))
+	}
+
+	data := processor.GetExtractedInfo()
+	// The things loaded before may satisfy dependencies too
+	loaded := e.GetAllThings()
+	for name, credits := range data.Credits {
+		loaded[name] = credits
+	}
+	versionChecker := NewVersionChecker(consts.MW_VERSION, runtime.Version())
+	incompatible := versionChecker.SetLoadedExtensionsAndSkins(loaded).CheckArray(extDependencies)
+	if len(incompatible) > 0 {
+		return nil, NewExtensionDependencyError(incompatible)
+	}
+	return data, nil
+}
+
+/**
+ * Set the globals, attributes and credits of the extracted data, then
+ * run the callbacks of the extensions
+ *
+ * @param ExtractedInfo $info
+ * @return error On unknown merge strategies
+ */
+func (e *ExtensionRegistry) exportExtractedData(info *ExtractedInfo) error {
+	if globals.GLOBALS == nil {
+		globals.GLOBALS = make(map[string]interface{})
+	}
+	for _, key := range sortedKeys(info.Globals) {
+		value, err := mergeGlobal(key, info.Globals[key], info.MergeStrategies[key])
+		if err != nil {
+			return err
+		}
+		if value != nil {
+			globals.GLOBALS[key] = value
+		}
+	}
+
+	e.mutex.Lock()
+	for name, credits := range info.Credits {
+		e.loaded[name] = credits
+	}
+	for name, value := range info.Attributes {
+		if existing, ok := e.attributes[name]; ok {
+			e.attributes[name] = arrayMergeRecursive(existing, value)
+		} else {
+			e.attributes[name] = value
+		}
+	}
+	e.mutex.Unlock()
+
+	names := make([]string, 0, len(info.Callbacks))
+	for name := range info.Callbacks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		callback, _ := GetFunction(info.Callbacks[name])
+		if callback, ok := callback.(func(credits map[string]interface{})); ok {
+			callback(info.Credits[name])
+		}
+	}
+	return nil
+}
+
+/**
+ * Whether a thing has been loaded
+ *
+ * @param string $name
+ * @return bool
+ */
+func (e *ExtensionRegistry) IsLoaded(name string) bool {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	_, ok := e.loaded[name]
+	return ok
+}
+
 /**
  * @param string $name
+ * @return array|null The attribute, null if no extension sets it
+ */
+func (e *ExtensionRegistry) GetAttribute(name string) interface{} {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	return e.attributes[name]
+}
+
+/**
+ * Get information about all things
+ *
+ * @return array Map of (name => credits)
+ */
+func (e *ExtensionRegistry) GetAllThings() map[string]map[string]interface{} {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	things := make(map[string]map[string]interface{}, len(e.loaded))
+	for name, credits := range e.loaded {
+		things[name] = credits
+	}
+	return things
+}
+
+/**
+ * @return BagOStuff
+ */
+func (e *ExtensionRegistry) getCache() libobjectcache.IBagOStuff {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	if e.cache != nil {
+		return e.cache
+	}
+	return objectcache.GetInstance(consts.CACHE_ACCEL)
+}
+
+/**
+ * @param array $queue Map of (path => mtime)
+ * @return string
+ */
+func (e *ExtensionRegistry) getCacheKey(queue map[string]int64) string {
+	paths := make([]string, 0, len(queue))
+	for path := range queue {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	hash := md5.New()
+	hash.Write([]byte(strconv.Itoa(CACHE_VERSION)))
+	for _, path := range paths {
+		hash.Write([]byte("\x00" + path + "\x00" + strconv.FormatInt(queue[path], 10)))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+/**
+ * Merge the value an extension gives to a global with the value set
+ * before, e.g. in LocalSettings
+ *
+ * @param string $key Name of the global
+ * @param mixed $value Value of the extension
+ * @param string $strategy Merge strategy, array_merge if empty
+ * @return mixed|null The new value of the global; null to keep the one set before
+ * @return error On unknown merge strategies
+ */
+func mergeGlobal(key string, value interface{}, strategy string) (interface{}, error) {
+	if strategy == "" {
+		strategy = "array_merge"
+	}
+	existing, isSet := globals.GLOBALS[key]
+	if strategy == "provide_default" {
+		if isSet {
+			return nil, nil
+		}
+		return value, nil
+	}
+	if !isSet {
+		return value, nil
+	}
+	// Go-typed settings, e.g. []string or map[string]string, are merged as
+	// PHP arrays, then converted back to their type
+	merged, err := mergeArrays(toArray(existing), toArray(value), strategy)
+	if err != nil {
+		return nil, exception.NewMWException(fmt.Sprintf("%s for %s", err, key))
+	}
+	if merged == nil {
+		return nil, nil
+	}
+	return convertTo(key, merged, existing)
+}
+
+/**
+ * @param array|mixed $existing
+ * @param array|mixed $value
+ * @param string $strategy
+ * @return array|null The merged arrays; null if $existing has been overridden
+ * @return error On unknown merge strategies
+ */
+func mergeArrays(existing interface{}, value interface{}, strategy string) (interface{}, error) {
+	// Optimistic: If the global is empty, we can skip merging
+	if isArray(existing) && arrayLen(existing) == 0 {
+		return value, nil
+	}
+	if !isArray(existing) || !isArray(value) {
+		// config setting that has already been overridden, don't set it
+		return nil, nil
+	}
+
+	switch strategy {
+	case "array_merge":
+		return arrayMerge(value, existing), nil
+	case "array_plus":
+		return arrayPlus(existing, value), nil
+	case "array_plus_2d":
+		return arrayPlus2d(existing, value), nil
+	case "array_replace_recursive":
+		return arrayReplaceRecursive(existing, value), nil
+	}
+	return nil, fmt.Errorf("Unknown merge strategy '%s'", strategy)
+}
+
+/**
+ * @param mixed $value
+ * @return mixed The slices and the maps with string keys of $value turned
+ *  into []interface{} and map[string]interface{}, the value itself otherwise
+ */
+func toArray(value interface{}) interface{} {
+	switch value.(type) {
+	case nil, []byte:
+		return value
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		list := make([]interface{}, v.Len())
+		for i := range list {
+			list[i] = toArray(v.Index(i).Interface())
+		}
+		return list
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return value
+		}
+		res := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			res[iter.Key().String()] = toArray(iter.Value().Interface())
+		}
+		return res
+	}
+	return value
+}
+
+/**
+ * Convert a merged array to the Go type of the global, as
+ * ConfigSchema::normalize() does
+ *
+ * @param string $key Name of the global
+ * @param array $merged
+ * @param mixed $existing Value of the global
+ * @return mixed
+ * @return error If the merged array does not fit the type of the global
+ */
+func convertTo(key string, merged interface{}, existing interface{}) (interface{}, error) {
+	switch existing.(type) {
+	case []interface{}, map[string]interface{}:
+		return merged, nil
+	}
+	t := reflect.TypeOf(existing)
+	if reflect.TypeOf(merged) == t {
+		return merged, nil
+	}
+	text, err := json.Marshal(merged)
+	if err != nil {
+		return nil, exception.NewMWException(fmt.Sprintf("%s: %s", key, err))
+	}
+	converted := reflect.New(t)
+	if err := json.Unmarshal(text, converted.Interface()); err != nil {
+		return nil, exception.NewMWException(fmt.Sprintf("%s must be of type %s, %s given", key, t, text))
+	}
+	return converted.Elem().Interface(), nil
+}
+
+/**
+ * @param mixed $value
+ * @return bool Whether $value is a PHP array: a list or a map
+ */
+func isArray(value interface{}) bool {
+	switch value.(type) {
+	case []interface{}, map[string]interface{}:
+		return true
+	}
+	return false
+}
+
+/**
+ * @param array $value
+ * @return int
+ */
+func arrayLen(value interface{}) int {
+	switch v := value.(type) {
+	case []interface{}:
+		return len(v)
+	case map[string]interface{}:
+		return len(v)
+	}
+	return 0
+}
+
+/**
+ * @param array $value
+ * @return array The map of $value, lists being keyed by their indexes
+ */
+func toMap(value interface{}) map[string]interface{} {
+	res := make(map[string]interface{})
+	switch v := value.(type) {
+	case []interface{}:
+		for i, item := range v {
+			res[strconv.Itoa(i)] = item
+		}
+	case map[string]interface{}:
+		for key, item := range v {
+			res[key] = item
+		}
+	}
+	return res
+}
+
+/**
+ * array_merge(): lists are appended, the keys of maps are overridden by
+ * the ones of $b
+ *
+ * @param array $a
+ * @param array $b
+ * @return array
+ */
+func arrayMerge(a, b interface{}) interface{} {
+	if la, ok := a.([]interface{}); ok {
+		if lb, ok := b.([]interface{}); ok {
+			return append(append([]interface{}{}, la...), lb...)
+		}
+	}
+	res := toMap(a)
+	for key, value := range toMap(b) {
+		res[key] = value
+	}
+	return res
+}
+
+/**
+ * The + operator: the keys of $a are kept, the other ones of $b added
+ *
+ * @param array $a
+ * @param array $b
  * @return array
  */
-func (e *ExtensionRegistry) GetAttribute(name string) (ret []string) {
-	if v, ok := e.attributes[name]; ok {
-		return v
+func arrayPlus(a, b interface{}) interface{} {
+	if la, ok := a.([]interface{}); ok {
+		if lb, ok := b.([]interface{}); ok {
+			if len(lb) <= len(la) {
+				return la
+			}
+			return append(append([]interface{}{}, la...), lb[len(la):]...)
+		}
+	}
+	res := toMap(b)
+	for key, value := range toMap(a) {
+		res[key] = value
+	}
+	return res
+}
+
+/**
+ * wfArrayPlus2d(): the + operator applied to the values of the same keys
+ *
+ * @param array $baseArray
+ * @param array $newValues
+ * @return array
+ */
+func arrayPlus2d(baseArray, newValues interface{}) interface{} {
+	res := toMap(newValues)
+	for name, groupVal := range toMap(baseArray) {
+		if newValue, ok := res[name]; ok && isArray(groupVal) && isArray(newValue) {
+			groupVal = arrayPlus(groupVal, newValue)
+		}
+		res[name] = groupVal
+	}
+	return res
+}
+
+/**
+ * array_replace_recursive(): the values of $b replace the ones of $a,
+ * merging the maps
+ *
+ * @param array $a
+ * @param array $b
+ * @return array
+ */
+func arrayReplaceRecursive(a, b interface{}) interface{} {
+	res := toMap(a)
+	for key, value := range toMap(b) {
+		if existing, ok := res[key]; ok && isArray(existing) && isArray(value) {
+			value = arrayReplaceRecursive(existing, value)
+		}
+		res[key] = value
+	}
+	if la, ok := a.([]interface{}); ok {
+		if _, ok := b.([]interface{}); ok {
+			// Keep lists lists
+			list := make([]interface{}, len(res))
+			for i := range list {
+				list[i] = res[strconv.Itoa(i)]
+			}
+			if len(list) >= len(la) {
+				return list
+			}
+		}
+	}
+	return res
+}
+
+/**
+ * array_merge_recursive(), as attributes are merged: lists are appended
+ * and maps merged, other values of $b replace the ones of $a
+ *
+ * @param mixed $a
+ * @param mixed $b
+ * @return mixed
+ */
+func arrayMergeRecursive(a, b interface{}) interface{} {
+	switch va := a.(type) {
+	case []interface{}:
+		if vb, ok := b.([]interface{}); ok {
+			return append(append([]interface{}{}, va...), vb...)
+		}
+	case map[string]interface{}:
+		if vb, ok := b.(map[string]interface{}); ok {
+			res := make(map[string]interface{}, len(va)+len(vb))
+			for key, value := range va {
+				res[key] = value
+			}
+			for key, value := range vb {
+				if existing, ok := res[key]; ok {
+					value = arrayMergeRecursive(existing, value)
+				}
+				res[key] = value
+			}
+			return res
+		}
 	}
-	return ret
+	return b
 }
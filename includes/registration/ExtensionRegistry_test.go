@@ -0,0 +1,324 @@
+package registration
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MangoDowner/mediawiki/globals"
+	libobjectcache "github.com/MangoDowner/mediawiki/includes/libs/objectcache"
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * Write a manifest to a directory of its own
+ */
+func writeManifest(t *testing.T, name, text string) string {
+	dir := filepath.Join(t.TempDir(), name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "extension.json")
+	if err := os.WriteFile(path, []byte(text), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+/**
+ * Run the test with its own globals
+ */
+func setGlobals(t *testing.T, values map[string]interface{}) {
+	saved := globals.GLOBALS
+	globals.GLOBALS = values
+	t.Cleanup(func() {
+		globals.GLOBALS = saved
+	})
+}
+
+const fooManifest = `{
+	"@note": "A comment",
+	"manifest_version": 2,
+	"name": "FooBar",
+	"version": "1.2.0",
+	"author": ["Jane Doe"],
+	"license-name": "GPL-2.0-or-later",
+	"callback": "FooBarHooks::onRegistration",
+	"Hooks": {
+		"BeforeInitialize": "FooBarHooks::onBeforeInitialize"
+	},
+	"MessagesDirs": {
+		"FooBar": ["i18n"]
+	},
+	"namespaces": [
+		{"id": 3000, "constant": "NS_FOO", "name": "Foo", "subpages": true, "content": true},
+		{"id": 3002, "constant": "NS_FOO_LATER", "name": "FooLater", "conditional": true}
+	],
+	"config": {
+		"Enabled": {"value": true},
+		"Paths": {"value": ["a"]},
+		"Sizes": {"value": {"small": 1}, "merge_strategy": "array_plus"},
+		"Dir": {"value": "data", "path": true}
+	},
+	"attributes": {
+		"VisualEditor": {"Plugins": ["foo"]}
+	}
+}`
+
+/**
+ * @covers ExtensionRegistry::loadFromQueue
+ * @covers ExtensionRegistry::exportExtractedData
+ */
+func TestLoadFromQueue(t *testing.T) {
+	setGlobals(t, map[string]interface{}{
+		"wgPaths": []interface{}{"b"},
+		"wgSizes": map[string]interface{}{"small": 2},
+	})
+	var registered map[string]interface{}
+	RegisterFunction("FooBarHooks::onRegistration", func(credits map[string]interface{}) {
+		registered = credits
+	})
+	RegisterFunction("FooBarHooks::onBeforeInitialize", func() bool { return true })
+
+	path := writeManifest(t, "FooBar", fooManifest)
+	registry := NewExtensionRegistry()
+	registry.SetCache(libobjectcache.NewHashBagOStuff(map[string]interface{}{}))
+	registry.Queue(path)
+	err := registry.LoadFromQueue()
+	test.AssertEqual(t, nil, err, `Manifest loaded`)
+	test.AssertEqual(t, 0, len(registry.GetQueue()), `Queue cleared`)
+
+	test.AssertTrue(t, registry.IsLoaded("FooBar"), `Extension loaded`)
+	test.AssertTrue(t, !registry.IsLoaded("Baz"), `Other extension not loaded`)
+	credits := registry.GetAllThings()["FooBar"]
+	test.AssertEqual(t, "1.2.0", credits["version"], `Credits version`)
+	test.AssertEqual(t, "extension", credits["type"], `Credits type defaults to extension`)
+	test.AssertEqual(t, path, credits["path"], `Credits path`)
+	test.AssertEqual(t, "1.2.0", registered["version"], `Callback run with the credits`)
+
+	test.AssertEqual(t, true, globals.GLOBALS["wgEnabled"], `Config set`)
+	test.AssertEqual(t, "[a b]", fmt.Sprint(globals.GLOBALS["wgPaths"]), `array_merge keeps the local settings last`)
+	test.AssertEqual(t, "map[small:2]", fmt.Sprint(globals.GLOBALS["wgSizes"]), `array_plus keeps the local settings`)
+	test.AssertEqual(t, filepath.Join(filepath.Dir(path), "data"), globals.GLOBALS["wgDir"], `Paths are absolute`)
+	test.AssertEqual(t, fmt.Sprint(map[string]interface{}{"FooBar": []interface{}{filepath.Join(filepath.Dir(path), "i18n")}}),
+		fmt.Sprint(globals.GLOBALS["wgMessagesDirs"]), `Messages directories`)
+	test.AssertEqual(t, "map[3000:true]", fmt.Sprint(globals.GLOBALS["wgNamespacesWithSubpages"]), `Namespace with subpages`)
+	test.AssertEqual(t, "[3000]", fmt.Sprint(globals.GLOBALS["wgContentNamespaces"]), `Content namespace`)
+
+	test.AssertEqual(t, "map[3000:Foo]", fmt.Sprint(registry.GetAttribute("ExtensionNamespaces")),
+		`Conditional namespaces are left out`)
+	test.AssertEqual(t, "map[BeforeInitialize:[FooBarHooks::onBeforeInitialize]]", fmt.Sprint(registry.GetAttribute("Hooks")),
+		`Hooks attribute`)
+	test.AssertEqual(t, "[foo]", fmt.Sprint(registry.GetAttribute("VisualEditorPlugins")), `Attributes of other extensions`)
+	test.AssertEqual(t, nil, registry.GetAttribute("Unknown"), `Unknown attribute`)
+}
+
+/**
+ * @covers ExtensionRegistry::loadFromQueue
+ */
+func TestLoadFromQueue_Cache(t *testing.T) {
+	setGlobals(t, map[string]interface{}{})
+	path := writeManifest(t, "Cached", `{"name": "Cached", "config": {"Answer": 42}}`)
+	cache := libobjectcache.NewHashBagOStuff(map[string]interface{}{})
+
+	registry := NewExtensionRegistry()
+	registry.SetCache(cache)
+	registry.Queue(path)
+	test.AssertEqual(t, nil, registry.LoadFromQueue(), `Manifest loaded`)
+
+	// The manifest is not read again as long as it is unchanged
+	mtime := fileMTime(t, path)
+	if err := os.WriteFile(path, []byte(`{"name": "Cached", "config": {"Answer": 43}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+	globals.GLOBALS = map[string]interface{}{}
+	registry = NewExtensionRegistry()
+	registry.SetCache(cache)
+	registry.Queue(path)
+	test.AssertEqual(t, nil, registry.LoadFromQueue(), `Manifest loaded from the cache`)
+	test.AssertEqual(t, float64(42), globals.GLOBALS["wgAnswer"], `Cached config`)
+	test.AssertTrue(t, registry.IsLoaded("Cached"), `Cached credits`)
+}
+
+/**
+ * @return time Modification time of the file
+ */
+func fileMTime(t *testing.T, path string) time.Time {
+	stat, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stat.ModTime()
+}
+
+/**
+ * @covers ExtensionRegistry::readFromQueue
+ */
+func TestReadFromQueue_Errors(t *testing.T) {
+	queue := map[string]int64{
+		writeManifest(t, "Invalid", `{"manifest_version": 2, "version": 1, "Unknown": true}`): 0,
+		writeManifest(t, "NotJson", `{`):                                                                 0,
+		writeManifest(t, "Future", `{"manifest_version": 3, "name": "Future"}`):                          0,
+		writeManifest(t, "Special", `{"name": "Special", "SpecialPages": {"Foo": "SpecialFooMissing"}}`): 0,
+	}
+	info, err := NewExtensionRegistry().ReadFromQueue(queue)
+	test.AssertTrue(t, info == nil, `Nothing extracted`)
+	message := fmt.Sprint(err)
+	for _, problem := range []string{
+		"name: The property name is required",
+		"version: integer value found, but string is required",
+		"Unknown: The property Unknown is not defined",
+		"is not a valid JSON file",
+		"unsupported manifest_version: 3",
+		"class SpecialFooMissing of SpecialPages Foo is not registered",
+	} {
+		test.AssertTrue(t, strings.Contains(message, problem), `All problems reported: `+problem)
+	}
+
+	queue = map[string]int64{
+		writeManifest(t, "Twice1", `{"name": "Twice"}`): 0,
+		writeManifest(t, "Twice2", `{"name": "Twice"}`): 0,
+	}
+	_, err = NewExtensionRegistry().ReadFromQueue(queue)
+	test.AssertTrue(t, strings.Contains(fmt.Sprint(err), "It was attempted to load Twice twice"), `Loaded twice`)
+
+	queue = map[string]int64{
+		writeManifest(t, "Conf1", `{"name": "Conf1", "config": {"Foo": 1}}`): 0,
+		writeManifest(t, "Conf2", `{"name": "Conf2", "config": {"Foo": 2}}`): 0,
+	}
+	_, err = NewExtensionRegistry().ReadFromQueue(queue)
+	test.AssertTrue(t, strings.Contains(fmt.Sprint(err), "The configuration setting 'Foo' was already set by Conf1"),
+		`Config set twice`)
+}
+
+/**
+ * @covers ExtensionRegistry::readFromQueue
+ */
+func TestReadFromQueue_Dependencies(t *testing.T) {
+	queue := map[string]int64{
+		writeManifest(t, "Base", `{"name": "Base", "version": "1.0.0"}`): 0,
+		writeManifest(t, "Plugin", `{"name": "Plugin", "requires": {
+			"MediaWiki": ">= 1.29.0",
+			"extensions": {"Base": "^1.0"}
+		}}`): 0,
+	}
+	info, err := NewExtensionRegistry().ReadFromQueue(queue)
+	test.AssertEqual(t, nil, err, `Dependencies satisfied`)
+	test.AssertEqual(t, 2, len(info.Credits), `Both loaded`)
+
+	queue = map[string]int64{
+		writeManifest(t, "Old", `{"name": "Old", "requires": {"MediaWiki": "< 1.30"}}`): 0,
+		writeManifest(t, "Needy", `{"name": "Needy", "requires": {
+			"extensions": {"Missing": "*", "Old": ">= 2.0"},
+			"skins": {"Vector": "*"}
+		}}`): 0,
+	}
+	_, err = NewExtensionRegistry().ReadFromQueue(queue)
+	dependencyError, ok := err.(*ExtensionDependencyError)
+	test.AssertTrue(t, ok, `ExtensionDependencyError`)
+	if ok {
+		test.AssertEqual(t, 4, len(dependencyError.Errors), `All problems reported at once`)
+		test.AssertTrue(t, dependencyError.IncompatibleCore, `Incompatible core`)
+		test.AssertEqual(t, "[Missing]", fmt.Sprint(dependencyError.MissingExtensions), `Missing extensions`)
+		test.AssertEqual(t, "[Vector]", fmt.Sprint(dependencyError.MissingSkins), `Missing skins`)
+		test.AssertEqual(t, "[Needy]", fmt.Sprint(dependencyError.IncompatibleExtensions), `Incompatible extensions`)
+	}
+
+	queue = map[string]int64{
+		writeManifest(t, "Chicken", `{"name": "Chicken", "requires": {"extensions": {"Egg": "*"}}}`): 0,
+		writeManifest(t, "Egg", `{"name": "Egg", "requires": {"extensions": {"Chicken": "*"}}}`):     0,
+	}
+	_, err = NewExtensionRegistry().ReadFromQueue(queue)
+	test.AssertEqual(t, "Dependency cycle: Chicken -> Egg -> Chicken.", fmt.Sprint(err), `Cycle detected`)
+}
+
+/**
+ * @covers ExtensionRegistry::queue
+ * @covers ExtensionRegistry::finishLoading
+ */
+func TestQueue_Late(t *testing.T) {
+	registry := NewExtensionRegistry()
+	path := writeManifest(t, "Late", `{"name": "Late"}`)
+	registry.Queue(path)
+	test.AssertEqual(t, 1, len(registry.GetQueue()), `Queued`)
+	registry.ClearQueue()
+	test.AssertEqual(t, 0, len(registry.GetQueue()), `Queue cleared`)
+
+	registry.FinishLoading()
+	defer func() {
+		test.AssertTrue(t, strings.Contains(fmt.Sprint(recover()), "tried to load late"), `Late loading rejected`)
+	}()
+	registry.Queue(path)
+}
+
+/**
+ * @covers ExtensionRegistry::exportExtractedData
+ */
+func TestMergeGlobal(t *testing.T) {
+	setGlobals(t, map[string]interface{}{
+		"wgList":     []interface{}{"local"},
+		"wgMap":      map[string]interface{}{"a": "local", "b": map[string]interface{}{"x": 1}},
+		"wgEmpty":    []interface{}{},
+		"wgScalar":   "local",
+		"wgProvided": "local",
+		"wgStrings":  []string{},
+		"wgTyped":    map[string]string{"a": "local"},
+		"wgNested":   map[string]map[string]bool{"a": {"x": true}},
+	})
+	cases := []struct {
+		key      string
+		value    interface{}
+		strategy string
+		expected string
+	}{
+		{"wgUnset", "ext", "", "ext"},
+		{"wgEmpty", []interface{}{"ext"}, "", "[ext]"},
+		{"wgScalar", "ext", "", "<nil>"},
+		{"wgProvided", "ext", "provide_default", "<nil>"},
+		{"wgDefault", "ext", "provide_default", "ext"},
+		{"wgList", []interface{}{"ext"}, "array_merge", "[ext local]"},
+		{"wgMap", map[string]interface{}{"a": "ext", "c": "ext"}, "array_merge", "map[a:local b:map[x:1] c:ext]"},
+		{"wgMap", map[string]interface{}{"a": "ext", "c": "ext"}, "array_plus", "map[a:local b:map[x:1] c:ext]"},
+		{"wgMap", map[string]interface{}{"b": map[string]interface{}{"x": 2, "y": 2}}, "array_plus_2d",
+			"map[a:local b:map[x:1 y:2]]"},
+		{"wgMap", map[string]interface{}{"b": map[string]interface{}{"x": 2}}, "array_replace_recursive",
+			"map[a:local b:map[x:2]]"},
+		{"wgStrings", []interface{}{"foo"}, "", "[foo]"},
+		{"wgTyped", map[string]interface{}{"a": "ext", "b": "ext"}, "array_plus", "map[a:local b:ext]"},
+		{"wgNested", map[string]interface{}{"a": map[string]interface{}{"y": true}, "b": map[string]interface{}{}},
+			"array_plus_2d", "map[a:map[x:true y:true] b:map[]]"},
+	}
+	for _, c := range cases {
+		value, err := mergeGlobal(c.key, c.value, c.strategy)
+		test.AssertEqual(t, nil, err, c.key+" "+c.strategy)
+		test.AssertEqual(t, c.expected, fmt.Sprint(value), c.key+" "+c.strategy)
+	}
+
+	// Go-typed globals keep their type
+	value, _ := mergeGlobal("wgStrings", []interface{}{"foo"}, "")
+	test.AssertEqual(t, fmt.Sprint([]string{"foo"}), fmt.Sprint(value.([]string)), `[]string kept`)
+	value, _ = mergeGlobal("wgNested", map[string]interface{}{"b": map[string]interface{}{"y": true}}, "array_plus_2d")
+	test.AssertEqual(t, true, value.(map[string]map[string]bool)["b"]["y"], `Nested maps kept`)
+	_, err := mergeGlobal("wgStrings", []interface{}{map[string]interface{}{}}, "")
+	test.AssertTrue(t, err != nil, `Values not fitting the type are rejected`)
+
+	_, err = mergeGlobal("wgMap", map[string]interface{}{}, "array_unknown")
+	test.AssertTrue(t, err != nil, `Unknown merge strategy`)
+}
+
+/**
+ * @covers ExtensionRegistry::exportExtractedData
+ */
+func TestArrayMergeRecursive(t *testing.T) {
+	merged := arrayMergeRecursive(
+		map[string]interface{}{"Hooks": map[string]interface{}{"A": []interface{}{"f"}}, "x": 1},
+		map[string]interface{}{"Hooks": map[string]interface{}{"A": []interface{}{"g"}, "B": []interface{}{"h"}}, "x": 2},
+	)
+	test.AssertEqual(t, "map[Hooks:map[A:[f g] B:[h]] x:2]", fmt.Sprint(merged), `Lists appended and maps merged`)
+}
@@ -0,0 +1,332 @@
+package registration
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/MangoDowner/mediawiki/includes/libs/semver"
+)
+
+/**
+ * "requires" key that applies to the version of Go
+ */
+const GO_VERSION = "Go"
+
+/**
+ * A dependency of an extension which is not satisfied
+ */
+type DependencyError struct {
+	Msg string
+	/**
+	 * @var string incompatible-core, incompatible-go, missing-extensions,
+	 *  missing-skins, incompatible-extensions, incompatible-skins,
+	 *  invalid-version, invalid-constraint, unknown-dependency or
+	 *  dependency-cycle
+	 */
+	Type string
+	/** @var string The missing extension or skin, for missing-* */
+	Missing string
+	/** @var string The extension or skin having the dependency, for incompatible-* */
+	Incompatible string
+}
+
+/**
+ * Provides functions to check a set of extensions with dependencies against
+ * a set of loaded extensions and given version information.
+ *
+ * @since 1.29
+ */
+type VersionChecker struct {
+	/**
+	 * @var Version|null Version of the MediaWiki core, null if it cannot be parsed
+	 */
+	coreVersion *semver.Version
+
+	/**
+	 * @var Version|null Version of Go, null if it cannot be parsed
+	 */
+	goVersion *semver.Version
+
+	coreVersionText string
+	goVersionText   string
+
+	/**
+	 * @var array Loaded extensions
+	 */
+	loaded map[string]map[string]interface{}
+}
+
+/**
+ * @param string $coreVersion Current version of core
+ * @param string $goVersion Current version of Go, e.g. runtime.Version()
+ */
+func NewVersionChecker(coreVersion, goVersion string) *VersionChecker {
+	this := new(VersionChecker)
+	this.coreVersionText = coreVersion
+	// Versions which cannot be parsed, e.g. development builds, are not checked
+	this.coreVersion, _ = semver.NormalizeVersion(coreVersion)
+	this.goVersionText = strings.TrimPrefix(goVersion, "go")
+	this.goVersion, _ = semver.NormalizeVersion(this.goVersionText)
+	this.loaded = make(map[string]map[string]interface{})
+	return this
+}
+
+/**
+ * Set an array with credits of all loaded extensions and skins.
+ *
+ * Ex.: [ 'Extension1' => [
+ *             'name' => 'Extension1',
+ *             'version' => '1.0.1'
+ *             ...
+ *        ],
+ *        'Skin1' => [
+ *             'name' => 'Skin1',
+ *             'version' => '0.0.1'
+ *             ...
+ *        ]
+ *      ]
+ *
+ * @param array $credits An array of installed extensions with credits of them
+ * @return VersionChecker $this
+ */
+func (v *VersionChecker) SetLoadedExtensionsAndSkins(credits map[string]map[string]interface{}) *VersionChecker {
+	v.loaded = credits
+	return v
+}
+
+/**
+ * Check all given dependencies if they are compatible with the named
+ * installed extensions in the $credits array.
+ *
+ * Example $extDependencies:
+ * 	{
+ * 		'FooBar' => {
+ * 			'MediaWiki' => '>= 1.25.0',
+ * 			'Go' => '>= 1.21',
+ * 			'extensions' => {
+ * 				'FooBaz' => '>= 1.25.0'
+ * 			},
+ * 			'skins' => {
+ * 				'BazBar' => '>= 1.0.0'
+ * 			}
+ * 		}
+ * 	}
+ *
+ * @param array $extDependencies All extensions that depend on other ones
+ * @return DependencyError[] All the problems, cycles of dependencies included;
+ *  empty if the dependencies are satisfied
+ */
+func (v *VersionChecker) CheckArray(extDependencies map[string]map[string]interface{}) (errors []*DependencyError) {
+	for _, extension := range sortedExtensions(extDependencies) {
+		dependencies := extDependencies[extension]
+		for _, dependencyType := range sortedKeys(dependencies) {
+			values := dependencies[dependencyType]
+			switch dependencyType {
+			case MEDIAWIKI_CORE, GO_VERSION:
+				if err := v.handleVersionDependency(values, extension, dependencyType); err != nil {
+					errors = append(errors, err)
+				}
+			case "extensions", "skins":
+				values, _ := values.(map[string]interface{})
+				for _, dependency := range sortedKeys(values) {
+					if err := v.handleExtensionDependency(dependency, values[dependency], extension,
+						dependencyType); err != nil {
+						errors = append(errors, err)
+					}
+				}
+			default:
+				errors = append(errors, &DependencyError{
+					Msg:  fmt.Sprintf("Dependency type %s unknown in %s.", dependencyType, extension),
+					Type: "unknown-dependency",
+				})
+			}
+		}
+	}
+	return append(errors, v.findCycles(extDependencies)...)
+}
+
+/**
+ * Handle a dependency to MediaWiki core or Go
+ *
+ * @param string $constraint The required version
+ * @param string $checkedExt The Extension, which depends on the version
+ * @param string $dependencyType MediaWiki or Go
+ * @return DependencyError|null
+ */
+func (v *VersionChecker) handleVersionDependency(constraint interface{}, checkedExt, dependencyType string) *DependencyError {
+	installed, description, errorType := v.coreVersion, "MediaWiki core (version "+v.coreVersionText+")",
+		"incompatible-core"
+	if dependencyType == GO_VERSION {
+		installed, description, errorType = v.goVersion, "Go version ("+v.goVersionText+")", "incompatible-go"
+	}
+	parsed, err := v.parseConstraint(constraint, checkedExt, dependencyType)
+	if err != nil {
+		return err
+	}
+	if installed == nil {
+		// Couldn't parse the version, so we can't check anything
+		return nil
+	}
+	// if the installed and required version are compatible, return nothing
+	if parsed.Matches(installed) {
+		return nil
+	}
+	// otherwise mark this as incompatible.
+	return &DependencyError{
+		Msg:  fmt.Sprintf("%s is not compatible with the current %s, it requires: %s.", checkedExt, description, constraint),
+		Type: errorType,
+	}
+}
+
+/**
+ * Handle a dependency to another extension.
+ *
+ * @param string $dependencyName The name of the dependency
+ * @param string $constraint The required version of the dependency
+ * @param string $checkedExt The Extension, which depends on this dependency
+ * @param string $type Either 'extensions' or 'skins'
+ * @return DependencyError|null
+ */
+func (v *VersionChecker) handleExtensionDependency(dependencyName string, constraint interface{},
+	checkedExt, dependencyType string) *DependencyError {
+	// Check if the dependency is even installed
+	credits, ok := v.loaded[dependencyName]
+	if !ok {
+		return &DependencyError{
+			Msg:     fmt.Sprintf("%s requires %s to be installed.", checkedExt, dependencyName),
+			Type:    "missing-" + dependencyType,
+			Missing: dependencyName,
+		}
+	}
+	parsed, err := v.parseConstraint(constraint, checkedExt, dependencyName)
+	if err != nil {
+		return err
+	}
+	// Check if the dependency has specified a version
+	version, ok := credits["version"].(string)
+	if !ok {
+		// If we depend upon any version, and none is set, that's fine.
+		if constraint == "*" {
+			return nil
+		}
+		// Otherwise, mark it as incompatible.
+		return &DependencyError{
+			Msg: fmt.Sprintf("%s does not expose its version, but %s requires: %s.",
+				dependencyName, checkedExt, constraint),
+			Type:         "incompatible-" + dependencyType,
+			Incompatible: checkedExt,
+		}
+	}
+	// Try to get a constraint for the dependency version
+	installed, versionErr := semver.NormalizeVersion(version)
+	if versionErr != nil {
+		// Non-parsable version, output an error message that the version string is invalid
+		return &DependencyError{
+			Msg:  fmt.Sprintf("%s does not have a valid version string.", dependencyName),
+			Type: "invalid-version",
+		}
+	}
+	// Check if the constraint actually matches...
+	if !parsed.Matches(installed) {
+		return &DependencyError{
+			Msg: fmt.Sprintf("%s is not compatible with the current installed version of %s (%s), it requires: %s.",
+				checkedExt, dependencyName, version, constraint),
+			Type:         "incompatible-" + dependencyType,
+			Incompatible: checkedExt,
+		}
+	}
+	return nil
+}
+
+/**
+ * @param string $constraint
+ * @param string $checkedExt The Extension having the constraint
+ * @param string $dependency What the constraint applies to
+ * @return Constraint
+ * @return DependencyError|null If the constraint cannot be parsed
+ */
+func (v *VersionChecker) parseConstraint(constraint interface{}, checkedExt, dependency string) (semver.Constraint,
+	*DependencyError) {
+	text, _ := constraint.(string)
+	parsed, err := semver.ParseConstraints(text)
+	if err != nil {
+		return nil, &DependencyError{
+			Msg:  fmt.Sprintf("%s has an invalid version constraint for %s: %v.", checkedExt, dependency, constraint),
+			Type: "invalid-constraint",
+		}
+	}
+	return parsed, nil
+}
+
+/**
+ * Find the cycles of the dependencies between the extensions and skins,
+ * which cannot be initialized in any order
+ *
+ * @param array $extDependencies
+ * @return DependencyError[] One per cycle
+ */
+func (v *VersionChecker) findCycles(extDependencies map[string]map[string]interface{}) (errors []*DependencyError) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var path []string
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = visiting
+		path = append(path, name)
+		for _, dependency := range dependencyNames(extDependencies[name]) {
+			switch state[dependency] {
+			case unvisited:
+				visit(dependency)
+			case visiting:
+				start := 0
+				for path[start] != dependency {
+					start++
+				}
+				cycle := append(append([]string{}, path[start:]...), dependency)
+				errors = append(errors, &DependencyError{
+					Msg:  fmt.Sprintf("Dependency cycle: %s.", strings.Join(cycle, " -> ")),
+					Type: "dependency-cycle",
+				})
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+	}
+	for _, name := range sortedExtensions(extDependencies) {
+		if state[name] == unvisited {
+			visit(name)
+		}
+	}
+	return errors
+}
+
+/**
+ * @param array $dependencies The "requires" of an extension
+ * @return string[] The extensions and skins it requires, sorted
+ */
+func dependencyNames(dependencies map[string]interface{}) (names []string) {
+	for _, dependencyType := range []string{"extensions", "skins"} {
+		values, _ := dependencies[dependencyType].(map[string]interface{})
+		names = append(names, sortedKeys(values)...)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/**
+ * @param array $extDependencies
+ * @return string[] The names of the extensions, sorted
+ */
+func sortedExtensions(extDependencies map[string]map[string]interface{}) []string {
+	names := make([]string, 0, len(extDependencies))
+	for name := range extDependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,105 @@
+package registration
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * @covers VersionChecker::checkArray
+ */
+func TestCheckArray(t *testing.T) {
+	checker := NewVersionChecker("1.30.2", "go1.21.5")
+	checker.SetLoadedExtensionsAndSkins(map[string]map[string]interface{}{
+		"FooBar":     {"version": "1.2.0"},
+		"NoVersion":  {},
+		"BadVersion": {"version": "one"},
+		"Vector":     {"version": "1.0.0"},
+	})
+
+	cases := []struct {
+		requires map[string]interface{}
+		expected string
+	}{
+		{map[string]interface{}{"MediaWiki": ">= 1.29.0", "Go": ">= 1.20"}, ""},
+		{map[string]interface{}{"MediaWiki": ">= 1.31.0"},
+			"incompatible-core: Ext is not compatible with the current MediaWiki core (version 1.30.2), it requires: >= 1.31.0."},
+		{map[string]interface{}{"Go": "^1.22"},
+			"incompatible-go: Ext is not compatible with the current Go version (1.21.5), it requires: ^1.22."},
+		{map[string]interface{}{"extensions": map[string]interface{}{"FooBar": "~1.2"}, "skins": map[string]interface{}{"Vector": "*"}}, ""},
+		{map[string]interface{}{"extensions": map[string]interface{}{"Missing": "*"}},
+			"missing-extensions: Ext requires Missing to be installed."},
+		{map[string]interface{}{"skins": map[string]interface{}{"Vector": ">= 2.0"}},
+			"incompatible-skins: Ext is not compatible with the current installed version of Vector (1.0.0), it requires: >= 2.0."},
+		{map[string]interface{}{"extensions": map[string]interface{}{"NoVersion": "*"}}, ""},
+		{map[string]interface{}{"extensions": map[string]interface{}{"NoVersion": "1.0"}},
+			"incompatible-extensions: NoVersion does not expose its version, but Ext requires: 1.0."},
+		{map[string]interface{}{"extensions": map[string]interface{}{"BadVersion": "1.0"}},
+			"invalid-version: BadVersion does not have a valid version string."},
+		{map[string]interface{}{"MediaWiki": "latest"},
+			"invalid-constraint: Ext has an invalid version constraint for MediaWiki: latest."},
+		{map[string]interface{}{"PHP": ">= 7.0"}, "unknown-dependency: Dependency type PHP unknown in Ext."},
+	}
+	for _, c := range cases {
+		var messages []string
+		for _, err := range checker.CheckArray(map[string]map[string]interface{}{"Ext": c.requires}) {
+			messages = append(messages, err.Type+": "+err.Msg)
+		}
+		test.AssetEqual(c.expected, strings.Join(messages, "\n"), fmt.Sprint(c.requires))
+	}
+
+	// Pre-releases satisfy the constraints of their release, development builds are not checked
+	checker = NewVersionChecker("1.31.0-alpha", "devel go1.22-abcdef")
+	errors := checker.CheckArray(map[string]map[string]interface{}{
+		"Ext": {"MediaWiki": ">= 1.31.0", "Go": ">= 1.22"},
+	})
+	test.AssetEqual(0, len(errors), `Pre-release core and development Go`)
+}
+
+/**
+ * @covers VersionChecker::checkArray
+ */
+func TestCheckArray_Cycles(t *testing.T) {
+	requires := func(names ...string) map[string]interface{} {
+		extensions := make(map[string]interface{})
+		for _, name := range names {
+			extensions[name] = "*"
+		}
+		return map[string]interface{}{"extensions": extensions}
+	}
+	loaded := map[string]map[string]interface{}{"A": {}, "B": {}, "C": {}, "D": {}, "E": {}}
+	errors := NewVersionChecker("1.30.2", "go1.21.5").SetLoadedExtensionsAndSkins(loaded).CheckArray(
+		map[string]map[string]interface{}{
+			"A": requires("B"),
+			"B": requires("C"),
+			"C": requires("A", "D"),
+			"D": {},
+			"E": requires("E"),
+		})
+	var messages []string
+	for _, err := range errors {
+		test.AssetEqual("dependency-cycle", err.Type, err.Msg)
+		messages = append(messages, err.Msg)
+	}
+	test.AssetEqual("Dependency cycle: A -> B -> C -> A.\nDependency cycle: E -> E.", strings.Join(messages, "\n"),
+		`All cycles reported once`)
+}
+
+/**
+ * @covers ExtensionDependencyError::__construct
+ */
+func TestExtensionDependencyError(t *testing.T) {
+	err := NewExtensionDependencyError([]*DependencyError{
+		{Msg: "Core", Type: "incompatible-core"},
+		{Msg: "Missing", Type: "missing-extensions", Missing: "Foo"},
+		{Msg: "Skin", Type: "incompatible-skins", Incompatible: "Bar"},
+	})
+	test.AssetEqual("Core\nMissing\nSkin", err.Error(), `Message lists all errors`)
+	test.AssetTrue(err.IncompatibleCore, `Incompatible core`)
+	test.AssetTrue(!err.IncompatibleGo, `Compatible Go`)
+	test.AssetEqual("[Foo]", fmt.Sprint(err.MissingExtensions), `Missing extensions`)
+	test.AssetEqual("[Bar]", fmt.Sprint(err.IncompatibleSkins), `Incompatible skins`)
+}
@@ -7,7 +7,22 @@
  */
 package setup
 
+import "github.com/MangoDowner/mediawiki/includes/registration"
+
 // Disable MWDebug for command line mode, this prevents MWDebug from eating up
 // all the memory from logging SQL queries on maintenance scripts
 var WgCommandLineMode bool
 
+/**
+ * Load the extensions and skins queued by LocalSettings, after which no
+ * more can be loaded
+ *
+ * @throws MWException If the manifests are invalid
+ */
+func LoadExtensions() {
+	registry := registration.GetInstance()
+	if err := registry.LoadFromQueue(); err != nil {
+		panic(err)
+	}
+	registry.FinishLoading()
+}
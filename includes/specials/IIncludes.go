@@ -0,0 +1,12 @@
+package specials
+
+// IHookContainer includes.HookContainer
+type IHookContainer interface {
+	GetHookNames() []string
+	GetHandlerDescriptions(hook string) []string
+}
+
+// IHookRunner includes.HookRunner
+type IHookRunner interface {
+	OnSoftwareInfo(software *map[string]string) bool
+}
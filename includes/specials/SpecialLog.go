@@ -3,6 +3,12 @@
  */
 package specials
 
+import (
+	"errors"
+
+	"github.com/astaxie/beego/context"
+)
+
 /**
  * A special page that lists log entries
  *
@@ -17,8 +23,12 @@ func NewSpecialLog() *SpecialLog {
 	return this
 }
 
-func (s *SpecialLog) Msg() {
-
+/**
+ * @param string|null $par
+ * @param Context $context
+ */
+func (s *SpecialLog) Execute(par string, context *context.Context) error {
+	return errors.New("special page is not implemented yet")
 }
 
 
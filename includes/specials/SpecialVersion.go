@@ -0,0 +1,364 @@
+/**
+ * Implements Special:Version
+ */
+package specials
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	"github.com/MangoDowner/mediawiki/includes/libs/rdbms/database"
+	"github.com/MangoDowner/mediawiki/includes/parser"
+	"github.com/MangoDowner/mediawiki/includes/registration"
+	"github.com/astaxie/beego/context"
+)
+
+/**
+ * An installed software, e.g. MediaWiki
+ */
+type SoftwareInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	URL     string `json:"url,omitempty"`
+}
+
+/**
+ * Credits of an installed extension or skin
+ */
+type ExtensionInfo struct {
+	Type           string   `json:"type"`
+	Name           string   `json:"name"`
+	NameMsg        string   `json:"namemsg,omitempty"`
+	Version        string   `json:"version,omitempty"`
+	Author         []string `json:"author,omitempty"`
+	URL            string   `json:"url,omitempty"`
+	Description    string   `json:"description,omitempty"`
+	DescriptionMsg string   `json:"descriptionmsg,omitempty"`
+	LicenseName    string   `json:"license-name,omitempty"`
+}
+
+/**
+ * A hook with its handlers
+ */
+type HookInfo struct {
+	Name     string   `json:"name"`
+	Handlers []string `json:"subscribers"`
+}
+
+/**
+ * What Special:Version shows, in its machine-readable form
+ */
+type VersionInfo struct {
+	Software        []*SoftwareInfo  `json:"software"`
+	Extensions      []*ExtensionInfo `json:"extensions"`
+	Skins           []*ExtensionInfo `json:"skins"`
+	Hooks           []*HookInfo      `json:"hooks"`
+	ParserTags      []string         `json:"parsertags"`
+	ParserFunctions []string         `json:"parserfunctions"`
+}
+
+/**
+ * Wikitext links to the web site of a software, e.g. "[https://www.sqlite.org/ SQLite]"
+ */
+var softwareLinkRegex = regexp.MustCompile(`^\[(\S+)\s+([^\]]+)\]$`)
+
+/**
+ * Give information about the version of MediaWiki, PHP, the DB and extensions
+ *
+ * Special:Version/json gives the same information as JSON.
+ *
+ * @ingroup SpecialPage
+ */
+type SpecialVersion struct {
+	hookContainer IHookContainer
+	hookRunner    IHookRunner
+	parser        *parser.Parser
+	/** @var ExtensionRegistry Where the credits of the extensions and skins come from */
+	registry *registration.ExtensionRegistry
+	/** @var callable Returns the main database, or null if there is none */
+	getDB func() database.IDatabase
+	/** @var callable Returns the text of an interface message */
+	msg func(key string, params ...string) string
+}
+
+/**
+ * @param HookContainer $hookContainer The hooks to list
+ * @param HookRunner $hookRunner
+ * @param Parser $parser The parser whose tags and functions to list
+ * @param ExtensionRegistry $registry The loaded extensions and skins
+ * @param callable $getDB Returns the main database, or null if there is none
+ * @param callable $msg Returns the text of an interface message
+ */
+func NewSpecialVersion(hookContainer IHookContainer, hookRunner IHookRunner, parser *parser.Parser,
+	registry *registration.ExtensionRegistry, getDB func() database.IDatabase, msg func(key string, params ...string) string) *SpecialVersion {
+	this := new(SpecialVersion)
+	this.hookContainer = hookContainer
+	this.hookRunner = hookRunner
+	this.parser = parser
+	this.registry = registry
+	this.getDB = getDB
+	this.msg = msg
+	return this
+}
+
+/**
+ * main()
+ *
+ * @param string|null $par "json" for the machine-readable variant
+ * @param Context $context
+ */
+func (s *SpecialVersion) Execute(par string, context *context.Context) error {
+	var body []byte
+	if strings.ToLower(par) == "json" {
+		var err error
+		if body, err = json.MarshalIndent(s.GetVersionInfo(), "", "\t"); err != nil {
+			return err
+		}
+		context.ResponseWriter.Header().Set("Content-type", "application/json; charset=utf-8")
+	} else {
+		body = []byte(s.GetHTML())
+		context.ResponseWriter.Header().Set("Content-type", "text/html; charset=utf-8")
+	}
+	_, err := context.ResponseWriter.Write(body)
+	return err
+}
+
+/**
+ * @return VersionInfo
+ */
+func (s *SpecialVersion) GetVersionInfo() *VersionInfo {
+	info := &VersionInfo{
+		Software:        s.softwareInformation(),
+		Extensions:      []*ExtensionInfo{},
+		Skins:           []*ExtensionInfo{},
+		Hooks:           []*HookInfo{},
+		ParserTags:      []string{},
+		ParserFunctions: []string{},
+	}
+	for _, extension := range s.getCredits() {
+		if extension.Type == "skin" {
+			info.Skins = append(info.Skins, extension)
+		} else {
+			info.Extensions = append(info.Extensions, extension)
+		}
+	}
+	if s.hookContainer != nil {
+		for _, name := range s.hookContainer.GetHookNames() {
+			info.Hooks = append(info.Hooks, &HookInfo{Name: name, Handlers: s.hookContainer.GetHandlerDescriptions(name)})
+		}
+	}
+	if s.parser != nil {
+		info.ParserTags = append(info.ParserTags, s.parser.GetTags()...)
+		info.ParserFunctions = append(info.ParserFunctions, s.parser.GetFunctionHooks()...)
+	}
+	return info
+}
+
+/**
+ * Returns wiki text showing the third party software versions (apache, php, mysql).
+ *
+ * @return SoftwareInfo[]
+ */
+func (s *SpecialVersion) softwareInformation() []*SoftwareInfo {
+	software := []*SoftwareInfo{
+		{Name: "MediaWiki", Version: consts.MW_VERSION, URL: "https://www.mediawiki.org/"},
+		{Name: "Go", Version: strings.TrimPrefix(runtime.Version(), "go"), URL: "https://go.dev/"},
+	}
+	if s.getDB != nil {
+		if db := s.getDB(); db != nil {
+			software = append(software, newSoftwareInfo(db.GetSoftwareLink(), db.GetServerVersion()))
+		}
+	}
+
+	// Allow a hook to add/remove items.
+	if s.hookRunner != nil {
+		extra := make(map[string]string)
+		s.hookRunner.OnSoftwareInfo(&extra)
+		names := make([]string, 0, len(extra))
+		for name := range extra {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			software = append(software, newSoftwareInfo(name, extra[name]))
+		}
+	}
+	return software
+}
+
+/**
+ * @param string $name Name of the software, or wikitext link to its web site
+ * @param string $version
+ * @return SoftwareInfo
+ */
+func newSoftwareInfo(name, version string) *SoftwareInfo {
+	if match := softwareLinkRegex.FindStringSubmatch(name); match != nil {
+		return &SoftwareInfo{Name: match[2], Version: version, URL: match[1]}
+	}
+	return &SoftwareInfo{Name: name, Version: version}
+}
+
+/**
+ * @return ExtensionInfo[] Credits of the loaded extensions and skins, sorted by name
+ */
+func (s *SpecialVersion) getCredits() []*ExtensionInfo {
+	if s.registry == nil {
+		return nil
+	}
+	things := s.registry.GetAllThings()
+	extensions := make([]*ExtensionInfo, 0, len(things))
+	for name, credits := range things {
+		info := &ExtensionInfo{Name: name}
+		info.Type, _ = credits["type"].(string)
+		info.NameMsg, _ = credits["namemsg"].(string)
+		info.Version, _ = credits["version"].(string)
+		info.URL, _ = credits["url"].(string)
+		info.Description, _ = credits["description"].(string)
+		info.DescriptionMsg, _ = credits["descriptionmsg"].(string)
+		info.LicenseName, _ = credits["license-name"].(string)
+		switch author := credits["author"].(type) {
+		case string:
+			info.Author = []string{author}
+		case []interface{}:
+			for _, a := range author {
+				if a, ok := a.(string); ok {
+					info.Author = append(info.Author, a)
+				}
+			}
+		}
+		extensions = append(extensions, info)
+	}
+	sort.Slice(extensions, func(i, j int) bool {
+		return extensions[i].Name < extensions[j].Name
+	})
+	return extensions
+}
+
+/**
+ * @return string HTML of the page
+ */
+func (s *SpecialVersion) GetHTML() string {
+	info := s.GetVersionInfo()
+	var out strings.Builder
+
+	out.WriteString(s.heading("mw-version-software", "version-software"))
+	out.WriteString(`<table class="wikitable" id="sv-software"><tr>`)
+	fmt.Fprintf(&out, "<th>%s</th><th>%s</th></tr>\n",
+		s.escapedMsg("version-software-product"), s.escapedMsg("version-software-version"))
+	for _, software := range info.Software {
+		fmt.Fprintf(&out, "<tr><td>%s</td><td>%s</td></tr>\n",
+			link(software.URL, software.Name), html.EscapeString(software.Version))
+	}
+	out.WriteString("</table>\n")
+
+	out.WriteString(s.getExtensionCredits("mw-version-ext", "version-extensions", "version-ext-colheader-name",
+		info.Extensions))
+	out.WriteString(s.getExtensionCredits("mw-version-skin", "version-skins", "version-skin-colheader-name",
+		info.Skins))
+
+	if len(info.ParserTags) > 0 {
+		out.WriteString(s.heading("mw-version-parser-extensiontags", "version-parser-extensiontags"))
+		out.WriteString("<ul>\n")
+		for _, tag := range info.ParserTags {
+			fmt.Fprintf(&out, "<li><code>%s</code></li>\n", html.EscapeString("<"+tag+">"))
+		}
+		out.WriteString("</ul>\n")
+	}
+	if len(info.ParserFunctions) > 0 {
+		out.WriteString(s.heading("mw-version-parser-function-hooks", "version-parser-function-hooks"))
+		out.WriteString("<ul>\n")
+		for _, function := range info.ParserFunctions {
+			fmt.Fprintf(&out, "<li><code>%s</code></li>\n", html.EscapeString(function))
+		}
+		out.WriteString("</ul>\n")
+	}
+
+	if len(info.Hooks) > 0 {
+		out.WriteString(s.heading("mw-version-hooks", "version-hooks"))
+		out.WriteString(`<table class="wikitable" id="sv-hooks"><tr>`)
+		fmt.Fprintf(&out, "<th>%s</th><th>%s</th></tr>\n",
+			s.escapedMsg("version-hook-name"), s.escapedMsg("version-hook-subscribedby"))
+		for _, hook := range info.Hooks {
+			fmt.Fprintf(&out, "<tr><td>%s</td><td>%s</td></tr>\n",
+				html.EscapeString(hook.Name), html.EscapeString(strings.Join(hook.Handlers, ", ")))
+		}
+		out.WriteString("</table>\n")
+	}
+	return out.String()
+}
+
+/**
+ * Creates and returns the HTML for a credits table of a type of extension
+ *
+ * @param string $id
+ * @param string $headingMsg Key of the message of the section heading
+ * @param string $nameMsg Key of the message of the name column
+ * @param ExtensionInfo[] $extensions
+ * @return string HTML, empty if there are no extensions of the type
+ */
+func (s *SpecialVersion) getExtensionCredits(id, headingMsg, nameMsg string, extensions []*ExtensionInfo) string {
+	if len(extensions) == 0 {
+		return ""
+	}
+	var out strings.Builder
+	out.WriteString(s.heading(id, headingMsg))
+	fmt.Fprintf(&out, `<table class="wikitable" id="sv-%s"><tr>`, strings.TrimPrefix(id, "mw-version-"))
+	for _, key := range []string{nameMsg, "version-ext-colheader-version", "version-ext-colheader-license",
+		"version-ext-colheader-description", "version-ext-colheader-credits"} {
+		fmt.Fprintf(&out, "<th>%s</th>", s.escapedMsg(key))
+	}
+	out.WriteString("</tr>\n")
+	for _, extension := range extensions {
+		name := extension.Name
+		if extension.NameMsg != "" {
+			name = s.msg(extension.NameMsg)
+		}
+		description := extension.Description
+		if extension.DescriptionMsg != "" {
+			description = s.msg(extension.DescriptionMsg)
+		}
+		fmt.Fprintf(&out, "<tr><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td></tr>\n",
+			link(extension.URL, name), html.EscapeString(extension.Version),
+			html.EscapeString(extension.LicenseName), html.EscapeString(description),
+			html.EscapeString(strings.Join(extension.Author, ", ")))
+	}
+	out.WriteString("</table>\n")
+	return out.String()
+}
+
+/**
+ * @param string $id
+ * @param string $key Message key of the heading
+ * @return string HTML
+ */
+func (s *SpecialVersion) heading(id, key string) string {
+	return fmt.Sprintf("<h2 id=\"%s\">%s</h2>\n", id, s.escapedMsg(key))
+}
+
+/**
+ * @param string $key
+ * @return string Escaped text of the message
+ */
+func (s *SpecialVersion) escapedMsg(key string) string {
+	return html.EscapeString(s.msg(key))
+}
+
+/**
+ * @param string $url Empty for no link; only http(s) URLs are linked, so that
+ *  a manifest can not slip a javascript: URL into the page
+ * @param string $text
+ * @return string HTML
+ */
+func link(url, text string) string {
+	lower := strings.ToLower(url)
+	if !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") {
+		return html.EscapeString(text)
+	}
+	return fmt.Sprintf(`<a class="external" href="%s">%s</a>`, html.EscapeString(url), html.EscapeString(text))
+}
@@ -0,0 +1,161 @@
+package specials
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	libobjectcache "github.com/MangoDowner/mediawiki/includes/libs/objectcache"
+	"github.com/MangoDowner/mediawiki/includes/libs/rdbms/database"
+	"github.com/MangoDowner/mediawiki/includes/parser"
+	"github.com/MangoDowner/mediawiki/includes/registration"
+	test "github.com/MangoDowner/mediawiki/tests"
+	"github.com/astaxie/beego/context"
+)
+
+type fakeHookContainer struct {
+	handlers map[string][]string
+}
+
+func (f *fakeHookContainer) GetHookNames() []string {
+	return []string{"BeforePageDisplay", "ParserFirstCallInit"}
+}
+
+func (f *fakeHookContainer) GetHandlerDescriptions(hook string) []string {
+	return f.handlers[hook]
+}
+
+func (f *fakeHookContainer) OnSoftwareInfo(software *map[string]string) bool {
+	(*software)["[https://lua.org/ Lua]"] = "5.1"
+	return true
+}
+
+/**
+ * @return SpecialVersion Listing the extension FooBar and the skin Vector
+ */
+func newTestSpecialVersion(t *testing.T) *SpecialVersion {
+	saved := globals.GLOBALS
+	globals.GLOBALS = map[string]interface{}{}
+	t.Cleanup(func() {
+		globals.GLOBALS = saved
+	})
+
+	registry := registration.NewExtensionRegistry()
+	registry.SetCache(libobjectcache.NewHashBagOStuff(map[string]interface{}{}))
+	for name, manifest := range map[string]string{
+		"extension.json": `{"manifest_version": 2, "name": "FooBar", "version": "1.2.0",
+			"author": ["Jane Doe", "John Doe"], "url": "https://example.org/FooBar",
+			"descriptionmsg": "foobar-desc", "license-name": "GPL-2.0-or-later"}`,
+		"skin.json": `{"manifest_version": 2, "name": "Vector", "type": "skin", "author": "Trevor <Parscal>"}`,
+	} {
+		path := filepath.Join(t.TempDir(), name)
+		if err := os.WriteFile(path, []byte(manifest), 0644); err != nil {
+			t.Fatal(err)
+		}
+		registry.Queue(path)
+	}
+	if err := registry.LoadFromQueue(); err != nil {
+		t.Fatal(err)
+	}
+
+	p := parser.NewParser(nil)
+	p.SetHook("ref", func(input *string, args map[string]string, parser *parser.Parser) string { return "" })
+	p.SetFunctionHook("sum", func(parser *parser.Parser, args []string) string { return "" })
+
+	hooks := &fakeHookContainer{handlers: map[string][]string{
+		"BeforePageDisplay":   {"FooBarHooks::onBeforePageDisplay"},
+		"ParserFirstCallInit": {"FooBarHooks::onParserFirstCallInit", "Cite::setHooks"},
+	}}
+	msg := func(key string, params ...string) string {
+		return "(" + key + ")"
+	}
+	return NewSpecialVersion(hooks, hooks, p, registry, func() database.IDatabase { return nil }, msg)
+}
+
+/**
+ * @covers SpecialVersion::execute
+ */
+func TestSpecialVersion_JSON(t *testing.T) {
+	page := newTestSpecialVersion(t)
+	recorder := httptest.NewRecorder()
+	ctx := context.NewContext()
+	ctx.Reset(recorder, httptest.NewRequest("GET", "/wiki/Special:Version/json", nil))
+	test.AssetEqual(nil, page.Execute("json", ctx), `Executed`)
+	test.AssetEqual("application/json; charset=utf-8", recorder.Header().Get("Content-type"), `JSON content type`)
+
+	var info VersionInfo
+	test.AssetEqual(nil, json.Unmarshal(recorder.Body.Bytes(), &info), `Valid JSON`)
+	test.AssetEqual(3, len(info.Software), `MediaWiki, Go and the hooked software`)
+	test.AssetEqual(fmt.Sprint(SoftwareInfo{"MediaWiki", consts.MW_VERSION, "https://www.mediawiki.org/"}),
+		fmt.Sprint(*info.Software[0]), `MediaWiki version`)
+	test.AssetEqual(strings.TrimPrefix(runtime.Version(), "go"), info.Software[1].Version, `Go version`)
+	test.AssetEqual(fmt.Sprint(SoftwareInfo{"Lua", "5.1", "https://lua.org/"}), fmt.Sprint(*info.Software[2]),
+		`Software added by the SoftwareInfo hook`)
+
+	test.AssetEqual(1, len(info.Extensions), `One extension`)
+	foo := info.Extensions[0]
+	test.AssetEqual("FooBar 1.2.0 [Jane Doe John Doe] https://example.org/FooBar foobar-desc GPL-2.0-or-later",
+		fmt.Sprint(foo.Name, " ", foo.Version, " ", foo.Author, " ", foo.URL, " ", foo.DescriptionMsg, " ",
+			foo.LicenseName), `Extension credits`)
+	test.AssetEqual(1, len(info.Skins), `One skin`)
+	test.AssetEqual("[Trevor <Parscal>]", fmt.Sprint(info.Skins[0].Author), `Single author`)
+
+	test.AssetEqual(2, len(info.Hooks), `Two hooks`)
+	test.AssetEqual("[FooBarHooks::onParserFirstCallInit Cite::setHooks]", fmt.Sprint(info.Hooks[1].Handlers),
+		`Hook handlers`)
+	test.AssetEqual("[ref]", fmt.Sprint(info.ParserTags), `Parser tags`)
+	test.AssetEqual("[gender grammar lc lcfirst plural sum uc ucfirst]", fmt.Sprint(info.ParserFunctions),
+		`Core and extension parser functions`)
+}
+
+/**
+ * @covers SpecialVersion::execute
+ */
+func TestSpecialVersion_HTML(t *testing.T) {
+	page := newTestSpecialVersion(t)
+	recorder := httptest.NewRecorder()
+	ctx := context.NewContext()
+	ctx.Reset(recorder, httptest.NewRequest("GET", "/wiki/Special:Version", nil))
+	test.AssetEqual(nil, page.Execute("", ctx), `Executed`)
+	test.AssetEqual("text/html; charset=utf-8", recorder.Header().Get("Content-type"), `HTML content type`)
+
+	body := recorder.Body.String()
+	for _, expected := range []string{
+		`<h2 id="mw-version-software">(version-software)</h2>`,
+		`<a class="external" href="https://www.mediawiki.org/">MediaWiki</a></td><td>` + consts.MW_VERSION,
+		`<h2 id="mw-version-ext">(version-extensions)</h2>`,
+		`<a class="external" href="https://example.org/FooBar">FooBar</a>`,
+		`<td>(foobar-desc)</td><td>Jane Doe, John Doe</td>`,
+		`<h2 id="mw-version-skin">(version-skins)</h2>`,
+		`<td>Trevor &lt;Parscal&gt;</td>`,
+		`<li><code>&lt;ref&gt;</code></li>`,
+		`<li><code>sum</code></li>`,
+		`<tr><td>ParserFirstCallInit</td><td>FooBarHooks::onParserFirstCallInit, Cite::setHooks</td></tr>`,
+	} {
+		test.AssetTrue(strings.Contains(body, expected), `HTML contains `+expected)
+	}
+}
+
+/**
+ * @covers SpecialVersion::link
+ */
+func TestLink(t *testing.T) {
+	for url, expected := range map[string]string{
+		"":                         `Foo&amp;Bar`,
+		"https://example.org/?a&b": `<a class="external" href="https://example.org/?a&amp;b">Foo&amp;Bar</a>`,
+		"HTTP://example.org/":      `<a class="external" href="HTTP://example.org/">Foo&amp;Bar</a>`,
+		"javascript:alert(1)":      `Foo&amp;Bar`,
+		" javascript:alert(1)":     `Foo&amp;Bar`,
+		"data:text/html,<b>":       `Foo&amp;Bar`,
+		"//example.org/":           `Foo&amp;Bar`,
+	} {
+		test.AssertEqual(t, expected, link(url, "Foo&Bar"), `Link of `+url)
+	}
+}
@@ -4,6 +4,10 @@
 package title
 
 import (
+	"fmt"
+	"github.com/MangoDowner/mediawiki/includes/cache"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	"github.com/MangoDowner/mediawiki/includes/exception"
 	"github.com/MangoDowner/mediawiki/includes/languages"
 )
 
@@ -27,7 +31,7 @@ type MediaWikiTitleCodec struct {
 	/**
 	 * @var GenderCache
 	 */
-	genderCache interface{}
+	genderCache *cache.GenderCache
 
 	/**
 	 * @var string[]
@@ -46,7 +50,7 @@ type MediaWikiTitleCodec struct {
  * @param string[]|string $localInterwikis
  * @param InterwikiLookup|null $interwikiLookup
  */
-func NewMediaWikiTitleCodec(language *languages.Language, genderCache interface{},
+func NewMediaWikiTitleCodec(language *languages.Language, genderCache *cache.GenderCache,
 	localInterWikis []string, interwikiLookup interface{}) *MediaWikiTitleCodec {
 	this := new(MediaWikiTitleCodec)
 	this.language = language
@@ -66,3 +70,28 @@ func NewMediaWikiTitleCodec(language *languages.Language, genderCache interface{
 func (m *MediaWikiTitleCodec) ClearCaches() {
 
 }
+
+/**
+ * @param int $namespace
+ * @param string $text
+ * @throws InvalidArgumentException If the namespace is invalid
+ * @return string Namespace name with underscores (not spaces)
+ */
+func (m *MediaWikiTitleCodec) GetNamespaceName(namespace int, text string) string {
+	var (
+		name string
+		ok   bool
+	)
+	if m.language.NeedsGenderDistinction() &&
+		(namespace == consts.NS_USER || namespace == consts.NS_USER_TALK) {
+		// NOTE: we are assuming here that the title text is a user name!
+		gender := m.genderCache.GetGenderOf(text)
+		name, ok = m.language.GetGenderNsText(namespace, gender)
+	} else {
+		name, ok = m.language.GetNsText(namespace)
+	}
+	if !ok {
+		panic(exception.NewMWException(fmt.Sprintf("Unknown namespace ID: %d", namespace)))
+	}
+	return name
+}
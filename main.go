@@ -2,11 +2,18 @@ package main
 
 import (
 	"github.com/MangoDowner/mediawiki/includes"
+	"github.com/MangoDowner/mediawiki/includes/setup"
 	_ "github.com/MangoDowner/mediawiki/routers"
 )
 
 func main() {
 	includes.WfEntryPointCheck()
+	includes.ApplyDefaultSettings()
+	loadLocalSettings()
+	setup.LoadExtensions()
+	if err := includes.ValidateMainConfig(); err != nil {
+		panic(err)
+	}
 	mediaWiki := includes.NewMediaWiki(nil)
 	mediaWiki.Run()
 }
@@ -0,0 +1,336 @@
+/**
+ * Generates includes/HookInterfaces.go, the typed hook interfaces and the
+ * HookRunner methods running them, from the hook documentation.
+ *
+ * Usage:
+ *
+ *   go run ./maintenance/generateHookInterfaces [-hooks docs/hooks.txt] [-output includes/HookInterfaces.go]
+ *
+ * or "go generate ./includes/".
+ *
+ * Every hook of docs/hooks.txt gets an interface. The documentation describes
+ * the parameters but not their Go types, so these are listed in
+ * hookParamTypes below; the generation fails if that list and the
+ * documentation disagree about the hooks or their parameters, or if a hook
+ * cannot be turned into a Go interface.
+ */
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+/**
+ * Go types of the parameters of the hooks, in the order of docs/hooks.txt.
+ * Parameters documented as passed by reference (&$param) are passed as
+ * pointers to these types.
+ *
+ * The parameters of the hooks missing here are typed as interface{}, their
+ * types not being ported yet. Add a hook here once the types of its
+ * parameters are available in the includes package; interface{} stands for
+ * the ones still missing.
+ */
+var hookParamTypes = map[string][]string{
+	"BeforeInitialize": {
+		"*Title", "bool", "*OutputPage", "languages.IUser", "*WebRequest", "*MediaWiki",
+	},
+	"CanonicalNamespaces":                {"map[int]string"},
+	"LanguageGetTranslatedLanguageNames": {"map[string]string", "string"},
+	"MediaWikiServices":                  {"*MediaWikiServices"},
+	"MessageCacheReplace":                {"string", "string"},
+	"MessagesPreLoad":                    {"string", "interface{}", "string"},
+	"PageContentSave": {
+		"interface{}", "languages.IUser", "interface{}", "string", "bool", "bool", "interface{}",
+	},
+	"ParserFirstCallInit":   {"*parser.Parser"},
+	"SoftwareInfo":          {"map[string]string"},
+	"TestCanonicalRedirect": {"*WebRequest", "*Title", "*OutputPage"},
+	"TitleExists":           {"*Title", "bool"},
+}
+
+/** Go type of the parameters of the hooks missing in hookParamTypes */
+const UNTYPED_PARAM = "interface{}"
+
+/**
+ * Identifiers of the HookRunner methods, which the parameters cannot use
+ */
+var reservedNames = map[string]bool{"r": true, "args": true, "handler": true}
+
+/**
+ * Import paths of the packages the parameter types can refer to
+ */
+var importPaths = map[string]string{
+	"languages": "github.com/MangoDowner/mediawiki/includes/languages",
+	"parser":    "github.com/MangoDowner/mediawiki/includes/parser",
+}
+
+/**
+ * A hook as described in docs/hooks.txt
+ */
+type HookDoc struct {
+	Name string
+	// Go identifier of the hook, set on generation: Name, with "::"
+	// replaced by "__", the other punctuation by "_" and the first letter
+	// in upper case
+	GoName      string
+	Description []string
+	Params      []*HookParam
+}
+
+/**
+ * A hook parameter as described in docs/hooks.txt
+ */
+type HookParam struct {
+	Name        string
+	ByRef       bool
+	Description string
+	// Go type, set on generation
+	Type string
+}
+
+var (
+	hookLineRegex  = regexp.MustCompile(`^'([^']+)':\s*(.*)$`)
+	paramLineRegex = regexp.MustCompile(`^(&?)\$(\w+)\s*:\s*(.*)$`)
+	qualifierRegex = regexp.MustCompile(`\b([a-z]\w*)\.[A-Z]`)
+	nonWordRegex   = regexp.MustCompile(`\W`)
+)
+
+/**
+ * Parse the "Events and parameters" section of docs/hooks.txt
+ *
+ * @param string $text
+ * @return HookDoc[] Map of (hook name => HookDoc)
+ */
+func parseHooksDoc(text string) map[string]*HookDoc {
+	hooks := make(map[string]*HookDoc)
+	start := strings.Index(text, "==Events and parameters==")
+	if start < 0 {
+		return hooks
+	}
+
+	var current *HookDoc
+	for _, line := range strings.Split(text[start:], "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" {
+			current = nil
+			continue
+		}
+		if matches := hookLineRegex.FindStringSubmatch(line); matches != nil {
+			current = &HookDoc{Name: matches[1]}
+			if matches[2] != "" {
+				current.Description = append(current.Description, matches[2])
+			}
+			hooks[current.Name] = current
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		if matches := paramLineRegex.FindStringSubmatch(line); matches != nil {
+			current.Params = append(current.Params, &HookParam{
+				Name:        matches[2],
+				ByRef:       matches[1] == "&",
+				Description: matches[3],
+			})
+		} else if len(current.Params) > 0 {
+			// Continuation of the parameter description
+			param := current.Params[len(current.Params)-1]
+			param.Description = strings.TrimSpace(param.Description + " " + strings.TrimSpace(line))
+		} else {
+			current.Description = append(current.Description, line)
+		}
+	}
+	return hooks
+}
+
+/**
+ * Generate the Go source of the hook interfaces
+ *
+ * @param HookDoc[] $docs Map of (hook name => HookDoc)
+ * @param array $types Map of (hook name => parameter Go types)
+ * @return string Formatted Go source
+ * @return error If the types do not match the documentation
+ */
+func generate(docs map[string]*HookDoc, types map[string][]string) ([]byte, error) {
+	for name := range types {
+		if _, ok := docs[name]; !ok {
+			return nil, fmt.Errorf("hook %s is not documented in docs/hooks.txt", name)
+		}
+	}
+	names := make([]string, 0, len(docs))
+	for name := range docs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hooks := make([]*HookDoc, 0, len(names))
+	imports := make(map[string]bool)
+	goNames := make(map[string]string)
+	for _, name := range names {
+		doc := docs[name]
+		doc.GoName = hookGoName(name)
+		if !token.IsIdentifier(doc.GoName) {
+			return nil, fmt.Errorf("hook %s has no valid Go name", name)
+		}
+		if other, ok := goNames[doc.GoName]; ok {
+			return nil, fmt.Errorf("hooks %s and %s have the same Go name %s", other, name, doc.GoName)
+		}
+		goNames[doc.GoName] = name
+
+		paramTypes, ok := types[name]
+		if !ok {
+			paramTypes = make([]string, len(doc.Params))
+			for i := range paramTypes {
+				paramTypes[i] = UNTYPED_PARAM
+			}
+		}
+		if len(doc.Params) != len(paramTypes) {
+			return nil, fmt.Errorf("hook %s has %d documented parameters, but %d types",
+				name, len(doc.Params), len(paramTypes))
+		}
+		params := make(map[string]bool)
+		for i, param := range doc.Params {
+			if params[paramName(param.Name)] {
+				return nil, fmt.Errorf("hook %s has several parameters named $%s", name, param.Name)
+			}
+			params[paramName(param.Name)] = true
+			param.Type = paramTypes[i]
+			if param.ByRef {
+				param.Type = "*" + param.Type
+			}
+			for _, matches := range qualifierRegex.FindAllStringSubmatch(param.Type, -1) {
+				path, ok := importPaths[matches[1]]
+				if !ok {
+					return nil, fmt.Errorf("hook %s refers to the unknown package %s", name, matches[1])
+				}
+				imports[path] = true
+			}
+		}
+		hooks = append(hooks, doc)
+	}
+
+	importList := make([]string, 0, len(imports))
+	for path := range imports {
+		importList = append(importList, path)
+	}
+	sort.Strings(importList)
+
+	var out bytes.Buffer
+	err := hookTemplate.Execute(&out, map[string]interface{}{
+		"Hooks":   hooks,
+		"Imports": importList,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(out.Bytes())
+}
+
+/**
+ * @param string $name Hook name from docs/hooks.txt, e.g. "EditPage::attemptSave:after"
+ * @return string Go identifier for the hook, e.g. "EditPage__attemptSave_after"
+ */
+func hookGoName(name string) string {
+	name = nonWordRegex.ReplaceAllString(strings.Replace(name, "::", "__", -1), "_")
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+/**
+ * @param string $name Parameter name from docs/hooks.txt
+ * @return string Go identifier for the parameter
+ */
+func paramName(name string) string {
+	if token.IsKeyword(name) || reservedNames[name] {
+		return name + "Param"
+	}
+	return name
+}
+
+var hookTemplate = template.Must(template.New("hooks").Funcs(template.FuncMap{
+	"paramName": paramName,
+	"docType": func(goType string) string {
+		goType = strings.TrimLeft(goType, "*")
+		if goType == UNTYPED_PARAM {
+			return "mixed"
+		}
+		return goType
+	},
+}).Parse(`// Code generated by maintenance/generateHookInterfaces from docs/hooks.txt. DO NOT EDIT.
+
+package includes
+
+import (
+	"reflect"
+{{range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+{{range .Hooks}}
+/**
+{{- range .Description}}
+ * {{.}}
+{{- end}}
+ */
+type {{.GoName}}Hook interface {
+	/**
+{{- range .Params}}
+	 * @param {{docType .Type}} {{if .ByRef}}&{{end}}${{.Name}}{{if .Description}} {{.Description}}{{end}}
+{{- end}}
+	 * @return bool False to abort the hook
+	 */
+	On{{.GoName}}({{range $i, $p := .Params}}{{if $i}}, {{end}}{{paramName $p.Name}} {{$p.Type}}{{end}}) bool
+}
+
+/**
+ * Run the {{.Name}} hook
+ *
+ * @return bool True if no handler aborted the hook
+ */
+func (r *HookRunner) On{{.GoName}}({{range $i, $p := .Params}}{{if $i}}, {{end}}{{paramName $p.Name}} {{$p.Type}}{{end}}) bool {
+	args := []interface{}{ {{- range $i, $p := .Params}}{{if $i}}, {{end}}{{paramName $p.Name}}{{end -}} }
+	return r.container.run("{{.Name}}", args, func(handler interface{}) bool {
+		return handler.({{.GoName}}Hook).On{{.GoName}}({{range $i, $p := .Params}}{{if $i}}, {{end}}{{paramName $p.Name}}{{end}})
+	})
+}
+{{end}}
+/**
+ * Interfaces of the hooks, by hook name
+ * @var array Map of (hook name => interface type)
+ */
+var hookInterfaces = map[string]reflect.Type{
+{{- range .Hooks}}
+	"{{.Name}}": reflect.TypeOf((*{{.GoName}}Hook)(nil)).Elem(),
+{{- end}}
+}
+`))
+
+func main() {
+	hooksFile := flag.String("hooks", "docs/hooks.txt", "hook documentation to read")
+	outputFile := flag.String("output", "includes/HookInterfaces.go", "Go file to write")
+	flag.Parse()
+
+	text, err := ioutil.ReadFile(*hooksFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	source, err := generate(parseHooksDoc(string(text)), hookParamTypes)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := ioutil.WriteFile(*outputFile, source, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
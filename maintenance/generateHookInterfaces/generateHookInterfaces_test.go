@@ -0,0 +1,97 @@
+package main
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * @covers generateHookInterfaces::parseHooksDoc
+ */
+func TestParseHooksDoc(t *testing.T) {
+	hooks := parseHooksDoc(`==Events and parameters==
+
+'SomeHook': Called for
+something.
+$wgSomething is mentioned here.
+$first: the first
+  parameter
+&$second: by reference
+
+'OtherHook': No parameters.
+`)
+	test.AssetEqual(2, len(hooks), `Hooks parsed`)
+	test.AssetEqual(3, len(hooks["SomeHook"].Description), `Description lines`)
+	test.AssetEqual(2, len(hooks["SomeHook"].Params), `Parameters need a colon`)
+	test.AssetEqual("the first parameter", hooks["SomeHook"].Params[0].Description, `Continued parameter description`)
+	test.AssetEqual(true, hooks["SomeHook"].Params[1].ByRef, `Parameters by reference`)
+	test.AssetEqual(0, len(hooks["OtherHook"].Params), `Hooks without parameters`)
+}
+
+/**
+ * @covers generateHookInterfaces::generate
+ */
+func TestGenerate(t *testing.T) {
+	docs := parseHooksDoc("==Events and parameters==\n\n'SomeHook': Called.\n$first: the first\n")
+	_, err := generate(docs, map[string][]string{"OtherHook": {"string"}})
+	test.AssetTrue(err != nil, `Undocumented hooks are rejected`)
+	_, err = generate(docs, map[string][]string{"SomeHook": {"string", "int"}})
+	test.AssetTrue(err != nil, `Parameter counts must match`)
+	_, err = generate(docs, map[string][]string{"SomeHook": {"user.User"}})
+	test.AssetTrue(err != nil, `Unknown packages are rejected`)
+
+	docs = parseHooksDoc("==Events and parameters==\n\n'SomeHook': Called.\n$first: the first\n$first: again\n")
+	_, err = generate(docs, map[string][]string{})
+	test.AssertTrue(t, err != nil, `Parameters with the same name are rejected`)
+	docs = parseHooksDoc("==Events and parameters==\n\n'Some::hook': Called.\n\n'Some__hook': Called.\n")
+	_, err = generate(docs, map[string][]string{})
+	test.AssertTrue(t, err != nil, `Hooks with the same Go name are rejected`)
+}
+
+/**
+ * @covers generateHookInterfaces::generate
+ */
+func TestGenerateAllHooks(t *testing.T) {
+	docs := parseHooksDoc(`==Events and parameters==
+
+'SomeHook': Called.
+$first: the first
+
+'Other::hook:after': Not typed.
+$type: the type
+&$result: the result
+`)
+	source, err := generate(docs, map[string][]string{"SomeHook": {"string"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertTrue(t, strings.Contains(string(source), "OnSomeHook(first string) bool"), `Typed hook`)
+	test.AssertTrue(t, strings.Contains(string(source),
+		"OnOther__hook_after(typeParam interface{}, result *interface{}) bool"), `Hooks missing in the types are generated`)
+	test.AssertTrue(t, strings.Contains(string(source), "@param mixed &$result the result"), `Untyped parameters are mixed`)
+	test.AssertTrue(t, strings.Contains(string(source),
+		`"Other::hook:after": reflect.TypeOf((*Other__hook_afterHook)(nil)).Elem()`), `Interfaces by hook name`)
+}
+
+/**
+ * The committed hook interfaces match docs/hooks.txt; run
+ * "go generate ./includes/" if this fails.
+ */
+func TestHookInterfacesUpToDate(t *testing.T) {
+	text, err := ioutil.ReadFile("../../docs/hooks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := generate(parseHooksDoc(string(text)), hookParamTypes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual, err := ioutil.ReadFile("../../includes/HookInterfaces.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssetEqual(string(expected), string(actual), `includes/HookInterfaces.go is up to date`)
+}
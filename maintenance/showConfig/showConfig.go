@@ -0,0 +1,79 @@
+/**
+ * Shows the effective configuration of the site: the defaults of
+ * MainConfigSchema, then app.conf, the settings file and the MW_SETTING_*
+ * environment variables, as the site loads them at startup, with the
+ * settings of the extensions.
+ *
+ * Usage:
+ *
+ *   go run ./maintenance/showConfig [-settings LocalSettings.yaml] [-format yaml|json] [setting ...]
+ *
+ * Without settings, all the settings of MainConfigSchema are shown. The
+ * command fails, as the site would, if the configuration is invalid.
+ */
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/MangoDowner/mediawiki/includes"
+	"github.com/MangoDowner/mediawiki/includes/config"
+	"github.com/MangoDowner/mediawiki/includes/setup"
+	"gopkg.in/yaml.v3"
+)
+
+func main() {
+	settingsFile := flag.String("settings", includes.GetLocalSettingsPath(), "settings file to load")
+	format := flag.String("format", "yaml", "output format, yaml or json")
+	flag.Parse()
+
+	includes.ApplyDefaultSettings()
+	if err := includes.LoadLocalSettings(*settingsFile, os.Environ()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	setup.LoadExtensions()
+	if err := includes.ValidateMainConfig(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	names := flag.Args()
+	if len(names) == 0 {
+		names = includes.MainConfigSchema.GetNames()
+	}
+	output, err := showConfig(includes.NewMainConfig(), names, *format)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Stdout.Write(output)
+}
+
+/**
+ * @param Config $conf
+ * @param string[] $names Settings to show
+ * @param string $format "yaml" or "json"
+ * @return string The settings, sorted by name
+ * @return error|null If a setting is unknown, or the format
+ */
+func showConfig(conf config.IConfig, names []string, format string) ([]byte, error) {
+	settings := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		if !conf.Has(name) {
+			return nil, fmt.Errorf("Unknown setting %s", name)
+		}
+		settings[name] = conf.Get(name)
+	}
+	switch format {
+	case "yaml":
+		return yaml.Marshal(settings)
+	case "json":
+		output, err := json.MarshalIndent(settings, "", "\t")
+		return append(output, '\n'), err
+	}
+	return nil, fmt.Errorf("Unknown format %s, expected yaml or json", format)
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/includes/config"
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * @covers showConfig::showConfig
+ */
+func TestShowConfig(t *testing.T) {
+	conf := config.NewHashConfig(map[string]interface{}{
+		"Sitename":    "Wiki",
+		"EnableEmail": true,
+		"Servers":     []string{"a"},
+	})
+	output, err := showConfig(conf, []string{"Sitename", "Servers", "EnableEmail"}, "yaml")
+	test.AssertEqual(t, nil, err, `YAML`)
+	test.AssertEqual(t, "EnableEmail: true\nServers:\n    - a\nSitename: Wiki\n", string(output), `Sorted YAML`)
+
+	output, err = showConfig(conf, []string{"Sitename", "Servers"}, "json")
+	test.AssertEqual(t, nil, err, `JSON`)
+	test.AssertEqual(t, "{\n\t\"Servers\": [\n\t\t\"a\"\n\t],\n\t\"Sitename\": \"Wiki\"\n}\n", string(output), `Sorted JSON`)
+
+	_, err = showConfig(conf, []string{"Sitenmae"}, "yaml")
+	test.AssertTrue(t, err != nil, `Unknown setting`)
+	_, err = showConfig(conf, []string{"Sitename"}, "ini")
+	test.AssertTrue(t, err != nil, `Unknown format`)
+}
@@ -2,7 +2,10 @@ package routers
 
 import (
 	"github.com/MangoDowner/mediawiki/controllers"
+	"github.com/MangoDowner/mediawiki/includes"
+	"github.com/MangoDowner/mediawiki/includes/objectcache"
 	"github.com/astaxie/beego"
+	"github.com/astaxie/beego/context"
 )
 
 func init() {
@@ -13,5 +16,14 @@ func init() {
     beego.Router("/test", &controllers.TestController{})
 	beego.Router("/ajax", &controllers.AjaxController{},
 	"get,post:Ajax")
+	// Each request collects the stats of its cache operations
+	beego.InsertFilter("*", beego.BeforeRouter, func(ctx *context.Context) {
+		ctx.Request = ctx.Request.WithContext(objectcache.WithRequestStats(ctx.Request.Context()))
+	})
+	// Work that can wait until the response is sent, also after the
+	// controllers wrote it
+	beego.InsertFilter("*", beego.FinishRouter, func(ctx *context.Context) {
+		includes.DoPostOutputShutdown(ctx.Request.Context())
+	}, false)
 
 }
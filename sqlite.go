@@ -0,0 +1,6 @@
+//go:build sqlite
+
+package main
+
+// The driver of $wgDBtype = "sqlite", which needs cgo: go build -tags sqlite
+import _ "github.com/mattn/go-sqlite3"
@@ -0,0 +1,73 @@
+package mwtest
+
+import (
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes"
+	"github.com/MangoDowner/mediawiki/includes/cache"
+	"github.com/MangoDowner/mediawiki/includes/objectcache"
+)
+
+/**
+ * Give the test its own copy of the global state, restored at the end of
+ * the test:
+ *   - $wgHooks and $GLOBALS, which the test may change freely,
+ *   - the global MediaWikiServices instance and HookContainer, created
+ *     again when first used,
+ *   - the object caches, cleared, and the database they use,
+ *   - the MessageCache and GenderCache singletons, created again when
+ *     first used.
+ *
+ * @note The test cannot run in parallel.
+ *
+ * @param TestingT $t
+ */
+func ResetGlobals(t testing.TB) {
+	forbidParallel(t)
+
+	oldHooks := includes.WgHooks
+	includes.WgHooks = make(map[string][]includes.HookFunc, len(oldHooks))
+	for name, handlers := range oldHooks {
+		includes.WgHooks[name] = append([]includes.HookFunc{}, handlers...)
+	}
+
+	oldGlobals := globals.GLOBALS
+	globals.GLOBALS = make(map[string]interface{}, len(oldGlobals))
+	for name, value := range oldGlobals {
+		globals.GLOBALS[name] = value
+	}
+
+	oldServices := includes.ForceGlobalInstance(nil)
+	oldHookContainer := includes.ForceGlobalHookContainer(nil)
+	oldMainDatabase := objectcache.MainDatabase
+	oldStats := objectcache.StatsdDataFactory
+	objectcache.Clear()
+	cache.DestroyMessageCacheInstance()
+	cache.DestroyGenderCacheInstance()
+
+	t.Cleanup(func() {
+		cache.DestroyGenderCacheInstance()
+		cache.DestroyMessageCacheInstance()
+		objectcache.Clear()
+		objectcache.StatsdDataFactory = oldStats
+		objectcache.MainDatabase = oldMainDatabase
+		includes.ForceGlobalHookContainer(oldHookContainer)
+		if services := includes.ForceGlobalInstance(oldServices); services != nil {
+			services.Destroy()
+		}
+		globals.GLOBALS = oldGlobals
+		includes.WgHooks = oldHooks
+	})
+}
+
+/**
+ * Make the test fail if it runs in parallel, or calls t.Parallel() later,
+ * as it changes the global state
+ *
+ * @param TestingT $t
+ */
+func forbidParallel(t testing.TB) {
+	// Setenv() refuses to run in parallel tests, and the other way round
+	t.Setenv("MW_TEST_GLOBAL_STATE", t.Name())
+}
@@ -0,0 +1,270 @@
+package mwtest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MangoDowner/mediawiki/includes/libs/rdbms/database"
+)
+
+/**
+ * Database keeping its tables in memory, for the tests which cannot use
+ * SQLite because no driver is linked in.
+ *
+ * Values are kept as strings and compared as strings, like the results
+ * of IDatabase queries.
+ */
+type MemoryDatabase struct {
+	/** @var array Map of (table => list of rows) */
+	tables map[string][]map[string]string
+	/** @var array Map of (table => unique fields, separated by commas) */
+	uniqueKeys map[string]string
+	mutex      sync.Mutex
+}
+
+/**
+ * @param array $uniqueKeys Map of (table => fields that must be unique
+ *  together, separated by commas); rows of the other tables are never
+ *  conflicting
+ */
+func NewMemoryDatabase(uniqueKeys map[string]string) *MemoryDatabase {
+	this := new(MemoryDatabase)
+	this.tables = make(map[string][]map[string]string)
+	this.uniqueKeys = uniqueKeys
+	return this
+}
+
+func (m *MemoryDatabase) GetType() string {
+	return "memory"
+}
+
+func (m *MemoryDatabase) GetSoftwareLink() string {
+	return "MemoryDatabase"
+}
+
+func (m *MemoryDatabase) GetServerVersion() string {
+	return ""
+}
+
+/**
+ * Tables are created on the first insert, so only CREATE statements are
+ * accepted, and they do nothing.
+ */
+func (m *MemoryDatabase) Query(sql string, fname string) error {
+	if !strings.HasPrefix(strings.ToUpper(strings.TrimSpace(sql)), "CREATE ") {
+		return database.NewDBError("Unsupported query: " + sql)
+	}
+	return nil
+}
+
+func (m *MemoryDatabase) Select(table string, vars []string, conds map[string]interface{}, fname string,
+	options map[string]interface{}) ([]map[string]string, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	var rows []map[string]string
+	for _, row := range m.tables[table] {
+		ok, err := m.matches(row, conds)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		res := make(map[string]string)
+		fields := vars
+		if len(fields) == 0 {
+			fields = sortedKeys(row)
+		}
+		for _, field := range fields {
+			res[field] = row[field]
+		}
+		rows = append(rows, res)
+	}
+	if orderBy, ok := options["ORDER BY"].(string); ok && orderBy != "" {
+		sort.SliceStable(rows, func(i, j int) bool { return rows[i][orderBy] < rows[j][orderBy] })
+	}
+	if limit, ok := options["LIMIT"].(int); ok && len(rows) > limit {
+		rows = rows[:limit]
+	}
+	return rows, nil
+}
+
+func (m *MemoryDatabase) SelectRow(table string, vars []string, conds map[string]interface{}, fname string,
+	options map[string]interface{}) (map[string]string, error) {
+	rows, err := m.Select(table, vars, conds, fname, options)
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	return rows[0], nil
+}
+
+func (m *MemoryDatabase) Insert(table string, rows []map[string]interface{}, fname string,
+	options map[string]interface{}) (int, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	affected := 0
+	for _, row := range rows {
+		if m.find(table, row) >= 0 {
+			if options["IGNORE"] == true {
+				continue
+			}
+			return affected, database.NewDBError(fmt.Sprintf("Duplicate entry for key '%s'", m.uniqueKeys[table]))
+		}
+		m.tables[table] = append(m.tables[table], toRow(row))
+		affected++
+	}
+	return affected, nil
+}
+
+func (m *MemoryDatabase) Update(table string, values map[string]interface{}, conds map[string]interface{},
+	fname string) (int, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	affected := 0
+	for _, row := range m.tables[table] {
+		ok, err := m.matches(row, conds)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			for field, value := range values {
+				row[field] = fmt.Sprint(value)
+			}
+			affected++
+		}
+	}
+	return affected, nil
+}
+
+func (m *MemoryDatabase) Replace(table string, uniqueIndexes []string, rows []map[string]interface{},
+	fname string) (int, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	affected := 0
+	for _, row := range rows {
+		if i := m.find(table, row); i >= 0 {
+			m.tables[table][i] = toRow(row)
+		} else {
+			m.tables[table] = append(m.tables[table], toRow(row))
+		}
+		affected++
+	}
+	return affected, nil
+}
+
+func (m *MemoryDatabase) Delete(table string, conds map[string]interface{}, fname string) (int, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	affected := 0
+	var kept []map[string]string
+	for _, row := range m.tables[table] {
+		_, all := conds["*"]
+		ok, err := m.matches(row, conds)
+		if err != nil {
+			return 0, err
+		}
+		if all || ok {
+			affected++
+		} else {
+			kept = append(kept, row)
+		}
+	}
+	m.tables[table] = kept
+	return affected, nil
+}
+
+func (m *MemoryDatabase) Timestamp(ts int) string {
+	return time.Unix(int64(ts), 0).UTC().Format("20060102150405")
+}
+
+/**
+ * @param array $row
+ * @param array $conds Map of (field => value or Expression)
+ * @return bool
+ * @throws DBError On unknown operators
+ */
+func (m *MemoryDatabase) matches(row map[string]string, conds map[string]interface{}) (bool, error) {
+	for field, cond := range conds {
+		if field == "*" {
+			continue
+		}
+		value := row[field]
+		switch c := cond.(type) {
+		case *database.Expression:
+			cmp := strings.Compare(value, fmt.Sprint(c.Value))
+			ok, known := map[string]bool{
+				"=": cmp == 0, "!=": cmp != 0, "<": cmp < 0, ">": cmp > 0, "<=": cmp <= 0, ">=": cmp >= 0,
+			}[c.Op]
+			if !known {
+				return false, database.NewDBError(fmt.Sprintf("Invalid operator \"%s\" for field %s", c.Op, field))
+			}
+			if !ok {
+				return false, nil
+			}
+		case []string:
+			found := false
+			for _, v := range c {
+				found = found || v == value
+			}
+			if !found {
+				return false, nil
+			}
+		default:
+			if value != fmt.Sprint(c) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+/**
+ * @param string $table
+ * @param array $row
+ * @return int Index of the row conflicting with $row, or -1
+ */
+func (m *MemoryDatabase) find(table string, row map[string]interface{}) int {
+	uniqueKey, ok := m.uniqueKeys[table]
+	if !ok {
+		return -1
+	}
+	fields := strings.Split(uniqueKey, ",")
+	for i, existing := range m.tables[table] {
+		conflicting := true
+		for _, field := range fields {
+			conflicting = conflicting && existing[field] == fmt.Sprint(row[field])
+		}
+		if conflicting {
+			return i
+		}
+	}
+	return -1
+}
+
+/**
+ * @param array $row Map of (field => value)
+ * @return array Map of (field => value as string)
+ */
+func toRow(row map[string]interface{}) map[string]string {
+	res := make(map[string]string, len(row))
+	for field, value := range row {
+		res[field] = fmt.Sprint(value)
+	}
+	return res
+}
+
+/**
+ * @param array $row
+ * @return string[]
+ */
+func sortedKeys(row map[string]string) []string {
+	keys := make([]string, 0, len(row))
+	for key := range row {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,118 @@
+/**
+ * Helpers isolating the tests from the global state of the wiki.
+ *
+ * Tests get their own service containers instead of the global
+ * MediaWikiServices instance, so that they can run in parallel:
+ *
+ * @code
+ *     func TestFoo(t *testing.T) {
+ *         t.Parallel()
+ *         services := mwtest.NewServices(t)
+ *         mwtest.OverrideService(services, "MainConfig", fakeConfig)
+ *         foo := NewFoo(services.GetMainConfig())
+ *         ...
+ *     }
+ * @endcode
+ *
+ * Tests of code which still uses the globals call ResetGlobals(), or use
+ * the wiki-in-memory fixture of NewWiki(). They cannot run in parallel.
+ */
+package mwtest
+
+import (
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/includes"
+	"github.com/MangoDowner/mediawiki/includes/config"
+)
+
+/**
+ * Create a service container for a test, wired like the global one but
+ * not shared with anything else. It has its own HookContainer, running
+ * the $wgHooks handlers and the handlers registered on it.
+ *
+ * The services are destroyed at the end of the test.
+ *
+ * @param TestingT $t
+ * @return MediaWikiServices
+ */
+func NewServices(t testing.TB) *includes.MediaWikiServices {
+	services := includes.NewMediaWikiServices(config.NewGlobalVarConfig(""))
+	services.LoadWiringFiles(includes.WgServiceWiringFiles)
+	includes.RedefineService(services.ServiceContainer, "HookContainer",
+		func(*includes.ServiceContainer) (*includes.HookContainer, error) {
+			return includes.NewHookContainer(includes.NewHooks()), nil
+		},
+	)
+	includes.NewHookRunner(services.GetHookContainer()).OnMediaWikiServices(services)
+	t.Cleanup(services.Destroy)
+	return services
+}
+
+/**
+ * Create a service container with the same wiring as $services, including
+ * its overrides and manipulators, but none of its service instances: the
+ * fork creates its own.
+ *
+ * The fork is destroyed at the end of the test.
+ *
+ * @param TestingT $t
+ * @param MediaWikiServices $services
+ * @return MediaWikiServices
+ */
+func ForkServices(t testing.TB, services *includes.MediaWikiServices) *includes.MediaWikiServices {
+	fork := includes.NewMediaWikiServices(services.GetBootstrapConfig())
+	fork.ImportWiring(services.ServiceContainer, []string{"BootstrapConfig"})
+	t.Cleanup(fork.Destroy)
+	return fork
+}
+
+/**
+ * Replace a service by the given instance, typically a fake. The instance
+ * in use, if any, is detached from the container without being destroyed.
+ *
+ * @param MediaWikiServices $services
+ * @param string $name Name of the service
+ * @param object $service The replacement
+ */
+func OverrideService[T any](services *includes.MediaWikiServices, name string, service T) {
+	instantiator := func(*includes.ServiceContainer) (T, error) {
+		return service, nil
+	}
+	if !services.HasService(name) {
+		includes.DefineService(services.ServiceContainer, name, instantiator)
+		return
+	}
+	services.ResetService(name, false)
+	includes.RedefineService(services.ServiceContainer, name, instantiator)
+}
+
+/**
+ * Make the code getting the global MediaWikiServices instance fail until
+ * the end of the test, to find the code which isolated services miss.
+ *
+ * @param TestingT $t
+ */
+func ForbidGlobalServices(t testing.TB) {
+	old := includes.SetGlobalInstanceForbidden(true)
+	t.Cleanup(func() {
+		includes.SetGlobalInstanceForbidden(old)
+	})
+}
+
+/**
+ * Make $services the global MediaWikiServices instance until the end of
+ * the test, for the code which does not get them injected yet.
+ *
+ * @note The test cannot run in parallel.
+ *
+ * @param TestingT $t
+ * @param MediaWikiServices $services
+ */
+func UseGlobalServices(t testing.TB, services *includes.MediaWikiServices) {
+	forbidParallel(t)
+	old := includes.ForceGlobalInstance(services)
+	t.Cleanup(func() {
+		includes.ForceGlobalInstance(old)
+	})
+}
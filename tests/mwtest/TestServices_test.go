@@ -0,0 +1,116 @@
+package mwtest
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes"
+	"github.com/MangoDowner/mediawiki/includes/config"
+	"github.com/MangoDowner/mediawiki/includes/exception"
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * The tests run in tests/mwtest/, app.conf is in the root of the repository
+ */
+func TestMain(m *testing.M) {
+	config.ConfigFile = "../../conf/app.conf"
+	os.Exit(m.Run())
+}
+
+type namespaceHandler struct {
+	Calls int
+}
+
+func (n *namespaceHandler) OnCanonicalNamespaces(namespaces *map[int]string) bool {
+	n.Calls++
+	return true
+}
+
+/**
+ * @covers mwtest::NewServices
+ */
+func TestNewServices(t *testing.T) {
+	t.Parallel()
+	first, second := NewServices(t), NewServices(t)
+	test.AssertTrue(t, first.GetSpecialPageFactory() != second.GetSpecialPageFactory(), `Services are not shared`)
+	test.AssertTrue(t, first.GetHookContainer() != includes.GetHookContainer(), `Own HookContainer`)
+
+	handler := &namespaceHandler{}
+	first.GetHookContainer().Register("CanonicalNamespaces", handler)
+	namespaces := map[int]string{}
+	includes.NewHookRunner(second.GetHookContainer()).OnCanonicalNamespaces(&namespaces)
+	test.AssertEqual(t, 0, handler.Calls, `Handlers are not shared`)
+	includes.NewHookRunner(first.GetHookContainer()).OnCanonicalNamespaces(&namespaces)
+	test.AssertEqual(t, 1, handler.Calls, `Handlers of the test`)
+}
+
+/**
+ * @covers mwtest::OverrideService
+ * @covers mwtest::ForkServices
+ */
+func TestOverrideAndForkServices(t *testing.T) {
+	t.Parallel()
+	services := NewServices(t)
+	services.GetMainConfig()
+	fake := config.NewGlobalVarConfig("fake")
+	OverrideService[config.IConfig](services, "MainConfig", fake)
+	test.AssertTrue(t, services.GetMainConfig() == fake, `Active services are overridden`)
+	OverrideService(services, "FakeService", "fake")
+	fakeService, _ := includes.GetService[string](services.ServiceContainer, "FakeService")
+	test.AssertEqual(t, "fake", fakeService, `Unknown services are defined`)
+
+	fork := ForkServices(t, services)
+	test.AssertTrue(t, fork.GetMainConfig() == fake, `Overrides are forked`)
+	test.AssertTrue(t, fork.GetBootstrapConfig() == services.GetBootstrapConfig(), `Same bootstrap config`)
+	test.AssertTrue(t, fork.GetSpecialPageFactory() != services.GetSpecialPageFactory(), `Own service instances`)
+	test.AssertEqual(t, 
+		fmt.Sprint(services.GetServiceNames()),
+		fmt.Sprint(fork.GetServiceNames()),
+		`Same services`,
+	)
+}
+
+/**
+ * @covers mwtest::ForbidGlobalServices
+ * @covers mwtest::UseGlobalServices
+ */
+func TestGlobalServices(t *testing.T) {
+	t.Run("forbidden", func(t *testing.T) {
+		ForbidGlobalServices(t)
+		defer func() {
+			_, ok := recover().(*exception.MWException)
+			test.AssertTrue(t, ok, `The global services are forbidden`)
+		}()
+		includes.GetMediaWikiServices()
+	})
+	services := NewServices(t)
+	t.Run("used", func(t *testing.T) {
+		UseGlobalServices(t, services)
+		test.AssertTrue(t, includes.GetMediaWikiServices() == services, `Services of the test made global`)
+	})
+	test.AssertTrue(t, includes.GetMediaWikiServices() != services, `Global services restored`)
+}
+
+/**
+ * @covers mwtest::ResetGlobals
+ */
+func TestResetGlobals(t *testing.T) {
+	if globals.GLOBALS == nil {
+		globals.GLOBALS = make(map[string]interface{})
+	}
+	oldHookContainer := includes.GetHookContainer()
+	t.Run("reset", func(t *testing.T) {
+		ResetGlobals(t)
+		globals.GLOBALS["wgMwtestGlobal"] = true
+		includes.WgHooks["CanonicalNamespaces"] = append(includes.WgHooks["CanonicalNamespaces"],
+			func(namespaces *map[int]string) bool { return true })
+		test.AssertTrue(t, includes.GetHookContainer() != oldHookContainer, `New HookContainer`)
+	})
+	_, ok := globals.GLOBALS["wgMwtestGlobal"]
+	test.AssertEqual(t, false, ok, `$GLOBALS restored`)
+	test.AssertEqual(t, 0, len(includes.WgHooks["CanonicalNamespaces"]), `$wgHooks restored`)
+	test.AssertTrue(t, includes.GetHookContainer() == oldHookContainer, `HookContainer restored`)
+}
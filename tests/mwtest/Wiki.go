@@ -0,0 +1,103 @@
+package mwtest
+
+import (
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes"
+	libobjectcache "github.com/MangoDowner/mediawiki/includes/libs/objectcache"
+	"github.com/MangoDowner/mediawiki/includes/libs/rdbms/database"
+	"github.com/MangoDowner/mediawiki/includes/objectcache"
+)
+
+/**
+ * A wiki living in memory for the length of a test: its database is an
+ * in-memory SQLite database, its caches are HashBagOStuff instances, and
+ * its global services are isolated ones.
+ *
+ * SQLite is used when the test binary links a driver registering itself
+ * as "sqlite3", e.g. with:
+ *
+ * @code
+ *     import _ "github.com/mattn/go-sqlite3"
+ * @endcode
+ *
+ * Otherwise the database is a MemoryDatabase. The tests of this package link
+ * the driver with the sqlite build tag, which needs cgo:
+ *
+ * @code
+ *     go test -tags sqlite ./tests/mwtest/
+ * @endcode
+ */
+type Wiki struct {
+	/** @var MediaWikiServices The services of the wiki, also the global ones */
+	Services *includes.MediaWikiServices
+	/** @var IDatabase The main database of the wiki */
+	DB database.IDatabase
+}
+
+/**
+ * Unique keys of the tables of the wiki in a MemoryDatabase
+ * @var array Map of (table => unique field)
+ */
+var wikiUniqueKeys = map[string]string{
+	"objectcache":     "keyname",
+	"page":            "page_namespace,page_title",
+	"user":            "user_name",
+	"user_properties": "up_user,up_property",
+}
+
+/**
+ * Set up a wiki in memory until the end of the test. The global state is
+ * reset, see ResetGlobals().
+ *
+ * @note The test cannot run in parallel.
+ *
+ * @param TestingT $t
+ * @return Wiki
+ */
+func NewWiki(t testing.TB) *Wiki {
+	ResetGlobals(t)
+	this := new(Wiki)
+	this.DB = newWikiDatabase(t)
+	objectcache.MainDatabase = func() (database.IDatabase, error) {
+		return this.DB, nil
+	}
+
+	// The default $wgObjectCaches has "hash", make sure the configured one does
+	if objectCaches, ok := globals.GLOBALS["wgObjectCaches"].(map[string]map[string]interface{}); ok {
+		caches := make(map[string]map[string]interface{}, len(objectCaches)+1)
+		for id, params := range objectCaches {
+			caches[id] = params
+		}
+		caches["hash"] = map[string]interface{}{"class": "HashBagOStuff", "reportDupes": false}
+		globals.GLOBALS["wgObjectCaches"] = caches
+	}
+	for _, name := range []string{"wgMainCacheType", "wgMessageCacheType", "wgParserCacheType", "wgSessionCacheType"} {
+		globals.GLOBALS[name] = "hash"
+	}
+
+	this.Services = NewServices(t)
+	OverrideService[database.IDatabase](this.Services, "MainDatabase", this.DB)
+	UseGlobalServices(t, this.Services)
+	return this
+}
+
+/**
+ * @param TestingT $t
+ * @return IDatabase An in-memory SQLite database if possible, a MemoryDatabase otherwise
+ */
+func newWikiDatabase(t testing.TB) database.IDatabase {
+	db, err := database.NewDatabaseSqlite(map[string]interface{}{"dbFilePath": ":memory:"})
+	if err != nil {
+		return NewMemoryDatabase(wikiUniqueKeys)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+	cache := libobjectcache.NewSqlBagOStuff(map[string]interface{}{"server": db})
+	if err := cache.CreateTables(); err != nil {
+		t.Fatalf("Cannot create the tables of the wiki: %s", err)
+	}
+	return db
+}
@@ -0,0 +1,131 @@
+package mwtest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/globals"
+	"github.com/MangoDowner/mediawiki/includes"
+	"github.com/MangoDowner/mediawiki/includes/cache"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	"github.com/MangoDowner/mediawiki/includes/languages"
+	libobjectcache "github.com/MangoDowner/mediawiki/includes/libs/objectcache"
+	"github.com/MangoDowner/mediawiki/includes/objectcache"
+	test "github.com/MangoDowner/mediawiki/tests"
+)
+
+/**
+ * @covers mwtest::NewWiki
+ */
+func TestNewWiki(t *testing.T) {
+	wiki := NewWiki(t)
+	test.AssertTrue(t, includes.GetMediaWikiServices() == wiki.Services, `Services of the wiki are global`)
+
+	_, ok := objectcache.GetLocalClusterInstance().(*libobjectcache.HashBagOStuff)
+	test.AssertTrue(t, ok, `Main cache in memory`)
+
+	cache := objectcache.GetInstance(consts.CACHE_DB)
+	test.AssertTrue(t, cache.Set("foo", "bar", 0, 0), `Cached in the database`)
+	rows, err := wiki.DB.Select("objectcache", []string{"keyname"}, nil, "TestNewWiki", nil)
+	test.AssertEqual(t, nil, err, `No error`)
+	test.AssertEqual(t, 1, len(rows), `Database of the wiki`)
+	value, _ := cache.Get("foo", 0)
+	test.AssertEqual(t, "bar", value, `Read back from the database`)
+}
+
+/**
+ * @covers MemoryDatabase::insert
+ * @covers MemoryDatabase::replace
+ */
+func TestMemoryDatabase(t *testing.T) {
+	db := NewMemoryDatabase(map[string]string{"objectcache": "keyname"})
+	rows := []map[string]interface{}{{"keyname": "a", "value": 1}, {"keyname": "b", "value": 2}}
+	affected, err := db.Insert("objectcache", rows, "TestMemoryDatabase", nil)
+	test.AssertEqual(t, nil, err, `Inserted`)
+	test.AssertEqual(t, 2, affected, `Inserted rows`)
+	_, err = db.Insert("objectcache", rows[:1], "TestMemoryDatabase", nil)
+	test.AssertTrue(t, err != nil, `Duplicate keys`)
+	affected, _ = db.Insert("objectcache", rows, "TestMemoryDatabase", map[string]interface{}{"IGNORE": true})
+	test.AssertEqual(t, 0, affected, `Duplicate keys ignored`)
+
+	db.Replace("objectcache", []string{"keyname"}, []map[string]interface{}{{"keyname": "a", "value": 3}},
+		"TestMemoryDatabase")
+	row, _ := db.SelectRow("objectcache", nil, map[string]interface{}{"keyname": "a"}, "TestMemoryDatabase", nil)
+	test.AssertEqual(t, "3", row["value"], `Replaced`)
+
+	affected, _ = db.Delete("objectcache", map[string]interface{}{"*": "*"}, "TestMemoryDatabase")
+	test.AssertEqual(t, 2, affected, `All rows deleted`)
+}
+
+/**
+ * @covers ObjectCache::newFromParams
+ * @covers ObjectCache::newAnything
+ */
+func TestMainDatabaseService(t *testing.T) {
+	ResetGlobals(t)
+	globals.GLOBALS["wgDBtype"] = "mysql"
+	UseGlobalServices(t, NewServices(t))
+
+	_, err := objectcache.MainDatabase()
+	test.AssertTrue(t, strings.Contains(fmt.Sprint(err), `Unsupported $wgDBtype "mysql"`), `Only SQLite is supported`)
+	_, ok := objectcache.NewAnything(nil).(*libobjectcache.EmptyBagOStuff)
+	test.AssertTrue(t, ok, `CACHE_ANYTHING does not cache without a database`)
+
+	defer func() {
+		test.AssertTrue(t, strings.Contains(fmt.Sprint(recover()), "cannot use the main wiki database"),
+			`CACHE_DB needs the database`)
+	}()
+	objectcache.GetInstance(consts.CACHE_DB)
+}
+
+/**
+ * @covers PageStore::savePage
+ * @covers PageStore::deletePage
+ * @covers MessageCache::replace
+ */
+func TestMessageCacheOverrides(t *testing.T) {
+	wiki := NewWiki(t)
+	store := wiki.Services.GetPageStore()
+	en := languages.Factory("en")
+
+	test.AssertEqual(t, nil, store.SavePage(consts.NS_MEDIAWIKI, "Mwtest-message", "Before"), `Page saved`)
+	message, _ := cache.SingletonMessageCache().Get("mwtest-message", true, en)
+	test.AssertEqual(t, "Before", message, `Override read from the page table`)
+
+	test.AssertEqual(t, nil, store.SavePage(consts.NS_MEDIAWIKI, "Mwtest-message", "After"), `Page edited`)
+	message, _ = cache.SingletonMessageCache().Get("mwtest-message", true, en)
+	test.AssertEqual(t, "After", message, `Edit replaces the override`)
+	cache.DestroyMessageCacheInstance()
+	message, _ = cache.SingletonMessageCache().Get("mwtest-message", true, en)
+	test.AssertEqual(t, "After", message, `Edit is in the shared cache`)
+
+	test.AssertEqual(t, nil, store.DeletePage(consts.NS_MEDIAWIKI, "Mwtest-message"), `Page deleted`)
+	_, ok := cache.SingletonMessageCache().Get("mwtest-message", true, en)
+	test.AssertEqual(t, false, ok, `Deletion removes the override`)
+	texts, _ := store.GetPageTexts(consts.NS_MEDIAWIKI)
+	test.AssertEqual(t, 0, len(texts), `No page left`)
+}
+
+/**
+ * @covers UserOptionsLookup::getOptionOfUsers
+ * @covers GenderCache::getGenderOf
+ */
+func TestGenderCachePreferences(t *testing.T) {
+	wiki := NewWiki(t)
+	globals.GLOBALS["wgDefaultUserOptions"] = map[string]interface{}{"gender": "female"}
+	wiki.Services.GetUserOptionsLookup()
+	wiki.DB.Insert("user", []map[string]interface{}{
+		{"user_id": 1, "user_name": "Alice"},
+		{"user_id": 2, "user_name": "Bob"},
+	}, "TestGenderCachePreferences", nil)
+	wiki.DB.Insert("user_properties", []map[string]interface{}{
+		{"up_user": 2, "up_property": "gender", "up_value": "male"},
+	}, "TestGenderCachePreferences", nil)
+
+	genderCache := cache.SingletonGenderCache()
+	test.AssertEqual(t, "female", genderCache.GetDefault(), `Default from $wgDefaultUserOptions`)
+	test.AssertEqual(t, "male", genderCache.GetGenderOf("User:Bob"), `Preference from user_properties`)
+	test.AssertEqual(t, "female", genderCache.GetGenderOf("Alice"), `No preference`)
+	test.AssertEqual(t, "female", genderCache.GetGenderOf("Nobody"), `No such user`)
+}
@@ -0,0 +1,44 @@
+//go:build sqlite
+
+package mwtest
+
+// Run the tests of the wiki on SQLite, which needs cgo: go test -tags sqlite
+import (
+	"testing"
+
+	"github.com/MangoDowner/mediawiki/includes/cache"
+	"github.com/MangoDowner/mediawiki/includes/consts"
+	"github.com/MangoDowner/mediawiki/includes/libs/rdbms/database"
+	test "github.com/MangoDowner/mediawiki/tests"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+/**
+ * @covers mwtest::newWikiDatabase
+ * @covers DatabaseSqlite::select
+ * @covers DatabaseSqlite::replace
+ */
+func TestSqliteWiki(t *testing.T) {
+	wiki := NewWiki(t)
+	_, ok := wiki.DB.(*database.DatabaseSqlite)
+	test.AssertTrue(t, ok, `Database of the wiki is SQLite`)
+
+	store := wiki.Services.GetPageStore()
+	test.AssertEqual(t, nil, store.SavePage(consts.NS_MEDIAWIKI, "Mwtest-message", "Before"), `Page saved`)
+	test.AssertEqual(t, nil, store.SavePage(consts.NS_MEDIAWIKI, "Mwtest-message", "After"), `Page replaced`)
+	texts, err := store.GetPageTexts(consts.NS_MEDIAWIKI)
+	test.AssertEqual(t, nil, err, `Pages read`)
+	test.AssertEqual(t, 1, len(texts), `One row per page`)
+	test.AssertEqual(t, "After", texts["Mwtest-message"], `Text of the page`)
+
+	wiki.Services.GetUserOptionsLookup()
+	_, err = wiki.DB.Insert("user", []map[string]interface{}{{"user_name": "Alice"}}, "TestSqliteWiki", nil)
+	test.AssertEqual(t, nil, err, `User created`)
+	row, _ := wiki.DB.SelectRow("user", []string{"user_id"}, map[string]interface{}{"user_name": "Alice"},
+		"TestSqliteWiki", nil)
+	_, err = wiki.DB.Insert("user_properties", []map[string]interface{}{
+		{"up_user": row["user_id"], "up_property": "gender", "up_value": "female"},
+	}, "TestSqliteWiki", nil)
+	test.AssertEqual(t, nil, err, `Preference saved`)
+	test.AssertEqual(t, "female", cache.SingletonGenderCache().GetGenderOf("Alice"), `Preference read`)
+}
@@ -1,6 +1,9 @@
 package test
 
-import "fmt"
+import (
+	"fmt"
+	"testing"
+)
 
 func AssetEqual(answer interface{}, result interface{}, tip string) {
 	if result == answer {
@@ -30,3 +33,24 @@ func AssetTrue(result bool, tip string) {
 	fmt.Println(tip)
 }
 
+/**
+ * AssetEqual, failing the test when the result is not the answer
+ */
+func AssertEqual(t testing.TB, answer interface{}, result interface{}, tip string) {
+	t.Helper()
+	AssetEqual(answer, result, tip)
+	if result != answer {
+		t.Errorf("%s: expected %v, got %v", tip, answer, result)
+	}
+}
+
+/**
+ * AssetTrue, failing the test when the result is false
+ */
+func AssertTrue(t testing.TB, result bool, tip string) {
+	t.Helper()
+	AssetTrue(result, tip)
+	if !result {
+		t.Errorf("%s: failed", tip)
+	}
+}